@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -95,6 +96,12 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 		}, nil
 }
 
+// DecryptSecrets decrypts the outgoing token as loaded from the database
+func (bt *BridgeType) DecryptSecrets(ciphers *pg.ColumnCiphers) (err error) {
+	bt.OutgoingToken, err = ciphers.Decrypt(bt.OutgoingToken)
+	return err
+}
+
 // AuthenticateBridgeType returns true if the passed token matches its
 // IncomingToken, or returns false with an error.
 func AuthenticateBridgeType(bt *BridgeType, token string) (bool, error) {
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/auth"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
@@ -56,6 +57,16 @@ func NewExternalInitiator(
 	}, nil
 }
 
+// DecryptSecrets decrypts the outgoing token and secret as loaded from the
+// database
+func (ei *ExternalInitiator) DecryptSecrets(ciphers *pg.ColumnCiphers) (err error) {
+	if ei.OutgoingToken, err = ciphers.Decrypt(ei.OutgoingToken); err != nil {
+		return err
+	}
+	ei.OutgoingSecret, err = ciphers.Decrypt(ei.OutgoingSecret)
+	return err
+}
+
 // AuthenticateExternalInitiator compares an auth against an initiator and
 // returns true if the password hashes match
 func AuthenticateExternalInitiator(eia *auth.Token, ea *ExternalInitiator) (bool, error) {
@@ -0,0 +1,258 @@
+package bridges
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const (
+	// healthCheckTimeout bounds each probe, unless the interval is shorter
+	healthCheckTimeout = 10 * time.Second
+	healthCheckPage    = 100
+)
+
+var (
+	promBridgeHealthChecks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_health_checks",
+		Help: "The number of health checks of the given bridge, by status",
+	}, []string{"bridge", "status"})
+	promBridgeHealthSuccessRate = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "bridge_health_success_rate",
+		Help: "The rate of successful health checks of the given bridge over the health window, between 0 and 1",
+	}, []string{"bridge"})
+	promBridgeHealthLatencyP95 = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "bridge_health_latency_p95_seconds",
+		Help: "The 95th percentile latency of the health checks of the given bridge over the health window",
+	}, []string{"bridge"})
+)
+
+// BridgeHealthCheck is the result of a probe of the health endpoint of a bridge
+type BridgeHealthCheck struct {
+	ID         int64
+	BridgeName BridgeName
+	Success    bool
+	Latency    time.Duration
+	Error      null.String
+	CreatedAt  time.Time
+}
+
+// BridgeHealth sums up the health checks of a bridge over a window
+type BridgeHealth struct {
+	Name          BridgeName
+	Checks        int64
+	SuccessRate   float64
+	LatencyP95    time.Duration
+	LastCheckedAt time.Time
+	// LastError is the error of the last check, if it failed
+	LastError null.String
+}
+
+// RankBridges orders names from the healthiest bridge: by success rate, then
+// by p95 latency. The bridges without health checks come last, in their
+// original order.
+func RankBridges(names []BridgeName, health []BridgeHealth) []BridgeName {
+	byName := make(map[BridgeName]BridgeHealth, len(health))
+	for _, h := range health {
+		byName[h.Name] = h
+	}
+	ranked := append([]BridgeName{}, names...)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		hi, iok := byName[ranked[i]]
+		hj, jok := byName[ranked[j]]
+		if !iok || !jok {
+			return iok && !jok
+		}
+		if hi.SuccessRate != hj.SuccessRate {
+			return hi.SuccessRate > hj.SuccessRate
+		}
+		return hi.LatencyP95 < hj.LatencyP95
+	})
+	return ranked
+}
+
+// HealthCheckerConfig is the configuration of the HealthChecker
+type HealthCheckerConfig interface {
+	BridgeHealthCheckInterval() time.Duration
+	BridgeHealthCheckPath() string
+	BridgeHealthWindow() time.Duration
+}
+
+// HealthChecker probes the health endpoint of every bridge periodically, and
+// records the results, which are kept for the health window
+type HealthChecker interface {
+	services.ServiceCtx
+}
+
+type healthChecker struct {
+	utils.StartStopOnce
+	orm    ORM
+	cfg    HealthCheckerConfig
+	client *http.Client
+	lggr   logger.Logger
+
+	chStop chan struct{}
+	wgDone sync.WaitGroup
+}
+
+var _ HealthChecker = (*healthChecker)(nil)
+
+// NewHealthChecker returns a HealthChecker probing the bridges with client
+func NewHealthChecker(orm ORM, cfg HealthCheckerConfig, client *http.Client, lggr logger.Logger) HealthChecker {
+	return &healthChecker{
+		orm:    orm,
+		cfg:    cfg,
+		client: client,
+		lggr:   lggr.Named("BridgeHealthChecker"),
+		chStop: make(chan struct{}),
+	}
+}
+
+func (h *healthChecker) Start(context.Context) error {
+	return h.StartOnce("BridgeHealthChecker", func() error {
+		h.wgDone.Add(1)
+		go h.run()
+		return nil
+	})
+}
+
+func (h *healthChecker) Close() error {
+	return h.StopOnce("BridgeHealthChecker", func() error {
+		close(h.chStop)
+		h.wgDone.Wait()
+		return nil
+	})
+}
+
+func (h *healthChecker) run() {
+	defer h.wgDone.Done()
+	ctx, cancel := utils.ContextFromChan(h.chStop)
+	defer cancel()
+
+	ticker := time.NewTicker(h.cfg.BridgeHealthCheckInterval())
+	defer ticker.Stop()
+	for {
+		h.checkAll(ctx)
+		select {
+		case <-h.chStop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkAll probes every bridge concurrently, then updates the metrics and
+// deletes the checks older than the window
+func (h *healthChecker) checkAll(ctx context.Context) {
+	var bts []BridgeType
+	for offset := 0; ; offset += healthCheckPage {
+		page, count, err := h.orm.BridgeTypes(offset, healthCheckPage)
+		if err != nil {
+			h.lggr.Errorw("Failed to load bridges", "err", err)
+			return
+		}
+		bts = append(bts, page...)
+		if offset+healthCheckPage >= count {
+			break
+		}
+	}
+
+	var wg sync.WaitGroup
+	for _, bt := range bts {
+		wg.Add(1)
+		go func(bt BridgeType) {
+			defer wg.Done()
+			check := h.probe(ctx, bt)
+			if ctx.Err() != nil {
+				return
+			}
+			status := "success"
+			if !check.Success {
+				status = "failure"
+				h.lggr.Debugw("Bridge health check failed", "bridge", bt.Name, "err", check.Error.String)
+			}
+			promBridgeHealthChecks.WithLabelValues(bt.Name.String(), status).Inc()
+			if err := h.orm.CreateBridgeHealthCheck(&check); err != nil {
+				h.lggr.Errorw("Failed to save bridge health check", "bridge", bt.Name, "err", err)
+			}
+		}(bt)
+	}
+	wg.Wait()
+	if ctx.Err() != nil {
+		return
+	}
+
+	since := time.Now().Add(-h.cfg.BridgeHealthWindow())
+	health, err := h.orm.BridgeHealth(nil, since)
+	if err != nil {
+		h.lggr.Errorw("Failed to load bridge health", "err", err)
+	}
+	for _, bh := range health {
+		promBridgeHealthSuccessRate.WithLabelValues(bh.Name.String()).Set(bh.SuccessRate)
+		promBridgeHealthLatencyP95.WithLabelValues(bh.Name.String()).Set(bh.LatencyP95.Seconds())
+	}
+	if err = h.orm.DeleteBridgeHealthChecks(since); err != nil {
+		h.lggr.Errorw("Failed to delete old bridge health checks", "err", err)
+	}
+}
+
+// probe GETs the health endpoint of bt, which is successful if it returns a
+// 2xx status
+func (h *healthChecker) probe(ctx context.Context, bt BridgeType) BridgeHealthCheck {
+	check := BridgeHealthCheck{BridgeName: bt.Name, CreatedAt: time.Now()}
+
+	timeout := healthCheckTimeout
+	if interval := h.cfg.BridgeHealthCheckInterval(); interval < timeout {
+		timeout = interval
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, HealthCheckURL(bt.URL.String(), h.cfg.BridgeHealthCheckPath()), nil)
+	if err != nil {
+		check.Error = null.StringFrom(err.Error())
+		return check
+	}
+	start := time.Now()
+	resp, err := h.client.Do(req)
+	check.Latency = time.Since(start)
+	if err != nil {
+		check.Error = null.StringFrom(err.Error())
+		return check
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		check.Error = null.StringFrom(fmt.Sprintf("unexpected status %d", resp.StatusCode))
+		return check
+	}
+	check.Success = true
+	return check
+}
+
+// HealthCheckURL returns the URL of the health endpoint of a bridge: its URL
+// with path instead of its own path and query
+func HealthCheckURL(bridgeURL string, path string) string {
+	u, err := url.Parse(bridgeURL)
+	if err != nil {
+		return bridgeURL
+	}
+	u.Path = path
+	u.RawPath = ""
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String()
+}
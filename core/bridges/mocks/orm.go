@@ -7,6 +7,10 @@ import (
 	bridges "github.com/smartcontractkit/chainlink/core/bridges"
 
 	mock "github.com/stretchr/testify/mock"
+
+	pg "github.com/smartcontractkit/chainlink/core/services/pg"
+
+	time "time"
 )
 
 // ORM is an autogenerated mock type for the ORM type
@@ -14,6 +18,29 @@ type ORM struct {
 	mock.Mock
 }
 
+// BridgeHealth provides a mock function with given fields: names, since
+func (_m *ORM) BridgeHealth(names []bridges.BridgeName, since time.Time) ([]bridges.BridgeHealth, error) {
+	ret := _m.Called(names, since)
+
+	var r0 []bridges.BridgeHealth
+	if rf, ok := ret.Get(0).(func([]bridges.BridgeName, time.Time) []bridges.BridgeHealth); ok {
+		r0 = rf(names, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]bridges.BridgeHealth)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]bridges.BridgeName, time.Time) error); ok {
+		r1 = rf(names, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BridgeTypes provides a mock function with given fields: offset, limit
 func (_m *ORM) BridgeTypes(offset int, limit int) ([]bridges.BridgeType, int, error) {
 	ret := _m.Called(offset, limit)
@@ -44,13 +71,34 @@ func (_m *ORM) BridgeTypes(offset int, limit int) ([]bridges.BridgeType, int, er
 	return r0, r1, r2
 }
 
-// CreateBridgeType provides a mock function with given fields: bt
-func (_m *ORM) CreateBridgeType(bt *bridges.BridgeType) error {
-	ret := _m.Called(bt)
+// CreateBridgeHealthCheck provides a mock function with given fields: check
+func (_m *ORM) CreateBridgeHealthCheck(check *bridges.BridgeHealthCheck) error {
+	ret := _m.Called(check)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*bridges.BridgeType) error); ok {
-		r0 = rf(bt)
+	if rf, ok := ret.Get(0).(func(*bridges.BridgeHealthCheck) error); ok {
+		r0 = rf(check)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateBridgeType provides a mock function with given fields: bt, qopts
+func (_m *ORM) CreateBridgeType(bt *bridges.BridgeType, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, bt)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*bridges.BridgeType, ...pg.QOpt) error); ok {
+		r0 = rf(bt, qopts...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -72,6 +120,20 @@ func (_m *ORM) CreateExternalInitiator(externalInitiator *bridges.ExternalInitia
 	return r0
 }
 
+// DeleteBridgeHealthChecks provides a mock function with given fields: before
+func (_m *ORM) DeleteBridgeHealthChecks(before time.Time) error {
+	ret := _m.Called(before)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(time.Time) error); ok {
+		r0 = rf(before)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteBridgeType provides a mock function with given fields: bt
 func (_m *ORM) DeleteBridgeType(bt *bridges.BridgeType) error {
 	ret := _m.Called(bt)
@@ -130,20 +192,27 @@ func (_m *ORM) ExternalInitiators(offset int, limit int) ([]bridges.ExternalInit
 	return r0, r1, r2
 }
 
-// FindBridge provides a mock function with given fields: name
-func (_m *ORM) FindBridge(name bridges.BridgeName) (bridges.BridgeType, error) {
-	ret := _m.Called(name)
+// FindBridge provides a mock function with given fields: name, qopts
+func (_m *ORM) FindBridge(name bridges.BridgeName, qopts ...pg.QOpt) (bridges.BridgeType, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	var r0 bridges.BridgeType
-	if rf, ok := ret.Get(0).(func(bridges.BridgeName) bridges.BridgeType); ok {
-		r0 = rf(name)
+	if rf, ok := ret.Get(0).(func(bridges.BridgeName, ...pg.QOpt) bridges.BridgeType); ok {
+		r0 = rf(name, qopts...)
 	} else {
 		r0 = ret.Get(0).(bridges.BridgeType)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(bridges.BridgeName) error); ok {
-		r1 = rf(name)
+	if rf, ok := ret.Get(1).(func(bridges.BridgeName, ...pg.QOpt) error); ok {
+		r1 = rf(name, qopts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -151,13 +220,20 @@ func (_m *ORM) FindBridge(name bridges.BridgeName) (bridges.BridgeType, error) {
 	return r0, r1
 }
 
-// FindBridges provides a mock function with given fields: name
-func (_m *ORM) FindBridges(name []bridges.BridgeName) ([]bridges.BridgeType, error) {
-	ret := _m.Called(name)
+// FindBridges provides a mock function with given fields: name, qopts
+func (_m *ORM) FindBridges(name []bridges.BridgeName, qopts ...pg.QOpt) ([]bridges.BridgeType, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	var r0 []bridges.BridgeType
-	if rf, ok := ret.Get(0).(func([]bridges.BridgeName) []bridges.BridgeType); ok {
-		r0 = rf(name)
+	if rf, ok := ret.Get(0).(func([]bridges.BridgeName, ...pg.QOpt) []bridges.BridgeType); ok {
+		r0 = rf(name, qopts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]bridges.BridgeType)
@@ -165,8 +241,8 @@ func (_m *ORM) FindBridges(name []bridges.BridgeName) ([]bridges.BridgeType, err
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]bridges.BridgeName) error); ok {
-		r1 = rf(name)
+	if rf, ok := ret.Get(1).(func([]bridges.BridgeName, ...pg.QOpt) error); ok {
+		r1 = rf(name, qopts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -218,13 +294,41 @@ func (_m *ORM) FindExternalInitiatorByName(iname string) (bridges.ExternalInitia
 	return r0, r1
 }
 
-// UpdateBridgeType provides a mock function with given fields: bt, btr
-func (_m *ORM) UpdateBridgeType(bt *bridges.BridgeType, btr *bridges.BridgeTypeRequest) error {
-	ret := _m.Called(bt, btr)
+// UpdateBridgeOutgoingToken provides a mock function with given fields: bt, token, qopts
+func (_m *ORM) UpdateBridgeOutgoingToken(bt *bridges.BridgeType, token string, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, bt, token)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*bridges.BridgeType, string, ...pg.QOpt) error); ok {
+		r0 = rf(bt, token, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateBridgeType provides a mock function with given fields: bt, btr, qopts
+func (_m *ORM) UpdateBridgeType(bt *bridges.BridgeType, btr *bridges.BridgeTypeRequest, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, bt, btr)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*bridges.BridgeType, *bridges.BridgeTypeRequest) error); ok {
-		r0 = rf(bt, btr)
+	if rf, ok := ret.Get(0).(func(*bridges.BridgeType, *bridges.BridgeTypeRequest, ...pg.QOpt) error); ok {
+		r0 = rf(bt, btr, qopts...)
 	} else {
 		r0 = ret.Error(0)
 	}
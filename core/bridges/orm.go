@@ -2,6 +2,9 @@ package bridges
 
 import (
 	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
 
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/sqlx"
@@ -14,12 +17,19 @@ import (
 //go:generate mockery --name ORM --output ./mocks --case=underscore
 
 type ORM interface {
-	FindBridge(name BridgeName) (bt BridgeType, err error)
-	FindBridges(name []BridgeName) (bts []BridgeType, err error)
+	FindBridge(name BridgeName, qopts ...pg.QOpt) (bt BridgeType, err error)
+	FindBridges(name []BridgeName, qopts ...pg.QOpt) (bts []BridgeType, err error)
 	DeleteBridgeType(bt *BridgeType) error
 	BridgeTypes(offset int, limit int) ([]BridgeType, int, error)
-	CreateBridgeType(bt *BridgeType) error
-	UpdateBridgeType(bt *BridgeType, btr *BridgeTypeRequest) error
+	CreateBridgeType(bt *BridgeType, qopts ...pg.QOpt) error
+	UpdateBridgeType(bt *BridgeType, btr *BridgeTypeRequest, qopts ...pg.QOpt) error
+	// UpdateBridgeOutgoingToken replaces the token the bridge sends to its
+	// external adapter
+	UpdateBridgeOutgoingToken(bt *BridgeType, token string, qopts ...pg.QOpt) error
+
+	CreateBridgeHealthCheck(check *BridgeHealthCheck) error
+	BridgeHealth(names []BridgeName, since time.Time) ([]BridgeHealth, error)
+	DeleteBridgeHealthChecks(before time.Time) error
 
 	ExternalInitiators(offset int, limit int) ([]ExternalInitiator, int, error)
 	CreateExternalInitiator(externalInitiator *ExternalInitiator) error
@@ -29,40 +39,53 @@ type ORM interface {
 }
 
 type orm struct {
-	q pg.Q
+	q       pg.Q
+	ciphers *pg.ColumnCiphers
 }
 
 var _ ORM = (*orm)(nil)
 
-func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig) ORM {
+// NewORM returns an ORM encrypting the secrets of bridges and external
+// initiators with ciphers
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig, ciphers *pg.ColumnCiphers) ORM {
 	namedLogger := lggr.Named("BridgeORM")
-	return &orm{pg.NewQ(db, namedLogger, cfg)}
+	return &orm{pg.NewQ(db, namedLogger, cfg), ciphers}
 }
 
 // FindBridge looks up a Bridge by its Name.
 // Returns sql.ErrNoRows if name not present
-func (o *orm) FindBridge(name BridgeName) (bt BridgeType, err error) {
+func (o *orm) FindBridge(name BridgeName, qopts ...pg.QOpt) (bt BridgeType, err error) {
+	q := o.q.WithOpts(qopts...)
 	sql := "SELECT * FROM bridge_types WHERE name = $1"
-	err = o.q.Get(&bt, sql, name.String())
+	if err = q.Get(&bt, sql, name.String()); err != nil {
+		return
+	}
+	err = bt.DecryptSecrets(o.ciphers)
 	return
 }
 
 // FindBridges looks up multiple bridges in a single query.
 // Errors unless all bridges successfully found. Requires at least one bridge.
 // Expects all bridges to be unique
-func (o *orm) FindBridges(names []BridgeName) (bts []BridgeType, err error) {
+func (o *orm) FindBridges(names []BridgeName, qopts ...pg.QOpt) (bts []BridgeType, err error) {
+	q := o.q.WithOpts(qopts...)
 	sql := "SELECT * FROM bridge_types WHERE name IN (?)"
 	query, args, err := sqlx.In(sql, names)
 	if err != nil {
 		return nil, err
 	}
-	err = o.q.Select(&bts, o.q.Rebind(query), args...)
+	err = q.Select(&bts, q.Rebind(query), args...)
 	if err != nil {
 		return nil, err
 	}
 	if len(bts) != len(names) {
 		return nil, errors.Errorf("not all bridges exist, asked for %v, exists %v", names, bts)
 	}
+	for i := range bts {
+		if err = bts[i].DecryptSecrets(o.ciphers); err != nil {
+			return nil, err
+		}
+	}
 	return
 }
 
@@ -94,6 +117,11 @@ func (o *orm) BridgeTypes(offset int, limit int) (bridges []BridgeType, count in
 		if err = tx.Select(&bridges, sql, limit, offset); err != nil {
 			return errors.Wrap(err, "BridgeTypes failed to load bridge_types")
 		}
+		for i := range bridges {
+			if err = bridges[i].DecryptSecrets(o.ciphers); err != nil {
+				return errors.Wrap(err, "BridgeTypes failed to decrypt bridge_types")
+			}
+		}
 		return nil
 	}, pg.OptReadOnlyTx())
 
@@ -101,25 +129,97 @@ func (o *orm) BridgeTypes(offset int, limit int) (bridges []BridgeType, count in
 }
 
 // CreateBridgeType saves the bridge type.
-func (o *orm) CreateBridgeType(bt *BridgeType) error {
+func (o *orm) CreateBridgeType(bt *BridgeType, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
 	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, created_at, updated_at)
 	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, now(), now())
 	RETURNING *;`
-	err := o.q.Transaction(func(tx pg.Queryer) error {
+	encrypted := *bt
+	outgoingToken, err := o.ciphers.Encrypt(bt.OutgoingToken)
+	if err != nil {
+		return errors.Wrap(err, "CreateBridgeType failed")
+	}
+	encrypted.OutgoingToken = outgoingToken
+	err = q.Transaction(func(tx pg.Queryer) error {
 		stmt, err := tx.PrepareNamed(stmt)
 		if err != nil {
 			return err
 		}
-		return stmt.Get(bt, bt)
+		if err = stmt.Get(bt, encrypted); err != nil {
+			return err
+		}
+		return bt.DecryptSecrets(o.ciphers)
 	})
 	return errors.Wrap(err, "CreateBridgeType failed")
 }
 
 // UpdateBridgeType updates the bridge type.
 func (o *orm) UpdateBridgeType(bt *BridgeType,
-	btr *BridgeTypeRequest) error {
+	btr *BridgeTypeRequest, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
 	sql := "UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3 WHERE name = $4 RETURNING *"
-	return o.q.Get(bt, sql, btr.URL, btr.Confirmations, btr.MinimumContractPayment, bt.Name)
+	if err := q.Get(bt, sql, btr.URL, btr.Confirmations, btr.MinimumContractPayment, bt.Name); err != nil {
+		return err
+	}
+	return bt.DecryptSecrets(o.ciphers)
+}
+
+// UpdateBridgeOutgoingToken replaces the outgoing token of the bridge type.
+func (o *orm) UpdateBridgeOutgoingToken(bt *BridgeType, token string, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	encrypted, err := o.ciphers.Encrypt(token)
+	if err != nil {
+		return errors.Wrap(err, "UpdateBridgeOutgoingToken failed")
+	}
+	sql := "UPDATE bridge_types SET outgoing_token = $1, updated_at = now() WHERE name = $2 RETURNING *"
+	if err = q.Get(bt, sql, encrypted, bt.Name); err != nil {
+		return errors.Wrap(err, "UpdateBridgeOutgoingToken failed")
+	}
+	return bt.DecryptSecrets(o.ciphers)
+}
+
+// CreateBridgeHealthCheck saves the result of a health check
+func (o *orm) CreateBridgeHealthCheck(check *BridgeHealthCheck) error {
+	stmt := `INSERT INTO bridge_health_checks (bridge_name, success, latency, error, created_at)
+	VALUES ($1, $2, $3, $4, $5) RETURNING id`
+	err := o.q.Get(&check.ID, stmt, check.BridgeName, check.Success, check.Latency, check.Error, check.CreatedAt)
+	return errors.Wrap(err, "CreateBridgeHealthCheck failed")
+}
+
+// BridgeHealth sums up the health checks made since, of the bridges named, or
+// of all the bridges if names is nil. The bridges without checks are omitted.
+func (o *orm) BridgeHealth(names []BridgeName, since time.Time) ([]BridgeHealth, error) {
+	health, err := LoadBridgeHealth(o.q, names, since)
+	return health, errors.Wrap(err, "BridgeHealth failed")
+}
+
+// LoadBridgeHealth is ORM.BridgeHealth, with q
+func LoadBridgeHealth(q pg.Queryer, names []BridgeName, since time.Time) (health []BridgeHealth, err error) {
+	var nameStrs []string
+	if names != nil {
+		nameStrs = make([]string, len(names))
+		for i, n := range names {
+			nameStrs[i] = n.String()
+		}
+	}
+	stmt := `SELECT bridge_name AS name,
+		COUNT(*) AS checks,
+		AVG(CASE WHEN success THEN 1 ELSE 0 END)::float8 AS success_rate,
+		(percentile_cont(0.95) WITHIN GROUP (ORDER BY latency))::bigint AS latency_p95,
+		MAX(created_at) AS last_checked_at,
+		(array_agg(error ORDER BY created_at DESC))[1] AS last_error
+	FROM bridge_health_checks
+	WHERE created_at >= $1 AND ($2::text[] IS NULL OR bridge_name = ANY($2))
+	GROUP BY bridge_name
+	ORDER BY bridge_name`
+	err = q.Select(&health, stmt, since, pq.Array(nameStrs))
+	return
+}
+
+// DeleteBridgeHealthChecks deletes the health checks made before
+func (o *orm) DeleteBridgeHealthChecks(before time.Time) error {
+	_, err := o.q.Exec(`DELETE FROM bridge_health_checks WHERE created_at < $1`, before)
+	return errors.Wrap(err, "DeleteBridgeHealthChecks failed")
 }
 
 // --- External Initiator
@@ -135,6 +235,11 @@ func (o *orm) ExternalInitiators(offset int, limit int) (exis []ExternalInitiato
 		if err = tx.Select(&exis, sql, limit, offset); err != nil {
 			return errors.Wrap(err, "ExternalInitiators failed to load external_initiators")
 		}
+		for i := range exis {
+			if err = exis[i].DecryptSecrets(o.ciphers); err != nil {
+				return errors.Wrap(err, "ExternalInitiators failed to decrypt external_initiators")
+			}
+		}
 		return nil
 	}, pg.OptReadOnlyTx())
 	return
@@ -146,13 +251,23 @@ func (o *orm) CreateExternalInitiator(externalInitiator *ExternalInitiator) (err
 	VALUES (:name, :url, :access_key, :salt, :hashed_secret, :outgoing_secret, :outgoing_token, now(), now())
 	RETURNING *
 	`
+	encrypted := *externalInitiator
+	if encrypted.OutgoingToken, err = o.ciphers.Encrypt(externalInitiator.OutgoingToken); err != nil {
+		return errors.Wrap(err, "CreateExternalInitiator failed")
+	}
+	if encrypted.OutgoingSecret, err = o.ciphers.Encrypt(externalInitiator.OutgoingSecret); err != nil {
+		return errors.Wrap(err, "CreateExternalInitiator failed")
+	}
 	err = o.q.Transaction(func(tx pg.Queryer) error {
 		var stmt *sqlx.NamedStmt
 		stmt, err = tx.PrepareNamed(query)
 		if err != nil {
 			return errors.Wrap(err, "failed to prepare named stmt")
 		}
-		return errors.Wrap(stmt.Get(externalInitiator, externalInitiator), "failed to load external_initiator")
+		if err = stmt.Get(externalInitiator, encrypted); err != nil {
+			return errors.Wrap(err, "failed to load external_initiator")
+		}
+		return externalInitiator.DecryptSecrets(o.ciphers)
 	})
 	return errors.Wrap(err, "CreateExternalInitiator failed")
 }
@@ -182,11 +297,17 @@ func (o *orm) FindExternalInitiator(
 ) (*ExternalInitiator, error) {
 	exi := &ExternalInitiator{}
 	err := o.q.Get(exi, `SELECT * FROM external_initiators WHERE access_key = $1`, eia.AccessKey)
-	return exi, err
+	if err != nil {
+		return exi, err
+	}
+	return exi, exi.DecryptSecrets(o.ciphers)
 }
 
 // FindExternalInitiatorByName finds an external initiator given an authentication request
 func (o *orm) FindExternalInitiatorByName(iname string) (exi ExternalInitiator, err error) {
-	err = o.q.Get(&exi, `SELECT * FROM external_initiators WHERE lower(name) = lower($1)`, iname)
+	if err = o.q.Get(&exi, `SELECT * FROM external_initiators WHERE lower(name) = lower($1)`, iname); err != nil {
+		return
+	}
+	err = exi.DecryptSecrets(o.ciphers)
 	return
 }
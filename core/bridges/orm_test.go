@@ -2,10 +2,12 @@ package bridges_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/sqlx"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/bridges"
@@ -19,7 +21,7 @@ func setupORM(t *testing.T) (*sqlx.DB, bridges.ORM) {
 
 	cfg := cltest.NewTestGeneralConfig(t)
 	db := pgtest.NewSqlxDB(t)
-	orm := bridges.NewORM(db, logger.TestLogger(t), cfg)
+	orm := bridges.NewORM(db, logger.TestLogger(t), cfg, nil)
 
 	return db, orm
 }
@@ -170,3 +172,59 @@ func TestORM_DeleteExternalInitiator(t *testing.T) {
 
 	require.NoError(t, orm.CreateExternalInitiator(exi))
 }
+
+func TestORM_BridgeHealth(t *testing.T) {
+	t.Parallel()
+	_, orm := setupORM(t)
+
+	var names []bridges.BridgeName
+	for i := 0; i < 3; i++ {
+		_, bt := cltest.NewBridgeType(t, cltest.BridgeOpts{})
+		require.NoError(t, orm.CreateBridgeType(bt))
+		names = append(names, bt.Name)
+	}
+	bridge1, bridge2, bridge3 := names[0], names[1], names[2]
+
+	now := time.Now()
+	checks := []bridges.BridgeHealthCheck{
+		{BridgeName: bridge1, Success: true, Latency: 10 * time.Millisecond, CreatedAt: now.Add(-3 * time.Minute)},
+		{BridgeName: bridge1, Success: true, Latency: 20 * time.Millisecond, CreatedAt: now.Add(-2 * time.Minute)},
+		{BridgeName: bridge2, Success: true, Latency: 10 * time.Millisecond, CreatedAt: now.Add(-2 * time.Minute)},
+		{BridgeName: bridge2, Success: false, Latency: time.Second, Error: null.StringFrom("timeout"), CreatedAt: now.Add(-time.Minute)},
+		// older than the window
+		{BridgeName: bridge3, Success: false, CreatedAt: now.Add(-2 * time.Hour)},
+	}
+	for i := range checks {
+		require.NoError(t, orm.CreateBridgeHealthCheck(&checks[i]))
+		assert.NotZero(t, checks[i].ID)
+	}
+
+	since := now.Add(-time.Hour)
+	health, err := orm.BridgeHealth([]bridges.BridgeName{bridge1, bridge2}, since)
+	require.NoError(t, err)
+	require.Len(t, health, 2)
+	if health[0].Name != bridge1 {
+		health[0], health[1] = health[1], health[0]
+	}
+
+	assert.Equal(t, bridge1, health[0].Name)
+	assert.Equal(t, int64(2), health[0].Checks)
+	assert.Equal(t, 1.0, health[0].SuccessRate)
+	assert.False(t, health[0].LastError.Valid)
+
+	assert.Equal(t, bridge2, health[1].Name)
+	assert.Equal(t, int64(2), health[1].Checks)
+	assert.Equal(t, 0.5, health[1].SuccessRate)
+	assert.Greater(t, health[1].LatencyP95, 500*time.Millisecond)
+	assert.Equal(t, "timeout", health[1].LastError.String)
+
+	health, err = orm.BridgeHealth([]bridges.BridgeName{bridge2, bridge3}, since)
+	require.NoError(t, err)
+	require.Len(t, health, 1)
+	assert.Equal(t, bridge2, health[0].Name)
+
+	require.NoError(t, orm.DeleteBridgeHealthChecks(since))
+	health, err = orm.BridgeHealth(names, now.Add(-24*time.Hour))
+	require.NoError(t, err)
+	assert.Len(t, health, 2)
+}
@@ -0,0 +1,56 @@
+package bridges
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// The headers of the signed requests to the bridges. See
+// docs/core/BRIDGE_REQUEST_SIGNING.md for how the bridges verify them.
+const (
+	SignatureHeader = "X-Chainlink-Signature"
+	TimestampHeader = "X-Chainlink-Timestamp"
+)
+
+// SignRequest returns the values of the TimestampHeader and SignatureHeader of
+// a request to a bridge with body, sent at now: the unix time in seconds, and
+// the hex encoded HMAC-SHA256 of "<timestamp>.<body>", keyed by the outgoing
+// token of the bridge
+func SignRequest(outgoingToken string, body []byte, now time.Time) (timestamp string, signature string) {
+	timestamp = strconv.FormatInt(now.Unix(), 10)
+	return timestamp, hex.EncodeToString(requestMAC(outgoingToken, timestamp, body))
+}
+
+// VerifyRequest returns an error unless signature is a valid signature of body
+// and timestamp, as made by SignRequest, and timestamp is at most maxAge
+// before or after now
+func VerifyRequest(outgoingToken string, body []byte, timestamp string, signature string, maxAge time.Duration, now time.Time) error {
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid timestamp")
+	}
+	if age := now.Sub(time.Unix(unix, 0)); age > maxAge || age < -maxAge {
+		return errors.Errorf("timestamp is %s old, more than %s", age, maxAge)
+	}
+	mac, err := hex.DecodeString(signature)
+	if err != nil {
+		return errors.Wrap(err, "invalid signature")
+	}
+	if !hmac.Equal(mac, requestMAC(outgoingToken, timestamp, body)) {
+		return errors.New("signature mismatch")
+	}
+	return nil
+}
+
+func requestMAC(outgoingToken string, timestamp string, body []byte) []byte {
+	h := hmac.New(sha256.New, []byte(outgoingToken))
+	h.Write([]byte(timestamp))
+	h.Write([]byte("."))
+	h.Write(body)
+	return h.Sum(nil)
+}
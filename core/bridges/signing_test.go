@@ -0,0 +1,54 @@
+package bridges_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+)
+
+func TestSignRequest(t *testing.T) {
+	t.Parallel()
+
+	token := "outgoing-token"
+	body := []byte(`{"id":"1","data":{"coin":"ETH"}}`)
+	now := time.Unix(1660000000, 0)
+
+	timestamp, signature := bridges.SignRequest(token, body, now)
+	assert.Equal(t, "1660000000", timestamp)
+
+	tests := []struct {
+		name      string
+		token     string
+		body      []byte
+		timestamp string
+		signature string
+		now       time.Time
+		err       string
+	}{
+		{"valid", token, body, timestamp, signature, now, ""},
+		{"valid within max age", token, body, timestamp, signature, now.Add(time.Minute), ""},
+		{"expired", token, body, timestamp, signature, now.Add(2 * time.Minute), "timestamp is 2m0s old"},
+		{"from the future", token, body, timestamp, signature, now.Add(-2 * time.Minute), "timestamp is -2m0s old"},
+		{"tampered body", token, []byte(`{"id":"1","data":{"coin":"BTC"}}`), timestamp, signature, now, "signature mismatch"},
+		{"tampered timestamp", token, body, "1660000001", signature, now, "signature mismatch"},
+		{"wrong token", "other-token", body, timestamp, signature, now, "signature mismatch"},
+		{"invalid timestamp", token, body, "yesterday", signature, now, "invalid timestamp"},
+		{"invalid signature", token, body, timestamp, "not hex", now, "invalid signature"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := bridges.VerifyRequest(tt.token, tt.body, tt.timestamp, tt.signature, time.Minute, tt.now)
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.err)
+			}
+		})
+	}
+}
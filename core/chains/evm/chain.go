@@ -82,6 +82,11 @@ func newChain(ctx context.Context, dbchain types.DBChain, nodes []types.Node, op
 
 	db := opts.DB
 	headBroadcaster := headtracker.NewHeadBroadcaster(l)
+	if cfg.EVMRPCEnabled() && cfg.NodeCacheReads() {
+		cachingClient := evmclient.NewCachingClient(l, client, cfg.NodeNoNewHeadsThreshold())
+		headBroadcaster.Subscribe(cachingClient)
+		client = cachingClient
+	}
 	headSaver := headtracker.NullSaver
 	var headTracker httypes.HeadTracker
 	if !cfg.EVMRPCEnabled() {
@@ -119,7 +124,7 @@ func newChain(ctx context.Context, dbchain types.DBChain, nodes []types.Node, op
 
 	var balanceMonitor monitor.BalanceMonitor
 	if cfg.EVMRPCEnabled() && cfg.BalanceMonitorEnabled() {
-		balanceMonitor = monitor.NewBalanceMonitor(client, opts.KeyStore, l)
+		balanceMonitor = monitor.NewBalanceMonitor(client, opts.KeyStore, monitor.NewORM(db, l, cfg), l, cfg.EvmRPCDefaultBatchSize())
 		headBroadcaster.Subscribe(balanceMonitor)
 	}
 
@@ -230,6 +235,20 @@ func (c *chain) Healthy() (merr error) {
 	return
 }
 
+// HealthReport implements services.HealthReporter
+func (c *chain) HealthReport() map[string]error {
+	report := map[string]error{
+		"TxManager":       c.txm.Healthy(),
+		"HeadBroadcaster": c.headBroadcaster.Healthy(),
+		"HeadTracker":     c.headTracker.Healthy(),
+		"LogBroadcaster":  c.logBroadcaster.Healthy(),
+	}
+	if c.balanceMonitor != nil {
+		report["BalanceMonitor"] = c.balanceMonitor.Healthy()
+	}
+	return report
+}
+
 func (c *chain) ID() *big.Int                             { return c.id }
 func (c *chain) Client() evmclient.Client                 { return c.client }
 func (c *chain) Config() evmconfig.ChainScopedConfig      { return c.cfg }
@@ -102,6 +102,22 @@ func (cll *chainSet) Healthy() (err error) {
 	}
 	return
 }
+
+// HealthReport implements services.HealthReporter, reporting the services of
+// each chain by chain ID
+func (cll *chainSet) HealthReport() map[string]error {
+	report := map[string]error{}
+	for _, c := range cll.Chains() {
+		r, ok := c.(services.HealthReporter)
+		if !ok {
+			continue
+		}
+		for name, err := range r.HealthReport() {
+			report[fmt.Sprintf("%s.%s", c.ID(), name)] = err
+		}
+	}
+	return report
+}
 func (cll *chainSet) Ready() (err error) {
 	for _, c := range cll.Chains() {
 		err = multierr.Combine(err, c.Ready())
@@ -0,0 +1,215 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// CachingClient is a Client memoizing the idempotent reads made at a block:
+// eth_call, eth_getLogs and eth_getBalance. The results are cached by block
+// hash, so that the jobs reading the same contract state at the same block
+// make a single request.
+//
+// Reads at the latest block are made at the latest head passed to
+// OnNewLongestChain, unless it was received more than maxHeadAge ago. Reads at
+// a block which is not in the chain of the latest head are not cached.
+type CachingClient struct {
+	Client
+	lggr       logger.Logger
+	maxHeadAge time.Duration
+
+	mu     sync.RWMutex
+	head   *evmtypes.Head
+	headAt time.Time
+	blocks map[common.Hash]map[string]interface{}
+
+	group singleflight.Group
+}
+
+var _ Client = (*CachingClient)(nil)
+
+// NewCachingClient returns a CachingClient for client, with no limit on the
+// age of the latest head if maxHeadAge is zero.
+func NewCachingClient(lggr logger.Logger, client Client, maxHeadAge time.Duration) *CachingClient {
+	return &CachingClient{
+		Client:     client,
+		lggr:       lggr.Named("CachingClient"),
+		maxHeadAge: maxHeadAge,
+		blocks:     make(map[common.Hash]map[string]interface{}),
+	}
+}
+
+// OnNewLongestChain sets the latest head, and drops the results cached for
+// the blocks which are no longer in its chain.
+func (c *CachingClient) OnNewLongestChain(_ context.Context, head *evmtypes.Head) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.head = head
+	c.headAt = time.Now()
+	for hash := range c.blocks {
+		if !head.IsInChain(hash) {
+			delete(c.blocks, hash)
+		}
+	}
+}
+
+// block returns the number and the hash of the block a read at blockNumber is
+// made at, with ok false if the read cannot be cached.
+func (c *CachingClient) block(blockNumber *big.Int) (n *big.Int, hash common.Hash, ok bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.head == nil {
+		return nil, hash, false
+	}
+	if blockNumber == nil {
+		if c.maxHeadAge > 0 && time.Since(c.headAt) > c.maxHeadAge {
+			return nil, hash, false
+		}
+		return big.NewInt(c.head.Number), c.head.Hash, true
+	}
+	// Negative block numbers are tags, like pending
+	if blockNumber.Sign() < 0 || !blockNumber.IsInt64() {
+		return nil, hash, false
+	}
+	hash = c.head.HashAtHeight(blockNumber.Int64())
+	return blockNumber, hash, hash != common.Hash{}
+}
+
+// cached returns the result of the read with key at the block hash, making it
+// if it is not cached yet. Concurrent identical reads are made once.
+func (c *CachingClient) cached(hash common.Hash, key string, read func() (interface{}, error)) (interface{}, error) {
+	c.mu.RLock()
+	v, ok := c.blocks[hash][key]
+	c.mu.RUnlock()
+	if ok {
+		return v, nil
+	}
+	v, err, _ := c.group.Do(hash.Hex()+key, func() (interface{}, error) {
+		v, err := read()
+		if err != nil {
+			return nil, err
+		}
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		// The block may have been reorged out during the read
+		if c.head == nil || !c.head.IsInChain(hash) {
+			c.lggr.Debugw("Not caching read of a block which is not in the chain", "blockHash", hash)
+			return v, nil
+		}
+		if c.blocks[hash] == nil {
+			c.blocks[hash] = make(map[string]interface{})
+		}
+		c.blocks[hash][key] = v
+		return v, nil
+	})
+	return v, err
+}
+
+func readKey(method string, args interface{}) (string, error) {
+	b, err := json.Marshal(args)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to marshal %s args", method)
+	}
+	return method + string(b), nil
+}
+
+func (c *CachingClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	n, hash, ok := c.block(blockNumber)
+	if !ok {
+		return c.Client.CallContract(ctx, msg, blockNumber)
+	}
+	key, err := readKey("eth_call", msg)
+	if err != nil {
+		return nil, err
+	}
+	v, err := c.cached(hash, key, func() (interface{}, error) {
+		return c.Client.CallContract(ctx, msg, n)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return common.CopyBytes(v.([]byte)), nil
+}
+
+func (c *CachingClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	var hash common.Hash
+	if q.BlockHash != nil {
+		hash = *q.BlockHash
+	} else {
+		if q.FromBlock != nil && q.FromBlock.Sign() < 0 {
+			return c.Client.FilterLogs(ctx, q)
+		}
+		var ok bool
+		// The logs up to a block are determined by its hash
+		if q.ToBlock, hash, ok = c.block(q.ToBlock); !ok {
+			return c.Client.FilterLogs(ctx, q)
+		}
+	}
+	key, err := readKey("eth_getLogs", q)
+	if err != nil {
+		return nil, err
+	}
+	v, err := c.cached(hash, key, func() (interface{}, error) {
+		return c.Client.FilterLogs(ctx, q)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append([]types.Log(nil), v.([]types.Log)...), nil
+}
+
+func (c *CachingClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	n, hash, ok := c.block(blockNumber)
+	if !ok {
+		return c.Client.BalanceAt(ctx, account, blockNumber)
+	}
+	v, err := c.cached(hash, "eth_getBalance"+account.Hex(), func() (interface{}, error) {
+		return c.Client.BalanceAt(ctx, account, n)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).Set(v.(*big.Int)), nil
+}
+
+func (c *CachingClient) GetEthBalance(ctx context.Context, account common.Address, blockNumber *big.Int) (*assets.Eth, error) {
+	balance, err := c.BalanceAt(ctx, account, blockNumber)
+	if err != nil {
+		return assets.NewEth(0), err
+	}
+	return (*assets.Eth)(balance), nil
+}
+
+// GetERC20Balance returns the balance of the given address for the token contract address.
+func (c *CachingClient) GetERC20Balance(ctx context.Context, address common.Address, contractAddress common.Address) (*big.Int, error) {
+	functionSelector := evmtypes.HexToFunctionSelector("0x70a08231") // balanceOf(address)
+	data := utils.ConcatBytes(functionSelector.Bytes(), common.LeftPadBytes(address.Bytes(), utils.EVMWordByteLen))
+	b, err := c.CallContract(ctx, ethereum.CallMsg{To: &contractAddress, Data: data}, nil)
+	if err != nil {
+		return new(big.Int), err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// GetLINKBalance returns the balance of LINK at the given address
+func (c *CachingClient) GetLINKBalance(ctx context.Context, linkAddress common.Address, address common.Address) (*assets.Link, error) {
+	balance, err := c.GetERC20Balance(ctx, address, linkAddress)
+	if err != nil {
+		return assets.NewLinkFromJuels(0), err
+	}
+	return (*assets.Link)(balance), nil
+}
@@ -0,0 +1,188 @@
+package client_test
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func TestCachingClient_CallContract(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	to := testutils.NewAddress()
+	msg := ethereum.CallMsg{To: &to, Data: []byte{1, 2, 3}}
+	parent := cltest.Head(41)
+	head := cltest.Head(42)
+	head.Parent = parent
+
+	t.Run("reads are not cached without a head", func(t *testing.T) {
+		ethClient := evmmocks.NewClient(t)
+		ethClient.On("CallContract", mock.Anything, msg, (*big.Int)(nil)).Return([]byte{42}, nil).Twice()
+		c := evmclient.NewCachingClient(logger.TestLogger(t), ethClient, 0)
+
+		for i := 0; i < 2; i++ {
+			b, err := c.CallContract(ctx, msg, nil)
+			require.NoError(t, err)
+			assert.Equal(t, []byte{42}, b)
+		}
+	})
+
+	t.Run("reads at the latest block are made once per head", func(t *testing.T) {
+		ethClient := evmmocks.NewClient(t)
+		ethClient.On("CallContract", mock.Anything, msg, big.NewInt(42)).Return([]byte{42}, nil).Once()
+		c := evmclient.NewCachingClient(logger.TestLogger(t), ethClient, 0)
+		c.OnNewLongestChain(ctx, head)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				b, err := c.CallContract(ctx, msg, nil)
+				assert.NoError(t, err)
+				assert.Equal(t, []byte{42}, b)
+			}()
+		}
+		wg.Wait()
+
+		// The cached result is at the same block
+		b, err := c.CallContract(ctx, msg, big.NewInt(42))
+		require.NoError(t, err)
+		assert.Equal(t, []byte{42}, b)
+
+		// The cached result is not mutated by the callers
+		b[0] = 0
+		b, err = c.CallContract(ctx, msg, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{42}, b)
+
+		next := cltest.Head(43)
+		next.Parent = head
+		ethClient.On("CallContract", mock.Anything, msg, big.NewInt(43)).Return([]byte{43}, nil).Once()
+		c.OnNewLongestChain(ctx, next)
+		b, err = c.CallContract(ctx, msg, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{43}, b)
+		b, err = c.CallContract(ctx, msg, big.NewInt(42))
+		require.NoError(t, err)
+		assert.Equal(t, []byte{42}, b)
+	})
+
+	t.Run("reads at a block which is not in the chain are not cached", func(t *testing.T) {
+		ethClient := evmmocks.NewClient(t)
+		ethClient.On("CallContract", mock.Anything, msg, big.NewInt(40)).Return([]byte{40}, nil).Twice()
+		ethClient.On("CallContract", mock.Anything, msg, big.NewInt(-1)).Return([]byte{44}, nil).Twice()
+		c := evmclient.NewCachingClient(logger.TestLogger(t), ethClient, 0)
+		c.OnNewLongestChain(ctx, head)
+
+		for i := 0; i < 2; i++ {
+			b, err := c.CallContract(ctx, msg, big.NewInt(40))
+			require.NoError(t, err)
+			assert.Equal(t, []byte{40}, b)
+			b, err = c.CallContract(ctx, msg, big.NewInt(-1))
+			require.NoError(t, err)
+			assert.Equal(t, []byte{44}, b)
+		}
+	})
+
+	t.Run("reorged blocks are dropped", func(t *testing.T) {
+		ethClient := evmmocks.NewClient(t)
+		ethClient.On("CallContract", mock.Anything, msg, big.NewInt(42)).Return([]byte{42}, nil).Twice()
+		c := evmclient.NewCachingClient(logger.TestLogger(t), ethClient, 0)
+		c.OnNewLongestChain(ctx, head)
+
+		b, err := c.CallContract(ctx, msg, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{42}, b)
+
+		reorged := cltest.Head(42)
+		reorged.Parent = parent
+		c.OnNewLongestChain(ctx, reorged)
+		b, err = c.CallContract(ctx, msg, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{42}, b)
+	})
+
+	t.Run("reads at the latest block are not cached if the head is too old", func(t *testing.T) {
+		ethClient := evmmocks.NewClient(t)
+		ethClient.On("CallContract", mock.Anything, msg, (*big.Int)(nil)).Return([]byte{42}, nil).Twice()
+		c := evmclient.NewCachingClient(logger.TestLogger(t), ethClient, time.Millisecond)
+		c.OnNewLongestChain(ctx, head)
+		time.Sleep(10 * time.Millisecond)
+
+		for i := 0; i < 2; i++ {
+			b, err := c.CallContract(ctx, msg, nil)
+			require.NoError(t, err)
+			assert.Equal(t, []byte{42}, b)
+		}
+	})
+}
+
+func TestCachingClient_FilterLogs(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	head := cltest.Head(42)
+	head.Parent = cltest.Head(41)
+	addr := testutils.NewAddress()
+	logs := []types.Log{{Address: addr, BlockNumber: 41}}
+
+	ethClient := evmmocks.NewClient(t)
+	ethClient.On("FilterLogs", mock.Anything, ethereum.FilterQuery{FromBlock: big.NewInt(41), ToBlock: big.NewInt(42), Addresses: []common.Address{addr}}).Return(logs, nil).Once()
+	ethClient.On("FilterLogs", mock.Anything, ethereum.FilterQuery{BlockHash: &head.Parent.Hash, Addresses: []common.Address{addr}}).Return(logs, nil).Once()
+	ethClient.On("FilterLogs", mock.Anything, ethereum.FilterQuery{FromBlock: big.NewInt(-1), Addresses: []common.Address{addr}}).Return(nil, nil).Twice()
+	c := evmclient.NewCachingClient(logger.TestLogger(t), ethClient, 0)
+	c.OnNewLongestChain(ctx, head)
+
+	for i := 0; i < 2; i++ {
+		res, err := c.FilterLogs(ctx, ethereum.FilterQuery{FromBlock: big.NewInt(41), Addresses: []common.Address{addr}})
+		require.NoError(t, err)
+		assert.Equal(t, logs, res)
+		res, err = c.FilterLogs(ctx, ethereum.FilterQuery{FromBlock: big.NewInt(41), ToBlock: big.NewInt(42), Addresses: []common.Address{addr}})
+		require.NoError(t, err)
+		assert.Equal(t, logs, res)
+		res, err = c.FilterLogs(ctx, ethereum.FilterQuery{BlockHash: &head.Parent.Hash, Addresses: []common.Address{addr}})
+		require.NoError(t, err)
+		assert.Equal(t, logs, res)
+		res, err = c.FilterLogs(ctx, ethereum.FilterQuery{FromBlock: big.NewInt(-1), Addresses: []common.Address{addr}})
+		require.NoError(t, err)
+		assert.Empty(t, res)
+	}
+}
+
+func TestCachingClient_BalanceAt(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	head := cltest.Head(42)
+	addr := testutils.NewAddress()
+
+	ethClient := evmmocks.NewClient(t)
+	ethClient.On("BalanceAt", mock.Anything, addr, big.NewInt(42)).Return(big.NewInt(100), nil).Once()
+	c := evmclient.NewCachingClient(logger.TestLogger(t), ethClient, 0)
+	c.OnNewLongestChain(ctx, head)
+
+	balance, err := c.BalanceAt(ctx, addr, nil)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(100), balance)
+	balance.SetInt64(0)
+
+	eth, err := c.GetEthBalance(ctx, addr, big.NewInt(42))
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(100), eth.ToInt())
+}
@@ -266,6 +266,14 @@ func ToBlockNumArg(number *big.Int) string {
 	if number == nil {
 		return "latest"
 	}
+	if number.IsInt64() {
+		// block tags, e.g. big.NewInt(int64(rpc.FinalizedBlockNumber))
+		switch bn := rpc.BlockNumber(number.Int64()); bn {
+		case rpc.SafeBlockNumber, rpc.FinalizedBlockNumber, rpc.PendingBlockNumber, rpc.LatestBlockNumber:
+			tag, _ := bn.MarshalText()
+			return string(tag)
+		}
+	}
 	return hexutil.EncodeBig(number)
 }
 
@@ -280,6 +280,15 @@ func TestEthClient_HeaderByNumber(t *testing.T) {
 	}
 }
 
+func TestToBlockNumArg(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "latest", evmclient.ToBlockNumArg(nil))
+	assert.Equal(t, "0x2a", evmclient.ToBlockNumArg(big.NewInt(42)))
+	assert.Equal(t, "finalized", evmclient.ToBlockNumArg(big.NewInt(int64(rpc.FinalizedBlockNumber))))
+	assert.Equal(t, "safe", evmclient.ToBlockNumArg(big.NewInt(int64(rpc.SafeBlockNumber))))
+}
+
 func TestEthClient_SendTransaction_NoSecondaryURL(t *testing.T) {
 	t.Parallel()
 
@@ -329,6 +338,41 @@ func TestEthClient_SendTransaction_WithSecondaryURLs(t *testing.T) {
 	require.Eventually(t, func() bool { return service.sentCount.Load() == int32(2) }, testutils.WaitTimeout(t), 500*time.Millisecond)
 }
 
+func TestEthClient_SendTransaction_SingleStrategy(t *testing.T) {
+	t.Parallel()
+
+	tx := types.NewTransaction(uint64(42), testutils.NewAddress(), big.NewInt(142), 242, big.NewInt(342), []byte{1, 2, 3})
+
+	wsURL := cltest.NewWSServer(t, &cltest.FixtureChainID, func(method string, params gjson.Result) (string, string) {
+		require.Equal(t, "eth_sendRawTransaction", method)
+		return `"` + tx.Hash().Hex() + `"`, ""
+	})
+
+	rpcSrv := rpc.NewServer()
+	t.Cleanup(rpcSrv.Stop)
+	service := sendTxService{chainID: &cltest.FixtureChainID}
+	rpcSrv.RegisterName("eth", &service)
+	ts := httptest.NewServer(rpcSrv)
+	t.Cleanup(ts.Close)
+
+	cfg := evmclient.TestNodeConfig{
+		SelectionMode: evmclient.NodeSelectionMode_RoundRobin,
+		SendStrategy:  evmclient.NodeSendStrategy_Single,
+	}
+	sendonlyURL := *cltest.MustParseURL(t, ts.URL)
+	ethClient, err := evmclient.NewClientWithTestNode(cfg, logger.TestLogger(t), wsURL, nil, []url.URL{sendonlyURL}, 42, &cltest.FixtureChainID)
+	require.NoError(t, err)
+	err = ethClient.Dial(testutils.Context(t))
+	require.NoError(t, err)
+
+	err = ethClient.SendTransaction(testutils.Context(t), tx)
+	require.NoError(t, err)
+
+	// Close waits for the sends to secondary nodes, if any
+	ethClient.Close()
+	assert.Equal(t, int32(0), service.sentCount.Load())
+}
+
 type sendTxService struct {
 	chainID   *big.Int
 	sentCount atomic.Int32
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"math/big"
+	"time"
 
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 
@@ -121,6 +122,10 @@ func (e *erroringNode) StateAndLatestBlockNumber() (NodeState, int64) {
 	return NodeStateUnreachable, -1
 }
 
+func (e *erroringNode) StateAndLatency() (NodeState, time.Duration) {
+	return NodeStateUnreachable, 0
+}
+
 func (e *erroringNode) DeclareOutOfSync()            {}
 func (e *erroringNode) DeclareInSync()               {}
 func (e *erroringNode) DeclareUnreachable()          {}
@@ -6,10 +6,14 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"regexp"
 	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -401,3 +405,69 @@ cleanup:
 	revertReason := strings.TrimSpace(string(revertReasonBytes))
 	return revertReason, nil
 }
+
+var (
+	revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	panicSelector  = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+)
+
+// panicReasons are the Solidity panic codes
+var panicReasons = map[uint64]string{
+	0x00: "generic panic",
+	0x01: "assert(false)",
+	0x11: "arithmetic underflow or overflow",
+	0x12: "division or modulo by zero",
+	0x21: "enum overflow",
+	0x22: "invalid encoded storage byte array accessed",
+	0x31: "out-of-bounds array access; popping on an empty array",
+	0x32: "out-of-bounds access of an array or bytesN",
+	0x41: "out of memory",
+	0x51: "uninitialized function",
+}
+
+// DecodeRevertReason decodes the data returned by a reverted call, which is
+// ABI encoded as Error(string) or Panic(uint256) by Solidity
+func DecodeRevertReason(data []byte) (string, error) {
+	if len(data) < 4 {
+		return "", errors.New("no revert data")
+	}
+	switch {
+	case bytes.Equal(data[:4], revertSelector):
+		return abi.UnpackRevert(data)
+	case bytes.Equal(data[:4], panicSelector):
+		if len(data) != 4+32 {
+			return "", errors.New("invalid panic data")
+		}
+		code := new(big.Int).SetBytes(data[4:])
+		if !code.IsUint64() {
+			return "", errors.Errorf("invalid panic code %s", code)
+		}
+		if reason, ok := panicReasons[code.Uint64()]; ok {
+			return fmt.Sprintf("panic: %s (0x%x)", reason, code.Uint64()), nil
+		}
+		return fmt.Sprintf("panic: unknown code (0x%x)", code.Uint64()), nil
+	}
+	return "", errors.Errorf("unknown revert data selector %s", hexutil.Encode(data[:4]))
+}
+
+// RevertReasonFromCallError returns the decoded revert reason of an eth_call
+// that reverted, from the "data" field of the RPC error. If the node did not
+// return any data, the reason given by the message is used instead, e.g.
+// "hello world" for "execution reverted: hello world".
+func RevertReasonFromCallError(err error) (string, error) {
+	jErr, eErr := extractRPCError(err)
+	if eErr != nil {
+		return "", eErr
+	}
+	if dataStr, ok := jErr.Data.(string); ok && dataStr != "" {
+		data, derr := hexutil.Decode(dataStr)
+		if derr != nil {
+			return "", errors.Wrap(derr, "unable to decode revert data")
+		}
+		return DecodeRevertReason(data)
+	}
+	if reason := strings.TrimPrefix(jErr.Message, "execution reverted: "); reason != jErr.Message {
+		return reason, nil
+	}
+	return "", errors.Errorf("no revert reason in error: %s", jErr.Message)
+}
@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -371,3 +374,53 @@ func Test_ExtractRevertReasonFromRPCError(t *testing.T) {
 		require.Error(tt, err)
 	})
 }
+
+func Test_DecodeRevertReason(t *testing.T) {
+	t.Parallel()
+
+	stringType, err := abi.NewType("string", "", nil)
+	require.NoError(t, err)
+	encoded, err := abi.Arguments{{Type: stringType}}.Pack("hello world")
+	require.NoError(t, err)
+	errorData := append(crypto.Keccak256([]byte("Error(string)"))[:4], encoded...)
+	panicData := append(hexutil.MustDecode("0x4e487b71"), common.LeftPadBytes([]byte{0x11}, 32)...)
+
+	t.Run("decodes Error(string)", func(t *testing.T) {
+		reason, err := evmclient.DecodeRevertReason(errorData)
+		require.NoError(t, err)
+		assert.Equal(t, "hello world", reason)
+	})
+
+	t.Run("decodes Panic(uint256)", func(t *testing.T) {
+		reason, err := evmclient.DecodeRevertReason(panicData)
+		require.NoError(t, err)
+		assert.Equal(t, "panic: arithmetic underflow or overflow (0x11)", reason)
+	})
+
+	t.Run("errors on custom errors", func(t *testing.T) {
+		_, err := evmclient.DecodeRevertReason(hexutil.MustDecode("0x12345678"))
+		require.EqualError(t, err, "unknown revert data selector 0x12345678")
+	})
+
+	t.Run("extracts the reason from the data of an RPC error", func(t *testing.T) {
+		reason, err := evmclient.RevertReasonFromCallError(errors.Wrap(&evmclient.JsonError{
+			Code:    3,
+			Data:    hexutil.Encode(panicData),
+			Message: "execution reverted",
+		}, "wrapped"))
+		require.NoError(t, err)
+		assert.Equal(t, "panic: arithmetic underflow or overflow (0x11)", reason)
+	})
+
+	t.Run("falls back to the message of an RPC error", func(t *testing.T) {
+		reason, err := evmclient.RevertReasonFromCallError(&evmclient.JsonError{
+			Code:    3,
+			Message: "execution reverted: hello world",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "hello world", reason)
+
+		_, err = evmclient.RevertReasonFromCallError(&evmclient.JsonError{Code: -32000, Message: "out of gas"})
+		require.Error(t, err)
+	})
+}
@@ -15,12 +15,19 @@ type TestNodeConfig struct {
 	PollFailureThreshold uint32
 	PollInterval         time.Duration
 	SelectionMode        string
+	SendStrategy         string
 }
 
 func (tc TestNodeConfig) NodeNoNewHeadsThreshold() time.Duration { return tc.NoNewHeadsThreshold }
 func (tc TestNodeConfig) NodePollFailureThreshold() uint32       { return tc.PollFailureThreshold }
 func (tc TestNodeConfig) NodePollInterval() time.Duration        { return tc.PollInterval }
 func (tc TestNodeConfig) NodeSelectionMode() string              { return tc.SelectionMode }
+func (tc TestNodeConfig) NodeSendStrategy() string {
+	if tc.SendStrategy == "" {
+		return NodeSendStrategy_Broadcast
+	}
+	return tc.SendStrategy
+}
 
 func NewClientWithTestNode(cfg NodeConfig, lggr logger.Logger, rpcUrl string, rpcHTTPURL *url.URL, sendonlyRPCURLs []url.URL, id int32, chainID *big.Int) (*client, error) {
 	parsed, err := url.ParseRequestURI(rpcUrl)
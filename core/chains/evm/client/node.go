@@ -89,6 +89,8 @@ type Node interface {
 	State() NodeState
 	// StateAndLatestBlockNumber() returns NodeState and the latest received block number
 	StateAndLatestBlockNumber() (NodeState, int64)
+	// StateAndLatency() returns NodeState and the latency of the last successful poll, or zero if unknown
+	StateAndLatency() (NodeState, time.Duration)
 	// Unique identifier for node
 	ID() int32
 	ChainID() *big.Int
@@ -141,6 +143,8 @@ type node struct {
 
 	// Each node is tracking the last received head number
 	latestReceivedBlockNumber int64
+	// and the latency of the last successful poll
+	latency time.Duration
 
 	// Need to track subscriptions because closing the RPC does not (always?)
 	// close the underlying subscription
@@ -171,6 +175,7 @@ type NodeConfig interface {
 	NodePollFailureThreshold() uint32
 	NodePollInterval() time.Duration
 	NodeSelectionMode() string
+	NodeSendStrategy() string
 }
 
 // NewNode returns a new *node as Node
@@ -436,6 +441,7 @@ func (n *node) CallContext(ctx context.Context, result interface{}, method strin
 	duration := time.Since(start)
 
 	n.logResult(lggr, err, duration, n.getRPCDomain(), "CallContext")
+	n.recordRPCCalls(rpcCall{method, args, result, err, duration})
 
 	return err
 }
@@ -458,6 +464,7 @@ func (n *node) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
 	duration := time.Since(start)
 
 	n.logResult(lggr, err, duration, n.getRPCDomain(), "BatchCallContext")
+	n.recordRPCCalls(batchRPCCalls(b, err, duration)...)
 
 	return err
 }
@@ -479,6 +486,7 @@ func (n *node) EthSubscribe(ctx context.Context, channel chan<- *evmtypes.Head,
 	duration := time.Since(start)
 
 	n.logResult(lggr, err, duration, n.getRPCDomain(), "EthSubscribe")
+	n.recordRPCCalls(rpcCall{"eth_subscribe", args, nil, err, duration})
 
 	return sub, err
 }
@@ -508,6 +516,7 @@ func (n *node) TransactionReceipt(ctx context.Context, txHash common.Hash) (rece
 	n.logResult(lggr, err, duration, n.getRPCDomain(), "TransactionReceipt",
 		"receipt", receipt,
 	)
+	n.recordRPCCalls(rpcCall{"eth_getTransactionReceipt", []interface{}{txHash}, receipt, err, duration})
 
 	return
 }
@@ -532,6 +541,7 @@ func (n *node) HeaderByNumber(ctx context.Context, number *big.Int) (header *typ
 	duration := time.Since(start)
 
 	n.logResult(lggr, err, duration, n.getRPCDomain(), "HeaderByNumber", "header", header)
+	n.recordRPCCalls(rpcCall{"eth_getBlockByNumber", []interface{}{number}, header, err, duration})
 
 	return
 }
@@ -558,6 +568,7 @@ func (n *node) HeaderByHash(ctx context.Context, hash common.Hash) (header *type
 	n.logResult(lggr, err, duration, n.getRPCDomain(), "HeaderByHash",
 		"header", header,
 	)
+	n.recordRPCCalls(rpcCall{"eth_getBlockByHash", []interface{}{hash}, header, err, duration})
 
 	return
 }
@@ -580,6 +591,7 @@ func (n *node) SendTransaction(ctx context.Context, tx *types.Transaction) error
 	duration := time.Since(start)
 
 	n.logResult(lggr, err, duration, n.getRPCDomain(), "SendTransaction")
+	n.recordRPCCalls(rpcCall{"eth_sendRawTransaction", []interface{}{tx}, nil, err, duration})
 
 	return err
 }
@@ -607,6 +619,7 @@ func (n *node) PendingNonceAt(ctx context.Context, account common.Address) (nonc
 	n.logResult(lggr, err, duration, n.getRPCDomain(), "PendingNonceAt",
 		"nonce", nonce,
 	)
+	n.recordRPCCalls(rpcCall{"eth_getTransactionCount", []interface{}{account}, nonce, err, duration})
 
 	return
 }
@@ -636,6 +649,7 @@ func (n *node) NonceAt(ctx context.Context, account common.Address, blockNumber
 	n.logResult(lggr, err, duration, n.getRPCDomain(), "NonceAt",
 		"nonce", nonce,
 	)
+	n.recordRPCCalls(rpcCall{"eth_getTransactionCount", []interface{}{account, blockNumber}, nonce, err, duration})
 
 	return
 }
@@ -662,6 +676,7 @@ func (n *node) PendingCodeAt(ctx context.Context, account common.Address) (code
 	n.logResult(lggr, err, duration, n.getRPCDomain(), "PendingCodeAt",
 		"code", code,
 	)
+	n.recordRPCCalls(rpcCall{"eth_getCode", []interface{}{account}, code, err, duration})
 
 	return
 }
@@ -688,6 +703,7 @@ func (n *node) CodeAt(ctx context.Context, account common.Address, blockNumber *
 	n.logResult(lggr, err, duration, n.getRPCDomain(), "CodeAt",
 		"code", code,
 	)
+	n.recordRPCCalls(rpcCall{"eth_getCode", []interface{}{account, blockNumber}, code, err, duration})
 
 	return
 }
@@ -714,6 +730,7 @@ func (n *node) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint
 	n.logResult(lggr, err, duration, n.getRPCDomain(), "EstimateGas",
 		"gas", gas,
 	)
+	n.recordRPCCalls(rpcCall{"eth_estimateGas", []interface{}{call}, gas, err, duration})
 
 	return
 }
@@ -740,6 +757,7 @@ func (n *node) SuggestGasPrice(ctx context.Context) (price *big.Int, err error)
 	n.logResult(lggr, err, duration, n.getRPCDomain(), "SuggestGasPrice",
 		"price", price,
 	)
+	n.recordRPCCalls(rpcCall{"eth_gasPrice", nil, price, err, duration})
 
 	return
 }
@@ -766,6 +784,7 @@ func (n *node) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumb
 	n.logResult(lggr, err, duration, n.getRPCDomain(), "CallContract",
 		"val", val,
 	)
+	n.recordRPCCalls(rpcCall{"eth_call", []interface{}{msg, blockNumber}, val, err, duration})
 
 	return
 
@@ -793,6 +812,7 @@ func (n *node) BlockByNumber(ctx context.Context, number *big.Int) (b *types.Blo
 	n.logResult(lggr, err, duration, n.getRPCDomain(), "BlockByNumber",
 		"block", b,
 	)
+	n.recordRPCCalls(rpcCall{"eth_getBlockByNumber", []interface{}{number}, b, err, duration})
 
 	return
 }
@@ -819,6 +839,7 @@ func (n *node) BlockByHash(ctx context.Context, hash common.Hash) (b *types.Bloc
 	n.logResult(lggr, err, duration, n.getRPCDomain(), "BlockByHash",
 		"block", b,
 	)
+	n.recordRPCCalls(rpcCall{"eth_getBlockByHash", []interface{}{hash}, b, err, duration})
 
 	return
 }
@@ -845,6 +866,7 @@ func (n *node) BalanceAt(ctx context.Context, account common.Address, blockNumbe
 	n.logResult(lggr, err, duration, n.getRPCDomain(), "BalanceAt",
 		"balance", balance,
 	)
+	n.recordRPCCalls(rpcCall{"eth_getBalance", []interface{}{account, blockNumber}, balance, err, duration})
 
 	return
 }
@@ -871,6 +893,7 @@ func (n *node) FilterLogs(ctx context.Context, q ethereum.FilterQuery) (l []type
 	n.logResult(lggr, err, duration, n.getRPCDomain(), "FilterLogs",
 		"log", l,
 	)
+	n.recordRPCCalls(rpcCall{"eth_getLogs", []interface{}{q}, l, err, duration})
 
 	return
 }
@@ -893,6 +916,7 @@ func (n *node) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery,
 	duration := time.Since(start)
 
 	n.logResult(lggr, err, duration, n.getRPCDomain(), "SubscribeFilterLogs")
+	n.recordRPCCalls(rpcCall{"eth_subscribe", []interface{}{q}, nil, err, duration})
 
 	return
 }
@@ -919,6 +943,7 @@ func (n *node) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error
 	n.logResult(lggr, err, duration, n.getRPCDomain(), "SuggestGasTipCap",
 		"tipCap", tipCap,
 	)
+	n.recordRPCCalls(rpcCall{"eth_maxPriorityFeePerGas", nil, tipCap, err, duration})
 
 	return
 }
@@ -968,6 +993,10 @@ func (n *node) logResult(
 		Observe(float64(callDuration))
 }
 
+func (n *node) recordRPCCalls(calls ...rpcCall) {
+	recordRPCCalls(n.chainID.String(), n.name, n.getRPCDomain(), false, calls...)
+}
+
 func (n *node) wrapWS(err error) error {
 	err = wrap(err, fmt.Sprintf("primary websocket (%s)", n.ws.uri.Redacted()))
 	return err
@@ -2,6 +2,7 @@ package client
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -108,6 +109,13 @@ func (n *node) StateAndLatestBlockNumber() (NodeState, int64) {
 	return n.state, n.latestReceivedBlockNumber
 }
 
+// StateAndLatency returns the current state of the node with the latency of the last successful poll.
+func (n *node) StateAndLatency() (NodeState, time.Duration) {
+	n.stateMu.RLock()
+	defer n.stateMu.RUnlock()
+	return n.state, n.latency
+}
+
 // setState is only used by internal state management methods.
 // This is low-level; care should be taken by the caller to ensure the new state is a valid transition.
 // State changes should always be synchronous: only one goroutine at a time should change state.
@@ -36,6 +36,14 @@ var (
 		Name: "evm_pool_rpc_node_polls_success",
 		Help: "The total number of successful poll checks for the given RPC node",
 	}, []string{"evmChainID", "nodeName"})
+	promEVMPoolRPCNodePollLatency = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "evm_pool_rpc_node_poll_latency",
+		Help: "The duration of the last successful poll check for the given RPC node in nanoseconds",
+	}, []string{"evmChainID", "nodeName"})
+	promEVMPoolRPCNodeSyncing = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "evm_pool_rpc_node_syncing",
+		Help: "Whether the given RPC node reported that it is still syncing on the last poll check (1) or not (0)",
+	}, []string{"evmChainID", "nodeName"})
 )
 
 // zombieNodeCheckInterval controls how often to re-check to see if we need to
@@ -56,6 +64,12 @@ func (n *node) setLatestReceivedBlockNumber(number int64) {
 	n.latestReceivedBlockNumber = number
 }
 
+func (n *node) setLatency(latency time.Duration) {
+	n.stateMu.Lock()
+	defer n.stateMu.Unlock()
+	n.latency = latency
+}
+
 // Node is a FSM
 // Each state has a loop that goes with it, which monitors the node and moves it into another state as necessary.
 // Only one loop must run at a time.
@@ -139,7 +153,17 @@ func (n *node) aliveLoop() {
 			lggr.Tracew("Polling for version", "nodeState", n.State(), "pollFailures", pollFailures)
 			ctx, cancel := context.WithTimeout(n.nodeCtx, pollInterval)
 			ctx, cancel2 := n.makeQueryCtx(ctx)
+			pollStart := time.Now()
 			err := n.CallContext(ctx, &version, "web3_clientVersion")
+			pollLatency := time.Since(pollStart)
+			var syncing bool
+			if err == nil {
+				var syncErr error
+				syncing, syncErr = n.isSyncing(ctx)
+				if syncErr != nil {
+					lggr.Warnw("Failed to check whether RPC endpoint is syncing", "err", syncErr, "nodeState", n.State())
+				}
+			}
 			cancel2()
 			cancel()
 			if err != nil {
@@ -150,10 +174,24 @@ func (n *node) aliveLoop() {
 				}
 				lggr.Warnw(fmt.Sprintf("Poll failure, RPC endpoint %s failed to respond properly", n.String()), "err", err, "pollFailures", pollFailures, "nodeState", n.State())
 			} else {
-				lggr.Debugw("Version poll successful", "nodeState", n.State(), "clientVersion", version)
+				lggr.Debugw("Version poll successful", "nodeState", n.State(), "clientVersion", version, "latency", pollLatency)
 				promEVMPoolRPCNodePollsSuccess.WithLabelValues(n.chainID.String(), n.name).Inc()
+				promEVMPoolRPCNodePollLatency.WithLabelValues(n.chainID.String(), n.name).Set(float64(pollLatency))
+				n.setLatency(pollLatency)
 				pollFailures = 0
 			}
+			if syncing {
+				promEVMPoolRPCNodeSyncing.WithLabelValues(n.chainID.String(), n.name).Set(1)
+				lggr.Errorw(fmt.Sprintf("RPC endpoint %s reported that it is still syncing", n.String()), "nodeState", n.State(), "latestReceivedBlockNumber", highestReceivedBlockNumber)
+				if n.nLiveNodes != nil && n.nLiveNodes() < 2 {
+					lggr.Critical("RPC endpoint is syncing; but cannot disable this connection because there are no other RPC endpoints, or all other RPC endpoints are dead. Chainlink is now operating in a degraded state and urgent action is required to resolve the issue")
+					continue
+				}
+				n.declareOutOfSync(highestReceivedBlockNumber)
+				return
+			} else if err == nil {
+				promEVMPoolRPCNodeSyncing.WithLabelValues(n.chainID.String(), n.name).Set(0)
+			}
 			if pollFailureThreshold > 0 && pollFailures >= pollFailureThreshold {
 				lggr.Errorw(fmt.Sprintf("RPC endpoint failed to respond to %d consecutive polls", pollFailures), "pollFailures", pollFailures, "nodeState", n.State())
 				if n.nLiveNodes != nil && n.nLiveNodes() < 2 {
@@ -201,6 +239,21 @@ func (n *node) aliveLoop() {
 	}
 }
 
+// isSyncing returns true if the node reports that it is still catching up
+// with the chain. eth_syncing returns false when synced, and an object with
+// the sync progress otherwise.
+func (n *node) isSyncing(ctx context.Context) (bool, error) {
+	var result interface{}
+	if err := n.CallContext(ctx, &result, "eth_syncing"); err != nil {
+		return false, err
+	}
+	if result == nil {
+		return false, nil
+	}
+	syncing, ok := result.(bool)
+	return !ok || syncing, nil
+}
+
 // outOfSyncLoop takes an OutOfSync node and puts it back to live status if it
 // receives a later head than one we have already seen
 func (n *node) outOfSyncLoop(stuckAtBlockNumber int64) {
@@ -89,6 +89,8 @@ func TestUnit_NodeLifecycle_aliveLoop(t *testing.T) {
 					return `"test client version"`, ""
 				}
 				return "this will error", ""
+			case "eth_syncing":
+				return "false", ""
 			default:
 				t.Errorf("unexpected RPC method: %s", method)
 			}
@@ -192,6 +194,8 @@ func TestUnit_NodeLifecycle_aliveLoop(t *testing.T) {
 					default:
 					}
 					return `"test client version 2"`, ""
+				case "eth_syncing":
+					return "false", ""
 				default:
 					t.Errorf("unexpected RPC method: %s", method)
 				}
@@ -235,6 +239,8 @@ func TestUnit_NodeLifecycle_aliveLoop(t *testing.T) {
 					return `"0x00"`, makeHeadResult(0)
 				case "web3_clientVersion":
 					return `"test client version 2"`, ""
+				case "eth_syncing":
+					return "false", ""
 				default:
 					t.Errorf("unexpected RPC method: %s", method)
 				}
@@ -260,6 +266,46 @@ func TestUnit_NodeLifecycle_aliveLoop(t *testing.T) {
 		testutils.WaitWithTimeout(t, chSubbed, "timed out waiting for initial subscription for OutOfSync")
 	})
 
+	t.Run("when RPC reports that it is syncing, transitions to out of sync", func(t *testing.T) {
+		cfg := TestNodeConfig{PollInterval: testutils.TestInterval}
+		chSubbed := make(chan struct{}, 1)
+		s := testutils.NewWSServer(t, testutils.FixtureChainID,
+			func(method string, params gjson.Result) (respResult string, notifyResult string) {
+				switch method {
+				case "eth_subscribe":
+					select {
+					case chSubbed <- struct{}{}:
+					default:
+					}
+					return `"0x00"`, ""
+				case "web3_clientVersion":
+					return `"test client version 2"`, ""
+				case "eth_syncing":
+					return `{"startingBlock":"0x0","currentBlock":"0x10","highestBlock":"0x100"}`, ""
+				default:
+					t.Errorf("unexpected RPC method: %s", method)
+				}
+				return "", ""
+			})
+
+		iN := NewNode(cfg, logger.TestLogger(t), *s.WSURL(), nil, "test node", 42, testutils.FixtureChainID)
+		n := iN.(*node)
+		n.nLiveNodes = func() int { return 2 }
+
+		dial(t, n)
+		defer n.Close()
+
+		n.wg.Add(1)
+		go n.aliveLoop()
+
+		testutils.AssertEventually(t, func() bool {
+			return n.State() == NodeStateOutOfSync
+		})
+
+		// Otherwise, there may be data race on dial() vs Close() (accessing ws.rpc)
+		testutils.WaitWithTimeout(t, chSubbed, "timed out waiting for subscription for OutOfSync")
+	})
+
 	t.Run("when no new heads received for threshold but we are the last live node, forcibly stays alive", func(t *testing.T) {
 		lggr, observedLogs := logger.TestLoggerObserved(t, zap.ErrorLevel)
 		pollDisabledCfg := TestNodeConfig{NoNewHeadsThreshold: testutils.TestInterval}
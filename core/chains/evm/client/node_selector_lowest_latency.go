@@ -0,0 +1,38 @@
+package client
+
+import (
+	"time"
+)
+
+type lowestLatencyNodeSelector struct {
+	nodes []Node
+}
+
+// NewLowestLatencyNodeSelector returns a selector that picks the alive node
+// with the lowest latency measured by the last successful poll. Nodes without
+// a measured latency are only picked if no other node is alive.
+func NewLowestLatencyNodeSelector(nodes []Node) NodeSelector {
+	return &lowestLatencyNodeSelector{
+		nodes: nodes,
+	}
+}
+
+func (s *lowestLatencyNodeSelector) Select() Node {
+	var node Node
+	var lowestLatency time.Duration
+	for _, n := range s.nodes {
+		state, latency := n.StateAndLatency()
+		if state != NodeStateAlive {
+			continue
+		}
+		if node == nil || (latency > 0 && (lowestLatency == 0 || latency < lowestLatency)) {
+			node = n
+			lowestLatency = latency
+		}
+	}
+	return node
+}
+
+func (s *lowestLatencyNodeSelector) Name() string {
+	return NodeSelectionMode_LowestLatency
+}
@@ -0,0 +1,67 @@
+package client_test
+
+import (
+	"testing"
+	"time"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLowestLatencyNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	var nodes []evmclient.Node
+
+	for i := 0; i < 4; i++ {
+		node := evmmocks.NewNode(t)
+		switch i {
+		case 0:
+			// latency unknown
+			node.On("StateAndLatency").Return(evmclient.NodeStateAlive, time.Duration(0))
+		case 1:
+			node.On("StateAndLatency").Return(evmclient.NodeStateAlive, 300*time.Millisecond)
+		case 2:
+			// lowest latency but out of sync
+			node.On("StateAndLatency").Return(evmclient.NodeStateOutOfSync, 50*time.Millisecond)
+		case 3:
+			node.On("StateAndLatency").Return(evmclient.NodeStateAlive, 100*time.Millisecond)
+		}
+		nodes = append(nodes, node)
+	}
+
+	selector := evmclient.NewLowestLatencyNodeSelector(nodes)
+	assert.Equal(t, nodes[3], selector.Select())
+}
+
+func TestLowestLatencyNodeSelector_Unknown(t *testing.T) {
+	t.Parallel()
+
+	var nodes []evmclient.Node
+
+	for i := 0; i < 2; i++ {
+		node := evmmocks.NewNode(t)
+		node.On("StateAndLatency").Return(evmclient.NodeStateAlive, time.Duration(0))
+		nodes = append(nodes, node)
+	}
+
+	selector := evmclient.NewLowestLatencyNodeSelector(nodes)
+	assert.Equal(t, nodes[0], selector.Select())
+}
+
+func TestLowestLatencyNodeSelector_None(t *testing.T) {
+	t.Parallel()
+
+	var nodes []evmclient.Node
+
+	for i := 0; i < 2; i++ {
+		node := evmmocks.NewNode(t)
+		node.On("StateAndLatency").Return(evmclient.NodeStateUnreachable, 100*time.Millisecond)
+		nodes = append(nodes, node)
+	}
+
+	selector := evmclient.NewLowestLatencyNodeSelector(nodes)
+	assert.Nil(t, selector.Select())
+}
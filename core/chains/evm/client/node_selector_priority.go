@@ -0,0 +1,28 @@
+package client
+
+type priorityNodeSelector struct {
+	nodes []Node
+}
+
+// NewPriorityNodeSelector returns a selector that always picks the first
+// alive node, in the order the nodes were configured. The first node is the
+// primary, and the others are fallbacks which are only used while every node
+// before them is unavailable.
+func NewPriorityNodeSelector(nodes []Node) NodeSelector {
+	return &priorityNodeSelector{
+		nodes: nodes,
+	}
+}
+
+func (s *priorityNodeSelector) Select() Node {
+	for _, n := range s.nodes {
+		if n.State() == NodeStateAlive {
+			return n
+		}
+	}
+	return nil
+}
+
+func (s *priorityNodeSelector) Name() string {
+	return NodeSelectionMode_Priority
+}
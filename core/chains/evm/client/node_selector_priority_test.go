@@ -0,0 +1,65 @@
+package client_test
+
+import (
+	"testing"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriorityNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	var nodes []evmclient.Node
+
+	for i := 0; i < 3; i++ {
+		node := evmmocks.NewNode(t)
+		if i == 0 {
+			// primary is out of sync
+			node.On("State").Return(evmclient.NodeStateOutOfSync)
+		} else if i == 1 {
+			// first fallback is alive
+			node.On("State").Return(evmclient.NodeStateAlive)
+		}
+		// second fallback is never checked
+		nodes = append(nodes, node)
+	}
+
+	selector := evmclient.NewPriorityNodeSelector(nodes)
+	assert.Equal(t, nodes[1], selector.Select())
+	assert.Equal(t, nodes[1], selector.Select())
+}
+
+func TestPriorityNodeSelector_Primary(t *testing.T) {
+	t.Parallel()
+
+	var nodes []evmclient.Node
+
+	for i := 0; i < 3; i++ {
+		node := evmmocks.NewNode(t)
+		if i == 0 {
+			node.On("State").Return(evmclient.NodeStateAlive)
+		}
+		nodes = append(nodes, node)
+	}
+
+	selector := evmclient.NewPriorityNodeSelector(nodes)
+	assert.Equal(t, nodes[0], selector.Select())
+}
+
+func TestPriorityNodeSelector_None(t *testing.T) {
+	t.Parallel()
+
+	var nodes []evmclient.Node
+
+	for i := 0; i < 3; i++ {
+		node := evmmocks.NewNode(t)
+		node.On("State").Return(evmclient.NodeStateUnreachable)
+		nodes = append(nodes, node)
+	}
+
+	selector := evmclient.NewPriorityNodeSelector(nodes)
+	assert.Nil(t, selector.Select())
+}
@@ -29,8 +29,15 @@ var (
 )
 
 const (
-	NodeSelectionMode_HighestHead = "HighestHead"
-	NodeSelectionMode_RoundRobin  = "RoundRobin"
+	NodeSelectionMode_HighestHead   = "HighestHead"
+	NodeSelectionMode_RoundRobin    = "RoundRobin"
+	NodeSelectionMode_Priority      = "Priority"
+	NodeSelectionMode_LowestLatency = "LowestLatency"
+)
+
+const (
+	NodeSendStrategy_Broadcast = "Broadcast"
+	NodeSendStrategy_Single    = "Single"
 )
 
 // NodeSelector represents a strategy to select the next node from the pool.
@@ -45,6 +52,7 @@ type NodeSelector interface {
 // PoolConfig represents settings for the Pool
 type PoolConfig interface {
 	NodeSelectionMode() string
+	NodeSendStrategy() string
 	NodeNoNewHeadsThreshold() time.Duration
 }
 
@@ -74,11 +82,21 @@ func NewPool(logger logger.Logger, cfg PoolConfig, nodes []Node, sendonlys []Sen
 			return NewHighestHeadNodeSelector(nodes)
 		case NodeSelectionMode_RoundRobin:
 			return NewRoundRobinSelector(nodes)
+		case NodeSelectionMode_Priority:
+			return NewPriorityNodeSelector(nodes)
+		case NodeSelectionMode_LowestLatency:
+			return NewLowestLatencyNodeSelector(nodes)
 		default:
 			panic(fmt.Sprintf("unsupported NodeSelectionMode: %s", cfg.NodeSelectionMode()))
 		}
 	}()
 
+	switch cfg.NodeSendStrategy() {
+	case NodeSendStrategy_Broadcast, NodeSendStrategy_Single:
+	default:
+		panic(fmt.Sprintf("unsupported NodeSendStrategy: %s", cfg.NodeSendStrategy()))
+	}
+
 	lggr := logger.Named("Pool").With("evmChainID", chainID.String())
 
 	if cfg.NodeNoNewHeadsThreshold() == 0 && cfg.NodeSelectionMode() == NodeSelectionMode_HighestHead {
@@ -98,7 +116,7 @@ func NewPool(logger logger.Logger, cfg PoolConfig, nodes []Node, sendonlys []Sen
 		sync.WaitGroup{},
 	}
 
-	p.logger.Debugf("The pool is configured to use NodeSelectionMode: %s", cfg.NodeSelectionMode())
+	p.logger.Debugf("The pool is configured to use NodeSelectionMode: %s and NodeSendStrategy: %s", cfg.NodeSelectionMode(), cfg.NodeSendStrategy())
 
 	return p
 }
@@ -295,6 +313,9 @@ func (p *Pool) BatchCallContextAll(ctx context.Context, b []rpc.BatchElem) error
 // Wrapped Geth client methods
 func (p *Pool) SendTransaction(ctx context.Context, tx *types.Transaction) error {
 	main := p.selectNode()
+	if p.config.NodeSendStrategy() == NodeSendStrategy_Single {
+		return main.SendTransaction(ctx, tx)
+	}
 	var all []SendOnlyNode
 	for _, n := range p.nodes {
 		all = append(all, n)
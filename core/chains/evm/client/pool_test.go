@@ -26,6 +26,7 @@ import (
 
 type poolConfig struct {
 	selectionMode       string
+	sendStrategy        string
 	noNewHeadsThreshold time.Duration
 }
 
@@ -33,12 +34,17 @@ func (c poolConfig) NodeSelectionMode() string {
 	return c.selectionMode
 }
 
+func (c poolConfig) NodeSendStrategy() string {
+	return c.sendStrategy
+}
+
 func (c poolConfig) NodeNoNewHeadsThreshold() time.Duration {
 	return c.noNewHeadsThreshold
 }
 
 var defaultConfig evmclient.PoolConfig = &poolConfig{
 	selectionMode:       evmclient.NodeSelectionMode_RoundRobin,
+	sendStrategy:        evmclient.NodeSendStrategy_Broadcast,
 	noNewHeadsThreshold: 0,
 }
 
@@ -0,0 +1,168 @@
+package client
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	promEVMPoolRPCMethodCalls = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_pool_rpc_method_calls_total",
+		Help: "The total number of JSON-RPC calls of the given method to the given RPC endpoint",
+	}, []string{"evmChainID", "nodeName", "rpcHost", "method", "success"})
+	promEVMPoolRPCMethodRequestBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_pool_rpc_method_request_bytes_total",
+		Help: "The approximate total size in bytes of the JSON-RPC params sent for the given method to the given RPC endpoint",
+	}, []string{"evmChainID", "nodeName", "rpcHost", "method"})
+	promEVMPoolRPCMethodResponseBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_pool_rpc_method_response_bytes_total",
+		Help: "The approximate total size in bytes of the JSON-RPC results received for the given method from the given RPC endpoint",
+	}, []string{"evmChainID", "nodeName", "rpcHost", "method"})
+	promEVMPoolRPCMethodLatency = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_pool_rpc_method_latency_seconds_total",
+		Help: "The total time in seconds spent waiting on JSON-RPC calls of the given method to the given RPC endpoint",
+	}, []string{"evmChainID", "nodeName", "rpcHost", "method"})
+)
+
+// RPCStat is the usage of one JSON-RPC method of one RPC endpoint since the
+// node started. Sizes are approximated by the JSON encoding of the params and
+// results.
+type RPCStat struct {
+	EVMChainID    string
+	NodeName      string
+	RPCHost       string
+	SendOnly      bool
+	Method        string
+	Calls         uint64
+	Errors        uint64
+	RequestBytes  uint64
+	ResponseBytes uint64
+	TotalLatency  time.Duration
+	MaxLatency    time.Duration
+}
+
+// AvgLatency is the average duration of the calls
+func (s RPCStat) AvgLatency() time.Duration {
+	if s.Calls == 0 {
+		return 0
+	}
+	return s.TotalLatency / time.Duration(s.Calls)
+}
+
+type rpcStatKey struct {
+	chainID, nodeName, rpcHost, method string
+	sendOnly                           bool
+}
+
+var (
+	rpcStatsMu sync.RWMutex
+	rpcStats   = make(map[rpcStatKey]*RPCStat)
+)
+
+// RPCStats returns the usage of every JSON-RPC method called on every RPC
+// endpoint, ordered by chain, node and method
+func RPCStats() []RPCStat {
+	rpcStatsMu.RLock()
+	stats := make([]RPCStat, 0, len(rpcStats))
+	for _, s := range rpcStats {
+		stats = append(stats, *s)
+	}
+	rpcStatsMu.RUnlock()
+	sort.Slice(stats, func(i, j int) bool {
+		a, b := stats[i], stats[j]
+		if a.EVMChainID != b.EVMChainID {
+			return a.EVMChainID < b.EVMChainID
+		}
+		if a.NodeName != b.NodeName {
+			return a.NodeName < b.NodeName
+		}
+		if a.SendOnly != b.SendOnly {
+			return !a.SendOnly
+		}
+		return a.Method < b.Method
+	})
+	return stats
+}
+
+// rpcCall is a JSON-RPC call to be recorded in the stats
+type rpcCall struct {
+	method   string
+	params   interface{}
+	result   interface{}
+	err      error
+	duration time.Duration
+}
+
+// recordRPCCalls adds calls made to the endpoint at rpcHost to the stats
+func recordRPCCalls(chainID, nodeName, rpcHost string, sendOnly bool, calls ...rpcCall) {
+	for _, c := range calls {
+		reqBytes, respBytes := jsonSize(c.params), 0
+		if c.err == nil {
+			respBytes = jsonSize(c.result)
+		}
+
+		promEVMPoolRPCMethodCalls.WithLabelValues(chainID, nodeName, rpcHost, c.method, strconv.FormatBool(c.err == nil)).Inc()
+		promEVMPoolRPCMethodRequestBytes.WithLabelValues(chainID, nodeName, rpcHost, c.method).Add(float64(reqBytes))
+		promEVMPoolRPCMethodResponseBytes.WithLabelValues(chainID, nodeName, rpcHost, c.method).Add(float64(respBytes))
+		promEVMPoolRPCMethodLatency.WithLabelValues(chainID, nodeName, rpcHost, c.method).Add(c.duration.Seconds())
+
+		key := rpcStatKey{chainID, nodeName, rpcHost, c.method, sendOnly}
+		rpcStatsMu.Lock()
+		s, ok := rpcStats[key]
+		if !ok {
+			s = &RPCStat{EVMChainID: chainID, NodeName: nodeName, RPCHost: rpcHost, SendOnly: sendOnly, Method: c.method}
+			rpcStats[key] = s
+		}
+		s.Calls++
+		if c.err != nil {
+			s.Errors++
+		}
+		s.RequestBytes += uint64(reqBytes)
+		s.ResponseBytes += uint64(respBytes)
+		s.TotalLatency += c.duration
+		if c.duration > s.MaxLatency {
+			s.MaxLatency = c.duration
+		}
+		rpcStatsMu.Unlock()
+	}
+}
+
+// batchRPCCalls returns the calls of a batch, which all share its duration
+func batchRPCCalls(b []rpc.BatchElem, err error, duration time.Duration) []rpcCall {
+	calls := make([]rpcCall, len(b))
+	for i, elem := range b {
+		callErr := elem.Error
+		if err != nil {
+			callErr = err
+		}
+		calls[i] = rpcCall{elem.Method, elem.Args, elem.Result, callErr, duration}
+	}
+	return calls
+}
+
+// jsonSize approximates the size of v on the wire
+func jsonSize(v interface{}) int {
+	switch t := v.(type) {
+	case nil:
+		return 0
+	case *types.Block:
+		// blocks have no JSON encoding, the RLP size is close enough
+		if t == nil {
+			return 0
+		}
+		return int(t.Size())
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return 0
+	}
+	return len(b)
+}
@@ -0,0 +1,60 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func TestRPCStats(t *testing.T) {
+	t.Parallel()
+
+	chainID := testutils.NewRandomEVMChainID()
+	url := testutils.MustParseURL(t, "http://rpc.example.com")
+	s := evmclient.NewSendOnlyNode(logger.TestLogger(t), *url, "sendonly", chainID).(evmclient.TestableSendOnlyNode)
+
+	mockBatchSender := mocks.NewBatchSender(t)
+	mockBatchSender.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Once()
+	mockBatchSender.On("BatchCallContext", mock.Anything, mock.Anything).Return(errors.New("boom")).Once()
+	s.SetEthClient(mockBatchSender, nil)
+
+	var balance string
+	require.NoError(t, s.BatchCallContext(testutils.Context(t), []rpc.BatchElem{
+		{Method: "eth_getBalance", Args: []interface{}{"0x01", "latest"}, Result: &balance},
+		{Method: "eth_chainId", Result: &balance},
+	}))
+	require.Error(t, s.BatchCallContext(testutils.Context(t), []rpc.BatchElem{
+		{Method: "eth_getBalance", Args: []interface{}{"0x02", "latest"}, Result: &balance},
+	}))
+
+	var stats []evmclient.RPCStat
+	for _, stat := range evmclient.RPCStats() {
+		if stat.EVMChainID == chainID.String() {
+			stats = append(stats, stat)
+		}
+	}
+	require.Len(t, stats, 2)
+
+	assert.Equal(t, "eth_chainId", stats[0].Method)
+	assert.Equal(t, uint64(1), stats[0].Calls)
+	assert.Equal(t, uint64(0), stats[0].Errors)
+
+	assert.Equal(t, "eth_getBalance", stats[1].Method)
+	assert.Equal(t, "sendonly", stats[1].NodeName)
+	assert.Equal(t, "rpc.example.com", stats[1].RPCHost)
+	assert.True(t, stats[1].SendOnly)
+	assert.Equal(t, uint64(2), stats[1].Calls)
+	assert.Equal(t, uint64(1), stats[1].Errors)
+	assert.Equal(t, uint64(2*len(`["0x01","latest"]`)), stats[1].RequestBytes)
+	assert.Equal(t, uint64(len(`""`)), stats[1].ResponseBytes)
+	assert.GreaterOrEqual(t, stats[1].MaxLatency, stats[1].AvgLatency())
+}
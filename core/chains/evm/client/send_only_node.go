@@ -141,7 +141,9 @@ func (s *sendOnlyNode) logTiming(lggr logger.Logger, duration time.Duration, err
 
 func (s *sendOnlyNode) SendTransaction(parentCtx context.Context, tx *types.Transaction) (err error) {
 	defer func(start time.Time) {
-		s.logTiming(s.log, time.Since(start), err, "SendTransaction")
+		duration := time.Since(start)
+		s.logTiming(s.log, duration, err, "SendTransaction")
+		recordRPCCalls(s.chainID.String(), s.name, s.uri.Host, true, rpcCall{"eth_sendRawTransaction", []interface{}{tx}, nil, err, duration})
 	}(time.Now())
 
 	ctx, cancel := s.makeQueryCtx(parentCtx)
@@ -151,7 +153,9 @@ func (s *sendOnlyNode) SendTransaction(parentCtx context.Context, tx *types.Tran
 
 func (s *sendOnlyNode) BatchCallContext(parentCtx context.Context, b []rpc.BatchElem) (err error) {
 	defer func(start time.Time) {
-		s.logTiming(s.log.With("nBatchElems", len(b)), time.Since(start), err, "BatchCallContext")
+		duration := time.Since(start)
+		s.logTiming(s.log.With("nBatchElems", len(b)), duration, err, "BatchCallContext")
+		recordRPCCalls(s.chainID.String(), s.name, s.uri.Host, true, batchRPCCalls(b, err, duration)...)
 	}(time.Now())
 
 	ctx, cancel := s.makeQueryCtx(parentCtx)
@@ -22,6 +22,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/config/envvar"
 	"github.com/smartcontractkit/chainlink/core/config/parse"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -85,7 +86,9 @@ type ChainScopedOnlyConfig interface {
 	OCRObservationGracePeriod() time.Duration
 	OCRDatabaseTimeout() time.Duration
 
+	SetEthTxReaperThreshold(value time.Duration) error
 	SetEvmGasPriceDefault(value *big.Int) error
+	SetEvmMaxGasPriceWei(value *big.Int) error
 }
 
 //go:generate mockery --name ChainScopedConfig --output ./mocks/ --case=underscore
@@ -194,6 +197,11 @@ func (c *chainScopedConfig) validate() (err error) {
 	if c.EvmFinalityDepth() < 1 {
 		err = multierr.Combine(err, errors.New("ETH_FINALITY_DEPTH must be greater than or equal to 1"))
 	}
+	switch c.EvmFinalityTag() {
+	case "", "safe", "finalized":
+	default:
+		err = multierr.Combine(err, errors.Errorf("ETH_FINALITY_TAG must be safe, finalized or empty, got: %s", c.EvmFinalityTag()))
+	}
 	if c.MinIncomingConfirmations() < 1 {
 		err = multierr.Combine(err, errors.New("MIN_INCOMING_CONFIRMATIONS must be greater than or equal to 1"))
 	}
@@ -535,6 +543,31 @@ func (c *chainScopedConfig) SetEvmGasPriceDefault(value *big.Int) error {
 	return c.orm.storeString("EvmGasPriceDefault", value.String())
 }
 
+// SetEvmMaxGasPriceWei saves a runtime value for the maximum gas price for transactions
+// nil or negative value clears
+func (c *chainScopedConfig) SetEvmMaxGasPriceWei(value *big.Int) error {
+	if _, ok := c.GeneralConfig.GlobalEvmMaxGasPriceWei(); ok {
+		return errors.New("cannot set maximum gas price, it is set by the ETH_MAX_GAS_PRICE_WEI environment variable")
+	}
+	if value == nil || value.Cmp(big.NewInt(0)) < 0 {
+		c.persistMu.Lock()
+		defer c.persistMu.Unlock()
+		c.persistedCfg.EvmMaxGasPriceWei = nil
+		return c.orm.clear("EvmMaxGasPriceWei")
+	}
+	min := c.EvmMinGasPriceWei()
+	if value.Cmp(min) < 0 {
+		return errors.Errorf("cannot set maximum gas price to %s, it is below the minimum allowed value of %s", value.String(), min.String())
+	}
+	if def := c.EvmGasPriceDefault(); value.Cmp(def) < 0 {
+		return errors.Errorf("cannot set maximum gas price to %s, it is below the default gas price of %s", value.String(), def.String())
+	}
+	c.persistMu.Lock()
+	defer c.persistMu.Unlock()
+	c.persistedCfg.EvmMaxGasPriceWei = utils.NewBig(value)
+	return c.orm.storeString("EvmMaxGasPriceWei", value.String())
+}
+
 // EvmFinalityDepth is the number of blocks after which an ethereum transaction is considered "final"
 // BlocksConsideredFinal determines how deeply we look back to ensure that transactions are confirmed onto the longest chain
 // There is not a large performance penalty to setting this relatively high (on the order of hundreds)
@@ -1052,6 +1085,20 @@ func (c *chainScopedConfig) EthTxReaperThreshold() time.Duration {
 	return c.defaultSet.ethTxReaperThreshold
 }
 
+// SetEthTxReaperThreshold saves a runtime value for EthTxReaperThreshold
+func (c *chainScopedConfig) SetEthTxReaperThreshold(value time.Duration) error {
+	if _, ok := c.GeneralConfig.GlobalEthTxReaperThreshold(); ok {
+		return errors.New("cannot set the eth_tx reaper threshold, it is set by the ETH_TX_REAPER_THRESHOLD environment variable")
+	}
+	if value < 0 {
+		return errors.Errorf("cannot set the eth_tx reaper threshold to %s, it must not be negative", value)
+	}
+	c.persistMu.Lock()
+	defer c.persistMu.Unlock()
+	c.persistedCfg.EthTxReaperThreshold = models.MustNewDuration(value)
+	return c.orm.storeString("EthTxReaperThreshold", value.String())
+}
+
 // EvmLogPollInterval how fast we poll for new logs.
 func (c *chainScopedConfig) EvmLogPollInterval() time.Duration {
 	val, ok := c.GeneralConfig.GlobalEvmLogPollInterval()
@@ -64,6 +64,40 @@ func TestChainScopedConfig(t *testing.T) {
 		})
 	})
 
+	t.Run("EvmMaxGasPriceWei", func(t *testing.T) {
+		t.Run("is not allowed to set below EvmMinGasPriceWei or EvmGasPriceDefault", func(t *testing.T) {
+			err := cfg.SetEvmMaxGasPriceWei(big.NewInt(1))
+			assert.EqualError(t, err, "cannot set maximum gas price to 1, it is below the minimum allowed value of 1000000000")
+
+			err = cfg.SetEvmMaxGasPriceWei(big.NewInt(41000000000))
+			assert.EqualError(t, err, "cannot set maximum gas price to 41000000000, it is below the default gas price of 42000000000")
+
+			assert.Equal(t, big.NewInt(100000000000000), cfg.EvmMaxGasPriceWei())
+		})
+		t.Run("sets and clears the maximum gas price", func(t *testing.T) {
+			require.NoError(t, cfg.SetEvmMaxGasPriceWei(big.NewInt(500000000000)))
+			assert.Equal(t, big.NewInt(500000000000), cfg.EvmMaxGasPriceWei())
+			got, ok := orm.LoadString(*utils.NewBig(chainID), "EvmMaxGasPriceWei")
+			if assert.True(t, ok) {
+				assert.Equal(t, "500000000000", got)
+			}
+
+			require.NoError(t, cfg.SetEvmMaxGasPriceWei(nil))
+			assert.Equal(t, big.NewInt(100000000000000), cfg.EvmMaxGasPriceWei())
+		})
+	})
+
+	t.Run("EthTxReaperThreshold", func(t *testing.T) {
+		require.NoError(t, cfg.SetEthTxReaperThreshold(2*time.Hour))
+		assert.Equal(t, 2*time.Hour, cfg.EthTxReaperThreshold())
+		got, ok := orm.LoadString(*utils.NewBig(chainID), "EthTxReaperThreshold")
+		if assert.True(t, ok) {
+			assert.Equal(t, "2h0m0s", got)
+		}
+
+		assert.Error(t, cfg.SetEthTxReaperThreshold(-time.Second))
+	})
+
 	t.Run("KeySpecificMaxGasPriceWei", func(t *testing.T) {
 		addr := testutils.NewAddress()
 		randomOtherAddr := testutils.NewAddress()
@@ -75,6 +75,122 @@ func (_m *ChainScopedConfig) AdvisoryLockID() int64 {
 	return r0
 }
 
+// AlertsCheckInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) AlertsCheckInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// AlertsJobErrorsThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) AlertsJobErrorsThreshold() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// AlertsJobErrorsWindow provides a mock function with given fields:
+func (_m *ChainScopedConfig) AlertsJobErrorsWindow() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// AlertsKeyBalanceThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) AlertsKeyBalanceThreshold() *assets.Eth {
+	ret := _m.Called()
+
+	var r0 *assets.Eth
+	if rf, ok := ret.Get(0).(func() *assets.Eth); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Eth)
+		}
+	}
+
+	return r0
+}
+
+// AlertsPagerDutyRoutingKey provides a mock function with given fields:
+func (_m *ChainScopedConfig) AlertsPagerDutyRoutingKey() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// AlertsSlackWebhookURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) AlertsSlackWebhookURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// AlertsTxStuckThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) AlertsTxStuckThreshold() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// AllowHeaders provides a mock function with given fields:
+func (_m *ChainScopedConfig) AllowHeaders() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // AllowOrigins provides a mock function with given fields:
 func (_m *ChainScopedConfig) AllowOrigins() string {
 	ret := _m.Called()
@@ -105,6 +221,22 @@ func (_m *ChainScopedConfig) AppID() uuid.UUID {
 	return r0
 }
 
+// AuditLogForwardURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) AuditLogForwardURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
 // AuthenticatedRateLimit provides a mock function with given fields:
 func (_m *ChainScopedConfig) AuthenticatedRateLimit() int64 {
 	ret := _m.Called()
@@ -427,6 +559,48 @@ func (_m *ChainScopedConfig) BlockHistoryEstimatorTransactionPercentile() uint16
 	return r0
 }
 
+// BridgeHealthCheckInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) BridgeHealthCheckInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// BridgeHealthCheckPath provides a mock function with given fields:
+func (_m *ChainScopedConfig) BridgeHealthCheckPath() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// BridgeHealthWindow provides a mock function with given fields:
+func (_m *ChainScopedConfig) BridgeHealthWindow() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // BridgeResponseURL provides a mock function with given fields:
 func (_m *ChainScopedConfig) BridgeResponseURL() *url.URL {
 	ret := _m.Called()
@@ -492,6 +666,20 @@ func (_m *ChainScopedConfig) Configure(_a0 types.ChainCfg) {
 	_m.Called(_a0)
 }
 
+// DatabaseAllowSchemaDrift provides a mock function with given fields:
+func (_m *ChainScopedConfig) DatabaseAllowSchemaDrift() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // DatabaseBackupDir provides a mock function with given fields:
 func (_m *ChainScopedConfig) DatabaseBackupDir() string {
 	ret := _m.Called()
@@ -720,6 +908,20 @@ func (_m *ChainScopedConfig) EVMRPCEnabled() bool {
 	return r0
 }
 
+// EnforceWebAuthn provides a mock function with given fields:
+func (_m *ChainScopedConfig) EnforceWebAuthn() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // EthTxReaperInterval provides a mock function with given fields:
 func (_m *ChainScopedConfig) EthTxReaperInterval() time.Duration {
 	ret := _m.Called()
@@ -850,6 +1052,20 @@ func (_m *ChainScopedConfig) EvmFinalityDepth() uint32 {
 	return r0
 }
 
+// EvmFinalityTag provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmFinalityTag() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // EvmGasBumpPercent provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmGasBumpPercent() uint16 {
 	ret := _m.Called()
@@ -1222,6 +1438,20 @@ func (_m *ChainScopedConfig) EvmMaxQueuedTransactions() uint64 {
 	return r0
 }
 
+// EvmMaxQueuedTransactionsPolicy provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmMaxQueuedTransactionsPolicy() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // EvmMinGasPriceWei provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmMinGasPriceWei() *big.Int {
 	ret := _m.Called()
@@ -2726,6 +2956,38 @@ func (_m *ChainScopedConfig) JSONConsole() bool {
 	return r0
 }
 
+// JobPipelineIPFSGatewayURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobPipelineIPFSGatewayURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// JobPipelineIPFSNodeURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobPipelineIPFSNodeURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
 // JobPipelineMaxRunDuration provides a mock function with given fields:
 func (_m *ChainScopedConfig) JobPipelineMaxRunDuration() time.Duration {
 	ret := _m.Called()
@@ -2740,6 +3002,116 @@ func (_m *ChainScopedConfig) JobPipelineMaxRunDuration() time.Duration {
 	return r0
 }
 
+// JobPipelineMetricsCustomLabels provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobPipelineMetricsCustomLabels() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// JobPipelineMetricsJobIDLabel provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobPipelineMetricsJobIDLabel() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// JobPipelinePublishURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobPipelinePublishURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// JobPipelineQueueTriggerTopics provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobPipelineQueueTriggerTopics() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// JobPipelineQueueTriggerURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobPipelineQueueTriggerURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// JobPipelineReaperExportEndpoint provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobPipelineReaperExportEndpoint() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// JobPipelineReaperExportURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobPipelineReaperExportURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
 // JobPipelineReaperInterval provides a mock function with given fields:
 func (_m *ChainScopedConfig) JobPipelineReaperInterval() time.Duration {
 	ret := _m.Called()
@@ -2782,6 +3154,22 @@ func (_m *ChainScopedConfig) JobPipelineResultWriteQueueDepth() uint64 {
 	return r0
 }
 
+// JobPipelineSpecVariables provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobPipelineSpecVariables() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
 // KeeperBaseFeeBufferPercent provides a mock function with given fields:
 func (_m *ChainScopedConfig) KeeperBaseFeeBufferPercent() uint32 {
 	ret := _m.Called()
@@ -3050,6 +3438,20 @@ func (_m *ChainScopedConfig) LinkContractAddress() string {
 	return r0
 }
 
+// LogBroadcasterPolling provides a mock function with given fields:
+func (_m *ChainScopedConfig) LogBroadcasterPolling() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // LogConfiguration provides a mock function with given fields: log
 func (_m *ChainScopedConfig) LogConfiguration(log coreconfig.LogFn) {
 	_m.Called(log)
@@ -3197,6 +3599,20 @@ func (_m *ChainScopedConfig) MinimumContractPayment() *assets.Link {
 	return r0
 }
 
+// NodeCacheReads provides a mock function with given fields:
+func (_m *ChainScopedConfig) NodeCacheReads() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // NodeNoNewHeadsThreshold provides a mock function with given fields:
 func (_m *ChainScopedConfig) NodeNoNewHeadsThreshold() time.Duration {
 	ret := _m.Called()
@@ -3253,6 +3669,20 @@ func (_m *ChainScopedConfig) NodeSelectionMode() string {
 	return r0
 }
 
+// NodeSendStrategy provides a mock function with given fields:
+func (_m *ChainScopedConfig) NodeSendStrategy() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // OCR2BlockchainTimeout provides a mock function with given fields:
 func (_m *ChainScopedConfig) OCR2BlockchainTimeout() time.Duration {
 	ret := _m.Called()
@@ -3977,6 +4407,34 @@ func (_m *ChainScopedConfig) P2PV2Bootstrappers() []commontypes.BootstrapperLoca
 	return r0
 }
 
+// P2PV2BootstrappersDNS provides a mock function with given fields:
+func (_m *ChainScopedConfig) P2PV2BootstrappersDNS() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// P2PV2BootstrappersDNSRefreshInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) P2PV2BootstrappersDNSRefreshInterval() models.Duration {
+	ret := _m.Called()
+
+	var r0 models.Duration
+	if rf, ok := ret.Get(0).(func() models.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(models.Duration)
+	}
+
+	return r0
+}
+
 // P2PV2BootstrappersRaw provides a mock function with given fields:
 func (_m *ChainScopedConfig) P2PV2BootstrappersRaw() []string {
 	ret := _m.Called()
@@ -3993,6 +4451,20 @@ func (_m *ChainScopedConfig) P2PV2BootstrappersRaw() []string {
 	return r0
 }
 
+// P2PV2CheckBootstrappers provides a mock function with given fields:
+func (_m *ChainScopedConfig) P2PV2CheckBootstrappers() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // P2PV2DeltaDial provides a mock function with given fields:
 func (_m *ChainScopedConfig) P2PV2DeltaDial() models.Duration {
 	ret := _m.Called()
@@ -4135,6 +4607,20 @@ func (_m *ChainScopedConfig) RPOrigin() string {
 	return r0
 }
 
+// ReadinessStrict provides a mock function with given fields:
+func (_m *ChainScopedConfig) ReadinessStrict() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // ReaperExpiration provides a mock function with given fields:
 func (_m *ChainScopedConfig) ReaperExpiration() models.Duration {
 	ret := _m.Called()
@@ -4205,6 +4691,25 @@ func (_m *ChainScopedConfig) SessionTimeout() models.Duration {
 	return r0
 }
 
+// SetDefaultHTTPTimeout provides a mock function with given fields: timeout
+func (_m *ChainScopedConfig) SetDefaultHTTPTimeout(timeout models.Duration) {
+	_m.Called(timeout)
+}
+
+// SetEthTxReaperThreshold provides a mock function with given fields: value
+func (_m *ChainScopedConfig) SetEthTxReaperThreshold(value time.Duration) error {
+	ret := _m.Called(value)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(time.Duration) error); ok {
+		r0 = rf(value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetEvmGasPriceDefault provides a mock function with given fields: value
 func (_m *ChainScopedConfig) SetEvmGasPriceDefault(value *big.Int) error {
 	ret := _m.Called(value)
@@ -4219,6 +4724,25 @@ func (_m *ChainScopedConfig) SetEvmGasPriceDefault(value *big.Int) error {
 	return r0
 }
 
+// SetEvmMaxGasPriceWei provides a mock function with given fields: value
+func (_m *ChainScopedConfig) SetEvmMaxGasPriceWei(value *big.Int) error {
+	ret := _m.Called(value)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*big.Int) error); ok {
+		r0 = rf(value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetJobPipelineReaperThreshold provides a mock function with given fields: threshold
+func (_m *ChainScopedConfig) SetJobPipelineReaperThreshold(threshold time.Duration) {
+	_m.Called(threshold)
+}
+
 // SetLogLevel provides a mock function with given fields: lvl
 func (_m *ChainScopedConfig) SetLogLevel(lvl zapcore.Level) error {
 	ret := _m.Called(lvl)
@@ -4392,6 +4916,64 @@ func (_m *ChainScopedConfig) TLSRedirect() bool {
 	return r0
 }
 
+// TelemetryExporterCACertPath provides a mock function with given fields:
+func (_m *ChainScopedConfig) TelemetryExporterCACertPath() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// TelemetryExporterCertPath provides a mock function with given fields:
+func (_m *ChainScopedConfig) TelemetryExporterCertPath() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// TelemetryExporterKeyPath provides a mock function with given fields:
+func (_m *ChainScopedConfig) TelemetryExporterKeyPath() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// TelemetryExporterURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) TelemetryExporterURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
 // TelemetryIngressBufferSize provides a mock function with given fields:
 func (_m *ChainScopedConfig) TelemetryIngressBufferSize() uint {
 	ret := _m.Called()
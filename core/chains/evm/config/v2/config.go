@@ -19,26 +19,29 @@ import (
 )
 
 type Chain struct {
-	BlockBackfillDepth       *uint32
-	BlockBackfillSkip        *bool
-	ChainType                *string
-	FinalityDepth            *uint32
-	FlagsContractAddress     *ethkey.EIP55Address
-	LinkContractAddress      *ethkey.EIP55Address
-	LogBackfillBatchSize     *uint32
-	LogPollInterval          *models.Duration
-	MaxInFlightTransactions  *uint32
-	MaxQueuedTransactions    *uint32
-	MinIncomingConfirmations *uint32
-	MinimumContractPayment   *assets.Link
-	NonceAutoSync            *bool
-	NoNewHeadsThreshold      *models.Duration
-	OperatorFactoryAddress   *ethkey.EIP55Address
-	RPCDefaultBatchSize      *uint32
-	RPCBlockQueryDelay       *uint16
-	TxReaperInterval         *models.Duration
-	TxReaperThreshold        *models.Duration
-	TxResendAfterThreshold   *models.Duration
+	BlockBackfillDepth          *uint32
+	BlockBackfillSkip           *bool
+	ChainType                   *string
+	FinalityDepth               *uint32
+	FinalityTag                 *string
+	FlagsContractAddress        *ethkey.EIP55Address
+	LinkContractAddress         *ethkey.EIP55Address
+	LogBackfillBatchSize        *uint32
+	LogBroadcasterPolling       *bool
+	LogPollInterval             *models.Duration
+	MaxInFlightTransactions     *uint32
+	MaxQueuedTransactions       *uint32
+	MaxQueuedTransactionsPolicy *string
+	MinIncomingConfirmations    *uint32
+	MinimumContractPayment      *assets.Link
+	NonceAutoSync               *bool
+	NoNewHeadsThreshold         *models.Duration
+	OperatorFactoryAddress      *ethkey.EIP55Address
+	RPCDefaultBatchSize         *uint32
+	RPCBlockQueryDelay          *uint16
+	TxReaperInterval            *models.Duration
+	TxReaperThreshold           *models.Duration
+	TxResendAfterThreshold      *models.Duration
 
 	UseForwarders *bool
 
@@ -136,9 +139,11 @@ type HeadTracker struct {
 }
 
 type NodePool struct {
+	CacheReads           *bool
 	PollFailureThreshold *uint32
 	PollInterval         *models.Duration
 	SelectionMode        *string
+	SendStrategy         *string
 }
 
 type OCR struct {
@@ -94,6 +94,9 @@ func (c *Chain) SetFrom(f *Chain) {
 	if v := f.FinalityDepth; v != nil {
 		c.FinalityDepth = v
 	}
+	if v := f.FinalityTag; v != nil {
+		c.FinalityTag = v
+	}
 	if v := f.FlagsContractAddress; v != nil {
 		c.FlagsContractAddress = v
 	}
@@ -103,6 +106,9 @@ func (c *Chain) SetFrom(f *Chain) {
 	if v := f.LogBackfillBatchSize; v != nil {
 		c.LogBackfillBatchSize = v
 	}
+	if v := f.LogBroadcasterPolling; v != nil {
+		c.LogBroadcasterPolling = v
+	}
 	if v := f.LogPollInterval; v != nil {
 		c.LogPollInterval = v
 	}
@@ -112,6 +118,9 @@ func (c *Chain) SetFrom(f *Chain) {
 	if v := f.MaxQueuedTransactions; v != nil {
 		c.MaxQueuedTransactions = v
 	}
+	if v := f.MaxQueuedTransactionsPolicy; v != nil {
+		c.MaxQueuedTransactionsPolicy = v
+	}
 	if v := f.MinIncomingConfirmations; v != nil {
 		c.MinIncomingConfirmations = v
 	}
@@ -267,6 +276,9 @@ func (c *Chain) SetFrom(f *Chain) {
 		if c.NodePool == nil {
 			c.NodePool = &NodePool{}
 		}
+		if v := n.CacheReads; v != nil {
+			c.NodePool.CacheReads = v
+		}
 		if v := n.PollFailureThreshold; v != nil {
 			c.NodePool.PollFailureThreshold = v
 		}
@@ -276,6 +288,9 @@ func (c *Chain) SetFrom(f *Chain) {
 		if v := n.SelectionMode; v != nil {
 			c.NodePool.SelectionMode = v
 		}
+		if v := n.SendStrategy; v != nil {
+			c.NodePool.SendStrategy = v
+		}
 	}
 	if o := f.OCR; o != nil {
 		if c.OCR == nil {
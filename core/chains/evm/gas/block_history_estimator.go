@@ -62,6 +62,7 @@ var (
 )
 
 var _ Estimator = &BlockHistoryEstimator{}
+var _ PercentileEstimator = &BlockHistoryEstimator{}
 
 //go:generate mockery --name Config --output ./mocks/ --case=underscore
 type (
@@ -79,7 +80,11 @@ type (
 		gasPrice      *big.Int
 		tipCap        *big.Int
 		latestBaseFee *big.Int
-		mu            sync.RWMutex
+		// sorted prices of the usable transactions in the block history, used
+		// to estimate at percentiles other than the configured one
+		sortedGasPrices []*big.Int
+		sortedTipCaps   []*big.Int
+		mu              sync.RWMutex
 
 		logger logger.SugaredLogger
 	}
@@ -103,6 +108,8 @@ func NewBlockHistoryEstimator(lggr logger.Logger, ethClient evmclient.Client, cf
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
 		sync.RWMutex{},
 		logger.Sugared(lggr.Named("BlockHistoryEstimator")),
 	}
@@ -193,6 +200,28 @@ func (b *BlockHistoryEstimator) GetLegacyGas(_ []byte, gasLimit uint32, maxGasPr
 	return
 }
 
+// GetLegacyGasAtPercentile is like GetLegacyGas, but uses the given percentile
+// of the gas prices in the block history instead of the configured one.
+func (b *BlockHistoryEstimator) GetLegacyGasAtPercentile(_ []byte, gasLimit uint32, maxGasPriceWei *big.Int, percentile uint16) (gasPrice *big.Int, chainSpecificGasLimit uint32, err error) {
+	ok := b.IfStarted(func() {
+		chainSpecificGasLimit = applyMultiplier(gasLimit, b.config.EvmGasLimitMultiplier())
+		b.mu.RLock()
+		defer b.mu.RUnlock()
+		gasPrice = atPercentile(b.sortedGasPrices, int(percentile))
+	})
+	if !ok {
+		return nil, 0, errors.New("BlockHistoryEstimator is not started; cannot estimate gas")
+	}
+	if gasPrice == nil {
+		return nil, 0, errors.New("BlockHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+	}
+	if min := b.config.EvmMinGasPriceWei(); gasPrice.Cmp(min) < 0 {
+		gasPrice = min
+	}
+	gasPrice = capGasPrice(gasPrice, maxGasPriceWei, b.config)
+	return
+}
+
 func (b *BlockHistoryEstimator) getGasPrice() *big.Int {
 	b.mu.RLock()
 	defer b.mu.RUnlock()
@@ -209,6 +238,25 @@ func (b *BlockHistoryEstimator) BumpLegacyGas(originalGasPrice *big.Int, gasLimi
 }
 
 func (b *BlockHistoryEstimator) GetDynamicFee(gasLimit uint32, maxGasPriceWei *big.Int) (fee DynamicFee, chainSpecificGasLimit uint32, err error) {
+	return b.getDynamicFee(gasLimit, maxGasPriceWei, func() *big.Int { return b.tipCap })
+}
+
+// GetDynamicFeeAtPercentile is like GetDynamicFee, but uses the given
+// percentile of the tip caps in the block history instead of the configured
+// one.
+func (b *BlockHistoryEstimator) GetDynamicFeeAtPercentile(gasLimit uint32, maxGasPriceWei *big.Int, percentile uint16) (fee DynamicFee, chainSpecificGasLimit uint32, err error) {
+	return b.getDynamicFee(gasLimit, maxGasPriceWei, func() *big.Int {
+		tipCap := atPercentile(b.sortedTipCaps, int(percentile))
+		if min := b.config.EvmGasTipCapMinimum(); tipCap != nil && tipCap.Cmp(min) < 0 {
+			return min
+		}
+		return tipCap
+	})
+}
+
+// getDynamicFee estimates the fee with the tip cap returned by getTipCap,
+// which is called with b.mu read locked.
+func (b *BlockHistoryEstimator) getDynamicFee(gasLimit uint32, maxGasPriceWei *big.Int, getTipCap func() *big.Int) (fee DynamicFee, chainSpecificGasLimit uint32, err error) {
 	if !b.config.EvmEIP1559DynamicFees() {
 		return fee, 0, errors.New("Can't get dynamic fee, EIP1559 is disabled")
 	}
@@ -219,7 +267,7 @@ func (b *BlockHistoryEstimator) GetDynamicFee(gasLimit uint32, maxGasPriceWei *b
 		chainSpecificGasLimit = applyMultiplier(gasLimit, b.config.EvmGasLimitMultiplier())
 		b.mu.RLock()
 		defer b.mu.RUnlock()
-		tipCap = b.tipCap
+		tipCap = getTipCap()
 		if tipCap == nil {
 			err = errors.New("BlockHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
 			return
@@ -575,9 +623,23 @@ func (b *BlockHistoryEstimator) percentilePrices(percentile int, eip1559 bool) (
 		tipCap = tipCaps[idx]
 	}
 
+	b.mu.Lock()
+	b.sortedGasPrices = gasPrices
+	b.sortedTipCaps = tipCaps
+	b.mu.Unlock()
+
 	return
 }
 
+// atPercentile returns the price at percentile of the sorted prices, or nil
+// if there are none.
+func atPercentile(sortedPrices []*big.Int, percentile int) *big.Int {
+	if len(sortedPrices) == 0 {
+		return nil
+	}
+	return sortedPrices[((len(sortedPrices)-1)*percentile)/100]
+}
+
 func verifyBlock(block Block, eip1559 bool) error {
 	if eip1559 && block.BaseFeePerGas == nil {
 		return errors.New("EIP-1559 mode was enabled, but block was missing baseFeePerGas")
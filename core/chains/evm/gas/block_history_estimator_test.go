@@ -1652,6 +1652,128 @@ func TestBlockHistoryEstimator_GetDynamicFee(t *testing.T) {
 	})
 }
 
+func TestBlockHistoryEstimator_GetLegacyGasAtPercentile(t *testing.T) {
+	t.Parallel()
+
+	cfg := newConfigWithEIP1559DynamicFeesDisabled(t)
+
+	maxGasPrice := big.NewInt(1000000)
+	cfg.On("BlockHistoryEstimatorTransactionPercentile").Return(uint16(35))
+	cfg.On("EvmGasLimitMultiplier").Return(float32(1))
+	cfg.On("EvmMaxGasPriceWei").Return(maxGasPrice)
+	cfg.On("EvmMinGasPriceWei").Return(big.NewInt(1050))
+
+	bhe := newBlockHistoryEstimator(t, nil, cfg)
+
+	t.Run("if estimator has not been started", func(t *testing.T) {
+		_, _, err := bhe.GetLegacyGasAtPercentile(make([]byte, 0), 10000, maxGasPrice, 50)
+		require.Error(t, err)
+	})
+
+	gas.SimulateStart(t, bhe)
+
+	t.Run("if there is no block history yet", func(t *testing.T) {
+		_, _, err := bhe.GetLegacyGasAtPercentile(make([]byte, 0), 10000, maxGasPrice, 50)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "has not finished the first gas estimation yet")
+	})
+
+	blocks := []gas.Block{
+		{
+			Number:       0,
+			Hash:         utils.NewHash(),
+			Transactions: cltest.LegacyTransactionsFromGasPrices(1000, 1100),
+		},
+		{
+			Number:       1,
+			Hash:         utils.NewHash(),
+			Transactions: cltest.LegacyTransactionsFromGasPrices(1200, 1300),
+		},
+	}
+	gas.SetRollingBlockHistory(bhe, blocks)
+	bhe.Recalculate(cltest.Head(1))
+
+	t.Run("uses the given percentile instead of the configured one", func(t *testing.T) {
+		fee, limit, err := bhe.GetLegacyGas(make([]byte, 0), 10000, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(1100), fee)
+		assert.Equal(t, 10000, int(limit))
+
+		fee, limit, err = bhe.GetLegacyGasAtPercentile(make([]byte, 0), 10000, maxGasPrice, 100)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(1300), fee)
+		assert.Equal(t, 10000, int(limit))
+
+		fee, _, err = bhe.GetLegacyGasAtPercentile(make([]byte, 0), 10000, maxGasPrice, 70)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(1200), fee)
+	})
+
+	t.Run("if gas price is lower than ETH_MIN_GAS_PRICE_WEI", func(t *testing.T) {
+		fee, _, err := bhe.GetLegacyGasAtPercentile(make([]byte, 0), 10000, maxGasPrice, 0)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(1050), fee)
+	})
+
+	t.Run("if gas price is higher than user-specified max", func(t *testing.T) {
+		fee, _, err := bhe.GetLegacyGasAtPercentile(make([]byte, 0), 10000, big.NewInt(1150), 100)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(1150), fee)
+	})
+}
+
+func TestBlockHistoryEstimator_GetDynamicFeeAtPercentile(t *testing.T) {
+	t.Parallel()
+
+	cfg := newConfigWithEIP1559DynamicFeesEnabled(t)
+	maxGasPrice := big.NewInt(1000000)
+	cfg.On("BlockHistoryEstimatorTransactionPercentile").Return(uint16(35))
+	cfg.On("EvmEIP1559DynamicFees").Return(true)
+	cfg.On("EvmGasBumpThreshold").Return(uint64(0))
+	cfg.On("EvmGasLimitMultiplier").Return(float32(1))
+	cfg.On("EvmMaxGasPriceWei").Return(maxGasPrice)
+	cfg.On("EvmGasTipCapMinimum").Return(big.NewInt(5500))
+	cfg.On("EvmMinGasPriceWei").Return(big.NewInt(0))
+
+	bhe := newBlockHistoryEstimator(t, nil, cfg)
+
+	blocks := []gas.Block{
+		{
+			BaseFeePerGas: big.NewInt(88889),
+			Number:        0,
+			Hash:          utils.NewHash(),
+			Transactions:  cltest.DynamicFeeTransactionsFromTipCaps(5000, 6000, 6000),
+		},
+		{
+			BaseFeePerGas: big.NewInt(100000),
+			Number:        1,
+			Hash:          utils.NewHash(),
+			Transactions:  cltest.DynamicFeeTransactionsFromTipCaps(10000),
+		},
+	}
+	gas.SetRollingBlockHistory(bhe, blocks)
+	bhe.Recalculate(cltest.Head(1))
+	gas.SimulateStart(t, bhe)
+
+	t.Run("uses the given percentile instead of the configured one", func(t *testing.T) {
+		fee, limit, err := bhe.GetDynamicFee(100000, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, gas.DynamicFee{FeeCap: maxGasPrice, TipCap: big.NewInt(6000)}, fee)
+		assert.Equal(t, 100000, int(limit))
+
+		fee, limit, err = bhe.GetDynamicFeeAtPercentile(100000, maxGasPrice, 100)
+		require.NoError(t, err)
+		assert.Equal(t, gas.DynamicFee{FeeCap: maxGasPrice, TipCap: big.NewInt(10000)}, fee)
+		assert.Equal(t, 100000, int(limit))
+	})
+
+	t.Run("if tip cap is lower than EVM_GAS_TIP_CAP_MINIMUM", func(t *testing.T) {
+		fee, _, err := bhe.GetDynamicFeeAtPercentile(100000, maxGasPrice, 0)
+		require.NoError(t, err)
+		assert.Equal(t, gas.DynamicFee{FeeCap: maxGasPrice, TipCap: big.NewInt(5500)}, fee)
+	})
+}
+
 func TestBlockHistoryEstimator_Bumps(t *testing.T) {
 	t.Parallel()
 	maxGasPrice := big.NewInt(1000000)
@@ -61,6 +61,9 @@ func NewEstimator(lggr logger.Logger, ethClient evmclient.Client, cfg Config) Es
 	case "FixedPrice":
 		return NewFixedPriceEstimator(cfg, lggr)
 	case "Optimism2", "L2Suggested":
+		if cfg.ChainType() == config.ChainOptimism {
+			return NewOptimismEstimator(lggr, ethClient, ethClient)
+		}
 		return NewL2SuggestedPriceEstimator(lggr, ethClient)
 	default:
 		lggr.Warnf("GasEstimator: unrecognised mode '%s', falling back to FixedPriceEstimator", s)
@@ -95,6 +98,14 @@ type Estimator interface {
 	BumpDynamicFee(original DynamicFee, gasLimit uint32, maxGasPriceWei *big.Int) (bumped DynamicFee, chainSpecificGasLimit uint32, err error)
 }
 
+// PercentileEstimator is implemented by estimators that price transactions at
+// a percentile of the prices of recent transactions. It allows overriding the
+// configured percentile for a single transaction.
+type PercentileEstimator interface {
+	GetLegacyGasAtPercentile(calldata []byte, gasLimit uint32, maxGasPriceWei *big.Int, percentile uint16) (gasPrice *big.Int, chainSpecificGasLimit uint32, err error)
+	GetDynamicFeeAtPercentile(gasLimit uint32, maxGasPriceWei *big.Int, percentile uint16) (fee DynamicFee, chainSpecificGasLimit uint32, err error)
+}
+
 // Opt is an option for a gas estimator
 type Opt int
 
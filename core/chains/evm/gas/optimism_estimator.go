@@ -0,0 +1,249 @@
+package gas
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// optimismEstimator is an Estimator which extends l2SuggestedPriceEstimator to account for the L1 data fee in the
+// maximum gas price check.
+type optimismEstimator struct {
+	utils.StartStopOnce
+
+	Estimator // *l2SuggestedPriceEstimator
+
+	client     ethClient
+	pollPeriod time.Duration
+	logger     logger.Logger
+
+	l1FeeParamsMu sync.RWMutex
+	l1FeeParams   *optimismL1FeeParams
+
+	chForceRefetch chan (chan struct{})
+	chInitialised  chan struct{}
+	chStop         chan struct{}
+	chDone         chan struct{}
+}
+
+// optimismL1FeeParams are the values of the OVM_GasPriceOracle used to compute the L1 data fee of a tx.
+type optimismL1FeeParams struct {
+	l1BaseFee *big.Int
+	overhead  *big.Int
+	scalar    *big.Int
+	decimals  *big.Int
+}
+
+func NewOptimismEstimator(lggr logger.Logger, rpcClient rpcClient, ethClient ethClient) Estimator {
+	lggr = lggr.Named("OptimismEstimator")
+	return &optimismEstimator{
+		Estimator:      NewL2SuggestedPriceEstimator(lggr, rpcClient),
+		client:         ethClient,
+		pollPeriod:     10 * time.Second,
+		logger:         lggr,
+		chForceRefetch: make(chan (chan struct{})),
+		chInitialised:  make(chan struct{}),
+		chStop:         make(chan struct{}),
+		chDone:         make(chan struct{}),
+	}
+}
+
+func (o *optimismEstimator) Start(ctx context.Context) error {
+	return o.StartOnce("OptimismEstimator", func() error {
+		if err := o.Estimator.Start(ctx); err != nil {
+			return errors.Wrap(err, "failed to start gas price estimator")
+		}
+		go o.run()
+		<-o.chInitialised
+		return nil
+	})
+}
+
+func (o *optimismEstimator) Close() error {
+	return o.StopOnce("OptimismEstimator", func() (err error) {
+		close(o.chStop)
+		err = errors.Wrap(o.Estimator.Close(), "failed to stop gas price estimator")
+		<-o.chDone
+		return
+	})
+}
+
+// GetLegacyGas estimates the gas price with the embedded l2SuggestedPriceEstimator.
+// The L1 data fee is paid on top of gasPrice * gasLimit, so it is spread over the gas limit to check the effective
+// gas price of the tx against maxGasPriceWei. It is computed from the tx calldata like
+// OVM_GasPriceOracle.getL1Fee() does.
+func (o *optimismEstimator) GetLegacyGas(calldata []byte, l2GasLimit uint32, maxGasPriceWei *big.Int, opts ...Opt) (gasPrice *big.Int, chainSpecificGasLimit uint32, err error) {
+	gasPrice, chainSpecificGasLimit, err = o.Estimator.GetLegacyGas(calldata, l2GasLimit, maxGasPriceWei, opts...)
+	if err != nil {
+		return
+	}
+	var params *optimismL1FeeParams
+	ok := o.IfStarted(func() {
+		if slices.Contains(opts, OptForceRefetch) {
+			ch := make(chan struct{})
+			select {
+			case o.chForceRefetch <- ch:
+			case <-o.chStop:
+				err = errors.New("estimator stopped")
+				return
+			}
+			select {
+			case <-ch:
+			case <-o.chStop:
+				err = errors.New("estimator stopped")
+				return
+			}
+		}
+		params = o.getL1FeeParams()
+	})
+	if !ok {
+		return nil, 0, errors.New("estimator is not started")
+	} else if err != nil {
+		return nil, 0, err
+	}
+	if params == nil || l2GasLimit == 0 {
+		// The L1 data fee is unknown until the oracle could be called, the tx can still be sent
+		return
+	}
+
+	l1Fee := params.l1Fee(calldata)
+	// ceil(l1Fee / l2GasLimit)
+	l1FeePerGas := new(big.Int).Add(l1Fee, big.NewInt(int64(l2GasLimit)-1))
+	l1FeePerGas.Div(l1FeePerGas, big.NewInt(int64(l2GasLimit)))
+	effectiveGasPrice := new(big.Int).Add(gasPrice, l1FeePerGas)
+	o.logger.Debugw("GetLegacyGas", "l2GasPrice", gasPrice, "l2GasLimit", l2GasLimit, "calldataLen", len(calldata),
+		"l1Fee", l1Fee, "effectiveGasPrice", effectiveGasPrice)
+	if maxGasPriceWei != nil && effectiveGasPrice.Cmp(maxGasPriceWei) > 0 {
+		return nil, 0, errors.Errorf("estimated gas price: %s including the L1 data fee: %s is greater than the maximum gas price configured: %s",
+			effectiveGasPrice.String(), l1Fee.String(), maxGasPriceWei.String())
+	}
+	return
+}
+
+// l1Fee returns the L1 data fee of a tx with calldata, following OVM_GasPriceOracle.getL1Fee():
+// (calldata gas + overhead + signature gas) * l1BaseFee * scalar / 10^decimals
+func (p *optimismL1FeeParams) l1Fee(calldata []byte) *big.Int {
+	var calldataGas int64
+	for _, b := range calldata {
+		if b == 0 {
+			calldataGas += 4
+		} else {
+			calldataGas += 16
+		}
+	}
+	// 68 bytes of signature, all assumed non-zero
+	l1GasUsed := new(big.Int).Add(big.NewInt(calldataGas+68*16), p.overhead)
+	fee := new(big.Int).Mul(l1GasUsed, p.l1BaseFee)
+	fee.Mul(fee, p.scalar)
+	return fee.Div(fee, new(big.Int).Exp(big.NewInt(10), p.decimals, nil))
+}
+
+func (o *optimismEstimator) getL1FeeParams() *optimismL1FeeParams {
+	o.l1FeeParamsMu.RLock()
+	defer o.l1FeeParamsMu.RUnlock()
+	return o.l1FeeParams
+}
+
+func (o *optimismEstimator) run() {
+	defer close(o.chDone)
+
+	t := o.refreshL1FeeParams()
+	close(o.chInitialised)
+
+	for {
+		select {
+		case <-o.chStop:
+			return
+		case ch := <-o.chForceRefetch:
+			t.Stop()
+			t = o.refreshL1FeeParams()
+			close(ch)
+		case <-t.C:
+			t = o.refreshL1FeeParams()
+		}
+	}
+}
+
+// refreshL1FeeParams calls the OVM_GasPriceOracle and caches the refreshed values.
+func (o *optimismEstimator) refreshL1FeeParams() (t *time.Timer) {
+	t = time.NewTimer(utils.WithJitter(o.pollPeriod))
+
+	params, err := o.callL1FeeParams()
+	if err != nil {
+		o.logger.Warnw("Failed to refresh L1 fee params", "err", err)
+		return
+	}
+
+	o.logger.Debugw("refreshL1FeeParams", "l1BaseFee", params.l1BaseFee, "overhead", params.overhead,
+		"scalar", params.scalar, "decimals", params.decimals)
+
+	o.l1FeeParamsMu.Lock()
+	o.l1FeeParams = params
+	o.l1FeeParamsMu.Unlock()
+	return
+}
+
+const (
+	// OVMGasPriceOracleAddress is the address of the OVM_GasPriceOracle predeploy of Optimism.
+	// https://github.com/ethereum-optimism/optimism/blob/develop/packages/contracts/contracts/L2/predeploys/OVM_GasPriceOracle.sol
+	OVMGasPriceOracleAddress = "0x420000000000000000000000000000000000000F"
+	// OVMGasPriceOracle_l1BaseFee is the hex encoded call to `function l1BaseFee() external view returns (uint256);`
+	OVMGasPriceOracle_l1BaseFee = "519b4bd3"
+	// OVMGasPriceOracle_overhead is the hex encoded call to `function overhead() external view returns (uint256);`
+	OVMGasPriceOracle_overhead = "0c18c162"
+	// OVMGasPriceOracle_scalar is the hex encoded call to `function scalar() external view returns (uint256);`
+	OVMGasPriceOracle_scalar = "f45e65d8"
+	// OVMGasPriceOracle_decimals is the hex encoded call to `function decimals() external view returns (uint256);`
+	OVMGasPriceOracle_decimals = "313ce567"
+)
+
+// callL1FeeParams calls l1BaseFee(), overhead(), scalar() and decimals() on the predeploy contract
+// OVMGasPriceOracleAddress.
+func (o *optimismEstimator) callL1FeeParams() (*optimismL1FeeParams, error) {
+	ctx, cancel := evmclient.ContextWithDefaultTimeoutFromChan(o.chStop)
+	defer cancel()
+
+	var params optimismL1FeeParams
+	for _, call := range []struct {
+		data string
+		v    **big.Int
+	}{
+		{OVMGasPriceOracle_l1BaseFee, &params.l1BaseFee},
+		{OVMGasPriceOracle_overhead, &params.overhead},
+		{OVMGasPriceOracle_scalar, &params.scalar},
+		{OVMGasPriceOracle_decimals, &params.decimals},
+	} {
+		v, err := o.callUint256(ctx, call.data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to call %s", call.data)
+		}
+		*call.v = v
+	}
+	return &params, nil
+}
+
+func (o *optimismEstimator) callUint256(ctx context.Context, data string) (*big.Int, error) {
+	precompile := common.HexToAddress(OVMGasPriceOracleAddress)
+	b, err := o.client.CallContract(ctx, ethereum.CallMsg{
+		To:   &precompile,
+		Data: common.Hex2Bytes(data),
+	}, big.NewInt(-1))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != 32 { // returns (uint256);
+		return nil, fmt.Errorf("return data length (%d) different than expected (%d)", len(b), 32)
+	}
+	return new(big.Int).SetBytes(b), nil
+}
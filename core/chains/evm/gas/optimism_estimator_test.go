@@ -0,0 +1,100 @@
+package gas_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func TestOptimismEstimator(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := big.NewInt(100)
+	calldata := []byte{0x00, 0x00, 0x01, 0x02, 0x03}
+	const gasLimit uint32 = 80000
+
+	// L1 fee = (2*4 + 3*16 + 68*16 + 2100) * 100 * 1_000_000 / 10^6 = 324400, i.e. 5 wei per gas
+	oracleValues := map[string]int64{
+		gas.OVMGasPriceOracle_l1BaseFee: 100,
+		gas.OVMGasPriceOracle_overhead:  2100,
+		gas.OVMGasPriceOracle_scalar:    1_000_000,
+		gas.OVMGasPriceOracle_decimals:  6,
+	}
+	newClients := func(t *testing.T, oracleErr error) (*mocks.RPCClient, *mocks.ETHClient) {
+		rpcClient := mocks.NewRPCClient(t)
+		rpcClient.On("CallContext", mock.Anything, mock.Anything, "eth_gasPrice").Return(nil).Run(func(args mock.Arguments) {
+			res := args.Get(1).(*hexutil.Big)
+			(*big.Int)(res).SetInt64(42)
+		})
+		ethClient := mocks.NewETHClient(t)
+		call := ethClient.On("CallContract", mock.Anything, mock.IsType(ethereum.CallMsg{}), mock.IsType(&big.Int{}))
+		if oracleErr != nil {
+			call.Return(nil, oracleErr)
+			return rpcClient, ethClient
+		}
+		call.Return(func(_ context.Context, callMsg ethereum.CallMsg, blockNumber *big.Int) []byte {
+			assert.Equal(t, gas.OVMGasPriceOracleAddress, callMsg.To.String())
+			assert.Equal(t, big.NewInt(-1), blockNumber)
+			v, ok := oracleValues[fmt.Sprintf("%x", callMsg.Data)]
+			assert.True(t, ok)
+			return common.BigToHash(big.NewInt(v)).Bytes()
+		}, nil)
+		return rpcClient, ethClient
+	}
+
+	t.Run("calling GetLegacyGas on unstarted estimator returns error", func(t *testing.T) {
+		o := gas.NewOptimismEstimator(logger.TestLogger(t), mocks.NewRPCClient(t), mocks.NewETHClient(t))
+		_, _, err := o.GetLegacyGas(calldata, gasLimit, maxGasPrice)
+		assert.EqualError(t, err, "estimator is not started")
+	})
+
+	t.Run("calling GetLegacyGas on started estimator returns estimates", func(t *testing.T) {
+		rpcClient, ethClient := newClients(t, nil)
+		o := gas.NewOptimismEstimator(logger.TestLogger(t), rpcClient, ethClient)
+		require.NoError(t, o.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, o.Close()) })
+
+		gasPrice, chainSpecificGasLimit, err := o.GetLegacyGas(calldata, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(42), gasPrice)
+		assert.Equal(t, gasLimit, chainSpecificGasLimit)
+	})
+
+	t.Run("gas price including the L1 data fee is greater than max gas price", func(t *testing.T) {
+		rpcClient, ethClient := newClients(t, nil)
+		o := gas.NewOptimismEstimator(logger.TestLogger(t), rpcClient, ethClient)
+		require.NoError(t, o.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, o.Close()) })
+
+		gasPrice, chainSpecificGasLimit, err := o.GetLegacyGas(calldata, gasLimit, big.NewInt(45))
+		assert.EqualError(t, err, "estimated gas price: 47 including the L1 data fee: 324400 is greater than the maximum gas price configured: 45")
+		assert.Nil(t, gasPrice)
+		assert.Equal(t, uint32(0), chainSpecificGasLimit)
+	})
+
+	t.Run("L1 data fee is ignored if the oracle cannot be called", func(t *testing.T) {
+		rpcClient, ethClient := newClients(t, errors.New("kaboom"))
+		o := gas.NewOptimismEstimator(logger.TestLogger(t), rpcClient, ethClient)
+		require.NoError(t, o.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, o.Close()) })
+
+		gasPrice, chainSpecificGasLimit, err := o.GetLegacyGas(calldata, gasLimit, big.NewInt(45))
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(42), gasPrice)
+		assert.Equal(t, gasLimit, chainSpecificGasLimit)
+	})
+}
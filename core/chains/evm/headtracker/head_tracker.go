@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -17,6 +18,7 @@ import (
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -30,6 +32,11 @@ var (
 		Name: "head_tracker_very_old_head",
 		Help: "Counter is incremented every time we get a head that is much lower than the highest seen head ('much lower' is defined as a block that is ETH_FINALITY_DEPTH or greater below the highest seen head)",
 	}, []string{"evmChainID"})
+
+	promHeadGaps = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "head_tracker_head_gaps",
+		Help: "Counter is incremented every time a new head is ETH_FINALITY_DEPTH or more above the previously backfilled one, for example after the node was offline or the RPC connection flapped",
+	}, []string{"evmChainID"})
 )
 
 // HeadsBufferSize - The buffer is used when heads sampling is disabled, to ensure the callback is run for every head
@@ -42,6 +49,9 @@ type headTracker struct {
 	ethClient       evmclient.Client
 	chainID         big.Int
 	config          Config
+	// lastBackfilled is the number of the last head passed to Backfill, or
+	// of the latest head in the db on startup. Only used by backfillLoop.
+	lastBackfilled int64
 
 	backfillMB   *utils.Mailbox[*evmtypes.Head]
 	broadcastMB  *utils.Mailbox[*evmtypes.Head]
@@ -84,6 +94,7 @@ func (ht *headTracker) Start(ctx context.Context) error {
 			return err
 		}
 		if latestChain != nil {
+			ht.lastBackfilled = latestChain.Number
 			ht.log.Debugw(
 				fmt.Sprintf("HeadTracker: Tracking logs from last block %v with hash %s", config.FriendlyBigInt(latestChain.ToInt()), latestChain.Hash.Hex()),
 				"blockNumber", latestChain.Number,
@@ -189,6 +200,9 @@ func (ht *headTracker) handleNewHead(ctx context.Context, head *evmtypes.Head) e
 		if headWithChain == nil {
 			return errors.Errorf("HeadTracker#handleNewHighestHead headWithChain was unexpectedly nil")
 		}
+		if tag := ht.config.EvmFinalityTag(); tag != "" {
+			ht.setFinalizedBlockNumber(ctx, headWithChain, tag)
+		}
 		ht.backfillMB.Deliver(headWithChain)
 		ht.broadcastMB.Deliver(headWithChain)
 	} else if head.Number == prevHead.Number {
@@ -207,6 +221,26 @@ func (ht *headTracker) handleNewHead(ctx context.Context, head *evmtypes.Head) e
 	return nil
 }
 
+// setFinalizedBlockNumber sets the number of the latest block marked by the
+// finality tag on head. It is left unset if the RPC node does not support the
+// tag, so that EvmFinalityDepth is used instead.
+func (ht *headTracker) setFinalizedBlockNumber(ctx context.Context, head *evmtypes.Head, tag string) {
+	blockNumber := rpc.FinalizedBlockNumber
+	if tag == "safe" {
+		blockNumber = rpc.SafeBlockNumber
+	}
+	finalized, err := ht.ethClient.HeadByNumber(ctx, big.NewInt(int64(blockNumber)))
+	if err != nil {
+		ht.log.Debugw("Failed to fetch head by finality tag, falling back to finality depth", "tag", tag, "err", err)
+		return
+	}
+	if finalized.Number > head.Number {
+		// the RPC node is ahead of the head we are processing
+		return
+	}
+	head.FinalizedBlockNumber = null.Int64From(finalized.Number)
+}
+
 func (ht *headTracker) broadcastLoop() {
 	defer ht.wgDone.Done()
 
@@ -263,7 +297,8 @@ func (ht *headTracker) backfillLoop() {
 					break
 				}
 				{
-					err := ht.Backfill(ctx, head, uint(ht.config.EvmFinalityDepth()))
+					err := ht.Backfill(ctx, head, ht.backfillDepth(head))
+					ht.lastBackfilled = head.Number
 					if err != nil {
 						ht.log.Warnw("Unexpected error while backfilling heads", "err", err)
 					} else if ctx.Err() != nil {
@@ -275,6 +310,34 @@ func (ht *headTracker) backfillLoop() {
 	}
 }
 
+// backfillDepth returns how many heads below and including head must be
+// present. This is normally EvmFinalityDepth, but if there is a gap since the
+// last backfilled head it is extended to cover the gap, up to
+// EvmHeadTrackerHistoryDepth, so that no range of heads is skipped.
+func (ht *headTracker) backfillDepth(head *evmtypes.Head) uint {
+	depth := int64(ht.config.EvmFinalityDepth())
+	if ht.lastBackfilled <= 0 {
+		return uint(depth)
+	}
+	gap := head.Number - ht.lastBackfilled
+	if gap < depth {
+		return uint(depth)
+	}
+	promHeadGaps.WithLabelValues(ht.chainID.String()).Inc()
+	gapDepth := gap + 1
+	if historyDepth := int64(ht.config.EvmHeadTrackerHistoryDepth()); gapDepth > historyDepth {
+		ht.log.Warnw("Gap in heads is larger than the head tracker history depth, only the most recent heads will be backfilled",
+			"blockNumber", head.Number, "lastBackfilledBlockNumber", ht.lastBackfilled, "historyDepth", historyDepth)
+		gapDepth = historyDepth
+	}
+	if gapDepth > depth {
+		ht.log.Infow(fmt.Sprintf("Detected gap of %d heads, backfilling", gap),
+			"blockNumber", head.Number, "lastBackfilledBlockNumber", ht.lastBackfilled)
+		depth = gapDepth
+	}
+	return uint(depth)
+}
+
 // backfill fetches all missing heads up until the base height
 func (ht *headTracker) backfill(ctx context.Context, head *evmtypes.Head, baseHeight int64) (err error) {
 	if head.Number <= baseHeight {
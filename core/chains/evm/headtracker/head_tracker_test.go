@@ -401,6 +401,56 @@ func TestHeadTracker_Start_LoadsLatestChain(t *testing.T) {
 	assert.Equal(t, h.Number, int64(3))
 }
 
+func TestHeadTracker_Start_BackfillsGap(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	logger := logger.TestLogger(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalEvmFinalityDepth = null.IntFrom(2)
+	cfg.Overrides.GlobalEvmHeadTrackerHistoryDepth = null.IntFrom(100)
+	config := evmtest.NewChainScopedConfig(t, cfg)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+
+	// the node was offline between heads 3 and 8
+	heads := make([]*evmtypes.Head, 9)
+	for i := range heads {
+		heads[i] = cltest.Head(i)
+		if i > 0 {
+			heads[i].ParentHash = heads[i-1].Hash
+		}
+	}
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(heads[8], nil).Once()
+	for i := 4; i < 8; i++ {
+		ethClient.On("HeadByNumber", mock.Anything, big.NewInt(int64(i))).Return(heads[i], nil).Once()
+	}
+	mockEth := &evmtest.MockEth{EthClient: ethClient}
+	ethClient.On("SubscribeNewHead", mock.Anything, mock.Anything).
+		Return(
+			func(ctx context.Context, ch chan<- *evmtypes.Head) ethereum.Subscription { return mockEth.NewSub(t) },
+			func(ctx context.Context, ch chan<- *evmtypes.Head) error { return nil },
+		)
+
+	orm := headtracker.NewORM(db, logger, cfg, cltest.FixtureChainID)
+	require.NoError(t, orm.IdempotentInsertHead(testutils.Context(t), heads[3]))
+	ht := createHeadTracker(t, ethClient, config, orm)
+	ht.Start(t)
+
+	gomega.NewWithT(t).Eventually(func() int64 {
+		h := ht.headSaver.Chain(heads[8].Hash)
+		if h == nil {
+			return -1
+		}
+		return h.EarliestInChain().Number
+	}, 5*time.Second, testutils.TestInterval).Should(gomega.Equal(int64(3)))
+
+	for i := 4; i < 8; i++ {
+		h, err := orm.HeadByHash(testutils.Context(t), heads[i].Hash)
+		require.NoError(t, err)
+		require.NotNil(t, h)
+	}
+}
+
 func TestHeadTracker_SwitchesToLongestChainWithHeadSamplingEnabled(t *testing.T) {
 	t.Parallel()
 
@@ -41,6 +41,20 @@ func (_m *Config) EvmFinalityDepth() uint32 {
 	return r0
 }
 
+// EvmFinalityTag provides a mock function with given fields:
+func (_m *Config) EvmFinalityTag() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // EvmHeadTrackerHistoryDepth provides a mock function with given fields:
 func (_m *Config) EvmHeadTrackerHistoryDepth() uint32 {
 	ret := _m.Called()
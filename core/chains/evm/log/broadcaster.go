@@ -37,7 +37,8 @@ type (
 	// dependents of LogBroadcaster are done.
 	//
 	// The backfill starts from the earliest block of either:
-	//  - Latest DB head minus BlockBackfillDepth and the maximum number of confirmations.
+	//  - Latest DB head minus BlockBackfillDepth and the maximum number of confirmations, or the block after
+	//    the lowest checkpoint if every subscriber has one.
 	//  - Earliest pending or unconsumed log broadcast from DB.
 	//
 	// If a subscriber is added after the LogBroadcaster does the initial backfill,
@@ -54,6 +55,11 @@ type (
 		// previously by any subscribers.
 		ReplayFromBlock(number int64, forceBroadcast bool)
 
+		// ReplayJobLogs fetches the logs between fromBlock and toBlock (inclusive) matching the listeners
+		// registered by the given job, and sends them to those listeners only. Logs that were already
+		// consumed by the job are skipped. It returns the number of logs sent.
+		ReplayJobLogs(ctx context.Context, jobID int32, fromBlock, toBlock int64) (int, error)
+
 		IsConnected() bool
 		Register(listener Listener, opts ListenerOpts) (unsubscribe func())
 
@@ -89,6 +95,11 @@ type (
 		forceBroadcast bool
 	}
 
+	jobSubscribersRequest struct {
+		jobID  int32
+		chSubs chan []*subscriber
+	}
+
 	broadcaster struct {
 		orm        ORM
 		config     Config
@@ -114,10 +125,14 @@ type (
 		wgDone                sync.WaitGroup
 		trackedAddressesCount atomic.Uint32
 		replayChannel         chan replayRequest
+		jobSubscribersChannel chan jobSubscribersRequest
 		highestSavedHead      *evmtypes.Head
 		lastSeenHeadNumber    atomic.Int64
 		logger                logger.Logger
 
+		// canonicalHashes holds the hashes of the recent blocks of the longest chain, to detect reorgs
+		canonicalHashes map[int64]common.Hash
+
 		// used for testing only
 		testPause, testResume chan struct{}
 	}
@@ -127,6 +142,8 @@ type (
 		BlockBackfillSkip() bool
 		EvmFinalityDepth() uint32
 		EvmLogBackfillBatchSize() uint32
+		EvmLogPollInterval() time.Duration
+		LogBroadcasterPolling() bool
 	}
 
 	ListenerOpts struct {
@@ -181,7 +198,9 @@ func NewBroadcaster(orm ORM, ethClient evmclient.Client, config Config, lggr log
 		DependentAwaiter:       utils.NewDependentAwaiter(),
 		chStop:                 chStop,
 		highestSavedHead:       highestSavedHead,
+		canonicalHashes:        make(map[int64]common.Hash),
 		replayChannel:          make(chan replayRequest, 1),
+		jobSubscribersChannel:  make(chan jobSubscribersRequest),
 	}
 }
 
@@ -205,6 +224,77 @@ func (b *broadcaster) ReplayFromBlock(number int64, forceBroadcast bool) {
 	}
 }
 
+// ReplayJobLogs implements the Broadcaster interface.
+func (b *broadcaster) ReplayJobLogs(ctx context.Context, jobID int32, fromBlock, toBlock int64) (sent int, err error) {
+	if fromBlock < 0 || toBlock < fromBlock {
+		return 0, errors.Errorf("invalid block range: from %v to %v", fromBlock, toBlock)
+	}
+	b.logger.Infow("Job replay requested", "jobID", jobID, "fromBlock", fromBlock, "toBlock", toBlock)
+
+	subs, err := b.jobSubscribers(ctx, jobID)
+	if err != nil {
+		return 0, err
+	}
+	if len(subs) == 0 {
+		return 0, errors.Errorf("no log listeners are registered for job %v", jobID)
+	}
+
+	latestHead, err := b.ethSubscriber.ethClient.HeadByNumber(ctx, nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to fetch latest head")
+	} else if latestHead == nil {
+		return 0, errors.New("got nil latest head")
+	}
+	if toBlock > latestHead.Number {
+		toBlock = latestHead.Number
+	}
+
+	broadcasts, err := b.orm.FindBroadcasts(fromBlock, toBlock)
+	if err != nil {
+		return 0, err
+	}
+	broadcastsExisting := make(map[LogBroadcastAsKey]bool)
+	for _, lb := range broadcasts {
+		broadcastsExisting[lb.AsKey()] = lb.Consumed
+	}
+
+	for _, sub := range subs {
+		var topics []common.Hash
+		for topic := range sub.opts.LogsWithTopics {
+			topics = append(topics, topic)
+		}
+		logs, err := b.ethSubscriber.fetchLogRange(ctx, []common.Address{sub.opts.Contract}, topics, fromBlock, toBlock)
+		if err != nil {
+			return sent, err
+		}
+
+		// A single-subscriber handler ensures the logs only reach this job
+		h := newHandler(b.logger, b.evmChainID)
+		h.addSubscriber(sub, nil)
+		for _, log := range logs {
+			if log.Removed || log.BlockNumber+uint64(sub.opts.MinIncomingConfirmations)-1 > uint64(latestHead.Number) {
+				continue
+			}
+			sent += h.sendLog(log, *latestHead, broadcastsExisting, b.orm, b.logger)
+		}
+	}
+	b.logger.Infow("Job replay finished", "jobID", jobID, "fromBlock", fromBlock, "toBlock", toBlock, "sent", sent)
+	return sent, nil
+}
+
+// jobSubscribers asks the event loop for the subscribers of jobID, since registrations are not thread-safe.
+func (b *broadcaster) jobSubscribers(ctx context.Context, jobID int32) ([]*subscriber, error) {
+	req := jobSubscribersRequest{jobID: jobID, chSubs: make(chan []*subscriber, 1)}
+	select {
+	case b.jobSubscribersChannel <- req:
+	case <-ctx.Done():
+		return nil, errors.Wrap(ctx.Err(), "log broadcaster is not processing requests")
+	case <-b.chStop:
+		return nil, errors.New("log broadcaster is stopped")
+	}
+	return <-req.chSubs, nil
+}
+
 func (b *broadcaster) Close() error {
 	return b.StopOnce("LogBroadcaster", func() error {
 		close(b.chStop)
@@ -280,6 +370,7 @@ func (b *broadcaster) OnNewLongestChain(ctx context.Context, head *evmtypes.Head
 	if wasOverCapacity {
 		b.logger.Debugw("Dropped the older head in the mailbox, while inserting latest (which is fine)", "latestBlockNumber", head.Number)
 	}
+	b.ethSubscriber.pollNow()
 }
 
 func (b *broadcaster) IsConnected() bool {
@@ -314,6 +405,10 @@ func (b *broadcaster) startResubscribeLoop() {
 		if from < 0 {
 			from = 0
 		}
+		// Subscribers that all have a checkpoint from before the restart only need logs after it
+		if checkpointed := b.checkpointedBackfillStart(); checkpointed != nil {
+			from = *checkpointed
+		}
 		b.backfillBlockNumber = null.NewInt64(from, true)
 	}
 
@@ -378,6 +473,20 @@ func (b *broadcaster) startResubscribeLoop() {
 	}
 }
 
+// checkpointedBackfillStart returns the block after the lowest checkpoint of the registered
+// subscribers, or nil if any of them has none.
+func (b *broadcaster) checkpointedBackfillStart() *int64 {
+	ctx, cancel := utils.ContextFromChan(b.chStop)
+	defer cancel()
+
+	checkpoints, err := b.orm.GetCheckpoints(pg.WithParentCtx(ctx))
+	if err != nil {
+		b.logger.Errorw("Failed to load log broadcast checkpoints, falling back to BlockBackfillDepth", "err", err)
+		return nil
+	}
+	return b.registrations.backfillStart(checkpoints)
+}
+
 func (b *broadcaster) reinitialize() (backfillStart *int64, abort bool) {
 	ctx, cancel := utils.ContextFromChan(b.chStop)
 	defer cancel()
@@ -444,6 +553,9 @@ func (b *broadcaster) eventLoop(chRawLogs <-chan types.Log, chErr <-chan error)
 			b.onReplayRequest(req)
 			return true, nil
 
+		case req := <-b.jobSubscribersChannel:
+			req.chSubs <- b.registrations.subscribersForJob(req.jobID)
+
 		case <-debounceResubscribe.C:
 			if needsResubscribe {
 				b.logger.Debug("Returning from the event loop to resubscribe")
@@ -555,10 +667,17 @@ func (b *broadcaster) onNewHeads() {
 
 		latestBlockNum := latestHead.Number
 		keptDepth := latestBlockNum - int64(keptLogsDepth)
+		if finalized := latestHead.FinalizedBlockNumber; finalized.Valid && finalized.Int64 < keptDepth {
+			// logs are kept until they are final, even if the finality tag
+			// lags behind the finality depth
+			keptDepth = finalized.Int64
+		}
 		if keptDepth < 0 {
 			keptDepth = 0
 		}
 
+		b.onReorg(latestHead, keptDepth)
+
 		ctx, cancel := utils.ContextFromChan(b.chStop)
 		defer cancel()
 
@@ -594,6 +713,46 @@ func (b *broadcaster) onNewHeads() {
 				b.logger.Errorw("Failed to set pending broadcasts number", "blockNumber", keptDepth, "err", err)
 			}
 		}
+
+		if err := b.orm.SetCheckpoints(b.registrations.checkpoints(latestBlockNum), pg.WithParentCtx(ctx)); err != nil {
+			b.logger.Errorw("Failed to set log broadcast checkpoints", "blockNumber", latestBlockNum, "err", err)
+		}
+	}
+}
+
+// onReorg compares the chain of head with the blocks seen previously. The logs of blocks that were
+// orphaned are removed from the pool and their broadcasts invalidated, so that the canonical logs
+// received from the subscription are delivered again, even if the chain later reorgs back to them.
+func (b *broadcaster) onReorg(head *evmtypes.Head, keptDepth int64) {
+	var orphanedHashes []common.Hash
+	var fromBlock int64
+	for h := head; h != nil; h = h.Parent {
+		if hash, exists := b.canonicalHashes[h.Number]; exists && hash != h.Hash {
+			b.logPool.removeBlock(hash, uint64(h.Number))
+			orphanedHashes = append(orphanedHashes, hash)
+			fromBlock = h.Number
+		}
+		b.canonicalHashes[h.Number] = h.Hash
+	}
+	for number := range b.canonicalHashes {
+		if number < keptDepth {
+			delete(b.canonicalHashes, number)
+		}
+	}
+	if len(orphanedHashes) == 0 {
+		return
+	}
+
+	b.logger.Infow("Reorg detected, invalidating logs of orphaned blocks",
+		"fromBlock", fromBlock, "blockNumber", head.Number, "orphanedBlocks", len(orphanedHashes))
+	ctx, cancel := utils.ContextFromChan(b.chStop)
+	defer cancel()
+	if err := b.orm.MarkBroadcastsReorged(orphanedHashes, pg.WithParentCtx(ctx)); err != nil {
+		b.logger.Errorw("Failed to mark log broadcasts reorged", "fromBlock", fromBlock, "err", err)
+	}
+	if b.config.LogBroadcasterPolling() {
+		// Polling only fetches the logs of new blocks, so the canonical logs must be fetched again
+		b.ReplayFromBlock(fromBlock, false)
 	}
 }
 
@@ -750,6 +909,11 @@ func (n *NullBroadcaster) Register(listener Listener, opts ListenerOpts) (unsubs
 // ReplayFromBlock implements the Broadcaster interface.
 func (n *NullBroadcaster) ReplayFromBlock(number int64, forceBroadcast bool) {}
 
+// ReplayJobLogs implements the Broadcaster interface.
+func (n *NullBroadcaster) ReplayJobLogs(ctx context.Context, jobID int32, fromBlock, toBlock int64) (int, error) {
+	return 0, errors.New(n.ErrMsg)
+}
+
 func (n *NullBroadcaster) BackfillBlockNumber() null.Int64 {
 	return null.NewInt64(0, false)
 }
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
 
 	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -22,15 +24,29 @@ type (
 		config    Config
 		logger    logger.Logger
 		chStop    chan struct{}
+		// chPoll triggers a poll of the polling subscription, if any
+		chPoll chan struct{}
 	}
 )
 
+// minPollInterval is the shortest interval between polls of a polling subscription
+const minPollInterval = time.Second
+
 func newEthSubscriber(ethClient evmclient.Client, config Config, logger logger.Logger, chStop chan struct{}) *ethSubscriber {
 	return &ethSubscriber{
 		ethClient: ethClient,
 		config:    config,
 		logger:    logger.Named("EthSubscriber"),
 		chStop:    chStop,
+		chPoll:    make(chan struct{}, 1),
+	}
+}
+
+// pollNow makes the polling subscription, if any, poll for logs without waiting for its interval.
+func (sub *ethSubscriber) pollNow() {
+	select {
+	case sub.chPoll <- struct{}{}:
+	default:
 	}
 }
 
@@ -100,6 +116,10 @@ func (sub *ethSubscriber) backfillLogs(fromBlockOverride null.Int64, addresses [
 		// request data limit.
 		// On matic its 5MB [https://github.com/maticnetwork/bor/blob/3de2110886522ab17e0b45f3c4a6722da72b7519/rpc/http.go#L35]
 		// On ethereum its 15MB [https://github.com/ethereum/go-ethereum/blob/master/rpc/websocket.go#L40]
+		// The batches are not sent together with BatchCallContext: each eth_getLogs call already
+		// covers a range of blocks, and the responses of a batch call come back as a single
+		// message, as large as one call over the combined range would be. A larger
+		// EvmLogBackfillBatchSize saves the same round trips.
 		batchSize := int64(sub.config.EvmLogBackfillBatchSize())
 		for from := q.FromBlock.Int64(); from <= latestHeight; from += batchSize {
 
@@ -190,6 +210,32 @@ func (sub *ethSubscriber) fetchLogBatch(ctx context.Context, query ethereum.Filt
 	return result, errOuter
 }
 
+// fetchLogRange fetches all logs in [fromBlock, toBlock] for the given addresses and topics, in batches of
+// EvmLogBackfillBatchSize blocks.
+func (sub *ethSubscriber) fetchLogRange(ctx context.Context, addresses []common.Address, topics []common.Hash, fromBlock, toBlock int64) ([]types.Log, error) {
+	var logs []types.Log
+	start := time.Now()
+	batchSize := int64(sub.config.EvmLogBackfillBatchSize())
+	for from := fromBlock; from <= toBlock; from += batchSize {
+		to := from + batchSize - 1
+		if to > toBlock {
+			to = toBlock
+		}
+		q := ethereum.FilterQuery{
+			FromBlock: big.NewInt(from),
+			ToBlock:   big.NewInt(to),
+			Addresses: addresses,
+			Topics:    [][]common.Hash{topics},
+		}
+		batchLogs, err := sub.fetchLogBatch(ctx, q, start)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch logs from %v to %v", from, to)
+		}
+		logs = append(logs, batchLogs...)
+	}
+	return logs, nil
+}
+
 // createSubscription creates a new log subscription starting at the current block.  If previous logs
 // are needed, they must be obtained through backfilling, as subscriptions can only be started from
 // the current head.
@@ -197,6 +243,9 @@ func (sub *ethSubscriber) createSubscription(addresses []common.Address, topics
 	if len(addresses) == 0 {
 		return newNoopSubscription(), false
 	}
+	if sub.config.LogBroadcasterPolling() {
+		return sub.createPollingSubscription(addresses, topics)
+	}
 
 	ctx, cancel := utils.ContextFromChan(sub.chStop)
 	defer cancel()
@@ -232,6 +281,46 @@ func (sub *ethSubscriber) createSubscription(addresses []common.Address, topics
 	return
 }
 
+// createPollingSubscription creates a log subscription starting at the current block, that fetches the logs
+// of new blocks with eth_getLogs instead of eth_subscribe.
+func (sub *ethSubscriber) createPollingSubscription(addresses []common.Address, topics []common.Hash) (subscr managedSubscription, abort bool) {
+	ctx, cancel := utils.ContextFromChan(sub.chStop)
+	defer cancel()
+
+	utils.RetryWithBackoff(ctx, func() (retry bool) {
+		latestBlock, err := sub.ethClient.HeadByNumber(ctx, nil)
+		if err != nil {
+			sub.logger.Errorw("Log subscriber could not fetch latest block header to start polling", "err", err)
+			return true
+		} else if latestBlock == nil {
+			sub.logger.Warn("Log subscriber got nil block header, will retry")
+			return true
+		}
+
+		sub.logger.Debugw("Polling for logs", "addresses", addresses, "topics", topics, "fromBlock", latestBlock.Number)
+		p := &pollingSubscription{
+			sub:       sub,
+			addresses: addresses,
+			topics:    topics,
+			fromBlock: latestBlock.Number,
+			chRawLogs: make(chan types.Log),
+			chErr:     make(chan error, 1),
+			chStop:    make(chan struct{}),
+		}
+		p.wgDone.Add(1)
+		go p.run()
+		subscr = p
+		return false
+	})
+	select {
+	case <-sub.chStop:
+		abort = true
+	default:
+		abort = false
+	}
+	return
+}
+
 // A managedSubscription acts as wrapper for the Subscription. Specifically, the
 // managedSubscription closes the log channel as soon as the unsubscribe request is made
 type managedSubscription interface {
@@ -270,3 +359,103 @@ func newNoopSubscription() noopSubscription {
 func (b noopSubscription) Err() <-chan error    { return nil }
 func (b noopSubscription) Logs() chan types.Log { return b.chRawLogs }
 func (b noopSubscription) Unsubscribe()         { close(b.chRawLogs) }
+
+// pollingSubscription fetches the logs of new blocks on every poll of the ethSubscriber, and otherwise at an
+// interval that halves when the chain has moved since the last poll and doubles when it has not, between
+// minPollInterval and EvmLogPollInterval.
+type pollingSubscription struct {
+	sub       *ethSubscriber
+	addresses []common.Address
+	topics    []common.Hash
+	fromBlock int64
+	chRawLogs chan types.Log
+	chErr     chan error
+	chStop    chan struct{}
+	wgDone    sync.WaitGroup
+}
+
+func (p *pollingSubscription) Err() <-chan error    { return p.chErr }
+func (p *pollingSubscription) Logs() chan types.Log { return p.chRawLogs }
+
+func (p *pollingSubscription) Unsubscribe() {
+	close(p.chStop)
+	p.wgDone.Wait() // ensure sending has stopped before closing the chan
+	close(p.chRawLogs)
+}
+
+func (p *pollingSubscription) run() {
+	defer p.wgDone.Done()
+
+	ctx, cancel := utils.ContextFromChan(p.chStop)
+	defer cancel()
+
+	maxInterval := p.sub.config.EvmLogPollInterval()
+	if maxInterval < minPollInterval {
+		maxInterval = minPollInterval
+	}
+	interval := maxInterval
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-p.chStop:
+			return
+		case <-p.sub.chPoll:
+		case <-timer.C:
+		}
+
+		moved, err := p.poll(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				p.chErr <- err
+			}
+			return
+		}
+		if moved {
+			interval /= 2
+		} else {
+			interval *= 2
+		}
+		if interval < minPollInterval {
+			interval = minPollInterval
+		} else if interval > maxInterval {
+			interval = maxInterval
+		}
+
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(interval)
+	}
+}
+
+// poll sends the logs from fromBlock to the latest block, and returns true if there were new blocks
+func (p *pollingSubscription) poll(ctxParent context.Context) (moved bool, err error) {
+	ctx, cancel := context.WithTimeout(ctxParent, time.Minute)
+	defer cancel()
+
+	latestBlock, err := p.sub.ethClient.HeadByNumber(ctx, nil)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to fetch latest block header")
+	} else if latestBlock == nil || latestBlock.Number < p.fromBlock {
+		return false, nil
+	}
+
+	logs, err := p.sub.fetchLogRange(ctx, p.addresses, p.topics, p.fromBlock, latestBlock.Number)
+	if err != nil {
+		return false, err
+	}
+	for _, log := range logs {
+		select {
+		case p.chRawLogs <- log:
+		case <-p.chStop:
+			return true, nil
+		}
+	}
+	p.fromBlock = latestBlock.Number + 1
+	return true, nil
+}
@@ -259,6 +259,60 @@ func TestBroadcaster_ReplaysLogs(t *testing.T) {
 	require.Eventually(t, func() bool { return helper.mockEth.UnsubscribeCallCount() >= 1 }, testutils.WaitTimeout(t), time.Second)
 }
 
+func TestBroadcaster_ReplayJobLogs(t *testing.T) {
+	testutils.SkipShortDB(t)
+	const (
+		blockHeight = 10
+	)
+
+	blocks := cltest.NewBlocks(t, blockHeight+1)
+	contract, err := flux_aggregator_wrapper.NewFluxAggregator(testutils.NewAddress(), nil)
+	require.NoError(t, err)
+	sentLogs := []types.Log{
+		blocks.LogOnBlockNum(3, contract.Address()),
+		blocks.LogOnBlockNum(7, contract.Address()),
+		// too young for 2 confirmations
+		blocks.LogOnBlockNum(10, contract.Address()),
+	}
+
+	mockEth := newMockEthClient(t, make(chan evmtest.RawSub[types.Log], 4), blockHeight, mockEthClientExpectedCalls{
+		FilterLogs:       3,
+		FilterLogsResult: sentLogs,
+	})
+	helper := newBroadcasterHelperWithEthClient(t, mockEth.EthClient, cltest.Head(blockHeight))
+	helper.mockEth = mockEth
+
+	listener1 := helper.newLogListenerWithJob("listener 1")
+	listener2 := helper.newLogListenerWithJob("listener 2")
+	helper.register(listener1, contract, 2)
+	helper.register(listener2, contract, 2)
+
+	helper.start()
+	defer helper.stop()
+
+	ctx := testutils.Context(t)
+
+	_, err = helper.lb.ReplayJobLogs(ctx, listener1.JobID(), 5, 2)
+	require.EqualError(t, err, "invalid block range: from 5 to 2")
+
+	_, err = helper.lb.ReplayJobLogs(ctx, listener1.JobID()+listener2.JobID(), 2, 20)
+	require.ErrorContains(t, err, "no log listeners are registered for job")
+
+	// Only the job being replayed receives the logs
+	sent, err := helper.lb.ReplayJobLogs(ctx, listener1.JobID(), 2, 20)
+	require.NoError(t, err)
+	assert.Equal(t, 2, sent)
+	assert.Equal(t, []uint64{3, 7}, listener1.getUniqueLogsBlockNumbers())
+	assert.Len(t, listener2.getUniqueLogs(), 0)
+
+	// Replaying again skips the logs the job already consumed
+	sent, err = helper.lb.ReplayJobLogs(ctx, listener1.JobID(), 2, 20)
+	require.NoError(t, err)
+	assert.Equal(t, 0, sent)
+	assert.Len(t, listener1.getUniqueLogs(), 2)
+	assert.Len(t, listener2.getUniqueLogs(), 0)
+}
+
 func TestBroadcaster_BackfillUnconsumedAfterCrash(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 	lggr := logger.TestLogger(t)
@@ -495,6 +549,56 @@ func TestBroadcaster_ShallowBackfillOnNodeStart(t *testing.T) {
 	require.Eventually(t, func() bool { return helper.mockEth.UnsubscribeCallCount() >= 1 }, testutils.WaitTimeout(t), time.Second)
 }
 
+func TestBroadcaster_Polling(t *testing.T) {
+	testutils.SkipShortDB(t)
+
+	blocks := cltest.NewBlocks(t, 15)
+	var height atomic.Int64
+	height.Store(10)
+
+	contract, err := flux_aggregator_wrapper.NewFluxAggregator(testutils.NewAddress(), nil)
+	require.NoError(t, err)
+	polledLog := blocks.LogOnBlockNum(11, contract.Address())
+
+	// no eth_subscribe calls are expected
+	ethClient := evmmocks.NewClient(t)
+	ethClient.On("ChainID", mock.Anything).Return(&cltest.FixtureChainID)
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(
+		func(context.Context, *big.Int) *evmtypes.Head { return blocks.Head(uint64(height.Load())) },
+		func(context.Context, *big.Int) error { return nil },
+	)
+	ethClient.On("FilterLogs", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, q ethereum.FilterQuery) []types.Log {
+			if q.FromBlock.Uint64() <= polledLog.BlockNumber && polledLog.BlockNumber <= q.ToBlock.Uint64() {
+				return []types.Log{polledLog}
+			}
+			return nil
+		},
+		func(context.Context, ethereum.FilterQuery) error { return nil },
+	)
+
+	helper := newBroadcasterHelperWithEthClient(t, ethClient, nil)
+	helper.globalConfig.Overrides.LogBroadcasterPolling = null.BoolFrom(true)
+
+	listener := helper.newLogListenerWithJob("polling")
+	helper.register(listener, contract, 1)
+	helper.start()
+	defer helper.stop()
+
+	require.Eventually(t, func() bool { return helper.lb.IsConnected() }, testutils.WaitTimeout(t), 100*time.Millisecond)
+
+	for _, n := range []int64{11, 12} {
+		height.Store(n)
+		(helper.lb).(httypes.HeadTrackable).OnNewLongestChain(testutils.Context(t), blocks.Head(uint64(n)))
+		time.Sleep(250 * time.Millisecond)
+	}
+
+	require.Eventually(t, func() bool { return len(listener.getUniqueLogs()) == 1 }, testutils.WaitTimeout(t), 100*time.Millisecond)
+	require.Equal(t, []types.Log{polledLog}, listener.getUniqueLogs())
+
+	helper.unsubscribeAll()
+}
+
 func TestBroadcaster_BackfillInBatches(t *testing.T) {
 	testutils.SkipShortDB(t)
 	const (
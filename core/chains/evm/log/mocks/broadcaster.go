@@ -168,6 +168,27 @@ func (_m *Broadcaster) ReplayFromBlock(number int64, forceBroadcast bool) {
 	_m.Called(number, forceBroadcast)
 }
 
+// ReplayJobLogs provides a mock function with given fields: ctx, jobID, fromBlock, toBlock
+func (_m *Broadcaster) ReplayJobLogs(ctx context.Context, jobID int32, fromBlock int64, toBlock int64) (int, error) {
+	ret := _m.Called(ctx, jobID, fromBlock, toBlock)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int64, int64) int); ok {
+		r0 = rf(ctx, jobID, fromBlock, toBlock)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int32, int64, int64) error); ok {
+		r1 = rf(ctx, jobID, fromBlock, toBlock)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Broadcaster) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -2,7 +2,11 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
 
 // Config is an autogenerated mock type for the Config type
 type Config struct {
@@ -65,6 +69,34 @@ func (_m *Config) EvmLogBackfillBatchSize() uint32 {
 	return r0
 }
 
+// EvmLogPollInterval provides a mock function with given fields:
+func (_m *Config) EvmLogPollInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// LogBroadcasterPolling provides a mock function with given fields:
+func (_m *Config) LogBroadcasterPolling() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewConfig interface {
 	mock.TestingT
 	Cleanup(func())
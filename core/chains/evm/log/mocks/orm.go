@@ -59,6 +59,65 @@ func (_m *ORM) FindBroadcasts(fromBlockNum int64, toBlockNum int64) ([]log.LogBr
 	return r0, r1
 }
 
+// FindReorgedBroadcasts provides a mock function with given fields: jobID, fromBlockNum, toBlockNum, qopts
+func (_m *ORM) FindReorgedBroadcasts(jobID int32, fromBlockNum int64, toBlockNum int64, qopts ...pg.QOpt) ([]log.LogBroadcast, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jobID, fromBlockNum, toBlockNum)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []log.LogBroadcast
+	if rf, ok := ret.Get(0).(func(int32, int64, int64, ...pg.QOpt) []log.LogBroadcast); ok {
+		r0 = rf(jobID, fromBlockNum, toBlockNum, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]log.LogBroadcast)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int32, int64, int64, ...pg.QOpt) error); ok {
+		r1 = rf(jobID, fromBlockNum, toBlockNum, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCheckpoints provides a mock function with given fields: qopts
+func (_m *ORM) GetCheckpoints(qopts ...pg.QOpt) ([]log.Checkpoint, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []log.Checkpoint
+	if rf, ok := ret.Get(0).(func(...pg.QOpt) []log.Checkpoint); ok {
+		r0 = rf(qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]log.Checkpoint)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(...pg.QOpt) error); ok {
+		r1 = rf(qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPendingMinBlock provides a mock function with given fields: qopts
 func (_m *ORM) GetPendingMinBlock(qopts ...pg.QOpt) (*int64, error) {
 	_va := make([]interface{}, len(qopts))
@@ -130,6 +189,27 @@ func (_m *ORM) MarkBroadcastsConsumed(blockHashes []common.Hash, blockNumbers []
 	return r0
 }
 
+// MarkBroadcastsReorged provides a mock function with given fields: blockHashes, qopts
+func (_m *ORM) MarkBroadcastsReorged(blockHashes []common.Hash, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, blockHashes)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]common.Hash, ...pg.QOpt) error); ok {
+		r0 = rf(blockHashes, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // MarkBroadcastsUnconsumed provides a mock function with given fields: fromBlock, qopts
 func (_m *ORM) MarkBroadcastsUnconsumed(fromBlock int64, qopts ...pg.QOpt) error {
 	_va := make([]interface{}, len(qopts))
@@ -180,6 +260,27 @@ func (_m *ORM) Reinitialize(qopts ...pg.QOpt) (*int64, error) {
 	return r0, r1
 }
 
+// SetCheckpoints provides a mock function with given fields: checkpoints, qopts
+func (_m *ORM) SetCheckpoints(checkpoints []log.Checkpoint, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, checkpoints)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]log.Checkpoint, ...pg.QOpt) error); ok {
+		r0 = rf(checkpoints, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetPendingMinBlock provides a mock function with given fields: blockNum, qopts
 func (_m *ORM) SetPendingMinBlock(blockNum *int64, qopts ...pg.QOpt) error {
 	_va := make([]interface{}, len(qopts))
@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/sqlx"
@@ -23,6 +24,9 @@ import (
 //  - Pending broadcast block numbers are synced to the min from the pool (or deleted when empty)
 //  - On reboot, backfill considers the min block number from unconsumed and pending broadcasts. Additionally, unconsumed
 //    entries are removed and the pending broadcasts number updated.
+//  - When a block is orphaned by a reorg, its consumed broadcasts are marked unconsumed and reorged, so that listeners
+//    can detect that a log they handled was replaced, and its unconsumed broadcasts are removed.
+//  - Checkpoints record the highest block fully sent to each listener, so that backfill on reboot can start after them.
 //
 type ORM interface {
 	// FindBroadcasts returns broadcasts for a range of block numbers, both consumed and unconsumed.
@@ -38,6 +42,11 @@ type ORM interface {
 	// MarkBroadcastsUnconsumed marks all log broadcasts from all jobs on or after fromBlock as
 	// unconsumed.
 	MarkBroadcastsUnconsumed(fromBlock int64, qopts ...pg.QOpt) error
+	// MarkBroadcastsReorged invalidates the log broadcasts of blocks orphaned by a reorg.
+	MarkBroadcastsReorged(blockHashes []common.Hash, qopts ...pg.QOpt) error
+	// FindReorgedBroadcasts returns the broadcasts jobID had consumed in blocks orphaned by a reorg,
+	// for a range of block numbers.
+	FindReorgedBroadcasts(jobID int32, fromBlockNum int64, toBlockNum int64, qopts ...pg.QOpt) ([]LogBroadcast, error)
 
 	// SetPendingMinBlock sets the minimum block number for which there are pending broadcasts in the pool, or nil if empty.
 	SetPendingMinBlock(blockNum *int64, qopts ...pg.QOpt) error
@@ -47,6 +56,11 @@ type ORM interface {
 	// Reinitialize cleans up the database by removing any unconsumed broadcasts, then updating (if necessary) and
 	// returning the pending minimum block number.
 	Reinitialize(qopts ...pg.QOpt) (blockNumber *int64, err error)
+
+	// SetCheckpoints saves the highest block fully processed by each listener.
+	SetCheckpoints(checkpoints []Checkpoint, qopts ...pg.QOpt) error
+	// GetCheckpoints returns the checkpoints saved by SetCheckpoints.
+	GetCheckpoints(qopts ...pg.QOpt) ([]Checkpoint, error)
 }
 
 type orm struct {
@@ -112,7 +126,7 @@ func (o *orm) MarkBroadcastConsumed(blockHash common.Hash, blockNumber uint64, l
         INSERT INTO log_broadcasts (block_hash, block_number, log_index, job_id, created_at, updated_at, consumed, evm_chain_id)
 		VALUES ($1, $2, $3, $4, NOW(), NOW(), true, $5)
 		ON CONFLICT (job_id, block_hash, log_index, evm_chain_id) DO UPDATE
-		SET consumed = true, updated_at = NOW()
+		SET consumed = true, reorged_at = NULL, updated_at = NOW()
     `, blockHash, blockNumber, logIndex, jobID, o.evmChainID)
 	return errors.Wrap(err, "failed to mark log broadcast as consumed")
 }
@@ -138,7 +152,7 @@ func (o *orm) MarkBroadcastsConsumed(blockHashes []common.Hash, blockNumbers []u
 INSERT INTO log_broadcasts (block_hash, block_number, log_index, job_id, created_at, updated_at, consumed, evm_chain_id)
 VALUES (:blockHash, :blockNumber, :logIndex, :jobID, NOW(), NOW(), true, :chainID)
 ON CONFLICT (job_id, block_hash, log_index, evm_chain_id) DO UPDATE
-SET consumed = true, updated_at = NOW();
+SET consumed = true, reorged_at = NULL, updated_at = NOW();
 	`
 	for i := range blockHashes {
 		inputs[i] = input{
@@ -166,6 +180,54 @@ func (o *orm) MarkBroadcastsUnconsumed(fromBlock int64, qopts ...pg.QOpt) error
 	return errors.Wrap(err, "failed to mark broadcasts unconsumed")
 }
 
+// MarkBroadcastsReorged implements the ORM interface.
+func (o *orm) MarkBroadcastsReorged(blockHashes []common.Hash, qopts ...pg.QOpt) error {
+	if len(blockHashes) == 0 {
+		return nil
+	}
+	hashes := make(pq.ByteaArray, len(blockHashes))
+	for i, h := range blockHashes {
+		hashes[i] = h.Bytes()
+	}
+	q := o.q.WithOpts(qopts...)
+	err := q.Transaction(func(tx pg.Queryer) error {
+		_, err := tx.Exec(`
+			DELETE FROM log_broadcasts
+			WHERE evm_chain_id = $1
+			AND block_hash = ANY($2)
+			AND consumed = false
+			AND reorged_at IS NULL
+		`, o.evmChainID, hashes)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(`
+			UPDATE log_broadcasts
+			SET consumed = false, reorged_at = NOW(), updated_at = NOW()
+			WHERE evm_chain_id = $1
+			AND block_hash = ANY($2)
+			AND consumed = true
+		`, o.evmChainID, hashes)
+		return err
+	})
+	return errors.Wrap(err, "failed to mark broadcasts reorged")
+}
+
+// FindReorgedBroadcasts implements the ORM interface.
+func (o *orm) FindReorgedBroadcasts(jobID int32, fromBlockNum int64, toBlockNum int64, qopts ...pg.QOpt) ([]LogBroadcast, error) {
+	var broadcasts []LogBroadcast
+	q := o.q.WithOpts(qopts...)
+	err := q.Select(&broadcasts, `
+		SELECT block_hash, consumed, log_index, job_id FROM log_broadcasts
+		WHERE job_id = $1
+		AND block_number >= $2
+		AND block_number <= $3
+		AND evm_chain_id = $4
+		AND reorged_at IS NOT NULL
+	`, jobID, fromBlockNum, toBlockNum, o.evmChainID)
+	return broadcasts, errors.Wrap(err, "failed to find reorged log broadcasts")
+}
+
 func (o *orm) Reinitialize(qopts ...pg.QOpt) (*int64, error) {
 	// Minimum block number from the set of unconsumed logs, which we'll remove later.
 	minUnconsumed, err := o.getUnconsumedMinBlock(qopts...)
@@ -219,6 +281,37 @@ func (o *orm) GetPendingMinBlock(qopts ...pg.QOpt) (*int64, error) {
 	return blockNumber, nil
 }
 
+func (o *orm) SetCheckpoints(checkpoints []Checkpoint, qopts ...pg.QOpt) error {
+	if len(checkpoints) == 0 {
+		return nil
+	}
+	type input struct {
+		Checkpoint
+		ChainID utils.Big `db:"chainID"`
+	}
+	inputs := make([]input, len(checkpoints))
+	for i, c := range checkpoints {
+		inputs[i] = input{c, o.evmChainID}
+	}
+	q := o.q.WithOpts(qopts...)
+	_, err := q.NamedExec(`
+INSERT INTO log_broadcasts_checkpoints (evm_chain_id, job_id, address, block_number, updated_at)
+VALUES (:chainID, :job_id, :address, :block_number, NOW())
+ON CONFLICT (evm_chain_id, job_id, address) DO UPDATE
+SET block_number = EXCLUDED.block_number, updated_at = NOW();
+	`, inputs)
+	return errors.Wrap(err, "failed to set log broadcast checkpoints")
+}
+
+func (o *orm) GetCheckpoints(qopts ...pg.QOpt) ([]Checkpoint, error) {
+	q := o.q.WithOpts(qopts...)
+	var checkpoints []Checkpoint
+	err := q.Select(&checkpoints, `
+        SELECT job_id, address, block_number FROM log_broadcasts_checkpoints WHERE evm_chain_id = $1
+    `, o.evmChainID)
+	return checkpoints, errors.Wrap(err, "failed to get log broadcast checkpoints")
+}
+
 func (o *orm) getUnconsumedMinBlock(qopts ...pg.QOpt) (*int64, error) {
 	q := o.q.WithOpts(qopts...)
 	var blockNumber *int64
@@ -227,6 +320,7 @@ func (o *orm) getUnconsumedMinBlock(qopts ...pg.QOpt) (*int64, error) {
 			WHERE evm_chain_id = $1
 			AND consumed = false
 			AND block_number IS NOT NULL
+			AND reorged_at IS NULL
     `, o.evmChainID)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
@@ -243,6 +337,7 @@ func (o *orm) removeUnconsumed(qopts ...pg.QOpt) error {
 			WHERE evm_chain_id = $1
 			AND consumed = false
 			AND block_number IS NOT NULL
+			AND reorged_at IS NULL
     `, o.evmChainID)
 	return errors.Wrap(err, "failed to delete unconsumed broadcasts")
 }
@@ -263,6 +358,13 @@ func (b LogBroadcast) AsKey() LogBroadcastAsKey {
 	}
 }
 
+// Checkpoint - data from log_broadcasts_checkpoints table columns
+type Checkpoint struct {
+	JobID       int32          `db:"job_id"`
+	Address     common.Address `db:"address"`
+	BlockNumber int64          `db:"block_number"`
+}
+
 // LogBroadcastAsKey - used as key in a map to filter out already consumed logs
 type LogBroadcastAsKey struct {
 	BlockHash common.Hash
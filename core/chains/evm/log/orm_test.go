@@ -12,6 +12,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
 )
@@ -156,6 +157,37 @@ func TestORM_pending(t *testing.T) {
 	require.Nil(t, num)
 }
 
+func TestORM_checkpoints(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	lggr := logger.TestLogger(t)
+	orm := log.NewORM(db, lggr, cfg, cltest.FixtureChainID)
+
+	checkpoints, err := orm.GetCheckpoints()
+	require.NoError(t, err)
+	require.Empty(t, checkpoints)
+	require.NoError(t, orm.SetCheckpoints(nil))
+
+	addr := testutils.NewAddress()
+	require.NoError(t, orm.SetCheckpoints([]log.Checkpoint{
+		{JobID: 1, Address: addr, BlockNumber: 10},
+		{JobID: 2, Address: addr, BlockNumber: 20},
+	}))
+	require.NoError(t, orm.SetCheckpoints([]log.Checkpoint{{JobID: 1, Address: addr, BlockNumber: 11}}))
+
+	checkpoints, err = orm.GetCheckpoints()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []log.Checkpoint{
+		{JobID: 1, Address: addr, BlockNumber: 11},
+		{JobID: 2, Address: addr, BlockNumber: 20},
+	}, checkpoints)
+
+	otherORM := log.NewORM(db, lggr, cfg, *testutils.SimulatedChainID)
+	checkpoints, err = otherORM.GetCheckpoints()
+	require.NoError(t, err)
+	require.Empty(t, checkpoints)
+}
+
 func TestORM_MarkUnconsumed(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 	cfg := cltest.NewTestGeneralConfig(t)
@@ -207,6 +239,68 @@ func TestORM_MarkUnconsumed(t *testing.T) {
 	require.False(t, consumed)
 }
 
+func TestORM_MarkBroadcastsReorged(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	lggr := logger.TestLogger(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+
+	orm := log.NewORM(db, lggr, cfg, cltest.FixtureChainID)
+
+	_, addr := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+	job := cltest.MustInsertV2JobSpec(t, db, addr)
+
+	logConsumed := cltest.RandomLog(t)
+	logConsumed.BlockNumber = 40
+	require.NoError(t,
+		orm.MarkBroadcastConsumed(logConsumed.BlockHash, logConsumed.BlockNumber, logConsumed.Index, job.ID))
+
+	logUnconsumed := cltest.RandomLog(t)
+	logUnconsumed.BlockNumber = 41
+	require.NoError(t,
+		orm.CreateBroadcast(logUnconsumed.BlockHash, logUnconsumed.BlockNumber, logUnconsumed.Index, job.ID))
+
+	logCanonical := cltest.RandomLog(t)
+	logCanonical.BlockNumber = 39
+	require.NoError(t,
+		orm.MarkBroadcastConsumed(logCanonical.BlockHash, logCanonical.BlockNumber, logCanonical.Index, job.ID))
+
+	require.NoError(t, orm.MarkBroadcastsReorged([]common.Hash{logConsumed.BlockHash, logUnconsumed.BlockHash}))
+
+	consumed, err := orm.WasBroadcastConsumed(logConsumed.BlockHash, logConsumed.Index, job.ID)
+	require.NoError(t, err)
+	require.False(t, consumed)
+	consumed, err = orm.WasBroadcastConsumed(logCanonical.BlockHash, logCanonical.Index, job.ID)
+	require.NoError(t, err)
+	require.True(t, consumed)
+
+	broadcasts, err := orm.FindBroadcasts(39, 41)
+	require.NoError(t, err)
+	require.Len(t, broadcasts, 2, "the unconsumed broadcast of the orphaned block should be removed")
+
+	reorged, err := orm.FindReorgedBroadcasts(job.ID, 0, 100)
+	require.NoError(t, err)
+	require.Equal(t, []log.LogBroadcast{{BlockHash: logConsumed.BlockHash, Consumed: false, LogIndex: logConsumed.Index, JobID: job.ID}}, reorged)
+	reorged, err = orm.FindReorgedBroadcasts(job.ID, 41, 100)
+	require.NoError(t, err)
+	require.Empty(t, reorged)
+
+	// reorged broadcasts are kept by Reinitialize
+	blockNumber, err := orm.Reinitialize()
+	require.NoError(t, err)
+	require.Nil(t, blockNumber)
+	reorged, err = orm.FindReorgedBroadcasts(job.ID, 0, 100)
+	require.NoError(t, err)
+	require.Len(t, reorged, 1)
+
+	// consuming the log again, after a reorg back to its block, clears the mark
+	require.NoError(t,
+		orm.MarkBroadcastConsumed(logConsumed.BlockHash, logConsumed.BlockNumber, logConsumed.Index, job.ID))
+	reorged, err = orm.FindReorgedBroadcasts(job.ID, 0, 100)
+	require.NoError(t, err)
+	require.Empty(t, reorged)
+}
+
 func TestORM_Reinitialize(t *testing.T) {
 	type TestLogBroadcast struct {
 		BlockNumber big.Int
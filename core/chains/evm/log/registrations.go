@@ -103,6 +103,16 @@ func (r *registrations) addSubscriber(sub *subscriber) (needsResubscribe bool) {
 	return
 }
 
+// subscribersForJob returns all registered subscribers belonging to jobID
+func (r *registrations) subscribersForJob(jobID int32) (subs []*subscriber) {
+	for sub := range r.registeredSubs {
+		if sub.listener.JobID() == jobID {
+			subs = append(subs, sub)
+		}
+	}
+	return
+}
+
 // handlersWithGreaterConfs allows for an optimisation - in the case that we
 // are already listening on this topic for a handler with a GREATER
 // MinIncomingConfirmations, it is not necessary to subscribe again
@@ -215,6 +225,47 @@ func (r *registrations) isAddressRegistered(address common.Address) bool {
 	return false
 }
 
+// checkpoints returns, for every subscriber, the highest block whose logs were all sent to it once
+// latestBlockNumber was processed. One block of margin is kept for logs received after their head.
+func (r *registrations) checkpoints(latestBlockNumber int64) (checkpoints []Checkpoint) {
+	for sub := range r.registeredSubs {
+		numConfirmations := int64(sub.opts.MinIncomingConfirmations)
+		if numConfirmations == 0 {
+			numConfirmations = 1
+		}
+		blockNumber := latestBlockNumber - numConfirmations
+		if blockNumber < 0 {
+			continue
+		}
+		checkpoints = append(checkpoints, Checkpoint{sub.listener.JobID(), sub.opts.Contract, blockNumber})
+	}
+	return
+}
+
+// backfillStart returns the block after the lowest checkpoint of the subscribers, or nil if there
+// are no subscribers or any of them has no checkpoint.
+func (r *registrations) backfillStart(checkpoints []Checkpoint) *int64 {
+	type key struct {
+		jobID   int32
+		address common.Address
+	}
+	saved := make(map[key]int64, len(checkpoints))
+	for _, c := range checkpoints {
+		saved[key{c.JobID, c.Address}] = c.BlockNumber
+	}
+	var start *int64
+	for sub := range r.registeredSubs {
+		blockNumber, exists := saved[key{sub.listener.JobID(), sub.opts.Contract}]
+		if !exists {
+			return nil
+		}
+		if next := blockNumber + 1; start == nil || next < *start {
+			start = &next
+		}
+	}
+	return start
+}
+
 func (r *registrations) sendLogs(logsToSend []logsOnBlock, latestHead evmtypes.Head, broadcasts []LogBroadcast, bc broadcastCreator) {
 	broadcastsExisting := make(map[LogBroadcastAsKey]bool)
 	for _, b := range broadcasts {
@@ -388,7 +439,7 @@ type broadcastCreator interface {
 func (r *handler) sendLog(log types.Log, latestHead evmtypes.Head,
 	broadcasts map[LogBroadcastAsKey]bool,
 	bc broadcastCreator,
-	logger logger.Logger) {
+	logger logger.Logger) (sent int) {
 
 	topic := log.Topics[0]
 
@@ -439,6 +490,7 @@ func (r *handler) sendLog(log types.Log, latestHead evmtypes.Head,
 		// must copy function pointer here since range pointer (sub) may not be
 		// used in goroutine below
 		handleLog := sub.listener.HandleLog
+		sent++
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
@@ -456,4 +508,5 @@ func (r *handler) sendLog(log types.Log, latestHead evmtypes.Head,
 		}()
 	}
 	wg.Wait()
+	return
 }
@@ -249,3 +249,30 @@ func TestUnit_Registrations_addSubscriber_removeSubscriber(t *testing.T) {
 		assert.Len(t, r.registeredSubs, 0)
 	})
 }
+
+func TestUnit_Registrations_checkpoints(t *testing.T) {
+	r := newTestRegistrations(t)
+
+	logsWithTopics := map[common.Hash][][]Topic{utils.NewHash(): nil}
+	sub1 := &subscriber{newTestListener(t, 1), ListenerOpts{Contract: testutils.NewAddress(), LogsWithTopics: logsWithTopics, MinIncomingConfirmations: 1}}
+	sub2 := &subscriber{newTestListener(t, 2), ListenerOpts{Contract: testutils.NewAddress(), LogsWithTopics: logsWithTopics, MinIncomingConfirmations: 10}}
+
+	assert.Nil(t, r.backfillStart(nil))
+
+	r.addSubscriber(sub1)
+	r.addSubscriber(sub2)
+
+	assert.Len(t, r.checkpoints(5), 1)
+	checkpoints := r.checkpoints(100)
+	assert.ElementsMatch(t, []Checkpoint{
+		{JobID: 1, Address: sub1.opts.Contract, BlockNumber: 99},
+		{JobID: 2, Address: sub2.opts.Contract, BlockNumber: 90},
+	}, checkpoints)
+
+	start := r.backfillStart(checkpoints)
+	require.NotNil(t, start)
+	assert.Equal(t, int64(91), *start)
+
+	// a subscriber without a checkpoint falls back to the default backfill
+	assert.Nil(t, r.backfillStart(checkpoints[:1]))
+}
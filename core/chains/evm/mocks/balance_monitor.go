@@ -10,6 +10,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	monitor "github.com/smartcontractkit/chainlink/core/chains/evm/monitor"
+
 	types "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 )
 
@@ -62,6 +64,11 @@ func (_m *BalanceMonitor) Healthy() error {
 	return r0
 }
 
+// OnFundingRequired provides a mock function with given fields: fn
+func (_m *BalanceMonitor) OnFundingRequired(fn monitor.FundingFunc) {
+	_m.Called(fn)
+}
+
 // OnNewLongestChain provides a mock function with given fields: ctx, head
 func (_m *BalanceMonitor) OnNewLongestChain(ctx context.Context, head *types.Head) {
 	_m.Called(ctx, head)
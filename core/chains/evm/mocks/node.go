@@ -5,6 +5,8 @@ package mocks
 import (
 	big "math/big"
 
+	time "time"
+
 	common "github.com/ethereum/go-ethereum/common"
 	client "github.com/smartcontractkit/chainlink/core/chains/evm/client"
 
@@ -430,6 +432,27 @@ func (_m *Node) State() client.NodeState {
 	return r0
 }
 
+// StateAndLatency provides a mock function with given fields:
+func (_m *Node) StateAndLatency() (client.NodeState, time.Duration) {
+	ret := _m.Called()
+
+	var r0 client.NodeState
+	if rf, ok := ret.Get(0).(func() client.NodeState); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(client.NodeState)
+	}
+
+	var r1 time.Duration
+	if rf, ok := ret.Get(1).(func() time.Duration); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(time.Duration)
+	}
+
+	return r0, r1
+}
+
 // StateAndLatestBlockNumber provides a mock function with given fields:
 func (_m *Node) StateAndLatestBlockNumber() (client.NodeState, int64) {
 	ret := _m.Called()
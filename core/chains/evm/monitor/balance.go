@@ -9,9 +9,12 @@ import (
 	"time"
 
 	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	uuid "github.com/satori/go.uuid"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
@@ -30,36 +33,65 @@ type (
 	BalanceMonitor interface {
 		httypes.HeadTrackable
 		GetEthBalance(gethCommon.Address) *assets.Eth
+		// OnFundingRequired registers fn to be called when the balance of a
+		// key with a funding job drops to one of its funding thresholds
+		OnFundingRequired(fn FundingFunc)
 		services.ServiceCtx
 	}
 
+	// FundingRequest describes a key whose balance dropped to one of its
+	// funding thresholds. It is the request body of the funding job run.
+	FundingRequest struct {
+		Address         gethCommon.Address   `json:"address"`
+		EVMChainID      *utils.Big           `json:"evmChainID"`
+		BalanceWei      *utils.Big           `json:"balanceWei"`
+		Status          ethkey.FundingStatus `json:"status"`
+		LowBalance      *utils.Big           `json:"lowBalanceWei"`
+		CriticalBalance *utils.Big           `json:"criticalBalanceWei"`
+		FundingJobID    uuid.UUID            `json:"-"`
+	}
+
+	FundingFunc func(ctx context.Context, req FundingRequest)
+
 	balanceMonitor struct {
 		utils.StartStopOnce
-		logger         logger.Logger
-		ethClient      evmclient.Client
-		chainID        *big.Int
-		chainIDStr     string
-		ethKeyStore    keystore.Eth
-		ethBalances    map[gethCommon.Address]*assets.Eth
-		ethBalancesMtx *sync.RWMutex
-		sleeperTask    utils.SleeperTask
+		logger          logger.Logger
+		ethClient       evmclient.Client
+		rpcBatchSize    uint32
+		chainID         *big.Int
+		chainIDStr      string
+		ethKeyStore     keystore.Eth
+		ethBalances     map[gethCommon.Address]*assets.Eth
+		fundingStatuses map[gethCommon.Address]ethkey.FundingStatus
+		ethBalancesMtx  *sync.RWMutex
+		sleeperTask     utils.SleeperTask
+		fundingFunc     FundingFunc
+		orm             ORM
+		lastSamples     map[gethCommon.Address]time.Time
 	}
 
 	NullBalanceMonitor struct{}
 )
 
-// NewBalanceMonitor returns a new balanceMonitor
-func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, logger logger.Logger) BalanceMonitor {
+// NewBalanceMonitor returns a new balanceMonitor. Balances are fetched with
+// batched eth_getBalance calls of up to rpcBatchSize keys, or of all keys if
+// it is zero, and sampled into orm every BalanceSampleInterval.
+func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, orm ORM, logger logger.Logger, rpcBatchSize uint32) BalanceMonitor {
 	bm := &balanceMonitor{
 		utils.StartStopOnce{},
 		logger,
 		ethClient,
+		rpcBatchSize,
 		ethClient.ChainID(),
 		ethClient.ChainID().String(),
 		ethKeyStore,
 		make(map[gethCommon.Address]*assets.Eth),
+		make(map[gethCommon.Address]ethkey.FundingStatus),
 		new(sync.RWMutex),
 		nil,
+		nil,
+		orm,
+		make(map[gethCommon.Address]time.Time),
 	}
 	bm.sleeperTask = utils.NewSleeperTask(&worker{bm: bm})
 	return bm
@@ -110,8 +142,18 @@ func (bm *balanceMonitor) updateBalance(ethBal assets.Eth, address gethCommon.Ad
 	bm.ethBalancesMtx.Lock()
 	oldBal := bm.ethBalances[address]
 	bm.ethBalances[address] = &ethBal
+	sample := time.Since(bm.lastSamples[address]) >= BalanceSampleInterval
+	if sample {
+		bm.lastSamples[address] = time.Now()
+	}
 	bm.ethBalancesMtx.Unlock()
 
+	if sample && bm.orm != nil {
+		if err := bm.orm.InsertBalanceSample(bm.chainID, address, ethBal); err != nil {
+			bm.logger.Errorw("BalanceMonitor: failed to store balance sample", "address", address.Hex(), "error", err)
+		}
+	}
+
 	lgr := bm.logger.Named("balance_log").With(
 		"address", address.Hex(),
 		"ethBalance", ethBal.String(),
@@ -127,12 +169,70 @@ func (bm *balanceMonitor) updateBalance(ethBal assets.Eth, address gethCommon.Ad
 	}
 }
 
+// BalanceSampleInterval is the interval between the samples of the balance
+// of a key stored for its activity history
+const BalanceSampleInterval = time.Hour
+
 func (bm *balanceMonitor) GetEthBalance(address gethCommon.Address) *assets.Eth {
 	bm.ethBalancesMtx.RLock()
 	defer bm.ethBalancesMtx.RUnlock()
 	return bm.ethBalances[address]
 }
 
+func (bm *balanceMonitor) OnFundingRequired(fn FundingFunc) {
+	bm.ethBalancesMtx.Lock()
+	defer bm.ethBalancesMtx.Unlock()
+	bm.fundingFunc = fn
+}
+
+// Funding jobs may call bridges and send transactions, so they are given
+// longer than a balance fetch
+const fundingRunTimeout = time.Minute
+
+// checkFunding compares the balance against the key's funding thresholds.
+// The funding job is only run when the status gets worse, so that a top up
+// in flight is not requested again on every head.
+func (bm *balanceMonitor) checkFunding(ethBal assets.Eth, state ethkey.State) {
+	address := state.Address.Address()
+	status := state.Status(ethBal.ToInt())
+	if status != ethkey.FundingStatusUnknown {
+		promFundingStatus.WithLabelValues(address.Hex(), bm.chainIDStr).Set(float64(status.Severity()))
+	}
+
+	bm.ethBalancesMtx.Lock()
+	oldStatus := bm.fundingStatuses[address]
+	bm.fundingStatuses[address] = status
+	fn := bm.fundingFunc
+	bm.ethBalancesMtx.Unlock()
+
+	if status.Severity() <= oldStatus.Severity() || status.Severity() <= ethkey.FundingStatusOK.Severity() {
+		return
+	}
+
+	lgr := bm.logger.With("address", address.Hex(), "ethBalance", ethBal.String(), "status", status)
+	if status == ethkey.FundingStatusCritical {
+		lgr.Errorf("ETH balance for %s is critically low", address.Hex())
+	} else {
+		lgr.Warnf("ETH balance for %s is low", address.Hex())
+	}
+
+	if !state.FundingJobID.Valid || fn == nil {
+		return
+	}
+	lgr.Infow("Running funding job", "fundingJobID", state.FundingJobID.UUID)
+	ctx, cancel := context.WithTimeout(context.Background(), fundingRunTimeout)
+	defer cancel()
+	fn(ctx, FundingRequest{
+		Address:         address,
+		EVMChainID:      utils.NewBig(bm.chainID),
+		BalanceWei:      utils.NewBig(ethBal.ToInt()),
+		Status:          status,
+		LowBalance:      state.LowBalance,
+		CriticalBalance: state.CriticalBalance,
+		FundingJobID:    state.FundingJobID.UUID,
+	})
+}
+
 var promETHBalance = promauto.NewGaugeVec(
 	prometheus.GaugeOpts{
 		Name: "eth_balance",
@@ -141,6 +241,14 @@ var promETHBalance = promauto.NewGaugeVec(
 	[]string{"account", "evmChainID"},
 )
 
+var promFundingStatus = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "eth_balance_funding_status",
+		Help: "Funding status of each Ethereum account with funding thresholds: 1 ok, 2 low, 3 critical",
+	},
+	[]string{"account", "evmChainID"},
+)
+
 func (bm *balanceMonitor) promUpdateEthBalance(balance *assets.Eth, from gethCommon.Address) {
 	balanceFloat, err := ApproximateFloat64(balance)
 
@@ -170,47 +278,82 @@ func (w *worker) WorkCtx(ctx context.Context) {
 	if err != nil {
 		w.bm.logger.Error("BalanceMonitor: error getting keys", err)
 	}
+	states, err := w.bm.ethKeyStore.GetStatesForChain(w.bm.chainID)
+	if err != nil {
+		w.bm.logger.Error("BalanceMonitor: error getting key states", err)
+	}
+	statesByAddress := make(map[gethCommon.Address]ethkey.State, len(states))
+	for _, state := range states {
+		statesByAddress[state.Address.Address()] = state
+	}
 
-	var wg sync.WaitGroup
-
-	wg.Add(len(keys))
-	for _, key := range keys {
-		go func(k ethkey.KeyV2) {
-			defer wg.Done()
-			w.checkAccountBalance(ctx, k)
-		}(key)
+	for i, bal := range w.checkAccountBalances(ctx, keys) {
+		if bal == nil {
+			continue
+		}
+		if state := statesByAddress[keys[i].Address]; state.FundingThresholds.IsSet() {
+			w.bm.checkFunding(*bal, state)
+		}
 	}
-	wg.Wait()
 }
 
 // Approximately ETH block time
 const ethFetchTimeout = 15 * time.Second
 
-func (w *worker) checkAccountBalance(ctx context.Context, k ethkey.KeyV2) {
+// checkAccountBalances fetches and updates the balances of keys, returning
+// them in the same order. Balances that could not be fetched are nil.
+func (w *worker) checkAccountBalances(ctx context.Context, keys []ethkey.KeyV2) []*assets.Eth {
 	ctx, cancel := context.WithTimeout(ctx, ethFetchTimeout)
 	defer cancel()
 
-	bal, err := w.bm.ethClient.BalanceAt(ctx, k.Address, nil)
-	if err != nil {
-		w.bm.logger.Errorw(fmt.Sprintf("BalanceMonitor: error getting balance for key %s", k.Address.Hex()),
-			"error", err,
-			"address", k.Address,
-		)
-	} else if bal == nil {
-		w.bm.logger.Errorw(fmt.Sprintf("BalanceMonitor: error getting balance for key %s: invariant violation, bal may not be nil", k.Address.Hex()),
-			"error", err,
-			"address", k.Address,
-		)
-	} else {
-		ethBal := assets.Eth(*bal)
+	reqs := make([]rpc.BatchElem, len(keys))
+	for i, k := range keys {
+		reqs[i] = rpc.BatchElem{
+			Method: "eth_getBalance",
+			Args:   []interface{}{k.Address, "latest"},
+			Result: new(hexutil.Big),
+		}
+	}
+
+	batchSize := int(w.bm.rpcBatchSize)
+	if batchSize == 0 {
+		batchSize = len(reqs)
+	}
+	for i := 0; i < len(reqs); i += batchSize {
+		j := i + batchSize
+		if j > len(reqs) {
+			j = len(reqs)
+		}
+		if err := w.bm.ethClient.BatchCallContext(ctx, reqs[i:j]); err != nil {
+			for k := i; k < j; k++ {
+				reqs[k].Error = err
+			}
+		}
+	}
+
+	balances := make([]*assets.Eth, len(keys))
+	for i, req := range reqs {
+		k := keys[i]
+		if req.Error != nil {
+			w.bm.logger.Errorw(fmt.Sprintf("BalanceMonitor: error getting balance for key %s", k.Address.Hex()),
+				"error", req.Error,
+				"address", k.Address,
+			)
+			continue
+		}
+		ethBal := assets.Eth(*req.Result.(*hexutil.Big))
 		w.bm.updateBalance(ethBal, k.Address)
+		balances[i] = &ethBal
 	}
+	return balances
 }
 
 func (*NullBalanceMonitor) GetEthBalance(gethCommon.Address) *assets.Eth {
 	return nil
 }
 
+func (*NullBalanceMonitor) OnFundingRequired(FundingFunc) {}
+
 // Start does noop for NullBalanceMonitor.
 func (*NullBalanceMonitor) Start(context.Context) error                                { return nil }
 func (*NullBalanceMonitor) Close() error                                               { return nil }
@@ -6,8 +6,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/onsi/gomega"
 	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -20,16 +24,37 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
-var nilBigInt *big.Int
-
 func newEthClientMock(t *testing.T) *evmmocks.Client {
 	mockEth := evmmocks.NewClient(t)
 	mockEth.On("ChainID").Maybe().Return(big.NewInt(0))
 	return mockEth
 }
 
+// mockBalances expects one batched call getting the balances of the given
+// addresses, in any order.
+func mockBalances(ethClient *evmmocks.Client, balances map[common.Address]*big.Int) *mock.Call {
+	return ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+		if len(b) != len(balances) {
+			return false
+		}
+		for _, elem := range b {
+			address, ok := elem.Args[0].(common.Address)
+			if elem.Method != "eth_getBalance" || !ok || balances[address] == nil || elem.Args[1] != "latest" {
+				return false
+			}
+		}
+		return true
+	})).Once().Return(nil).Run(func(args mock.Arguments) {
+		for _, elem := range args.Get(1).([]rpc.BatchElem) {
+			*elem.Result.(*hexutil.Big) = hexutil.Big(*balances[elem.Args[0].(common.Address)])
+		}
+	})
+}
+
 func TestBalanceMonitor_Start(t *testing.T) {
 	t.Parallel()
 
@@ -42,7 +67,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 		_, k0Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 		_, k1Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.TestLogger(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, monitor.NewORM(db, logger.TestLogger(t), cfg), logger.TestLogger(t), 0)
 		defer bm.Close()
 
 		k0bal := big.NewInt(42)
@@ -50,8 +75,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 		assert.Nil(t, bm.GetEthBalance(k0Addr))
 		assert.Nil(t, bm.GetEthBalance(k1Addr))
 
-		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(k0bal, nil)
-		ethClient.On("BalanceAt", mock.Anything, k1Addr, nilBigInt).Once().Return(k1bal, nil)
+		mockBalances(ethClient, map[common.Address]*big.Int{k0Addr: k0bal, k1Addr: k1bal})
 
 		assert.NoError(t, bm.Start(testutils.Context(t)))
 
@@ -70,11 +94,11 @@ func TestBalanceMonitor_Start(t *testing.T) {
 
 		_, k0Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.TestLogger(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, monitor.NewORM(db, logger.TestLogger(t), cfg), logger.TestLogger(t), 0)
 		defer bm.Close()
 		k0bal := big.NewInt(42)
 
-		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(k0bal, nil)
+		mockBalances(ethClient, map[common.Address]*big.Int{k0Addr: k0bal})
 
 		assert.NoError(t, bm.Start(testutils.Context(t)))
 
@@ -90,18 +114,20 @@ func TestBalanceMonitor_Start(t *testing.T) {
 
 		_, k0Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.TestLogger(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, monitor.NewORM(db, logger.TestLogger(t), cfg), logger.TestLogger(t), 0)
 		defer bm.Close()
 		ctxCancelledAwaiter := cltest.NewAwaiter()
 
-		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Run(func(args mock.Arguments) {
+		ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+			return len(b) == 1 && b[0].Args[0] == k0Addr
+		})).Once().Run(func(args mock.Arguments) {
 			ctx := args.Get(0).(context.Context)
 			select {
 			case <-time.After(testutils.WaitTimeout(t)):
 			case <-ctx.Done():
 				ctxCancelledAwaiter.ItHappened()
 			}
-		}).Return(nil, nil)
+		}).Return(context.Canceled)
 
 		ctx, cancel := context.WithCancel(testutils.Context(t))
 		go func() {
@@ -120,12 +146,12 @@ func TestBalanceMonitor_Start(t *testing.T) {
 
 		_, k0Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.TestLogger(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, monitor.NewORM(db, logger.TestLogger(t), cfg), logger.TestLogger(t), 0)
 		defer bm.Close()
 
-		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).
+		ethClient.On("BatchCallContext", mock.Anything, mock.Anything).
 			Once().
-			Return(nil, errors.New("a little easter egg for the 4chan link marines error"))
+			Return(errors.New("a little easter egg for the 4chan link marines error"))
 
 		assert.NoError(t, bm.Start(testutils.Context(t)))
 
@@ -148,7 +174,8 @@ func TestBalanceMonitor_OnNewLongestChain_UpdatesBalance(t *testing.T) {
 		_, k0Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 		_, k1Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.TestLogger(t))
+		orm := monitor.NewORM(db, logger.TestLogger(t), cfg)
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, orm, logger.TestLogger(t), 0)
 		k0bal := big.NewInt(42)
 		// Deliberately larger than a 64 bit unsigned integer to test overflow
 		k1bal := big.NewInt(0)
@@ -156,14 +183,12 @@ func TestBalanceMonitor_OnNewLongestChain_UpdatesBalance(t *testing.T) {
 
 		head := cltest.Head(0)
 
-		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(k0bal, nil)
-		ethClient.On("BalanceAt", mock.Anything, k1Addr, nilBigInt).Once().Return(k1bal, nil)
+		mockBalances(ethClient, map[common.Address]*big.Int{k0Addr: k0bal, k1Addr: k1bal})
 
 		require.NoError(t, bm.Start(testutils.Context(t)))
 		defer bm.Close()
 
-		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(k0bal, nil)
-		ethClient.On("BalanceAt", mock.Anything, k1Addr, nilBigInt).Once().Return(k1bal, nil)
+		mockBalances(ethClient, map[common.Address]*big.Int{k0Addr: k0bal, k1Addr: k1bal})
 
 		// Do the thing
 		bm.OnNewLongestChain(testutils.Context(t), head)
@@ -181,8 +206,7 @@ func TestBalanceMonitor_OnNewLongestChain_UpdatesBalance(t *testing.T) {
 
 		head = cltest.Head(1)
 
-		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(k0bal2, nil)
-		ethClient.On("BalanceAt", mock.Anything, k1Addr, nilBigInt).Once().Return(k1bal2, nil)
+		mockBalances(ethClient, map[common.Address]*big.Int{k0Addr: k0bal2, k1Addr: k1bal2})
 
 		bm.OnNewLongestChain(testutils.Context(t), head)
 
@@ -192,7 +216,71 @@ func TestBalanceMonitor_OnNewLongestChain_UpdatesBalance(t *testing.T) {
 		gomega.NewWithT(t).Eventually(func() *big.Int {
 			return bm.GetEthBalance(k1Addr).ToInt()
 		}).Should(gomega.Equal(k1bal2))
+
+		// Only the first balance is sampled within BalanceSampleInterval
+		samples, err := orm.BalanceSamples(k0Addr, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		require.Len(t, samples, 1)
+		assert.Equal(t, k0bal, samples[0].Balance.ToInt())
+		assert.Equal(t, int64(0), samples[0].EVMChainID.Int64())
+	})
+}
+
+func TestBalanceMonitor_FundingThresholds(t *testing.T) {
+	t.Parallel()
+
+	cfg := cltest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	ethClient := newEthClientMock(t)
+	fundingJob, _ := cltest.MustInsertWebhookSpec(t, db)
+
+	_, k0Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+	require.NoError(t, ethKeyStore.SetFundingThresholds(k0Addr, big.NewInt(0), ethkey.FundingThresholds{
+		LowBalance:      utils.NewBigI(100),
+		CriticalBalance: utils.NewBigI(10),
+		FundingJobID:    uuid.NullUUID{UUID: fundingJob.ExternalJobID, Valid: true},
+	}))
+
+	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, monitor.NewORM(db, logger.TestLogger(t), cfg), logger.TestLogger(t), 0)
+	requests := make(chan monitor.FundingRequest, 10)
+	bm.OnFundingRequired(func(ctx context.Context, req monitor.FundingRequest) {
+		requests <- req
 	})
+
+	checkBalance := func(bal int64, head int64) {
+		mockBalances(ethClient, map[common.Address]*big.Int{k0Addr: big.NewInt(bal)})
+		bm.OnNewLongestChain(testutils.Context(t), cltest.Head(head))
+		gomega.NewWithT(t).Eventually(func() *big.Int {
+			return bm.GetEthBalance(k0Addr).ToInt()
+		}).Should(gomega.Equal(big.NewInt(bal)))
+	}
+
+	mockBalances(ethClient, map[common.Address]*big.Int{k0Addr: big.NewInt(500)})
+	require.NoError(t, bm.Start(testutils.Context(t)))
+	defer bm.Close()
+	assert.Empty(t, requests)
+
+	checkBalance(50, 1)
+	req := <-requests
+	assert.Equal(t, k0Addr, req.Address)
+	assert.Equal(t, ethkey.FundingStatusLow, req.Status)
+	assert.Equal(t, "50", req.BalanceWei.String())
+	assert.Equal(t, fundingJob.ExternalJobID, req.FundingJobID)
+
+	// still low, the top up is not requested again
+	checkBalance(40, 2)
+	assert.Empty(t, requests)
+
+	checkBalance(5, 3)
+	req = <-requests
+	assert.Equal(t, ethkey.FundingStatusCritical, req.Status)
+
+	// topped up, then low again
+	checkBalance(1000, 4)
+	checkBalance(90, 5)
+	req = <-requests
+	assert.Equal(t, ethkey.FundingStatusLow, req.Status)
 }
 
 func TestBalanceMonitor_FewerRPCCallsWhenBehind(t *testing.T) {
@@ -206,28 +294,28 @@ func TestBalanceMonitor_FewerRPCCallsWhenBehind(t *testing.T) {
 
 	ethClient := newEthClientMock(t)
 
-	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.TestLogger(t))
-	ethClient.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).
+	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, monitor.NewORM(db, logger.TestLogger(t), cfg), logger.TestLogger(t), 0)
+	ethClient.On("BatchCallContext", mock.Anything, mock.Anything).
 		Once().
-		Return(big.NewInt(1), nil)
+		Return(nil)
 	require.NoError(t, bm.Start(testutils.Context(t)))
 
 	head := cltest.Head(0)
 
 	// Only expect this twice, even though 10 heads will come in
 	mockUnblocker := make(chan time.Time)
-	ethClient.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).
+	ethClient.On("BatchCallContext", mock.Anything, mock.Anything).
 		WaitUntil(mockUnblocker).
 		Once().
-		Return(big.NewInt(42), nil)
+		Return(nil)
 	// This second call is Maybe because the SleeperTask may not have started
 	// before we call `OnNewLongestChain` 10 times, in which case it's only
 	// executed once
 	var callCount atomic.Int32
-	ethClient.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).
+	ethClient.On("BatchCallContext", mock.Anything, mock.Anything).
 		Run(func(mock.Arguments) { callCount.Inc() }).
 		Maybe().
-		Return(big.NewInt(42), nil)
+		Return(nil)
 
 	// Do the thing multiple times
 	for i := 0; i < 10; i++ {
@@ -235,16 +323,48 @@ func TestBalanceMonitor_FewerRPCCallsWhenBehind(t *testing.T) {
 	}
 
 	// Unblock the first mock
-	cltest.CallbackOrTimeout(t, "FewerRPCCallsWhenBehind unblock BalanceAt", func() {
+	cltest.CallbackOrTimeout(t, "FewerRPCCallsWhenBehind unblock BatchCallContext", func() {
 		mockUnblocker <- time.Time{}
 	})
 
 	bm.Close()
 
-	// Make sure the BalanceAt mock wasn't called more than once
+	// Make sure the BatchCallContext mock wasn't called more than once
 	assert.LessOrEqual(t, callCount.Load(), int32(1))
 }
 
+func TestBalanceMonitor_BatchSize(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	ethClient := newEthClientMock(t)
+
+	var addresses []common.Address
+	for i := 0; i < 3; i++ {
+		_, addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+		addresses = append(addresses, addr)
+	}
+
+	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, monitor.NewORM(db, logger.TestLogger(t), cfg), logger.TestLogger(t), 2)
+	var batchSizes []int
+	ethClient.On("BatchCallContext", mock.Anything, mock.Anything).Times(2).Return(nil).Run(func(args mock.Arguments) {
+		elems := args.Get(1).([]rpc.BatchElem)
+		batchSizes = append(batchSizes, len(elems))
+		for _, elem := range elems {
+			*elem.Result.(*hexutil.Big) = hexutil.Big(*big.NewInt(7))
+		}
+	})
+	require.NoError(t, bm.Start(testutils.Context(t)))
+	defer bm.Close()
+
+	assert.Equal(t, []int{2, 1}, batchSizes)
+	for _, addr := range addresses {
+		assert.Equal(t, big.NewInt(7), bm.GetEthBalance(addr).ToInt())
+	}
+}
+
 func Test_ApproximateFloat64(t *testing.T) {
 	t.Parallel()
 
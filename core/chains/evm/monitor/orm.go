@@ -0,0 +1,64 @@
+package monitor
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// BalanceSampleRetention is how long the balance samples are kept
+const BalanceSampleRetention = 30 * 24 * time.Hour
+
+// BalanceSample is the balance of a key at a time
+type BalanceSample struct {
+	EVMChainID utils.Big
+	Balance    assets.Eth
+	CreatedAt  time.Time
+}
+
+// ORM stores the history of the balances of the keys
+type ORM interface {
+	// InsertBalanceSample stores the balance of the key on the chain, and
+	// deletes its samples older than BalanceSampleRetention
+	InsertBalanceSample(chainID *big.Int, address common.Address, balance assets.Eth) error
+	// BalanceSamples returns the samples of the balances of the key on all
+	// chains since a time, oldest first
+	BalanceSamples(address common.Address, since time.Time, qopts ...pg.QOpt) ([]BalanceSample, error)
+}
+
+type orm struct {
+	q pg.Q
+}
+
+var _ ORM = (*orm)(nil)
+
+// NewORM returns an ORM of the balance samples
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig) ORM {
+	return &orm{pg.NewQ(db, lggr.Named("BalanceMonitorORM"), cfg)}
+}
+
+func (o *orm) InsertBalanceSample(chainID *big.Int, address common.Address, balance assets.Eth) error {
+	return o.q.Transaction(func(tx pg.Queryer) error {
+		if _, err := tx.Exec(`INSERT INTO eth_key_balance_samples (address, evm_chain_id, balance, created_at) VALUES ($1, $2, $3, NOW())`,
+			address, utils.NewBig(chainID), balance); err != nil {
+			return err
+		}
+		_, err := tx.Exec(`DELETE FROM eth_key_balance_samples WHERE address = $1 AND evm_chain_id = $2 AND created_at < $3`,
+			address, utils.NewBig(chainID), time.Now().Add(-BalanceSampleRetention))
+		return err
+	})
+}
+
+func (o *orm) BalanceSamples(address common.Address, since time.Time, qopts ...pg.QOpt) (samples []BalanceSample, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Select(&samples, `SELECT evm_chain_id, balance, created_at FROM eth_key_balance_samples WHERE address = $1 AND created_at >= $2 ORDER BY created_at, id`,
+		address, since)
+	return
+}
@@ -93,8 +93,9 @@ type EthBroadcaster struct {
 	q         pg.Q
 	ethClient evmclient.Client
 	ChainKeyStore
-	estimator      gas.Estimator
-	resumeCallback ResumeCallback
+	estimator       gas.Estimator
+	resumeCallback  ResumeCallback
+	txEventCallback TxEventCallback
 
 	ethTxInsertListener pg.Subscription
 	eventBroadcaster    pg.EventBroadcaster
@@ -327,7 +328,7 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddres
 		var a EthTxAttempt
 		keySpecificMaxGasPriceWei := eb.config.KeySpecificMaxGasPriceWei(etx.FromAddress)
 		if eb.config.EvmEIP1559DynamicFees() {
-			fee, gasLimit, err := eb.estimator.GetDynamicFee(etx.GasLimit, keySpecificMaxGasPriceWei)
+			fee, gasLimit, err := eb.getDynamicFee(*etx, keySpecificMaxGasPriceWei)
 			if err != nil {
 				return errors.Wrap(err, "failed to get dynamic gas fee"), true
 			}
@@ -336,7 +337,7 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddres
 				return errors.Wrap(err, "processUnstartedEthTxs failed on NewDynamicFeeAttempt"), true
 			}
 		} else {
-			gasPrice, gasLimit, err := eb.estimator.GetLegacyGas(etx.EncodedPayload, etx.GasLimit, keySpecificMaxGasPriceWei)
+			gasPrice, gasLimit, err := eb.getLegacyGas(*etx, keySpecificMaxGasPriceWei)
 			if err != nil {
 				return errors.Wrap(err, "failed to estimate gas"), true
 			}
@@ -359,6 +360,38 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddres
 	}
 }
 
+// gasPricePercentile returns the gas price percentile override of the
+// transaction, if it has one and the estimator supports it.
+func (eb *EthBroadcaster) gasPricePercentile(etx EthTx) (gas.PercentileEstimator, uint16, bool) {
+	pe, ok := eb.estimator.(gas.PercentileEstimator)
+	if !ok {
+		return nil, 0, false
+	}
+	meta, err := etx.GetMeta()
+	if err != nil {
+		eb.logger.Errorw("Failed to get meta of the transaction, ignoring gas price percentile override", "ethTxID", etx.ID, "err", err)
+		return nil, 0, false
+	}
+	if meta == nil || meta.GasPricePercentile == nil {
+		return nil, 0, false
+	}
+	return pe, *meta.GasPricePercentile, true
+}
+
+func (eb *EthBroadcaster) getLegacyGas(etx EthTx, maxGasPriceWei *big.Int) (*big.Int, uint32, error) {
+	if pe, percentile, ok := eb.gasPricePercentile(etx); ok {
+		return pe.GetLegacyGasAtPercentile(etx.EncodedPayload, etx.GasLimit, maxGasPriceWei, percentile)
+	}
+	return eb.estimator.GetLegacyGas(etx.EncodedPayload, etx.GasLimit, maxGasPriceWei)
+}
+
+func (eb *EthBroadcaster) getDynamicFee(etx EthTx, maxGasPriceWei *big.Int) (gas.DynamicFee, uint32, error) {
+	if pe, percentile, ok := eb.gasPricePercentile(etx); ok {
+		return pe.GetDynamicFeeAtPercentile(etx.GasLimit, maxGasPriceWei, percentile)
+	}
+	return eb.estimator.GetDynamicFee(etx.GasLimit, maxGasPriceWei)
+}
+
 // handleInProgressEthTx checks if there is any transaction
 // in_progress and if so, finishes the job
 func (eb *EthBroadcaster) handleAnyInProgressEthTx(ctx context.Context, fromAddress gethCommon.Address) (err error, retryable bool) {
@@ -664,7 +697,7 @@ func (eb *EthBroadcaster) saveAttempt(etx *EthTx, attempt EthTxAttempt, NewAttem
 	}
 	etx.State = EthTxUnconfirmed
 	attempt.State = NewAttemptState
-	return eb.q.Transaction(func(tx pg.Queryer) error {
+	err := eb.q.Transaction(func(tx pg.Queryer) error {
 		if err := eb.incrementNextNonce(etx.FromAddress, *etx.Nonce, pg.WithQueryer(tx)); err != nil {
 			return errors.Wrap(err, "saveUnconfirmed failed")
 		}
@@ -681,6 +714,10 @@ func (eb *EthBroadcaster) saveAttempt(etx *EthTx, attempt EthTxAttempt, NewAttem
 		}
 		return nil
 	})
+	if err == nil && eb.txEventCallback != nil {
+		eb.txEventCallback(newTxEvent(*etx, EthTxUnconfirmed, &attempt.Hash))
+	}
+	return err
 }
 
 func (eb *EthBroadcaster) tryAgainBumpingGas(ctx context.Context, lgr logger.Logger, sendError *evmclient.SendError, etx EthTx, attempt EthTxAttempt, initialBroadcastAt time.Time) (err error, retryable bool) {
@@ -803,12 +840,16 @@ func (eb *EthBroadcaster) saveFatallyErroredTransaction(lgr logger.Logger, etx *
 	}
 	etx.Nonce = nil
 	etx.State = EthTxFatalError
-	return eb.q.Transaction(func(tx pg.Queryer) error {
+	err := eb.q.Transaction(func(tx pg.Queryer) error {
 		if _, err := tx.Exec(`DELETE FROM eth_tx_attempts WHERE eth_tx_id = $1`, etx.ID); err != nil {
 			return errors.Wrapf(err, "saveFatallyErroredTransaction failed to delete eth_tx_attempt with eth_tx.ID %v", etx.ID)
 		}
 		return errors.Wrap(tx.Get(etx, `UPDATE eth_txes SET state=$1, error=$2, broadcast_at=NULL, initial_broadcast_at=NULL, nonce=NULL WHERE id=$3 RETURNING *`, etx.State, etx.Error, etx.ID), "saveFatallyErroredTransaction failed to save eth_tx")
 	})
+	if err == nil && eb.txEventCallback != nil {
+		eb.txEventCallback(newTxEvent(*etx, EthTxFatalError, nil))
+	}
+	return err
 }
 
 func (eb *EthBroadcaster) getNextNonce(address gethCommon.Address) (nonce int64, err error) {
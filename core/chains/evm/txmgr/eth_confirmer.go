@@ -12,6 +12,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -22,9 +23,11 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	uuid "github.com/satori/go.uuid"
 	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/sqlx"
 
+	"github.com/smartcontractkit/chainlink/core/assets"
 	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/label"
@@ -125,17 +128,20 @@ type EthConfirmer struct {
 	q         pg.Q
 	ethClient evmclient.Client
 	ChainKeyStore
-	estimator      gas.Estimator
-	resumeCallback ResumeCallback
+	estimator       gas.Estimator
+	resumeCallback  ResumeCallback
+	txEventCallback TxEventCallback
 
 	keyStates []ethkey.State
 
 	mb        *utils.Mailbox[*evmtypes.Head]
+	chForce   chan forceRequest
 	ctx       context.Context
 	ctxCancel context.CancelFunc
 	wg        sync.WaitGroup
 
 	nConsecutiveBlocksChainTooShort int
+	latestBlockNum                  int64
 }
 
 // NewEthConfirmer instantiates a new eth confirmer
@@ -159,12 +165,15 @@ func NewEthConfirmer(db *sqlx.DB, ethClient evmclient.Client, config Config, key
 		},
 		estimator,
 		resumeCallback,
+		nil,
 		keyStates,
 		utils.NewMailbox[*evmtypes.Head](1),
+		make(chan forceRequest),
 		ctx,
 		cancel,
 		sync.WaitGroup{},
 		0,
+		0,
 	}
 }
 
@@ -212,6 +221,8 @@ func (ec *EthConfirmer) runLoop() {
 					continue
 				}
 			}
+		case req := <-ec.chForce:
+			req.done <- ec.handleForceRequest(req)
 		case <-ec.ctx.Done():
 			return
 		}
@@ -231,6 +242,7 @@ func (ec *EthConfirmer) processHead(ctx context.Context, head *evmtypes.Head) er
 	mark := time.Now()
 
 	ec.lggr.Debugw("processHead start", "headNum", head.Number, "id", "eth_confirmer")
+	ec.latestBlockNum = head.Number
 
 	if err := ec.SetBroadcastBeforeBlockNum(head.Number); err != nil {
 		return errors.Wrap(err, "SetBroadcastBeforeBlockNum failed")
@@ -468,6 +480,7 @@ func (ec *EthConfirmer) fetchAndSaveReceipts(ctx context.Context, attempts []Eth
 	}
 
 	observeUntilTxConfirmed(ec.chainID, attempts, allReceipts)
+	ec.emitConfirmedTxEvents(attempts, allReceipts)
 
 	return nil
 }
@@ -573,7 +586,13 @@ func (ec *EthConfirmer) batchFetchReceipts(ctx context.Context, attempts []EthTx
 		}
 
 		if receipt.Status == 0 {
-			l.Warnf("transaction %s reverted on-chain", receipt.TxHash)
+			revertReason := ec.fetchRevertReason(ctx, l, attempt, receipt.BlockNumber)
+			l.Warnw(fmt.Sprintf("transaction %s reverted on-chain", receipt.TxHash), "revertReason", revertReason)
+			if revertReason != "" {
+				if err := ec.saveRevertReason(attempt.ID, revertReason); err != nil {
+					l.Errorw("Failed to save revert reason", "err", err)
+				}
+			}
 			// This might increment more than once e.g. in case of re-orgs going back and forth we might re-fetch the same receipt
 			promRevertedTxCount.WithLabelValues(ec.chainID.String()).Add(1)
 		} else {
@@ -599,6 +618,39 @@ func (ec *EthConfirmer) batchFetchReceipts(ctx context.Context, attempts []EthTx
 	return
 }
 
+// fetchRevertReason replays a reverted transaction with eth_call at the block
+// it was mined in and decodes the revert reason. It is best effort, an empty
+// string is returned if the reason could not be found.
+func (ec *EthConfirmer) fetchRevertReason(ctx context.Context, lggr logger.Logger, attempt EthTxAttempt, blockNumber *big.Int) string {
+	etx := attempt.EthTx
+	if etx.ID == 0 {
+		// the eth_tx was not loaded with the attempt
+		return ""
+	}
+	_, err := ec.ethClient.CallContract(ctx, ethereum.CallMsg{
+		From:  etx.FromAddress,
+		To:    &etx.ToAddress,
+		Gas:   uint64(attempt.ChainSpecificGasLimit),
+		Value: etx.Value.ToInt(),
+		Data:  etx.EncodedPayload,
+	}, blockNumber)
+	if err == nil {
+		lggr.Debug("Replaying reverted transaction did not revert, no revert reason available")
+		return ""
+	}
+	reason, derr := evmclient.RevertReasonFromCallError(err)
+	if derr != nil {
+		lggr.Debugw("Could not decode revert reason", "err", derr, "callErr", err)
+		return ""
+	}
+	return reason
+}
+
+func (ec *EthConfirmer) saveRevertReason(attemptID int64, reason string) error {
+	_, err := ec.q.Exec(`UPDATE eth_tx_attempts SET revert_reason = $1 WHERE id = $2`, reason, attemptID)
+	return errors.Wrap(err, "saveRevertReason failed")
+}
+
 func (ec *EthConfirmer) saveFetchedReceipts(receipts []evmtypes.Receipt) (err error) {
 	if len(receipts) == 0 {
 		return nil
@@ -1590,6 +1642,145 @@ SELECT * FROM eth_txes WHERE from_address = $1 AND nonce = $2 AND state IN ('con
 	return
 }
 
+// ErrEthTxNotUnconfirmed is returned when bumping or cancelling a transaction
+// that was not broadcast yet, or that was already confirmed or errored.
+var ErrEthTxNotUnconfirmed = errors.New("only unconfirmed transactions can be bumped or cancelled")
+
+// forceRequest is a request to immediately bump the gas of an unconfirmed
+// transaction, or to cancel it. It is handled by the runLoop between heads, so
+// that it does not race with the normal gas bumping cycle.
+type forceRequest struct {
+	ctx    context.Context
+	etxID  int64
+	cancel bool
+	done   chan error
+}
+
+// force sends the request to the runLoop, or fails it if the EthConfirmer is
+// stopped first.
+func (ec *EthConfirmer) force(req forceRequest) {
+	select {
+	case ec.chForce <- req:
+	case <-req.ctx.Done():
+		req.done <- req.ctx.Err()
+	case <-ec.ctx.Done():
+		req.done <- errors.New("EthConfirmer was stopped")
+	}
+}
+
+func (ec *EthConfirmer) handleForceRequest(req forceRequest) error {
+	ctx, cancel := utils.WithCloseChan(req.ctx, ec.ctx.Done())
+	defer cancel()
+
+	if req.cancel {
+		return ec.CancelEthTx(ctx, req.etxID)
+	}
+	return ec.BumpEthTx(ctx, req.etxID)
+}
+
+// BumpEthTx immediately sends a new attempt of the unconfirmed eth_tx with
+// bumped gas.
+// NOTE: This SHOULD NOT be run concurrently with ProcessHead
+func (ec *EthConfirmer) BumpEthTx(ctx context.Context, etxID int64) error {
+	return ec.forceEthTx(ctx, etxID, false)
+}
+
+// CancelEthTx replaces the unconfirmed eth_tx with a zero-value transfer to
+// its sender, and immediately sends it with bumped gas.
+// NOTE: This SHOULD NOT be run concurrently with ProcessHead
+func (ec *EthConfirmer) CancelEthTx(ctx context.Context, etxID int64) error {
+	return ec.forceEthTx(ctx, etxID, true)
+}
+
+func (ec *EthConfirmer) forceEthTx(ctx context.Context, etxID int64, cancel bool) error {
+	etx, err := ec.findUnconfirmedEthTx(ctx, etxID)
+	if err != nil {
+		return err
+	}
+	if len(etx.EthTxAttempts) > 0 && etx.EthTxAttempts[0].State == EthTxAttemptInProgress {
+		// Only one attempt per eth_tx may be in progress, so we send it first
+		if err = ec.handleAnyInProgressAttempts(ctx, etx.FromAddress, ec.latestBlockNum); err != nil {
+			return errors.Wrap(err, "handleAnyInProgressAttempts failed")
+		}
+		if etx, err = ec.findUnconfirmedEthTx(ctx, etxID); err != nil {
+			return err
+		}
+	}
+	if len(etx.EthTxAttempts) == 0 {
+		return errors.Errorf("eth_tx %d has no attempts", etx.ID)
+	}
+	lggr := etx.GetLogger(ec.lggr)
+
+	if cancel {
+		// Replace the transaction with a zero-value transfer to ourselves
+		etx.ToAddress = etx.FromAddress
+		etx.EncodedPayload = []byte{}
+		etx.Value = assets.NewEthValue(0)
+		etx.AccessList = NullableEIP2930AccessList{}
+	}
+
+	previousAttempt := etx.EthTxAttempts[0]
+	previousAttempt.EthTx = *etx
+	attempt, err := ec.bumpGas(previousAttempt)
+	if err != nil {
+		return err
+	}
+
+	if cancel {
+		lggr.Infow("Cancelling transaction", "gasPrice", attempt.GasPrice, "gasTipCap", attempt.GasTipCap, "gasFeeCap", attempt.GasFeeCap)
+		if err = ec.saveCancelledEthTx(ctx, etx, &attempt); err != nil {
+			return err
+		}
+	} else {
+		lggr.Infow("Bumping gas of transaction", "gasPrice", attempt.GasPrice, "gasTipCap", attempt.GasTipCap, "gasFeeCap", attempt.GasFeeCap)
+		if err = ec.saveInProgressAttempt(&attempt); err != nil {
+			return errors.Wrap(err, "saveInProgressAttempt failed")
+		}
+	}
+
+	return ec.handleInProgressAttempt(ctx, lggr, *etx, attempt, ec.latestBlockNum)
+}
+
+func (ec *EthConfirmer) findUnconfirmedEthTx(ctx context.Context, etxID int64) (*EthTx, error) {
+	var etx EthTx
+	err := ec.q.WithOpts(pg.WithParentCtx(ctx)).Transaction(func(tx pg.Queryer) error {
+		if err := tx.Get(&etx, `SELECT * FROM eth_txes WHERE id = $1 AND evm_chain_id = $2`, etxID, ec.chainID.String()); err != nil {
+			return errors.Wrapf(err, "failed to find eth_tx %d", etxID)
+		}
+		return loadEthTxAttempts(tx, &etx)
+	}, pg.OptReadOnlyTx())
+	if err != nil {
+		return nil, err
+	}
+	if etx.State != EthTxUnconfirmed {
+		return nil, errors.Wrapf(ErrEthTxNotUnconfirmed, "eth_tx %d is %s", etx.ID, etx.State)
+	}
+	return &etx, nil
+}
+
+// saveCancelledEthTx saves the replacement of the eth_tx along with its first
+// attempt. A pipeline run waiting for the eth_tx is resumed with an error,
+// since its transaction will not be sent anymore.
+func (ec *EthConfirmer) saveCancelledEthTx(ctx context.Context, etx *EthTx, attempt *EthTxAttempt) error {
+	if etx.PipelineTaskRunID.Valid && ec.resumeCallback != nil {
+		if err := ec.resumeCallback(etx.PipelineTaskRunID.UUID, nil, errors.Errorf("eth_tx %d was cancelled", etx.ID)); err != nil {
+			return errors.Wrap(err, "failed to resume pipeline run")
+		}
+	}
+	err := ec.q.WithOpts(pg.WithParentCtx(ctx)).Transaction(func(tx pg.Queryer) error {
+		if _, err := tx.Exec(`UPDATE eth_txes SET to_address = $1, encoded_payload = $2, value = $3, access_list = NULL, pipeline_task_run_id = NULL WHERE id = $4`,
+			etx.ToAddress, etx.EncodedPayload, etx.Value, etx.ID); err != nil {
+			return errors.Wrap(err, "failed to update eth_txes")
+		}
+		query, args, err := tx.BindNamed(insertIntoEthTxAttemptsQuery, attempt)
+		if err != nil {
+			return errors.Wrap(err, "failed to BindNamed")
+		}
+		return errors.Wrap(tx.Get(attempt, query, args...), "failed to insert into eth_tx_attempts")
+	})
+	return errors.Wrap(err, "saveCancelledEthTx failed")
+}
+
 // ResumePendingTaskRuns issues callbacks to task runs that are pending waiting for receipts
 func (ec *EthConfirmer) ResumePendingTaskRuns(ctx context.Context, head *evmtypes.Head) error {
 	type x struct {
@@ -1598,6 +1789,12 @@ func (ec *EthConfirmer) ResumePendingTaskRuns(ctx context.Context, head *evmtype
 		FailOnRevert bool             `db:"FailOnRevert"`
 	}
 	var receipts []x
+	// Transactions in blocks marked final by the finality tag are resumed even
+	// if they do not have min_confirmations yet, since they cannot be re-orged
+	finalizedBlockNumber := int64(-1)
+	if head.FinalizedBlockNumber.Valid {
+		finalizedBlockNumber = head.FinalizedBlockNumber.Int64
+	}
 	// NOTE: we don't filter on eth_txes.state = 'confirmed', because a transaction with an attached receipt
 	// is guaranteed to be confirmed. This results in a slightly better query plan.
 	if err := ec.q.Select(&receipts, `
@@ -1606,8 +1803,8 @@ func (ec *EthConfirmer) ResumePendingTaskRuns(ctx context.Context, head *evmtype
 	INNER JOIN eth_txes ON eth_txes.pipeline_task_run_id = pipeline_task_runs.id
 	INNER JOIN eth_tx_attempts ON eth_txes.id = eth_tx_attempts.eth_tx_id
 	INNER JOIN eth_receipts ON eth_tx_attempts.hash = eth_receipts.tx_hash
-	WHERE pipeline_runs.state = 'suspended' AND eth_receipts.block_number <= ($1 - eth_txes.min_confirmations) AND eth_txes.evm_chain_id = $2
-	`, head.Number, ec.chainID.String()); err != nil {
+	WHERE pipeline_runs.state = 'suspended' AND (eth_receipts.block_number <= ($1 - eth_txes.min_confirmations) OR eth_receipts.block_number <= $3) AND eth_txes.evm_chain_id = $2
+	`, head.Number, ec.chainID.String(), finalizedBlockNumber); err != nil {
 		return err
 	}
 
@@ -1631,11 +1828,58 @@ func (ec *EthConfirmer) ResumePendingTaskRuns(ctx context.Context, head *evmtype
 		}
 	}
 
+	return ec.resumeFailedTaskRuns()
+}
+
+// resumeFailedTaskRuns fails task runs that are pending waiting for receipts of
+// eth_txes that will never be confirmed, e.g. because they were abandoned or
+// never got a receipt. Otherwise their pipeline runs would stay suspended
+// forever.
+func (ec *EthConfirmer) resumeFailedTaskRuns() error {
+	type x struct {
+		ID      uuid.UUID   `db:"id"`
+		EthTxID int64       `db:"eth_tx_id"`
+		Error   null.String `db:"error"`
+	}
+	var failed []x
+	if err := ec.q.Select(&failed, `
+	SELECT pipeline_task_runs.id, eth_txes.id "eth_tx_id", eth_txes.error FROM pipeline_task_runs
+	INNER JOIN pipeline_runs ON pipeline_runs.id = pipeline_task_runs.pipeline_run_id
+	INNER JOIN eth_txes ON eth_txes.pipeline_task_run_id = pipeline_task_runs.id
+	WHERE pipeline_runs.state = 'suspended' AND pipeline_task_runs.finished_at IS NULL AND eth_txes.state = 'fatal_error' AND eth_txes.evm_chain_id = $1
+	`, ec.chainID.String()); err != nil {
+		return errors.Wrap(err, "failed to load task runs pending failed eth_txes")
+	}
+
+	for _, data := range failed {
+		taskErr := errors.Errorf("eth_tx %d failed: %s", data.EthTxID, data.Error.String)
+		ec.lggr.Debugw("Callback: resuming ethtx with error", "taskErr", taskErr, "pipelineTaskRunID", data.ID)
+		if err := ec.resumeCallback(data.ID, nil, taskErr); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 // observeUntilTxConfirmed observes the promBlocksUntilTxConfirmed metric for each confirmed
 // transaction.
+// emitConfirmedTxEvents reports the transactions of the attempts that received
+// a receipt as confirmed
+func (ec *EthConfirmer) emitConfirmedTxEvents(attempts []EthTxAttempt, receipts []evmtypes.Receipt) {
+	if ec.txEventCallback == nil {
+		return
+	}
+	for _, attempt := range attempts {
+		for _, r := range receipts {
+			if attempt.Hash == r.TxHash {
+				hash := attempt.Hash
+				ec.txEventCallback(newTxEvent(attempt.EthTx, EthTxConfirmed, &hash))
+			}
+		}
+	}
+}
+
 func observeUntilTxConfirmed(chainID big.Int, attempts []EthTxAttempt, receipts []evmtypes.Receipt) {
 	for _, attempt := range attempts {
 		for _, r := range receipts {
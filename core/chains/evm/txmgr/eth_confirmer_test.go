@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -20,7 +21,9 @@ import (
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
 	evmconfig "github.com/smartcontractkit/chainlink/core/chains/evm/config"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
@@ -29,6 +32,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	clnull "github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	ksmocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
@@ -40,6 +44,16 @@ func newTestChainScopedConfig(t *testing.T) evmconfig.ChainScopedConfig {
 	return evmtest.NewChainScopedConfig(t, cfg)
 }
 
+// newEthClientMockWithRevertReasons returns an eth client mock for the tests
+// checking receipts. The EthConfirmer treats the receipts without a status as
+// reverted and calls their transaction again to fetch the revert reason, which
+// the mock leaves empty.
+func newEthClientMockWithRevertReasons(t *testing.T) *evmmocks.Client {
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ethClient.On("CallContract", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	return ethClient
+}
+
 func mustInsertUnstartedEthTx(t *testing.T, borm txmgr.ORM, fromAddress gethCommon.Address) {
 	etx := cltest.NewEthTx(t, fromAddress)
 	etx.State = txmgr.EthTxUnstarted
@@ -176,7 +190,7 @@ func TestEthConfirmer_CheckForReceipts(t *testing.T) {
 	config := newTestChainScopedConfig(t)
 	borm := cltest.NewTxmORM(t, db, config)
 
-	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ethClient := newEthClientMockWithRevertReasons(t)
 	ethKeyStore := cltest.NewKeyStore(t, db, config).Eth()
 
 	key, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
@@ -542,7 +556,7 @@ func TestEthConfirmer_CheckForReceipts_batching(t *testing.T) {
 
 	state, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
 
-	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ethClient := newEthClientMockWithRevertReasons(t)
 
 	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
 
@@ -601,7 +615,7 @@ func TestEthConfirmer_CheckForReceipts_HandlesNilMetaWithForwardingEnabled(t *te
 
 	borm := cltest.NewTxmORM(t, db, cfg)
 	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
-	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ethClient := newEthClientMockWithRevertReasons(t)
 	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
 
 	state, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
@@ -632,6 +646,55 @@ func TestEthConfirmer_CheckForReceipts_HandlesNilMetaWithForwardingEnabled(t *te
 	require.NoError(t, ec.CheckForReceipts(ctx, 42))
 }
 
+func TestEthConfirmer_CheckForReceipts_RevertReason(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	borm := cltest.NewTxmORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	state, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+	ec := cltest.NewEthConfirmer(t, db, ethClient, evmcfg, ethKeyStore, []ethkey.State{state}, nil)
+	ctx := testutils.Context(t)
+
+	etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 0, fromAddress)
+	attempt := etx.EthTxAttempts[0]
+
+	txmReceipt := evmtypes.Receipt{
+		TxHash:           attempt.Hash,
+		BlockHash:        utils.NewHash(),
+		BlockNumber:      big.NewInt(42),
+		TransactionIndex: uint(1),
+		Status:           uint64(0),
+	}
+
+	ethClient.On("NonceAt", mock.Anything, mock.Anything, mock.Anything).Return(uint64(10), nil)
+	ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+		return len(b) == 1 &&
+			cltest.BatchElemMatchesParams(b[0], attempt.Hash, "eth_getTransactionReceipt")
+	})).Return(nil).Run(func(args mock.Arguments) {
+		elems := args.Get(1).([]rpc.BatchElem)
+		elems[0].Result = &txmReceipt
+	}).Once()
+	ethClient.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+		return msg.From == fromAddress && *msg.To == etx.ToAddress
+	}), big.NewInt(42)).Return(nil, &evmclient.JsonError{
+		Code:    3,
+		Message: "execution reverted: not enough LINK",
+	}).Once()
+
+	require.NoError(t, ec.CheckForReceipts(ctx, 42))
+
+	etx, err := borm.FindEthTxWithAttempts(etx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgr.EthTxConfirmed, etx.State)
+	require.Len(t, etx.EthTxAttempts, 1)
+	assert.Equal(t, null.StringFrom("not enough LINK"), etx.EthTxAttempts[0].RevertReason)
+}
+
 func TestEthConfirmer_CheckForReceipts_only_likely_confirmed(t *testing.T) {
 	t.Parallel()
 
@@ -644,7 +707,7 @@ func TestEthConfirmer_CheckForReceipts_only_likely_confirmed(t *testing.T) {
 
 	state, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
 
-	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ethClient := newEthClientMockWithRevertReasons(t)
 
 	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
 
@@ -701,7 +764,7 @@ func TestEthConfirmer_CheckForReceipts_should_not_check_for_likely_unconfirmed(t
 
 	state, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
 
-	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ethClient := newEthClientMockWithRevertReasons(t)
 
 	ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, []ethkey.State{state}, nil)
 
@@ -732,7 +795,7 @@ func TestEthConfirmer_CheckForReceipts_confirmed_missing_receipt_scoped_to_key(t
 	state1_2, fromAddress1_2 := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, chainId1)
 	state2_1, fromAddress2_1 := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, chainId2)
 
-	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ethClient := newEthClientMockWithRevertReasons(t)
 	ethClient.On("NonceAt", mock.Anything, mock.Anything, mock.Anything).Return(uint64(20), nil)
 	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
 
@@ -797,7 +860,7 @@ func TestEthConfirmer_CheckForReceipts_confirmed_missing_receipt(t *testing.T) {
 
 	state, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
 
-	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ethClient := newEthClientMockWithRevertReasons(t)
 
 	cfg.Overrides.GlobalEvmFinalityDepth = null.IntFrom(50)
 	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
@@ -2740,6 +2803,118 @@ func TestEthConfirmer_ForceRebroadcast(t *testing.T) {
 	})
 }
 
+func TestEthConfirmer_BumpEthTx(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	borm := cltest.NewTxmORM(t, db, cfg)
+
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	state, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	config := newTestChainScopedConfig(t)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, []ethkey.State{state}, nil)
+
+	t.Run("sends a new attempt with bumped gas", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 0, fromAddress)
+		previousGasPrice := etx.EthTxAttempts[0].GasPrice.ToInt()
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *types.Transaction) bool {
+			return tx.Nonce() == uint64(*etx.Nonce) &&
+				tx.GasPrice().Cmp(previousGasPrice) > 0 &&
+				reflect.DeepEqual(tx.Data(), etx.EncodedPayload) &&
+				*tx.To() == etx.ToAddress
+		})).Return(nil).Once()
+
+		require.NoError(t, ec.BumpEthTx(testutils.Context(t), etx.ID))
+
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxUnconfirmed, etx.State)
+		require.Len(t, etx.EthTxAttempts, 2)
+		assert.Equal(t, txmgr.EthTxAttemptBroadcast, etx.EthTxAttempts[0].State)
+		assert.Equal(t, 1, etx.EthTxAttempts[0].GasPrice.Cmp(utils.NewBig(previousGasPrice)))
+	})
+
+	t.Run("errors if the eth_tx is not unconfirmed", func(t *testing.T) {
+		etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 1, 1, fromAddress)
+
+		err := ec.BumpEthTx(testutils.Context(t), etx.ID)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, txmgr.ErrEthTxNotUnconfirmed))
+	})
+}
+
+func TestEthConfirmer_CancelEthTx(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	borm := cltest.NewTxmORM(t, db, cfg)
+
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	state, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	config := newTestChainScopedConfig(t)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+
+	pgtest.MustExec(t, db, `SET CONSTRAINTS pipeline_runs_pipeline_spec_id_fkey DEFERRED`)
+
+	t.Run("replaces the eth_tx with a zero-value transfer to its sender", func(t *testing.T) {
+		ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, []ethkey.State{state}, nil)
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 0, fromAddress)
+		previousGasPrice := etx.EthTxAttempts[0].GasPrice.ToInt()
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *types.Transaction) bool {
+			return tx.Nonce() == uint64(*etx.Nonce) &&
+				tx.GasPrice().Cmp(previousGasPrice) > 0 &&
+				*tx.To() == fromAddress &&
+				tx.Value().Cmp(big.NewInt(0)) == 0 &&
+				len(tx.Data()) == 0
+		})).Return(nil).Once()
+
+		require.NoError(t, ec.CancelEthTx(testutils.Context(t), etx.ID))
+
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxUnconfirmed, etx.State)
+		assert.Equal(t, fromAddress, etx.ToAddress)
+		assert.Empty(t, etx.EncodedPayload)
+		assert.Equal(t, assets.NewEthValue(0), etx.Value)
+		require.Len(t, etx.EthTxAttempts, 2)
+		assert.Equal(t, txmgr.EthTxAttemptBroadcast, etx.EthTxAttempts[0].State)
+	})
+
+	t.Run("resumes the pipeline run waiting for the eth_tx with an error", func(t *testing.T) {
+		run := cltest.MustInsertPipelineRun(t, db)
+		tr := cltest.MustInsertUnfinishedPipelineTaskRun(t, db, run.ID)
+
+		var resumed bool
+		ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, []ethkey.State{state}, func(id uuid.UUID, value interface{}, err error) error {
+			resumed = true
+			assert.Equal(t, tr.ID, id)
+			assert.Nil(t, value)
+			assert.Error(t, err)
+			return nil
+		})
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 1, fromAddress)
+		pgtest.MustExec(t, db, `UPDATE eth_txes SET pipeline_task_run_id = $1, min_confirmations = 1 WHERE id = $2`, &tr.ID, etx.ID)
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *types.Transaction) bool {
+			return tx.Nonce() == uint64(*etx.Nonce)
+		})).Return(nil).Once()
+
+		require.NoError(t, ec.CancelEthTx(testutils.Context(t), etx.ID))
+		assert.True(t, resumed)
+
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.False(t, etx.PipelineTaskRunID.Valid)
+	})
+}
+
 func TestEthConfirmer_ResumePendingRuns(t *testing.T) {
 	t.Parallel()
 
@@ -2891,4 +3066,74 @@ func TestEthConfirmer_ResumePendingRuns(t *testing.T) {
 			t.Fatal("no value received")
 		}
 	})
+
+	pgtest.MustExec(t, db, `DELETE FROM pipeline_runs`)
+
+	t.Run("processes fatally errored eth_txes", func(t *testing.T) {
+		ch := make(chan interface{})
+		var err error
+		ec := cltest.NewEthConfirmer(t, db, ethClient, evmcfg, ethKeyStore, []ethkey.State{state}, func(id uuid.UUID, value interface{}, thisErr error) error {
+			err = thisErr
+			ch <- value
+			return nil
+		})
+
+		run := cltest.MustInsertPipelineRun(t, db)
+		tr := cltest.MustInsertUnfinishedPipelineTaskRun(t, db, run.ID)
+		pgtest.MustExec(t, db, `UPDATE pipeline_runs SET state = 'suspended' WHERE id = $1`, run.ID)
+
+		etx := cltest.MustInsertFatalErrorEthTx(t, borm, fromAddress)
+		pgtest.MustExec(t, db, `UPDATE eth_txes SET pipeline_task_run_id = $1, min_confirmations = $2 WHERE id = $3`, &tr.ID, minConfirmations, etx.ID)
+
+		go func() {
+			err2 := ec.ResumePendingTaskRuns(testutils.Context(t), &head)
+			require.NoError(t, err2)
+		}()
+
+		select {
+		case data := <-ch:
+			assert.EqualError(t, err, fmt.Sprintf("eth_tx %d failed: something exploded", etx.ID))
+			assert.Nil(t, data)
+
+		case <-testutils.AfterWaitTimeout(t):
+			t.Fatal("no value received")
+		}
+	})
+
+	pgtest.MustExec(t, db, `DELETE FROM pipeline_runs`)
+
+	t.Run("processes eth_txes in finalized blocks younger than minConfirmations", func(t *testing.T) {
+		ch := make(chan interface{})
+		ec := cltest.NewEthConfirmer(t, db, ethClient, evmcfg, ethKeyStore, []ethkey.State{state}, func(id uuid.UUID, value interface{}, thisErr error) error {
+			assert.NoError(t, thisErr)
+			ch <- value
+			return nil
+		})
+
+		run := cltest.MustInsertPipelineRun(t, db)
+		tr := cltest.MustInsertUnfinishedPipelineTaskRun(t, db, run.ID)
+		pgtest.MustExec(t, db, `UPDATE pipeline_runs SET state = 'suspended' WHERE id = $1`, run.ID)
+
+		etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 5, 1, fromAddress)
+		attempt := etx.EthTxAttempts[0]
+		receipt := cltest.MustInsertEthReceipt(t, borm, head.Number-1, head.Hash, attempt.Hash)
+		pgtest.MustExec(t, db, `UPDATE eth_txes SET pipeline_task_run_id = $1, min_confirmations = $2 WHERE id = $3`, &tr.ID, minConfirmations, etx.ID)
+
+		finalizedHead := head
+		finalizedHead.FinalizedBlockNumber = clnull.Int64From(head.Number - 1)
+
+		go func() {
+			err2 := ec.ResumePendingTaskRuns(testutils.Context(t), &finalizedHead)
+			require.NoError(t, err2)
+		}()
+
+		select {
+		case data := <-ch:
+			require.IsType(t, evmtypes.Receipt{}, data)
+			assert.Equal(t, receipt.TxHash, data.(evmtypes.Receipt).TxHash)
+
+		case <-testutils.AfterWaitTimeout(t):
+			t.Fatal("no value received")
+		}
+	})
 }
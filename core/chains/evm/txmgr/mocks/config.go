@@ -380,6 +380,20 @@ func (_m *Config) EvmMaxQueuedTransactions() uint64 {
 	return r0
 }
 
+// EvmMaxQueuedTransactionsPolicy provides a mock function with given fields:
+func (_m *Config) EvmMaxQueuedTransactionsPolicy() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // EvmMinGasPriceWei provides a mock function with given fields:
 func (_m *Config) EvmMinGasPriceWei() *big.Int {
 	ret := _m.Called()
@@ -6,6 +6,8 @@ import (
 	common "github.com/ethereum/go-ethereum/common"
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	txmgr "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 )
 
@@ -74,6 +76,36 @@ func (_m *ORM) EthTransactionsWithAttempts(offset int, limit int) ([]txmgr.EthTx
 	return r0, r1, r2
 }
 
+// EthTransactionsWithAttemptsByMeta provides a mock function with given fields: filter, offset, limit
+func (_m *ORM) EthTransactionsWithAttemptsByMeta(filter txmgr.EthTxMetaFilter, offset int, limit int) ([]txmgr.EthTx, int, error) {
+	ret := _m.Called(filter, offset, limit)
+
+	var r0 []txmgr.EthTx
+	if rf, ok := ret.Get(0).(func(txmgr.EthTxMetaFilter, int, int) []txmgr.EthTx); ok {
+		r0 = rf(filter, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]txmgr.EthTx)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(txmgr.EthTxMetaFilter, int, int) int); ok {
+		r1 = rf(filter, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(txmgr.EthTxMetaFilter, int, int) error); ok {
+		r2 = rf(filter, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // EthTxAttempts provides a mock function with given fields: offset, limit
 func (_m *ORM) EthTxAttempts(offset int, limit int) ([]txmgr.EthTxAttempt, int, error) {
 	ret := _m.Called(offset, limit)
@@ -194,6 +226,52 @@ func (_m *ORM) FindEthTxWithAttempts(etxID int64) (txmgr.EthTx, error) {
 	return r0, r1
 }
 
+// GasAnalyticsByJob provides a mock function with given fields: jobID, since
+func (_m *ORM) GasAnalyticsByJob(jobID *int32, since time.Time) ([]txmgr.JobGasAnalytics, error) {
+	ret := _m.Called(jobID, since)
+
+	var r0 []txmgr.JobGasAnalytics
+	if rf, ok := ret.Get(0).(func(*int32, time.Time) []txmgr.JobGasAnalytics); ok {
+		r0 = rf(jobID, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]txmgr.JobGasAnalytics)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*int32, time.Time) error); ok {
+		r1 = rf(jobID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GasSpendByDay provides a mock function with given fields: address, since
+func (_m *ORM) GasSpendByDay(address common.Address, since time.Time) ([]txmgr.DailyGasSpend, error) {
+	ret := _m.Called(address, since)
+
+	var r0 []txmgr.DailyGasSpend
+	if rf, ok := ret.Get(0).(func(common.Address, time.Time) []txmgr.DailyGasSpend); ok {
+		r0 = rf(address, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]txmgr.DailyGasSpend)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, time.Time) error); ok {
+		r1 = rf(address, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // InsertEthReceipt provides a mock function with given fields: receipt
 func (_m *ORM) InsertEthReceipt(receipt *txmgr.EthReceipt) error {
 	ret := _m.Called(receipt)
@@ -27,6 +27,34 @@ type TxManager struct {
 	mock.Mock
 }
 
+// BumpEthTransaction provides a mock function with given fields: ctx, etxID
+func (_m *TxManager) BumpEthTransaction(ctx context.Context, etxID int64) error {
+	ret := _m.Called(ctx, etxID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, etxID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CancelEthTransaction provides a mock function with given fields: ctx, etxID
+func (_m *TxManager) CancelEthTransaction(ctx context.Context, etxID int64) error {
+	ret := _m.Called(ctx, etxID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, etxID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Close provides a mock function with given fields:
 func (_m *TxManager) Close() error {
 	ret := _m.Called()
@@ -118,11 +146,37 @@ func (_m *TxManager) Ready() error {
 	return r0
 }
 
+// ReconcileNonces provides a mock function with given fields: ctx, address, beginningNonce, endingNonce
+func (_m *TxManager) ReconcileNonces(ctx context.Context, address common.Address, beginningNonce int64, endingNonce int64) (txmgr.NonceReconciliation, error) {
+	ret := _m.Called(ctx, address, beginningNonce, endingNonce)
+
+	var r0 txmgr.NonceReconciliation
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, int64, int64) txmgr.NonceReconciliation); ok {
+		r0 = rf(ctx, address, beginningNonce, endingNonce)
+	} else {
+		r0 = ret.Get(0).(txmgr.NonceReconciliation)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, int64, int64) error); ok {
+		r1 = rf(ctx, address, beginningNonce, endingNonce)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RegisterResumeCallback provides a mock function with given fields: fn
 func (_m *TxManager) RegisterResumeCallback(fn txmgr.ResumeCallback) {
 	_m.Called(fn)
 }
 
+// RegisterTxEventCallback provides a mock function with given fields: fn
+func (_m *TxManager) RegisterTxEventCallback(fn txmgr.TxEventCallback) {
+	_m.Called(fn)
+}
+
 // Reset provides a mock function with given fields: f, addr, abandon
 func (_m *TxManager) Reset(f func(), addr common.Address, abandon bool) error {
 	ret := _m.Called(f, addr, abandon)
@@ -31,7 +31,14 @@ type EthTxMeta struct {
 	JobID *int32 `json:"JobID,omitempty"`
 
 	// Pipeline fields
+	// RunID and TaskName identify the pipeline run and the task that created
+	// the tx. RunID is only set for runs that are saved before they execute.
+	RunID        *int64    `json:"RunID,omitempty"`
+	TaskName     *string   `json:"TaskName,omitempty"`
 	FailOnRevert null.Bool `json:"FailOnRevert,omitempty"`
+	// Overrides the configured BLOCK_HISTORY_ESTIMATOR_TRANSACTION_PERCENTILE
+	// for the initial attempt, if the estimator supports it
+	GasPricePercentile *uint16 `json:"GasPricePercentile,omitempty"`
 
 	// VRF-only fields
 	RequestID     *common.Hash `json:"RequestID,omitempty"`
@@ -192,6 +199,10 @@ type EthTx struct {
 	// TransmitChecker defines the check that should be performed before a transaction is submitted on
 	// chain.
 	TransmitChecker *datatypes.JSON
+
+	// IdempotencyKey is set on the priority transactions, which may be
+	// inserted again after a timeout
+	IdempotencyKey uuid.NullUUID
 }
 
 func (e EthTx) GetError() error {
@@ -297,6 +308,8 @@ type EthTxAttempt struct {
 	State                   EthTxAttemptState
 	EthReceipts             []EthReceipt `json:"-"`
 	TxType                  int
+	// RevertReason is the decoded reason of a reverted transaction, if known
+	RevertReason null.String
 }
 
 // GetSignedTx decodes the SignedRawTx into a types.Transaction struct
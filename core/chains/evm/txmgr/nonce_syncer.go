@@ -3,6 +3,7 @@ package txmgr
 import (
 	"context"
 	"fmt"
+	"math"
 	"math/big"
 	"sync"
 	"time"
@@ -12,16 +13,16 @@ import (
 	"github.com/smartcontractkit/sqlx"
 	"go.uber.org/multierr"
 
+	"github.com/smartcontractkit/chainlink/core/assets"
 	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 type (
-	NonceSyncerKeyStore interface {
-		GetNextNonce(address common.Address, chainID *big.Int, qopts ...pg.QOpt) (int64, error)
-	}
 	// NonceSyncer manages the delicate task of syncing the local nonce with the
 	// chain nonce in case of divergence.
 	//
@@ -54,30 +55,66 @@ type (
 	//
 	// This gives us re-org protection up to ETH_FINALITY_DEPTH deep in the
 	// worst case, which is in line with our other guarantees.
+	//
+	// The opposite can also happen: the local nonce is ahead of the chain
+	// nonce, but some of the nonces in between have no eth_tx, e.g. because
+	// they were abandoned. Nothing will ever be mined at these nonces, so all
+	// the transactions after them are stuck. We backfill every such gap with a
+	// placeholder eth_tx, a zero-value transfer to self, which the EthConfirmer
+	// then sends and bumps like any other transaction.
 	NonceSyncer struct {
+		db        *sqlx.DB
 		q         pg.Q
 		ethClient evmclient.Client
 		chainID   *big.Int
 		logger    logger.Logger
-		kst       NonceSyncerKeyStore
+		kst       KeyStore
+		config    Config
+		estimator gas.Estimator
+		ChainKeyStore
 	}
 	// NSinserttx represents an EthTx and Attempt to be inserted together
 	NSinserttx struct {
 		Etx     EthTx
 		Attempt EthTxAttempt
 	}
+	// NonceReconciliation is the outcome of reconciling the local nonce of a
+	// key with the chain.
+	NonceReconciliation struct {
+		Address common.Address
+		// LocalNonce is keys.next_nonce before reconciling
+		LocalNonce int64
+		// LatestNonce and PendingNonce are the results of
+		// eth_getTransactionCount at the latest and pending blocks. The
+		// latest nonce is only fetched if the local nonce is ahead of the
+		// pending nonce.
+		LatestNonce  uint64
+		PendingNonce uint64
+		// NextNonce is keys.next_nonce after reconciling
+		NextNonce int64
+		// Backfilled are the nonces that were filled with placeholder eth_txes
+		Backfilled []int64
+		// Rebroadcast are the nonces of the eth_txes that were re-sent
+		Rebroadcast []int64
+	}
 )
 
 // NewNonceSyncer returns a new syncer
-func NewNonceSyncer(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig, ethClient evmclient.Client, kst NonceSyncerKeyStore) *NonceSyncer {
+func NewNonceSyncer(db *sqlx.DB, lggr logger.Logger, cfg Config, ethClient evmclient.Client, kst KeyStore) *NonceSyncer {
 	lggr = lggr.Named("NonceSyncer")
 	q := pg.NewQ(db, lggr, cfg)
 	return &NonceSyncer{
+		db,
 		q,
 		ethClient,
 		ethClient.ChainID(),
 		lggr,
 		kst,
+		cfg,
+		// Placeholders are sent at the default gas price and left to the
+		// EthConfirmer to bump, since the node's estimator may not be started yet
+		gas.NewFixedPriceEstimator(cfg, lggr),
+		NewChainKeyStore(*ethClient.ChainID(), cfg, kst),
 	}
 }
 
@@ -93,7 +130,7 @@ func (s NonceSyncer) SyncAll(ctx context.Context, keyStates []ethkey.State) (mer
 	for _, keyState := range keyStates {
 		go func(k ethkey.State) {
 			defer wg.Done()
-			if err := s.fastForwardNonceIfNecessary(ctx, k.Address.Address()); err != nil {
+			if _, err := s.Reconcile(ctx, k.Address.Address(), 0, math.MaxInt64); err != nil {
 				errMu.Lock()
 				defer errMu.Unlock()
 				merr = multierr.Combine(merr, err)
@@ -106,18 +143,58 @@ func (s NonceSyncer) SyncAll(ctx context.Context, keyStates []ethkey.State) (mer
 	return errors.Wrap(merr, "NonceSyncer#fastForwardNoncesIfNecessary failed")
 }
 
-func (s NonceSyncer) fastForwardNonceIfNecessary(ctx context.Context, address common.Address) error {
-	chainNonce, err := s.pendingNonceFromEthClient(ctx, address)
+// Reconcile fast forwards the local nonce of the key if the chain is ahead of
+// it, and backfills the gaps between the chain nonce and the local nonce that
+// fall within the given (inclusive) nonce range.
+//
+// Like SyncAll, it must not be called while the EthBroadcaster or EthConfirmer
+// are running.
+func (s NonceSyncer) Reconcile(ctx context.Context, address common.Address, beginningNonce, endingNonce int64) (rec NonceReconciliation, err error) {
+	rec.Address = address
+	rec.PendingNonce, err = s.pendingNonceFromEthClient(ctx, address)
 	if err != nil {
-		return errors.Wrap(err, "GetNextNonce failed to loadInitialNonceFromEthClient")
+		return rec, errors.Wrap(err, "GetNextNonce failed to loadInitialNonceFromEthClient")
 	}
-	if chainNonce == 0 {
-		return nil
+	rec.LocalNonce, err = s.kst.GetNextNonce(address, s.chainID, pg.WithParentCtx(ctx))
+	if err != nil {
+		return rec, err
 	}
 
-	keyNextNonce, err := s.kst.GetNextNonce(address, s.chainID, pg.WithParentCtx(ctx))
+	chainNonce := rec.PendingNonce
+	if chainNonce < uint64(rec.LocalNonce) {
+		// Some of the nonces in between may be gaps. The latest nonce is
+		// checked as well, since not all eth nodes track the pending nonce
+		// reliably.
+		rec.LatestNonce, err = s.latestNonceFromEthClient(ctx, address)
+		if err != nil {
+			return rec, errors.Wrap(err, "failed to get latest nonce from eth client")
+		}
+		if rec.LatestNonce > chainNonce {
+			chainNonce = rec.LatestNonce
+		}
+	}
+	if err = s.fastForwardNonceIfNecessary(ctx, address, rec.LocalNonce, chainNonce); err != nil {
+		return rec, err
+	}
+	rec.NextNonce, err = s.kst.GetNextNonce(address, s.chainID, pg.WithParentCtx(ctx))
 	if err != nil {
-		return err
+		return rec, err
+	}
+
+	// Transactions up to the pending nonce are either mined or in the mempool
+	if int64(chainNonce) > beginningNonce {
+		beginningNonce = int64(chainNonce)
+	}
+	if rec.NextNonce-1 < endingNonce {
+		endingNonce = rec.NextNonce - 1
+	}
+	rec.Backfilled, err = s.backfillNonceGaps(ctx, address, beginningNonce, endingNonce)
+	return rec, err
+}
+
+func (s NonceSyncer) fastForwardNonceIfNecessary(ctx context.Context, address common.Address, keyNextNonce int64, chainNonce uint64) error {
+	if chainNonce == 0 {
+		return nil
 	}
 
 	q := s.q.WithOpts(pg.WithParentCtx(ctx))
@@ -166,11 +243,141 @@ func (s NonceSyncer) fastForwardNonceIfNecessary(ctx context.Context, address co
 	})
 }
 
+// backfillNonceGaps inserts a placeholder eth_tx with an in_progress attempt
+// for every nonce in the (inclusive) range that has no eth_tx
+func (s NonceSyncer) backfillNonceGaps(ctx context.Context, address common.Address, beginningNonce, endingNonce int64) (backfilled []int64, err error) {
+	if beginningNonce > endingNonce {
+		return nil, nil
+	}
+	q := s.q.WithOpts(pg.WithParentCtx(ctx))
+
+	var gaps []int64
+	err = q.Select(&gaps, `
+SELECT n FROM generate_series($1::bigint, $2::bigint) AS n
+WHERE NOT EXISTS (SELECT 1 FROM eth_txes WHERE from_address = $3 AND evm_chain_id = $4 AND nonce = n)
+ORDER BY n ASC
+`, beginningNonce, endingNonce, address, s.chainID.String())
+	if err != nil {
+		return nil, errors.Wrap(err, "NonceSyncer#backfillNonceGaps failed to find nonce gaps")
+	}
+	if len(gaps) == 0 {
+		return nil, nil
+	}
+	s.logger.Warnw(fmt.Sprintf("address %s has %d nonce gap(s) between the chain nonce and the local nonce, transactions after them will be stuck until they are filled. "+
+		"Backfilling them with zero-value transactions to self.", address.Hex(), len(gaps)),
+		"address", address.Hex(), "nonces", gaps)
+
+	for _, nonce := range gaps {
+		ins, err := s.makePlaceholder(address, nonce)
+		if err != nil {
+			return backfilled, err
+		}
+		if err = s.insertPlaceholder(q, &ins); err != nil {
+			return backfilled, err
+		}
+		backfilled = append(backfilled, nonce)
+	}
+	return backfilled, nil
+}
+
+func (s NonceSyncer) makePlaceholder(address common.Address, nonce int64) (ins NSinserttx, err error) {
+	now := time.Now()
+	ins.Etx = EthTx{
+		Nonce:              &nonce,
+		FromAddress:        address,
+		ToAddress:          address,
+		EncodedPayload:     []byte{},
+		Value:              assets.NewEthValue(0),
+		GasLimit:           s.config.EvmGasLimitDefault(),
+		BroadcastAt:        &now,
+		InitialBroadcastAt: &now,
+		CreatedAt:          now,
+		State:              EthTxUnconfirmed,
+		EVMChainID:         *utils.NewBig(s.chainID),
+	}
+	maxGasPrice := s.config.KeySpecificMaxGasPriceWei(address)
+	if s.config.EvmEIP1559DynamicFees() {
+		fee, gasLimit, err := s.estimator.GetDynamicFee(ins.Etx.GasLimit, maxGasPrice)
+		if err != nil {
+			return ins, errors.Wrap(err, "NonceSyncer#makePlaceholder failed to get dynamic fee")
+		}
+		ins.Attempt, err = s.NewDynamicFeeAttempt(ins.Etx, fee, gasLimit)
+		return ins, errors.Wrap(err, "NonceSyncer#makePlaceholder failed to create attempt")
+	}
+	gasPrice, gasLimit, err := s.estimator.GetLegacyGas(ins.Etx.EncodedPayload, ins.Etx.GasLimit, maxGasPrice)
+	if err != nil {
+		return ins, errors.Wrap(err, "NonceSyncer#makePlaceholder failed to get gas price")
+	}
+	ins.Attempt, err = s.NewLegacyAttempt(ins.Etx, gasPrice, gasLimit)
+	return ins, errors.Wrap(err, "NonceSyncer#makePlaceholder failed to create attempt")
+}
+
+func (s NonceSyncer) insertPlaceholder(q pg.Q, ins *NSinserttx) error {
+	return q.Transaction(func(tx pg.Queryer) error {
+		query, args, err := tx.BindNamed(`INSERT INTO eth_txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, broadcast_at, initial_broadcast_at, created_at, state, evm_chain_id) VALUES (
+:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :broadcast_at, :initial_broadcast_at, :created_at, :state, :evm_chain_id
+) RETURNING *`, &ins.Etx)
+		if err != nil {
+			return errors.Wrap(err, "NonceSyncer#insertPlaceholder failed to BindNamed")
+		}
+		if err = tx.Get(&ins.Etx, query, args...); err != nil {
+			return errors.Wrap(err, "NonceSyncer#insertPlaceholder failed to insert eth_tx")
+		}
+		ins.Attempt.EthTxID = ins.Etx.ID
+		query, args, err = tx.BindNamed(insertIntoEthTxAttemptsQuery, &ins.Attempt)
+		if err != nil {
+			return errors.Wrap(err, "NonceSyncer#insertPlaceholder failed to BindNamed")
+		}
+		return errors.Wrap(tx.Get(&ins.Attempt, query, args...), "NonceSyncer#insertPlaceholder failed to insert eth_tx_attempt")
+	})
+}
+
+// Rebroadcast re-sends the highest priced broadcast attempt of every
+// unconfirmed eth_tx of the key within the given (inclusive) nonce range, and
+// returns their nonces.
+func (s NonceSyncer) Rebroadcast(ctx context.Context, address common.Address, beginningNonce, endingNonce int64) (nonces []int64, err error) {
+	var attempts []EthTxAttempt
+	err = s.q.WithOpts(pg.WithParentCtx(ctx)).Select(&attempts, `
+SELECT DISTINCT ON (eth_txes.nonce) eth_tx_attempts.*
+FROM eth_tx_attempts
+JOIN eth_txes ON eth_txes.id = eth_tx_attempts.eth_tx_id AND eth_txes.state IN ('unconfirmed', 'confirmed_missing_receipt')
+WHERE eth_tx_attempts.state <> 'in_progress' AND eth_txes.from_address = $1 AND eth_txes.evm_chain_id = $2 AND eth_txes.nonce BETWEEN $3 AND $4
+ORDER BY eth_txes.nonce ASC, eth_tx_attempts.gas_price DESC, eth_tx_attempts.gas_tip_cap DESC
+`, address, s.chainID.String(), beginningNonce, endingNonce)
+	if err != nil {
+		return nil, errors.Wrap(err, "NonceSyncer#Rebroadcast failed to load eth_tx_attempts")
+	}
+	if len(attempts) == 0 {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, batchSendTransactionTimeout)
+	defer cancel()
+	reqs, err := batchSendTransactions(ctx, s.db, attempts, int(s.config.EvmRPCDefaultBatchSize()), s.logger, s.ethClient)
+	if err != nil {
+		return nil, errors.Wrap(err, "NonceSyncer#Rebroadcast failed to re-send transactions")
+	}
+	logResendResult(s.logger, reqs)
+
+	if err = loadEthTxes(s.q, attempts); err != nil {
+		return nil, errors.Wrap(err, "NonceSyncer#Rebroadcast failed to load eth_txes")
+	}
+	for _, attempt := range attempts {
+		nonces = append(nonces, *attempt.EthTx.Nonce)
+	}
+	return nonces, nil
+}
+
 func (s NonceSyncer) pendingNonceFromEthClient(ctx context.Context, account common.Address) (nextNonce uint64, err error) {
 	nextNonce, err = s.ethClient.PendingNonceAt(ctx, account)
 	return nextNonce, errors.WithStack(err)
 }
 
+func (s NonceSyncer) latestNonceFromEthClient(ctx context.Context, account common.Address) (nonce uint64, err error) {
+	nonce, err = s.ethClient.NonceAt(ctx, account, nil)
+	return nonce, errors.WithStack(err)
+}
+
 func (s NonceSyncer) hasInProgressTransaction(q pg.Queryer, account common.Address) (exists bool, err error) {
 	err = q.Get(&exists, `SELECT EXISTS(SELECT 1 FROM eth_txes WHERE state = 'in_progress' AND from_address = $1 AND evm_chain_id = $2)`, account, s.chainID.String())
 	return exists, errors.Wrap(err, "hasInProgressTransaction failed")
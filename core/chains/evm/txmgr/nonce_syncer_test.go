@@ -1,6 +1,8 @@
 package txmgr_test
 
 import (
+	"math"
+	"math/big"
 	"testing"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
@@ -11,6 +13,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/sqlx"
 	"github.com/stretchr/testify/assert"
@@ -33,7 +36,7 @@ func Test_NonceSyncer_SyncAll(t *testing.T) {
 			return from == addr
 		})).Return(uint64(0), errors.New("something exploded"))
 
-		ns := txmgr.NewNonceSyncer(db, logger.TestLogger(t), cfg, ethClient, ethKeyStore)
+		ns := txmgr.NewNonceSyncer(db, logger.TestLogger(t), evmtest.NewChainScopedConfig(t, cfg), ethClient, ethKeyStore)
 
 		sendingKeys := cltest.MustSendingKeyStates(t, ethKeyStore, testutils.FixtureChainID)
 		err := ns.SyncAll(testutils.Context(t), sendingKeys)
@@ -58,7 +61,7 @@ func Test_NonceSyncer_SyncAll(t *testing.T) {
 			return from == addr
 		})).Return(uint64(0), nil)
 
-		ns := txmgr.NewNonceSyncer(db, logger.TestLogger(t), cfg, ethClient, ethKeyStore)
+		ns := txmgr.NewNonceSyncer(db, logger.TestLogger(t), evmtest.NewChainScopedConfig(t, cfg), ethClient, ethKeyStore)
 
 		sendingKeys := cltest.MustSendingKeyStates(t, ethKeyStore, testutils.FixtureChainID)
 		require.NoError(t, ns.SyncAll(testutils.Context(t), sendingKeys))
@@ -73,22 +76,25 @@ func Test_NonceSyncer_SyncAll(t *testing.T) {
 		db := pgtest.NewSqlxDB(t)
 		cfg := cltest.NewTestGeneralConfig(t)
 
+		borm := cltest.NewTxmORM(t, db, cfg)
 		ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
 		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
 
 		k1, _ := cltest.MustInsertRandomKey(t, ethKeyStore, int64(32))
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 31, k1.Address)
 
 		ethClient.On("PendingNonceAt", mock.Anything, mock.MatchedBy(func(addr common.Address) bool {
 			return k1.Address == addr
 		})).Return(uint64(31), nil)
+		ethClient.On("NonceAt", mock.Anything, k1.Address, (*big.Int)(nil)).Return(uint64(31), nil)
 
-		ns := txmgr.NewNonceSyncer(db, logger.TestLogger(t), cfg, ethClient, ethKeyStore)
+		ns := txmgr.NewNonceSyncer(db, logger.TestLogger(t), evmtest.NewChainScopedConfig(t, cfg), ethClient, ethKeyStore)
 
 		sendingKeys := cltest.MustSendingKeyStates(t, ethKeyStore, testutils.FixtureChainID)
 		require.NoError(t, ns.SyncAll(testutils.Context(t), sendingKeys))
 
-		cltest.AssertCount(t, db, "eth_txes", 0)
-		cltest.AssertCount(t, db, "eth_tx_attempts", 0)
+		cltest.AssertCount(t, db, "eth_txes", 1)
+		cltest.AssertCount(t, db, "eth_tx_attempts", 1)
 
 		assertDatabaseNonce(t, db, k1.Address, 32)
 	})
@@ -112,7 +118,7 @@ func Test_NonceSyncer_SyncAll(t *testing.T) {
 			return key1 == addr
 		})).Return(uint64(5), nil)
 
-		ns := txmgr.NewNonceSyncer(db, logger.TestLogger(t), cfg, ethClient, ethKeyStore)
+		ns := txmgr.NewNonceSyncer(db, logger.TestLogger(t), evmtest.NewChainScopedConfig(t, cfg), ethClient, ethKeyStore)
 
 		sendingKeys := cltest.MustSendingKeyStates(t, ethKeyStore, testutils.FixtureChainID)
 		require.NoError(t, ns.SyncAll(testutils.Context(t), sendingKeys))
@@ -136,7 +142,7 @@ func Test_NonceSyncer_SyncAll(t *testing.T) {
 			// by 1, but does not need to change when taking into account the in_progress tx
 			return key1 == addr
 		})).Return(uint64(1), nil)
-		ns := txmgr.NewNonceSyncer(db, logger.TestLogger(t), cfg, ethClient, ethKeyStore)
+		ns := txmgr.NewNonceSyncer(db, logger.TestLogger(t), evmtest.NewChainScopedConfig(t, cfg), ethClient, ethKeyStore)
 
 		sendingKeys := cltest.MustSendingKeyStates(t, ethKeyStore, testutils.FixtureChainID)
 		require.NoError(t, ns.SyncAll(testutils.Context(t), sendingKeys))
@@ -148,11 +154,88 @@ func Test_NonceSyncer_SyncAll(t *testing.T) {
 			// by 2, but only ahead by 1 if we count the in_progress tx as +1
 			return key1 == addr
 		})).Return(uint64(2), nil)
-		ns = txmgr.NewNonceSyncer(db, logger.TestLogger(t), cfg, ethClient, ethKeyStore)
+		ns = txmgr.NewNonceSyncer(db, logger.TestLogger(t), evmtest.NewChainScopedConfig(t, cfg), ethClient, ethKeyStore)
 
 		require.NoError(t, ns.SyncAll(testutils.Context(t), sendingKeys))
 		assertDatabaseNonce(t, db, key1, 1)
 	})
+
+	t.Run("backfills nonce gaps between chain nonce and local nonce", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		cfg := cltest.NewTestGeneralConfig(t)
+		borm := cltest.NewTxmORM(t, db, cfg)
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+
+		_, key1 := cltest.MustInsertRandomKey(t, ethKeyStore, int64(5))
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 2, key1)
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 4, key1)
+
+		ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("PendingNonceAt", mock.Anything, key1).Return(uint64(2), nil)
+		ethClient.On("NonceAt", mock.Anything, key1, (*big.Int)(nil)).Return(uint64(2), nil)
+		ns := txmgr.NewNonceSyncer(db, logger.TestLogger(t), evmtest.NewChainScopedConfig(t, cfg), ethClient, ethKeyStore)
+
+		sendingKeys := cltest.MustSendingKeyStates(t, ethKeyStore, testutils.FixtureChainID)
+		require.NoError(t, ns.SyncAll(testutils.Context(t), sendingKeys))
+
+		assertDatabaseNonce(t, db, key1, 5)
+		cltest.AssertCount(t, db, "eth_txes", 3)
+
+		var etx txmgr.EthTx
+		require.NoError(t, db.Get(&etx, `SELECT * FROM eth_txes WHERE nonce = 3`))
+		assert.Equal(t, txmgr.EthTxUnconfirmed, etx.State)
+		assert.Equal(t, key1, etx.FromAddress)
+		assert.Equal(t, key1, etx.ToAddress)
+		assert.Empty(t, etx.EncodedPayload)
+
+		var attempt txmgr.EthTxAttempt
+		require.NoError(t, db.Get(&attempt, `SELECT * FROM eth_tx_attempts WHERE eth_tx_id = $1`, etx.ID))
+		assert.Equal(t, txmgr.EthTxAttemptInProgress, attempt.State)
+	})
+}
+
+func Test_NonceSyncer_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	borm := cltest.NewTxmORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+
+	_, key1 := cltest.MustInsertRandomKey(t, ethKeyStore, int64(4))
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 3, key1)
+
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ethClient.On("PendingNonceAt", mock.Anything, key1).Return(uint64(1), nil)
+	ethClient.On("NonceAt", mock.Anything, key1, (*big.Int)(nil)).Return(uint64(0), nil)
+	ns := txmgr.NewNonceSyncer(db, logger.TestLogger(t), evmtest.NewChainScopedConfig(t, cfg), ethClient, ethKeyStore)
+
+	t.Run("only backfills gaps within the nonce range", func(t *testing.T) {
+		rec, err := ns.Reconcile(testutils.Context(t), key1, 0, 1)
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), rec.LocalNonce)
+		assert.Equal(t, int64(4), rec.NextNonce)
+		assert.Equal(t, uint64(0), rec.LatestNonce)
+		assert.Equal(t, uint64(1), rec.PendingNonce)
+		// nonce 0 is in the mempool
+		assert.Equal(t, []int64{1}, rec.Backfilled)
+		cltest.AssertCount(t, db, "eth_txes", 2)
+
+		rec, err = ns.Reconcile(testutils.Context(t), key1, 0, math.MaxInt64)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{2}, rec.Backfilled)
+		cltest.AssertCount(t, db, "eth_txes", 3)
+	})
+
+	t.Run("rebroadcasts unconfirmed transactions within the nonce range", func(t *testing.T) {
+		ethClient.On("BatchCallContextAll", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+			return len(b) == 1 && b[0].Method == "eth_sendRawTransaction"
+		})).Return(nil).Once()
+
+		nonces, err := ns.Rebroadcast(testutils.Context(t), key1, 3, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3}, nonces)
+	})
 }
 
 func assertDatabaseNonce(t *testing.T, db *sqlx.DB, address common.Address, nonce int64) {
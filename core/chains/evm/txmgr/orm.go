@@ -1,6 +1,8 @@
 package txmgr
 
 import (
+	"math/big"
+	"strconv"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -8,8 +10,11 @@ import (
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/sqlx"
 
+	"github.com/smartcontractkit/chainlink/core/assets"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 //go:generate mockery --name ORM --output ./mocks/ --case=underscore
@@ -17,6 +22,7 @@ import (
 type ORM interface {
 	EthTransactions(offset, limit int) ([]EthTx, int, error)
 	EthTransactionsWithAttempts(offset, limit int) ([]EthTx, int, error)
+	EthTransactionsWithAttemptsByMeta(filter EthTxMetaFilter, offset, limit int) ([]EthTx, int, error)
 	EthTxAttempts(offset, limit int) ([]EthTxAttempt, int, error)
 	FindEthTxAttempt(hash common.Hash) (*EthTxAttempt, error)
 	FindEthTxAttemptsByEthTxIDs(ids []int64) ([]EthTxAttempt, error)
@@ -25,6 +31,8 @@ type ORM interface {
 	InsertEthTx(etx *EthTx) error
 	InsertEthReceipt(receipt *EthReceipt) error
 	FindEthTxWithAttempts(etxID int64) (etx EthTx, err error)
+	GasSpendByDay(address common.Address, since time.Time) ([]DailyGasSpend, error)
+	GasAnalyticsByJob(jobID *int32, since time.Time) ([]JobGasAnalytics, error)
 }
 
 type orm struct {
@@ -132,6 +140,168 @@ func (o *orm) EthTransactionsWithAttempts(offset, limit int) (txs []EthTx, count
 	return
 }
 
+// EthTxMetaFilter restricts eth transactions to the ones created by a job or a
+// pipeline run, or sent from an address. Nil fields match any transaction.
+type EthTxMetaFilter struct {
+	JobID       *int32
+	RunID       *int64
+	FromAddress *common.Address
+}
+
+// EthTransactionsWithAttemptsByMeta is like EthTransactionsWithAttempts, but
+// only returns the eth transactions matching the filter.
+func (o *orm) EthTransactionsWithAttemptsByMeta(filter EthTxMetaFilter, offset, limit int) (txs []EthTx, count int, err error) {
+	var jobID, runID *string
+	if filter.JobID != nil {
+		s := strconv.FormatInt(int64(*filter.JobID), 10)
+		jobID = &s
+	}
+	if filter.RunID != nil {
+		s := strconv.FormatInt(*filter.RunID, 10)
+		runID = &s
+	}
+	var fromAddress interface{}
+	if filter.FromAddress != nil {
+		fromAddress = filter.FromAddress.Bytes()
+	}
+	const where = `WHERE id IN (SELECT DISTINCT eth_tx_id FROM eth_tx_attempts)
+AND ($1::text IS NULL OR meta->>'JobID' = $1)
+AND ($2::text IS NULL OR meta->>'RunID' = $2)
+AND ($3::bytea IS NULL OR from_address = $3)`
+
+	sql := `SELECT count(*) FROM eth_txes ` + where
+	if err = o.q.Get(&count, sql, jobID, runID, fromAddress); err != nil {
+		return
+	}
+
+	sql = `SELECT * FROM eth_txes ` + where + ` ORDER BY id desc LIMIT $4 OFFSET $5`
+	if err = o.q.Select(&txs, sql, jobID, runID, fromAddress, limit, offset); err != nil {
+		return
+	}
+
+	err = o.preloadTxAttempts(txs)
+	return
+}
+
+// DailyGasSpend is the gas spent by the confirmed transactions of an address
+// on a chain in a UTC day
+type DailyGasSpend struct {
+	Date         time.Time
+	EVMChainID   utils.Big
+	Transactions int
+	GasUsed      uint64
+	// Fee is the gas used times the gas price of the attempts, or their fee
+	// cap for EIP-1559 transactions, so an upper bound of their fee
+	Fee assets.Eth
+}
+
+// GasSpendByDay returns the gas spent per day by the transactions sent from
+// the address since a time, by their receipt, oldest first
+func (o *orm) GasSpendByDay(address common.Address, since time.Time) ([]DailyGasSpend, error) {
+	var rows []struct {
+		EVMChainID utils.Big
+		Receipt    evmtypes.Receipt
+		GasPrice   utils.Big
+		CreatedAt  time.Time
+	}
+	err := o.q.Select(&rows, `SELECT eth_txes.evm_chain_id, eth_receipts.receipt, COALESCE(eth_tx_attempts.gas_price, eth_tx_attempts.gas_fee_cap) AS gas_price, eth_receipts.created_at
+FROM eth_receipts
+JOIN eth_tx_attempts ON eth_tx_attempts.hash = eth_receipts.tx_hash
+JOIN eth_txes ON eth_txes.id = eth_tx_attempts.eth_tx_id
+WHERE eth_txes.from_address = $1 AND eth_txes.state = 'confirmed' AND eth_receipts.created_at >= $2
+ORDER BY eth_receipts.created_at, eth_receipts.id`, address, since)
+	if err != nil {
+		return nil, errors.Wrap(err, "GasSpendByDay failed")
+	}
+
+	var days []DailyGasSpend
+	for _, row := range rows {
+		date := row.CreatedAt.UTC().Truncate(24 * time.Hour)
+		i := len(days) - 1
+		for ; i >= 0; i-- {
+			if days[i].Date.Equal(date) && days[i].EVMChainID.Cmp(&row.EVMChainID) == 0 {
+				break
+			}
+		}
+		if i < 0 {
+			days = append(days, DailyGasSpend{Date: date, EVMChainID: row.EVMChainID})
+			i = len(days) - 1
+		}
+		fee := new(big.Int).Mul(new(big.Int).SetUint64(row.Receipt.GasUsed), row.GasPrice.ToInt())
+		days[i].Transactions++
+		days[i].GasUsed += row.Receipt.GasUsed
+		days[i].Fee.ToInt().Add(days[i].Fee.ToInt(), fee)
+	}
+	return days, nil
+}
+
+// JobGasAnalytics aggregates the confirmed transactions of a job on a chain
+type JobGasAnalytics struct {
+	JobID        int32
+	EVMChainID   utils.Big
+	Transactions int
+	// Attempts counts the attempts of the transactions, including their bumps
+	Attempts int
+	GasUsed  uint64
+	// Fee is the gas used times the gas price of the included attempts, or
+	// their fee cap for EIP-1559 transactions, so an upper bound of their fee
+	Fee assets.Eth
+}
+
+// AverageBumps returns the average number of gas bumps of the transactions
+// before their inclusion
+func (a JobGasAnalytics) AverageBumps() float64 {
+	if a.Transactions == 0 {
+		return 0
+	}
+	return float64(a.Attempts-a.Transactions) / float64(a.Transactions)
+}
+
+// GasAnalyticsByJob aggregates the transactions of the jobs created since a
+// time and confirmed, by job and chain. If jobID is set, only the transactions
+// of this job are aggregated.
+func (o *orm) GasAnalyticsByJob(jobID *int32, since time.Time) ([]JobGasAnalytics, error) {
+	var rows []struct {
+		JobID      int32
+		EVMChainID utils.Big
+		Receipt    evmtypes.Receipt
+		GasPrice   utils.Big
+		Attempts   int
+	}
+	// A transaction reorged into another block has several receipts, the
+	// latest one is used
+	err := o.q.Select(&rows, `SELECT * FROM (
+	SELECT DISTINCT ON (eth_txes.id) (eth_txes.meta->>'JobID')::int AS job_id, eth_txes.evm_chain_id, eth_receipts.receipt,
+		COALESCE(eth_tx_attempts.gas_price, eth_tx_attempts.gas_fee_cap) AS gas_price,
+		(SELECT count(*) FROM eth_tx_attempts a WHERE a.eth_tx_id = eth_txes.id) AS attempts
+	FROM eth_txes
+	JOIN eth_tx_attempts ON eth_tx_attempts.eth_tx_id = eth_txes.id
+	JOIN eth_receipts ON eth_receipts.tx_hash = eth_tx_attempts.hash
+	WHERE eth_txes.state = 'confirmed' AND eth_txes.meta->>'JobID' IS NOT NULL AND eth_txes.created_at >= $1
+	ORDER BY eth_txes.id, eth_receipts.block_number DESC
+) txes
+WHERE $2::int IS NULL OR job_id = $2
+ORDER BY job_id, evm_chain_id`, since, jobID)
+	if err != nil {
+		return nil, errors.Wrap(err, "GasAnalyticsByJob failed")
+	}
+
+	var jobs []JobGasAnalytics
+	for _, row := range rows {
+		i := len(jobs) - 1
+		if i < 0 || jobs[i].JobID != row.JobID || jobs[i].EVMChainID.Cmp(&row.EVMChainID) != 0 {
+			jobs = append(jobs, JobGasAnalytics{JobID: row.JobID, EVMChainID: row.EVMChainID})
+			i++
+		}
+		fee := new(big.Int).Mul(new(big.Int).SetUint64(row.Receipt.GasUsed), row.GasPrice.ToInt())
+		jobs[i].Transactions++
+		jobs[i].Attempts += row.Attempts
+		jobs[i].GasUsed += row.Receipt.GasUsed
+		jobs[i].Fee.ToInt().Add(jobs[i].Fee.ToInt(), fee)
+	}
+	return jobs, nil
+}
+
 // EthTxAttempts returns the last tx attempts sorted by created_at descending.
 func (o *orm) EthTxAttempts(offset, limit int) (txs []EthTxAttempt, count int, err error) {
 	sql := `SELECT count(*) FROM eth_tx_attempts`
@@ -3,6 +3,7 @@ package txmgr_test
 import (
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
@@ -61,6 +62,119 @@ func TestORM_EthTransactionsWithAttempts(t *testing.T) {
 	assert.Equal(t, int64(1), *txs[0].Nonce, "transactions should be sorted by nonce")
 }
 
+func TestORM_EthTransactionsWithAttemptsByMeta(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	orm := cltest.NewTxmORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+
+	_, from := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+	tx1 := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, orm, 0, 1, from)
+	tx2 := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, orm, 1, 2, from)
+	cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, orm, 2, 3, from)
+	pgtest.MustExec(t, db, `UPDATE eth_txes SET meta = '{"JobID": 1, "RunID": 10, "TaskName": "submit"}' WHERE id = $1`, tx1.ID)
+	pgtest.MustExec(t, db, `UPDATE eth_txes SET meta = '{"JobID": 1, "RunID": 11}' WHERE id = $1`, tx2.ID)
+
+	jobID, otherJobID, runID := int32(1), int32(2), int64(10)
+
+	txs, count, err := orm.EthTransactionsWithAttemptsByMeta(txmgr.EthTxMetaFilter{JobID: &jobID}, 0, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, txs, 2)
+	assert.Equal(t, tx2.ID, txs[0].ID)
+	assert.Equal(t, tx1.ID, txs[1].ID)
+	assert.Len(t, txs[0].EthTxAttempts, 1, "eth tx attempts are preloaded")
+
+	txs, count, err = orm.EthTransactionsWithAttemptsByMeta(txmgr.EthTxMetaFilter{JobID: &jobID, RunID: &runID}, 0, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, txs, 1)
+	assert.Equal(t, tx1.ID, txs[0].ID)
+	meta, err := txs[0].GetMeta()
+	require.NoError(t, err)
+	assert.Equal(t, "submit", *meta.TaskName)
+
+	_, count, err = orm.EthTransactionsWithAttemptsByMeta(txmgr.EthTxMetaFilter{JobID: &otherJobID}, 0, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	_, count, err = orm.EthTransactionsWithAttemptsByMeta(txmgr.EthTxMetaFilter{FromAddress: &from}, 0, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	_, other := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+	_, count, err = orm.EthTransactionsWithAttemptsByMeta(txmgr.EthTxMetaFilter{FromAddress: &other}, 0, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestORM_GasSpendByDay(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	orm := cltest.NewTxmORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+
+	_, from := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+	_, other := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+	cltest.MustInsertConfirmedEthTxWithReceipt(t, orm, from, 0, 1)
+	cltest.MustInsertConfirmedEthTxWithReceipt(t, orm, from, 1, 2)
+	cltest.MustInsertConfirmedEthTxWithReceipt(t, orm, other, 0, 3)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, orm, 2, from)
+	pgtest.MustExec(t, db, `UPDATE eth_receipts SET receipt = jsonb_set(receipt, '{gasUsed}', '"0x5208"')`)
+	pgtest.MustExec(t, db, `UPDATE eth_tx_attempts SET gas_price = 2`)
+
+	days, err := orm.GasSpendByDay(from, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Len(t, days, 1)
+	assert.Equal(t, time.Now().UTC().Truncate(24*time.Hour), days[0].Date)
+	assert.Equal(t, int64(0), days[0].EVMChainID.Int64())
+	assert.Equal(t, 2, days[0].Transactions)
+	assert.Equal(t, uint64(42000), days[0].GasUsed)
+	assert.Equal(t, big.NewInt(84000), days[0].Fee.ToInt())
+
+	days, err = orm.GasSpendByDay(from, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, days)
+}
+
+func TestORM_GasAnalyticsByJob(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	orm := cltest.NewTxmORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+
+	_, from := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+	etx1 := cltest.MustInsertConfirmedEthTxWithReceipt(t, orm, from, 0, 1)
+	etx2 := cltest.MustInsertConfirmedEthTxWithReceipt(t, orm, from, 1, 2)
+	cltest.MustInsertConfirmedEthTxWithReceipt(t, orm, from, 2, 3) // without job
+	blockNum := int64(2)
+	bump := cltest.NewLegacyEthTxAttempt(t, etx2.ID)
+	bump.State = txmgr.EthTxAttemptBroadcast
+	bump.BroadcastBeforeBlockNum = &blockNum
+	require.NoError(t, orm.InsertEthTxAttempt(&bump))
+	pgtest.MustExec(t, db, `UPDATE eth_txes SET meta = '{"JobID": 42}' WHERE id IN ($1, $2)`, etx1.ID, etx2.ID)
+	pgtest.MustExec(t, db, `UPDATE eth_receipts SET receipt = jsonb_set(receipt, '{gasUsed}', '"0x5208"')`)
+	pgtest.MustExec(t, db, `UPDATE eth_tx_attempts SET gas_price = 2`)
+
+	jobs, err := orm.GasAnalyticsByJob(nil, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, int32(42), jobs[0].JobID)
+	assert.Equal(t, 2, jobs[0].Transactions)
+	assert.Equal(t, 3, jobs[0].Attempts)
+	assert.Equal(t, 0.5, jobs[0].AverageBumps())
+	assert.Equal(t, uint64(42000), jobs[0].GasUsed)
+	assert.Equal(t, big.NewInt(84000), jobs[0].Fee.ToInt())
+
+	jobID := int32(43)
+	jobs, err = orm.GasAnalyticsByJob(&jobID, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, jobs)
+}
+
 func TestORM_EthTransactions(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 	cfg := cltest.NewTestGeneralConfig(t)
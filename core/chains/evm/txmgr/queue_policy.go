@@ -0,0 +1,105 @@
+package txmgr
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// Queue policies control what happens when a transaction is created from a
+// key that already has EvmMaxQueuedTransactions unstarted transactions
+const (
+	// EthTxQueuePolicyReject fails the new transaction
+	EthTxQueuePolicyReject = "Reject"
+	// EthTxQueuePolicyDropOldest fails the oldest unstarted transactions of
+	// the key to make room for the new one
+	EthTxQueuePolicyDropOldest = "DropOldest"
+	// EthTxQueuePolicyBlock waits for room in the queue, up to
+	// queueBlockTimeout
+	EthTxQueuePolicyBlock = "Block"
+)
+
+const (
+	queueBlockTimeout      = 30 * time.Second
+	queueBlockPollInterval = 1 * time.Second
+)
+
+// ErrEthTxQueueFull is returned when a transaction cannot be created because
+// the queue of the sending key is full
+var ErrEthTxQueueFull = errors.New("transaction queue is full")
+
+var promNumQueueOverflows = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "tx_manager_num_queue_overflows",
+	Help: "Number of transactions created from a key whose queue was full, labeled by the queue policy applied",
+}, []string{"evmChainID", "policy"})
+
+// ensureQueueCapacity applies the queue policy if the queue of the sending key
+// is full
+func (b *Txm) ensureQueueCapacity(q pg.Q, newTx NewTx) error {
+	maxQueued := b.config.EvmMaxQueuedTransactions()
+	err := CheckEthTxQueueCapacity(q, newTx.FromAddress, maxQueued, b.chainID)
+	if !errors.Is(err, ErrEthTxQueueFull) {
+		return err
+	}
+
+	policy := b.config.EvmMaxQueuedTransactionsPolicy()
+	promNumQueueOverflows.WithLabelValues(b.chainID.String(), policy).Inc()
+	switch policy {
+	case EthTxQueuePolicyDropOldest:
+		dropped, derr := dropOldestUnstartedEthTxes(q, newTx.FromAddress, maxQueued, b.chainID.String())
+		if derr != nil {
+			return derr
+		}
+		b.logger.Warnw(fmt.Sprintf("Transaction queue full, dropped %d oldest unstarted transactions", dropped), "fromAddress", newTx.FromAddress, "maxQueuedTransactions", maxQueued)
+		return nil
+	case EthTxQueuePolicyBlock:
+		return b.waitForQueueCapacity(q, newTx.FromAddress, maxQueued)
+	default:
+		return err
+	}
+}
+
+// dropOldestUnstartedEthTxes fails the oldest unstarted transactions of
+// fromAddress, so that only maxQueued-1 remain. Pipeline runs waiting for them
+// are resumed with an error by the EthConfirmer.
+func dropOldestUnstartedEthTxes(q pg.Queryer, fromAddress common.Address, maxQueued uint64, chainID string) (int64, error) {
+	res, err := q.Exec(`
+UPDATE eth_txes SET state = 'fatal_error', error = $1
+WHERE state = 'unstarted' AND id IN (
+	SELECT id FROM eth_txes
+	WHERE from_address = $2 AND state = 'unstarted' AND evm_chain_id = $3
+	ORDER BY id DESC OFFSET $4
+)`, "dropped from full transaction queue", fromAddress, chainID, maxQueued-1)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to drop oldest unstarted eth_txes")
+	}
+	return res.RowsAffected()
+}
+
+// waitForQueueCapacity blocks until the queue of fromAddress has room, the
+// Txm stops, or queueBlockTimeout elapses
+func (b *Txm) waitForQueueCapacity(q pg.Q, fromAddress common.Address, maxQueued uint64) error {
+	timeout := time.NewTimer(queueBlockTimeout)
+	defer timeout.Stop()
+	ticker := time.NewTicker(queueBlockPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-b.chStop:
+			return errors.New("txm is stopping")
+		case <-timeout.C:
+			return errors.Wrapf(ErrEthTxQueueFull, "timed out after %s waiting for room in the queue of %s", queueBlockTimeout, fromAddress.Hex())
+		case <-ticker.C:
+			err := CheckEthTxQueueCapacity(q, fromAddress, maxQueued, b.chainID)
+			if !errors.Is(err, ErrEthTxQueueFull) {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package txmgr
+
+import (
+	"context"
+	"database/sql/driver"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	// priorityQueryTimeout bounds the time spent inserting a priority
+	// transaction before it is held in the retry buffer
+	priorityQueryTimeout = 2 * time.Second
+	// retryBufferCapacity is the maximum number of transactions held in
+	// memory per chain
+	retryBufferCapacity      = 1000
+	retryBufferFlushInterval = 5 * time.Second
+)
+
+var promRetryBufferSize = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "tx_manager_retry_buffer_size",
+	Help: "Number of priority transactions held in memory until the database is available",
+}, []string{"evmChainID"})
+
+// retryBuffer holds priority transactions that could not be inserted because
+// the database was unavailable, and retries their insert until it recovers.
+//
+// It is only a retry buffer: the held transactions are kept in memory and are
+// lost if the node stops before the database recovers, and none of them is
+// broadcast while the database is unavailable, since their nonces are only
+// assigned once they are inserted.
+type retryBuffer struct {
+	mu  sync.Mutex
+	txs []NewTx
+}
+
+func newRetryBuffer() *retryBuffer {
+	return &retryBuffer{}
+}
+
+func (l *retryBuffer) add(newTx NewTx) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.txs) >= retryBufferCapacity {
+		return errors.Errorf("retry buffer is full (%d transactions)", retryBufferCapacity)
+	}
+	l.txs = append(l.txs, newTx)
+	return nil
+}
+
+func (l *retryBuffer) peek() (NewTx, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.txs) == 0 {
+		return NewTx{}, false
+	}
+	return l.txs[0], true
+}
+
+func (l *retryBuffer) pop() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.txs = l.txs[1:]
+}
+
+func (l *retryBuffer) len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.txs)
+}
+
+func (b *Txm) runRetryBuffer() {
+	defer b.wg.Done()
+	ticker := time.NewTicker(retryBufferFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-b.chStop:
+			if n := b.retryBuffer.len(); n > 0 {
+				b.logger.Criticalw("Stopping with priority transactions that were never inserted", "count", n)
+			}
+			return
+		case <-ticker.C:
+			b.flushRetryBuffer()
+		}
+	}
+}
+
+// flushRetryBuffer inserts the held transactions in order, stopping at the
+// first one that fails because the database is still unavailable
+func (b *Txm) flushRetryBuffer() {
+	defer func() {
+		promRetryBufferSize.WithLabelValues(b.chainID.String()).Set(float64(b.retryBuffer.len()))
+	}()
+	for {
+		newTx, ok := b.retryBuffer.peek()
+		if !ok {
+			return
+		}
+		etx, err := b.insertEthTx(b.q, newTx)
+		if isDBUnavailable(err) {
+			b.logger.Debugw("Database still unavailable, keeping priority transactions", "count", b.retryBuffer.len(), "err", err)
+			return
+		}
+		b.retryBuffer.pop()
+		if err != nil {
+			b.logger.Errorw("Dropping priority transaction", "fromAddress", newTx.FromAddress, "toAddress", newTx.ToAddress, "meta", newTx.Meta, "err", err)
+			continue
+		}
+		b.logger.Infow("Inserted priority transaction", "ethTxID", etx.ID, "fromAddress", newTx.FromAddress)
+		b.Trigger(newTx.FromAddress)
+	}
+}
+
+// isDBUnavailable returns true if err was caused by the database timing out or
+// being unreachable, rather than by the transaction being rejected
+func isDBUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, driver.ErrBadConn) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
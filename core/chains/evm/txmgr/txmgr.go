@@ -6,6 +6,7 @@ import (
 	"database/sql"
 	"fmt"
 	"math/big"
+	"strings"
 	"sync"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"github.com/smartcontractkit/sqlx"
+	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
@@ -44,6 +46,7 @@ type Config interface {
 	EvmGasLimitDefault() uint32
 	EvmMaxInFlightTransactions() uint32
 	EvmMaxQueuedTransactions() uint64
+	EvmMaxQueuedTransactionsPolicy() string
 	EvmNonceAutoSync() bool
 	EvmUseForwarders() bool
 	EvmRPCDefaultBatchSize() uint32
@@ -70,6 +73,37 @@ var _ TxManager = &Txm{}
 // ResumeCallback is assumed to be idempotent
 type ResumeCallback func(id uuid.UUID, result interface{}, err error) error
 
+// TxEventCallback is called when a transaction is broadcast, confirmed or
+// fatally errors. It must not block.
+type TxEventCallback func(TxEvent)
+
+// TxEvent is a change of state of a transaction
+type TxEvent struct {
+	EthTxID           int64
+	EVMChainID        *big.Int
+	FromAddress       common.Address
+	ToAddress         common.Address
+	State             EthTxState
+	TxHash            *common.Hash
+	Error             string
+	PipelineTaskRunID uuid.NullUUID
+	Time              time.Time
+}
+
+func newTxEvent(etx EthTx, state EthTxState, hash *common.Hash) TxEvent {
+	return TxEvent{
+		EthTxID:           etx.ID,
+		EVMChainID:        etx.EVMChainID.ToInt(),
+		FromAddress:       etx.FromAddress,
+		ToAddress:         etx.ToAddress,
+		State:             state,
+		TxHash:            hash,
+		Error:             etx.Error.String,
+		PipelineTaskRunID: etx.PipelineTaskRunID,
+		Time:              time.Now(),
+	}
+}
+
 //go:generate mockery --recursive --name TxManager --output ./mocks/ --case=underscore --structname TxManager --filename tx_manager.go
 type TxManager interface {
 	httypes.HeadTrackable
@@ -78,8 +112,12 @@ type TxManager interface {
 	CreateEthTransaction(newTx NewTx, qopts ...pg.QOpt) (etx EthTx, err error)
 	GetGasEstimator() gas.Estimator
 	RegisterResumeCallback(fn ResumeCallback)
+	RegisterTxEventCallback(fn TxEventCallback)
 	SendEther(chainID *big.Int, from, to common.Address, value assets.Eth, gasLimit uint32) (etx EthTx, err error)
 	Reset(f func(), addr common.Address, abandon bool) error
+	BumpEthTransaction(ctx context.Context, etxID int64) error
+	CancelEthTransaction(ctx context.Context, etxID int64) error
+	ReconcileNonces(ctx context.Context, address common.Address, beginningNonce, endingNonce int64) (NonceReconciliation, error)
 }
 
 type reset struct {
@@ -105,10 +143,12 @@ type Txm struct {
 	chainID          big.Int
 	checkerFactory   TransmitCheckerFactory
 
-	chHeads        chan *evmtypes.Head
-	trigger        chan common.Address
-	reset          chan reset
-	resumeCallback ResumeCallback
+	chHeads         chan *evmtypes.Head
+	trigger         chan common.Address
+	reset           chan reset
+	chForce         chan forceRequest
+	resumeCallback  ResumeCallback
+	txEventCallback TxEventCallback
 
 	chStop   chan struct{}
 	chSubbed chan struct{}
@@ -117,12 +157,26 @@ type Txm struct {
 	reaper      *Reaper
 	ethResender *EthResender
 	fwdMgr      *forwarders.FwdMgr
+	retryBuffer *retryBuffer
 }
 
 func (b *Txm) RegisterResumeCallback(fn ResumeCallback) {
 	b.resumeCallback = fn
 }
 
+// RegisterTxEventCallback adds a callback called when a transaction is
+// broadcast, confirmed or fatally errors. Must be called before Start.
+func (b *Txm) RegisterTxEventCallback(fn TxEventCallback) {
+	if prev := b.txEventCallback; prev != nil {
+		b.txEventCallback = func(e TxEvent) {
+			prev(e)
+			fn(e)
+		}
+		return
+	}
+	b.txEventCallback = fn
+}
+
 // NewTxm creates a new Txm with the given configuration.
 func NewTxm(db *sqlx.DB, ethClient evmclient.Client, cfg Config, keyStore KeyStore, eventBroadcaster pg.EventBroadcaster, lggr logger.Logger, checkerFactory TransmitCheckerFactory, logPoller logpoller.LogPoller) *Txm {
 	lggr = lggr.Named("Txm")
@@ -150,6 +204,8 @@ func NewTxm(db *sqlx.DB, ethClient evmclient.Client, cfg Config, keyStore KeySto
 		chStop:           make(chan struct{}),
 		chSubbed:         make(chan struct{}),
 		reset:            make(chan reset),
+		chForce:          make(chan forceRequest),
+		retryBuffer:      newRetryBuffer(),
 	}
 	if cfg.EthTxResendAfterThreshold() > 0 {
 		b.ethResender = NewEthResender(lggr, db, ethClient, defaultResenderPollInterval, cfg)
@@ -194,6 +250,7 @@ func (b *Txm) Start(ctx context.Context) (merr error) {
 
 		eb := NewEthBroadcaster(b.db, b.ethClient, b.config, b.keyStore, b.eventBroadcaster, keyStates, b.gasEstimator, b.resumeCallback, b.logger, b.checkerFactory)
 		ec := NewEthConfirmer(b.db, b.ethClient, b.config, b.keyStore, keyStates, b.gasEstimator, b.resumeCallback, b.logger)
+		eb.txEventCallback, ec.txEventCallback = b.txEventCallback, b.txEventCallback
 		if err = eb.Start(ctx); err != nil {
 			return errors.Wrap(err, "Txm: EthBroadcaster failed to start")
 		}
@@ -205,8 +262,9 @@ func (b *Txm) Start(ctx context.Context) (merr error) {
 			return errors.Wrap(err, "Txm: Estimator failed to start")
 		}
 
-		b.wg.Add(1)
+		b.wg.Add(2)
 		go b.runLoop(eb, ec, keyStates)
+		go b.runRetryBuffer()
 		<-b.chSubbed
 
 		if b.reaper != nil {
@@ -315,6 +373,7 @@ func (b *Txm) runLoop(eb *EthBroadcaster, ec *EthConfirmer, keyStates []ethkey.S
 
 		eb = NewEthBroadcaster(b.db, b.ethClient, b.config, b.keyStore, b.eventBroadcaster, keyStates, b.gasEstimator, b.resumeCallback, b.logger, b.checkerFactory)
 		ec = NewEthConfirmer(b.db, b.ethClient, b.config, b.keyStore, keyStates, b.gasEstimator, b.resumeCallback, b.logger)
+		eb.txEventCallback, ec.txEventCallback = b.txEventCallback, b.txEventCallback
 
 		var wg sync.WaitGroup
 		// two goroutines to handle independent backoff retries starting:
@@ -373,6 +432,9 @@ func (b *Txm) runLoop(eb *EthBroadcaster, ec *EthConfirmer, keyStates []ethkey.S
 			eb.Trigger(address)
 		case head := <-b.chHeads:
 			ec.mb.Deliver(head)
+		case req := <-b.chForce:
+			// The EthConfirmer may be busy processing a head
+			go ec.force(req)
 		case reset := <-b.reset:
 			// This check prevents the weird edge-case where you can select
 			// into this block after chStop has already been closed and the
@@ -447,6 +509,68 @@ func (b *Txm) Trigger(addr common.Address) {
 	}
 }
 
+// BumpEthTransaction immediately sends a new attempt of the unconfirmed
+// transaction with bumped gas, instead of waiting for ETH_GAS_BUMP_THRESHOLD
+// blocks.
+func (b *Txm) BumpEthTransaction(ctx context.Context, etxID int64) error {
+	return b.forceEthTransaction(ctx, forceRequest{ctx: ctx, etxID: etxID, done: make(chan error, 1)})
+}
+
+// CancelEthTransaction replaces the unconfirmed transaction with a zero-value
+// transfer to its sender at the same nonce and a bumped gas price. The
+// transaction is only cancelled if the replacement is mined first.
+func (b *Txm) CancelEthTransaction(ctx context.Context, etxID int64) error {
+	return b.forceEthTransaction(ctx, forceRequest{ctx: ctx, etxID: etxID, cancel: true, done: make(chan error, 1)})
+}
+
+func (b *Txm) forceEthTransaction(ctx context.Context, req forceRequest) (err error) {
+	ok := b.IfStarted(func() {
+		select {
+		case b.chForce <- req:
+		case <-b.chStop:
+			err = errors.New("Txm was stopped")
+			return
+		case <-ctx.Done():
+			err = ctx.Err()
+			return
+		}
+		select {
+		case err = <-req.done:
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+	})
+	if !ok {
+		return errors.New("not started")
+	}
+	return err
+}
+
+// ReconcileNonces pauses the EthBroadcaster and EthConfirmer, reconciles the
+// local nonce of the key with the chain, backfilling any gaps within the given
+// (inclusive) nonce range, and re-sends the unconfirmed transactions of the
+// key within that range.
+func (b *Txm) ReconcileNonces(ctx context.Context, address common.Address, beginningNonce, endingNonce int64) (rec NonceReconciliation, err error) {
+	if beginningNonce < 0 || endingNonce < beginningNonce {
+		return rec, errors.Errorf("invalid nonce range %d to %d", beginningNonce, endingNonce)
+	}
+	if err = b.keyStore.CheckEnabled(address, &b.chainID); err != nil {
+		return rec, err
+	}
+	var ferr error
+	err = b.Reset(func() {
+		syncer := NewNonceSyncer(b.db, b.logger, b.config, b.ethClient, b.keyStore)
+		if rec, ferr = syncer.Reconcile(ctx, address, beginningNonce, endingNonce); ferr != nil {
+			return
+		}
+		rec.Rebroadcast, ferr = syncer.Rebroadcast(ctx, address, beginningNonce, endingNonce)
+	}, address, false)
+	if err != nil {
+		return rec, err
+	}
+	return rec, errors.Wrapf(ferr, "failed to reconcile nonces for key %s", address.Hex())
+}
+
 type NewTx struct {
 	FromAddress    common.Address
 	ToAddress      common.Address
@@ -464,14 +588,42 @@ type NewTx struct {
 
 	// Checker defines the check that should be run before a transaction is submitted on chain.
 	Checker TransmitCheckerSpec
+
+	// Priority transactions fulfil chain obligations, like OCR transmissions.
+	// If the database is unavailable when they are created, they are held in
+	// the in-memory retry buffer and inserted once it recovers, and
+	// ErrHeldInRetryBuffer is returned. They are not broadcast until then.
+	// Only the transactions inserted outside of the caller's database
+	// transaction can be held, so VRF v2 fulfillments, inserted with their
+	// pipeline run and consumed log, are requeued by their listener instead.
+	Priority bool
+
+	// IdempotencyKey identifies the transaction, which is inserted only once
+	// however many times it is created. It is set on priority transactions.
+	IdempotencyKey *uuid.UUID
 }
 
+// ErrHeldInRetryBuffer is returned when a priority transaction could not be
+// inserted because the database is unavailable, and is held in memory until it
+// recovers. It is lost if the node stops before then.
+var ErrHeldInRetryBuffer = errors.New("database unavailable, transaction held in the retry buffer")
+
 // CreateEthTransaction inserts a new transaction
 func (b *Txm) CreateEthTransaction(newTx NewTx, qs ...pg.QOpt) (etx EthTx, err error) {
 	if err = b.checkEnabled(newTx.FromAddress); err != nil {
 		return etx, err
 	}
 
+	if newTx.Priority {
+		// Don't let a degraded database hold up the caller
+		qs = append(qs, func(q *pg.Q) { q.QueryTimeout = priorityQueryTimeout })
+		if newTx.IdempotencyKey == nil {
+			// The insert may time out after being committed, so the held
+			// transaction must not be inserted twice
+			key := uuid.NewV4()
+			newTx.IdempotencyKey = &key
+		}
+	}
 	q := b.q.WithOpts(qs...)
 
 	if b.config.EvmUseForwarders() && newTx.Forwardable {
@@ -492,11 +644,31 @@ func (b *Txm) CreateEthTransaction(newTx NewTx, qs ...pg.QOpt) (etx EthTx, err e
 		}
 	}
 
-	err = CheckEthTxQueueCapacity(q, newTx.FromAddress, b.config.EvmMaxQueuedTransactions(), b.chainID)
+	etx, err = b.insertEthTx(q, newTx)
+	// Priority transactions can only be held back if they are not part of
+	// the caller's database transaction
+	if _, standalone := q.Queryer.(*sqlx.DB); err != nil && newTx.Priority && standalone && isDBUnavailable(err) {
+		if lerr := b.retryBuffer.add(newTx); lerr != nil {
+			return etx, multierr.Combine(err, lerr)
+		}
+		b.logger.Warnw("Database unavailable, holding priority transaction in the retry buffer", "fromAddress", newTx.FromAddress, "toAddress", newTx.ToAddress, "meta", newTx.Meta, "idempotencyKey", newTx.IdempotencyKey, "err", err)
+		return EthTx{}, ErrHeldInRetryBuffer
+	}
+	return etx, err
+}
+
+// insertEthTx checks the queue capacity and spend limits of the sending key,
+// and inserts newTx
+func (b *Txm) insertEthTx(q pg.Q, newTx NewTx) (etx EthTx, err error) {
+	err = b.ensureQueueCapacity(q, newTx)
 	if err != nil {
 		return etx, errors.Wrap(err, "Txm#CreateEthTransaction")
 	}
 
+	if err = b.checkSpendLimits(q, newTx); err != nil {
+		return etx, errors.Wrap(err, "Txm#CreateEthTransaction")
+	}
+
 	value := 0
 	err = q.Transaction(func(tx pg.Queryer) error {
 		if newTx.PipelineTaskRunID != nil {
@@ -510,13 +682,23 @@ func (b *Txm) CreateEthTransaction(newTx NewTx, qs ...pg.QOpt) (etx EthTx, err e
 				return nil
 			}
 		}
+		if newTx.IdempotencyKey != nil {
+			err = tx.Get(&etx, `SELECT * FROM eth_txes WHERE idempotency_key = $1`, newTx.IdempotencyKey)
+			if !errors.Is(err, sql.ErrNoRows) {
+				if err != nil {
+					return errors.Wrap(err, "Txm#CreateEthTransaction")
+				}
+				// the transaction was inserted already, by an insert which timed out after committing
+				return nil
+			}
+		}
 		err := tx.Get(&etx, `
-INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker)
+INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, idempotency_key)
 VALUES (
-$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11
+$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12
 )
 RETURNING "eth_txes".*
-`, newTx.FromAddress, newTx.ToAddress, newTx.EncodedPayload, value, newTx.GasLimit, newTx.Meta, newTx.Strategy.Subject(), b.chainID.String(), newTx.MinConfirmations, newTx.PipelineTaskRunID, newTx.Checker)
+`, newTx.FromAddress, newTx.ToAddress, newTx.EncodedPayload, value, newTx.GasLimit, newTx.Meta, newTx.Strategy.Subject(), b.chainID.String(), newTx.MinConfirmations, newTx.PipelineTaskRunID, newTx.Checker, newTx.IdempotencyKey)
 		if err != nil {
 			return errors.Wrap(err, "Txm#CreateEthTransaction failed to insert eth_tx")
 		}
@@ -538,6 +720,19 @@ func (b *Txm) checkEnabled(addr common.Address) error {
 	return errors.Wrapf(err, "cannot send transaction from %s on chain ID %s", addr.Hex(), b.chainID.String())
 }
 
+func (b *Txm) checkSpendLimits(q pg.Queryer, newTx NewTx) error {
+	states, err := b.keyStore.GetStatesForChain(&b.chainID)
+	if err != nil {
+		return errors.Wrap(err, "failed to load key states")
+	}
+	for _, state := range states {
+		if state.Address.Address() == newTx.FromAddress {
+			return CheckKeySpendLimits(q, newTx.FromAddress, state.SpendLimits, newTx.Meta, b.chainID)
+		}
+	}
+	return nil
+}
+
 // GetGasEstimator returns the gas estimator, mostly useful for tests
 func (b *Txm) GetGasEstimator() gas.Estimator {
 	return b.gasEstimator
@@ -671,6 +866,13 @@ func CountUnstartedTransactions(q pg.Q, fromAddress common.Address, chainID big.
 	return countTransactionsWithState(q, fromAddress, EthTxUnstarted, chainID)
 }
 
+// CountInFlightTransactions returns the number of transactions across all
+// addresses and chains that have not yet been broadcast
+func CountInFlightTransactions(q pg.Q) (count uint32, err error) {
+	err = q.Get(&count, `SELECT count(*) FROM eth_txes WHERE state IN ('unstarted', 'in_progress')`)
+	return count, errors.Wrap(err, "failed to CountInFlightTransactions")
+}
+
 func countTransactionsWithState(q pg.Q, fromAddress common.Address, state EthTxState, chainID big.Int) (count uint32, err error) {
 	err = q.Get(&count, `SELECT count(*) FROM eth_txes WHERE from_address = $1 AND state = $2 AND evm_chain_id = $3`,
 		fromAddress, state, chainID.String())
@@ -691,11 +893,56 @@ func CheckEthTxQueueCapacity(q pg.Queryer, fromAddress common.Address, maxQueued
 	}
 
 	if count >= maxQueuedTransactions {
-		err = errors.Errorf("cannot create transaction; too many unstarted transactions in the queue (%v/%v). %s", count, maxQueuedTransactions, label.MaxQueuedTransactionsWarning)
+		err = errors.Wrapf(ErrEthTxQueueFull, "cannot create transaction; too many unstarted transactions in the queue (%v/%v). %s", count, maxQueuedTransactions, label.MaxQueuedTransactionsWarning)
 	}
 	return
 }
 
+// CheckKeySpendLimits returns an error if creating a transaction from
+// fromAddress would exceed the key's spend limits. Daily spend counts the value
+// plus the maximum fee (gas limit times the latest attempt's gas price or fee
+// cap) of every transaction created from the key in the last 24 hours.
+func CheckKeySpendLimits(q pg.Queryer, fromAddress common.Address, limits ethkey.SpendLimits, meta *EthTxMeta, chainID big.Int) error {
+	if len(limits.EnabledJobTypes) > 0 && meta != nil && meta.JobID != nil {
+		var jobType string
+		if err := q.Get(&jobType, `SELECT type FROM jobs WHERE id = $1`, *meta.JobID); err != nil {
+			return errors.Wrap(err, "txmgr.CheckKeySpendLimits failed to load job type")
+		}
+		if !limits.AllowsJobType(jobType) {
+			return errors.Errorf("cannot create transaction; key %s is not enabled for %s jobs (enabled: %s)", fromAddress.Hex(), jobType, strings.Join(limits.EnabledJobTypes, ","))
+		}
+	}
+
+	if limits.MaxInFlightTransactions.Valid {
+		var count int64
+		err := q.Get(&count, `SELECT count(*) FROM eth_txes WHERE from_address = $1 AND state IN ('unstarted', 'in_progress', 'unconfirmed') AND evm_chain_id = $2`, fromAddress, chainID.String())
+		if err != nil {
+			return errors.Wrap(err, "txmgr.CheckKeySpendLimits in-flight query failed")
+		}
+		if count >= limits.MaxInFlightTransactions.Int64 {
+			return errors.Errorf("cannot create transaction; key %s has too many in-flight transactions (%d/%d)", fromAddress.Hex(), count, limits.MaxInFlightTransactions.Int64)
+		}
+	}
+
+	if limits.MaxDailySpend != nil {
+		var spent utils.Big
+		err := q.Get(&spent, `SELECT COALESCE(SUM(e.value + e.gas_limit * COALESCE(a.gas_price, a.gas_fee_cap, 0)), 0)
+FROM eth_txes e
+LEFT JOIN LATERAL (
+	SELECT gas_price, gas_fee_cap FROM eth_tx_attempts WHERE eth_tx_id = e.id ORDER BY id DESC LIMIT 1
+) a ON TRUE
+WHERE e.from_address = $1 AND e.evm_chain_id = $2 AND e.created_at > NOW() - interval '24 hours' AND e.state <> 'fatal_error'`, fromAddress, chainID.String())
+		if err != nil {
+			return errors.Wrap(err, "txmgr.CheckKeySpendLimits daily spend query failed")
+		}
+		if spent.Cmp(limits.MaxDailySpend) >= 0 {
+			return errors.Errorf("cannot create transaction; key %s has reached its daily spend limit (%s/%s wei)", fromAddress.Hex(), spent.String(), limits.MaxDailySpend.String())
+		}
+	}
+
+	return nil
+}
+
 var _ TxManager = &NullTxManager{}
 
 type NullTxManager struct {
@@ -718,12 +965,22 @@ func (n *NullTxManager) CreateEthTransaction(NewTx, ...pg.QOpt) (etx EthTx, err
 func (n *NullTxManager) Reset(f func(), addr common.Address, abandon bool) error {
 	return nil
 }
+func (n *NullTxManager) BumpEthTransaction(context.Context, int64) error {
+	return errors.New(n.ErrMsg)
+}
+func (n *NullTxManager) CancelEthTransaction(context.Context, int64) error {
+	return errors.New(n.ErrMsg)
+}
+func (n *NullTxManager) ReconcileNonces(context.Context, common.Address, int64, int64) (rec NonceReconciliation, err error) {
+	return rec, errors.New(n.ErrMsg)
+}
 
 // SendEther does nothing, null functionality
 func (n *NullTxManager) SendEther(chainID *big.Int, from, to common.Address, value assets.Eth, gasLimit uint32) (etx EthTx, err error) {
 	return etx, errors.New(n.ErrMsg)
 }
-func (n *NullTxManager) Healthy() error                           { return nil }
-func (n *NullTxManager) Ready() error                             { return nil }
-func (n *NullTxManager) GetGasEstimator() gas.Estimator           { return nil }
-func (n *NullTxManager) RegisterResumeCallback(fn ResumeCallback) {}
+func (n *NullTxManager) Healthy() error                             { return nil }
+func (n *NullTxManager) Ready() error                               { return nil }
+func (n *NullTxManager) GetGasEstimator() gas.Estimator             { return nil }
+func (n *NullTxManager) RegisterResumeCallback(fn ResumeCallback)   {}
+func (n *NullTxManager) RegisterTxEventCallback(fn TxEventCallback) {}
@@ -158,6 +158,60 @@ func TestTxm_CheckEthTxQueueCapacity(t *testing.T) {
 	})
 }
 
+func TestTxm_CheckKeySpendLimits(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	borm := cltest.NewTxmORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+
+	_, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+
+	t.Run("with no limits returns nil", func(t *testing.T) {
+		err := txmgr.CheckKeySpendLimits(db, fromAddress, ethkey.SpendLimits{}, nil, cltest.FixtureChainID)
+		require.NoError(t, err)
+	})
+
+	// value 142 + gas limit 1e9 * gas price 1
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 0, fromAddress)
+	// value 142, no attempt yet
+	cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+	// fatally errored transactions are ignored
+	cltest.MustInsertFatalErrorEthTx(t, borm, fromAddress)
+
+	t.Run("max in-flight transactions", func(t *testing.T) {
+		err := txmgr.CheckKeySpendLimits(db, fromAddress, ethkey.SpendLimits{MaxInFlightTransactions: null.IntFrom(3)}, nil, cltest.FixtureChainID)
+		require.NoError(t, err)
+
+		err = txmgr.CheckKeySpendLimits(db, fromAddress, ethkey.SpendLimits{MaxInFlightTransactions: null.IntFrom(2)}, nil, cltest.FixtureChainID)
+		require.ErrorContains(t, err, "too many in-flight transactions (2/2)")
+	})
+
+	t.Run("max daily spend", func(t *testing.T) {
+		err := txmgr.CheckKeySpendLimits(db, fromAddress, ethkey.SpendLimits{MaxDailySpend: utils.NewBigI(1_000_000_285)}, nil, cltest.FixtureChainID)
+		require.NoError(t, err)
+
+		err = txmgr.CheckKeySpendLimits(db, fromAddress, ethkey.SpendLimits{MaxDailySpend: utils.NewBigI(1_000_000_284)}, nil, cltest.FixtureChainID)
+		require.ErrorContains(t, err, "reached its daily spend limit (1000000284/1000000284 wei)")
+	})
+
+	t.Run("enabled job types", func(t *testing.T) {
+		jb, _ := cltest.MustInsertWebhookSpec(t, db)
+		meta := &txmgr.EthTxMeta{JobID: &jb.ID}
+
+		err := txmgr.CheckKeySpendLimits(db, fromAddress, ethkey.SpendLimits{EnabledJobTypes: []string{"webhook", "vrf"}}, meta, cltest.FixtureChainID)
+		require.NoError(t, err)
+
+		err = txmgr.CheckKeySpendLimits(db, fromAddress, ethkey.SpendLimits{EnabledJobTypes: []string{"offchainreporting"}}, meta, cltest.FixtureChainID)
+		require.ErrorContains(t, err, "is not enabled for webhook jobs")
+
+		// transactions not created by a job are not restricted
+		err = txmgr.CheckKeySpendLimits(db, fromAddress, ethkey.SpendLimits{EnabledJobTypes: []string{"offchainreporting"}}, nil, cltest.FixtureChainID)
+		require.NoError(t, err)
+	})
+}
+
 func TestTxm_CountUnconfirmedTransactions(t *testing.T) {
 	t.Parallel()
 
@@ -271,6 +325,7 @@ func TestTxm_CreateEthTransaction(t *testing.T) {
 
 	t.Run("with queue at capacity does not insert eth_tx", func(t *testing.T) {
 		config.On("EvmMaxQueuedTransactions").Return(uint64(1)).Once()
+		config.On("EvmMaxQueuedTransactionsPolicy").Return(txmgr.EthTxQueuePolicyReject).Once()
 		_, err := txm.CreateEthTransaction(txmgr.NewTx{
 			FromAddress:    fromAddress,
 			ToAddress:      testutils.NewAddress(),
@@ -547,6 +602,72 @@ func newMockTxStrategy(t *testing.T) *txmmocks.TxStrategy {
 	return txmmocks.NewTxStrategy(t)
 }
 
+func TestTxm_CreateEthTransaction_RetryBuffer(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	kst := cltest.NewKeyStore(t, db, cfg)
+	_, fromAddress := cltest.MustInsertRandomKey(t, kst.Eth(), 0)
+
+	config := newMockConfig(t)
+	config.On("EthTxResendAfterThreshold").Return(time.Duration(0))
+	config.On("EthTxReaperThreshold").Return(time.Duration(0))
+	config.On("GasEstimatorMode").Return("FixedPrice")
+	config.On("LogSQL").Return(false)
+	config.On("EvmMaxQueuedTransactions").Return(uint64(0))
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	lggr := logger.TestLogger(t)
+	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewPGCfg(true)),
+		ethClient, lggr, 100*time.Millisecond, 2, 3, 2)
+	txm := txmgr.NewTxm(db, ethClient, config, kst.Eth(), nil, lggr, &testCheckerFactory{}, lp)
+
+	// PruneQueue runs last in the insert transaction, so timing it out
+	// simulates a database that is unavailable
+	strategy := newMockTxStrategy(t)
+	strategy.On("Subject").Return(uuid.NullUUID{})
+	strategy.On("PruneQueue", mock.Anything).Return(int64(0), context.DeadlineExceeded).Times(3)
+	newTx := txmgr.NewTx{
+		FromAddress:    fromAddress,
+		ToAddress:      testutils.NewAddress(),
+		EncodedPayload: []byte{1, 2, 3},
+		GasLimit:       21000,
+		Strategy:       strategy,
+	}
+
+	_, err := txm.CreateEthTransaction(newTx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	newTx.Priority = true
+	key := uuid.NewV4()
+	newTx.IdempotencyKey = &key
+	_, err = txm.CreateEthTransaction(newTx)
+	require.ErrorIs(t, err, txmgr.ErrHeldInRetryBuffer)
+	cltest.AssertCount(t, db, "eth_txes", 0)
+
+	// the database is still unavailable
+	txmgr.FlushRetryBuffer(txm)
+	cltest.AssertCount(t, db, "eth_txes", 0)
+
+	strategy.On("PruneQueue", mock.Anything).Return(int64(0), nil).Once()
+	txmgr.FlushRetryBuffer(txm)
+	cltest.AssertCount(t, db, "eth_txes", 1)
+
+	// a transaction created again with the same key is not inserted twice
+	etx, err := txm.CreateEthTransaction(newTx)
+	require.NoError(t, err)
+	assert.Equal(t, key, etx.IdempotencyKey.UUID)
+	cltest.AssertCount(t, db, "eth_txes", 1)
+
+	// rejected transactions are not held
+	_, err = txm.CreateEthTransaction(txmgr.NewTx{
+		FromAddress: testutils.NewAddress(),
+		Strategy:    strategy,
+		Priority:    true,
+	})
+	require.Error(t, err)
+}
+
 func newMockConfig(t *testing.T) *txmmocks.Config {
 	// These are only used for logging, the exact value doesn't matter
 	// It can be overridden in the test that uses it
@@ -578,6 +699,71 @@ func newMockConfig(t *testing.T) *txmmocks.Config {
 	return cfg
 }
 
+func TestTxm_CreateEthTransaction_QueuePolicy(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	borm := cltest.NewTxmORM(t, db, cfg)
+	kst := cltest.NewKeyStore(t, db, cfg)
+
+	_, fromAddress := cltest.MustInsertRandomKey(t, kst.Eth(), 0)
+
+	config := newMockConfig(t)
+	config.On("EthTxResendAfterThreshold").Return(time.Duration(0))
+	config.On("EthTxReaperThreshold").Return(time.Duration(0))
+	config.On("GasEstimatorMode").Return("FixedPrice")
+	config.On("LogSQL").Return(false)
+	config.On("EvmMaxQueuedTransactions").Return(uint64(2))
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+
+	lggr := logger.TestLogger(t)
+	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewPGCfg(true)),
+		ethClient, lggr, 100*time.Millisecond, 2, 3, 2)
+	txm := txmgr.NewTxm(db, ethClient, config, kst.Eth(), nil, lggr, &testCheckerFactory{}, lp)
+
+	newTx := txmgr.NewTx{
+		FromAddress:    fromAddress,
+		ToAddress:      testutils.NewAddress(),
+		EncodedPayload: []byte{1, 2, 3},
+		GasLimit:       21000,
+		Strategy:       txmgr.SendEveryStrategy{},
+	}
+
+	t.Run("DropOldest fails the oldest unstarted transactions", func(t *testing.T) {
+		config.On("EvmMaxQueuedTransactionsPolicy").Return(txmgr.EthTxQueuePolicyDropOldest).Once()
+		oldest := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+		newer := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+
+		etx, err := txm.CreateEthTransaction(newTx)
+		require.NoError(t, err)
+
+		oldest, err = borm.FindEthTxWithAttempts(oldest.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxFatalError, oldest.State)
+		assert.Equal(t, "dropped from full transaction queue", oldest.Error.String)
+
+		for _, id := range []int64{newer.ID, etx.ID} {
+			tx, err := borm.FindEthTxWithAttempts(id)
+			require.NoError(t, err)
+			assert.Equal(t, txmgr.EthTxUnstarted, tx.State)
+		}
+	})
+
+	t.Run("Block waits for room in the queue", func(t *testing.T) {
+		config.On("EvmMaxQueuedTransactionsPolicy").Return(txmgr.EthTxQueuePolicyBlock).Once()
+
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			pgtest.MustExec(t, db, `UPDATE eth_txes SET state = 'fatal_error', error = 'cleared' WHERE state = 'unstarted' AND from_address = $1`, fromAddress)
+		}()
+
+		_, err := txm.CreateEthTransaction(newTx)
+		require.NoError(t, err)
+		cltest.AssertCount(t, db, "eth_txes", 4)
+	})
+}
+
 func TestTxm_CreateEthTransaction_OutOfEth(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 	cfg := cltest.NewTestGeneralConfig(t)
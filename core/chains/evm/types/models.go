@@ -33,6 +33,10 @@ type Head struct {
 	ReceiptsRoot     common.Hash
 	TransactionsRoot common.Hash
 	StateRoot        common.Hash
+	// FinalizedBlockNumber is the number of the latest block marked by the
+	// finality tag when this head was received. It is not persisted and is
+	// only set if the finality tag is enabled and supported by the RPC node.
+	FinalizedBlockNumber null.Int64 `db:"-"`
 }
 
 // NewHead returns a Head instance.
@@ -46,6 +50,16 @@ func NewHead(number *big.Int, blockHash common.Hash, parentHash common.Hash, tim
 	}
 }
 
+// LatestFinalizedBlockNumber returns the number of the latest final block as
+// of this head: the block marked by the finality tag if known, otherwise the
+// block finalityDepth blocks below the head.
+func (h *Head) LatestFinalizedBlockNumber(finalityDepth uint32) int64 {
+	if h.FinalizedBlockNumber.Valid {
+		return h.FinalizedBlockNumber.Int64
+	}
+	return h.Number - int64(finalityDepth)
+}
+
 // EarliestInChain recurses through parents until it finds the earliest one
 func (h *Head) EarliestInChain() *Head {
 	for h.Parent != nil {
@@ -126,6 +126,14 @@ func TestHead_ChainLength(t *testing.T) {
 	assert.Equal(t, uint32(0), head2.ChainLength())
 }
 
+func TestHead_LatestFinalizedBlockNumber(t *testing.T) {
+	head := evmtypes.Head{Number: 100}
+	assert.Equal(t, int64(50), head.LatestFinalizedBlockNumber(50))
+
+	head.FinalizedBlockNumber = null.Int64From(70)
+	assert.Equal(t, int64(70), head.LatestFinalizedBlockNumber(50))
+}
+
 func TestModels_HexToFunctionSelector(t *testing.T) {
 	t.Parallel()
 	fid := evmtypes.HexToFunctionSelector("0xb3f98adc")
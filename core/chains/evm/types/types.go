@@ -145,6 +145,9 @@ type Receipt struct {
 	BlockHash         common.Hash     `json:"blockHash,omitempty"`
 	BlockNumber       *big.Int        `json:"blockNumber,omitempty"`
 	TransactionIndex  uint            `json:"transactionIndex"`
+	// EffectiveGasPrice is the price paid per gas, it is only returned by the
+	// nodes supporting EIP-1559
+	EffectiveGasPrice *big.Int `json:"effectiveGasPrice,omitempty"`
 }
 
 // FromGethReceipt converts a gethTypes.Receipt to a Receipt
@@ -168,6 +171,7 @@ func FromGethReceipt(gr *gethTypes.Receipt) *Receipt {
 		gr.BlockHash,
 		gr.BlockNumber,
 		gr.TransactionIndex,
+		nil,
 	}
 }
 
@@ -201,6 +205,7 @@ func (r Receipt) MarshalJSON() ([]byte, error) {
 		BlockHash         common.Hash     `json:"blockHash,omitempty"`
 		BlockNumber       *hexutil.Big    `json:"blockNumber,omitempty"`
 		TransactionIndex  hexutil.Uint    `json:"transactionIndex"`
+		EffectiveGasPrice *hexutil.Big    `json:"effectiveGasPrice,omitempty"`
 	}
 	var enc Receipt
 	enc.PostState = r.PostState
@@ -214,6 +219,7 @@ func (r Receipt) MarshalJSON() ([]byte, error) {
 	enc.BlockHash = r.BlockHash
 	enc.BlockNumber = (*hexutil.Big)(r.BlockNumber)
 	enc.TransactionIndex = hexutil.Uint(r.TransactionIndex)
+	enc.EffectiveGasPrice = (*hexutil.Big)(r.EffectiveGasPrice)
 	return json.Marshal(&enc)
 }
 
@@ -231,6 +237,7 @@ func (r *Receipt) UnmarshalJSON(input []byte) error {
 		BlockHash         *common.Hash     `json:"blockHash,omitempty"`
 		BlockNumber       *hexutil.Big     `json:"blockNumber,omitempty"`
 		TransactionIndex  *hexutil.Uint    `json:"transactionIndex"`
+		EffectiveGasPrice *hexutil.Big     `json:"effectiveGasPrice,omitempty"`
 	}
 	var dec Receipt
 	if err := json.Unmarshal(input, &dec); err != nil {
@@ -267,6 +274,9 @@ func (r *Receipt) UnmarshalJSON(input []byte) error {
 	if dec.TransactionIndex != nil {
 		r.TransactionIndex = uint(*dec.TransactionIndex)
 	}
+	if dec.EffectiveGasPrice != nil {
+		r.EffectiveGasPrice = (*big.Int)(dec.EffectiveGasPrice)
+	}
 	return nil
 }
 
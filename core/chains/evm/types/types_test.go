@@ -158,6 +158,13 @@ func TestReceipt_MarshalUnmarshalJson(t *testing.T) {
 	assert.NoError(t, err)
 
 	assert.Equal(t, receipt, parsedReceipt)
+
+	receipt.EffectiveGasPrice = big.NewInt(42)
+	json, err = receipt.MarshalJSON()
+	require.NoError(t, err)
+	parsedReceipt = &types.Receipt{}
+	require.NoError(t, parsedReceipt.UnmarshalJSON(json))
+	assert.Equal(t, big.NewInt(42), parsedReceipt.EffectiveGasPrice)
 }
 
 func TestLog_MarshalUnmarshalJson(t *testing.T) {
@@ -22,9 +22,10 @@ import (
 )
 
 const (
-	MaxQueueLen      = 1000
-	MaxRetryTimeMs   = 250 // max tx retry time (exponential retry will taper to retry every 0.25s)
-	MaxSigsToConfirm = 256 // max number of signatures in GetSignatureStatus call
+	MaxQueueLen           = 1000
+	MaxRetryTimeMs        = 250 // max tx retry time (exponential retry will taper to retry every 0.25s)
+	MaxSigsToConfirm      = 256 // max number of signatures in GetSignatureStatus call
+	MaxBlockhashRefreshes = 3   // max number of times a tx is signed again with a new blockhash
 )
 
 var (
@@ -51,6 +52,7 @@ type pendingTx struct {
 	tx        *solanaGo.Transaction
 	timeout   time.Duration
 	signature solanaGo.Signature
+	refreshes int // number of blockhash refreshes
 }
 
 // NewTxm creates a txm. Uses simulation so should only be used to send txes to trusted contracts i.e. OCR.
@@ -330,9 +332,10 @@ func (txm *Txm) simulate(ctx context.Context) {
 			errStr := fmt.Sprintf("%v", res.Err) // convert to string to handle various interfaces
 			switch {
 			// blockhash not found when simulating, occurs when network bank has not seen the given blockhash or tx is too old
-			// let simulation process/clean up
+			// resend the tx with the latest blockhash, or let simulation process/clean up
 			case strings.Contains(errStr, "BlockhashNotFound"):
 				txm.lggr.Warnw("simulate: BlockhashNotFound", "signature", msg.signature, "result", res)
+				txm.refreshBlockhash(ctx, client, msg)
 				continue
 			// transaction will encounter execution error/revert, mark as reverted to remove from confirmation + retry
 			case strings.Contains(errStr, "InstructionError"):
@@ -353,36 +356,100 @@ func (txm *Txm) simulate(ctx context.Context) {
 	}
 }
 
-// Enqueue enqueue a msg destined for the solana chain.
-func (txm *Txm) Enqueue(accountID string, tx *solanaGo.Transaction) error {
-	// validate nil pointer
-	if tx == nil {
-		return errors.New("error in soltxm.Enqueue: tx is nil pointer")
+// refreshBlockhash signs a tx again with the latest blockhash, and sends it in place of the original tx,
+// as long as the original tx was not found on chain.
+// txs with other signers than the fee payer cannot be signed again.
+func (txm *Txm) refreshBlockhash(ctx context.Context, client solanaClient.ReaderWriter, msg pendingTx) {
+	if msg.refreshes >= MaxBlockhashRefreshes {
+		txm.lggr.Warnw("not refreshing blockhash: too many refreshes", "signature", msg.signature, "refreshes", msg.refreshes)
+		return
 	}
-	// validate account keys slice
-	if len(tx.Message.AccountKeys) == 0 {
-		return errors.New("error in soltxm.Enqueue: not enough account keys in tx")
+	if len(msg.tx.Signatures) != 1 {
+		txm.lggr.Debugw("not refreshing blockhash: tx has multiple signers", "signature", msg.signature)
+		return
+	}
+	res, err := client.LatestBlockhash()
+	if err != nil {
+		txm.lggr.Errorw("failed to get latest blockhash in soltxm.refreshBlockhash", "signature", msg.signature, "error", err)
+		return
+	}
+	if res == nil || res.Value == nil {
+		txm.lggr.Errorw("nil pointer returned from LatestBlockhash in soltxm.refreshBlockhash", "signature", msg.signature)
+		return
+	}
+	// same blockhash: the RPC node has not seen it yet, let the retries continue
+	if res.Value.Blockhash == msg.tx.Message.RecentBlockhash {
+		return
+	}
+	// the original tx may have landed since it was simulated, signing it again would send it twice
+	statuses, err := client.SignatureStatuses(ctx, []solanaGo.Signature{msg.signature})
+	if err != nil {
+		txm.lggr.Errorw("failed to get signature status in soltxm.refreshBlockhash", "signature", msg.signature, "error", err)
+		return
+	}
+	if len(statuses) != 1 || statuses[0] != nil {
+		txm.lggr.Debugw("not refreshing blockhash: tx was found", "signature", msg.signature)
+		return
 	}
 
-	// get key
+	tx := &solanaGo.Transaction{Message: msg.tx.Message}
+	tx.Message.RecentBlockhash = res.Value.Blockhash
+	if err = txm.sign(tx); err != nil {
+		txm.lggr.Errorw("failed to sign tx in soltxm.refreshBlockhash", "signature", msg.signature, "error", err)
+		return
+	}
+	refreshed := pendingTx{
+		tx:        tx,
+		timeout:   msg.timeout,
+		refreshes: msg.refreshes + 1,
+	}
+	// cancel retry of the original tx before sending the refreshed one
+	txm.txs.OnError(msg.signature, TxFailDrop)
+	select {
+	case txm.chSend <- refreshed:
+		txm.lggr.Infow("resending tx with refreshed blockhash", "signature", msg.signature, "blockhash", res.Value.Blockhash, "refreshes", refreshed.refreshes)
+	default:
+		txm.lggr.Errorw("failed to enqeue tx with refreshed blockhash", "queueFull", len(txm.chSend) == MaxQueueLen, "signature", msg.signature)
+	}
+}
+
+// sign appends the signature of the fee payer to tx.
+func (txm *Txm) sign(tx *solanaGo.Transaction) error {
 	// fee payer account is index 0 account
 	// https://github.com/gagliardetto/solana-go/blob/main/transaction.go#L252
 	key, err := txm.ks.Get(tx.Message.AccountKeys[0].String())
 	if err != nil {
-		return errors.Wrap(err, "error in soltxm.Enqueue.GetKey")
+		return errors.Wrap(err, "GetKey")
 	}
 	txMsg, err := tx.Message.MarshalBinary()
 	if err != nil {
-		return errors.Wrap(err, "error in soltxm.Enqueue.MarshalBinary")
+		return errors.Wrap(err, "MarshalBinary")
 	}
-	// sign tx
 	sigBytes, err := key.Sign(txMsg)
 	if err != nil {
-		return errors.Wrap(err, "error in soltxm.Enqueue.Sign")
+		return errors.Wrap(err, "Sign")
 	}
 	var finalSig [64]byte
 	copy(finalSig[:], sigBytes)
 	tx.Signatures = append(tx.Signatures, finalSig)
+	return nil
+}
+
+// Enqueue enqueue a msg destined for the solana chain.
+func (txm *Txm) Enqueue(accountID string, tx *solanaGo.Transaction) error {
+	// validate nil pointer
+	if tx == nil {
+		return errors.New("error in soltxm.Enqueue: tx is nil pointer")
+	}
+	// validate account keys slice
+	if len(tx.Message.AccountKeys) == 0 {
+		return errors.New("error in soltxm.Enqueue: not enough account keys in tx")
+	}
+
+	// sign tx
+	if err := txm.sign(tx); err != nil {
+		return errors.Wrap(err, "error in soltxm.Enqueue")
+	}
 
 	msg := pendingTx{
 		tx:      tx,
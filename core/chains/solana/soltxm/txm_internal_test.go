@@ -282,7 +282,7 @@ func TestTxm(t *testing.T) {
 		mc.On("SendTx", mock.Anything, tx).Panic("SendTx should not be called anymore")
 	})
 
-	// tx fails simulation with BlockHashNotFound error
+	// tx fails simulation with BlockHashNotFound error, and the latest blockhash is the same
 	// txm should continue to confirm tx (in this case it will succeed)
 	t.Run("fail_simulation_blockhashNotFound", func(t *testing.T) {
 		tx := getTx(t, pubkey)
@@ -290,6 +290,9 @@ func TestTxm(t *testing.T) {
 		var wg sync.WaitGroup
 		wg.Add(2)
 
+		mc.On("LatestBlockhash").Return(&rpc.GetLatestBlockhashResult{
+			Value: &rpc.LatestBlockhashResult{Blockhash: tx.Message.RecentBlockhash},
+		}, nil).Once()
 		mc.On("SendTx", mock.Anything, tx).Return(sig, nil)
 		mc.On("SimulateTx", mock.Anything, tx, mock.Anything).Run(func(mock.Arguments) {
 			wg.Done()
@@ -314,6 +317,92 @@ func TestTxm(t *testing.T) {
 		mc.On("SendTx", mock.Anything, tx).Panic("SendTx should not be called anymore")
 	})
 
+	// tx fails simulation with BlockHashNotFound error, and the latest blockhash is new
+	// txm should drop the tx, and send it again signed with the new blockhash (in this case it will succeed)
+	t.Run("fail_simulation_blockhashNotFound_refresh", func(t *testing.T) {
+		tx := getTx(t, pubkey)
+		sig := getSig()
+		refreshedSig := getSig()
+		blockhash := solana.Hash{1}
+		refreshed := mock.MatchedBy(func(refreshedTx *solana.Transaction) bool {
+			return refreshedTx.Message.RecentBlockhash == blockhash && len(refreshedTx.Signatures) == 1
+		})
+		var wg sync.WaitGroup
+		wg.Add(2)
+
+		mc.On("LatestBlockhash").Return(&rpc.GetLatestBlockhashResult{
+			Value: &rpc.LatestBlockhashResult{Blockhash: blockhash},
+		}, nil).Once()
+		mc.On("SendTx", mock.Anything, tx).Return(sig, nil)
+		mc.On("SimulateTx", mock.Anything, tx, mock.Anything).Return(&rpc.SimulateTransactionResult{
+			Err: "BlockhashNotFound",
+		}, nil).Once()
+		mc.On("SignatureStatuses", mock.Anything, []solana.Signature{sig}).Return([]*rpc.SignatureStatusesResult{nil}, nil).Maybe()
+		mc.On("SendTx", mock.Anything, refreshed).Return(refreshedSig, nil)
+		mc.On("SimulateTx", mock.Anything, refreshed, mock.Anything).Run(func(mock.Arguments) {
+			wg.Done()
+		}).Return(&rpc.SimulateTransactionResult{}, nil).Once()
+		mc.On("SignatureStatuses", mock.Anything, []solana.Signature{refreshedSig}).Run(func(mock.Arguments) {
+			wg.Done()
+		}).Return([]*rpc.SignatureStatusesResult{&rpc.SignatureStatusesResult{
+			ConfirmationStatus: rpc.ConfirmationStatusConfirmed,
+		}}, nil).Once()
+		// tx should be able to queue
+		assert.NoError(t, txm.Enqueue(t.Name(), tx))
+		wg.Wait()      // wait to be picked up and processed
+		waitFor(empty) // txs cleared after timeout
+
+		// check prom metric
+		prom.error++
+		prom.drop++
+		prom.success++
+		prom.assertEqual(t)
+
+		// panic if sendTx called after context cancelled
+		mc.On("SendTx", mock.Anything, tx).Panic("SendTx should not be called anymore")
+		mc.On("SendTx", mock.Anything, refreshed).Panic("SendTx should not be called anymore")
+	})
+
+	// tx fails simulation with BlockHashNotFound error, and the latest blockhash is new, but the tx was found
+	// txm should not send it again, and continue to confirm tx (in this case it will succeed)
+	t.Run("fail_simulation_blockhashNotFound_found", func(t *testing.T) {
+		tx := getTx(t, pubkey)
+		sig := getSig()
+		blockhash := solana.Hash{2}
+		refreshed := mock.MatchedBy(func(refreshedTx *solana.Transaction) bool {
+			return refreshedTx.Message.RecentBlockhash == blockhash
+		})
+		var wg sync.WaitGroup
+		wg.Add(2)
+
+		mc.On("LatestBlockhash").Run(func(mock.Arguments) {
+			wg.Done()
+		}).Return(&rpc.GetLatestBlockhashResult{
+			Value: &rpc.LatestBlockhashResult{Blockhash: blockhash},
+		}, nil).Once()
+		mc.On("SendTx", mock.Anything, tx).Return(sig, nil)
+		mc.On("SendTx", mock.Anything, refreshed).Panic("SendTx should not be called with a refreshed blockhash")
+		mc.On("SimulateTx", mock.Anything, tx, mock.Anything).Run(func(mock.Arguments) {
+			wg.Done()
+		}).Return(&rpc.SimulateTransactionResult{
+			Err: "BlockhashNotFound",
+		}, nil).Once()
+		mc.On("SignatureStatuses", mock.Anything, []solana.Signature{sig}).Return([]*rpc.SignatureStatusesResult{&rpc.SignatureStatusesResult{
+			ConfirmationStatus: rpc.ConfirmationStatusConfirmed,
+		}}, nil)
+		// tx should be able to queue
+		assert.NoError(t, txm.Enqueue(t.Name(), tx))
+		wg.Wait()      // wait to be picked up and processed
+		waitFor(empty) // txs cleared after timeout
+
+		// check prom metric
+		prom.success++
+		prom.assertEqual(t)
+
+		// panic if sendTx called after context cancelled
+		mc.On("SendTx", mock.Anything, tx).Panic("SendTx should not be called anymore")
+	})
+
 	// tx fails simulation with AlreadyProcessed error
 	// txm should continue to confirm tx (in this case it will revert)
 	t.Run("fail_simulation_alreadyProcessed", func(t *testing.T) {
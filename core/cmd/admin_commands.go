@@ -4,12 +4,17 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"strings"
+	"time"
 
 	"github.com/manyminds/api2go/jsonapi"
+	"github.com/pkg/errors"
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
 
+	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
@@ -19,13 +24,12 @@ type AdminUsersPresenter struct {
 	presenters.UserResource
 }
 
-var adminUsersTableHeaders = []string{"Email", "Role", "Has API token", "Created At", "Updated at"}
+var adminUsersTableHeaders = []string{"Email", "Role", "Created At", "Updated at"}
 
 func (p *AdminUsersPresenter) ToRow() []string {
 	row := []string{
 		p.ID,
 		string(p.Role),
-		p.HasActiveApiToken,
 		p.CreatedAt.String(),
 		p.UpdatedAt.String(),
 	}
@@ -171,3 +175,133 @@ func (cli *Client) DeleteUser(c *cli.Context) (err error) {
 
 	return cli.renderAPIResponse(response, &AdminUsersPresenter{}, "Successfully deleted API user")
 }
+
+type APITokenPresenter struct {
+	JAID
+	presenters.APITokenResource
+}
+
+var apiTokensTableHeaders = []string{"Name", "Scope", "Access Key", "Expires At", "Last Used At", "Created At"}
+
+func formatNullTime(t null.Time) string {
+	if !t.Valid {
+		return ""
+	}
+	return t.Time.Format(time.RFC3339)
+}
+
+func (p *APITokenPresenter) ToRow() []string {
+	return []string{
+		p.Name,
+		string(p.Scope),
+		p.AccessKey,
+		formatNullTime(p.ExpiresAt),
+		formatNullTime(p.LastUsedAt),
+		p.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// RenderTable implements TableRenderer. The secret is only returned by the
+// node when the token is created.
+func (p *APITokenPresenter) RenderTable(rt RendererTable) error {
+	headers := apiTokensTableHeaders
+	row := p.ToRow()
+	if p.Secret != "" {
+		headers = append(headers[:len(headers):len(headers)], "Secret")
+		row = append(row, p.Secret)
+	}
+	renderList(headers, [][]string{row}, rt.Writer)
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+type APITokenPresenters []APITokenPresenter
+
+// RenderTable implements TableRenderer
+func (ps APITokenPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("API tokens\n")); err != nil {
+		return err
+	}
+	renderList(apiTokensTableHeaders, rows, rt.Writer)
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListAPITokens renders the API tokens of the logged in user
+func (cli *Client) ListAPITokens(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/user/tokens")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &APITokenPresenters{})
+}
+
+// CreateAPIToken creates a named API token for the logged in user, prompting
+// for their password
+func (cli *Client) CreateAPIToken(c *cli.Context) (err error) {
+	request := sessions.APITokenRequest{
+		Name:  c.String("name"),
+		Scope: c.String("scope"),
+	}
+	if c.IsSet("expires-in") {
+		expiresIn := c.Duration("expires-in")
+		if expiresIn <= 0 {
+			return cli.errorOut(errors.New("--expires-in must be positive"))
+		}
+		request.ExpiresAt = null.TimeFrom(time.Now().Add(expiresIn))
+	}
+
+	fmt.Println("Password of the logged in user:")
+	request.Password = cli.PasswordPrompter.Prompt()
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	response, err := cli.HTTP.Post("/v2/user/tokens", bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(response, &APITokenPresenter{}, "Successfully created API token, keep the secret as it is not shown again")
+}
+
+// DeleteAPIToken deletes a named API token of the logged in user
+func (cli *Client) DeleteAPIToken(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the name of the token to be deleted"))
+	}
+	response, err := cli.HTTP.Delete("/v2/user/tokens/" + url.PathEscape(c.Args().First()))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	if _, err = cli.parseResponse(response); err != nil {
+		return cli.errorOut(err)
+	}
+
+	fmt.Printf("API token %v deleted\n", c.Args().First())
+	return nil
+}
@@ -9,7 +9,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli"
 
+	"github.com/smartcontractkit/chainlink/core/cmd"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/sessions"
 )
 
 func TestClient_CreateUser(t *testing.T) {
@@ -84,3 +86,42 @@ func TestClient_ChangeRole(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_APITokens(t *testing.T) {
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+	client.PasswordPrompter = cltest.MockPasswordPrompter{
+		Password: cltest.Password,
+	}
+
+	set := flag.NewFlagSet("test", 0)
+	set.String("name", "ci", "")
+	set.String("scope", "job-management", "")
+	set.Duration("expires-in", 0, "")
+	require.NoError(t, set.Set("expires-in", "24h"))
+	require.NoError(t, client.CreateAPIToken(cli.NewContext(nil, set, nil)))
+	require.Len(t, r.Renders, 1)
+	created := r.Renders[0].(*cmd.APITokenPresenter)
+	assert.Equal(t, "ci", created.Name)
+	assert.Equal(t, sessions.APITokenScopeJobManagement, created.Scope)
+	assert.NotEmpty(t, created.Secret)
+	assert.True(t, created.ExpiresAt.Valid)
+
+	set = flag.NewFlagSet("test", 0)
+	set.String("name", "ci", "")
+	set.String("scope", "root", "")
+	assert.ErrorContains(t, client.CreateAPIToken(cli.NewContext(nil, set, nil)), "Invalid scope: root")
+
+	require.NoError(t, client.ListAPITokens(cltest.EmptyCLIContext()))
+	require.Len(t, r.Renders, 2)
+	tokens := *r.Renders[1].(*cmd.APITokenPresenters)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, "ci", tokens[0].Name)
+	assert.Empty(t, tokens[0].Secret)
+
+	set = flag.NewFlagSet("test", 0)
+	require.NoError(t, set.Parse([]string{"ci"}))
+	require.NoError(t, client.DeleteAPIToken(cli.NewContext(nil, set, nil)))
+	assert.Error(t, client.DeleteAPIToken(cli.NewContext(nil, set, nil)))
+	assert.Error(t, client.DeleteAPIToken(cltest.EmptyCLIContext()))
+}
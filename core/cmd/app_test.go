@@ -0,0 +1,37 @@
+package cmd_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+)
+
+func TestRequiresCredentials(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		args string
+		exp  bool
+	}{
+		{"node start", true},
+		{"local n --debug", true},
+		{"node db migrate", true},
+		{"node rebroadcast-transactions --beginningNonce 1", true},
+		{"node status", false},
+		{"node profile", false},
+		{"jobs list", false},
+		{"keys eth list", false},
+		{"node", false},
+		{"", false},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.args, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.exp, cmd.RequiresCredentials(strings.Fields(test.args)))
+		})
+	}
+}
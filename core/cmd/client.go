@@ -33,6 +33,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/chains/terra"
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/secrets"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/periodicbackup"
@@ -77,6 +78,8 @@ type Client struct {
 	PromptingSessionRequestBuilder SessionRequestBuilder
 	ChangePasswordPrompter         ChangePasswordPrompter
 	PasswordPrompter               PasswordPrompter
+	// Credentials are fetched from the secrets provider at boot
+	Credentials secrets.Credentials
 }
 
 func (cli *Client) errorOut(err error) error {
@@ -126,6 +129,14 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg config.Gene
 		}
 	}
 
+	// Refuse to start on a schema changed outside of the migrations
+	if err = migrate.CheckSchema(db.DB); err != nil {
+		if !errors.Is(err, migrate.ErrSchemaDrift) || !cfg.DatabaseAllowSchemaDrift() {
+			return nil, errors.Wrap(err, "CheckSchema (set DATABASE_ALLOW_SCHEMA_DRIFT=true to start anyway, or run `chainlink db migrate --accept-drift` to accept the current schema)")
+		}
+		appLggr.Errorw("Database schema has drifted, starting anyway as DATABASE_ALLOW_SCHEMA_DRIFT is set", "err", err)
+	}
+
 	// Migrate the database
 	if cfg.MigrateDatabase() {
 		if err = migrate.Migrate(db.DB, appLggr); err != nil {
@@ -219,7 +230,7 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg config.Gene
 
 	restrictedClient := clhttp.NewRestrictedHTTPClient(cfg, appLggr)
 	unrestrictedClient := clhttp.NewUnrestrictedHTTPClient()
-	externalInitiatorManager := webhook.NewExternalInitiatorManager(db, unrestrictedClient, appLggr, cfg)
+	externalInitiatorManager := webhook.NewExternalInitiatorManager(db, unrestrictedClient, appLggr, cfg, keyStore.ColumnCiphers())
 	return chainlink.NewApplication(chainlink.ApplicationOpts{
 		Config:                   cfg,
 		SqlxDB:                   db,
@@ -264,7 +275,11 @@ type Runner interface {
 }
 
 // ChainlinkRunner is used to run the node application.
-type ChainlinkRunner struct{}
+type ChainlinkRunner struct {
+	// TLSKey is the PEM encoded TLS private key, used instead of the key
+	// file when set
+	TLSKey []byte
+}
 
 // Run sets the log level based on config and starts the web router to listen
 // for input and return data.
@@ -300,6 +315,7 @@ func (n ChainlinkRunner) Run(ctx context.Context, app chainlink.Application) err
 				config.TLSPort(),
 				config.CertFile(),
 				config.KeyFile(),
+				n.TLSKey,
 				config.HTTPServerWriteTimeout())
 		})
 	}
@@ -351,10 +367,23 @@ func (s *server) run(port uint16, writeTimeout time.Duration) error {
 	return errors.Wrap(err, "failed to run plaintext HTTP server")
 }
 
-func (s *server) runTLS(port uint16, certFile, keyFile string, writeTimeout time.Duration) error {
+func (s *server) runTLS(port uint16, certFile, keyFile string, keyPEM []byte, writeTimeout time.Duration) error {
 	s.lggr.Infof("Listening and serving HTTPS on port %d", port)
 	s.tlsServer = createServer(s.handler, port, writeTimeout)
-	err := s.tlsServer.ListenAndServeTLS(certFile, keyFile)
+	if len(keyPEM) == 0 {
+		err := s.tlsServer.ListenAndServeTLS(certFile, keyFile)
+		return errors.Wrap(err, "failed to run TLS server")
+	}
+	certPEM, err := os.ReadFile(certFile)
+	if err != nil {
+		return errors.Wrap(err, "failed to read TLS certificate")
+	}
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return errors.Wrap(err, "failed to load TLS key pair")
+	}
+	s.tlsServer.TLSConfig = &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+	err = s.tlsServer.ListenAndServeTLS("", "")
 	return errors.Wrap(err, "failed to run TLS server")
 }
 
@@ -0,0 +1,257 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/pkg/errors"
+	clipkg "github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/services/periodicbackup"
+	"github.com/smartcontractkit/chainlink/core/static"
+	"github.com/smartcontractkit/chainlink/core/store/dialects"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// keyRingsTable holds the keys of the node, encrypted with the keystore
+// password
+const keyRingsTable = "encrypted_key_rings"
+
+// roleKeyRingsTable holds the keys of the keystore roles with a password of
+// their own
+const roleKeyRingsTable = "encrypted_role_key_rings"
+
+// BackupDatabase dumps the database to a file restorable with RestoreDatabase.
+// The data of the transient tables is excluded, and the keys can be written
+// to a separate file, encrypted with their own password.
+func (cli *Client) BackupDatabase(c *clipkg.Context) (err error) {
+	cfg := cli.Config
+	dbURL := cfg.DatabaseURL()
+	if backupURL := cfg.DatabaseBackupURL(); backupURL != nil {
+		dbURL = *backupURL
+	}
+
+	keysFile := c.String("keys-file")
+	var keysPassword string
+	if keysFile != "" {
+		keysPassword, err = utils.PasswordFromFile(c.String("keys-password"))
+		if err != nil {
+			return cli.errorOut(errors.Wrap(err, "failed to read the keys password"))
+		} else if keysPassword == "" {
+			return cli.errorOut(errors.New("--keys-password is required with --keys-file"))
+		}
+	}
+
+	output := c.String("output")
+	if output == "" {
+		dir := filepath.Join(cfg.RootDir(), "backup")
+		if cfg.DatabaseBackupDir() != "" {
+			dir = cfg.DatabaseBackupDir()
+		}
+		output = filepath.Join(dir, fmt.Sprintf("cl_backup_%s_%s.dump", static.Version, time.Now().UTC().Format("20060102T150405Z")))
+	}
+	if err = os.MkdirAll(filepath.Dir(output), 0700); err != nil {
+		return cli.errorOut(errors.Wrapf(err, "failed to create the directory of %s", output))
+	}
+
+	args := []string{dbURL.String(), "-F", "c", "-f", output}
+	excluded := append([]string{}, periodicbackup.TransientTables...)
+	if c.Bool("lite") {
+		excluded = append(excluded, periodicbackup.LiteExcludedTables...)
+	}
+	if keysFile != "" {
+		excluded = append(excluded, keyRingsTable, roleKeyRingsTable)
+	}
+	for _, table := range excluded {
+		args = append(args, "--exclude-table-data="+table)
+	}
+
+	var keysSQL []byte
+	if keysFile == "" {
+		cli.Logger.Infof("Backing up database %s to %s", dbURL.Redacted(), output)
+		if _, err = runPgCommand(nil, "pg_dump", args...); err != nil {
+			return cli.errorOut(err)
+		}
+	} else {
+		// both dumps share a snapshot, so the keys match the rest of the backup
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		var snapshot string
+		var release func()
+		snapshot, release, err = exportSnapshot(ctx, dbURL.String())
+		if err != nil {
+			return cli.errorOut(err)
+		}
+		defer release()
+
+		cli.Logger.Infof("Backing up database %s to %s, and its keys to %s", dbURL.Redacted(), output, keysFile)
+		if _, err = runPgCommand(nil, "pg_dump", append(args, "--snapshot="+snapshot)...); err != nil {
+			return cli.errorOut(err)
+		}
+		keysSQL, err = runPgCommand(nil, "pg_dump", dbURL.String(), "--snapshot="+snapshot, "--data-only", "--inserts", "--table="+keyRingsTable, "--table="+roleKeyRingsTable)
+		if err != nil {
+			return cli.errorOut(err)
+		}
+	}
+
+	if err = verifyBackup(output, keysFile == ""); err != nil {
+		return cli.errorOut(errors.Wrapf(err, "backup %s is not restorable", output))
+	}
+
+	if keysFile != "" {
+		cryptoJSON, err2 := gethkeystore.EncryptDataV3(keysSQL, []byte(keysPassword), gethkeystore.StandardScryptN, gethkeystore.StandardScryptP)
+		if err2 != nil {
+			return cli.errorOut(errors.Wrap(err2, "failed to encrypt the keys"))
+		}
+		b, err2 := json.Marshal(cryptoJSON)
+		if err2 != nil {
+			return cli.errorOut(errors.Wrap(err2, "failed to encrypt the keys"))
+		}
+		if err2 = utils.WriteFileWithMaxPerms(keysFile, b, 0600); err2 != nil {
+			return cli.errorOut(errors.Wrapf(err2, "failed to write the keys to %s", keysFile))
+		}
+	}
+
+	cli.Logger.Infof("Backup completed: %s", output)
+	return nil
+}
+
+// RestoreDatabase replaces the content of the database with a backup made by
+// BackupDatabase. The database is migrated to the current version the next
+// time the node starts.
+func (cli *Client) RestoreDatabase(c *clipkg.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the backup file"))
+	}
+	file := c.Args().First()
+	dbURL := cli.Config.DatabaseURL()
+
+	toc, err := runPgCommand(nil, "pg_restore", "--list", file)
+	if err != nil {
+		return cli.errorOut(errors.Wrapf(err, "failed to read backup %s", file))
+	}
+	if !hasTableData(toc, "goose_migrations") {
+		return cli.errorOut(errors.Errorf("%s is not a backup of a Chainlink database", file))
+	}
+
+	// decrypt the keys before touching the database, to fail early on a wrong
+	// password
+	var keysSQL []byte
+	if keysFile := c.String("keys-file"); keysFile != "" {
+		password, err2 := utils.PasswordFromFile(c.String("keys-password"))
+		if err2 != nil {
+			return cli.errorOut(errors.Wrap(err2, "failed to read the keys password"))
+		}
+		b, err2 := os.ReadFile(keysFile)
+		if err2 != nil {
+			return cli.errorOut(errors.Wrapf(err2, "failed to read %s", keysFile))
+		}
+		var cryptoJSON gethkeystore.CryptoJSON
+		if err2 = json.Unmarshal(b, &cryptoJSON); err2 != nil {
+			return cli.errorOut(errors.Wrapf(err2, "failed to parse %s", keysFile))
+		}
+		if keysSQL, err2 = gethkeystore.DecryptDataV3(cryptoJSON, password); err2 != nil {
+			return cli.errorOut(errors.Wrap(err2, "failed to decrypt the keys, is the password correct?"))
+		}
+	} else if !hasTableData(toc, keyRingsTable) {
+		return cli.errorOut(errors.New("the keys were backed up separately, pass their file with --keys-file"))
+	}
+
+	cli.Logger.Warnf("This replaces the content of database %s with %s", dbURL.Redacted(), file)
+	if !confirmAction(c) {
+		return nil
+	}
+
+	cli.Logger.Infof("Restoring database %s from %s", dbURL.Redacted(), file)
+	if _, err = runPgCommand(nil, "pg_restore", "--clean", "--if-exists", "--no-owner", "--single-transaction", "-d", dbURL.String(), file); err != nil {
+		return cli.errorOut(err)
+	}
+	if keysSQL != nil {
+		if _, err = runPgCommand(keysSQL, "psql", dbURL.String(), "-q", "-v", "ON_ERROR_STOP=1", "--single-transaction", "-f", "-"); err != nil {
+			return cli.errorOut(errors.Wrap(err, "database restored, but failed to restore the keys"))
+		}
+	}
+
+	cli.Logger.Info("Restore completed")
+	return nil
+}
+
+// exportSnapshot exports the snapshot of a transaction, to be shared by
+// several pg_dump runs. The snapshot is valid until release is called.
+func exportSnapshot(ctx context.Context, dbURL string) (snapshot string, release func(), err error) {
+	db, err := sql.Open(string(dialects.Postgres), dbURL)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "failed to connect to the database")
+	}
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		_ = db.Close()
+		return "", nil, errors.Wrap(err, "failed to begin transaction")
+	}
+	release = func() {
+		_ = tx.Rollback()
+		_ = db.Close()
+	}
+	if err = tx.QueryRowContext(ctx, `SELECT pg_export_snapshot()`).Scan(&snapshot); err != nil {
+		release()
+		return "", nil, errors.Wrap(err, "failed to export snapshot")
+	}
+	return snapshot, release, nil
+}
+
+// verifyBackup checks that pg_restore can read the backup, and that it holds
+// the migrations state and, unless they were backed up separately, the keys
+func verifyBackup(file string, keys bool) error {
+	toc, err := runPgCommand(nil, "pg_restore", "--list", file)
+	if err != nil {
+		return err
+	}
+	if !hasTableData(toc, "goose_migrations") {
+		return errors.New("the migrations state is missing")
+	}
+	if keys && !hasTableData(toc, keyRingsTable) {
+		return errors.New("the keys are missing")
+	}
+	return nil
+}
+
+// hasTableData returns true if the table of contents listed by pg_restore
+// holds the data of table
+func hasTableData(toc []byte, table string) bool {
+	for _, line := range strings.Split(string(toc), "\n") {
+		fields := strings.Fields(line)
+		for i := 0; i+3 < len(fields); i++ {
+			if fields[i] == "TABLE" && fields[i+1] == "DATA" && fields[i+3] == table {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// runPgCommand runs one of the postgres client tools, the arguments are not
+// logged as they include the database URL
+func runPgCommand(stdin []byte, name string, args ...string) ([]byte, error) {
+	cmd := exec.Command(name, args...)
+	if stdin != nil {
+		cmd.Stdin = bytes.NewReader(stdin)
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		var ee *exec.ExitError
+		if errors.As(err, &ee) {
+			return nil, errors.Wrapf(err, "%s failed with output: %s", name, string(ee.Stderr))
+		}
+		return nil, errors.Wrapf(err, "%s failed", name)
+	}
+	return out, nil
+}
@@ -0,0 +1,72 @@
+package cmd_test
+
+import (
+	"flag"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest/heavyweight"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func TestClient_BackupAndRestoreDatabase(t *testing.T) {
+	config, sqlxDB := heavyweight.FullTestDB(t, "backuprestore")
+	keyStore := cltest.NewKeyStore(t, sqlxDB, config)
+	_, address := cltest.MustInsertRandomKey(t, keyStore.Eth(), 0)
+
+	lggr := logger.TestLogger(t)
+	client := cmd.Client{
+		Config:      config,
+		Logger:      lggr,
+		CloseLogger: lggr.Sync,
+	}
+
+	dir := t.TempDir()
+	backupFile := filepath.Join(dir, "backup.dump")
+	keysFile := filepath.Join(dir, "keys.json")
+
+	set := flag.NewFlagSet("test", 0)
+	set.String("output", backupFile, "")
+	set.String("keys-file", keysFile, "")
+	set.String("keys-password", "../internal/fixtures/correct_password.txt", "")
+	require.NoError(t, client.BackupDatabase(cli.NewContext(nil, set, nil)))
+	assert.FileExists(t, backupFile)
+	assert.FileExists(t, keysFile)
+
+	sqlxDB.MustExec(`DELETE FROM encrypted_key_rings`)
+
+	t.Run("the keys are required when backed up separately", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		set.Bool("yes", true, "")
+		require.NoError(t, set.Parse([]string{backupFile}))
+		require.ErrorContains(t, client.RestoreDatabase(cli.NewContext(nil, set, nil)), "--keys-file")
+	})
+
+	t.Run("wrong keys password", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		set.Bool("yes", true, "")
+		set.String("keys-file", keysFile, "")
+		set.String("keys-password", "../internal/fixtures/incorrect_password.txt", "")
+		require.NoError(t, set.Parse([]string{backupFile}))
+		require.ErrorContains(t, client.RestoreDatabase(cli.NewContext(nil, set, nil)), "failed to decrypt the keys")
+	})
+
+	set = flag.NewFlagSet("test", 0)
+	set.Bool("yes", true, "")
+	set.String("keys-file", keysFile, "")
+	set.String("keys-password", "../internal/fixtures/correct_password.txt", "")
+	require.NoError(t, set.Parse([]string{backupFile}))
+	require.NoError(t, client.RestoreDatabase(cli.NewContext(nil, set, nil)))
+
+	var count int
+	require.NoError(t, sqlxDB.Get(&count, `SELECT count(*) FROM encrypted_key_rings`))
+	assert.Equal(t, 1, count)
+	require.NoError(t, sqlxDB.Get(&count, `SELECT count(*) FROM evm_key_states WHERE address = $1`, address))
+	assert.Equal(t, 1, count)
+}
@@ -105,6 +105,36 @@ func (cli *Client) CreateETHKey(c *cli.Context) (err error) {
 	return cli.renderAPIResponse(resp, &EthKeyPresenter{}, "ETH key created.\n\n🔑 New key")
 }
 
+// AddRemoteETHKey adds a key signed by a remote signer
+func (cli *Client) AddRemoteETHKey(c *cli.Context) (err error) {
+	addUrl := url.URL{
+		Path: "/v2/keys/evm/remote",
+	}
+	query := addUrl.Query()
+	query.Set("address", c.String("address"))
+	query.Set("type", c.String("type"))
+	query.Set("url", c.String("url"))
+	if c.IsSet("keyName") {
+		query.Set("keyName", c.String("keyName"))
+	}
+	if c.IsSet("evmChainID") {
+		query.Set("evmChainID", c.String("evmChainID"))
+	}
+
+	addUrl.RawQuery = query.Encode()
+	resp, err := cli.HTTP.Post(addUrl.String(), nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &EthKeyPresenter{}, "Remote ETH key added.\n\n🔑 New key")
+}
+
 // UpdateETHKey updates an Ethereum key's parameters,
 // address of key must be passed as well as at least one parameter to update
 func (cli *Client) UpdateETHKey(c *cli.Context) (err error) {
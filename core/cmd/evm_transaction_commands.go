@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"net/url"
+	"strconv"
 
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
@@ -82,6 +84,92 @@ func (cli *Client) ShowTransaction(c *cli.Context) (err error) {
 	return err
 }
 
+// BumpTransaction immediately bumps the gas of the unconfirmed transaction with
+// the given hash
+func (cli *Client) BumpTransaction(c *cli.Context) (err error) {
+	return cli.forceTransaction(c, "bump")
+}
+
+// CancelTransaction replaces the unconfirmed transaction with the given hash
+// with a zero-value transfer to its sender
+func (cli *Client) CancelTransaction(c *cli.Context) (err error) {
+	return cli.forceTransaction(c, "cancel")
+}
+
+func (cli *Client) forceTransaction(c *cli.Context, action string) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the hash of the transaction"))
+	}
+	hash := c.Args().First()
+	resp, err := cli.HTTP.Post("/v2/transactions/evm/"+hash+"/"+action, nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	err = cli.renderAPIResponse(resp, &EthTxPresenter{})
+	return err
+}
+
+type NonceReconciliationPresenter struct {
+	JAID
+	presenters.NonceReconciliationResource
+}
+
+// RenderTable implements TableRenderer
+func (p *NonceReconciliationPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Address", "EVM Chain ID", "Local Nonce", "Latest Nonce", "Pending Nonce", "Next Nonce", "Backfilled", "Rebroadcast"})
+	table.Append([]string{
+		p.Address.Hex(),
+		p.EVMChainID.String(),
+		fmt.Sprint(p.LocalNonce),
+		fmt.Sprint(p.LatestNonce),
+		fmt.Sprint(p.PendingNonce),
+		fmt.Sprint(p.NextNonce),
+		fmt.Sprint(p.Backfilled),
+		fmt.Sprint(p.Rebroadcast),
+	})
+
+	render("Nonce Reconciliation", table)
+	return nil
+}
+
+// RebroadcastTransactionRange reconciles the local nonce of a key with the
+// chain, backfilling nonce gaps and re-sending the unconfirmed transactions
+// within the given nonce range, on a running node
+func (cli *Client) RebroadcastTransactionRange(c *cli.Context) (err error) {
+	if !c.IsSet("address") || !c.IsSet("beginningNonce") || !c.IsSet("endingNonce") {
+		return cli.errorOut(errors.New("must pass address, beginningNonce and endingNonce"))
+	}
+	rebroadcastUrl := url.URL{
+		Path: "/v2/transactions/evm/rebroadcast",
+	}
+	query := rebroadcastUrl.Query()
+	query.Set("address", c.String("address"))
+	query.Set("beginningNonce", strconv.FormatInt(c.Int64("beginningNonce"), 10))
+	query.Set("endingNonce", strconv.FormatInt(c.Int64("endingNonce"), 10))
+	if c.IsSet("evmChainID") {
+		query.Set("evmChainID", c.String("evmChainID"))
+	}
+
+	rebroadcastUrl.RawQuery = query.Encode()
+	resp, err := cli.HTTP.Post(rebroadcastUrl.String(), nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &NonceReconciliationPresenter{})
+}
+
 // IndexTxAttempts returns the list of transactions in descending order,
 // taking an optional page parameter
 func (cli *Client) IndexTxAttempts(c *cli.Context) error {
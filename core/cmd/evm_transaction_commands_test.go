@@ -73,6 +73,51 @@ func TestClient_ShowTransaction(t *testing.T) {
 	assert.Equal(t, &tx.FromAddress, renderedTx.From)
 }
 
+func TestClient_BumpTransaction_Errors(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, _ := app.NewClientAndRenderer()
+
+	_, from := cltest.MustAddRandomKeyToKeystore(t, app.KeyStore.Eth())
+	tx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, app.TxmORM(), 0, 1, from)
+	attempt := tx.EthTxAttempts[0]
+
+	set := flag.NewFlagSet("test bump tx", 0)
+	c := cli.NewContext(nil, set, nil)
+	require.Error(t, client.BumpTransaction(c))
+	require.Error(t, client.CancelTransaction(c))
+
+	set = flag.NewFlagSet("test bump tx", 0)
+	set.Parse([]string{attempt.Hash.Hex()})
+	c = cli.NewContext(nil, set, nil)
+	require.Error(t, client.BumpTransaction(c), "confirmed transactions cannot be bumped")
+	require.Error(t, client.CancelTransaction(c), "confirmed transactions cannot be cancelled")
+}
+
+func TestClient_RebroadcastTransactionRange_Errors(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, _ := app.NewClientAndRenderer()
+
+	_, from := cltest.MustAddRandomKeyToKeystore(t, app.KeyStore.Eth())
+
+	set := flag.NewFlagSet("test rebroadcast", 0)
+	set.String("address", from.Hex(), "")
+	c := cli.NewContext(nil, set, nil)
+	require.Error(t, client.RebroadcastTransactionRange(c), "nonce range is required")
+
+	set = flag.NewFlagSet("test rebroadcast", 0)
+	set.String("address", from.Hex(), "")
+	set.Int64("beginningNonce", 0, "")
+	set.Int64("endingNonce", 0, "")
+	require.NoError(t, set.Set("beginningNonce", "2"))
+	require.NoError(t, set.Set("endingNonce", "1"))
+	c = cli.NewContext(nil, set, nil)
+	require.Error(t, client.RebroadcastTransactionRange(c), "nonce range is invalid")
+}
+
 func TestClient_IndexTxAttempts(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type ExternalDatabasePresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.ExternalDatabaseResource
+}
+
+var externalDatabasesHeaders = []string{"Name", "URL", "Created At"}
+
+// ToRow presents the ExternalDatabaseResource as a slice of strings.
+func (p *ExternalDatabasePresenter) ToRow() []string {
+	return []string{
+		p.GetID(),
+		p.URL,
+		p.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *ExternalDatabasePresenter) RenderTable(rt RendererTable) error {
+	renderList(externalDatabasesHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return nil
+}
+
+// ExternalDatabasePresenters implements TableRenderer for a slice of ExternalDatabasePresenter.
+type ExternalDatabasePresenters []ExternalDatabasePresenter
+
+// RenderTable implements TableRenderer
+func (ps ExternalDatabasePresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(externalDatabasesHeaders, rows, rt.Writer)
+	return nil
+}
+
+// ListExternalDatabases lists the external databases
+func (cli *Client) ListExternalDatabases(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/external_databases")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &ExternalDatabasePresenters{})
+}
+
+// CreateExternalDatabase registers an external database for the dbquery tasks
+func (cli *Client) CreateExternalDatabase(c *cli.Context) (err error) {
+	request := web.CreateExternalDatabaseRequest{
+		Name: c.String("name"),
+		URL:  c.String("url"),
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	resp, err := cli.HTTP.Post("/v2/external_databases", bytes.NewReader(body))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &ExternalDatabasePresenter{}, "External database created")
+}
+
+// DeleteExternalDatabase unregisters an external database
+func (cli *Client) DeleteExternalDatabase(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the name of the database to be deleted"))
+	}
+	resp, err := cli.HTTP.Delete("/v2/external_databases/" + c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	if _, err = cli.parseResponse(resp); err != nil {
+		return cli.errorOut(err)
+	}
+
+	fmt.Printf("External database %v deleted\n", c.Args().First())
+	return nil
+}
@@ -11,3 +11,8 @@ func (cli *Client) CheckRemoteBuildCompatibility(lggr logger.Logger, onlyWarn bo
 func (cli *Client) ConfigDumpStr() (string, error) {
 	return cli.configDumpStr()
 }
+
+// RequiresCredentials exposes requiresCredentials for testing.
+func RequiresCredentials(args []string) bool {
+	return requiresCredentials(args)
+}
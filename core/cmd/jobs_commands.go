@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/url"
 	"time"
 
 	"github.com/pkg/errors"
@@ -155,6 +156,9 @@ func (ps JobPresenters) RenderTable(rt RendererTable) error {
 
 // ListJobs lists all jobs
 func (cli *Client) ListJobs(c *cli.Context) (err error) {
+	if c.Bool("archived") {
+		return cli.getPage("/v2/jobs?archived=true", c.Int("page"), &JobPresenters{})
+	}
 	return cli.getPage("/v2/jobs", c.Int("page"), &JobPresenters{})
 }
 
@@ -220,12 +224,33 @@ func (cli *Client) CreateJob(c *cli.Context) (err error) {
 	return err
 }
 
-// DeleteJob deletes a job
+// DeleteJob deletes a job, or archives it with --archive
 func (cli *Client) DeleteJob(c *cli.Context) error {
 	if !c.Args().Present() {
 		return cli.errorOut(errors.New("must pass the job id to be archived"))
 	}
-	resp, err := cli.HTTP.Delete("/v2/jobs/" + c.Args().First())
+	if c.Bool("archive") {
+		return cli.removeJob(c.Args().First(), "archive", "Job %v Archived\n")
+	}
+	return cli.removeJob(c.Args().First(), "", "Job %v Deleted\n")
+}
+
+// PurgeJob deletes an archived job, along with its runs
+func (cli *Client) PurgeJob(c *cli.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the job id to be purged"))
+	}
+	return cli.removeJob(c.Args().First(), "purge", "Job %v Purged\n")
+}
+
+func (cli *Client) removeJob(id string, param string, msg string) error {
+	deleteURL := url.URL{Path: "/v2/jobs/" + id}
+	if param != "" {
+		query := deleteURL.Query()
+		query.Set(param, "true")
+		deleteURL.RawQuery = query.Encode()
+	}
+	resp, err := cli.HTTP.Delete(deleteURL.String())
 	if err != nil {
 		return cli.errorOut(err)
 	}
@@ -234,10 +259,37 @@ func (cli *Client) DeleteJob(c *cli.Context) error {
 		return cli.errorOut(err)
 	}
 
-	fmt.Printf("Job %v Deleted\n", c.Args().First())
+	fmt.Printf(msg, id)
 	return nil
 }
 
+// PauseJob stops the services of a job until it is resumed
+func (cli *Client) PauseJob(c *cli.Context) (err error) {
+	return cli.setJobPaused(c, "pause", "Job paused")
+}
+
+// ResumeJob restarts the services of a paused job
+func (cli *Client) ResumeJob(c *cli.Context) (err error) {
+	return cli.setJobPaused(c, "resume", "Job resumed")
+}
+
+func (cli *Client) setJobPaused(c *cli.Context, action string, msg string) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.Errorf("must pass the job id to %s", action))
+	}
+	resp, err := cli.HTTP.Post("/v2/jobs/"+c.Args().First()+"/"+action, nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobPresenter{}, msg)
+}
+
 // TriggerPipelineRun triggers a job run based on a job ID
 func (cli *Client) TriggerPipelineRun(c *cli.Context) error {
 	if !c.Args().Present() {
@@ -400,6 +400,54 @@ func TestClient_DeleteJob(t *testing.T) {
 	requireJobsCount(t, app.JobORM(), 0)
 }
 
+func TestClient_ArchiveAndPurgeJob(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t, withConfigSet(func(c *configtest.TestGeneralConfig) {
+		c.Overrides.SetTriggerFallbackDBPollInterval(100 * time.Millisecond)
+		c.Overrides.EVMEnabled = null.BoolFrom(true)
+		c.Overrides.GlobalEvmNonceAutoSync = null.BoolFrom(false)
+		c.Overrides.GlobalBalanceMonitorEnabled = null.BoolFrom(false)
+		c.Overrides.GlobalGasEstimatorMode = null.StringFrom("FixedPrice")
+	}))
+	client, r := app.NewClientAndRenderer()
+
+	fs := flag.NewFlagSet("", flag.ExitOnError)
+	fs.Parse([]string{"../testdata/tomlspecs/direct-request-spec.toml"})
+	require.NoError(t, client.CreateJob(cli.NewContext(nil, fs, nil)))
+	require.NotEmpty(t, r.Renders)
+	output := *r.Renders[0].(*cmd.JobPresenter)
+
+	jobs, _, err := app.JobORM().FindJobs(0, 1000)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	cltest.AwaitJobActive(t, app.JobSpawner(), jobs[0].ID, 3*time.Second)
+
+	// only archived jobs can be purged
+	set := flag.NewFlagSet("test", 0)
+	set.Parse([]string{output.ID})
+	require.Error(t, client.PurgeJob(cli.NewContext(nil, set, nil)))
+
+	set = flag.NewFlagSet("test", 0)
+	set.Bool("archive", true, "")
+	set.Parse([]string{output.ID})
+	require.NoError(t, client.DeleteJob(cli.NewContext(nil, set, nil)))
+
+	requireJobsCount(t, app.JobORM(), 0)
+	assert.NotContains(t, app.JobSpawner().ActiveJobs(), jobs[0].ID)
+	archived, _, err := app.JobORM().FindArchivedJobs(0, 1000)
+	require.NoError(t, err)
+	require.Len(t, archived, 1)
+
+	set = flag.NewFlagSet("test", 0)
+	set.Parse([]string{output.ID})
+	require.NoError(t, client.PurgeJob(cli.NewContext(nil, set, nil)))
+
+	archived, _, err = app.JobORM().FindArchivedJobs(0, 1000)
+	require.NoError(t, err)
+	require.Empty(t, archived)
+}
+
 func requireJobsCount(t *testing.T, orm job.ORM, expected int) {
 	jobs, _, err := orm.FindJobs(0, 1000)
 	require.NoError(t, err)
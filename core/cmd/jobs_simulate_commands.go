@@ -0,0 +1,324 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/headtracker"
+	httypes "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/types"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	clhttp "github.com/smartcontractkit/chainlink/core/utils/http"
+)
+
+// JobSimulationPresenter is the result of a job pipeline run simulated
+// with read-only calls to a chain at a fixed block.
+type JobSimulationPresenter struct {
+	ForkBlockNumber uint64                 `json:"forkBlockNumber"`
+	EVMChainID      string                 `json:"evmChainID"`
+	State           pipeline.RunStatus     `json:"state"`
+	Outputs         interface{}            `json:"outputs"`
+	Errors          []string               `json:"errors"`
+	TaskRuns        []SimulatedTaskRun     `json:"taskRuns"`
+	Transactions    []SimulatedTransaction `json:"transactions"`
+}
+
+// SimulatedTaskRun is the result of a task of a simulated run.
+type SimulatedTaskRun struct {
+	DotID  string            `json:"dotId"`
+	Type   pipeline.TaskType `json:"type"`
+	Output interface{}       `json:"output"`
+	Error  string            `json:"error,omitempty"`
+}
+
+// SimulatedTransaction is a transaction of an ethtx task, executed with
+// eth_call at the fork block instead of being sent.
+type SimulatedTransaction struct {
+	From     common.Address `json:"from"`
+	To       common.Address `json:"to"`
+	Data     hexutil.Bytes  `json:"data"`
+	GasLimit uint32         `json:"gasLimit"`
+	Error    string         `json:"error,omitempty"`
+}
+
+// RenderTable implements TableRenderer
+func (p *JobSimulationPresenter) RenderTable(rt RendererTable) error {
+	outputs, err := json.Marshal(p.Outputs)
+	if err != nil {
+		return err
+	}
+	renderList([]string{"EVM Chain ID", "Fork Block", "State", "Outputs", "Errors"}, [][]string{{
+		p.EVMChainID,
+		fmt.Sprint(p.ForkBlockNumber),
+		string(p.State),
+		string(outputs),
+		strings.Join(p.Errors, "\n"),
+	}}, rt.Writer)
+
+	table := rt.newTable([]string{"Task", "Type", "Output", "Error"})
+	for _, tr := range p.TaskRuns {
+		output, err := json.Marshal(tr.Output)
+		if err != nil {
+			return err
+		}
+		table.Append([]string{tr.DotID, string(tr.Type), string(output), tr.Error})
+	}
+	render("Task Runs", table)
+
+	if len(p.Transactions) > 0 {
+		table = rt.newTable([]string{"From", "To", "Gas Limit", "Data", "Error"})
+		for _, tx := range p.Transactions {
+			table.Append([]string{tx.From.Hex(), tx.To.Hex(), fmt.Sprint(tx.GasLimit), tx.Data.String(), tx.Error})
+		}
+		render("Simulated Transactions", table)
+	}
+	return nil
+}
+
+// SimulateJob runs the pipeline of a job spec once, with the eth calls made
+// at a fixed block of the chain, and prints the results. Nothing is stored in
+// the database, and the transactions of the ethtx tasks are executed with
+// eth_call instead of being sent.
+//
+// The simulation is read-only: every task sees the state of the fork block,
+// as the transactions are never mined, so the tasks do not see the state
+// changes of the transactions of the tasks before them. The simulation does
+// not start a fork of its own either, the --fork-url is only called.
+func (cli *Client) SimulateJob(c *cli.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the filepath of the job spec to simulate"))
+	}
+	forkURL := c.String("fork-url")
+	if forkURL == "" {
+		return cli.errorOut(errors.New("Must pass the --fork-url of the RPC to simulate the job against"))
+	}
+
+	b, err := os.ReadFile(c.Args().First())
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "failed to read the job spec"))
+	}
+	spec, vars, err := simulationSpec(string(b), c.String("vars"))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	ctx := context.Background()
+	ethClient, err := ethclient.DialContext(ctx, forkURL)
+	if err != nil {
+		return cli.errorOut(errors.Wrapf(err, "failed to dial %s", forkURL))
+	}
+	defer ethClient.Close()
+	chainID, err := ethClient.ChainID(ctx)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "failed to get the chain ID of the fork"))
+	}
+	forkBlock := c.Uint64("fork-block-number")
+	if !c.IsSet("fork-block-number") {
+		if forkBlock, err = ethClient.BlockNumber(ctx); err != nil {
+			return cli.errorOut(errors.Wrap(err, "failed to get the block number of the fork"))
+		}
+	}
+
+	lggr := cli.Logger.Named("JobSimulation")
+	client := &readOnlyClient{
+		NullClient: evmclient.NewNullClient(chainID, lggr),
+		eth:        ethClient,
+		forkBlock:  new(big.Int).SetUint64(forkBlock),
+	}
+	txm := &callTxManager{
+		NullTxManager: txmgr.NullTxManager{ErrMsg: "transactions are not sent by jobs simulate"},
+		client:        client,
+	}
+	chainSet, err := evm.NewChainSet(ctx, evm.ChainSetOpts{
+		Config:       cli.Config,
+		Logger:       lggr,
+		GenEthClient: func(evmtypes.DBChain) evmclient.Client { return client },
+		GenLogBroadcaster: func(evmtypes.DBChain) log.Broadcaster {
+			return &log.NullBroadcaster{ErrMsg: "logs are not broadcast by jobs simulate"}
+		},
+		GenHeadTracker: func(evmtypes.DBChain, httypes.HeadBroadcaster) httypes.HeadTracker {
+			return headtracker.NullTracker
+		},
+		GenTxManager: func(evmtypes.DBChain) txmgr.TxManager { return txm },
+	}, []evmtypes.DBChain{{ID: *utils.NewBig(chainID), Cfg: &evmtypes.ChainCfg{}, Enabled: true}}, nil)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "failed to create the simulated chain"))
+	}
+
+	// The ORM is only used by the bridge tasks, which are rejected, and by
+	// the deviation tasks, which always deviate without it
+	runner := pipeline.NewRunner(nil, cli.Config, chainSet, simulationKeyStore{}, simulationKeyStore{}, lggr,
+		clhttp.NewRestrictedHTTPClient(cli.Config, lggr), clhttp.NewUnrestrictedHTTPClient())
+	run, _, err := runner.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(vars), lggr)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "failed to run the job pipeline"))
+	}
+
+	p := JobSimulationPresenter{
+		ForkBlockNumber: forkBlock,
+		EVMChainID:      chainID.String(),
+		State:           run.State,
+		Outputs:         run.Outputs.Val,
+		Transactions:    txm.transactions(),
+	}
+	for _, e := range run.AllErrors {
+		if e.Valid {
+			p.Errors = append(p.Errors, e.String)
+		}
+	}
+	for _, tr := range run.PipelineTaskRuns {
+		p.TaskRuns = append(p.TaskRuns, SimulatedTaskRun{
+			DotID:  tr.DotID,
+			Type:   tr.Type,
+			Output: tr.Output.Val,
+			Error:  tr.Error.ValueOrZero(),
+		})
+	}
+	return cli.errorOut(cli.Render(&p))
+}
+
+// simulationSpec returns the pipeline spec of the TOML job spec, and the vars
+// of its run: jobSpec holds the top level fields of the job spec, and the
+// JSON object varsJSON is merged in.
+func simulationSpec(tomlString string, varsJSON string) (spec pipeline.Spec, vars map[string]interface{}, err error) {
+	tree, err := toml.Load(tomlString)
+	if err != nil {
+		return spec, nil, errors.Wrap(err, "failed to parse the job spec")
+	}
+	spec.JobType, _ = tree.Get("type").(string)
+	spec.JobName, _ = tree.Get("name").(string)
+	if gasLimit, ok := tree.Get("gasLimit").(int64); ok {
+		limit := uint32(gasLimit)
+		spec.GasLimit = &limit
+	}
+	if spec.JobType == pipeline.KeeperJobType {
+		spec.DotDagSource = pipeline.KeepersObservationSource
+	} else {
+		spec.DotDagSource, _ = tree.Get("observationSource").(string)
+	}
+	if strings.TrimSpace(spec.DotDagSource) == "" {
+		return spec, nil, errors.New("the job spec has no observationSource to simulate")
+	}
+
+	p, err := pipeline.Parse(spec.DotDagSource)
+	if err != nil {
+		return spec, nil, errors.Wrap(err, "invalid observationSource")
+	}
+	for _, task := range p.Tasks {
+		if task.Type() == pipeline.TaskTypeBridge {
+			return spec, nil, errors.Errorf("task %s: bridge tasks cannot be simulated, as the bridges are stored in the database of the node", task.DotID())
+		}
+	}
+
+	jobSpec := map[string]interface{}{"databaseID": 0}
+	for k, v := range tree.ToMap() {
+		switch v.(type) {
+		case map[string]interface{}, []interface{}:
+		default:
+			jobSpec[k] = v
+		}
+	}
+	vars = map[string]interface{}{"jobSpec": jobSpec}
+	if varsJSON != "" {
+		var extra map[string]interface{}
+		if err = json.Unmarshal([]byte(varsJSON), &extra); err != nil {
+			return spec, nil, errors.Wrap(err, "--vars must be a JSON object")
+		}
+		for k, v := range extra {
+			vars[k] = v
+		}
+	}
+	return spec, vars, nil
+}
+
+// readOnlyClient makes the calls of the simulated chain at the fork block.
+// Sending transactions fails, as with the NullClient.
+type readOnlyClient struct {
+	*evmclient.NullClient
+	eth       *ethclient.Client
+	forkBlock *big.Int
+}
+
+func (c *readOnlyClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if blockNumber == nil {
+		blockNumber = c.forkBlock
+	}
+	return c.eth.CallContract(ctx, msg, blockNumber)
+}
+
+func (c *readOnlyClient) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return c.eth.EstimateGas(ctx, call)
+}
+
+// callTxManager executes the transactions with eth_call at the fork block,
+// and records them. Their state changes are discarded.
+type callTxManager struct {
+	txmgr.NullTxManager
+	client *readOnlyClient
+
+	mu  sync.Mutex
+	txs []SimulatedTransaction
+}
+
+func (m *callTxManager) CreateEthTransaction(newTx txmgr.NewTx, _ ...pg.QOpt) (etx txmgr.EthTx, err error) {
+	ctx, cancel := evmclient.WithDefaultTimeout(context.Background())
+	defer cancel()
+	to := newTx.ToAddress
+	_, err = m.client.CallContract(ctx, ethereum.CallMsg{
+		From: newTx.FromAddress,
+		To:   &to,
+		Gas:  uint64(newTx.GasLimit),
+		Data: newTx.EncodedPayload,
+	}, nil)
+
+	tx := SimulatedTransaction{From: newTx.FromAddress, To: to, Data: newTx.EncodedPayload, GasLimit: newTx.GasLimit}
+	if err != nil {
+		tx.Error = err.Error()
+		err = errors.Wrap(err, "the transaction would fail")
+	}
+	m.mu.Lock()
+	m.txs = append(m.txs, tx)
+	m.mu.Unlock()
+	return etx, err
+}
+
+func (m *callTxManager) transactions() []SimulatedTransaction {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]SimulatedTransaction(nil), m.txs...)
+}
+
+// simulationKeyStore stands for the keystores of the node, which are not
+// used by the simulation.
+type simulationKeyStore struct{}
+
+func (simulationKeyStore) GetRoundRobinAddress(_ *big.Int, addrs ...common.Address) (common.Address, error) {
+	if len(addrs) == 0 {
+		return common.Address{}, errors.New("ethtx tasks must set from to be simulated")
+	}
+	return addrs[0], nil
+}
+
+func (simulationKeyStore) GenerateProof(string, *big.Int) (vrfkey.Proof, error) {
+	return vrfkey.Proof{}, errors.New("VRF proofs cannot be simulated, as the VRF keys are stored in the keystore of the node")
+}
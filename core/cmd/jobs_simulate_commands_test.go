@@ -0,0 +1,102 @@
+package cmd_test
+
+import (
+	"flag"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestClient_SimulateJob(t *testing.T) {
+	t.Parallel()
+
+	const spec = `
+type = "webhook"
+schemaVersion = 1
+contractAddress = "0x5FbDB2315678afecb367f032d93F642f64180aa3"
+observationSource = """
+	call  [type=ethcall evmChainID="1337" contract="$(jobSpec.contractAddress)" data="0x313ce567"]
+	parse [type=ethabidecode abi="uint256 value" data="$(call)"]
+	call -> parse
+"""
+`
+	specPath := filepath.Join(t.TempDir(), "spec.toml")
+	require.NoError(t, os.WriteFile(specPath, []byte(spec), 0600))
+
+	ws := testutils.NewWSServer(t, big.NewInt(1337), func(method string, params gjson.Result) (string, string) {
+		switch method {
+		case "eth_blockNumber":
+			return `"0x2a"`, ""
+		case "eth_call":
+			assert.Equal(t, "0x5fbdb2315678afecb367f032d93f642f64180aa3", params.Array()[0].Get("to").String())
+			assert.Equal(t, "0x2a", params.Array()[1].String())
+			return `"0x0000000000000000000000000000000000000000000000000000000000000012"`, ""
+		}
+		t.Errorf("unexpected method %s", method)
+		return "null", ""
+	})
+
+	r := &cltest.RendererMock{}
+	client := cmd.Client{
+		Config:   cltest.NewTestGeneralConfig(t),
+		Logger:   logger.TestLogger(t),
+		Renderer: r,
+	}
+
+	set := flag.NewFlagSet("test", 0)
+	set.String("fork-url", ws.WSURL().String(), "")
+	require.NoError(t, set.Parse([]string{specPath}))
+	require.NoError(t, client.SimulateJob(cli.NewContext(nil, set, nil)))
+
+	require.Len(t, r.Renders, 1)
+	p := r.Renders[0].(*cmd.JobSimulationPresenter)
+	assert.Equal(t, uint64(42), p.ForkBlockNumber)
+	assert.Equal(t, "1337", p.EVMChainID)
+	assert.Equal(t, pipeline.RunStatusCompleted, p.State)
+	assert.Empty(t, p.Errors)
+	require.Len(t, p.TaskRuns, 2)
+	for _, tr := range p.TaskRuns {
+		assert.Empty(t, tr.Error)
+		if tr.DotID == "parse" {
+			assert.Equal(t, map[string]interface{}{"value": big.NewInt(18)}, tr.Output)
+		}
+	}
+	assert.Empty(t, p.Transactions)
+}
+
+func TestClient_SimulateJob_RejectsBridgeTasks(t *testing.T) {
+	t.Parallel()
+
+	const spec = `
+type = "webhook"
+schemaVersion = 1
+observationSource = """
+	fetch [type=bridge name="my-bridge"]
+"""
+`
+	specPath := filepath.Join(t.TempDir(), "spec.toml")
+	require.NoError(t, os.WriteFile(specPath, []byte(spec), 0600))
+
+	client := cmd.Client{
+		Config:   cltest.NewTestGeneralConfig(t),
+		Logger:   logger.TestLogger(t),
+		Renderer: &cltest.RendererMock{},
+	}
+	set := flag.NewFlagSet("test", 0)
+	set.String("fork-url", "ws://localhost:8546", "")
+	require.NoError(t, set.Parse([]string{specPath}))
+	err := client.SimulateJob(cli.NewContext(nil, set, nil))
+	assert.EqualError(t, err, "task fetch: bridge tasks cannot be simulated, as the bridges are stored in the database of the node")
+}
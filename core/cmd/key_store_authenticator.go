@@ -8,6 +8,7 @@ import (
 	clipkg "github.com/urfave/cli"
 
 	"github.com/smartcontractkit/chainlink/core/config"
+	"github.com/smartcontractkit/chainlink/core/secrets"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -18,7 +19,10 @@ type TerminalKeyStoreAuthenticator struct {
 	Prompter Prompter
 }
 
-func (auth TerminalKeyStoreAuthenticator) authenticate(c *clipkg.Context, keyStore keystore.Master, cfg config.GeneralConfig) error {
+// authenticate unlocks the keystore with the password from the --password
+// file, the secrets provider or the config, in that order, prompting for it
+// if none is set.
+func (auth TerminalKeyStoreAuthenticator) authenticate(c *clipkg.Context, keyStore keystore.Master, cfg config.GeneralConfig, creds secrets.Credentials) error {
 	isEmpty, err := keyStore.IsEmpty()
 	if err != nil {
 		return errors.Wrap(err, "error determining if keystore is empty")
@@ -32,6 +36,8 @@ func (auth TerminalKeyStoreAuthenticator) authenticate(c *clipkg.Context, keySto
 		if err != nil {
 			return errors.Wrap(err, "error reading password from file")
 		}
+	} else if creds.KeystorePassword != "" {
+		password = creds.KeystorePassword
 	} else {
 		password = cfg.KeystorePassword()
 	}
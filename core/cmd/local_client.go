@@ -135,7 +135,7 @@ func (cli *Client) runNode(c *clipkg.Context) error {
 
 	sessionORM := app.SessionORM()
 	keyStore := app.GetKeyStore()
-	err = cli.KeyStoreAuthenticator.authenticate(c, keyStore, cli.Config)
+	err = cli.KeyStoreAuthenticator.authenticate(c, keyStore, cli.Config, cli.Credentials)
 	if err != nil {
 		return errors.Wrap(err, "error authenticating keystore")
 	}
@@ -261,8 +261,14 @@ func (cli *Client) runNode(c *clipkg.Context) error {
 
 	lggr.Infow(fmt.Sprintf("Chainlink booted in %.2fs", time.Since(static.InitTime).Seconds()), "appID", app.ID())
 
+	runner := cli.Runner
+	if r, ok := runner.(ChainlinkRunner); ok && cli.Credentials.TLSKey != "" {
+		r.TLSKey = []byte(cli.Credentials.TLSKey)
+		runner = r
+	}
+
 	grp.Go(func() error {
-		errInternal := cli.Runner.Run(grpCtx, app)
+		errInternal := runner.Run(grpCtx, app)
 		if errors.Is(errInternal, http.ErrServerClosed) {
 			errInternal = nil
 		}
@@ -555,10 +561,31 @@ func (cli *Client) MigrateDatabase(c *clipkg.Context) error {
 		return cli.errorOut(errors.New("You must set DATABASE_URL env variable. HINT: If you are running this to set up your local test database, try DATABASE_URL=postgresql://postgres@localhost:5432/chainlink_test?sslmode=disable"))
 	}
 
+	if c.Bool("dry-run") {
+		db, err := newConnection(cfg, cli.Logger)
+		if err != nil {
+			return cli.errorOut(fmt.Errorf("failed to initialize orm: %v", err))
+		}
+		defer db.Close()
+		return cli.errorOut(migrate.WritePendingSQL(db.DB, os.Stdout))
+	}
+
 	cli.Logger.Infof("Migrating database: %#v", parsed.String())
 	if err := migrateDB(cfg, cli.Logger); err != nil {
 		return cli.errorOut(err)
 	}
+
+	if c.Bool("accept-drift") {
+		db, err := newConnection(cfg, cli.Logger)
+		if err != nil {
+			return cli.errorOut(fmt.Errorf("failed to initialize orm: %v", err))
+		}
+		defer db.Close()
+		if err = migrate.AcceptSchema(db.DB); err != nil {
+			return cli.errorOut(err)
+		}
+		cli.Logger.Info("Recorded the current database schema as expected")
+	}
 	return nil
 }
 
@@ -612,6 +639,14 @@ func (cli *Client) StatusDatabase(c *clipkg.Context) error {
 	if err = migrate.Status(db.DB, cli.Logger); err != nil {
 		return fmt.Errorf("Status failed: %v", err)
 	}
+
+	err = migrate.CheckSchema(db.DB)
+	if errors.Is(err, migrate.ErrSchemaDrift) {
+		return fmt.Errorf("%v, run `chainlink db migrate --accept-drift` to accept the current schema", err)
+	} else if err != nil {
+		return fmt.Errorf("CheckSchema failed: %v", err)
+	}
+	cli.Logger.Info("Database schema matches its migrations")
 	return nil
 }
 
@@ -223,7 +223,7 @@ func (cli *Client) ConfigureOCR2VRFNode(c *clipkg.Context) (*SetupOCR2VRFNodePay
 }
 
 func setupKeystore(cli *Client, c *clipkg.Context, app chainlink.Application, keyStore keystore.Master) error {
-	err := cli.KeyStoreAuthenticator.authenticate(c, keyStore, cli.Config)
+	err := cli.KeyStoreAuthenticator.authenticate(c, keyStore, cli.Config, cli.Credentials)
 	if err != nil {
 		return errors.Wrap(err, "error authenticating keystore")
 	}
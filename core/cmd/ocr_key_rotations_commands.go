@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/services/keyrotation"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type OCRKeyRotationPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.OCRKeyRotationResource
+}
+
+var ocrKeyRotationsHeaders = []string{"ID", "Key Type", "Old Key Bundle ID", "New Key Bundle ID", "State", "Job IDs", "Created At", "Completed At"}
+
+// ToRow presents the OCRKeyRotationResource as a slice of strings.
+func (p *OCRKeyRotationPresenter) ToRow() []string {
+	jobIDs := make([]string, len(p.JobIDs))
+	for i, id := range p.JobIDs {
+		jobIDs[i] = fmt.Sprint(id)
+	}
+	completedAt := ""
+	if p.CompletedAt != nil {
+		completedAt = p.CompletedAt.Format(time.RFC3339)
+	}
+	return []string{
+		p.GetID(),
+		string(p.KeyType),
+		p.OldKeyBundleID,
+		p.NewKeyBundleID,
+		string(p.State),
+		strings.Join(jobIDs, ","),
+		p.CreatedAt.Format(time.RFC3339),
+		completedAt,
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *OCRKeyRotationPresenter) RenderTable(rt RendererTable) error {
+	renderList(ocrKeyRotationsHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return nil
+}
+
+// OCRKeyRotationPresenters implements TableRenderer for a slice of OCRKeyRotationPresenter.
+type OCRKeyRotationPresenters []OCRKeyRotationPresenter
+
+// RenderTable implements TableRenderer
+func (ps OCRKeyRotationPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(ocrKeyRotationsHeaders, rows, rt.Writer)
+	return nil
+}
+
+// ListOCRKeyRotations lists the rotations of the OCR and OCR2 key bundles
+func (cli *Client) ListOCRKeyRotations(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/keys/ocr_rotations")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &OCRKeyRotationPresenters{})
+}
+
+// StageOCRKeyRotation creates a new key bundle replacing the given one, to be
+// added to the on-chain config before completing the rotation
+func (cli *Client) StageOCRKeyRotation(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the ID of the key bundle to rotate"))
+	}
+	request := web.OCRKeyRotationRequest{
+		KeyType:     keyrotation.KeyType(c.String("type")),
+		KeyBundleID: c.Args().First(),
+	}
+	if err = keyrotation.ValidateKeyType(request.KeyType); err != nil {
+		return cli.errorOut(err)
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	resp, err := cli.HTTP.Post("/v2/keys/ocr_rotations", bytes.NewReader(body))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &OCRKeyRotationPresenter{}, "Key bundle rotation staged, complete it once the on-chain config lists the new key bundle")
+}
+
+// CompleteOCRKeyRotation switches the jobs of a staged rotation to its new
+// key bundle
+func (cli *Client) CompleteOCRKeyRotation(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the rotation id to be completed"))
+	}
+	resp, err := cli.HTTP.Post(fmt.Sprintf("/v2/keys/ocr_rotations/%s/complete", c.Args().First()), nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &OCRKeyRotationPresenter{}, "Key bundle rotation completed")
+}
+
+// CancelOCRKeyRotation deletes a staged rotation, keeping its new key bundle
+func (cli *Client) CancelOCRKeyRotation(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the rotation id to be cancelled"))
+	}
+	resp, err := cli.HTTP.Delete("/v2/keys/ocr_rotations/" + c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	if _, err = cli.parseResponse(resp); err != nil {
+		return cli.errorOut(err)
+	}
+
+	fmt.Printf("Key bundle rotation %v cancelled\n", c.Args().First())
+	return nil
+}
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+var p2pPeersHeaders = []string{"Job ID", "Peer ID", "Connected", "Dial Failures", "Last Dial Error", "Sent", "Received", "Last Received At"}
+
+func p2pPeerRow(jobID string, p presenters.P2PPeerStatus) []string {
+	lastReceivedAt := ""
+	if p.LastReceivedAt != nil {
+		lastReceivedAt = p.LastReceivedAt.Format(time.RFC3339)
+	}
+	return []string{
+		jobID,
+		p.PeerID,
+		fmt.Sprint(p.Connected),
+		fmt.Sprint(p.DialFailures),
+		p.LastDialError,
+		fmt.Sprintf("%d msgs / %d B", p.MessagesSent, p.BytesSent),
+		fmt.Sprintf("%d msgs / %d B", p.MessagesReceived, p.BytesReceived),
+		lastReceivedAt,
+	}
+}
+
+type P2PStatusPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.P2PStatusResource
+}
+
+// RenderTable implements TableRenderer
+func (p *P2PStatusPresenter) RenderTable(rt RendererTable) error {
+	renderList([]string{"Peer ID", "Networking Stack", "Announce Addresses"}, [][]string{{
+		p.GetID(),
+		p.NetworkingStack,
+		strings.Join(p.AnnounceAddresses, ", "),
+	}}, rt.Writer)
+
+	var rows [][]string
+	for _, job := range p.Jobs {
+		for _, peer := range job.Peers {
+			rows = append(rows, p2pPeerRow(fmt.Sprint(job.JobID), peer))
+		}
+	}
+	renderList(p2pPeersHeaders, rows, rt.Writer)
+	return nil
+}
+
+type P2PPingPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.P2PPingResource
+}
+
+// RenderTable implements TableRenderer
+func (p *P2PPingPresenter) RenderTable(rt RendererTable) error {
+	renderList(p2pPeersHeaders[1:], [][]string{p2pPeerRow("", p.P2PPeerStatus)[1:]}, rt.Writer)
+
+	var rows [][]string
+	for _, r := range p.Results {
+		latency := ""
+		if r.Error == "" {
+			latency = fmt.Sprintf("%.1fms", r.Latency)
+		}
+		rows = append(rows, []string{r.Address, latency, r.Error})
+	}
+	renderList([]string{"Address", "Latency", "Error"}, rows, rt.Writer)
+	return nil
+}
+
+// ShowP2PStatus shows the peers of each OCR job, their connectivity and
+// traffic
+func (cli *Client) ShowP2PStatus(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/p2p/status")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &P2PStatusPresenter{})
+}
+
+// PingP2PPeer dials the addresses of a peer, and shows its connectivity
+func (cli *Client) PingP2PPeer(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the peer ID to ping"))
+	}
+
+	body, err := json.Marshal(web.P2PPingRequest{Addresses: c.StringSlice("address")})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	resp, err := cli.HTTP.Post("/v2/p2p/ping/"+c.Args().First(), bytes.NewReader(body))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &P2PPingPresenter{})
+}
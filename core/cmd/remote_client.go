@@ -163,6 +163,49 @@ func (cli *Client) ReplayFromBlock(c *clipkg.Context) (err error) {
 	return err
 }
 
+// ReplayJobLogs sends the chain logs in the given block range to the log listeners of a single job
+func (cli *Client) ReplayJobLogs(c *clipkg.Context) (err error) {
+	jobID := c.Int64("job")
+	if jobID <= 0 {
+		return cli.errorOut(errors.New("Must pass a positive value in '--job' parameter"))
+	}
+	fromBlock := c.Int64("from")
+	toBlock := c.Int64("to")
+	if fromBlock < 0 || toBlock < fromBlock {
+		return cli.errorOut(errors.New("'--from' must be non-negative and not greater than '--to'"))
+	}
+
+	query := url.Values{}
+	query.Set("from", strconv.FormatInt(fromBlock, 10))
+	query.Set("to", strconv.FormatInt(toBlock, 10))
+	if c.IsSet("evmChainID") {
+		query.Set("evmChainID", c.String("evmChainID"))
+	}
+
+	buf := bytes.NewBufferString("{}")
+	resp, err := cli.HTTP.Post(fmt.Sprintf("/v2/replay_job/%v?%s", jobID, query.Encode()), buf)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		bytes, err2 := cli.parseResponse(resp)
+		if err2 != nil {
+			return errors.Wrap(err2, "parseResponse error")
+		}
+		return cli.errorOut(errors.New(string(bytes)))
+	}
+
+	err = cli.printResponseBody(resp)
+	return err
+}
+
 // RemoteLogin creates a cookie session to run remote commands.
 func (cli *Client) RemoteLogin(c *clipkg.Context) error {
 	lggr := cli.Logger.Named("RemoteLogin")
@@ -235,6 +278,80 @@ func (cli *Client) ChangePassword(c *clipkg.Context) (err error) {
 	return nil
 }
 
+// ChangeKeystorePassword re-encrypts all keys in the node's keystore with the
+// password read from the newpassword file, or only the keys of a role.
+func (cli *Client) ChangeKeystorePassword(c *clipkg.Context) (err error) {
+	oldPasswordFile := c.String("oldpassword")
+	if len(oldPasswordFile) == 0 {
+		return cli.errorOut(errors.New("Must specify --oldpassword flag"))
+	}
+	oldPassword, err := os.ReadFile(oldPasswordFile)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not read password file"))
+	}
+	newPasswordFile := c.String("newpassword")
+	if len(newPasswordFile) == 0 {
+		return cli.errorOut(errors.New("Must specify --newpassword flag"))
+	}
+	newPassword, err := os.ReadFile(newPasswordFile)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not read password file"))
+	}
+
+	requestData, err := json.Marshal(web.UpdatePasswordRequest{
+		OldPassword: strings.TrimSpace(string(oldPassword)),
+		NewPassword: strings.TrimSpace(string(newPassword)),
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	path := "/v2/keys/password"
+	if role := c.String("role"); role != "" {
+		path = "/v2/keys/roles/" + url.PathEscape(role) + "/password"
+	}
+	resp, err := cli.HTTP.Patch(path, bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusNoContent {
+		_, err = cli.parseResponse(resp)
+		return err
+	}
+	if c.String("role") != "" {
+		fmt.Println("Keystore role password updated.")
+	} else {
+		fmt.Println("Keystore password updated. Start the node with the new password from now on.")
+	}
+	return nil
+}
+
+// RotateColumnKey re-encrypts the secrets stored in the node's database with a
+// new column key
+func (cli *Client) RotateColumnKey(c *clipkg.Context) (err error) {
+	resp, err := cli.HTTP.Post("/v2/keys/column_key/rotate", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return cli.printResponseBody(resp)
+	}
+	fmt.Println("Column key rotated.")
+	return nil
+}
+
 // Profile will collect pprof metrics and store them in a folder.
 func (cli *Client) Profile(c *clipkg.Context) error {
 	seconds := c.Uint("seconds")
@@ -463,13 +580,13 @@ func (cli *Client) ConfigDump(c *clipkg.Context) (err error) {
 	return nil
 }
 
+// ConfigFileValidate prints the config, and returns an error if it is invalid
 func (cli *Client) ConfigFileValidate(c *clipkg.Context) error {
-	err := cli.Config.Validate()
-	if err != nil {
-		fmt.Println("Invalid configuration:", err)
-		fmt.Println()
-	}
 	cli.Config.LogConfiguration(func(params ...any) { fmt.Println(params...) })
+	if err := cli.Config.Validate(); err != nil {
+		return cli.errorOut(errors.Wrap(err, "invalid configuration"))
+	}
+	fmt.Println("Valid configuration.")
 	return nil
 }
 
@@ -481,6 +598,16 @@ func normalizePassword(password string) string {
 func (cli *Client) SetLogLevel(c *clipkg.Context) (err error) {
 	logLevel := c.String("level")
 	request := web.LogPatchRequest{Level: logLevel}
+	for _, pkgLevel := range c.StringSlice("package") {
+		pkg, level, found := strings.Cut(pkgLevel, "=")
+		if !found {
+			return cli.errorOut(errors.Errorf("invalid package log level %q, must be <package>=<level>", pkgLevel))
+		}
+		if request.Packages == nil {
+			request.Packages = make(map[string]string)
+		}
+		request.Packages[pkg] = level
+	}
 	requestData, err := json.Marshal(request)
 	if err != nil {
 		return cli.errorOut(err)
@@ -10,6 +10,7 @@ import (
 	"math/big"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
@@ -18,6 +19,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli"
+	"go.uber.org/zap/zapcore"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/auth"
@@ -31,6 +33,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/static"
 	"github.com/smartcontractkit/chainlink/core/testdata/testspecs"
@@ -144,6 +147,29 @@ func TestClient_ReplayBlocks(t *testing.T) {
 	assert.NoError(t, client.ReplayFromBlock(c))
 }
 
+func TestClient_ReplayJobLogs(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t,
+		withConfigSet(func(c *configtest.TestGeneralConfig) {
+			c.Overrides.EVMEnabled = null.BoolFrom(true)
+			c.Overrides.GlobalEvmNonceAutoSync = null.BoolFrom(false)
+			c.Overrides.GlobalBalanceMonitorEnabled = null.BoolFrom(false)
+			c.Overrides.GlobalGasEstimatorMode = null.StringFrom("FixedPrice")
+		}))
+	client, _ := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("flagset", 0)
+	set.Int64("job", 42, "")
+	set.Int64("from", 10, "")
+	set.Int64("to", 5, "")
+	c := cli.NewContext(nil, set, nil)
+	assert.ErrorContains(t, client.ReplayJobLogs(c), "'--from' must be non-negative and not greater than '--to'")
+
+	require.NoError(t, set.Set("to", "20"))
+	assert.ErrorContains(t, client.ReplayJobLogs(c), "job not found")
+}
+
 func TestClient_CreateExternalInitiator(t *testing.T) {
 	t.Parallel()
 
@@ -429,6 +455,40 @@ func TestClient_ChangePassword(t *testing.T) {
 	require.Contains(t, err.Error(), "Unauthorized")
 }
 
+func TestClient_ChangeKeystorePassword(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, _ := app.NewClientAndRenderer()
+
+	newPasswordFile := filepath.Join(t.TempDir(), "password.txt")
+	require.NoError(t, os.WriteFile(newPasswordFile, []byte("n3wp4ssw0rdAlsoLongEnough\n"), 0600))
+
+	changePassword := func(role, oldPasswordFile, newPasswordFile string) error {
+		set := flag.NewFlagSet("test", 0)
+		set.String("oldpassword", oldPasswordFile, "")
+		set.String("newpassword", newPasswordFile, "")
+		set.String("role", role, "")
+		return client.ChangeKeystorePassword(cli.NewContext(nil, set, nil))
+	}
+
+	err := changePassword("", "../internal/fixtures/incorrect_password.txt", newPasswordFile)
+	require.ErrorContains(t, err, keystore.ErrWrongPassword.Error())
+
+	// too short
+	require.Error(t, changePassword("", "../internal/fixtures/correct_password.txt", "../internal/fixtures/new_password.txt"))
+
+	require.ErrorContains(t, changePassword("unknown", "../internal/fixtures/correct_password.txt", newPasswordFile), "unknown keystore role")
+	require.NoError(t, changePassword("vrf", "../internal/fixtures/correct_password.txt", newPasswordFile))
+	err = changePassword("vrf", "../internal/fixtures/correct_password.txt", newPasswordFile)
+	require.ErrorContains(t, err, keystore.ErrWrongPassword.Error())
+
+	require.NoError(t, changePassword("", "../internal/fixtures/correct_password.txt", newPasswordFile))
+
+	err = changePassword("", "../internal/fixtures/correct_password.txt", newPasswordFile)
+	require.ErrorContains(t, err, keystore.ErrWrongPassword.Error())
+}
+
 func TestClient_Profile_InvalidSecondsParam(t *testing.T) {
 	t.Parallel()
 
@@ -728,6 +788,21 @@ func TestClient_SetLogConfig(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, logLevel, app.Config.LogLevel().String())
 
+	set = flag.NewFlagSet("loglevel", 0)
+	set.Var(&cli.StringSlice{"core/services/pipeline=debug"}, "package", "")
+	c = cli.NewContext(nil, set, nil)
+
+	err = client.SetLogLevel(c)
+	require.NoError(t, err)
+	pkgLevels, err := app.PackageLogLevels(testutils.Context(t))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]zapcore.Level{"core/services/pipeline": zapcore.DebugLevel}, pkgLevels)
+
+	set = flag.NewFlagSet("loglevel", 0)
+	set.Var(&cli.StringSlice{"core/services/pipeline"}, "package", "")
+	c = cli.NewContext(nil, set, nil)
+	require.Error(t, client.SetLogLevel(c))
+
 	sqlEnabled := true
 	set = flag.NewFlagSet("logsql", 0)
 	set.Bool("enable", sqlEnabled, "")
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/olekukonko/tablewriter"
@@ -208,11 +209,21 @@ func (rt RendererTable) newTable(headers []string) *tablewriter.Table {
 
 func (rt RendererTable) renderConfigPatchResponse(config *web.ConfigPatchResponse) error {
 	table := rt.newTable([]string{"Config", "Old Value", "New Value"})
-	table.Append([]string{
-		"EvmGasPriceDefault",
-		config.EvmGasPriceDefault.From,
-		config.EvmGasPriceDefault.To,
-	})
+	if config.EvmGasPriceDefault != (web.Change{}) {
+		table.Append([]string{
+			"EvmGasPriceDefault",
+			config.EvmGasPriceDefault.From,
+			config.EvmGasPriceDefault.To,
+		})
+	}
+	names := make([]string, 0, len(config.Changes))
+	for name := range config.Changes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		table.Append([]string{name, config.Changes[name].From, config.Changes[name].To})
+	}
 	render("Configuration Changes", table)
 	return nil
 }
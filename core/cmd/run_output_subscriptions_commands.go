@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type RunOutputSubscriptionPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.RunOutputSubscriptionResource
+}
+
+var runOutputSubscriptionsHeaders = []string{"ID", "URL", "Job ID", "Job Type", "Status", "Created At"}
+
+// ToRow presents the RunOutputSubscriptionResource as a slice of strings.
+func (p *RunOutputSubscriptionPresenter) ToRow() []string {
+	jobID := ""
+	if p.JobID.Valid {
+		jobID = strconv.FormatInt(p.JobID.Int64, 10)
+	}
+	return []string{
+		p.GetID(),
+		p.URL,
+		jobID,
+		p.JobType.ValueOrZero(),
+		p.Status.ValueOrZero(),
+		p.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// RenderTable implements TableRenderer. The secret is only returned by the
+// node when the subscription is created.
+func (p *RunOutputSubscriptionPresenter) RenderTable(rt RendererTable) error {
+	headers := runOutputSubscriptionsHeaders
+	row := p.ToRow()
+	if p.Secret != "" {
+		headers = append(headers[:len(headers):len(headers)], "Secret")
+		row = append(row, p.Secret)
+	}
+	renderList(headers, [][]string{row}, rt.Writer)
+	return nil
+}
+
+// RunOutputSubscriptionPresenters implements TableRenderer for a slice of RunOutputSubscriptionPresenter.
+type RunOutputSubscriptionPresenters []RunOutputSubscriptionPresenter
+
+// RenderTable implements TableRenderer
+func (ps RunOutputSubscriptionPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(runOutputSubscriptionsHeaders, rows, rt.Writer)
+	return nil
+}
+
+// ListRunOutputSubscriptions lists the URLs subscribed to run outputs
+func (cli *Client) ListRunOutputSubscriptions(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/run_output_subscriptions")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &RunOutputSubscriptionPresenters{})
+}
+
+// SubscribeRunOutputs subscribes a URL to the outputs of finished runs
+func (cli *Client) SubscribeRunOutputs(c *cli.Context) (err error) {
+	u, err := url.ParseRequestURI(c.String("url"))
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "invalid url"))
+	}
+	request := web.CreateRunOutputSubscriptionRequest{URL: models.WebURL(*u)}
+	if c.IsSet("job") {
+		request.JobID = null.IntFrom(c.Int64("job"))
+	}
+	if c.IsSet("jobType") {
+		request.JobType = null.StringFrom(c.String("jobType"))
+	}
+	if c.IsSet("status") {
+		request.Status = null.StringFrom(c.String("status"))
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	resp, err := cli.HTTP.Post("/v2/run_output_subscriptions", bytes.NewReader(body))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &RunOutputSubscriptionPresenter{}, "Run output subscription created, keep the secret to verify deliveries")
+}
+
+// UnsubscribeRunOutputs deletes a run outputs subscription
+func (cli *Client) UnsubscribeRunOutputs(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the subscription id to be deleted"))
+	}
+	resp, err := cli.HTTP.Delete("/v2/run_output_subscriptions/" + c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	if _, err = cli.parseResponse(resp); err != nil {
+		return cli.errorOut(err)
+	}
+
+	fmt.Printf("Run output subscription %v deleted\n", c.Args().First())
+	return nil
+}
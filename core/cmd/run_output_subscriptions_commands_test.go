@@ -0,0 +1,80 @@
+package cmd_test
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestRunOutputSubscriptionPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		createdAt = time.Now()
+		buffer    = bytes.NewBufferString("")
+		r         = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.RunOutputSubscriptionPresenter{
+		RunOutputSubscriptionResource: presenters.RunOutputSubscriptionResource{
+			JAID:      presenters.NewJAID("7"),
+			URL:       "https://example.com/outputs",
+			Secret:    "s3cr3t",
+			JobID:     null.IntFrom(42),
+			Status:    null.StringFrom("errored"),
+			CreatedAt: createdAt,
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+	output := buffer.String()
+	assert.Contains(t, output, "https://example.com/outputs")
+	assert.Contains(t, output, "42")
+	assert.Contains(t, output, "errored")
+	assert.Contains(t, output, "s3cr3t")
+	assert.Contains(t, output, createdAt.Format(time.RFC3339))
+
+	buffer.Reset()
+	p.Secret = ""
+	require.NoError(t, cmd.RunOutputSubscriptionPresenters{p}.RenderTable(r))
+	output = buffer.String()
+	assert.Contains(t, output, "https://example.com/outputs")
+	assert.NotContains(t, output, "Secret")
+}
+
+func TestClient_RunOutputSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	set.String("url", "https://example.com/outputs", "")
+	set.String("status", "completed", "")
+	require.NoError(t, client.SubscribeRunOutputs(cli.NewContext(nil, set, nil)))
+	require.Len(t, r.Renders, 1)
+	created := r.Renders[0].(*cmd.RunOutputSubscriptionPresenter)
+	assert.Equal(t, "https://example.com/outputs", created.URL)
+	assert.Equal(t, "completed", created.Status.String)
+	assert.NotEmpty(t, created.Secret)
+
+	require.NoError(t, client.ListRunOutputSubscriptions(nilContext))
+	require.Len(t, r.Renders, 2)
+	subs := *r.Renders[1].(*cmd.RunOutputSubscriptionPresenters)
+	require.Len(t, subs, 1)
+	assert.Equal(t, created.ID, subs[0].ID)
+
+	set = flag.NewFlagSet("test", 0)
+	require.NoError(t, set.Parse([]string{created.ID}))
+	require.NoError(t, client.UnsubscribeRunOutputs(cli.NewContext(nil, set, nil)))
+	require.Error(t, client.UnsubscribeRunOutputs(cli.NewContext(nil, set, nil)))
+}
@@ -40,6 +40,7 @@ type ConfigSchema struct {
 	DatabaseURL string `env:"DATABASE_URL"`
 
 	// General/misc
+	AuditLogForwardURL           *url.URL        `env:"AUDIT_LOG_FORWARD_URL"`
 	ChainType                    string          `env:"CHAIN_TYPE"`
 	Dev                          bool            `env:"CHAINLINK_DEV" default:"false"`
 	ExplorerAccessKey            string          `env:"EXPLORER_ACCESS_KEY"`
@@ -49,6 +50,10 @@ type ConfigSchema struct {
 	InsecureFastScrypt           bool            `env:"INSECURE_FAST_SCRYPT" default:"false"` //nodoc
 	ReaperExpiration             models.Duration `env:"REAPER_EXPIRATION" default:"240h"`     //nodoc
 	RootDir                      string          `env:"ROOT" default:"~/.chainlink"`
+	TelemetryExporterURL         *url.URL        `env:"TELEMETRY_EXPORTER_URL"`
+	TelemetryExporterCACertPath  string          `env:"TELEMETRY_EXPORTER_CA_CERT_PATH"`
+	TelemetryExporterCertPath    string          `env:"TELEMETRY_EXPORTER_CERT_PATH"`
+	TelemetryExporterKeyPath     string          `env:"TELEMETRY_EXPORTER_KEY_PATH"`
 	TelemetryIngressUniConn      bool            `env:"TELEMETRY_INGRESS_UNICONN" default:"true"`
 	TelemetryIngressLogging      bool            `env:"TELEMETRY_INGRESS_LOGGING" default:"false"`
 	TelemetryIngressServerPubKey string          `env:"TELEMETRY_INGRESS_SERVER_PUB_KEY"`
@@ -61,6 +66,7 @@ type ConfigSchema struct {
 	ShutdownGracePeriod          time.Duration   `env:"SHUTDOWN_GRACE_PERIOD" default:"5s"`
 
 	// Database
+	DatabaseAllowSchemaDrift             bool          `env:"DATABASE_ALLOW_SCHEMA_DRIFT" default:"false"`
 	DatabaseListenerMaxReconnectDuration time.Duration `env:"DATABASE_LISTENER_MAX_RECONNECT_DURATION" default:"10m"` //nodoc
 	DatabaseListenerMinReconnectInterval time.Duration `env:"DATABASE_LISTENER_MIN_RECONNECT_INTERVAL" default:"1m"`  //nodoc
 	MigrateDatabase                      bool          `env:"MIGRATE_DATABASE" default:"true"`
@@ -91,20 +97,23 @@ type ConfigSchema struct {
 	LogUnixTS         bool           `env:"LOG_UNIX_TS" default:"false"`
 
 	// Web Server
+	AllowHeaders                   string          `env:"ALLOW_HEADERS"`
 	AllowOrigins                   string          `env:"ALLOW_ORIGINS" default:"http://localhost:3000,http://localhost:6688"`
 	AuthenticatedRateLimit         int64           `env:"AUTHENTICATED_RATE_LIMIT" default:"1000"`
 	AuthenticatedRateLimitPeriod   time.Duration   `env:"AUTHENTICATED_RATE_LIMIT_PERIOD" default:"1m"`
 	BridgeResponseURL              url.URL         `env:"BRIDGE_RESPONSE_URL"`
 	HTTPServerWriteTimeout         time.Duration   `env:"HTTP_SERVER_WRITE_TIMEOUT" default:"10s"`
 	Port                           uint16          `env:"CHAINLINK_PORT" default:"6688"`
+	ReadinessStrict                bool            `env:"READINESS_STRICT" default:"false"`
 	SecureCookies                  bool            `env:"SECURE_COOKIES" default:"true"`
 	SessionTimeout                 models.Duration `env:"SESSION_TIMEOUT" default:"15m"`
 	UnAuthenticatedRateLimit       int64           `env:"UNAUTHENTICATED_RATE_LIMIT" default:"5"`
 	UnAuthenticatedRateLimitPeriod time.Duration   `env:"UNAUTHENTICATED_RATE_LIMIT_PERIOD" default:"20s"`
 
 	// Web Server MFA
-	RPID     string `env:"MFA_RPID"`
-	RPOrigin string `env:"MFA_RPORIGIN"`
+	RPID            string `env:"MFA_RPID"`
+	RPOrigin        string `env:"MFA_RPORIGIN"`
+	EnforceWebAuthn bool   `env:"ENFORCE_WEBAUTHN" default:"false"`
 
 	// Web Server TLS
 	TLSCertPath string `env:"TLS_CERT_PATH"`
@@ -148,6 +157,7 @@ type ConfigSchema struct {
 	EthTxReaperThreshold              time.Duration `env:"ETH_TX_REAPER_THRESHOLD"`
 	EthTxResendAfterThreshold         time.Duration `env:"ETH_TX_RESEND_AFTER_THRESHOLD"`
 	EvmFinalityDepth                  uint32        `env:"ETH_FINALITY_DEPTH"`
+	EvmFinalityTag                    string        `env:"ETH_FINALITY_TAG"`
 	EvmHeadTrackerHistoryDepth        uint          `env:"ETH_HEAD_TRACKER_HISTORY_DEPTH"`
 	EvmHeadTrackerMaxBufferSize       uint          `env:"ETH_HEAD_TRACKER_MAX_BUFFER_SIZE"`
 	EvmHeadTrackerSamplingInterval    time.Duration `env:"ETH_HEAD_TRACKER_SAMPLING_INTERVAL"`
@@ -155,14 +165,17 @@ type ConfigSchema struct {
 	EvmLogPollInterval                time.Duration `env:"ETH_LOG_POLL_INTERVAL"`
 	EvmRPCDefaultBatchSize            uint32        `env:"ETH_RPC_DEFAULT_BATCH_SIZE"`
 	LinkContractAddress               string        `env:"LINK_CONTRACT_ADDRESS"`
+	LogBroadcasterPolling             bool          `env:"LOG_BROADCASTER_POLLING" default:"false"`
 	OperatorFactoryAddress            string        `env:"OPERATOR_FACTORY_ADDRESS"`
 	MinIncomingConfirmations          uint32        `env:"MIN_INCOMING_CONFIRMATIONS"`
 	MinimumContractPayment            assets.Link   `env:"MINIMUM_CONTRACT_PAYMENT_LINK_JUELS"`
 	// Node liveness checking
+	NodeCacheReads           bool          `env:"NODE_CACHE_READS" default:"false"`
 	NodeNoNewHeadsThreshold  time.Duration `env:"NODE_NO_NEW_HEADS_THRESHOLD"`
 	NodePollFailureThreshold uint32        `env:"NODE_POLL_FAILURE_THRESHOLD"`
 	NodePollInterval         time.Duration `env:"NODE_POLL_INTERVAL"`
 	NodeSelectionMode        string        `env:"NODE_SELECTION_MODE"`
+	NodeSendStrategy         string        `env:"NODE_SEND_STRATEGY" default:"Broadcast"`
 
 	// EVM Gas Controls
 	EvmEIP1559DynamicFees bool     `env:"EVM_EIP1559_DYNAMIC_FEES"`
@@ -193,20 +206,34 @@ type ConfigSchema struct {
 	BlockHistoryEstimatorEIP1559FeeCapBufferBlocks uint16 `env:"BLOCK_HISTORY_ESTIMATOR_EIP1559_FEE_CAP_BUFFER_BLOCKS"`
 	BlockHistoryEstimatorTransactionPercentile     uint16 `env:"BLOCK_HISTORY_ESTIMATOR_TRANSACTION_PERCENTILE"`
 	// Txm
-	EvmGasBumpTxDepth          uint16 `env:"ETH_GAS_BUMP_TX_DEPTH"`
-	EvmMaxInFlightTransactions uint32 `env:"ETH_MAX_IN_FLIGHT_TRANSACTIONS"`
-	EvmMaxQueuedTransactions   uint64 `env:"ETH_MAX_QUEUED_TRANSACTIONS"`
-	EvmNonceAutoSync           bool   `env:"ETH_NONCE_AUTO_SYNC"`
-	EvmUseForwarders           bool   `env:"ETH_USE_FORWARDERS"`
+	EvmGasBumpTxDepth              uint16 `env:"ETH_GAS_BUMP_TX_DEPTH"`
+	EvmMaxInFlightTransactions     uint32 `env:"ETH_MAX_IN_FLIGHT_TRANSACTIONS"`
+	EvmMaxQueuedTransactions       uint64 `env:"ETH_MAX_QUEUED_TRANSACTIONS"`
+	EvmMaxQueuedTransactionsPolicy string `env:"ETH_MAX_QUEUED_TRANSACTIONS_POLICY" default:"Reject"`
+	EvmNonceAutoSync               bool   `env:"ETH_NONCE_AUTO_SYNC"`
+	EvmUseForwarders               bool   `env:"ETH_USE_FORWARDERS"`
 
 	// Job Pipeline and tasks
+	BridgeHealthCheckInterval        time.Duration   `env:"BRIDGE_HEALTH_CHECK_INTERVAL" default:"0"`
+	BridgeHealthCheckPath            string          `env:"BRIDGE_HEALTH_CHECK_PATH" default:"/health"`
+	BridgeHealthWindow               time.Duration   `env:"BRIDGE_HEALTH_WINDOW" default:"1h"`
 	DefaultHTTPLimit                 int64           `env:"DEFAULT_HTTP_LIMIT" default:"32768"`
 	DefaultHTTPTimeout               models.Duration `env:"DEFAULT_HTTP_TIMEOUT" default:"15s"`
 	FeatureExternalInitiators        bool            `env:"FEATURE_EXTERNAL_INITIATORS" default:"false"`
+	JobPipelineIPFSGatewayURL        url.URL         `env:"JOB_PIPELINE_IPFS_GATEWAY_URL"`
+	JobPipelineIPFSNodeURL           url.URL         `env:"JOB_PIPELINE_IPFS_NODE_URL"`
 	JobPipelineMaxRunDuration        time.Duration   `env:"JOB_PIPELINE_MAX_RUN_DURATION" default:"10m"`
+	JobPipelinePublishURL            url.URL         `env:"JOB_PIPELINE_PUBLISH_URL"`
+	JobPipelineQueueTriggerTopics    []string        `env:"JOB_PIPELINE_QUEUE_TRIGGER_TOPICS"`
+	JobPipelineQueueTriggerURL       url.URL         `env:"JOB_PIPELINE_QUEUE_TRIGGER_URL"`
+	JobPipelineReaperExportEndpoint  url.URL         `env:"JOB_PIPELINE_REAPER_EXPORT_ENDPOINT"`
+	JobPipelineReaperExportURL       url.URL         `env:"JOB_PIPELINE_REAPER_EXPORT_URL"`
 	JobPipelineReaperInterval        time.Duration   `env:"JOB_PIPELINE_REAPER_INTERVAL" default:"1h"`
 	JobPipelineReaperThreshold       time.Duration   `env:"JOB_PIPELINE_REAPER_THRESHOLD" default:"24h"`
 	JobPipelineResultWriteQueueDepth uint64          `env:"JOB_PIPELINE_RESULT_WRITE_QUEUE_DEPTH" default:"100"`
+	JobPipelineSpecVariables         []string        `env:"JOB_PIPELINE_SPEC_VARIABLES"`
+	JobPipelineMetricsJobIDLabel     string          `env:"JOB_PIPELINE_METRICS_JOB_ID_LABEL" default:"keep"`
+	JobPipelineMetricsCustomLabels   []string        `env:"JOB_PIPELINE_METRICS_CUSTOM_LABELS"`
 
 	// Flux Monitor
 	FMDefaultTransactionQueueDepth uint32 `env:"FM_DEFAULT_TRANSACTION_QUEUE_DEPTH" default:"1"` //nodoc
@@ -260,11 +287,14 @@ type ConfigSchema struct {
 	P2PPeerID                           p2pkey.PeerID `env:"P2P_PEER_ID"`
 	P2PPeerstoreWriteInterval           time.Duration `env:"P2P_PEERSTORE_WRITE_INTERVAL" default:"5m"` //nodoc
 	// V2 Only
-	P2PV2AnnounceAddresses []string        `env:"P2PV2_ANNOUNCE_ADDRESSES"`
-	P2PV2Bootstrappers     []string        `env:"P2PV2_BOOTSTRAPPERS"`
-	P2PV2DeltaDial         models.Duration `env:"P2PV2_DELTA_DIAL" default:"15s"`     //nodoc
-	P2PV2DeltaReconcile    models.Duration `env:"P2PV2_DELTA_RECONCILE" default:"1m"` //nodoc
-	P2PV2ListenAddresses   []string        `env:"P2PV2_LISTEN_ADDRESSES"`
+	P2PV2AnnounceAddresses               []string        `env:"P2PV2_ANNOUNCE_ADDRESSES"`
+	P2PV2Bootstrappers                   []string        `env:"P2PV2_BOOTSTRAPPERS"`
+	P2PV2BootstrappersDNS                string          `env:"P2PV2_BOOTSTRAPPERS_DNS"`
+	P2PV2BootstrappersDNSRefreshInterval models.Duration `env:"P2PV2_BOOTSTRAPPERS_DNS_REFRESH_INTERVAL" default:"5m"`
+	P2PV2CheckBootstrappers              bool            `env:"P2PV2_CHECK_BOOTSTRAPPERS" default:"false"`
+	P2PV2DeltaDial                       models.Duration `env:"P2PV2_DELTA_DIAL" default:"15s"`     //nodoc
+	P2PV2DeltaReconcile                  models.Duration `env:"P2PV2_DELTA_RECONCILE" default:"1m"` //nodoc
+	P2PV2ListenAddresses                 []string        `env:"P2PV2_LISTEN_ADDRESSES"`
 	// DEPRECATED
 	OCROutgoingMessageBufferSize int           `env:"OCR_OUTGOING_MESSAGE_BUFFER_SIZE" default:"10"` //nodoc
 	OCRIncomingMessageBufferSize int           `env:"OCR_INCOMING_MESSAGE_BUFFER_SIZE" default:"10"` //nodoc
@@ -305,6 +335,15 @@ type ConfigSchema struct {
 	PyroscopeAuthToken     string `env:"PYROSCOPE_AUTH_TOKEN"`                    //nodoc
 	PyroscopeServerAddress string `env:"PYROSCOPE_SERVER_ADDRESS"`                //nodoc
 	PyroscopeEnvironment   string `env:"PYROSCOPE_ENVIRONMENT" default:"mainnet"` //nodoc
+
+	// Alerts
+	AlertsCheckInterval       time.Duration `env:"ALERTS_CHECK_INTERVAL" default:"1m"`
+	AlertsJobErrorsThreshold  uint32        `env:"ALERTS_JOB_ERRORS_THRESHOLD" default:"0"`
+	AlertsJobErrorsWindow     time.Duration `env:"ALERTS_JOB_ERRORS_WINDOW" default:"10m"`
+	AlertsTxStuckThreshold    time.Duration `env:"ALERTS_TX_STUCK_THRESHOLD" default:"0"`
+	AlertsKeyBalanceThreshold *assets.Eth   `env:"ALERTS_KEY_BALANCE_THRESHOLD"`
+	AlertsSlackWebhookURL     *url.URL      `env:"ALERTS_SLACK_WEBHOOK_URL"`
+	AlertsPagerDutyRoutingKey string        `env:"ALERTS_PAGERDUTY_ROUTING_KEY"`
 }
 
 // Name gets the environment variable Name for a config schema field
@@ -327,6 +366,18 @@ func TryName(field string) string {
 	return item.Tag.Get("env")
 }
 
+// Names returns the names of all the environment variables of the schema
+func Names() []string {
+	schemaT := reflect.TypeOf(ConfigSchema{})
+	names := make([]string, 0, schemaT.NumField())
+	for i := 0; i < schemaT.NumField(); i++ {
+		if name := schemaT.Field(i).Tag.Get("env"); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
 // DefaultValue looks up the default value
 func DefaultValue(name string) (string, bool) {
 	schemaT := reflect.TypeOf(ConfigSchema{})
@@ -13,7 +13,9 @@ func TestConfigSchema(t *testing.T) {
 	items := map[string]string{
 		"AdvisoryLockCheckInterval":                      "ADVISORY_LOCK_CHECK_INTERVAL",
 		"AdvisoryLockID":                                 "ADVISORY_LOCK_ID",
+		"AllowHeaders":                                   "ALLOW_HEADERS",
 		"AllowOrigins":                                   "ALLOW_ORIGINS",
+		"AuditLogForwardURL":                             "AUDIT_LOG_FORWARD_URL",
 		"AuthenticatedRateLimit":                         "AUTHENTICATED_RATE_LIMIT",
 		"AuthenticatedRateLimitPeriod":                   "AUTHENTICATED_RATE_LIMIT_PERIOD",
 		"AutoPprofBlockProfileRate":                      "AUTO_PPROF_BLOCK_PROFILE_RATE",
@@ -37,8 +39,12 @@ func TestConfigSchema(t *testing.T) {
 		"BlockHistoryEstimatorBlockHistorySize":          "BLOCK_HISTORY_ESTIMATOR_BLOCK_HISTORY_SIZE",
 		"BlockHistoryEstimatorEIP1559FeeCapBufferBlocks": "BLOCK_HISTORY_ESTIMATOR_EIP1559_FEE_CAP_BUFFER_BLOCKS",
 		"BlockHistoryEstimatorTransactionPercentile":     "BLOCK_HISTORY_ESTIMATOR_TRANSACTION_PERCENTILE",
+		"BridgeHealthCheckInterval":                      "BRIDGE_HEALTH_CHECK_INTERVAL",
+		"BridgeHealthCheckPath":                          "BRIDGE_HEALTH_CHECK_PATH",
+		"BridgeHealthWindow":                             "BRIDGE_HEALTH_WINDOW",
 		"BridgeResponseURL":                              "BRIDGE_RESPONSE_URL",
 		"ChainType":                                      "CHAIN_TYPE",
+		"DatabaseAllowSchemaDrift":                       "DATABASE_ALLOW_SCHEMA_DRIFT",
 		"DatabaseBackupDir":                              "DATABASE_BACKUP_DIR",
 		"DatabaseBackupFrequency":                        "DATABASE_BACKUP_FREQUENCY",
 		"DatabaseBackupMode":                             "DATABASE_BACKUP_MODE",
@@ -54,6 +60,7 @@ func TestConfigSchema(t *testing.T) {
 		"Dev":                                            "CHAINLINK_DEV",
 		"EVMEnabled":                                     "EVM_ENABLED",
 		"EVMRPCEnabled":                                  "EVM_RPC_ENABLED",
+		"EnforceWebAuthn":                                "ENFORCE_WEBAUTHN",
 		"EthTxReaperInterval":                            "ETH_TX_REAPER_INTERVAL",
 		"EthTxReaperThreshold":                           "ETH_TX_REAPER_THRESHOLD",
 		"EthTxResendAfterThreshold":                      "ETH_TX_RESEND_AFTER_THRESHOLD",
@@ -65,6 +72,7 @@ func TestConfigSchema(t *testing.T) {
 		"EvmBalanceMonitorBlockDelay":                    "ETH_BALANCE_MONITOR_BLOCK_DELAY",
 		"EvmEIP1559DynamicFees":                          "EVM_EIP1559_DYNAMIC_FEES",
 		"EvmFinalityDepth":                               "ETH_FINALITY_DEPTH",
+		"EvmFinalityTag":                                 "ETH_FINALITY_TAG",
 		"EvmGasBumpPercent":                              "ETH_GAS_BUMP_PERCENT",
 		"EvmGasBumpThreshold":                            "ETH_GAS_BUMP_THRESHOLD",
 		"EvmGasBumpTxDepth":                              "ETH_GAS_BUMP_TX_DEPTH",
@@ -90,6 +98,7 @@ func TestConfigSchema(t *testing.T) {
 		"EvmMaxGasPriceWei":                              "ETH_MAX_GAS_PRICE_WEI",
 		"EvmMaxInFlightTransactions":                     "ETH_MAX_IN_FLIGHT_TRANSACTIONS",
 		"EvmMaxQueuedTransactions":                       "ETH_MAX_QUEUED_TRANSACTIONS",
+		"EvmMaxQueuedTransactionsPolicy":                 "ETH_MAX_QUEUED_TRANSACTIONS_POLICY",
 		"EvmMinGasPriceWei":                              "ETH_MIN_GAS_PRICE_WEI",
 		"EvmNonceAutoSync":                               "ETH_NONCE_AUTO_SYNC",
 		"EvmUseForwarders":                               "ETH_USE_FORWARDERS",
@@ -114,10 +123,20 @@ func TestConfigSchema(t *testing.T) {
 		"HTTPServerWriteTimeout":                         "HTTP_SERVER_WRITE_TIMEOUT",
 		"InsecureFastScrypt":                             "INSECURE_FAST_SCRYPT",
 		"JSONConsole":                                    "JSON_CONSOLE",
+		"JobPipelineIPFSGatewayURL":                      "JOB_PIPELINE_IPFS_GATEWAY_URL",
+		"JobPipelineIPFSNodeURL":                         "JOB_PIPELINE_IPFS_NODE_URL",
 		"JobPipelineMaxRunDuration":                      "JOB_PIPELINE_MAX_RUN_DURATION",
+		"JobPipelineMetricsCustomLabels":                 "JOB_PIPELINE_METRICS_CUSTOM_LABELS",
+		"JobPipelineMetricsJobIDLabel":                   "JOB_PIPELINE_METRICS_JOB_ID_LABEL",
+		"JobPipelinePublishURL":                          "JOB_PIPELINE_PUBLISH_URL",
+		"JobPipelineQueueTriggerTopics":                  "JOB_PIPELINE_QUEUE_TRIGGER_TOPICS",
+		"JobPipelineQueueTriggerURL":                     "JOB_PIPELINE_QUEUE_TRIGGER_URL",
+		"JobPipelineReaperExportEndpoint":                "JOB_PIPELINE_REAPER_EXPORT_ENDPOINT",
+		"JobPipelineReaperExportURL":                     "JOB_PIPELINE_REAPER_EXPORT_URL",
 		"JobPipelineReaperInterval":                      "JOB_PIPELINE_REAPER_INTERVAL",
 		"JobPipelineReaperThreshold":                     "JOB_PIPELINE_REAPER_THRESHOLD",
 		"JobPipelineResultWriteQueueDepth":               "JOB_PIPELINE_RESULT_WRITE_QUEUE_DEPTH",
+		"JobPipelineSpecVariables":                       "JOB_PIPELINE_SPEC_VARIABLES",
 		"KeeperCheckUpkeepGasPriceFeatureEnabled":        "KEEPER_CHECK_UPKEEP_GAS_PRICE_FEATURE_ENABLED",
 		"KeeperDefaultTransactionQueueDepth":             "KEEPER_DEFAULT_TRANSACTION_QUEUE_DEPTH",
 		"KeeperGasPriceBufferPercent":                    "KEEPER_GAS_PRICE_BUFFER_PERCENT",
@@ -134,6 +153,7 @@ func TestConfigSchema(t *testing.T) {
 		"LeaseLockDuration":                              "LEASE_LOCK_DURATION",
 		"LeaseLockRefreshInterval":                       "LEASE_LOCK_REFRESH_INTERVAL",
 		"LinkContractAddress":                            "LINK_CONTRACT_ADDRESS",
+		"LogBroadcasterPolling":                          "LOG_BROADCASTER_POLLING",
 		"OperatorFactoryAddress":                         "OPERATOR_FACTORY_ADDRESS",
 		"LogFileDir":                                     "LOG_FILE_DIR",
 		"LogLevel":                                       "LOG_LEVEL",
@@ -147,10 +167,12 @@ func TestConfigSchema(t *testing.T) {
 		"MinIncomingConfirmations":                       "MIN_INCOMING_CONFIRMATIONS",
 		"MinimumContractPayment":                         "MINIMUM_CONTRACT_PAYMENT_LINK_JUELS",
 		"MinimumServiceDuration":                         "MINIMUM_SERVICE_DURATION",
+		"NodeCacheReads":                                 "NODE_CACHE_READS",
 		"NodeNoNewHeadsThreshold":                        "NODE_NO_NEW_HEADS_THRESHOLD",
 		"NodePollFailureThreshold":                       "NODE_POLL_FAILURE_THRESHOLD",
 		"NodePollInterval":                               "NODE_POLL_INTERVAL",
 		"NodeSelectionMode":                              "NODE_SELECTION_MODE",
+		"NodeSendStrategy":                               "NODE_SEND_STRATEGY",
 		"ORMMaxIdleConns":                                "ORM_MAX_IDLE_CONNS",
 		"ORMMaxOpenConns":                                "ORM_MAX_OPEN_CONNS",
 		"OptimismGasFees":                                "OPTIMISM_GAS_FEES",
@@ -158,6 +180,7 @@ func TestConfigSchema(t *testing.T) {
 		"RPCEnabled":                                     "RPC_ENABLED",
 		"RPID":                                           "MFA_RPID",
 		"RPOrigin":                                       "MFA_RPORIGIN",
+		"ReadinessStrict":                                "READINESS_STRICT",
 		"ReaperExpiration":                               "REAPER_EXPIRATION",
 		"RootDir":                                        "ROOT",
 		"SecureCookies":                                  "SECURE_COOKIES",
@@ -173,6 +196,10 @@ func TestConfigSchema(t *testing.T) {
 		"TLSKeyPath":                                     "TLS_KEY_PATH",
 		"TLSPort":                                        "CHAINLINK_TLS_PORT",
 		"TLSRedirect":                                    "CHAINLINK_TLS_REDIRECT",
+		"TelemetryExporterCACertPath":                    "TELEMETRY_EXPORTER_CA_CERT_PATH",
+		"TelemetryExporterCertPath":                      "TELEMETRY_EXPORTER_CERT_PATH",
+		"TelemetryExporterKeyPath":                       "TELEMETRY_EXPORTER_KEY_PATH",
+		"TelemetryExporterURL":                           "TELEMETRY_EXPORTER_URL",
 		"TelemetryIngressBufferSize":                     "TELEMETRY_INGRESS_BUFFER_SIZE",
 		"TelemetryIngressLogging":                        "TELEMETRY_INGRESS_LOGGING",
 		"TelemetryIngressUniConn":                        "TELEMETRY_INGRESS_UNICONN",
@@ -231,18 +258,30 @@ func TestConfigSchema(t *testing.T) {
 		"P2PPeerstoreWriteInterval":           "P2P_PEERSTORE_WRITE_INTERVAL",
 
 		// P2P v2 networking
-		"P2PV2AccountAddresses":  "P2PV2_ANNOUNCE_ADDRESSES",
-		"P2PV2AnnounceAddresses": "P2PV2_ANNOUNCE_ADDRESSES",
-		"P2PV2Bootstrappers":     "P2PV2_BOOTSTRAPPERS",
-		"P2PV2DeltaDial":         "P2PV2_DELTA_DIAL",
-		"P2PV2DeltaReconcile":    "P2PV2_DELTA_RECONCILE",
-		"P2PV2ListenAddresses":   "P2PV2_LISTEN_ADDRESSES",
+		"P2PV2AccountAddresses":                "P2PV2_ANNOUNCE_ADDRESSES",
+		"P2PV2AnnounceAddresses":               "P2PV2_ANNOUNCE_ADDRESSES",
+		"P2PV2Bootstrappers":                   "P2PV2_BOOTSTRAPPERS",
+		"P2PV2BootstrappersDNS":                "P2PV2_BOOTSTRAPPERS_DNS",
+		"P2PV2BootstrappersDNSRefreshInterval": "P2PV2_BOOTSTRAPPERS_DNS_REFRESH_INTERVAL",
+		"P2PV2CheckBootstrappers":              "P2PV2_CHECK_BOOTSTRAPPERS",
+		"P2PV2DeltaDial":                       "P2PV2_DELTA_DIAL",
+		"P2PV2DeltaReconcile":                  "P2PV2_DELTA_RECONCILE",
+		"P2PV2ListenAddresses":                 "P2PV2_LISTEN_ADDRESSES",
 
 		// Pyroscope profiling
 		"PyroscopeAuthToken":     "PYROSCOPE_AUTH_TOKEN",
 		"PyroscopeServerAddress": "PYROSCOPE_SERVER_ADDRESS",
 		"PyroscopeEnvironment":   "PYROSCOPE_ENVIRONMENT",
 
+		// Alerts
+		"AlertsCheckInterval":       "ALERTS_CHECK_INTERVAL",
+		"AlertsJobErrorsThreshold":  "ALERTS_JOB_ERRORS_THRESHOLD",
+		"AlertsJobErrorsWindow":     "ALERTS_JOB_ERRORS_WINDOW",
+		"AlertsKeyBalanceThreshold": "ALERTS_KEY_BALANCE_THRESHOLD",
+		"AlertsPagerDutyRoutingKey": "ALERTS_PAGERDUTY_ROUTING_KEY",
+		"AlertsSlackWebhookURL":     "ALERTS_SLACK_WEBHOOK_URL",
+		"AlertsTxStuckThreshold":    "ALERTS_TX_STUCK_THRESHOLD",
+
 		// P2P deprecated
 		"OCRNewStreamTimeout":          "OCR_NEW_STREAM_TIMEOUT",
 		"OCRBootstrapCheckInterval":    "OCR_BOOTSTRAP_CHECK_INTERVAL",
@@ -71,6 +71,122 @@ func (_m *GeneralConfig) AdvisoryLockID() int64 {
 	return r0
 }
 
+// AlertsCheckInterval provides a mock function with given fields:
+func (_m *GeneralConfig) AlertsCheckInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// AlertsJobErrorsThreshold provides a mock function with given fields:
+func (_m *GeneralConfig) AlertsJobErrorsThreshold() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// AlertsJobErrorsWindow provides a mock function with given fields:
+func (_m *GeneralConfig) AlertsJobErrorsWindow() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// AlertsKeyBalanceThreshold provides a mock function with given fields:
+func (_m *GeneralConfig) AlertsKeyBalanceThreshold() *assets.Eth {
+	ret := _m.Called()
+
+	var r0 *assets.Eth
+	if rf, ok := ret.Get(0).(func() *assets.Eth); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Eth)
+		}
+	}
+
+	return r0
+}
+
+// AlertsPagerDutyRoutingKey provides a mock function with given fields:
+func (_m *GeneralConfig) AlertsPagerDutyRoutingKey() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// AlertsSlackWebhookURL provides a mock function with given fields:
+func (_m *GeneralConfig) AlertsSlackWebhookURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// AlertsTxStuckThreshold provides a mock function with given fields:
+func (_m *GeneralConfig) AlertsTxStuckThreshold() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// AllowHeaders provides a mock function with given fields:
+func (_m *GeneralConfig) AllowHeaders() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // AllowOrigins provides a mock function with given fields:
 func (_m *GeneralConfig) AllowOrigins() string {
 	ret := _m.Called()
@@ -101,6 +217,22 @@ func (_m *GeneralConfig) AppID() uuid.UUID {
 	return r0
 }
 
+// AuditLogForwardURL provides a mock function with given fields:
+func (_m *GeneralConfig) AuditLogForwardURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
 // AuthenticatedRateLimit provides a mock function with given fields:
 func (_m *GeneralConfig) AuthenticatedRateLimit() int64 {
 	ret := _m.Called()
@@ -325,6 +457,48 @@ func (_m *GeneralConfig) BlockBackfillSkip() bool {
 	return r0
 }
 
+// BridgeHealthCheckInterval provides a mock function with given fields:
+func (_m *GeneralConfig) BridgeHealthCheckInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// BridgeHealthCheckPath provides a mock function with given fields:
+func (_m *GeneralConfig) BridgeHealthCheckPath() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// BridgeHealthWindow provides a mock function with given fields:
+func (_m *GeneralConfig) BridgeHealthWindow() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // BridgeResponseURL provides a mock function with given fields:
 func (_m *GeneralConfig) BridgeResponseURL() *url.URL {
 	ret := _m.Called()
@@ -355,6 +529,20 @@ func (_m *GeneralConfig) CertFile() string {
 	return r0
 }
 
+// DatabaseAllowSchemaDrift provides a mock function with given fields:
+func (_m *GeneralConfig) DatabaseAllowSchemaDrift() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // DatabaseBackupDir provides a mock function with given fields:
 func (_m *GeneralConfig) DatabaseBackupDir() string {
 	ret := _m.Called()
@@ -583,6 +771,20 @@ func (_m *GeneralConfig) EVMRPCEnabled() bool {
 	return r0
 }
 
+// EnforceWebAuthn provides a mock function with given fields:
+func (_m *GeneralConfig) EnforceWebAuthn() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // EthereumHTTPURL provides a mock function with given fields:
 func (_m *GeneralConfig) EthereumHTTPURL() *url.URL {
 	ret := _m.Called()
@@ -643,6 +845,34 @@ func (_m *GeneralConfig) EthereumURL() string {
 	return r0
 }
 
+// EvmFinalityTag provides a mock function with given fields:
+func (_m *GeneralConfig) EvmFinalityTag() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// EvmMaxQueuedTransactionsPolicy provides a mock function with given fields:
+func (_m *GeneralConfig) EvmMaxQueuedTransactionsPolicy() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // ExplorerAccessKey provides a mock function with given fields:
 func (_m *GeneralConfig) ExplorerAccessKey() string {
 	ret := _m.Called()
@@ -2061,6 +2291,38 @@ func (_m *GeneralConfig) JSONConsole() bool {
 	return r0
 }
 
+// JobPipelineIPFSGatewayURL provides a mock function with given fields:
+func (_m *GeneralConfig) JobPipelineIPFSGatewayURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// JobPipelineIPFSNodeURL provides a mock function with given fields:
+func (_m *GeneralConfig) JobPipelineIPFSNodeURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
 // JobPipelineMaxRunDuration provides a mock function with given fields:
 func (_m *GeneralConfig) JobPipelineMaxRunDuration() time.Duration {
 	ret := _m.Called()
@@ -2075,6 +2337,116 @@ func (_m *GeneralConfig) JobPipelineMaxRunDuration() time.Duration {
 	return r0
 }
 
+// JobPipelineMetricsCustomLabels provides a mock function with given fields:
+func (_m *GeneralConfig) JobPipelineMetricsCustomLabels() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// JobPipelineMetricsJobIDLabel provides a mock function with given fields:
+func (_m *GeneralConfig) JobPipelineMetricsJobIDLabel() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// JobPipelinePublishURL provides a mock function with given fields:
+func (_m *GeneralConfig) JobPipelinePublishURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// JobPipelineQueueTriggerTopics provides a mock function with given fields:
+func (_m *GeneralConfig) JobPipelineQueueTriggerTopics() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// JobPipelineQueueTriggerURL provides a mock function with given fields:
+func (_m *GeneralConfig) JobPipelineQueueTriggerURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// JobPipelineReaperExportEndpoint provides a mock function with given fields:
+func (_m *GeneralConfig) JobPipelineReaperExportEndpoint() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// JobPipelineReaperExportURL provides a mock function with given fields:
+func (_m *GeneralConfig) JobPipelineReaperExportURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
 // JobPipelineReaperInterval provides a mock function with given fields:
 func (_m *GeneralConfig) JobPipelineReaperInterval() time.Duration {
 	ret := _m.Called()
@@ -2117,6 +2489,22 @@ func (_m *GeneralConfig) JobPipelineResultWriteQueueDepth() uint64 {
 	return r0
 }
 
+// JobPipelineSpecVariables provides a mock function with given fields:
+func (_m *GeneralConfig) JobPipelineSpecVariables() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
 // KeeperBaseFeeBufferPercent provides a mock function with given fields:
 func (_m *GeneralConfig) KeeperBaseFeeBufferPercent() uint32 {
 	ret := _m.Called()
@@ -2355,6 +2743,20 @@ func (_m *GeneralConfig) LeaseLockRefreshInterval() time.Duration {
 	return r0
 }
 
+// LogBroadcasterPolling provides a mock function with given fields:
+func (_m *GeneralConfig) LogBroadcasterPolling() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // LogConfiguration provides a mock function with given fields: log
 func (_m *GeneralConfig) LogConfiguration(log config.LogFn) {
 	_m.Called(log)
@@ -2472,6 +2874,34 @@ func (_m *GeneralConfig) MigrateDatabase() bool {
 	return r0
 }
 
+// NodeCacheReads provides a mock function with given fields:
+func (_m *GeneralConfig) NodeCacheReads() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// NodeSendStrategy provides a mock function with given fields:
+func (_m *GeneralConfig) NodeSendStrategy() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // OCR2BlockchainTimeout provides a mock function with given fields:
 func (_m *GeneralConfig) OCR2BlockchainTimeout() time.Duration {
 	ret := _m.Called()
@@ -3126,6 +3556,34 @@ func (_m *GeneralConfig) P2PV2Bootstrappers() []commontypes.BootstrapperLocator
 	return r0
 }
 
+// P2PV2BootstrappersDNS provides a mock function with given fields:
+func (_m *GeneralConfig) P2PV2BootstrappersDNS() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// P2PV2BootstrappersDNSRefreshInterval provides a mock function with given fields:
+func (_m *GeneralConfig) P2PV2BootstrappersDNSRefreshInterval() models.Duration {
+	ret := _m.Called()
+
+	var r0 models.Duration
+	if rf, ok := ret.Get(0).(func() models.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(models.Duration)
+	}
+
+	return r0
+}
+
 // P2PV2BootstrappersRaw provides a mock function with given fields:
 func (_m *GeneralConfig) P2PV2BootstrappersRaw() []string {
 	ret := _m.Called()
@@ -3142,6 +3600,20 @@ func (_m *GeneralConfig) P2PV2BootstrappersRaw() []string {
 	return r0
 }
 
+// P2PV2CheckBootstrappers provides a mock function with given fields:
+func (_m *GeneralConfig) P2PV2CheckBootstrappers() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // P2PV2DeltaDial provides a mock function with given fields:
 func (_m *GeneralConfig) P2PV2DeltaDial() models.Duration {
 	ret := _m.Called()
@@ -3270,6 +3742,20 @@ func (_m *GeneralConfig) RPOrigin() string {
 	return r0
 }
 
+// ReadinessStrict provides a mock function with given fields:
+func (_m *GeneralConfig) ReadinessStrict() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // ReaperExpiration provides a mock function with given fields:
 func (_m *GeneralConfig) ReaperExpiration() models.Duration {
 	ret := _m.Called()
@@ -3340,6 +3826,16 @@ func (_m *GeneralConfig) SessionTimeout() models.Duration {
 	return r0
 }
 
+// SetDefaultHTTPTimeout provides a mock function with given fields: timeout
+func (_m *GeneralConfig) SetDefaultHTTPTimeout(timeout models.Duration) {
+	_m.Called(timeout)
+}
+
+// SetJobPipelineReaperThreshold provides a mock function with given fields: threshold
+func (_m *GeneralConfig) SetJobPipelineReaperThreshold(threshold time.Duration) {
+	_m.Called(threshold)
+}
+
 // SetLogLevel provides a mock function with given fields: lvl
 func (_m *GeneralConfig) SetLogLevel(lvl zapcore.Level) error {
 	ret := _m.Called(lvl)
@@ -3513,6 +4009,64 @@ func (_m *GeneralConfig) TLSRedirect() bool {
 	return r0
 }
 
+// TelemetryExporterCACertPath provides a mock function with given fields:
+func (_m *GeneralConfig) TelemetryExporterCACertPath() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// TelemetryExporterCertPath provides a mock function with given fields:
+func (_m *GeneralConfig) TelemetryExporterCertPath() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// TelemetryExporterKeyPath provides a mock function with given fields:
+func (_m *GeneralConfig) TelemetryExporterKeyPath() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// TelemetryExporterURL provides a mock function with given fields:
+func (_m *GeneralConfig) TelemetryExporterURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
 // TelemetryIngressBufferSize provides a mock function with given fields:
 func (_m *GeneralConfig) TelemetryIngressBufferSize() uint {
 	ret := _m.Called()
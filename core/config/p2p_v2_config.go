@@ -15,6 +15,9 @@ type P2PV2Networking interface {
 	P2PV2AnnounceAddresses() []string
 	P2PV2Bootstrappers() (locators []ocrcommontypes.BootstrapperLocator)
 	P2PV2BootstrappersRaw() []string
+	P2PV2BootstrappersDNS() string
+	P2PV2BootstrappersDNSRefreshInterval() models.Duration
+	P2PV2CheckBootstrappers() bool
 	P2PV2DeltaDial() models.Duration
 	P2PV2DeltaReconcile() models.Duration
 	P2PV2ListenAddresses() []string
@@ -60,6 +63,24 @@ func (c *generalConfig) P2PV2BootstrappersRaw() []string {
 	return c.viper.GetStringSlice(envvar.Name("P2PV2Bootstrappers"))
 }
 
+// P2PV2BootstrappersDNS is a DNS name resolving to the default bootstrapper
+// peers, overriding P2PV2Bootstrappers once resolved
+func (c *generalConfig) P2PV2BootstrappersDNS() string {
+	return c.viper.GetString(envvar.Name("P2PV2BootstrappersDNS"))
+}
+
+// P2PV2BootstrappersDNSRefreshInterval controls how often P2PV2BootstrappersDNS
+// is resolved again
+func (c *generalConfig) P2PV2BootstrappersDNSRefreshInterval() models.Duration {
+	return models.MustMakeDuration(c.getWithFallback("P2PV2BootstrappersDNSRefreshInterval", parse.Duration).(time.Duration))
+}
+
+// P2PV2CheckBootstrappers enables checking that the v2 bootstrap peers of the
+// OCR and OCR2 jobs are reachable when they are created
+func (c *generalConfig) P2PV2CheckBootstrappers() bool {
+	return c.getWithFallback("P2PV2CheckBootstrappers", parse.Bool).(bool)
+}
+
 // P2PV2DeltaDial controls how far apart Dial attempts are
 func (c *generalConfig) P2PV2DeltaDial() models.Duration {
 	return models.MustMakeDuration(c.getWithFallback("P2PV2DeltaDial", parse.Duration).(time.Duration))
@@ -28,6 +28,14 @@ func Link(str string) (*assets.Link, error) {
 	return i, nil
 }
 
+func Eth(str string) (*assets.Eth, error) {
+	i, ok := new(assets.Eth).SetString(str, 10)
+	if !ok {
+		return i, fmt.Errorf("unable to parse '%v' into *assets.Eth(base 10)", str)
+	}
+	return i, nil
+}
+
 func LogLevel(str string) (zapcore.Level, error) {
 	var lvl zapcore.Level
 	err := lvl.Set(str)
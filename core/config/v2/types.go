@@ -9,6 +9,7 @@ import (
 	ocrnetworking "github.com/smartcontractkit/libocr/networking"
 	"go.uber.org/multierr"
 
+	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
@@ -30,6 +31,10 @@ type Core struct {
 
 	TelemetryIngress *TelemetryIngress
 
+	TelemetryExporter *TelemetryExporter
+
+	Alerts *Alerts
+
 	Log *Log
 
 	WebServer *WebServer
@@ -110,6 +115,7 @@ type Feature struct {
 }
 
 type Database struct {
+	AllowSchemaDrift              *bool
 	DefaultIdleInTxSessionTimeout *models.Duration
 	DefaultLockTimeout            *models.Duration
 	DefaultQueryTimeout           *models.Duration
@@ -150,6 +156,23 @@ type DatabaseBackup struct {
 	// DatabaseBackupURL stored in Secrets
 }
 
+type TelemetryExporter struct {
+	URL        *models.URL
+	CACertPath *string
+	CertPath   *string
+	KeyPath    *string
+}
+
+type Alerts struct {
+	CheckInterval       *models.Duration
+	JobErrorsThreshold  *uint32
+	JobErrorsWindow     *models.Duration
+	TxStuckThreshold    *models.Duration
+	KeyBalanceThreshold *assets.Eth
+	SlackWebhookURL     *models.URL
+	PagerDutyRoutingKey *string
+}
+
 type TelemetryIngress struct {
 	UniConn      *bool
 	Logging      *bool
@@ -170,13 +193,16 @@ type Log struct {
 	FileMaxBackups  *int64
 	JSONConsole     *bool
 	UnixTS          *bool
+	AuditForwardURL *models.URL
 }
 
 type WebServer struct {
 	AllowOrigins            *string
+	AllowHeaders            *string
 	BridgeResponseURL       *models.URL
 	HTTPWriteTimeout        *models.Duration
 	HTTPPort                *uint16
+	ReadinessStrict         *bool
 	SecureCookies           *bool
 	SessionTimeout          *models.Duration
 	SessionReaperExpiration *models.Duration
@@ -191,6 +217,7 @@ type WebServer struct {
 type WebServerMFA struct {
 	RPID     *string
 	RPOrigin *string
+	Enforce  *bool
 }
 
 type WebServerRateLimit struct {
@@ -209,13 +236,26 @@ type WebServerTLS struct {
 }
 
 type JobPipeline struct {
+	BridgeHealthCheckInterval *models.Duration
+	BridgeHealthCheckPath     *string
+	BridgeHealthWindow        *models.Duration
 	DefaultHTTPRequestTimeout *models.Duration
 	ExternalInitiatorsEnabled *bool
 	HTTPRequestMaxSize        *utils.FileSize
+	IPFSGatewayURL            *models.URL
+	IPFSNodeURL               *models.URL
 	MaxRunDuration            *models.Duration
+	PublishURL                *models.URL
+	QueueTriggerTopics        *[]string
+	QueueTriggerURL           *models.URL
+	ReaperExportEndpoint      *models.URL
+	ReaperExportURL           *models.URL
 	ReaperInterval            *models.Duration
 	ReaperThreshold           *models.Duration
 	ResultWriteQueueDepth     *uint32
+	SpecVariables             *[]string
+	MetricsJobIDLabel         *string
+	MetricsCustomLabels       *[]string
 }
 
 type FluxMonitor struct {
@@ -292,11 +332,14 @@ func (p *P2PV1) ValidateConfig() (err error) {
 }
 
 type P2PV2 struct {
-	AnnounceAddresses    *[]string
-	DefaultBootstrappers *[]ocrcommontypes.BootstrapperLocator
-	DeltaDial            *models.Duration
-	DeltaReconcile       *models.Duration
-	ListenAddresses      *[]string
+	AnnounceAddresses               *[]string
+	BootstrappersDNS                *string
+	BootstrappersDNSRefreshInterval *models.Duration
+	CheckBootstrappers              *bool
+	DefaultBootstrappers            *[]ocrcommontypes.BootstrapperLocator
+	DeltaDial                       *models.Duration
+	DeltaReconcile                  *models.Duration
+	ListenAddresses                 *[]string
 }
 
 type Keeper struct {
@@ -136,3 +136,21 @@ type ErrEmpty struct {
 func (e ErrEmpty) Error() string {
 	return fmt.Sprintf("%s: empty: %s", e.Name, e.Msg)
 }
+
+type ErrUnknown struct {
+	Name string
+	Msg  string
+}
+
+func (e ErrUnknown) Error() string {
+	return fmt.Sprintf("%s: unknown: %s", e.Name, e.Msg)
+}
+
+type ErrLegacyEnv struct {
+	Name string
+	Msg  string
+}
+
+func (e ErrLegacyEnv) Error() string {
+	return fmt.Sprintf("%s: legacy env var set: %s", e.Name, e.Msg)
+}
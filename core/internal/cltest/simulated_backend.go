@@ -57,7 +57,9 @@ func NewApplicationWithConfigAndKeyOnSimulatedBlockchain(
 		Enabled: true,
 	}
 
-	flagsAndDeps = append(flagsAndDeps, client, eventBroadcaster, simulatedBackendChain)
+	// The defaults come first, so that a client in flagsAndDeps wrapping the
+	// simulated backend replaces them
+	flagsAndDeps = append([]interface{}{client, eventBroadcaster, simulatedBackendChain}, flagsAndDeps...)
 
 	//  app.Stop() will call client.Close on the simulated backend
 	return NewApplicationWithConfigAndKey(t, cfg, flagsAndDeps...)
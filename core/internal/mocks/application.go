@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	audit "github.com/smartcontractkit/chainlink/core/services/audit"
+
 	big "math/big"
 
 	bridges "github.com/smartcontractkit/chainlink/core/bridges"
@@ -12,20 +14,28 @@ import (
 
 	context "context"
 
+	externaldb "github.com/smartcontractkit/chainlink/core/services/externaldb"
+
 	feeds "github.com/smartcontractkit/chainlink/core/services/feeds"
 
 	job "github.com/smartcontractkit/chainlink/core/services/job"
 
+	keyrotation "github.com/smartcontractkit/chainlink/core/services/keyrotation"
+
 	keystore "github.com/smartcontractkit/chainlink/core/services/keystore"
 
 	logger "github.com/smartcontractkit/chainlink/core/logger"
 
 	mock "github.com/stretchr/testify/mock"
 
+	ocrcommon "github.com/smartcontractkit/chainlink/core/services/ocrcommon"
+
 	pg "github.com/smartcontractkit/chainlink/core/services/pg"
 
 	pipeline "github.com/smartcontractkit/chainlink/core/services/pipeline"
 
+	runoutputs "github.com/smartcontractkit/chainlink/core/services/runoutputs"
+
 	services "github.com/smartcontractkit/chainlink/core/services"
 
 	sessions "github.com/smartcontractkit/chainlink/core/sessions"
@@ -36,10 +46,14 @@ import (
 
 	types "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 
+	updates "github.com/smartcontractkit/chainlink/core/services/updates"
+
 	uuid "github.com/satori/go.uuid"
 
 	webhook "github.com/smartcontractkit/chainlink/core/services/webhook"
 
+	withdrawals "github.com/smartcontractkit/chainlink/core/services/withdrawals"
+
 	zapcore "go.uber.org/zap/zapcore"
 )
 
@@ -62,6 +76,36 @@ func (_m *Application) AddJobV2(ctx context.Context, _a1 *job.Job) error {
 	return r0
 }
 
+// ArchiveJob provides a mock function with given fields: ctx, jobID
+func (_m *Application) ArchiveJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuditLogger provides a mock function with given fields:
+func (_m *Application) AuditLogger() audit.Logger {
+	ret := _m.Called()
+
+	var r0 audit.Logger
+	if rf, ok := ret.Get(0).(func() audit.Logger); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(audit.Logger)
+		}
+	}
+
+	return r0
+}
+
 // BridgeORM provides a mock function with given fields:
 func (_m *Application) BridgeORM() bridges.ORM {
 	ret := _m.Called()
@@ -99,6 +143,20 @@ func (_m *Application) ConfigDump(_a0 context.Context) (string, error) {
 	return r0, r1
 }
 
+// CreateMaintenanceWindow provides a mock function with given fields: ctx, w
+func (_m *Application) CreateMaintenanceWindow(ctx context.Context, w *pipeline.MaintenanceWindow) error {
+	ret := _m.Called(ctx, w)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pipeline.MaintenanceWindow) error); ok {
+		r0 = rf(ctx, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteJob provides a mock function with given fields: ctx, jobID
 func (_m *Application) DeleteJob(ctx context.Context, jobID int32) error {
 	ret := _m.Called(ctx, jobID)
@@ -113,6 +171,36 @@ func (_m *Application) DeleteJob(ctx context.Context, jobID int32) error {
 	return r0
 }
 
+// DeleteMaintenanceWindow provides a mock function with given fields: ctx, id
+func (_m *Application) DeleteMaintenanceWindow(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ETHKeyRotator provides a mock function with given fields:
+func (_m *Application) ETHKeyRotator() keyrotation.ETHRotator {
+	ret := _m.Called()
+
+	var r0 keyrotation.ETHRotator
+	if rf, ok := ret.Get(0).(func() keyrotation.ETHRotator); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(keyrotation.ETHRotator)
+		}
+	}
+
+	return r0
+}
+
 // EVMORM provides a mock function with given fields:
 func (_m *Application) EVMORM() types.ORM {
 	ret := _m.Called()
@@ -129,6 +217,52 @@ func (_m *Application) EVMORM() types.ORM {
 	return r0
 }
 
+// EffectiveConfigDump provides a mock function with given fields: _a0
+func (_m *Application) EffectiveConfigDump(_a0 context.Context) (string, map[string]string, error) {
+	ret := _m.Called(_a0)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 map[string]string
+	if rf, ok := ret.Get(1).(func(context.Context) map[string]string); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[string]string)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context) error); ok {
+		r2 = rf(_a0)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ExternalDatabases provides a mock function with given fields:
+func (_m *Application) ExternalDatabases() externaldb.Registry {
+	ret := _m.Called()
+
+	var r0 externaldb.Registry
+	if rf, ok := ret.Get(0).(func() externaldb.Registry); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(externaldb.Registry)
+		}
+	}
+
+	return r0
+}
+
 // GetChains provides a mock function with given fields:
 func (_m *Application) GetChains() chainlink.Chains {
 	ret := _m.Called()
@@ -333,6 +467,112 @@ func (_m *Application) JobSpawner() job.Spawner {
 	return r0
 }
 
+// KeyRotator provides a mock function with given fields:
+func (_m *Application) KeyRotator() keyrotation.Rotator {
+	ret := _m.Called()
+
+	var r0 keyrotation.Rotator
+	if rf, ok := ret.Get(0).(func() keyrotation.Rotator); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(keyrotation.Rotator)
+		}
+	}
+
+	return r0
+}
+
+// LinkWithdrawer provides a mock function with given fields:
+func (_m *Application) LinkWithdrawer() withdrawals.Withdrawer {
+	ret := _m.Called()
+
+	var r0 withdrawals.Withdrawer
+	if rf, ok := ret.Get(0).(func() withdrawals.Withdrawer); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(withdrawals.Withdrawer)
+		}
+	}
+
+	return r0
+}
+
+// MaintenanceStatus provides a mock function with given fields: ctx
+func (_m *Application) MaintenanceStatus(ctx context.Context) (chainlink.MaintenanceStatus, error) {
+	ret := _m.Called(ctx)
+
+	var r0 chainlink.MaintenanceStatus
+	if rf, ok := ret.Get(0).(func(context.Context) chainlink.MaintenanceStatus); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(chainlink.MaintenanceStatus)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PackageLogLevels provides a mock function with given fields: ctx
+func (_m *Application) PackageLogLevels(ctx context.Context) (map[string]zapcore.Level, error) {
+	ret := _m.Called(ctx)
+
+	var r0 map[string]zapcore.Level
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]zapcore.Level); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]zapcore.Level)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PauseJob provides a mock function with given fields: ctx, jobID
+func (_m *Application) PauseJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PeerWrapper provides a mock function with given fields:
+func (_m *Application) PeerWrapper() *ocrcommon.SingletonPeerWrapper {
+	ret := _m.Called()
+
+	var r0 *ocrcommon.SingletonPeerWrapper
+	if rf, ok := ret.Get(0).(func() *ocrcommon.SingletonPeerWrapper); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ocrcommon.SingletonPeerWrapper)
+		}
+	}
+
+	return r0
+}
+
 // PipelineORM provides a mock function with given fields:
 func (_m *Application) PipelineORM() pipeline.ORM {
 	ret := _m.Called()
@@ -349,6 +589,20 @@ func (_m *Application) PipelineORM() pipeline.ORM {
 	return r0
 }
 
+// PurgeJob provides a mock function with given fields: ctx, jobID
+func (_m *Application) PurgeJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ReplayFromBlock provides a mock function with given fields: chainID, number, forceBroadcast
 func (_m *Application) ReplayFromBlock(chainID *big.Int, number uint64, forceBroadcast bool) error {
 	ret := _m.Called(chainID, number, forceBroadcast)
@@ -363,6 +617,41 @@ func (_m *Application) ReplayFromBlock(chainID *big.Int, number uint64, forceBro
 	return r0
 }
 
+// ReplayJobLogs provides a mock function with given fields: ctx, chainID, jobID, fromBlock, toBlock
+func (_m *Application) ReplayJobLogs(ctx context.Context, chainID *big.Int, jobID int32, fromBlock uint64, toBlock uint64) (int, error) {
+	ret := _m.Called(ctx, chainID, jobID, fromBlock, toBlock)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, int32, uint64, uint64) int); ok {
+		r0 = rf(ctx, chainID, jobID, fromBlock, toBlock)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int, int32, uint64, uint64) error); ok {
+		r1 = rf(ctx, chainID, jobID, fromBlock, toBlock)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResumeJob provides a mock function with given fields: ctx, jobID
+func (_m *Application) ResumeJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ResumeJobV2 provides a mock function with given fields: ctx, taskID, result
 func (_m *Application) ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error {
 	ret := _m.Called(ctx, taskID, result)
@@ -398,6 +687,22 @@ func (_m *Application) RunJobV2(ctx context.Context, jobID int32, meta map[strin
 	return r0, r1
 }
 
+// RunOutputsNotifier provides a mock function with given fields:
+func (_m *Application) RunOutputsNotifier() runoutputs.Notifier {
+	ret := _m.Called()
+
+	var r0 runoutputs.Notifier
+	if rf, ok := ret.Get(0).(func() runoutputs.Notifier); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(runoutputs.Notifier)
+		}
+	}
+
+	return r0
+}
+
 // RunWebhookJobV2 provides a mock function with given fields: ctx, jobUUID, requestBody, meta
 func (_m *Application) RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error) {
 	ret := _m.Called(ctx, jobUUID, requestBody, meta)
@@ -465,6 +770,25 @@ func (_m *Application) SetLogLevel(lvl zapcore.Level) error {
 	return r0
 }
 
+// SetMaintenanceMode provides a mock function with given fields: enabled
+func (_m *Application) SetMaintenanceMode(enabled bool) {
+	_m.Called(enabled)
+}
+
+// SetPackageLogLevel provides a mock function with given fields: ctx, pkg, lvl
+func (_m *Application) SetPackageLogLevel(ctx context.Context, pkg string, lvl *zapcore.Level) error {
+	ret := _m.Called(ctx, pkg, lvl)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *zapcore.Level) error); ok {
+		r0 = rf(ctx, pkg, lvl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Application) Start(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -509,6 +833,20 @@ func (_m *Application) TxmORM() txmgr.ORM {
 	return r0
 }
 
+// UpdatesBroadcaster provides a mock function with given fields:
+func (_m *Application) UpdatesBroadcaster() updates.Broadcaster {
+	ret := _m.Called()
+
+	var r0 updates.Broadcaster
+	if rf, ok := ret.Get(0).(func() updates.Broadcaster); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(updates.Broadcaster)
+	}
+
+	return r0
+}
+
 // WakeSessionReaper provides a mock function with given fields:
 func (_m *Application) WakeSessionReaper() {
 	_m.Called()
@@ -5,6 +5,8 @@ package mocks
 import (
 	big "math/big"
 
+	common "github.com/ethereum/go-ethereum/common"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -18,11 +20,21 @@ func (_m *PrometheusBackend) SetMaxUnconfirmedAge(_a0 *big.Int, _a1 float64) {
 	_m.Called(_a0, _a1)
 }
 
+// SetMaxPendingTxAgeByAddress provides a mock function with given fields: _a0, _a1, _a2
+func (_m *PrometheusBackend) SetMaxPendingTxAgeByAddress(_a0 *big.Int, _a1 common.Address, _a2 float64) {
+	_m.Called(_a0, _a1, _a2)
+}
+
 // SetMaxUnconfirmedBlocks provides a mock function with given fields: _a0, _a1
 func (_m *PrometheusBackend) SetMaxUnconfirmedBlocks(_a0 *big.Int, _a1 int64) {
 	_m.Called(_a0, _a1)
 }
 
+// SetPendingTransactionsByAddress provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *PrometheusBackend) SetPendingTransactionsByAddress(_a0 *big.Int, _a1 common.Address, _a2 string, _a3 int64) {
+	_m.Called(_a0, _a1, _a2, _a3)
+}
+
 // SetPipelineRunsQueued provides a mock function with given fields: n
 func (_m *PrometheusBackend) SetPipelineRunsQueued(n int) {
 	_m.Called(n)
@@ -39,6 +39,7 @@ var _ config.GeneralConfig = &TestGeneralConfig{}
 type GeneralConfigOverrides struct {
 	AdvisoryLockCheckInterval               *time.Duration
 	AdvisoryLockID                          null.Int
+	AllowHeaders                            null.String
 	AllowOrigins                            null.String
 	BlockBackfillDepth                      null.Int
 	BlockBackfillSkip                       null.Bool
@@ -97,6 +98,7 @@ type GeneralConfigOverrides struct {
 	KeeperTurnFlagEnabled                   null.Bool
 	LeaseLockDuration                       *time.Duration
 	LeaseLockRefreshInterval                *time.Duration
+	LogBroadcasterPolling                   null.Bool
 	LogFileDir                              null.String
 	LogLevel                                *zapcore.Level
 	DefaultLogLevel                         *zapcore.Level
@@ -110,6 +112,7 @@ type GeneralConfigOverrides struct {
 	OperatorFactoryAddress                  null.String
 	NodeNoNewHeadsThreshold                 *time.Duration
 	JobPipelineReaperInterval               *time.Duration
+	ReadinessStrict                         null.Bool
 
 	// Feature Flags
 	FeatureExternalInitiators null.Bool
@@ -293,6 +296,13 @@ func (c *TestGeneralConfig) EVMRPCEnabled() bool {
 	return c.GeneralConfig.EVMRPCEnabled()
 }
 
+func (c *TestGeneralConfig) ReadinessStrict() bool {
+	if c.Overrides.ReadinessStrict.Valid {
+		return c.Overrides.ReadinessStrict.Bool
+	}
+	return c.GeneralConfig.ReadinessStrict()
+}
+
 // TerraEnabled allows Terra to be used
 func (c *TestGeneralConfig) TerraEnabled() bool {
 	if c.Overrides.TerraEnabled.Valid {
@@ -494,6 +504,13 @@ func (c *TestGeneralConfig) BlockBackfillSkip() bool {
 	return c.GeneralConfig.BlockBackfillSkip()
 }
 
+func (c *TestGeneralConfig) AllowHeaders() string {
+	if c.Overrides.AllowHeaders.Valid {
+		return c.Overrides.AllowHeaders.String
+	}
+	return c.GeneralConfig.AllowHeaders()
+}
+
 func (c *TestGeneralConfig) AllowOrigins() string {
 	if c.Overrides.AllowOrigins.Valid {
 		return c.Overrides.AllowOrigins.String
@@ -501,6 +518,13 @@ func (c *TestGeneralConfig) AllowOrigins() string {
 	return c.GeneralConfig.AllowOrigins()
 }
 
+func (c *TestGeneralConfig) LogBroadcasterPolling() bool {
+	if c.Overrides.LogBroadcasterPolling.Valid {
+		return c.Overrides.LogBroadcasterPolling.Bool
+	}
+	return c.GeneralConfig.LogBroadcasterPolling()
+}
+
 func (c *TestGeneralConfig) LogLevel() zapcore.Level {
 	if c.Overrides.LogLevel != nil {
 		return *c.Overrides.LogLevel
@@ -0,0 +1,518 @@
+// Package pipelinetest provides an in-memory pipeline.ORM, for the unit tests
+// which only need the pipeline runs to be stored, without a Postgres database.
+package pipelinetest
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"github.com/smartcontractkit/sqlx"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// ErrSQLNotSupported is returned by the queries made on the pg.Q of the
+// in-memory ORM.
+var ErrSQLNotSupported = errors.New("the in-memory pipeline ORM does not support SQL queries, use pipeline.NewORM with a database")
+
+// ORM is an in-memory pipeline.ORM. Its GetQ returns a pg.Q on which
+// transactions succeed but queries fail with ErrSQLNotSupported: runs can be
+// executed and stored, but the bridge tasks, and the ORMs sharing the
+// transaction of the runner, still need a database.
+type ORM struct {
+	q pg.Q
+
+	mu         sync.Mutex
+	specs      map[int32]pipeline.Spec
+	runs       map[int64]*pipeline.Run
+	heartbeats map[int64]int
+	windows    map[int64]pipeline.MaintenanceWindow
+	lastValues map[lastValueKey]pipeline.LastValue
+	state      map[int32]map[string]interface{}
+	lastSpecID int32
+	lastRunID  int64
+	lastWinID  int64
+}
+
+var _ pipeline.ORM = (*ORM)(nil)
+
+type lastValueKey struct {
+	specID int32
+	dotID  string
+}
+
+// NewInMemoryORM returns an empty in-memory ORM.
+func NewInMemoryORM(t testing.TB) *ORM {
+	db := sqlx.NewDb(sql.OpenDB(noSQLConnector{}), "postgres")
+	t.Cleanup(func() { _ = db.Close() })
+	return &ORM{
+		q:          pg.NewQ(db, logger.TestLogger(t), logConfig{}),
+		specs:      make(map[int32]pipeline.Spec),
+		runs:       make(map[int64]*pipeline.Run),
+		heartbeats: make(map[int64]int),
+		windows:    make(map[int64]pipeline.MaintenanceWindow),
+		lastValues: make(map[lastValueKey]pipeline.LastValue),
+		state:      make(map[int32]map[string]interface{}),
+	}
+}
+
+// Heartbeats returns the number of deduplicated runs recorded as a heartbeat
+// of the run.
+func (o *ORM) Heartbeats(runID int64) int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.heartbeats[runID]
+}
+
+func (o *ORM) CreateSpec(p pipeline.Pipeline, maxTaskDuration models.Interval, _ ...pg.QOpt) (int32, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.lastSpecID++
+	o.specs[o.lastSpecID] = pipeline.Spec{
+		ID:              o.lastSpecID,
+		DotDagSource:    p.Source,
+		CreatedAt:       time.Now(),
+		MaxTaskDuration: maxTaskDuration,
+	}
+	return o.lastSpecID, nil
+}
+
+func (o *ORM) CreateRun(run *pipeline.Run, _ ...pg.QOpt) error {
+	if run.CreatedAt.IsZero() {
+		return errors.New("run.CreatedAt must be set")
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.insertRun(run, true)
+	return nil
+}
+
+func (o *ORM) InsertRun(run *pipeline.Run, _ ...pg.QOpt) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.insertRun(run, false)
+	return nil
+}
+
+// insertRun assigns an ID to the run and stores a copy of it, with its task
+// runs if withTaskRuns.
+func (o *ORM) insertRun(run *pipeline.Run, withTaskRuns bool) {
+	o.lastRunID++
+	run.ID = o.lastRunID
+	for i := range run.PipelineTaskRuns {
+		run.PipelineTaskRuns[i].PipelineRunID = run.ID
+	}
+	stored := *run
+	stored.PipelineTaskRuns = nil
+	if withTaskRuns {
+		stored.PipelineTaskRuns = append([]pipeline.TaskRun(nil), run.PipelineTaskRuns...)
+	}
+	o.runs[run.ID] = &stored
+}
+
+func (o *ORM) StoreRun(run *pipeline.Run, _ ...pg.QOpt) (restart bool, err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	stored, ok := o.runs[run.ID]
+	if !ok {
+		return false, errors.Wrap(sql.ErrNoRows, "StoreRun")
+	}
+
+	if !run.FinishedAt.Valid {
+		// Diff with the stored task runs, if updated, swap them in and restart
+		for i, tr := range run.PipelineTaskRuns {
+			if !tr.IsPending() {
+				continue
+			}
+			if taskRun := stored.ByDotID(tr.DotID); taskRun != nil && !taskRun.IsPending() {
+				run.PipelineTaskRuns[i] = *taskRun
+				restart = true
+			}
+		}
+		if restart {
+			return true, nil
+		}
+		run.State = pipeline.RunStatusSuspended
+		stored.State = run.State
+	} else {
+		if run.Outputs.Val == nil || len(run.FatalErrors)+len(run.AllErrors) == 0 {
+			return false, errors.Errorf("run must have both Outputs and Errors, got Outputs: %#v, FatalErrors: %#v, AllErrors: %#v", run.Outputs.Val, run.FatalErrors, run.AllErrors)
+		}
+		stored.State = run.State
+		stored.FinishedAt = run.FinishedAt
+		stored.AllErrors = run.AllErrors
+		stored.FatalErrors = run.FatalErrors
+		stored.Outputs = run.Outputs
+		if err = o.storeLastValues(run); err != nil {
+			return false, errors.Wrap(err, "StoreRun")
+		}
+	}
+
+	taskRuns := make([]pipeline.TaskRun, len(run.PipelineTaskRuns))
+	for i, tr := range run.PipelineTaskRuns {
+		if existing := stored.ByDotID(tr.DotID); existing != nil {
+			existing.Output = tr.Output
+			existing.Error = tr.Error
+			existing.FinishedAt = tr.FinishedAt
+			taskRuns[i] = *existing
+			continue
+		}
+		tr.PipelineRunID = run.ID
+		stored.PipelineTaskRuns = append(stored.PipelineTaskRuns, tr)
+		taskRuns[i] = tr
+	}
+	run.PipelineTaskRuns = taskRuns
+	return false, nil
+}
+
+func (o *ORM) DeleteRun(id int64) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	delete(o.runs, id)
+	return nil
+}
+
+func (o *ORM) UpdateTaskRunResult(taskID uuid.UUID, result pipeline.Result) (run pipeline.Run, start bool, err error) {
+	if result.OutputDB().Valid && result.ErrorDB().Valid {
+		panic("run result must specify either output or error, not both")
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, stored := range o.runs {
+		if stored.State != pipeline.RunStatusRunning && stored.State != pipeline.RunStatusSuspended {
+			continue
+		}
+		for i := range stored.PipelineTaskRuns {
+			tr := &stored.PipelineTaskRuns[i]
+			if tr.ID != taskID {
+				continue
+			}
+			tr.Output = result.OutputDB()
+			tr.Error = result.ErrorDB()
+			tr.FinishedAt = null.TimeFrom(time.Now())
+			if stored.State == pipeline.RunStatusSuspended {
+				start = true
+				stored.State = pipeline.RunStatusRunning
+			}
+			return o.load(stored), start, nil
+		}
+	}
+	return run, false, sql.ErrNoRows
+}
+
+func (o *ORM) InsertFinishedRun(run *pipeline.Run, saveSuccessfulTaskRuns bool, _ ...pg.QOpt) error {
+	if err := checkFinishedRun(run, saveSuccessfulTaskRuns); err != nil {
+		return err
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if err := o.storeLastValues(run); err != nil {
+		return errors.Wrap(err, "InsertFinishedRun failed")
+	}
+	if dedup := run.PipelineSpec.RunDedup; dedup != nil && !run.HasErrors() {
+		deduplicated, err := o.insertHeartbeatIfUnchanged(run, *dedup)
+		if err != nil || deduplicated {
+			return errors.Wrap(err, "InsertFinishedRun failed")
+		}
+	}
+	o.insertRun(run, saveSuccessfulTaskRuns || run.HasErrors())
+	return nil
+}
+
+func (o *ORM) insertHeartbeatIfUnchanged(run *pipeline.Run, dedup pipeline.RunDedup) (bool, error) {
+	var last *pipeline.Run
+	for _, stored := range o.runs {
+		if stored.PipelineSpecID == run.PipelineSpecID && (last == nil || runLess(last, stored)) {
+			last = stored
+		}
+	}
+	if last == nil {
+		return false, nil
+	}
+	outputs, err := run.Outputs.MarshalJSON()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to encode outputs")
+	}
+	lastOutputs, err := last.Outputs.MarshalJSON()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to encode outputs")
+	}
+	if !dedup.Unchanged(outputs, lastOutputs) {
+		return false, nil
+	}
+	o.heartbeats[last.ID]++
+	return true, nil
+}
+
+// storeLastValues stores the outputs of the deviation tasks, and the state
+// changes, of run if the run is successful.
+func (o *ORM) storeLastValues(run *pipeline.Run) error {
+	if run.HasErrors() {
+		return nil
+	}
+	for _, tr := range run.PipelineTaskRuns {
+		if tr.Type != pipeline.TaskTypeDeviation || !tr.Output.Valid {
+			continue
+		}
+		var value pipeline.DecimalParam
+		if err := value.UnmarshalPipelineParam(tr.Output.Val); err != nil {
+			return errors.Wrapf(err, "invalid output of deviation task %s", tr.DotID)
+		}
+		o.lastValues[lastValueKey{run.PipelineSpecID, tr.DotID}] = pipeline.LastValue{Value: value.Decimal(), UpdatedAt: run.FinishedAt.Time}
+	}
+	for key, value := range run.StateChanges() {
+		// Like the JSON column, so that the values read are the same
+		b, err := pipeline.JSONSerializable{Val: value, Valid: true}.MarshalJSON()
+		if err != nil {
+			return errors.Wrapf(err, "failed to encode the state %s", key)
+		}
+		var decoded pipeline.JSONSerializable
+		if err = decoded.UnmarshalJSON(b); err != nil {
+			return errors.Wrapf(err, "failed to decode the state %s", key)
+		}
+		if o.state[run.PipelineSpecID] == nil {
+			o.state[run.PipelineSpecID] = make(map[string]interface{})
+		}
+		o.state[run.PipelineSpecID][key] = decoded.Val
+	}
+	return nil
+}
+
+func (o *ORM) InsertFinishedRuns(runs []*pipeline.Run, saveSuccessfulTaskRuns bool, _ ...pg.QOpt) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, run := range runs {
+		if err := o.storeLastValues(run); err != nil {
+			return errors.Wrap(err, "InsertFinishedRuns failed")
+		}
+		o.insertRun(run, saveSuccessfulTaskRuns || run.HasErrors())
+	}
+	return nil
+}
+
+func (o *ORM) DeleteRunsOlderThan(_ context.Context, threshold time.Duration) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	before := time.Now().Add(-threshold)
+	for id, stored := range o.runs {
+		if stored.FinishedAt.Valid && stored.FinishedAt.Time.Before(before) {
+			delete(o.runs, id)
+		}
+	}
+	return nil
+}
+
+func (o *ORM) FindRunsFinishedBefore(_ context.Context, before time.Time, limit int) ([]pipeline.Run, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	runs := o.selectRuns(func(r *pipeline.Run) bool {
+		return r.FinishedAt.Valid && r.FinishedAt.Time.Before(before)
+	}, func(a, b *pipeline.Run) bool { return a.ID < b.ID })
+	if len(runs) > limit {
+		runs = runs[:limit]
+	}
+	return runs, nil
+}
+
+func (o *ORM) DeleteRuns(_ context.Context, ids []int64) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, id := range ids {
+		delete(o.runs, id)
+	}
+	return nil
+}
+
+func (o *ORM) FindRun(id int64) (pipeline.Run, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	stored, ok := o.runs[id]
+	if !ok {
+		return pipeline.Run{}, sql.ErrNoRows
+	}
+	return o.load(stored), nil
+}
+
+func (o *ORM) GetAllRuns() ([]pipeline.Run, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.selectRuns(func(*pipeline.Run) bool { return true }, runLess), nil
+}
+
+func (o *ORM) GetUnfinishedRuns(_ context.Context, now time.Time, fn func(run pipeline.Run) error) error {
+	o.mu.Lock()
+	runs := o.selectRuns(func(r *pipeline.Run) bool {
+		return r.State == pipeline.RunStatusRunning && r.CreatedAt.Before(now)
+	}, runLess)
+	o.mu.Unlock()
+	for _, run := range runs {
+		if err := fn(run); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *ORM) GetQ() pg.Q {
+	return o.q
+}
+
+func (o *ORM) CreateMaintenanceWindow(w *pipeline.MaintenanceWindow, _ ...pg.QOpt) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.lastWinID++
+	w.ID = o.lastWinID
+	w.CreatedAt = time.Now()
+	o.windows[w.ID] = *w
+	return nil
+}
+
+func (o *ORM) DeleteMaintenanceWindow(id int64, _ ...pg.QOpt) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if _, ok := o.windows[id]; !ok {
+		return sql.ErrNoRows
+	}
+	delete(o.windows, id)
+	return nil
+}
+
+func (o *ORM) FindMaintenanceWindows(_ ...pg.QOpt) ([]pipeline.MaintenanceWindow, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	var windows []pipeline.MaintenanceWindow
+	now := time.Now()
+	for _, w := range o.windows {
+		if w.EndsAt.After(now) {
+			windows = append(windows, w)
+		}
+	}
+	sort.Slice(windows, func(i, j int) bool {
+		if !windows[i].StartsAt.Equal(windows[j].StartsAt) {
+			return windows[i].StartsAt.Before(windows[j].StartsAt)
+		}
+		return windows[i].ID < windows[j].ID
+	})
+	return windows, nil
+}
+
+func (o *ORM) FindLastValue(specID int32, dotID string, _ ...pg.QOpt) (pipeline.LastValue, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	value, ok := o.lastValues[lastValueKey{specID, dotID}]
+	if !ok {
+		return pipeline.LastValue{}, sql.ErrNoRows
+	}
+	return value, nil
+}
+
+func (o *ORM) FindState(specID int32, _ ...pg.QOpt) (map[string]interface{}, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	state := make(map[string]interface{}, len(o.state[specID]))
+	for key, value := range o.state[specID] {
+		state[key] = value
+	}
+	return state, nil
+}
+
+// selectRuns returns copies of the stored runs matching filter, sorted by less.
+func (o *ORM) selectRuns(filter func(*pipeline.Run) bool, less func(a, b *pipeline.Run) bool) []pipeline.Run {
+	var matching []*pipeline.Run
+	for _, stored := range o.runs {
+		if filter(stored) {
+			matching = append(matching, stored)
+		}
+	}
+	sort.Slice(matching, func(i, j int) bool { return less(matching[i], matching[j]) })
+	runs := make([]pipeline.Run, len(matching))
+	for i, stored := range matching {
+		runs[i] = o.load(stored)
+	}
+	return runs
+}
+
+// load returns a copy of the stored run with its spec and sorted task runs,
+// like the associations loaded by pipeline.ORM.
+func (o *ORM) load(stored *pipeline.Run) pipeline.Run {
+	run := *stored
+	if spec, ok := o.specs[run.PipelineSpecID]; ok && run.PipelineSpec.ID == 0 {
+		run.PipelineSpec = spec
+	}
+	if run.PipelineSpec.JobType == "keeper" {
+		run.PipelineSpec.DotDagSource = pipeline.KeepersObservationSource
+	}
+	run.PipelineTaskRuns = append([]pipeline.TaskRun(nil), stored.PipelineTaskRuns...)
+	sort.Slice(run.PipelineTaskRuns, func(i, j int) bool {
+		a, b := run.PipelineTaskRuns[i], run.PipelineTaskRuns[j]
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.Before(b.CreatedAt)
+		}
+		return bytes.Compare(a.ID.Bytes(), b.ID.Bytes()) < 0
+	})
+	return run
+}
+
+func runLess(a, b *pipeline.Run) bool {
+	if !a.CreatedAt.Equal(b.CreatedAt) {
+		return a.CreatedAt.Before(b.CreatedAt)
+	}
+	return a.ID < b.ID
+}
+
+func checkFinishedRun(run *pipeline.Run, saveSuccessfulTaskRuns bool) error {
+	if run.CreatedAt.IsZero() {
+		return errors.New("run.CreatedAt must be set")
+	}
+	if run.FinishedAt.IsZero() {
+		return errors.New("run.FinishedAt must be set")
+	}
+	if run.Outputs.Val == nil || len(run.FatalErrors)+len(run.AllErrors) == 0 {
+		return errors.Errorf("run must have both Outputs and Errors, got Outputs: %#v, FatalErrors: %#v, AllErrors: %#v", run.Outputs.Val, run.FatalErrors, run.AllErrors)
+	}
+	if len(run.PipelineTaskRuns) == 0 && (saveSuccessfulTaskRuns || run.HasErrors()) {
+		return errors.New("must provide task run results")
+	}
+	return nil
+}
+
+type logConfig struct{}
+
+func (logConfig) LogSQL() bool { return false }
+
+// noSQLConnector connects to a database/sql driver whose transactions succeed,
+// and whose queries fail with ErrSQLNotSupported.
+type noSQLConnector struct{}
+
+func (noSQLConnector) Connect(context.Context) (driver.Conn, error) { return noSQLConn{}, nil }
+func (noSQLConnector) Driver() driver.Driver                        { return noSQLDriver{} }
+
+type noSQLDriver struct{}
+
+func (noSQLDriver) Open(string) (driver.Conn, error) { return noSQLConn{}, nil }
+
+type noSQLConn struct{}
+
+func (noSQLConn) Prepare(string) (driver.Stmt, error) { return nil, ErrSQLNotSupported }
+func (noSQLConn) Close() error                        { return nil }
+func (noSQLConn) Begin() (driver.Tx, error)           { return noSQLTx{}, nil }
+func (noSQLConn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	return noSQLTx{}, nil
+}
+
+type noSQLTx struct{}
+
+func (noSQLTx) Commit() error   { return nil }
+func (noSQLTx) Rollback() error { return nil }
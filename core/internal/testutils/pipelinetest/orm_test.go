@@ -0,0 +1,265 @@
+package pipelinetest_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pipelinetest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+func newRunner(t *testing.T, orm pipeline.ORM) pipeline.Runner {
+	return pipeline.NewRunner(orm, configtest.NewTestGeneralConfig(t), nil, nil, nil, logger.TestLogger(t), nil, nil)
+}
+
+func createSpec(t *testing.T, orm pipeline.ORM, source string) pipeline.Spec {
+	p, err := pipeline.Parse(source)
+	require.NoError(t, err)
+	specID, err := orm.CreateSpec(*p, models.Interval(time.Minute))
+	require.NoError(t, err)
+	return pipeline.Spec{ID: specID, DotDagSource: source}
+}
+
+func TestInMemoryORM_InsertFinishedRun(t *testing.T) {
+	orm := pipelinetest.NewInMemoryORM(t)
+	r := newRunner(t, orm)
+	lggr := logger.TestLogger(t)
+	spec := createSpec(t, orm, `parse [type=jsonparse data="$(jobRun.requestBody)" path="value"];`)
+
+	execute := func(requestBody string, saveSuccessfulTaskRuns bool) pipeline.Run {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"jobRun": map[string]interface{}{"requestBody": requestBody},
+		})
+		runID, _, err := r.ExecuteAndInsertFinishedRun(testutils.Context(t), spec, vars, lggr, saveSuccessfulTaskRuns)
+		require.NoError(t, err)
+		run, err := orm.FindRun(runID)
+		require.NoError(t, err)
+		return run
+	}
+
+	run := execute(`{"value": "foo"}`, true)
+	assert.Equal(t, pipeline.RunStatusCompleted, run.State)
+	assert.Equal(t, []interface{}{"foo"}, run.Outputs.Val)
+	assert.Equal(t, spec.DotDagSource, run.PipelineSpec.DotDagSource)
+	require.Len(t, run.PipelineTaskRuns, 1)
+	assert.Equal(t, run.ID, run.PipelineTaskRuns[0].PipelineRunID)
+
+	// the successful task runs are stored only if asked, the failed ones always
+	assert.Empty(t, execute(`{"value": "bar"}`, false).PipelineTaskRuns)
+	failed := execute(`{}`, false)
+	assert.Equal(t, pipeline.RunStatusErrored, failed.State)
+	assert.Len(t, failed.PipelineTaskRuns, 1)
+
+	runs, err := orm.GetAllRuns()
+	require.NoError(t, err)
+	require.Len(t, runs, 3)
+	assert.Equal(t, run.ID, runs[0].ID)
+	assert.Equal(t, failed.ID, runs[2].ID)
+
+	_, err = orm.FindRun(failed.ID + 1)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestInMemoryORM_RunDedup(t *testing.T) {
+	orm := pipelinetest.NewInMemoryORM(t)
+	r := newRunner(t, orm)
+	lggr := logger.TestLogger(t)
+	spec := createSpec(t, orm, `value [type=memo value=<$(jobRun.value)>];`)
+	spec.RunDedup = &pipeline.RunDedup{Tolerance: 1}
+
+	execute := func(value string) int64 {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"jobRun": map[string]interface{}{"value": value},
+		})
+		runID, _, err := r.ExecuteAndInsertFinishedRun(testutils.Context(t), spec, vars, lggr, false)
+		require.NoError(t, err)
+		return runID
+	}
+
+	first := execute("100")
+	// within the tolerance, recorded as a heartbeat of the first run
+	assert.Zero(t, execute("100.5"))
+	assert.Equal(t, 1, orm.Heartbeats(first))
+	assert.NotZero(t, execute("102"))
+
+	runs, err := orm.GetAllRuns()
+	require.NoError(t, err)
+	assert.Len(t, runs, 2)
+}
+
+func TestInMemoryORM_LastValuesAndState(t *testing.T) {
+	orm := pipelinetest.NewInMemoryORM(t)
+	r := newRunner(t, orm)
+	lggr := logger.TestLogger(t)
+	spec := createSpec(t, orm, `
+dev [type=deviation input="$(jobRun.value)" threshold=10];
+set [type=setstate key=last value=<$(jobRun.value)>];
+dev -> set;
+`)
+
+	execute := func(value string) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"jobRun": map[string]interface{}{"value": value},
+		})
+		_, _, err := r.ExecuteAndInsertFinishedRun(testutils.Context(t), spec, vars, lggr, true)
+		require.NoError(t, err)
+	}
+
+	_, err := orm.FindLastValue(spec.ID, "dev")
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	execute("100")
+	// within the deviation threshold, the run is not stored
+	execute("105")
+	last, err := orm.FindLastValue(spec.ID, "dev")
+	require.NoError(t, err)
+	assert.Equal(t, "100", last.Value.String())
+	state, err := orm.FindState(spec.ID)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"last": "100"}, state)
+
+	execute("120")
+	last, err = orm.FindLastValue(spec.ID, "dev")
+	require.NoError(t, err)
+	assert.Equal(t, "120", last.Value.String())
+}
+
+func TestInMemoryORM_StoreRun(t *testing.T) {
+	orm := pipelinetest.NewInMemoryORM(t)
+	now := time.Now()
+	taskID := uuid.NewV4()
+	run := pipeline.Run{
+		State:     pipeline.RunStatusRunning,
+		Inputs:    pipeline.JSONSerializable{Val: map[string]interface{}{}, Valid: true},
+		CreatedAt: now,
+		PipelineTaskRuns: []pipeline.TaskRun{{
+			ID:        taskID,
+			Type:      pipeline.TaskTypeETHTx,
+			DotID:     "tx",
+			CreatedAt: now,
+		}},
+	}
+	require.NoError(t, orm.CreateRun(&run))
+	assert.NotZero(t, run.ID)
+
+	// the run waits for its pending task
+	restart, err := orm.StoreRun(&run)
+	require.NoError(t, err)
+	assert.False(t, restart)
+	assert.Equal(t, pipeline.RunStatusSuspended, run.State)
+
+	resumed, start, err := orm.UpdateTaskRunResult(taskID, pipeline.Result{Value: "0xabc"})
+	require.NoError(t, err)
+	assert.True(t, start)
+	assert.Equal(t, pipeline.RunStatusRunning, resumed.State)
+
+	// the run restarts with the result of its task
+	restart, err = orm.StoreRun(&run)
+	require.NoError(t, err)
+	assert.True(t, restart)
+	assert.Equal(t, pipeline.JSONSerializable{Val: "0xabc", Valid: true}, run.PipelineTaskRuns[0].Output)
+
+	run.State = pipeline.RunStatusCompleted
+	run.FinishedAt = null.TimeFrom(time.Now())
+	run.Outputs = pipeline.JSONSerializable{Val: []interface{}{"0xabc"}, Valid: true}
+	run.AllErrors = pipeline.RunErrors{null.String{}}
+	run.FatalErrors = pipeline.RunErrors{null.String{}}
+	restart, err = orm.StoreRun(&run)
+	require.NoError(t, err)
+	assert.False(t, restart)
+
+	stored, err := orm.FindRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, pipeline.RunStatusCompleted, stored.State)
+	assert.Len(t, stored.PipelineTaskRuns, 1)
+
+	// the results of finished runs are not updated
+	_, _, err = orm.UpdateTaskRunResult(taskID, pipeline.Result{Value: "0xdef"})
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	_, err = orm.StoreRun(&pipeline.Run{ID: run.ID + 1})
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestInMemoryORM_DeleteRuns(t *testing.T) {
+	orm := pipelinetest.NewInMemoryORM(t)
+	r := newRunner(t, orm)
+	lggr := logger.TestLogger(t)
+	spec := createSpec(t, orm, `a [type=lowercase input="FOO"];`)
+
+	var runIDs []int64
+	for i := 0; i < 3; i++ {
+		runID, _, err := r.ExecuteAndInsertFinishedRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), lggr, false)
+		require.NoError(t, err)
+		runIDs = append(runIDs, runID)
+	}
+
+	runs, err := orm.FindRunsFinishedBefore(testutils.Context(t), time.Now(), 2)
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	assert.Equal(t, runIDs[:2], []int64{runs[0].ID, runs[1].ID})
+
+	require.NoError(t, orm.DeleteRuns(testutils.Context(t), []int64{runIDs[0]}))
+	require.NoError(t, orm.DeleteRunsOlderThan(testutils.Context(t), time.Hour))
+	runs, err = orm.GetAllRuns()
+	require.NoError(t, err)
+	assert.Len(t, runs, 2)
+
+	require.NoError(t, orm.DeleteRunsOlderThan(testutils.Context(t), 0))
+	runs, err = orm.GetAllRuns()
+	require.NoError(t, err)
+	assert.Empty(t, runs)
+}
+
+func TestInMemoryORM_MaintenanceWindows(t *testing.T) {
+	orm := pipelinetest.NewInMemoryORM(t)
+	now := time.Now()
+	later := pipeline.MaintenanceWindow{StartsAt: now.Add(time.Hour), EndsAt: now.Add(2 * time.Hour), Action: pipeline.MaintenanceWindowActionSkip}
+	current := pipeline.MaintenanceWindow{StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour), Action: pipeline.MaintenanceWindowActionSkip}
+	past := pipeline.MaintenanceWindow{StartsAt: now.Add(-2 * time.Hour), EndsAt: now.Add(-time.Hour), Action: pipeline.MaintenanceWindowActionSkip}
+	for _, w := range []*pipeline.MaintenanceWindow{&later, &current, &past} {
+		require.NoError(t, orm.CreateMaintenanceWindow(w))
+		assert.NotZero(t, w.ID)
+	}
+
+	// the windows which did not end yet, by start
+	windows, err := orm.FindMaintenanceWindows()
+	require.NoError(t, err)
+	require.Len(t, windows, 2)
+	assert.Equal(t, current.ID, windows[0].ID)
+	assert.Equal(t, later.ID, windows[1].ID)
+
+	require.NoError(t, orm.DeleteMaintenanceWindow(current.ID))
+	assert.ErrorIs(t, orm.DeleteMaintenanceWindow(current.ID), sql.ErrNoRows)
+	windows, err = orm.FindMaintenanceWindows()
+	require.NoError(t, err)
+	assert.Len(t, windows, 1)
+}
+
+func TestInMemoryORM_GetQ(t *testing.T) {
+	orm := pipelinetest.NewInMemoryORM(t)
+
+	// transactions succeed, so that runs can be stored in the transaction of
+	// the runner, but queries fail
+	var calls int
+	err := orm.GetQ().Transaction(func(tx pg.Queryer) error {
+		calls++
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls)
+
+	var count int
+	err = orm.GetQ().Get(&count, `SELECT count(*) FROM pipeline_runs`)
+	assert.ErrorIs(t, err, pipelinetest.ErrSQLNotSupported)
+}
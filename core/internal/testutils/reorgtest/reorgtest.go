@@ -0,0 +1,302 @@
+// Package reorgtest simulates the adversarial behaviour of a real chain on top
+// of the geth simulated backend: reorgs, delayed receipts and nonce gaps. It
+// is used to integration test the components following the chain, like the
+// log broadcaster, the tx manager and the job delegates.
+package reorgtest
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// Chain is an evmclient.Client backed by a simulated backend, which can be
+// reorged, and made to delay receipts or lose transactions.
+type Chain struct {
+	*evmclient.SimulatedBackendClient
+	Backend *backends.SimulatedBackend
+
+	t        testing.TB
+	chainID  *big.Int
+	reorgKey *ecdsa.PrivateKey
+
+	mu           sync.Mutex
+	reorgs       int64
+	receiptDelay uint64
+	dropNext     int
+	dropped      []*types.Transaction
+	queued       map[common.Address]map[uint64]*types.Transaction
+}
+
+var _ evmclient.Client = (*Chain)(nil)
+
+// NewChain returns a Chain on a new simulated backend with the genesis alloc.
+// An additional account is funded to make the blocks of the reorgs unique.
+func NewChain(t testing.TB, alloc core.GenesisAlloc, gasLimit uint32) *Chain {
+	reorgKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	genesis := core.GenesisAlloc{crypto.PubkeyToAddress(reorgKey.PublicKey): {Balance: assets.Ether(1000)}}
+	for addr, account := range alloc {
+		genesis[addr] = account
+	}
+
+	backend := backends.NewSimulatedBackend(genesis, uint64(gasLimit))
+	// NOTE: Make sure to finish closing any application/client before
+	// backend.Close or they can hang
+	t.Cleanup(func() {
+		logger.TestLogger(t).ErrorIfClosing(backend, "simulated backend")
+	})
+	return &Chain{
+		SimulatedBackendClient: evmclient.NewSimulatedBackendClient(t, backend, testutils.SimulatedChainID),
+		Backend:                backend,
+		t:                      t,
+		chainID:                testutils.SimulatedChainID,
+		reorgKey:               reorgKey,
+		queued:                 make(map[common.Address]map[uint64]*types.Transaction),
+	}
+}
+
+// Mine commits n blocks with the pending transactions.
+func (c *Chain) Mine(n int) {
+	for i := 0; i < n; i++ {
+		c.Backend.Commit()
+	}
+}
+
+// Reorg replaces the last depth canonical blocks by a side chain of length
+// blocks, which must be longer to become canonical. The txs are included in
+// the first block of the side chain, and the transactions of the replaced
+// blocks are returned, as they are not sent again.
+//
+// The pending block must be empty, i.e. the pending transactions must be
+// mined with Mine before a reorg.
+func (c *Chain) Reorg(depth, length int, txs ...*types.Transaction) (reorged []*types.Transaction) {
+	require.Greater(c.t, depth, 0, "reorg depth must be positive")
+	require.Greater(c.t, length, depth, "the side chain must be longer than the reorg depth to become canonical")
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ctx := testutils.Context(c.t)
+	head := c.Backend.Blockchain().CurrentBlock().NumberU64()
+	require.LessOrEqual(c.t, uint64(depth), head, "reorg depth must not exceed the chain height")
+	ancestor := c.Backend.Blockchain().GetBlockByNumber(head - uint64(depth))
+	reorgAddr := crypto.PubkeyToAddress(c.reorgKey.PublicKey)
+	for n := ancestor.NumberU64() + 1; n <= head; n++ {
+		for _, tx := range c.Backend.Blockchain().GetBlockByNumber(n).Transactions() {
+			if to := tx.To(); to == nil || *to != reorgAddr {
+				reorged = append(reorged, tx)
+			}
+		}
+	}
+
+	require.NoError(c.t, c.Backend.Fork(ctx, ancestor.Hash()), "pending transactions must be mined before a reorg")
+	require.NoError(c.t, c.Backend.SendTransaction(ctx, c.newReorgTx(ctx, ancestor)))
+	for _, tx := range txs {
+		require.NoError(c.t, c.Backend.SendTransaction(ctx, tx))
+	}
+	for i := 0; i < length; i++ {
+		c.Backend.Commit()
+	}
+	require.Equal(c.t, head-uint64(depth)+uint64(length), c.Backend.Blockchain().CurrentBlock().NumberU64(), "side chain did not become canonical")
+	return
+}
+
+// newReorgTx returns a self transfer of the reorg account, with a unique value
+// so that no side chain is identical to a previously replaced chain.
+func (c *Chain) newReorgTx(ctx context.Context, parent *types.Block) *types.Transaction {
+	addr := crypto.PubkeyToAddress(c.reorgKey.PublicKey)
+	nonce, err := c.Backend.PendingNonceAt(ctx, addr)
+	require.NoError(c.t, err)
+	c.reorgs++
+	gasFeeCap := new(big.Int).Mul(parent.BaseFee(), big.NewInt(2))
+	tx, err := types.SignNewTx(c.reorgKey, types.NewLondonSigner(c.chainID), &types.DynamicFeeTx{
+		ChainID:   c.chainID,
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(0),
+		GasFeeCap: gasFeeCap.Add(gasFeeCap, big.NewInt(1)),
+		Gas:       21000,
+		To:        &addr,
+		Value:     big.NewInt(c.reorgs),
+	})
+	require.NoError(c.t, err)
+	return tx
+}
+
+// DelayReceipts hides the receipts of the transactions until blocks have been
+// mined on top of their block.
+func (c *Chain) DelayReceipts(blocks uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.receiptDelay = blocks
+}
+
+// DropNextTransactions makes the next n transactions sent to the chain get
+// lost: they are acknowledged, but never mined.
+func (c *Chain) DropNextTransactions(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.dropNext = n
+}
+
+// Dropped returns the transactions lost so far.
+func (c *Chain) Dropped() []*types.Transaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*types.Transaction(nil), c.dropped...)
+}
+
+// SendTransaction sends a transaction, unless it is dropped. Transactions with
+// a nonce higher than the pending nonce of their sender are queued until the
+// nonce gap is filled, like in the mempool of a real node.
+func (c *Chain) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.dropNext > 0 {
+		c.dropNext--
+		c.dropped = append(c.dropped, tx)
+		return nil
+	}
+
+	sender, err := types.Sender(types.NewLondonSigner(c.chainID), tx)
+	if err != nil {
+		return err
+	}
+	pendingNonce, err := c.Backend.PendingNonceAt(ctx, sender)
+	if err != nil {
+		return err
+	}
+	if tx.Nonce() > pendingNonce {
+		if c.queued[sender] == nil {
+			c.queued[sender] = make(map[uint64]*types.Transaction)
+		}
+		c.queued[sender][tx.Nonce()] = tx
+		return nil
+	}
+	if err = c.SimulatedBackendClient.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+	return c.flushQueued(ctx, sender)
+}
+
+// flushQueued sends the queued transactions of sender following its pending nonce.
+func (c *Chain) flushQueued(ctx context.Context, sender common.Address) error {
+	for {
+		nonce, err := c.Backend.PendingNonceAt(ctx, sender)
+		if err != nil {
+			return err
+		}
+		tx, ok := c.queued[sender][nonce]
+		if !ok {
+			return nil
+		}
+		delete(c.queued[sender], nonce)
+		if err = c.Backend.SendTransaction(ctx, tx); err != nil {
+			return err
+		}
+	}
+}
+
+// TransactionReceipt returns the receipt of the transaction, or
+// ethereum.NotFound while it is delayed.
+func (c *Chain) TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	receipt, err := c.SimulatedBackendClient.TransactionReceipt(ctx, hash)
+	if err != nil || receipt == nil {
+		return receipt, err
+	}
+	if c.isDelayed(receipt.BlockNumber) {
+		return nil, ethereum.NotFound
+	}
+	return receipt, nil
+}
+
+// BatchCallContext makes a batch rpc call, in which the raw transactions are
+// sent like with SendTransaction, and the delayed receipts are returned empty
+// like the receipts of pending transactions.
+func (c *Chain) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	var calls []rpc.BatchElem
+	var indexes []int
+	for i, elem := range b {
+		if elem.Method == "eth_sendRawTransaction" {
+			b[i].Error = c.sendRawTransaction(ctx, elem)
+			continue
+		}
+		calls = append(calls, elem)
+		indexes = append(indexes, i)
+	}
+	if len(calls) == 0 {
+		return nil
+	}
+	if err := c.SimulatedBackendClient.BatchCallContext(ctx, calls); err != nil {
+		return err
+	}
+	for j, elem := range calls {
+		i := indexes[j]
+		b[i] = elem
+		if elem.Method != "eth_getTransactionReceipt" || elem.Error != nil {
+			continue
+		}
+		if receipt, ok := elem.Result.(*evmtypes.Receipt); ok && receipt != nil && c.isDelayed(receipt.BlockNumber) {
+			b[i].Result = &evmtypes.Receipt{}
+		}
+	}
+	return nil
+}
+
+func (c *Chain) sendRawTransaction(ctx context.Context, elem rpc.BatchElem) error {
+	if len(elem.Args) != 1 {
+		return errors.Errorf("expected 1 arg, got %d for eth_sendRawTransaction", len(elem.Args))
+	}
+	raw, ok := elem.Args[0].(string)
+	if !ok {
+		return errors.Errorf("expected arg to be a hex string, got: %T", elem.Args[0])
+	}
+	b, err := hexutil.Decode(raw)
+	if err != nil {
+		return err
+	}
+	tx := new(types.Transaction)
+	if err = tx.UnmarshalBinary(b); err != nil {
+		return err
+	}
+	if hash, ok := elem.Result.(*common.Hash); ok {
+		*hash = tx.Hash()
+	}
+	return c.SendTransaction(ctx, tx)
+}
+
+// BatchCallContextAll makes a batch rpc call.
+func (c *Chain) BatchCallContextAll(ctx context.Context, b []rpc.BatchElem) error {
+	return c.BatchCallContext(ctx, b)
+}
+
+func (c *Chain) isDelayed(blockNumber *big.Int) bool {
+	c.mu.Lock()
+	delay := c.receiptDelay
+	c.mu.Unlock()
+	if blockNumber == nil || delay == 0 {
+		return false
+	}
+	head := c.Backend.Blockchain().CurrentBlock().NumberU64()
+	return blockNumber.Uint64()+delay > head
+}
@@ -0,0 +1,284 @@
+package reorgtest_test
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/onsi/gomega"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/core/gethwrappers/generated/link_token_interface"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/reorgtest"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/web"
+)
+
+// setup returns a chain, on which the key of the returned application is
+// funded, and starts the application.
+func setup(t *testing.T, cfg *configtest.TestGeneralConfig, alloc core.GenesisAlloc) (*reorgtest.Chain, *cltest.TestApplication, ethkey.KeyV2) {
+	key := cltest.MustGenerateRandomKey(t)
+	genesis := core.GenesisAlloc{key.Address: {Balance: assets.Ether(1000)}}
+	for addr, account := range alloc {
+		genesis[addr] = account
+	}
+	chain := reorgtest.NewChain(t, genesis, uint32(ethconfig.Defaults.Miner.GasCeil*2))
+
+	app := cltest.NewApplicationWithConfigAndKeyOnSimulatedBlockchain(t, cfg, chain.Backend, chain, key)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	return chain, app, key
+}
+
+// mineUntil mines a block at each poll until cond holds.
+func mineUntil(t *testing.T, chain *reorgtest.Chain, cond func() bool) {
+	require.Eventually(t, func() bool {
+		if cond() {
+			return true
+		}
+		chain.Mine(1)
+		return false
+	}, testutils.WaitTimeout(t), time.Second)
+}
+
+func awaitPendingNonce(t *testing.T, chain *reorgtest.Chain, addr common.Address, nonce uint64) {
+	require.Eventually(t, func() bool {
+		pending, err := chain.Backend.PendingNonceAt(testutils.Context(t), addr)
+		require.NoError(t, err)
+		return pending == nonce
+	}, testutils.WaitTimeout(t), 100*time.Millisecond)
+}
+
+func countEthTxes(t *testing.T, app *cltest.TestApplication, state txmgr.EthTxState) (count int) {
+	require.NoError(t, app.GetSqlxDB().Get(&count, `SELECT count(*) FROM eth_txes WHERE state = $1`, state))
+	return
+}
+
+// confirmedIn returns the block of the receipt of the eth_tx.
+func confirmedIn(t *testing.T, app *cltest.TestApplication, etxID int64) (block struct {
+	Hash   common.Hash `db:"block_hash"`
+	Number int64       `db:"block_number"`
+}) {
+	require.NoError(t, app.GetSqlxDB().Get(&block, `
+SELECT eth_receipts.block_hash, eth_receipts.block_number FROM eth_receipts
+JOIN eth_tx_attempts ON eth_tx_attempts.hash = eth_receipts.tx_hash
+WHERE eth_tx_attempts.eth_tx_id = $1`, etxID))
+	return
+}
+
+type approvalListener struct {
+	lb    log.Broadcaster
+	jobID int32
+
+	mu         sync.Mutex
+	broadcasts []log.Broadcast
+}
+
+func (l *approvalListener) HandleLog(lb log.Broadcast) {
+	consumed, err := l.lb.WasAlreadyConsumed(lb)
+	if err != nil || consumed {
+		return
+	}
+	l.mu.Lock()
+	l.broadcasts = append(l.broadcasts, lb)
+	l.mu.Unlock()
+	_ = l.lb.MarkConsumed(lb)
+}
+
+func (l *approvalListener) JobID() int32 {
+	return l.jobID
+}
+
+func (l *approvalListener) blockHashes() (hashes []common.Hash) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, lb := range l.broadcasts {
+		hashes = append(hashes, lb.RawLog().BlockHash)
+	}
+	return
+}
+
+func TestReorg_LogBroadcaster(t *testing.T) {
+	owner := testutils.MustNewSimTransactor(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalEvmFinalityDepth = null.IntFrom(10)
+	chain, app, _ := setup(t, cfg, core.GenesisAlloc{owner.From: {Balance: assets.Ether(1000)}})
+
+	linkAddr, _, linkToken, err := link_token_interface.DeployLinkToken(owner, chain.Backend)
+	require.NoError(t, err)
+	chain.Mine(1)
+
+	jb := job.Job{
+		Type:          job.Cron,
+		SchemaVersion: 1,
+		CronSpec:      &job.CronSpec{CronSchedule: "@every 1s"},
+		PipelineSpec:  &pipeline.Spec{},
+		ExternalJobID: uuid.NewV4(),
+	}
+	require.NoError(t, app.JobORM().CreateJob(&jb))
+
+	lb := evmtest.MustGetDefaultChain(t, app.GetChains().EVM).LogBroadcaster()
+	listener := &approvalListener{lb: lb, jobID: jb.ID}
+	unsubscribe := lb.Register(listener, log.ListenerOpts{
+		Contract: linkAddr,
+		ParseLog: linkToken.ParseLog,
+		LogsWithTopics: map[common.Hash][][]log.Topic{
+			link_token_interface.LinkTokenApproval{}.Topic(): {},
+		},
+		MinIncomingConfirmations: 1,
+	})
+	t.Cleanup(unsubscribe)
+	gomega.NewWithT(t).Eventually(func() uint32 {
+		return lb.(log.BroadcasterInTest).TrackedAddressesCount()
+	}, testutils.WaitTimeout(t), 100*time.Millisecond).Should(gomega.BeNumerically(">=", 1))
+
+	approveTx, err := linkToken.Approve(owner, testutils.NewAddress(), big.NewInt(1))
+	require.NoError(t, err)
+	chain.Mine(1)
+	receipt := cltest.RequireTxSuccessful(t, chain, approveTx.Hash())
+	mineUntil(t, chain, func() bool { return len(listener.blockHashes()) == 1 })
+	assert.Equal(t, []common.Hash{receipt.BlockHash}, listener.blockHashes())
+
+	// the approval is included again in the replacing block at the same
+	// height, and is received again from there
+	depth := int(chain.Backend.Blockchain().CurrentBlock().NumberU64() - receipt.BlockNumber.Uint64() + 1)
+	reorged := chain.Reorg(depth, depth+1, approveTx)
+	require.Len(t, reorged, 1)
+	assert.Equal(t, approveTx.Hash(), reorged[0].Hash())
+	reorgedReceipt := cltest.RequireTxSuccessful(t, chain, approveTx.Hash())
+	require.Equal(t, receipt.BlockNumber, reorgedReceipt.BlockNumber)
+	require.NotEqual(t, receipt.BlockHash, reorgedReceipt.BlockHash)
+
+	mineUntil(t, chain, func() bool { return len(listener.blockHashes()) == 2 })
+	assert.Equal(t, []common.Hash{receipt.BlockHash, reorgedReceipt.BlockHash}, listener.blockHashes())
+}
+
+func TestReorg_EthConfirmer(t *testing.T) {
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalEvmFinalityDepth = null.IntFrom(10)
+	chain, app, key := setup(t, cfg, nil)
+	txm := evmtest.MustGetDefaultChain(t, app.GetChains().EVM).TxManager()
+
+	etx, err := txm.CreateEthTransaction(txmgr.NewTx{
+		FromAddress:    key.Address,
+		ToAddress:      testutils.NewAddress(),
+		EncodedPayload: []byte{},
+		GasLimit:       21000,
+		Strategy:       txmgr.NewSendEveryStrategy(),
+	})
+	require.NoError(t, err)
+	awaitPendingNonce(t, chain, key.Address, 1)
+	mineUntil(t, chain, func() bool { return countEthTxes(t, app, txmgr.EthTxConfirmed) == 1 })
+	before := confirmedIn(t, app, etx.ID)
+
+	// the transaction is reorged out, and must be sent again by the confirmer
+	// to be confirmed on the new canonical chain
+	depth := int(chain.Backend.Blockchain().CurrentBlock().Number().Int64() - before.Number + 1)
+	reorged := chain.Reorg(depth, depth+1)
+	require.Len(t, reorged, 1)
+	mineUntil(t, chain, func() bool {
+		receipt, err := chain.TransactionReceipt(testutils.Context(t), reorged[0].Hash())
+		if err != nil || countEthTxes(t, app, txmgr.EthTxConfirmed) != 1 {
+			return false
+		}
+		return confirmedIn(t, app, etx.ID).Hash == receipt.BlockHash
+	})
+	assert.NotEqual(t, before.Hash, confirmedIn(t, app, etx.ID).Hash)
+}
+
+func TestDelayedReceipts_WebhookEthTx(t *testing.T) {
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.SetTriggerFallbackDBPollInterval(100 * time.Millisecond)
+	chain, app, key := setup(t, cfg, nil)
+
+	tomlSpec := fmt.Sprintf(`
+type            = "webhook"
+schemaVersion   = 1
+observationSource   = """
+	submit_tx  [type=ethtx to="%s"
+            data="0xdeadbeef"
+            minConfirmations="2"
+            from="[\\"%s\\"]"
+			]
+"""
+`, testutils.NewAddress(), key.Address)
+	j := cltest.CreateJobViaWeb(t, app, []byte(cltest.MustJSONMarshal(t, web.CreateJobRequest{TOML: tomlSpec})))
+	cltest.AwaitJobActive(t, app.JobSpawner(), j.ID, testutils.WaitTimeout(t))
+
+	const delay = 3
+	chain.DelayReceipts(delay)
+	cltest.CreateJobRunViaUser(t, app, j.ExternalJobID, "")
+	awaitPendingNonce(t, chain, key.Address, 1)
+	chain.Mine(1)
+	minedIn := chain.Backend.Blockchain().CurrentBlock().NumberU64()
+
+	// the transaction is mined, but its receipt is not returned yet
+	chain.Mine(delay - 1)
+	gomega.NewWithT(t).Consistently(func() int {
+		return countEthTxes(t, app, txmgr.EthTxUnconfirmed)
+	}, 2*time.Second, 100*time.Millisecond).Should(gomega.Equal(1))
+	cltest.AssertCount(t, app.GetSqlxDB(), "eth_receipts", 0)
+
+	mineUntil(t, chain, func() bool {
+		var completed int
+		require.NoError(t, app.GetSqlxDB().Get(&completed, `SELECT count(*) FROM pipeline_runs WHERE state = $1`, pipeline.RunStatusCompleted))
+		return completed == 1
+	})
+	runs := cltest.WaitForPipelineComplete(t, 0, j.ID, 1, 1, app.JobORM(), testutils.WaitTimeout(t), 100*time.Millisecond)
+	cltest.AssertPipelineTaskRunsSuccessful(t, runs[0].PipelineTaskRuns)
+	outputs := runs[0].Outputs.Val.([]interface{})
+	require.Len(t, outputs, 1)
+	receipt := outputs[0].(map[string]interface{})
+	assert.Equal(t, hexutil.EncodeUint64(minedIn), receipt["blockNumber"])
+}
+
+func TestNonceGap_EthResender(t *testing.T) {
+	cfg := cltest.NewTestGeneralConfig(t)
+	resendAfter := time.Second
+	cfg.Overrides.GlobalEthTxResendAfterThreshold = &resendAfter
+	chain, app, key := setup(t, cfg, nil)
+	txm := evmtest.MustGetDefaultChain(t, app.GetChains().EVM).TxManager()
+
+	// the first transaction is lost, so that the next one is queued behind
+	// the nonce gap
+	chain.DropNextTransactions(1)
+	for i := 0; i < 2; i++ {
+		_, err := txm.CreateEthTransaction(txmgr.NewTx{
+			FromAddress:    key.Address,
+			ToAddress:      testutils.NewAddress(),
+			EncodedPayload: []byte{},
+			GasLimit:       21000,
+			Strategy:       txmgr.NewSendEveryStrategy(),
+		})
+		require.NoError(t, err)
+	}
+	require.Eventually(t, func() bool {
+		return countEthTxes(t, app, txmgr.EthTxUnconfirmed) == 2
+	}, testutils.WaitTimeout(t), 100*time.Millisecond)
+	dropped := chain.Dropped()
+	require.Len(t, dropped, 1)
+	assert.Equal(t, uint64(0), dropped[0].Nonce())
+
+	// the resender sends the lost transaction again, which fills the gap
+	mineUntil(t, chain, func() bool { return countEthTxes(t, app, txmgr.EthTxConfirmed) == 2 })
+	nonce, err := chain.Backend.NonceAt(testutils.Context(t), key.Address, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), nonce)
+}
@@ -65,6 +65,10 @@ type Logger interface {
 
 	// SetLogLevel changes the log level for this and all connected Loggers.
 	SetLogLevel(zapcore.Level)
+	// SetPackageLogLevels replaces the log levels overriding the global level
+	// for the given packages, e.g. core/services/pipeline, and their
+	// subpackages, for this and all connected Loggers.
+	SetPackageLogLevels(map[string]zapcore.Level)
 
 	Trace(args ...interface{})
 	Debug(args ...interface{})
@@ -204,6 +204,11 @@ func (_m *MockLogger) SetLogLevel(_a0 zapcore.Level) {
 	_m.Called(_a0)
 }
 
+// SetPackageLogLevels provides a mock function with given fields: _a0
+func (_m *MockLogger) SetPackageLogLevels(_a0 map[string]zapcore.Level) {
+	_m.Called(_a0)
+}
+
 // Sync provides a mock function with given fields:
 func (_m *MockLogger) Sync() error {
 	ret := _m.Called()
@@ -11,9 +11,10 @@ var NullLogger Logger = &nullLogger{}
 
 type nullLogger struct{}
 
-func (l *nullLogger) With(args ...interface{}) Logger { return l }
-func (l *nullLogger) Named(name string) Logger        { return l }
-func (l *nullLogger) SetLogLevel(_ zapcore.Level)     {}
+func (l *nullLogger) With(args ...interface{}) Logger                { return l }
+func (l *nullLogger) Named(name string) Logger                       { return l }
+func (l *nullLogger) SetLogLevel(_ zapcore.Level)                    {}
+func (l *nullLogger) SetPackageLogLevels(_ map[string]zapcore.Level) {}
 
 func (l *nullLogger) Trace(args ...interface{})    {}
 func (l *nullLogger) Debug(args ...interface{})    {}
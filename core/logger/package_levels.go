@@ -0,0 +1,113 @@
+package logger
+
+import (
+	"path"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// packageLevels holds the log levels overriding the global level for the
+// packages logging, keyed by package path, e.g. core/services/pipeline.
+// An override applies to the subpackages too, unless they have their own.
+type packageLevels struct {
+	global zap.AtomicLevel
+
+	mu     sync.RWMutex
+	levels map[string]zapcore.Level
+	min    zapcore.Level // lowest override
+}
+
+func newPackageLevels(global zap.AtomicLevel) *packageLevels {
+	return &packageLevels{global: global}
+}
+
+func (p *packageLevels) set(levels map[string]zapcore.Level) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.levels = make(map[string]zapcore.Level, len(levels))
+	p.min = zapcore.FatalLevel
+	for pkg, lvl := range levels {
+		p.levels[strings.Trim(pkg, "/")] = lvl
+		if lvl < p.min {
+			p.min = lvl
+		}
+	}
+}
+
+func (p *packageLevels) hasOverrides() bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return len(p.levels) > 0
+}
+
+// Enabled returns true if lvl is enabled globally or for any package.
+func (p *packageLevels) Enabled(lvl zapcore.Level) bool {
+	if p.global.Enabled(lvl) {
+		return true
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return len(p.levels) > 0 && lvl >= p.min
+}
+
+// enabledAt returns true if lvl is enabled for the package of file.
+func (p *packageLevels) enabledAt(file string, lvl zapcore.Level) bool {
+	dir := path.Dir(file) + "/"
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	var match string
+	for pkg := range p.levels {
+		if len(pkg) > len(match) && (strings.HasPrefix(dir, pkg+"/") || strings.Contains(dir, "/"+pkg+"/")) {
+			match = pkg
+		}
+	}
+	if match != "" {
+		return lvl >= p.levels[match]
+	}
+	return p.global.Enabled(lvl)
+}
+
+var _ zapcore.Core = &packageLevelCore{}
+
+// packageLevelCore filters the entries of a Core by the level of the package
+// they are logged from. The package is only known once the caller is added to
+// the entry, so when there are overrides the entries are filtered on Write.
+type packageLevelCore struct {
+	zapcore.Core
+	levels *packageLevels
+}
+
+func (c *packageLevelCore) Enabled(lvl zapcore.Level) bool {
+	return c.levels.Enabled(lvl)
+}
+
+func (c *packageLevelCore) With(fields []zapcore.Field) zapcore.Core {
+	return &packageLevelCore{c.Core.With(fields), c.levels}
+}
+
+func (c *packageLevelCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if !c.levels.hasOverrides() {
+		if !c.levels.global.Enabled(ent.Level) {
+			return ce
+		}
+		return c.Core.Check(ent, ce)
+	}
+	if !c.levels.Enabled(ent.Level) {
+		return ce
+	}
+	return ce.AddCore(ent, c)
+}
+
+func (c *packageLevelCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	if ent.Caller.Defined {
+		if !c.levels.enabledAt(ent.Caller.File, ent.Level) {
+			return nil
+		}
+	} else if !c.levels.global.Enabled(ent.Level) {
+		return nil
+	}
+	return c.Core.Write(ent, fields)
+}
@@ -0,0 +1,53 @@
+package logger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestPackageLevelCore(t *testing.T) {
+	t.Parallel()
+
+	obsCore, observed := observer.New(zapcore.DebugLevel)
+	levels := newPackageLevels(zap.NewAtomicLevelAt(zapcore.InfoLevel))
+	lggr := &zapLogger{
+		level:         levels.global,
+		pkgLevels:     levels,
+		SugaredLogger: zap.New(&packageLevelCore{obsCore, levels}, zap.AddCaller()).Sugar(),
+	}
+
+	logAll := func() []zapcore.Level {
+		observed.TakeAll()
+		lggr.Debug("debug")
+		lggr.Info("info")
+		lggr.Warn("warn")
+		var lvls []zapcore.Level
+		for _, e := range observed.TakeAll() {
+			lvls = append(lvls, e.Level)
+		}
+		return lvls
+	}
+
+	assert.Equal(t, []zapcore.Level{zapcore.InfoLevel, zapcore.WarnLevel}, logAll())
+
+	lggr.SetPackageLogLevels(map[string]zapcore.Level{"core/logger": zapcore.DebugLevel})
+	assert.Equal(t, []zapcore.Level{zapcore.DebugLevel, zapcore.InfoLevel, zapcore.WarnLevel}, logAll())
+
+	lggr.SetPackageLogLevels(map[string]zapcore.Level{"core": zapcore.WarnLevel})
+	assert.Equal(t, []zapcore.Level{zapcore.WarnLevel}, logAll())
+
+	// the most specific package wins
+	lggr.SetPackageLogLevels(map[string]zapcore.Level{"core": zapcore.DebugLevel, "core/logger/": zapcore.WarnLevel})
+	assert.Equal(t, []zapcore.Level{zapcore.WarnLevel}, logAll())
+
+	lggr.SetPackageLogLevels(map[string]zapcore.Level{"core/services": zapcore.DebugLevel})
+	assert.Equal(t, []zapcore.Level{zapcore.InfoLevel, zapcore.WarnLevel}, logAll())
+
+	lggr.SetPackageLogLevels(nil)
+	lggr.SetLogLevel(zapcore.WarnLevel)
+	assert.Equal(t, []zapcore.Level{zapcore.WarnLevel}, logAll())
+}
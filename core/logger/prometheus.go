@@ -86,6 +86,10 @@ func (s *prometheusLogger) SetLogLevel(level zapcore.Level) {
 	s.h.SetLogLevel(level)
 }
 
+func (s *prometheusLogger) SetPackageLogLevels(levels map[string]zapcore.Level) {
+	s.h.SetPackageLogLevels(levels)
+}
+
 func (s *prometheusLogger) Trace(args ...interface{}) {
 	s.h.Trace(args...)
 }
@@ -87,6 +87,10 @@ func (s *sentryLogger) SetLogLevel(level zapcore.Level) {
 	s.h.SetLogLevel(level)
 }
 
+func (s *sentryLogger) SetPackageLogLevels(levels map[string]zapcore.Level) {
+	s.h.SetPackageLogLevels(levels)
+}
+
 func (s *sentryLogger) Trace(args ...interface{}) {
 	s.h.Trace(args...)
 }
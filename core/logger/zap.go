@@ -15,6 +15,7 @@ var _ Logger = &zapLogger{}
 type zapLogger struct {
 	*zap.SugaredLogger
 	level      zap.AtomicLevel
+	pkgLevels  *packageLevels
 	name       string
 	fields     []interface{}
 	callerSkip int
@@ -36,6 +37,12 @@ func (l *zapLogger) SetLogLevel(lvl zapcore.Level) {
 	l.level.SetLevel(lvl)
 }
 
+func (l *zapLogger) SetPackageLogLevels(levels map[string]zapcore.Level) {
+	if l.pkgLevels != nil {
+		l.pkgLevels.set(levels)
+	}
+}
+
 func (l *zapLogger) With(args ...interface{}) Logger {
 	newLogger := *l
 	newLogger.SugaredLogger = l.SugaredLogger.With(args...)
@@ -76,7 +76,8 @@ type zapDiskLogger struct {
 }
 
 func (cfg zapDiskLoggerConfig) newLogger(zcfg zap.Config, cores ...zapcore.Core) (Logger, func() error, error) {
-	newCore, errWriter, err := cfg.newCore(zcfg)
+	pkgLevels := newPackageLevels(zcfg.Level)
+	newCore, errWriter, err := cfg.newCore(zcfg, pkgLevels)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -97,6 +98,7 @@ func (cfg zapDiskLoggerConfig) newLogger(zcfg zap.Config, cores ...zapcore.Core)
 		pollDiskSpaceDone: make(chan struct{}),
 		zapLogger: zapLogger{
 			level:         zcfg.Level,
+			pkgLevels:     pkgLevels,
 			SugaredLogger: zap.New(core, zap.ErrorOutput(errWriter), zap.AddCaller(), zap.AddStacktrace(zapcore.ErrorLevel)).Sugar(),
 		},
 		diskLogLevel: diskLogLevel,
@@ -121,7 +123,7 @@ func (cfg zapDiskLoggerConfig) newLogger(zcfg zap.Config, cores ...zapcore.Core)
 	return lggr, closeLogger, err
 }
 
-func (cfg zapDiskLoggerConfig) newCore(zcfg zap.Config) (zapcore.Core, zapcore.WriteSyncer, error) {
+func (cfg zapDiskLoggerConfig) newCore(zcfg zap.Config, pkgLevels *packageLevels) (zapcore.Core, zapcore.WriteSyncer, error) {
 	encoder := zapcore.NewJSONEncoder(makeEncoderConfig(cfg.local))
 
 	sink, closeOut, err := zap.Open(zcfg.OutputPaths...)
@@ -139,9 +141,9 @@ func (cfg zapDiskLoggerConfig) newCore(zcfg zap.Config) (zapcore.Core, zapcore.W
 		return nil, nil, errors.New("missing Level")
 	}
 
-	filteredLogLevels := zap.LevelEnablerFunc(zcfg.Level.Enabled)
+	filteredLogLevels := zap.LevelEnablerFunc(pkgLevels.Enabled)
 
-	return zapcore.NewCore(encoder, sink, filteredLogLevels), errSink, nil
+	return &packageLevelCore{zapcore.NewCore(encoder, sink, filteredLogLevels), pkgLevels}, errSink, nil
 }
 
 func (l *zapDiskLogger) pollDiskSpace() {
@@ -0,0 +1,161 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// AWSKMSProvider decrypts secrets with AWS KMS. References are base64
+// encoded ciphertexts, as returned by `aws kms encrypt`. Credentials are
+// read from the standard AWS env vars.
+type AWSKMSProvider struct {
+	// Endpoint defaults to the regional KMS endpoint
+	Endpoint        string
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	Client          *http.Client
+}
+
+var _ Provider = (*AWSKMSProvider)(nil)
+
+func newAWSKMSProviderFromEnv() (*AWSKMSProvider, error) {
+	region := os.Getenv("AWS_REGION")
+	if region == "" {
+		region = os.Getenv("AWS_DEFAULT_REGION")
+	}
+	p := &AWSKMSProvider{
+		Region:          region,
+		AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		Client:          &http.Client{Timeout: fetchTimeout},
+	}
+	if p.Region == "" || p.AccessKeyID == "" || p.SecretAccessKey == "" {
+		return nil, errors.New("AWS_REGION, AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY must be set to use aws-kms")
+	}
+	return p, nil
+}
+
+func (p *AWSKMSProvider) Fetch(ctx context.Context, ref string) (string, error) {
+	if _, err := base64.StdEncoding.DecodeString(ref); err != nil {
+		return "", errors.Wrap(err, "invalid aws-kms reference, must be a base64 ciphertext")
+	}
+	body, err := json.Marshal(map[string]string{"CiphertextBlob": ref})
+	if err != nil {
+		return "", err
+	}
+	endpoint := p.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://kms.%s.amazonaws.com/", p.Region)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "TrentService.Decrypt")
+	p.sign(req, body, time.Now().UTC())
+
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, "aws kms request failed")
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return "", err
+	}
+	var decrypted struct {
+		Plaintext string `json:"Plaintext"`
+		Message   string `json:"message"`
+	}
+	_ = json.Unmarshal(respBody, &decrypted)
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("aws kms responded with status %d: %s", resp.StatusCode, decrypted.Message)
+	}
+	plaintext, err := base64.StdEncoding.DecodeString(decrypted.Plaintext)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid aws kms response")
+	}
+	return string(plaintext), nil
+}
+
+// sign adds an AWS Signature Version 4 to req
+func (p *AWSKMSProvider) sign(req *http.Request, body []byte, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	req.Header.Set("X-Amz-Date", amzDate)
+	if p.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", p.SessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		headers[strings.ToLower(k)] = strings.TrimSpace(strings.Join(v, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, k := range names {
+		canonicalHeaders.WriteString(k + ":" + headers[k] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		sha256Hex(body),
+	}, "\n")
+
+	scope := date + "/" + p.Region + "/kms/aws4_request"
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+p.SecretAccessKey), date)
+	for _, s := range []string{p.Region, "kms", "aws4_request"} {
+		key = hmacSHA256(key, s)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s", p.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func canonicalQuery(values url.Values) string {
+	return strings.ReplaceAll(values.Encode(), "+", "%20")
+}
+
+func sha256Hex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
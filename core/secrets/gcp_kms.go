@@ -0,0 +1,113 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// GCPKMSKeyEnv names the crypto key used to decrypt, in the format
+	// projects/<project>/locations/<location>/keyRings/<ring>/cryptoKeys/<key>
+	GCPKMSKeyEnv = "GCP_KMS_KEY"
+	// GCPAccessTokenEnv optionally holds an OAuth access token. Otherwise the
+	// token of the instance's service account is fetched from the metadata
+	// server.
+	GCPAccessTokenEnv = "GCP_ACCESS_TOKEN"
+
+	defaultGCPKMSEndpoint = "https://cloudkms.googleapis.com"
+	gcpMetadataTokenURL   = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token"
+)
+
+// GCPKMSProvider decrypts secrets with Google Cloud KMS. References are base64
+// encoded ciphertexts, as returned by `gcloud kms encrypt`.
+type GCPKMSProvider struct {
+	Endpoint    string
+	KeyName     string
+	AccessToken string
+	Client      *http.Client
+}
+
+var _ Provider = (*GCPKMSProvider)(nil)
+
+func newGCPKMSProviderFromEnv() (*GCPKMSProvider, error) {
+	keyName := os.Getenv(GCPKMSKeyEnv)
+	if keyName == "" {
+		return nil, errors.Errorf("%s must be set to use gcp-kms", GCPKMSKeyEnv)
+	}
+	return &GCPKMSProvider{defaultGCPKMSEndpoint, keyName, os.Getenv(GCPAccessTokenEnv), &http.Client{Timeout: fetchTimeout}}, nil
+}
+
+func (p *GCPKMSProvider) Fetch(ctx context.Context, ref string) (string, error) {
+	if _, err := base64.StdEncoding.DecodeString(ref); err != nil {
+		return "", errors.Wrap(err, "invalid gcp-kms reference, must be a base64 ciphertext")
+	}
+	token, err := p.accessToken(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get gcp access token")
+	}
+	body, err := json.Marshal(map[string]string{"ciphertext": ref})
+	if err != nil {
+		return "", err
+	}
+	u := strings.TrimSuffix(p.Endpoint, "/") + "/v1/" + p.KeyName + ":decrypt"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	var decrypted struct {
+		Plaintext string `json:"plaintext"`
+	}
+	if err = p.do(req, &decrypted); err != nil {
+		return "", errors.Wrap(err, "gcp kms request failed")
+	}
+	plaintext, err := base64.StdEncoding.DecodeString(decrypted.Plaintext)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid gcp kms response")
+	}
+	return string(plaintext), nil
+}
+
+func (p *GCPKMSProvider) accessToken(ctx context.Context) (string, error) {
+	if p.AccessToken != "" {
+		return p.AccessToken, nil
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, gcpMetadataTokenURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+	var token struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err = p.do(req, &token); err != nil {
+		return "", err
+	}
+	return token.AccessToken, nil
+}
+
+func (p *GCPKMSProvider) do(req *http.Request, v interface{}) error {
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("responded with status %d: %s", resp.StatusCode, body)
+	}
+	return json.Unmarshal(body, v)
+}
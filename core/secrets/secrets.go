@@ -0,0 +1,80 @@
+// Package secrets fetches node credentials from an external secret store at
+// boot, so they do not have to be kept in plaintext env vars or files.
+package secrets
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// ProviderEnv names the secret store, one of vault, aws-kms or gcp-kms
+	ProviderEnv = "SECRETS_PROVIDER"
+	// DatabaseURLEnv holds the reference of the DATABASE_URL secret
+	DatabaseURLEnv = "DATABASE_URL_SECRET"
+	// KeystorePasswordEnv holds the reference of the keystore password secret
+	KeystorePasswordEnv = "KEYSTORE_PASSWORD_SECRET"
+	// TLSKeyEnv holds the reference of the PEM encoded TLS private key secret
+	TLSKeyEnv = "TLS_KEY_SECRET"
+)
+
+const (
+	fetchTimeout    = 30 * time.Second
+	maxResponseSize = 1 << 20
+)
+
+// Provider fetches secrets by reference. The format of a reference depends
+// on the provider.
+type Provider interface {
+	Fetch(ctx context.Context, ref string) (string, error)
+}
+
+// Credentials are the node credentials fetched at boot. Credentials without
+// a reference are left empty.
+type Credentials struct {
+	DatabaseURL      string
+	KeystorePassword string
+	TLSKey           string
+}
+
+// NewProviderFromEnv returns the provider named by SECRETS_PROVIDER, or nil
+// if it is not set.
+func NewProviderFromEnv() (Provider, error) {
+	switch name := os.Getenv(ProviderEnv); name {
+	case "":
+		return nil, nil
+	case "vault":
+		return newVaultProviderFromEnv()
+	case "aws-kms":
+		return newAWSKMSProviderFromEnv()
+	case "gcp-kms":
+		return newGCPKMSProviderFromEnv()
+	default:
+		return nil, errors.Errorf("invalid %s %q, must be vault, aws-kms or gcp-kms", ProviderEnv, name)
+	}
+}
+
+// FetchCredentials fetches the credentials whose references are set in the
+// env. A nil provider fetches nothing.
+func FetchCredentials(ctx context.Context, p Provider) (creds Credentials, err error) {
+	if p == nil {
+		return
+	}
+	for env, dst := range map[string]*string{
+		DatabaseURLEnv:      &creds.DatabaseURL,
+		KeystorePasswordEnv: &creds.KeystorePassword,
+		TLSKeyEnv:           &creds.TLSKey,
+	} {
+		ref := os.Getenv(env)
+		if ref == "" {
+			continue
+		}
+		if *dst, err = p.Fetch(ctx, ref); err != nil {
+			return Credentials{}, errors.Wrapf(err, "failed to fetch %s", env)
+		}
+	}
+	return
+}
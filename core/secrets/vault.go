@@ -0,0 +1,94 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// VaultAddrEnv and VaultTokenEnv are the standard Vault env vars
+	VaultAddrEnv  = "VAULT_ADDR"
+	VaultTokenEnv = "VAULT_TOKEN"
+
+	defaultVaultAddr = "http://127.0.0.1:8200"
+)
+
+// VaultProvider reads secrets from a Vault KV secrets engine (version 1 or
+// 2). References have the format <path>#<field>, e.g.
+// secret/data/chainlink#database_url.
+type VaultProvider struct {
+	Addr   string
+	Token  string
+	Client *http.Client
+}
+
+var _ Provider = (*VaultProvider)(nil)
+
+func newVaultProviderFromEnv() (*VaultProvider, error) {
+	token := os.Getenv(VaultTokenEnv)
+	if token == "" {
+		return nil, errors.Errorf("%s must be set to use vault", VaultTokenEnv)
+	}
+	addr := os.Getenv(VaultAddrEnv)
+	if addr == "" {
+		addr = defaultVaultAddr
+	}
+	return &VaultProvider{addr, token, &http.Client{Timeout: fetchTimeout}}, nil
+}
+
+type vaultResponse struct {
+	Data   map[string]json.RawMessage `json:"data"`
+	Errors []string                   `json:"errors"`
+}
+
+func (p *VaultProvider) Fetch(ctx context.Context, ref string) (string, error) {
+	path, field, ok := strings.Cut(ref, "#")
+	if !ok || path == "" || field == "" {
+		return "", errors.Errorf("invalid vault reference %q, must be <path>#<field>", ref)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(p.Addr, "/")+"/v1/"+strings.TrimPrefix(path, "/"), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", p.Token)
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, "vault request failed")
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return "", err
+	}
+	var vr vaultResponse
+	if err = json.Unmarshal(body, &vr); err != nil && resp.StatusCode == http.StatusOK {
+		return "", errors.Wrap(err, "invalid vault response")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("vault responded with status %d: %s", resp.StatusCode, strings.Join(vr.Errors, ", "))
+	}
+
+	data := vr.Data
+	// KV version 2 nests the secret's fields under data.data
+	if nested, ok := data["data"]; ok {
+		if err = json.Unmarshal(nested, &data); err != nil {
+			return "", errors.Wrap(err, "invalid vault response")
+		}
+	}
+	raw, ok := data[field]
+	if !ok {
+		return "", errors.Errorf("vault secret %s has no field %s", path, field)
+	}
+	var value string
+	if err = json.Unmarshal(raw, &value); err != nil {
+		return "", errors.Errorf("vault secret %s field %s is not a string", path, field)
+	}
+	return value, nil
+}
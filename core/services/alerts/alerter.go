@@ -0,0 +1,279 @@
+package alerts
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/exp/slices"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const notifyTimeout = 10 * time.Second
+
+var (
+	promAlertsFiring = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "alerts_firing",
+		Help: "The number of alerts currently firing for the given rule",
+	}, []string{"rule"})
+	promFailedNotifications = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "alerts_failed_notifications",
+		Help: "The number of alert notifications that could not be sent to the given webhook",
+	}, []string{"notifier"})
+)
+
+type Severity string
+
+const (
+	SeverityCritical Severity = "critical"
+	SeverityWarning  Severity = "warning"
+)
+
+// Alert is raised by a Rule
+type Alert struct {
+	// Key identifies the alert across evaluations, e.g. job_errors/42
+	Key      string
+	Rule     string
+	Severity Severity
+	Summary  string
+	Details  map[string]string
+	FiredAt  time.Time
+}
+
+// Rule is a condition evaluated periodically by the Alerter
+type Rule interface {
+	Name() string
+	// Evaluate returns the alerts currently firing
+	Evaluate(ctx context.Context) ([]Alert, error)
+}
+
+// Notifier sends alerts to a webhook
+type Notifier interface {
+	Name() string
+	// Notify sends a, as firing or as resolved
+	Notify(ctx context.Context, a Alert, resolved bool) error
+}
+
+// Alerter evaluates the rules continuously and notifies when an alert starts
+// firing and when it is resolved. An alert still firing is not sent again, also
+// across restarts, and the notifications which failed are retried on each
+// evaluation until they are sent.
+type Alerter interface {
+	services.ServiceCtx
+	// Firing returns the alerts currently firing, ordered by key
+	Firing() []Alert
+}
+
+type alerter struct {
+	utils.StartStopOnce
+	rules     []Rule
+	notifiers []Notifier
+	interval  time.Duration
+	orm       ORM
+	lggr      logger.Logger
+
+	// alerts are firing, or resolved with pending notifications
+	alertsMu sync.RWMutex
+	alerts   map[string]AlertState
+
+	chStop chan struct{}
+	wgDone sync.WaitGroup
+}
+
+var _ Alerter = (*alerter)(nil)
+
+// NewAlerter returns an Alerter evaluating rules every interval
+func NewAlerter(rules []Rule, notifiers []Notifier, interval time.Duration, orm ORM, lggr logger.Logger) Alerter {
+	return &alerter{
+		rules:     rules,
+		notifiers: notifiers,
+		interval:  interval,
+		orm:       orm,
+		lggr:      lggr.Named("Alerter"),
+		alerts:    make(map[string]AlertState),
+		chStop:    make(chan struct{}),
+	}
+}
+
+func (a *alerter) Start(ctx context.Context) error {
+	return a.StartOnce("Alerter", func() error {
+		states, err := a.orm.FindAlerts(pg.WithParentCtx(ctx))
+		if err != nil {
+			return errors.Wrap(err, "failed to load the alerts")
+		}
+		for _, s := range states {
+			a.alerts[s.Key] = s
+		}
+		a.wgDone.Add(1)
+		go a.run()
+		return nil
+	})
+}
+
+func (a *alerter) Close() error {
+	return a.StopOnce("Alerter", func() error {
+		close(a.chStop)
+		a.wgDone.Wait()
+		return nil
+	})
+}
+
+func (a *alerter) Firing() []Alert {
+	a.alertsMu.RLock()
+	alerts := make([]Alert, 0, len(a.alerts))
+	for _, s := range a.alerts {
+		if !s.Resolved {
+			alerts = append(alerts, s.Alert)
+		}
+	}
+	a.alertsMu.RUnlock()
+	sort.Slice(alerts, func(i, j int) bool { return alerts[i].Key < alerts[j].Key })
+	return alerts
+}
+
+func (a *alerter) run() {
+	defer a.wgDone.Done()
+	ctx, cancel := utils.ContextFromChan(a.chStop)
+	defer cancel()
+
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+	for {
+		a.evaluate(ctx)
+		a.notify(ctx)
+		select {
+		case <-a.chStop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// evaluate runs every rule and saves the changes since the last evaluation,
+// to be sent to every notifier. The alerts of a rule failing to evaluate are
+// left as they are.
+func (a *alerter) evaluate(ctx context.Context) {
+	current := make(map[string]Alert)
+	failed := make(map[string]bool)
+	for _, r := range a.rules {
+		alerts, err := r.Evaluate(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			a.lggr.Errorw("Failed to evaluate alert rule", "rule", r.Name(), "err", err)
+			failed[r.Name()] = true
+			continue
+		}
+		for _, alert := range alerts {
+			alert.Rule = r.Name()
+			current[alert.Key] = alert
+		}
+	}
+
+	var changed []AlertState
+	for key, alert := range current {
+		if s, ok := a.alerts[key]; !ok || s.Resolved {
+			alert.FiredAt = time.Now()
+			a.lggr.Warnw("Alert firing", "key", key, "summary", alert.Summary, "details", alert.Details)
+			changed = append(changed, AlertState{Alert: alert, PendingNotifiers: a.notifierNames()})
+		}
+	}
+	for key, s := range a.alerts {
+		if _, ok := current[key]; !ok && !s.Resolved && !failed[s.Rule] {
+			a.lggr.Infow("Alert resolved", "key", key, "summary", s.Summary)
+			changed = append(changed, AlertState{Alert: s.Alert, Resolved: true, PendingNotifiers: a.notifierNames()})
+		}
+	}
+	for _, s := range changed {
+		a.save(ctx, s)
+	}
+
+	counts := make(map[string]int)
+	a.alertsMu.RLock()
+	for _, s := range a.alerts {
+		if !s.Resolved {
+			counts[s.Rule]++
+		}
+	}
+	a.alertsMu.RUnlock()
+	for _, r := range a.rules {
+		promAlertsFiring.WithLabelValues(r.Name()).Set(float64(counts[r.Name()]))
+	}
+}
+
+// notify sends the alerts to the notifiers which were not sent them yet. The
+// resolved alerts are deleted once sent to every notifier.
+func (a *alerter) notify(ctx context.Context) {
+	a.alertsMu.RLock()
+	var pending []AlertState
+	for _, s := range a.alerts {
+		if len(s.PendingNotifiers) > 0 {
+			pending = append(pending, s)
+		}
+	}
+	a.alertsMu.RUnlock()
+	sort.Slice(pending, func(i, j int) bool { return pending[i].Key < pending[j].Key })
+
+	for _, s := range pending {
+		var failed []string
+		for _, notifier := range a.notifiers {
+			if !slices.Contains(s.PendingNotifiers, notifier.Name()) {
+				continue
+			}
+			err := func() error {
+				ctx, cancel := context.WithTimeout(ctx, notifyTimeout)
+				defer cancel()
+				return notifier.Notify(ctx, s.Alert, s.Resolved)
+			}()
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				promFailedNotifications.WithLabelValues(notifier.Name()).Inc()
+				a.lggr.Errorw("Failed to send alert, it will be retried", "notifier", notifier.Name(), "key", s.Key, "resolved", s.Resolved, "err", err)
+				failed = append(failed, notifier.Name())
+			}
+		}
+		s.PendingNotifiers = failed
+		a.save(ctx, s)
+	}
+}
+
+// save persists the state of an alert, and keeps it in memory even if the
+// database can't be written to
+func (a *alerter) save(ctx context.Context, s AlertState) {
+	var err error
+	if s.Resolved && len(s.PendingNotifiers) == 0 {
+		err = a.orm.DeleteAlert(s.Key, pg.WithParentCtx(ctx))
+	} else {
+		err = a.orm.UpsertAlert(s, pg.WithParentCtx(ctx))
+	}
+	if err != nil && ctx.Err() == nil {
+		a.lggr.Errorw("Failed to save alert", "key", s.Key, "err", err)
+	}
+
+	a.alertsMu.Lock()
+	defer a.alertsMu.Unlock()
+	if s.Resolved && len(s.PendingNotifiers) == 0 {
+		delete(a.alerts, s.Key)
+	} else {
+		a.alerts[s.Key] = s
+	}
+}
+
+func (a *alerter) notifierNames() []string {
+	names := make([]string, len(a.notifiers))
+	for i, n := range a.notifiers {
+		names[i] = n.Name()
+	}
+	return names
+}
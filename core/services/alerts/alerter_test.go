@@ -0,0 +1,174 @@
+package alerts_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
+)
+
+type fakeRule struct {
+	mu     sync.Mutex
+	alerts []alerts.Alert
+	err    error
+}
+
+func (r *fakeRule) Name() string { return "fake" }
+
+func (r *fakeRule) Evaluate(context.Context) ([]alerts.Alert, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.alerts, r.err
+}
+
+func (r *fakeRule) set(err error, keys ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.err = err
+	r.alerts = nil
+	for _, k := range keys {
+		r.alerts = append(r.alerts, alerts.Alert{Key: k, Severity: alerts.SeverityCritical, Summary: k + " is broken"})
+	}
+}
+
+type notification struct {
+	key      string
+	resolved bool
+}
+
+type fakeNotifier struct {
+	ch   chan notification
+	fail atomic.Bool
+}
+
+func newFakeNotifier() *fakeNotifier {
+	return &fakeNotifier{ch: make(chan notification, 10)}
+}
+
+func (n *fakeNotifier) Name() string { return "fake" }
+
+func (n *fakeNotifier) Notify(_ context.Context, a alerts.Alert, resolved bool) error {
+	if n.fail.Load() {
+		return errors.New("webhook is down")
+	}
+	n.ch <- notification{a.Key, resolved}
+	return nil
+}
+
+func (n *fakeNotifier) next(t *testing.T) notification {
+	select {
+	case got := <-n.ch:
+		return got
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for notification")
+	}
+	return notification{}
+}
+
+func (n *fakeNotifier) assertNone(t *testing.T) {
+	select {
+	case got := <-n.ch:
+		t.Fatalf("unexpected notification %v", got)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func newAlerter(t *testing.T, orm alerts.ORM, rule alerts.Rule, notifier alerts.Notifier) alerts.Alerter {
+	alerter := alerts.NewAlerter([]alerts.Rule{rule}, []alerts.Notifier{notifier}, 10*time.Millisecond, orm, logger.TestLogger(t))
+	require.NoError(t, alerter.Start(testutils.Context(t)))
+	return alerter
+}
+
+func TestAlerter(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	orm := alerts.NewORM(db, logger.TestLogger(t), cltest.NewTestGeneralConfig(t))
+	rule := &fakeRule{}
+	rule.set(nil, "a")
+	notifier := newFakeNotifier()
+	alerter := newAlerter(t, orm, rule, notifier)
+	t.Cleanup(func() { assert.NoError(t, alerter.Close()) })
+	next := func() notification { return notifier.next(t) }
+
+	assert.Equal(t, notification{"a", false}, next())
+	rule.set(nil, "a", "b")
+	assert.Equal(t, notification{"b", false}, next())
+
+	firing := alerter.Firing()
+	require.Len(t, firing, 2)
+	assert.Equal(t, "fake", firing[0].Rule)
+	assert.False(t, firing[0].FiredAt.IsZero())
+
+	// the alerts of a failing rule are not resolved
+	rule.set(errors.New("boom"))
+	time.Sleep(50 * time.Millisecond)
+	assert.Len(t, alerter.Firing(), 2)
+
+	rule.set(nil, "b")
+	assert.Equal(t, notification{"a", true}, next())
+	rule.set(nil)
+	assert.Equal(t, notification{"b", true}, next())
+	assert.Empty(t, alerter.Firing())
+
+	// alerts still firing are not sent again
+	notifier.assertNone(t)
+}
+
+func TestAlerter_Restart(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	orm := alerts.NewORM(db, logger.TestLogger(t), cltest.NewTestGeneralConfig(t))
+	rule := &fakeRule{}
+	rule.set(nil, "a", "b")
+	notifier := newFakeNotifier()
+	alerter := newAlerter(t, orm, rule, notifier)
+	notifier.next(t)
+	notifier.next(t)
+	require.NoError(t, alerter.Close())
+
+	// the alerts firing before the restart are not sent again, the ones
+	// resolved meanwhile are
+	rule.set(nil, "a")
+	alerter = newAlerter(t, orm, rule, notifier)
+	t.Cleanup(func() { assert.NoError(t, alerter.Close()) })
+	assert.Equal(t, notification{"b", true}, notifier.next(t))
+	notifier.assertNone(t)
+	require.Len(t, alerter.Firing(), 1)
+	assert.Equal(t, "a", alerter.Firing()[0].Key)
+}
+
+func TestAlerter_RetriesFailedNotifications(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	orm := alerts.NewORM(db, logger.TestLogger(t), cltest.NewTestGeneralConfig(t))
+	rule := &fakeRule{}
+	rule.set(nil, "a")
+	notifier := newFakeNotifier()
+	notifier.fail.Store(true)
+	alerter := newAlerter(t, orm, rule, notifier)
+	t.Cleanup(func() { assert.NoError(t, alerter.Close()) })
+
+	time.Sleep(50 * time.Millisecond)
+	states, err := orm.FindAlerts()
+	require.NoError(t, err)
+	require.Len(t, states, 1)
+	assert.Equal(t, []string{"fake"}, states[0].PendingNotifiers)
+
+	notifier.fail.Store(false)
+	assert.Equal(t, notification{"a", false}, notifier.next(t))
+	notifier.assertNone(t)
+}
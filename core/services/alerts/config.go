@@ -0,0 +1,58 @@
+package alerts
+
+import (
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// Config configures the rules and the webhooks alerts are sent to
+type Config interface {
+	pg.LogConfig
+	AlertsCheckInterval() time.Duration
+	AlertsJobErrorsThreshold() uint32
+	AlertsJobErrorsWindow() time.Duration
+	AlertsKeyBalanceThreshold() *assets.Eth
+	AlertsPagerDutyRoutingKey() string
+	AlertsSlackWebhookURL() *url.URL
+	AlertsTxStuckThreshold() time.Duration
+}
+
+// RulesFromConfig returns the rules enabled by cfg
+func RulesFromConfig(cfg Config, db *sqlx.DB, chainSet evm.ChainSet, keyStore keystore.Eth, lggr logger.Logger) (rules []Rule) {
+	q := pg.NewQ(db, lggr, cfg)
+	// jobs can have their own thresholds, even if the rule is disabled for
+	// the others
+	rules = append(rules, NewJobErrorsRule(q, cfg.AlertsJobErrorsThreshold(), cfg.AlertsJobErrorsWindow()))
+	if threshold := cfg.AlertsTxStuckThreshold(); threshold > 0 {
+		rules = append(rules, NewTxStuckRule(q, threshold))
+	}
+	if threshold := cfg.AlertsKeyBalanceThreshold(); threshold != nil && chainSet != nil {
+		rules = append(rules, NewKeyBalanceRule(chainSet, keyStore, threshold))
+	}
+	return
+}
+
+// NotifiersFromConfig returns the notifiers of the webhooks configured by cfg
+func NotifiersFromConfig(cfg Config, client *http.Client) (notifiers []Notifier) {
+	if u := cfg.AlertsSlackWebhookURL(); u != nil {
+		notifiers = append(notifiers, NewSlackNotifier(u.String(), client))
+	}
+	if key := cfg.AlertsPagerDutyRoutingKey(); key != "" {
+		source, err := os.Hostname()
+		if err != nil || source == "" {
+			source = "chainlink"
+		}
+		notifiers = append(notifiers, NewPagerDutyNotifier(PagerDutyEventsURL, key, source, client))
+	}
+	return
+}
@@ -0,0 +1,122 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// PagerDutyEventsURL is the endpoint of the PagerDuty Events API v2
+const PagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+type slackNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewSlackNotifier returns a Notifier posting to the Slack incoming webhook at url
+func NewSlackNotifier(url string, client *http.Client) Notifier {
+	return &slackNotifier{url, client}
+}
+
+func (n *slackNotifier) Name() string { return "slack" }
+
+func (n *slackNotifier) Notify(ctx context.Context, a Alert, resolved bool) error {
+	var sb strings.Builder
+	if resolved {
+		fmt.Fprintf(&sb, ":white_check_mark: *RESOLVED* %s", a.Summary)
+	} else {
+		fmt.Fprintf(&sb, ":rotating_light: *FIRING* [%s] %s", a.Severity, a.Summary)
+		keys := make([]string, 0, len(a.Details))
+		for k := range a.Details {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(&sb, "\n• %s: `%s`", k, a.Details[k])
+		}
+	}
+	return post(ctx, n.client, n.url, map[string]string{"text": sb.String()})
+}
+
+type pagerDutyNotifier struct {
+	url        string
+	routingKey string
+	source     string
+	client     *http.Client
+}
+
+// NewPagerDutyNotifier returns a Notifier triggering and resolving events on
+// the PagerDuty service with routingKey. Alerts are deduplicated by their key.
+func NewPagerDutyNotifier(url, routingKey, source string, client *http.Client) Notifier {
+	return &pagerDutyNotifier{url, routingKey, source, client}
+}
+
+func (n *pagerDutyNotifier) Name() string { return "pagerduty" }
+
+type pagerDutyPayload struct {
+	Summary       string            `json:"summary"`
+	Source        string            `json:"source"`
+	Severity      Severity          `json:"severity"`
+	Timestamp     string            `json:"timestamp,omitempty"`
+	Component     string            `json:"component,omitempty"`
+	CustomDetails map[string]string `json:"custom_details,omitempty"`
+}
+
+type pagerDutyEvent struct {
+	RoutingKey  string            `json:"routing_key"`
+	EventAction string            `json:"event_action"`
+	DedupKey    string            `json:"dedup_key"`
+	Payload     *pagerDutyPayload `json:"payload,omitempty"`
+}
+
+func (n *pagerDutyNotifier) Notify(ctx context.Context, a Alert, resolved bool) error {
+	event := pagerDutyEvent{
+		RoutingKey:  n.routingKey,
+		EventAction: "trigger",
+		DedupKey:    a.Key,
+	}
+	if resolved {
+		event.EventAction = "resolve"
+	} else {
+		event.Payload = &pagerDutyPayload{
+			Summary:       a.Summary,
+			Source:        n.source,
+			Severity:      a.Severity,
+			Timestamp:     a.FiredAt.UTC().Format("2006-01-02T15:04:05.000Z"),
+			Component:     a.Rule,
+			CustomDetails: a.Details,
+		}
+	}
+	return post(ctx, n.client, n.url, event)
+}
+
+func post(ctx context.Context, client *http.Client, url string, v interface{}) error {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<10))
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return errors.Errorf("webhook responded with status %d: %s", resp.StatusCode, respBody)
+	}
+	return nil
+}
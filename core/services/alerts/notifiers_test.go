@@ -0,0 +1,88 @@
+package alerts_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
+)
+
+func newWebhook(t *testing.T, status int) (*httptest.Server, chan map[string]interface{}) {
+	chBodies := make(chan map[string]interface{}, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var body map[string]interface{}
+		require.NoError(t, json.Unmarshal(b, &body))
+		chBodies <- body
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, chBodies
+}
+
+var testAlert = alerts.Alert{
+	Key:      "job_errors/1",
+	Rule:     alerts.RuleJobErrors,
+	Severity: alerts.SeverityCritical,
+	Summary:  "Job 1 had 5 errored runs in the last 10m0s",
+	Details:  map[string]string{"jobID": "1", "erroredRuns": "5"},
+	FiredAt:  time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC),
+}
+
+func TestSlackNotifier(t *testing.T) {
+	t.Parallel()
+
+	srv, chBodies := newWebhook(t, http.StatusOK)
+	n := alerts.NewSlackNotifier(srv.URL, srv.Client())
+
+	require.NoError(t, n.Notify(testutils.Context(t), testAlert, false))
+	assert.Equal(t, ":rotating_light: *FIRING* [critical] Job 1 had 5 errored runs in the last 10m0s\n• erroredRuns: `5`\n• jobID: `1`", (<-chBodies)["text"])
+
+	require.NoError(t, n.Notify(testutils.Context(t), testAlert, true))
+	assert.Equal(t, ":white_check_mark: *RESOLVED* Job 1 had 5 errored runs in the last 10m0s", (<-chBodies)["text"])
+}
+
+func TestPagerDutyNotifier(t *testing.T) {
+	t.Parallel()
+
+	srv, chBodies := newWebhook(t, http.StatusAccepted)
+	n := alerts.NewPagerDutyNotifier(srv.URL, "routing-key", "node-1", srv.Client())
+
+	require.NoError(t, n.Notify(testutils.Context(t), testAlert, false))
+	body := <-chBodies
+	assert.Equal(t, "routing-key", body["routing_key"])
+	assert.Equal(t, "trigger", body["event_action"])
+	assert.Equal(t, "job_errors/1", body["dedup_key"])
+	assert.Equal(t, map[string]interface{}{
+		"summary":        "Job 1 had 5 errored runs in the last 10m0s",
+		"source":         "node-1",
+		"severity":       "critical",
+		"timestamp":      "2022-09-01T12:00:00.000Z",
+		"component":      "job_errors",
+		"custom_details": map[string]interface{}{"jobID": "1", "erroredRuns": "5"},
+	}, body["payload"])
+
+	require.NoError(t, n.Notify(testutils.Context(t), testAlert, true))
+	assert.Equal(t, map[string]interface{}{
+		"routing_key":  "routing-key",
+		"event_action": "resolve",
+		"dedup_key":    "job_errors/1",
+	}, <-chBodies)
+}
+
+func TestNotifier_ErrorStatus(t *testing.T) {
+	t.Parallel()
+
+	srv, _ := newWebhook(t, http.StatusBadRequest)
+	n := alerts.NewSlackNotifier(srv.URL, srv.Client())
+	require.EqualError(t, n.Notify(testutils.Context(t), testAlert, false), "webhook responded with status 400: ")
+}
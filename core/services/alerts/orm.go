@@ -0,0 +1,107 @@
+package alerts
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// AlertState is an alert firing, or resolved, with the notifiers it was not
+// sent to yet
+type AlertState struct {
+	Alert
+	Resolved bool
+	// PendingNotifiers are the names of the notifiers which were not sent the
+	// alert, as firing or as resolved, yet
+	PendingNotifiers []string
+}
+
+// ORM persists the alerts, so that they are not sent again after a restart
+type ORM interface {
+	// FindAlerts returns the alerts firing, and the alerts resolved which were
+	// not sent to every notifier yet
+	FindAlerts(qopts ...pg.QOpt) ([]AlertState, error)
+	// UpsertAlert saves the state of an alert
+	UpsertAlert(s AlertState, qopts ...pg.QOpt) error
+	// DeleteAlert deletes an alert once it is resolved and sent to every
+	// notifier
+	DeleteAlert(key string, qopts ...pg.QOpt) error
+}
+
+type orm struct {
+	q pg.Q
+}
+
+var _ ORM = (*orm)(nil)
+
+// NewORM returns an ORM of the alerts
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig) ORM {
+	return &orm{pg.NewQ(db, lggr.Named("AlertsORM"), cfg)}
+}
+
+type dbAlert struct {
+	Key              string
+	Rule             string
+	Severity         Severity
+	Summary          string
+	Details          []byte
+	FiredAt          time.Time
+	Resolved         bool
+	PendingNotifiers pq.StringArray
+}
+
+func (o *orm) FindAlerts(qopts ...pg.QOpt) (states []AlertState, err error) {
+	q := o.q.WithOpts(qopts...)
+	var rows []dbAlert
+	if err = q.Select(&rows, `SELECT * FROM alerts ORDER BY key`); err != nil {
+		return nil, errors.Wrap(err, "FindAlerts failed")
+	}
+	states = make([]AlertState, len(rows))
+	for i, row := range rows {
+		states[i] = AlertState{
+			Alert: Alert{
+				Key:      row.Key,
+				Rule:     row.Rule,
+				Severity: row.Severity,
+				Summary:  row.Summary,
+				FiredAt:  row.FiredAt,
+			},
+			Resolved:         row.Resolved,
+			PendingNotifiers: row.PendingNotifiers,
+		}
+		if err = json.Unmarshal(row.Details, &states[i].Details); err != nil {
+			return nil, errors.Wrapf(err, "FindAlerts failed to decode the details of alert %s", row.Key)
+		}
+	}
+	return states, nil
+}
+
+func (o *orm) UpsertAlert(s AlertState, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	details, err := json.Marshal(s.Details)
+	if err != nil {
+		return errors.Wrap(err, "UpsertAlert failed to encode the details")
+	}
+	pending := s.PendingNotifiers
+	if pending == nil {
+		pending = []string{}
+	}
+	_, err = q.Exec(`INSERT INTO alerts (key, rule, severity, summary, details, fired_at, resolved, pending_notifiers)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (key) DO UPDATE SET rule = EXCLUDED.rule, severity = EXCLUDED.severity, summary = EXCLUDED.summary, details = EXCLUDED.details,
+	fired_at = EXCLUDED.fired_at, resolved = EXCLUDED.resolved, pending_notifiers = EXCLUDED.pending_notifiers`,
+		s.Key, s.Rule, s.Severity, s.Summary, details, s.FiredAt, s.Resolved, pq.StringArray(pending))
+	return errors.Wrap(err, "UpsertAlert failed")
+}
+
+func (o *orm) DeleteAlert(key string, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	_, err := q.Exec(`DELETE FROM alerts WHERE key = $1`, key)
+	return errors.Wrap(err, "DeleteAlert failed")
+}
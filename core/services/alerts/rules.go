@@ -0,0 +1,216 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/services/budgets"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const (
+	RuleJobErrors  = "job_errors"
+	RuleTxStuck    = "tx_stuck"
+	RuleKeyBalance = "key_balance"
+	RuleJobBudget  = "job_budget"
+)
+
+type jobErrorsRule struct {
+	q         pg.Q
+	threshold uint32
+	window    time.Duration
+}
+
+// NewJobErrorsRule fires for each job with at least threshold errored runs
+// finished within window. The jobs with an alertErrorsThreshold or an
+// alertErrorsWindow use theirs instead, a threshold of 0 disables the rule for
+// the other jobs.
+func NewJobErrorsRule(q pg.Q, threshold uint32, window time.Duration) Rule {
+	return &jobErrorsRule{q, threshold, window}
+}
+
+func (r *jobErrorsRule) Name() string { return RuleJobErrors }
+
+func (r *jobErrorsRule) Evaluate(ctx context.Context) ([]Alert, error) {
+	var rows []struct {
+		ID        int32
+		Name      null.String
+		Errors    int64
+		Threshold int64
+		Window    models.Interval `db:"errors_window"`
+	}
+	err := r.q.WithOpts(pg.WithParentCtx(ctx)).Select(&rows, `
+SELECT jobs.id, jobs.name, COUNT(*) AS errors, COALESCE(jobs.alert_errors_threshold, $2) AS threshold,
+	COALESCE(jobs.alert_errors_window, $3) AS errors_window
+FROM jobs
+JOIN pipeline_runs ON pipeline_runs.pipeline_spec_id = jobs.pipeline_spec_id
+WHERE COALESCE(jobs.alert_errors_threshold, $2) > 0 AND pipeline_runs.state = 'errored'
+	AND pipeline_runs.finished_at > $1::timestamptz - make_interval(secs => COALESCE(jobs.alert_errors_window, $3) / 1e9)
+GROUP BY jobs.id, jobs.name
+HAVING COUNT(*) >= COALESCE(jobs.alert_errors_threshold, $2)`, time.Now(), r.threshold, r.window.Nanoseconds())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to count errored runs")
+	}
+
+	alerts := make([]Alert, len(rows))
+	for i, row := range rows {
+		name := row.Name.ValueOrZero()
+		if name == "" {
+			name = strconv.Itoa(int(row.ID))
+		}
+		alerts[i] = Alert{
+			Key:      fmt.Sprintf("%s/%d", RuleJobErrors, row.ID),
+			Severity: SeverityCritical,
+			Summary:  fmt.Sprintf("Job %s had %d errored runs in the last %s", name, row.Errors, row.Window.Duration()),
+			Details: map[string]string{
+				"jobID":       strconv.Itoa(int(row.ID)),
+				"jobName":     row.Name.ValueOrZero(),
+				"erroredRuns": strconv.FormatInt(row.Errors, 10),
+				"threshold":   strconv.FormatInt(row.Threshold, 10),
+			},
+		}
+	}
+	return alerts, nil
+}
+
+type txStuckRule struct {
+	q         pg.Q
+	threshold time.Duration
+}
+
+// NewTxStuckRule fires for each key with transactions still unconfirmed
+// threshold after they were first broadcast
+func NewTxStuckRule(q pg.Q, threshold time.Duration) Rule {
+	return &txStuckRule{q, threshold}
+}
+
+func (r *txStuckRule) Name() string { return RuleTxStuck }
+
+func (r *txStuckRule) Evaluate(ctx context.Context) ([]Alert, error) {
+	var rows []struct {
+		EVMChainID  utils.Big      `db:"evm_chain_id"`
+		FromAddress common.Address `db:"from_address"`
+		Count       int64
+		Nonce       int64
+		BroadcastAt time.Time `db:"broadcast_at"`
+	}
+	err := r.q.WithOpts(pg.WithParentCtx(ctx)).Select(&rows, `
+SELECT evm_chain_id, from_address, COUNT(*) AS count, MIN(nonce) AS nonce, MIN(initial_broadcast_at) AS broadcast_at FROM eth_txes
+WHERE state = 'unconfirmed' AND initial_broadcast_at < $1
+GROUP BY evm_chain_id, from_address`, time.Now().Add(-r.threshold))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find stuck transactions")
+	}
+
+	alerts := make([]Alert, len(rows))
+	for i, row := range rows {
+		alerts[i] = Alert{
+			Key:      fmt.Sprintf("%s/%s/%s", RuleTxStuck, row.EVMChainID.String(), row.FromAddress.Hex()),
+			Severity: SeverityCritical,
+			Summary: fmt.Sprintf("%d transactions from %s on chain %s are unconfirmed for more than %s, starting at nonce %d",
+				row.Count, row.FromAddress.Hex(), row.EVMChainID.String(), r.threshold, row.Nonce),
+			Details: map[string]string{
+				"evmChainID":  row.EVMChainID.String(),
+				"fromAddress": row.FromAddress.Hex(),
+				"count":       strconv.FormatInt(row.Count, 10),
+				"nonce":       strconv.FormatInt(row.Nonce, 10),
+				"broadcastAt": row.BroadcastAt.UTC().Format(time.RFC3339),
+			},
+		}
+	}
+	return alerts, nil
+}
+
+type keyBalanceRule struct {
+	chainSet  evm.ChainSet
+	keyStore  keystore.Eth
+	threshold *assets.Eth
+}
+
+// NewKeyBalanceRule fires for each enabled key with a balance below
+// threshold, on the chains with the balance monitor enabled
+func NewKeyBalanceRule(chainSet evm.ChainSet, keyStore keystore.Eth, threshold *assets.Eth) Rule {
+	return &keyBalanceRule{chainSet, keyStore, threshold}
+}
+
+func (r *keyBalanceRule) Name() string { return RuleKeyBalance }
+
+func (r *keyBalanceRule) Evaluate(context.Context) ([]Alert, error) {
+	var alerts []Alert
+	for _, chain := range r.chainSet.Chains() {
+		keys, err := r.keyStore.EnabledKeysForChain(chain.ID())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get keys for chain %s", chain.ID())
+		}
+		for _, key := range keys {
+			balance := chain.BalanceMonitor().GetEthBalance(key.Address)
+			if balance == nil || balance.Cmp(r.threshold) >= 0 {
+				continue
+			}
+			alerts = append(alerts, Alert{
+				Key:      fmt.Sprintf("%s/%s/%s", RuleKeyBalance, chain.ID(), key.Address.Hex()),
+				Severity: SeverityWarning,
+				Summary:  fmt.Sprintf("Key %s on chain %s has a balance of %s ETH, below %s ETH", key.Address.Hex(), chain.ID(), balance, r.threshold),
+				Details: map[string]string{
+					"evmChainID": chain.ID().String(),
+					"address":    key.Address.Hex(),
+					"balance":    balance.String(),
+					"threshold":  r.threshold.String(),
+				},
+			})
+		}
+	}
+	return alerts, nil
+}
+
+type jobBudgetRule struct {
+	orm budgets.ORM
+}
+
+// NewJobBudgetRule fires for each job which spent its whole monthly budget
+func NewJobBudgetRule(orm budgets.ORM) Rule {
+	return &jobBudgetRule{orm}
+}
+
+func (r *jobBudgetRule) Name() string { return RuleJobBudget }
+
+func (r *jobBudgetRule) Evaluate(ctx context.Context) ([]Alert, error) {
+	spends, err := r.orm.JobSpends(budgets.MonthStart(time.Now()), pg.WithParentCtx(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get the spends of the jobs")
+	}
+
+	var alerts []Alert
+	for _, s := range spends {
+		if !s.Exceeded() {
+			continue
+		}
+		name := s.Name.ValueOrZero()
+		if name == "" {
+			name = strconv.Itoa(int(s.JobID))
+		}
+		alerts = append(alerts, Alert{
+			Key:      fmt.Sprintf("%s/%d", RuleJobBudget, s.JobID),
+			Severity: SeverityCritical,
+			Summary:  fmt.Sprintf("Job %s spent %s wei this month, exceeding its monthly budget of %s wei", name, s.MonthSpend.ToInt(), s.MonthlyBudget.ToInt()),
+			Details: map[string]string{
+				"jobID":         strconv.Itoa(int(s.JobID)),
+				"jobName":       s.Name.ValueOrZero(),
+				"monthSpend":    s.MonthSpend.ToInt().String(),
+				"monthlyBudget": s.MonthlyBudget.ToInt().String(),
+			},
+		})
+	}
+	return alerts, nil
+}
@@ -0,0 +1,114 @@
+package alerts_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
+	"github.com/smartcontractkit/chainlink/core/services/budgets"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+func TestJobErrorsRule(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	q := pg.NewQ(db, logger.TestLogger(t), cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	_, addr := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+	jb := cltest.MustInsertV2JobSpec(t, db, addr)
+
+	insertErroredRun := func(finishedAt time.Time) {
+		_, err := db.Exec(`INSERT INTO pipeline_runs (state, pipeline_spec_id, created_at, finished_at, fatal_errors, all_errors)
+VALUES ('errored', $1, $2, $2, '["boom"]', '["boom"]')`, jb.PipelineSpecID, finishedAt)
+		require.NoError(t, err)
+	}
+	insertErroredRun(time.Now())
+	insertErroredRun(time.Now().Add(-time.Minute))
+	insertErroredRun(time.Now().Add(-time.Hour))
+
+	rule := alerts.NewJobErrorsRule(q, 3, 10*time.Minute)
+	firing, err := rule.Evaluate(testutils.Context(t))
+	require.NoError(t, err)
+	assert.Empty(t, firing)
+
+	rule = alerts.NewJobErrorsRule(q, 2, 10*time.Minute)
+	firing, err = rule.Evaluate(testutils.Context(t))
+	require.NoError(t, err)
+	require.Len(t, firing, 1)
+	assert.Equal(t, alerts.SeverityCritical, firing[0].Severity)
+	assert.Equal(t, "2", firing[0].Details["erroredRuns"])
+	assert.Equal(t, strconv.Itoa(int(jb.ID)), firing[0].Details["jobID"])
+
+	// the thresholds of the job override the global ones
+	_, err = db.Exec(`UPDATE jobs SET alert_errors_threshold = 3, alert_errors_window = $1 WHERE id = $2`, (2 * time.Hour).Nanoseconds(), jb.ID)
+	require.NoError(t, err)
+	rule = alerts.NewJobErrorsRule(q, 0, 10*time.Minute)
+	firing, err = rule.Evaluate(testutils.Context(t))
+	require.NoError(t, err)
+	require.Len(t, firing, 1)
+	assert.Equal(t, "3", firing[0].Details["erroredRuns"])
+	assert.Equal(t, "3", firing[0].Details["threshold"])
+}
+
+func TestTxStuckRule(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	q := pg.NewQ(db, logger.TestLogger(t), cfg)
+	borm := cltest.NewTxmORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+	cltest.MustInsertUnconfirmedEthTx(t, borm, 0, fromAddress, time.Now().Add(-time.Hour))
+	cltest.MustInsertUnconfirmedEthTx(t, borm, 1, fromAddress, time.Now().Add(-30*time.Minute))
+	cltest.MustInsertUnconfirmedEthTx(t, borm, 2, fromAddress)
+
+	rule := alerts.NewTxStuckRule(q, 2*time.Hour)
+	firing, err := rule.Evaluate(testutils.Context(t))
+	require.NoError(t, err)
+	assert.Empty(t, firing)
+
+	rule = alerts.NewTxStuckRule(q, 10*time.Minute)
+	firing, err = rule.Evaluate(testutils.Context(t))
+	require.NoError(t, err)
+	require.Len(t, firing, 1)
+	assert.Equal(t, "tx_stuck/"+cltest.FixtureChainID.String()+"/"+fromAddress.Hex(), firing[0].Key)
+	assert.Equal(t, "2", firing[0].Details["count"])
+	assert.Equal(t, "0", firing[0].Details["nonce"])
+}
+
+func TestJobBudgetRule(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	_, addr := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+	jb := cltest.MustInsertV2JobSpec(t, db, addr)
+	pgtest.MustExec(t, db, `UPDATE jobs SET monthly_budget = 100 WHERE id = $1`, jb.ID)
+	pgtest.MustExec(t, db, `INSERT INTO job_spends (eth_tx_id, job_id, evm_chain_id, gas_used, fee, confirmed_at) VALUES (1, $1, 0, 21000, 60, NOW())`, jb.ID)
+
+	rule := alerts.NewJobBudgetRule(budgets.NewORM(db, logger.TestLogger(t), cfg))
+	firing, err := rule.Evaluate(testutils.Context(t))
+	require.NoError(t, err)
+	assert.Empty(t, firing)
+
+	pgtest.MustExec(t, db, `INSERT INTO job_spends (eth_tx_id, job_id, evm_chain_id, gas_used, fee, confirmed_at) VALUES (2, $1, 0, 21000, 40, NOW())`, jb.ID)
+	firing, err = rule.Evaluate(testutils.Context(t))
+	require.NoError(t, err)
+	require.Len(t, firing, 1)
+	assert.Equal(t, "job_budget/"+strconv.Itoa(int(jb.ID)), firing[0].Key)
+	assert.Equal(t, "100", firing[0].Details["monthSpend"])
+	assert.Equal(t, "100", firing[0].Details["monthlyBudget"])
+}
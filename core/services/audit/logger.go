@@ -0,0 +1,132 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const (
+	// queueSize is the number of events buffered for forwarding, events
+	// recorded while the queue is full are only kept in the database
+	queueSize      = 100
+	forwardTimeout = 10 * time.Second
+)
+
+// Logger records the changes made through the API and forwards them to the
+// configured sink
+type Logger interface {
+	services.ServiceCtx
+	// Record saves ev and queues it for forwarding
+	Record(ev *Event) error
+	Events(since, until null.Time, offset, limit int) ([]Event, int, error)
+}
+
+type sink interface {
+	send(ctx context.Context, body []byte) error
+}
+
+type auditLogger struct {
+	utils.StartStopOnce
+	orm  ORM
+	sink sink
+	lggr logger.Logger
+
+	chEvents chan Event
+	chStop   chan struct{}
+	wgDone   sync.WaitGroup
+}
+
+var _ Logger = (*auditLogger)(nil)
+
+// NewLogger returns a Logger forwarding to forwardURL, which may be nil.
+// Supported schemes are udp and tcp for syslog, and http and https.
+func NewLogger(orm ORM, forwardURL *url.URL, client *http.Client, lggr logger.Logger) (Logger, error) {
+	l := &auditLogger{
+		orm:      orm,
+		lggr:     lggr.Named("AuditLogger"),
+		chEvents: make(chan Event, queueSize),
+		chStop:   make(chan struct{}),
+	}
+	if forwardURL == nil {
+		return l, nil
+	}
+	switch forwardURL.Scheme {
+	case "udp", "tcp":
+		l.sink = &syslogSink{network: forwardURL.Scheme, addr: forwardURL.Host}
+	case "http", "https":
+		l.sink = &httpSink{url: forwardURL.String(), client: client}
+	default:
+		return nil, errors.Errorf("invalid audit log forward url %q, must be udp, tcp, http or https", forwardURL.Redacted())
+	}
+	return l, nil
+}
+
+func (l *auditLogger) Start(context.Context) error {
+	return l.StartOnce("AuditLogger", func() error {
+		if l.sink != nil {
+			l.wgDone.Add(1)
+			go l.run()
+		}
+		return nil
+	})
+}
+
+func (l *auditLogger) Close() error {
+	return l.StopOnce("AuditLogger", func() error {
+		close(l.chStop)
+		l.wgDone.Wait()
+		return nil
+	})
+}
+
+func (l *auditLogger) Record(ev *Event) error {
+	if err := l.orm.CreateEvent(ev); err != nil {
+		return err
+	}
+	if l.sink == nil {
+		return nil
+	}
+	select {
+	case l.chEvents <- *ev:
+	default:
+		l.lggr.Errorw("Audit forwarding queue is full, event is only kept in the database", "eventID", ev.ID)
+	}
+	return nil
+}
+
+func (l *auditLogger) Events(since, until null.Time, offset, limit int) ([]Event, int, error) {
+	return l.orm.Events(since, until, offset, limit)
+}
+
+func (l *auditLogger) run() {
+	defer l.wgDone.Done()
+	for {
+		select {
+		case <-l.chStop:
+			return
+		case ev := <-l.chEvents:
+			body, err := json.Marshal(ev)
+			if err != nil {
+				l.lggr.Errorw("Failed to encode audit event", "eventID", ev.ID, "err", err)
+				continue
+			}
+			ctx, cancel := utils.ContextFromChanWithDeadline(l.chStop, forwardTimeout)
+			err = l.sink.send(ctx, body)
+			cancel()
+			if err != nil {
+				l.lggr.Warnw("Failed to forward audit event", "eventID", ev.ID, "err", err)
+			}
+		}
+	}
+}
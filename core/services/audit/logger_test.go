@@ -0,0 +1,120 @@
+package audit_test
+
+import (
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/audit"
+)
+
+func newLogger(t *testing.T, forwardURL *url.URL) audit.Logger {
+	db := pgtest.NewSqlxDB(t)
+	lggr := logger.TestLogger(t)
+	l, err := audit.NewLogger(audit.NewORM(db, lggr, pgtest.NewPGCfg(false)), forwardURL, http.DefaultClient, lggr)
+	require.NoError(t, err)
+	require.NoError(t, l.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, l.Close()) })
+	return l
+}
+
+func newEvent(path string) *audit.Event {
+	return &audit.Event{
+		Actor:       "apiuser@chainlink.test",
+		AuthMethod:  audit.AuthMethodSession,
+		Method:      http.MethodPost,
+		Path:        path,
+		RemoteIP:    "127.0.0.1",
+		PayloadHash: audit.PayloadHash([]byte(`{}`)),
+		Status:      http.StatusOK,
+	}
+}
+
+func TestLogger_Events(t *testing.T) {
+	t.Parallel()
+
+	l := newLogger(t, nil)
+	for _, path := range []string{"/v2/jobs", "/v2/bridge_types", "/v2/transfers"} {
+		require.NoError(t, l.Record(newEvent(path)))
+	}
+
+	events, count, err := l.Events(null.Time{}, null.Time{}, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, events, 2)
+	assert.Equal(t, "/v2/bridge_types", events[0].Path)
+	assert.Equal(t, "/v2/transfers", events[1].Path)
+
+	_, count, err = l.Events(null.TimeFrom(time.Now().Add(time.Hour)), null.Time{}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+	_, count, err = l.Events(null.TimeFrom(time.Now().Add(-time.Hour)), null.TimeFrom(time.Now().Add(time.Hour)), 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+}
+
+func TestLogger_ForwardHTTP(t *testing.T) {
+	t.Parallel()
+
+	ch := make(chan audit.Event, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var ev audit.Event
+		require.NoError(t, json.Unmarshal(body, &ev))
+		ch <- ev
+	}))
+	t.Cleanup(server.Close)
+
+	l := newLogger(t, testutils.MustParseURL(t, server.URL))
+	ev := newEvent("/v2/jobs")
+	require.NoError(t, l.Record(ev))
+
+	select {
+	case received := <-ch:
+		assert.Equal(t, ev.ID, received.ID)
+		assert.Equal(t, "/v2/jobs", received.Path)
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for event")
+	}
+}
+
+func TestLogger_ForwardSyslog(t *testing.T) {
+	t.Parallel()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, conn.Close()) })
+
+	l := newLogger(t, testutils.MustParseURL(t, "udp://"+conn.LocalAddr().String()))
+	require.NoError(t, l.Record(newEvent("/v2/jobs")))
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(testutils.WaitTimeout(t))))
+	buf := make([]byte, 4096)
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+	msg := string(buf[:n])
+	assert.True(t, strings.HasPrefix(msg, "<86>1 "), msg)
+	assert.Contains(t, msg, " chainlink - audit - ")
+	assert.Contains(t, msg, `"path":"/v2/jobs"`)
+}
+
+func TestNewLogger_InvalidScheme(t *testing.T) {
+	t.Parallel()
+
+	_, err := audit.NewLogger(nil, testutils.MustParseURL(t, "ftp://example.com"), http.DefaultClient, logger.TestLogger(t))
+	require.Error(t, err)
+}
@@ -0,0 +1,42 @@
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// Ways an actor can authenticate a request
+const (
+	AuthMethodSession           = "session"
+	AuthMethodAPIToken          = "api_token"
+	AuthMethodExternalInitiator = "external_initiator"
+)
+
+// Event is a record of a request that changed the node's state
+type Event struct {
+	ID int64 `json:"id"`
+	// Actor is the email of the user or the name of the external initiator
+	Actor string `json:"actor"`
+	// AuthMethod is one of session, external_initiator or api_token:<name>
+	AuthMethod string `json:"authMethod"`
+	Method     string `json:"method"`
+	Path       string `json:"path"`
+	// Operation is the GraphQL operation name, empty for other requests
+	Operation string `json:"operation"`
+	RemoteIP  string `json:"remoteIP"`
+	// PayloadHash is the hex encoded SHA-256 of the request body
+	PayloadHash string    `json:"payloadHash"`
+	Status      int       `json:"status"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// PayloadHash returns the hex encoded SHA-256 of body, or an empty string if
+// there is no body
+func PayloadHash(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,49 @@
+package audit
+
+import (
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+type ORM interface {
+	CreateEvent(ev *Event, qopts ...pg.QOpt) error
+	Events(since, until null.Time, offset, limit int, qopts ...pg.QOpt) ([]Event, int, error)
+}
+
+type orm struct {
+	q pg.Q
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig) ORM {
+	return &orm{pg.NewQ(db, lggr.Named("AuditORM"), cfg)}
+}
+
+// CreateEvent inserts ev, setting its ID and timestamp
+func (o *orm) CreateEvent(ev *Event, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	sql := `INSERT INTO audit_events (actor, auth_method, method, path, operation, remote_ip, payload_hash, status, created_at)
+VALUES (:actor, :auth_method, :method, :path, :operation, :remote_ip, :payload_hash, :status, NOW())
+RETURNING *`
+	err := q.GetNamed(sql, ev, ev)
+	return errors.Wrap(err, "CreateEvent failed")
+}
+
+// Events returns a page of the events created in [since, until), oldest
+// first, along with the total count. Unset bounds are open.
+func (o *orm) Events(since, until null.Time, offset, limit int, qopts ...pg.QOpt) (events []Event, count int, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Transaction(func(tx pg.Queryer) error {
+		filter := `WHERE ($1::timestamptz IS NULL OR created_at >= $1) AND ($2::timestamptz IS NULL OR created_at < $2)`
+		if err = tx.Get(&count, `SELECT count(*) FROM audit_events `+filter, since, until); err != nil {
+			return errors.Wrap(err, "error counting audit events")
+		}
+		return tx.Select(&events, `SELECT * FROM audit_events `+filter+` ORDER BY id LIMIT $3 OFFSET $4`, since, until, limit, offset)
+	})
+	return events, count, errors.Wrap(err, "Events failed")
+}
@@ -0,0 +1,70 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// syslogPriority is the authpriv facility with the info severity
+const syslogPriority = 10*8 + 6
+
+// syslogSink sends each event as an RFC 5424 message. Connections are not
+// kept open between events, which are infrequent.
+type syslogSink struct {
+	network string
+	addr    string
+}
+
+func (s *syslogSink) send(ctx context.Context, body []byte) error {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, s.network, s.addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err = conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+	}
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+	msg := fmt.Sprintf("<%d>1 %s %s chainlink - audit - %s\n", syslogPriority, time.Now().UTC().Format(time.RFC3339Nano), hostname, body)
+	_, err = io.WriteString(conn, msg)
+	return err
+}
+
+// httpSink POSTs each event as JSON
+type httpSink struct {
+	url    string
+	client *http.Client
+}
+
+func (s *httpSink) send(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return errors.Errorf("audit sink responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
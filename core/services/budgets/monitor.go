@@ -0,0 +1,137 @@
+package budgets
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// CheckInterval is how often the spends are accounted and the budgets checked
+const CheckInterval = 15 * time.Second
+
+// Monitor accounts the spends of the jobs periodically, and tells which jobs
+// exceeded their monthly budget so that their transactions are paused until
+// the next month, or until their budget is raised
+type Monitor interface {
+	services.ServiceCtx
+	pipeline.Budgets
+}
+
+type monitor struct {
+	utils.StartStopOnce
+	orm      ORM
+	jobORM   job.ORM
+	interval time.Duration
+	lggr     logger.Logger
+
+	exceededMu sync.RWMutex
+	exceeded   map[int32]bool
+
+	chStop chan struct{}
+	wgDone sync.WaitGroup
+}
+
+var _ Monitor = (*monitor)(nil)
+
+// NewMonitor returns a Monitor checking the budgets every interval
+func NewMonitor(orm ORM, jobORM job.ORM, interval time.Duration, lggr logger.Logger) Monitor {
+	return &monitor{
+		orm:      orm,
+		jobORM:   jobORM,
+		interval: interval,
+		lggr:     lggr.Named("BudgetMonitor"),
+		exceeded: make(map[int32]bool),
+		chStop:   make(chan struct{}),
+	}
+}
+
+func (m *monitor) Start(context.Context) error {
+	return m.StartOnce("BudgetMonitor", func() error {
+		m.wgDone.Add(1)
+		go m.run()
+		return nil
+	})
+}
+
+func (m *monitor) Close() error {
+	return m.StopOnce("BudgetMonitor", func() error {
+		close(m.chStop)
+		m.wgDone.Wait()
+		return nil
+	})
+}
+
+func (m *monitor) Exceeded(jobID int32) bool {
+	m.exceededMu.RLock()
+	defer m.exceededMu.RUnlock()
+	return m.exceeded[jobID]
+}
+
+func (m *monitor) run() {
+	defer m.wgDone.Done()
+	ctx, cancel := utils.ContextFromChan(m.chStop)
+	defer cancel()
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		if err := m.check(ctx); err != nil && ctx.Err() == nil {
+			m.lggr.Errorw("Failed to check the job budgets", "err", err)
+		}
+		select {
+		case <-m.chStop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// check accounts the new transactions, then replaces the jobs over budget.
+// An error is recorded on the jobs going over budget.
+func (m *monitor) check(ctx context.Context) error {
+	for {
+		count, err := m.orm.RecordSpends(pg.WithParentCtx(ctx))
+		if err != nil {
+			return err
+		}
+		if count < recordBatchSize {
+			break
+		}
+	}
+
+	spends, err := m.orm.JobSpends(MonthStart(time.Now()), pg.WithParentCtx(ctx))
+	if err != nil {
+		return err
+	}
+	exceeded := make(map[int32]bool)
+	for _, s := range spends {
+		if !s.Exceeded() {
+			continue
+		}
+		exceeded[s.JobID] = true
+		if m.Exceeded(s.JobID) {
+			continue
+		}
+		msg := fmt.Sprintf("Job spent %s wei this month, exceeding its monthly budget of %s wei: its transactions are paused until the next month", s.MonthSpend.ToInt(), s.MonthlyBudget.ToInt())
+		m.lggr.Errorw(msg, "jobID", s.JobID, "jobName", s.Name.ValueOrZero())
+		m.jobORM.TryRecordError(s.JobID, msg, pg.WithParentCtx(ctx))
+	}
+
+	m.exceededMu.Lock()
+	for jobID := range m.exceeded {
+		if !exceeded[jobID] {
+			m.lggr.Infow("Job is within its monthly budget again, its transactions are resumed", "jobID", jobID)
+		}
+	}
+	m.exceeded = exceeded
+	m.exceededMu.Unlock()
+	return nil
+}
@@ -0,0 +1,32 @@
+package budgets_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/budgets"
+	jobmocks "github.com/smartcontractkit/chainlink/core/services/job/mocks"
+)
+
+func TestMonitor(t *testing.T) {
+	t.Parallel()
+
+	orm, jb := setupSpends(t)
+	jobORM := jobmocks.NewORM(t)
+	jobORM.On("TryRecordError", jb.ID, mock.MatchedBy(func(description string) bool {
+		return assert.Contains(t, description, "exceeding its monthly budget of 50000 wei")
+	}), mock.Anything).Once()
+
+	monitor := budgets.NewMonitor(orm, jobORM, 100*time.Millisecond, logger.TestLogger(t))
+	require.NoError(t, monitor.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, monitor.Close()) })
+
+	assert.Eventually(t, func() bool { return monitor.Exceeded(jb.ID) }, testutils.WaitTimeout(t), 100*time.Millisecond)
+	assert.False(t, monitor.Exceeded(jb.ID+1))
+}
@@ -0,0 +1,143 @@
+package budgets
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// recordBatchSize is the number of transactions accounted at once
+const recordBatchSize = 1000
+
+// JobSpend is the wei spent by the confirmed transactions of a job
+type JobSpend struct {
+	JobID         int32
+	Name          null.String
+	MonthlyBudget *assets.Eth
+	// MonthSpend is the wei spent since the start of the month
+	MonthSpend assets.Eth
+	// TotalSpend is the wei spent since the transactions of the job are
+	// accounted
+	TotalSpend   assets.Eth
+	Transactions int64
+}
+
+// Exceeded returns true if the job has a budget, and spent all of it this
+// month
+func (s JobSpend) Exceeded() bool {
+	return s.MonthlyBudget != nil && s.MonthSpend.Cmp(s.MonthlyBudget) >= 0
+}
+
+// MonthStart returns the start of the calendar month of t, in UTC
+func MonthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// ORM accounts the wei spent by the transactions of the jobs. The spends are
+// kept after the transactions are reaped.
+type ORM interface {
+	// RecordSpends accounts up to recordBatchSize confirmed transactions of
+	// the jobs which were not accounted yet, and returns their number
+	RecordSpends(qopts ...pg.QOpt) (int, error)
+	// JobSpends returns the spends of the jobs with a budget or accounted
+	// transactions, with their spend since monthStart
+	JobSpends(monthStart time.Time, qopts ...pg.QOpt) ([]JobSpend, error)
+	// FindJobSpend returns the spend of the job, or sql.ErrNoRows if it
+	// doesn't exist
+	FindJobSpend(jobID int32, monthStart time.Time, qopts ...pg.QOpt) (JobSpend, error)
+}
+
+type orm struct {
+	q pg.Q
+}
+
+var _ ORM = (*orm)(nil)
+
+// NewORM returns an ORM of the spends of the jobs
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig) ORM {
+	return &orm{pg.NewQ(db, lggr.Named("BudgetsORM"), cfg)}
+}
+
+// RecordSpends prices the gas used by the transactions at their effective gas
+// price, or at the gas price of their attempt if their receipt has none: the
+// fee cap for EIP-1559 transactions, so an upper bound of their fee.
+// A transaction reorged into another block has several receipts, the latest
+// one is used.
+// Only the transactions with a job are scanned, using the partial index on
+// their JobID, so that the transactions without one are not scanned again on
+// each call.
+func (o *orm) RecordSpends(qopts ...pg.QOpt) (count int, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Transaction(func(tx pg.Queryer) error {
+		var rows []struct {
+			EthTxID     int64
+			JobID       int32
+			EVMChainID  utils.Big
+			Receipt     evmtypes.Receipt
+			GasPrice    utils.Big
+			ConfirmedAt time.Time
+		}
+		err = tx.Select(&rows, `SELECT DISTINCT ON (eth_txes.id) eth_txes.id AS eth_tx_id, jobs.id AS job_id, eth_txes.evm_chain_id, eth_receipts.receipt,
+	COALESCE(eth_tx_attempts.gas_price, eth_tx_attempts.gas_fee_cap) AS gas_price, eth_receipts.created_at AS confirmed_at
+FROM eth_txes
+JOIN jobs ON jobs.id = (eth_txes.meta->>'JobID')::int
+JOIN eth_tx_attempts ON eth_tx_attempts.eth_tx_id = eth_txes.id
+JOIN eth_receipts ON eth_receipts.tx_hash = eth_tx_attempts.hash
+WHERE eth_txes.meta->>'JobID' IS NOT NULL AND eth_txes.state = 'confirmed' AND NOT EXISTS (SELECT 1 FROM job_spends WHERE job_spends.eth_tx_id = eth_txes.id)
+ORDER BY eth_txes.id, eth_receipts.block_number DESC
+LIMIT $1`, recordBatchSize)
+		if err != nil {
+			return errors.Wrap(err, "failed to find the transactions to account")
+		}
+
+		for _, row := range rows {
+			price := row.Receipt.EffectiveGasPrice
+			if price == nil {
+				price = row.GasPrice.ToInt()
+			}
+			fee := new(big.Int).Mul(new(big.Int).SetUint64(row.Receipt.GasUsed), price)
+			_, err = tx.Exec(`INSERT INTO job_spends (eth_tx_id, job_id, evm_chain_id, gas_used, fee, confirmed_at) VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (eth_tx_id) DO NOTHING`, row.EthTxID, row.JobID, row.EVMChainID, row.Receipt.GasUsed, utils.NewBig(fee), row.ConfirmedAt)
+			if err != nil {
+				return errors.Wrapf(err, "failed to account transaction %d", row.EthTxID)
+			}
+		}
+		count = len(rows)
+		return nil
+	})
+	return count, errors.Wrap(err, "RecordSpends failed")
+}
+
+const selectJobSpends = `SELECT jobs.id AS job_id, jobs.name, jobs.monthly_budget,
+	COALESCE(SUM(job_spends.fee) FILTER (WHERE job_spends.confirmed_at >= $1), 0) AS month_spend,
+	COALESCE(SUM(job_spends.fee), 0) AS total_spend,
+	COUNT(job_spends.eth_tx_id) AS transactions
+FROM jobs
+LEFT JOIN job_spends ON job_spends.job_id = jobs.id`
+
+func (o *orm) JobSpends(monthStart time.Time, qopts ...pg.QOpt) (spends []JobSpend, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Select(&spends, selectJobSpends+`
+WHERE jobs.monthly_budget IS NOT NULL OR job_spends.eth_tx_id IS NOT NULL
+GROUP BY jobs.id
+ORDER BY jobs.id`, monthStart)
+	return spends, errors.Wrap(err, "JobSpends failed")
+}
+
+func (o *orm) FindJobSpend(jobID int32, monthStart time.Time, qopts ...pg.QOpt) (spend JobSpend, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Get(&spend, selectJobSpends+`
+WHERE jobs.id = $2
+GROUP BY jobs.id`, monthStart, jobID)
+	return spend, errors.Wrap(err, "FindJobSpend failed")
+}
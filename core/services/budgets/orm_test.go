@@ -0,0 +1,81 @@
+package budgets_test
+
+import (
+	"database/sql"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/budgets"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+)
+
+// setupSpends inserts a job with a budget of 50000 wei, which spent 63000 wei
+// in two transactions
+func setupSpends(t *testing.T) (budgets.ORM, job.Job) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	txmORM := cltest.NewTxmORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	_, from := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+	jb := cltest.MustInsertV2JobSpec(t, db, from)
+
+	etx1 := cltest.MustInsertConfirmedEthTxWithReceipt(t, txmORM, from, 0, 1)
+	etx2 := cltest.MustInsertConfirmedEthTxWithReceipt(t, txmORM, from, 1, 2)
+	cltest.MustInsertConfirmedEthTxWithReceipt(t, txmORM, from, 2, 3) // without job
+	pgtest.MustExec(t, db, `UPDATE jobs SET monthly_budget = 50000 WHERE id = $1`, jb.ID)
+	pgtest.MustExec(t, db, `UPDATE eth_txes SET meta = jsonb_build_object('JobID', $1::int) WHERE id IN ($2, $3)`, jb.ID, etx1.ID, etx2.ID)
+	pgtest.MustExec(t, db, `UPDATE eth_receipts SET receipt = jsonb_set(receipt, '{gasUsed}', '"0x5208"')`)
+	pgtest.MustExec(t, db, `UPDATE eth_receipts SET receipt = jsonb_set(receipt, '{effectiveGasPrice}', '"0x1"') WHERE tx_hash = $1`, etx2.EthTxAttempts[0].Hash)
+	pgtest.MustExec(t, db, `UPDATE eth_tx_attempts SET gas_price = 2`)
+
+	return budgets.NewORM(db, logger.TestLogger(t), cfg), jb
+}
+
+func TestORM_RecordSpends(t *testing.T) {
+	t.Parallel()
+
+	orm, jb := setupSpends(t)
+
+	count, err := orm.RecordSpends()
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	count, err = orm.RecordSpends()
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	spends, err := orm.JobSpends(budgets.MonthStart(time.Now()))
+	require.NoError(t, err)
+	require.Len(t, spends, 1)
+	assert.Equal(t, jb.ID, spends[0].JobID)
+	assert.Equal(t, int64(2), spends[0].Transactions)
+	// 21000 gas at the gas price of 2 of the attempt, and 21000 gas at the
+	// effective gas price of 1 of the receipt
+	assert.Equal(t, big.NewInt(63000), spends[0].MonthSpend.ToInt())
+	assert.Equal(t, big.NewInt(63000), spends[0].TotalSpend.ToInt())
+	require.NotNil(t, spends[0].MonthlyBudget)
+	assert.Equal(t, big.NewInt(50000), spends[0].MonthlyBudget.ToInt())
+	assert.True(t, spends[0].Exceeded())
+
+	spend, err := orm.FindJobSpend(jb.ID, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(0), spend.MonthSpend.ToInt())
+	assert.Equal(t, big.NewInt(63000), spend.TotalSpend.ToInt())
+	assert.False(t, spend.Exceeded())
+
+	_, err = orm.FindJobSpend(jb.ID+1, time.Now())
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestMonthStart(t *testing.T) {
+	t.Parallel()
+
+	ts := time.Date(2022, time.March, 15, 12, 30, 0, 0, time.FixedZone("UTC+2", 2*60*60))
+	assert.Equal(t, time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC), budgets.MonthStart(ts))
+}
@@ -3,7 +3,9 @@ package chainlink
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"math/big"
+	"net"
 	"net/http"
 	"reflect"
 	"sync"
@@ -25,6 +27,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/monitor"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/chains/solana"
@@ -33,28 +36,42 @@ import (
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
+	"github.com/smartcontractkit/chainlink/core/services/audit"
 	"github.com/smartcontractkit/chainlink/core/services/blockhashstore"
+	"github.com/smartcontractkit/chainlink/core/services/budgets"
 	"github.com/smartcontractkit/chainlink/core/services/cron"
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/core/services/externaldb"
 	"github.com/smartcontractkit/chainlink/core/services/feeds"
 	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
+	"github.com/smartcontractkit/chainlink/core/services/ipfs"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/jobtrigger"
 	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/services/keyrotation"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/core/services/ocr2"
 	"github.com/smartcontractkit/chainlink/core/services/ocrbootstrap"
 	"github.com/smartcontractkit/chainlink/core/services/ocrcommon"
+	"github.com/smartcontractkit/chainlink/core/services/otlp"
+	"github.com/smartcontractkit/chainlink/core/services/payments"
 	"github.com/smartcontractkit/chainlink/core/services/periodicbackup"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/promreporter"
+	"github.com/smartcontractkit/chainlink/core/services/publish"
 	"github.com/smartcontractkit/chainlink/core/services/relay"
 	evmrelay "github.com/smartcontractkit/chainlink/core/services/relay/evm"
+	"github.com/smartcontractkit/chainlink/core/services/runexport"
+	"github.com/smartcontractkit/chainlink/core/services/runoutputs"
 	"github.com/smartcontractkit/chainlink/core/services/synchronization"
 	"github.com/smartcontractkit/chainlink/core/services/telemetry"
+	"github.com/smartcontractkit/chainlink/core/services/updates"
 	"github.com/smartcontractkit/chainlink/core/services/vrf"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/core/services/withdrawals"
 	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -71,7 +88,16 @@ type Application interface {
 	GetConfig() config.GeneralConfig
 	// ConfigDump returns a TOML configuration from the current environment and database configuration.
 	ConfigDump(context.Context) (string, error)
+	// EffectiveConfigDump returns the dumped configuration with the defaults applied and the secrets redacted, and
+	// the source of each value: env, db or default.
+	EffectiveConfigDump(context.Context) (string, map[string]string, error)
 	SetLogLevel(lvl zapcore.Level) error
+	// PackageLogLevels returns the persisted log levels overriding the global
+	// level for packages.
+	PackageLogLevels(ctx context.Context) (map[string]zapcore.Level, error)
+	// SetPackageLogLevel overrides the log level of a package, or clears the
+	// override if lvl is nil.
+	SetPackageLogLevel(ctx context.Context, pkg string, lvl *zapcore.Level) error
 	GetKeyStore() keystore.Master
 	GetEventBroadcaster() pg.EventBroadcaster
 	WakeSessionReaper()
@@ -90,6 +116,10 @@ type Application interface {
 	TxmORM() txmgr.ORM
 	AddJobV2(ctx context.Context, job *job.Job) error
 	DeleteJob(ctx context.Context, jobID int32) error
+	ArchiveJob(ctx context.Context, jobID int32) error
+	PurgeJob(ctx context.Context, jobID int32) error
+	PauseJob(ctx context.Context, jobID int32) error
+	ResumeJob(ctx context.Context, jobID int32) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
 	// Testing only
@@ -98,9 +128,46 @@ type Application interface {
 	// Feeds
 	GetFeedsService() feeds.Service
 
+	// KeyRotator stages and completes the rotations of the OCR key bundles
+	KeyRotator() keyrotation.Rotator
+	// ETHKeyRotator replaces ETH keys, switching their jobs and sweeping
+	// their ETH to the new keys
+	ETHKeyRotator() keyrotation.ETHRotator
+	// LinkWithdrawer withdraws the LINK earned by the Oracle and Operator
+	// contracts with an automatic withdrawal
+	LinkWithdrawer() withdrawals.Withdrawer
+
+	// PeerWrapper is the P2P peer of the OCR jobs, nil if P2P is disabled
+	PeerWrapper() *ocrcommon.SingletonPeerWrapper
+
+	// RunOutputsNotifier delivers the outputs of finished runs to subscribed URLs
+	RunOutputsNotifier() runoutputs.Notifier
+
+	// ExternalDatabases holds the databases queried by the dbquery tasks
+	ExternalDatabases() externaldb.Registry
+
+	// AuditLogger records the changes made through the API
+	AuditLogger() audit.Logger
+
+	// UpdatesBroadcaster pushes the changes of runs, transactions and heads to
+	// the operator UI
+	UpdatesBroadcaster() updates.Broadcaster
+
 	// ReplayFromBlock replays logs from on or after the given block number. If forceBroadcast is
 	// set to true, consumers will reprocess data even if it has already been processed.
 	ReplayFromBlock(chainID *big.Int, number uint64, forceBroadcast bool) error
+	// ReplayJobLogs sends the logs between fromBlock and toBlock to the log listeners of the given
+	// job only, skipping logs the job has already consumed. It returns the number of logs sent.
+	ReplayJobLogs(ctx context.Context, chainID *big.Int, jobID int32, fromBlock, toBlock uint64) (int, error)
+
+	// SetMaintenanceMode pauses (or resumes) the start of new pipeline runs so
+	// that in-flight work can drain before the node is shut down.
+	SetMaintenanceMode(enabled bool)
+	MaintenanceStatus(ctx context.Context) (MaintenanceStatus, error)
+	// CreateMaintenanceWindow and DeleteMaintenanceWindow schedule the periods
+	// during which the new runs submitting transactions are skipped or queued
+	CreateMaintenanceWindow(ctx context.Context, w *pipeline.MaintenanceWindow) error
+	DeleteMaintenanceWindow(ctx context.Context, id int64) error
 
 	// ID is unique to this particular application instance
 	ID() uuid.UUID
@@ -122,6 +189,14 @@ type ChainlinkApplication struct {
 	sessionORM               sessions.ORM
 	txmORM                   txmgr.ORM
 	FeedsService             feeds.Service
+	keyRotator               keyrotation.Rotator
+	ethKeyRotator            keyrotation.ETHRotator
+	linkWithdrawer           withdrawals.Withdrawer
+	peerWrapper              *ocrcommon.SingletonPeerWrapper
+	runOutputsNotifier       runoutputs.Notifier
+	externalDatabases        externaldb.Registry
+	auditLogger              audit.Logger
+	updatesBroadcaster       updates.Broadcaster
 	webhookJobRunner         webhook.JobRunner
 	Config                   config.GeneralConfig
 	KeyStore                 keystore.Master
@@ -253,6 +328,17 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	}
 	subservices = append(subservices, explorerClient, telemetryIngressClient, telemetryIngressBatchClient)
 
+	var telemetryExporter otlp.Exporter
+	if cfg.TelemetryExporterURL() != nil {
+		var err error
+		telemetryExporter, err = otlp.NewExporter(cfg, globalLogger)
+		if err != nil {
+			return nil, errors.Wrap(err, "NewApplication: failed to initialize telemetry exporter")
+		}
+		monitoringEndpointGen = otlp.NewMonitoringEndpointGenerator(monitoringEndpointGen, telemetryExporter)
+		subservices = append(subservices, telemetryExporter)
+	}
+
 	if cfg.DatabaseBackupMode() != config.DatabaseBackupModeNone && cfg.DatabaseBackupFrequency() > 0 {
 		globalLogger.Infow("DatabaseBackup: periodic database backups are enabled", "frequency", cfg.DatabaseBackupFrequency())
 
@@ -270,27 +356,114 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	promReporter := promreporter.NewPromReporter(db.DB, globalLogger)
 	subservices = append(subservices, promReporter)
 
+	if err := pipeline.ConfigureMetrics(cfg.JobPipelineMetricsJobIDLabel(), cfg.JobPipelineMetricsCustomLabels()); err != nil {
+		return nil, errors.Wrap(err, "invalid pipeline metrics config")
+	}
+
 	var (
 		pipelineORM    = pipeline.NewORM(db, globalLogger, cfg)
-		bridgeORM      = bridges.NewORM(db, globalLogger, cfg)
+		bridgeORM      = bridges.NewORM(db, globalLogger, cfg, keyStore.ColumnCiphers())
 		sessionORM     = sessions.NewORM(db, cfg.SessionTimeout().Duration(), globalLogger, cfg)
 		pipelineRunner = pipeline.NewRunner(pipelineORM, cfg, chains.EVM, keyStore.Eth(), keyStore.VRF(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient)
 		jobORM         = job.NewORM(db, chains.EVM, pipelineORM, keyStore, globalLogger, cfg)
 		txmORM         = txmgr.NewORM(db, globalLogger, cfg)
 	)
 
+	windows, err := pipelineORM.FindMaintenanceWindows()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load maintenance windows")
+	}
+	pipelineRunner.SetMaintenanceWindows(windows)
+	pipelineRunner.SetColumnCiphers(keyStore.ColumnCiphers())
+
+	if exportURL := cfg.JobPipelineReaperExportURL(); exportURL != nil && exportURL.String() != "" {
+		exporter, err := runexport.NewExporter(exportURL, cfg.JobPipelineReaperExportEndpoint(), unrestrictedHTTPClient, globalLogger)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid run export config")
+		}
+		pipelineRunner.SetRunExporter(exporter)
+	}
+
+	if publishURL := cfg.JobPipelinePublishURL(); publishURL != nil && publishURL.String() != "" {
+		publisher, err := publish.NewPublisher(publishURL, globalLogger)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid publish config")
+		}
+		pipelineRunner.SetPublisher(publisher)
+		subservices = append(subservices, publisher)
+	}
+
+	ipfsNodeURL, ipfsGatewayURL := cfg.JobPipelineIPFSNodeURL(), cfg.JobPipelineIPFSGatewayURL()
+	if (ipfsNodeURL != nil && ipfsNodeURL.String() != "") || (ipfsGatewayURL != nil && ipfsGatewayURL.String() != "") {
+		client, err := ipfs.NewClient(ipfsNodeURL, ipfsGatewayURL, unrestrictedHTTPClient, globalLogger)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid IPFS config")
+		}
+		pipelineRunner.SetIPFS(client)
+	}
+
+	if cfg.BridgeHealthCheckInterval() > 0 {
+		subservices = append(subservices, bridges.NewHealthChecker(bridgeORM, cfg, unrestrictedHTTPClient, globalLogger))
+	}
+
+	runOutputsNotifier := runoutputs.NewNotifier(runoutputs.NewORM(db, globalLogger, cfg, keyStore.ColumnCiphers()), unrestrictedHTTPClient, globalLogger)
+	pipelineRunner.OnRunFinished(runOutputsNotifier.Notify)
+	if telemetryExporter != nil {
+		pipelineRunner.OnRunFinished(telemetryExporter.ExportRun)
+	}
+	subservices = append(subservices, runOutputsNotifier)
+
+	externalDatabases := externaldb.NewRegistry(externaldb.NewORM(db, globalLogger, cfg, keyStore.ColumnCiphers()), globalLogger)
+	pipelineRunner.SetExternalDatabases(externalDatabases)
+	subservices = append(subservices, externalDatabases)
+
+	budgetsORM := budgets.NewORM(db, globalLogger, cfg)
+	budgetMonitor := budgets.NewMonitor(budgetsORM, jobORM, budgets.CheckInterval, globalLogger)
+	pipelineRunner.SetBudgets(budgetMonitor)
+	subservices = append(subservices, budgetMonitor)
+
+	// The payments of the requests served by directrequest jobs are earned
+	// when their runs complete
+	directRequestDelegate := directrequest.NewDelegate(
+		globalLogger,
+		pipelineRunner,
+		pipelineORM,
+		payments.NewORM(db, globalLogger, cfg),
+		chains.EVM)
+	pipelineRunner.OnRunFinished(directRequestDelegate.OnRunFinished)
+
+	updatesBroadcaster := updates.NewBroadcaster(globalLogger)
+	pipelineRunner.OnRunCreated(updatesBroadcaster.OnRunCreated)
+	pipelineRunner.OnRunFinished(updatesBroadcaster.OnRunFinished)
+
+	auditLogger, err := audit.NewLogger(audit.NewORM(db, globalLogger, cfg), cfg.AuditLogForwardURL(), unrestrictedHTTPClient, globalLogger)
+	if err != nil {
+		return nil, err
+	}
+	subservices = append(subservices, auditLogger)
+
+	rules := alerts.RulesFromConfig(cfg, db, chains.EVM, keyStore.Eth(), globalLogger)
+	if notifiers := alerts.NotifiersFromConfig(cfg, unrestrictedHTTPClient); len(notifiers) > 0 {
+		// The jobs exceeding their budget are always alerted on
+		rules = append(rules, alerts.NewJobBudgetRule(budgetsORM))
+		subservices = append(subservices, alerts.NewAlerter(rules, notifiers, cfg.AlertsCheckInterval(), alerts.NewORM(db, globalLogger, cfg), globalLogger))
+	} else if cfg.AlertsJobErrorsThreshold() > 0 || cfg.AlertsTxStuckThreshold() > 0 || cfg.AlertsKeyBalanceThreshold() != nil {
+		globalLogger.Warn("Alert rules are enabled but no webhook is configured, alerts will not be sent")
+	}
+
 	for _, chain := range chains.EVM.Chains() {
 		chain.HeadBroadcaster().Subscribe(promReporter)
+		chain.HeadBroadcaster().Subscribe(updatesBroadcaster)
 		chain.TxManager().RegisterResumeCallback(pipelineRunner.ResumeRun)
+		chain.TxManager().RegisterTxEventCallback(updatesBroadcaster.OnTxEvent)
+		if telemetryExporter != nil {
+			chain.TxManager().RegisterTxEventCallback(telemetryExporter.ExportTx)
+		}
 	}
 
 	var (
 		delegates = map[job.Type]job.Delegate{
-			job.DirectRequest: directrequest.NewDelegate(
-				globalLogger,
-				pipelineRunner,
-				pipelineORM,
-				chains.EVM),
+			job.DirectRequest: directRequestDelegate,
 			job.Keeper: keeper.NewDelegate(
 				db,
 				jobORM,
@@ -320,6 +493,23 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		webhookJobRunner = delegates[job.Webhook].(*webhook.Delegate).WebhookJobRunner()
 	)
 
+	// Run the funding webhook job of keys whose balance drops to a threshold
+	for _, chain := range chains.EVM.Chains() {
+		if chain.BalanceMonitor() == nil {
+			continue
+		}
+		chain.BalanceMonitor().OnFundingRequired(func(ctx context.Context, req monitor.FundingRequest) {
+			body, err := json.Marshal(req)
+			if err != nil {
+				globalLogger.Errorw("Failed to encode funding request", "address", req.Address, "err", err)
+				return
+			}
+			if _, err = webhookJobRunner.RunJob(ctx, req.FundingJobID, string(body), pipeline.JSONSerializable{}); err != nil {
+				globalLogger.Errorw("Failed to run funding job", "address", req.Address, "jobID", req.FundingJobID, "err", err)
+			}
+		})
+	}
+
 	// Flux monitor requires ethereum just to boot, silence errors with a null delegate
 	if !cfg.EVMRPCEnabled() {
 		delegates[job.FluxMonitor] = &job.NullDelegate{Type: job.FluxMonitor}
@@ -415,7 +605,29 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		lbs = append(lbs, c.LogBroadcaster())
 	}
 	jobSpawner := job.NewSpawner(jobORM, cfg, delegates, db, globalLogger, lbs)
+	if peerWrapper != nil && cfg.P2PV2BootstrappersDNS() != "" {
+		// Resolves the default bootstrappers before the jobs are started
+		subservices = append(subservices, ocrcommon.NewBootstrapperDiscovery(cfg, net.DefaultResolver, peerWrapper, jobSpawner, globalLogger))
+	}
 	subservices = append(subservices, jobSpawner, pipelineRunner)
+	if triggerURL := cfg.JobPipelineQueueTriggerURL(); triggerURL != nil && triggerURL.String() != "" {
+		// Started after the job spawner, for the webhook jobs to be running
+		consumer, err := jobtrigger.NewConsumer(triggerURL, jobtrigger.Workers, globalLogger)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid job trigger config")
+		}
+		trigger, err := jobtrigger.NewTrigger(cfg.JobPipelineQueueTriggerTopics(), consumer, webhookJobRunner, jobtrigger.NewORM(db, globalLogger, cfg), globalLogger)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid job trigger config")
+		}
+		subservices = append(subservices, trigger)
+	}
+	keyRotationORM := keyrotation.NewORM(db, globalLogger, cfg)
+	keyRotator := keyrotation.NewRotator(keyRotationORM, keyStore, jobSpawner, globalLogger)
+	ethKeyRotator := keyrotation.NewETHRotator(keyRotationORM, keyStore.Eth(), chains.EVM, jobSpawner, keyrotation.ETHRotationPollInterval, globalLogger)
+	subservices = append(subservices, ethKeyRotator)
+	linkWithdrawer := withdrawals.NewWithdrawer(withdrawals.NewORM(db, globalLogger, cfg), keyStore.Eth(), chains.EVM, withdrawals.PollInterval, globalLogger)
+	subservices = append(subservices, linkWithdrawer)
 
 	// We start the log poller after the job spawner
 	// so jobs have a chance to apply their initial log filters.
@@ -452,6 +664,14 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		sessionORM:               sessionORM,
 		txmORM:                   txmORM,
 		FeedsService:             feedsService,
+		keyRotator:               keyRotator,
+		ethKeyRotator:            ethKeyRotator,
+		linkWithdrawer:           linkWithdrawer,
+		peerWrapper:              peerWrapper,
+		runOutputsNotifier:       runOutputsNotifier,
+		externalDatabases:        externalDatabases,
+		auditLogger:              auditLogger,
+		updatesBroadcaster:       updatesBroadcaster,
 		Config:                   cfg,
 		webhookJobRunner:         webhookJobRunner,
 		KeyStore:                 keyStore,
@@ -478,6 +698,9 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 			return nil, err
 		}
 	}
+	if err := app.HealthChecker.Register("Database", pg.NewDBHealthChecker(db)); err != nil {
+		return nil, err
+	}
 
 	return app, nil
 }
@@ -499,6 +722,10 @@ func (app *ChainlinkApplication) Start(ctx context.Context) error {
 		panic("application is already started")
 	}
 
+	if err := app.applyPackageLogLevels(pg.NewQ(app.sqlxDB, app.logger, app.Config, pg.WithParentCtx(ctx))); err != nil {
+		app.logger.Errorw("Failed to restore package log levels", "err", err)
+	}
+
 	if app.FeedsService != nil {
 		if err := app.FeedsService.Start(ctx); err != nil {
 			app.logger.Infof("[Feeds Service] %v", err)
@@ -646,6 +873,11 @@ func (app *ChainlinkApplication) WakeSessionReaper() {
 }
 
 func (app *ChainlinkApplication) AddJobV2(ctx context.Context, j *job.Job) error {
+	if app.peerWrapper != nil && app.Config.P2PV2CheckBootstrappers() {
+		if err := app.peerWrapper.CheckBootstrappers(ctx, *j); err != nil {
+			return err
+		}
+	}
 	return app.jobSpawner.CreateJob(j, pg.WithParentCtx(ctx))
 }
 
@@ -664,7 +896,54 @@ func (app *ChainlinkApplication) DeleteJob(ctx context.Context, jobID int32) err
 }
 
 func (app *ChainlinkApplication) RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error) {
-	return app.webhookJobRunner.RunJob(ctx, jobUUID, requestBody, meta)
+	runID, err := app.webhookJobRunner.RunJob(ctx, jobUUID, requestBody, meta)
+	if errors.Is(err, webhook.ErrJobNotExists) {
+		// paused jobs are not registered with the runner
+		jb, ferr := app.jobORM.FindJobByExternalJobID(jobUUID, pg.WithParentCtx(ctx))
+		if ferr == nil && jb.Paused {
+			return 0, job.ErrJobPaused
+		}
+	}
+	return runID, err
+}
+
+// ArchiveJob stops the services of a job and hides it from the active jobs,
+// its spec and runs are kept until it is purged
+func (app *ChainlinkApplication) ArchiveJob(ctx context.Context, jobID int32) error {
+	// Do not allow the job to be archived if it is managed by the Feeds Manager
+	isManaged, err := app.FeedsService.IsJobManaged(ctx, int64(jobID))
+	if err != nil {
+		return err
+	}
+
+	if isManaged {
+		return errors.New("job must be deleted in the feeds manager")
+	}
+
+	return app.jobSpawner.ArchiveJob(jobID, pg.WithParentCtx(ctx))
+}
+
+// PurgeJob deletes an archived job, along with its runs. It returns
+// job.ErrJobNotArchived if the job is not archived.
+func (app *ChainlinkApplication) PurgeJob(ctx context.Context, jobID int32) error {
+	jb, err := app.jobORM.FindJob(ctx, jobID)
+	if err != nil {
+		return err
+	}
+	if !jb.ArchivedAt.Valid {
+		return job.ErrJobNotArchived
+	}
+	return app.jobORM.DeleteJob(jobID, pg.WithParentCtx(ctx))
+}
+
+// PauseJob stops the services of a job until it is resumed
+func (app *ChainlinkApplication) PauseJob(ctx context.Context, jobID int32) error {
+	return app.jobSpawner.PauseJob(jobID, pg.WithParentCtx(ctx))
+}
+
+// ResumeJob restarts the services of a paused job
+func (app *ChainlinkApplication) ResumeJob(ctx context.Context, jobID int32) error {
+	return app.jobSpawner.ResumeJob(ctx, jobID, pg.WithParentCtx(ctx))
 }
 
 // Only used for local testing, not supported by the UI.
@@ -680,6 +959,12 @@ func (app *ChainlinkApplication) RunJobV2(
 	if err != nil {
 		return 0, errors.Wrapf(err, "job ID %v", jobID)
 	}
+	if jb.ArchivedAt.Valid {
+		return 0, errors.Errorf("job ID %v is archived", jobID)
+	}
+	if jb.Paused {
+		return 0, errors.Wrapf(job.ErrJobPaused, "job ID %v", jobID)
+	}
 	var runID int64
 
 	// Some jobs are special in that they do not have a task graph.
@@ -743,6 +1028,38 @@ func (app *ChainlinkApplication) GetFeedsService() feeds.Service {
 	return app.FeedsService
 }
 
+func (app *ChainlinkApplication) KeyRotator() keyrotation.Rotator {
+	return app.keyRotator
+}
+
+func (app *ChainlinkApplication) ETHKeyRotator() keyrotation.ETHRotator {
+	return app.ethKeyRotator
+}
+
+func (app *ChainlinkApplication) LinkWithdrawer() withdrawals.Withdrawer {
+	return app.linkWithdrawer
+}
+
+func (app *ChainlinkApplication) PeerWrapper() *ocrcommon.SingletonPeerWrapper {
+	return app.peerWrapper
+}
+
+func (app *ChainlinkApplication) RunOutputsNotifier() runoutputs.Notifier {
+	return app.runOutputsNotifier
+}
+
+func (app *ChainlinkApplication) ExternalDatabases() externaldb.Registry {
+	return app.externalDatabases
+}
+
+func (app *ChainlinkApplication) AuditLogger() audit.Logger {
+	return app.auditLogger
+}
+
+func (app *ChainlinkApplication) UpdatesBroadcaster() updates.Broadcaster {
+	return app.updatesBroadcaster
+}
+
 // ReplayFromBlock implements the Application interface.
 func (app *ChainlinkApplication) ReplayFromBlock(chainID *big.Int, number uint64, forceBroadcast bool) error {
 	chain, err := app.Chains.EVM.Get(chainID)
@@ -758,6 +1075,18 @@ func (app *ChainlinkApplication) ReplayFromBlock(chainID *big.Int, number uint64
 	return nil
 }
 
+// ReplayJobLogs implements the Application interface.
+func (app *ChainlinkApplication) ReplayJobLogs(ctx context.Context, chainID *big.Int, jobID int32, fromBlock, toBlock uint64) (int, error) {
+	if _, err := app.jobORM.FindJob(ctx, jobID); err != nil {
+		return 0, errors.Wrapf(err, "failed to find job %v", jobID)
+	}
+	chain, err := app.Chains.EVM.Get(chainID)
+	if err != nil {
+		return 0, err
+	}
+	return chain.LogBroadcaster().ReplayJobLogs(ctx, jobID, int64(fromBlock), int64(toBlock))
+}
+
 // GetChains returns Chains.
 func (app *ChainlinkApplication) GetChains() Chains {
 	return app.Chains
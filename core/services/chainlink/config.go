@@ -2,8 +2,11 @@ package chainlink
 
 import (
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/pelletier/go-toml/v2"
+	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
@@ -20,6 +23,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/chains/solana"
 	starknet "github.com/smartcontractkit/chainlink/core/chains/starknet/types"
 	tertyp "github.com/smartcontractkit/chainlink/core/chains/terra/types"
+	"github.com/smartcontractkit/chainlink/core/config/envvar"
 	config "github.com/smartcontractkit/chainlink/core/config/v2"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -47,6 +51,24 @@ type Config struct {
 	Terra TerraConfigs `toml:",omitempty"`
 }
 
+// decodeTOML decodes s into v, returning an ErrUnknown for each key of s which is not a field of v, like a misspelled one.
+func decodeTOML(s string, v any) error {
+	d := toml.NewDecoder(strings.NewReader(s))
+	d.DisallowUnknownFields()
+	err := d.Decode(v)
+	var strict *toml.StrictMissingError
+	if !errors.As(err, &strict) {
+		return err
+	}
+	var errs error
+	for _, e := range strict.Errors {
+		row, col := e.Position()
+		errs = multierr.Append(errs, config.ErrUnknown{Name: strings.Join(e.Key(), "."),
+			Msg: fmt.Sprintf("not a config field, at line %d column %d", row, col)})
+	}
+	return errs
+}
+
 // TOMLString returns a TOML encoded string.
 func (c *Config) TOMLString() (string, error) {
 	b, err := toml.Marshal(c)
@@ -79,6 +101,28 @@ func (s *Secrets) Validate() error {
 	return config.Validate(s)
 }
 
+// secretEnvVars are the legacy env vars which may still be set with TOML config, see Secrets.SetOverrides
+var secretEnvVars = map[string]bool{
+	"DATABASE_URL":        true,
+	"DATABASE_BACKUP_URL": true,
+	"EXPLORER_ACCESS_KEY": true,
+	"EXPLORER_SECRET":     true,
+}
+
+// ValidateLegacyEnv returns an ErrLegacyEnv for each legacy config env var which is set, since they are ignored when
+// configuring with TOML. Only the secrets may be set from the env.
+func ValidateLegacyEnv() (err error) {
+	for _, name := range envvar.Names() {
+		if secretEnvVars[name] {
+			continue
+		}
+		if _, ok := os.LookupEnv(name); ok {
+			err = multierr.Append(err, config.ErrLegacyEnv{Name: name, Msg: "must be set in the TOML config instead"})
+		}
+	}
+	return
+}
+
 // Use ENV vars or flags to override secrets
 func (s *Secrets) SetOverrides(context *cli.Context) error {
 	// Override DB and Explorer secrets from ENV vars, if present
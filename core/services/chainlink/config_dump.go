@@ -35,19 +35,26 @@ import (
 )
 
 func (app *ChainlinkApplication) ConfigDump(ctx context.Context) (string, error) {
-	var chains dbData
+	chains, err := app.loadDBData(ctx)
+	if err != nil {
+		return "", err
+	}
+	return configDump(chains)
+}
+
+// loadDBData loads the chains and nodes configured in the database
+func (app *ChainlinkApplication) loadDBData(ctx context.Context) (chains dbData, err error) {
 	if app != nil {
-		var err error
 		if app.Chains.EVM != nil {
 			chains.EVMChains, _, err = app.Chains.EVM.Index(0, -1)
 			if err != nil {
-				return "", err
+				return chains, err
 			}
 			chains.EVMNodes = make(map[string][]evmtyp.Node)
 			for _, dbChain := range chains.EVMChains {
 				chains.EVMNodes[dbChain.ID.String()], _, err = app.Chains.EVM.GetNodesForChain(ctx, dbChain.ID, 0, -1)
 				if err != nil {
-					return "", errors.Wrapf(err, "failed to get nodes for evm chain %v", dbChain.ID)
+					return chains, errors.Wrapf(err, "failed to get nodes for evm chain %v", dbChain.ID)
 				}
 			}
 		}
@@ -55,14 +62,14 @@ func (app *ChainlinkApplication) ConfigDump(ctx context.Context) (string, error)
 		if app.Chains.Solana != nil {
 			chains.SolanaChains, _, err = app.Chains.Solana.Index(0, -1)
 			if err != nil {
-				return "", err
+				return chains, err
 			}
 
 			chains.SolanaNodes = make(map[string][]soldb.Node)
 			for _, dbChain := range chains.SolanaChains {
 				chains.SolanaNodes[dbChain.ID], _, err = app.Chains.Solana.GetNodesForChain(ctx, dbChain.ID, 0, -1)
 				if err != nil {
-					return "", errors.Wrapf(err, "failed to get nodes for solana chain %s", dbChain.ID)
+					return chains, errors.Wrapf(err, "failed to get nodes for solana chain %s", dbChain.ID)
 				}
 			}
 		}
@@ -70,19 +77,19 @@ func (app *ChainlinkApplication) ConfigDump(ctx context.Context) (string, error)
 		if app.Chains.Terra != nil {
 			chains.TerraChains, _, err = app.Chains.Terra.Index(0, -1)
 			if err != nil {
-				return "", err
+				return chains, err
 			}
 
 			chains.TerraNodes = make(map[string][]terdb.Node)
 			for _, dbChain := range chains.TerraChains {
 				chains.TerraNodes[dbChain.ID], _, err = app.Chains.Terra.GetNodesForChain(ctx, dbChain.ID, 0, -1)
 				if err != nil {
-					return "", errors.Wrapf(err, "failed to get nodes for terra chain %s", dbChain.ID)
+					return chains, errors.Wrapf(err, "failed to get nodes for terra chain %s", dbChain.ID)
 				}
 			}
 		}
 	}
-	return configDump(chains)
+	return chains, nil
 }
 
 type dbData struct {
@@ -214,6 +221,11 @@ func (c *Config) loadLegacyEVMEnv() {
 			c.EVM[i].FinalityDepth = e
 		}
 	}
+	if e := envvar.NewString("EvmFinalityTag").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].FinalityTag = e
+		}
+	}
 	if e := envvar.NewUint32("EvmHeadTrackerHistoryDepth").ParsePtr(); e != nil {
 		for i := range c.EVM {
 			if c.EVM[i].HeadTracker == nil {
@@ -265,6 +277,11 @@ func (c *Config) loadLegacyEVMEnv() {
 			c.EVM[i].LinkContractAddress = e
 		}
 	}
+	if e := envvar.NewBool("LogBroadcasterPolling").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].LogBroadcasterPolling = e
+		}
+	}
 	if e := envvar.New("OperatorFactoryAddress", ethkey.NewEIP55Address).ParsePtr(); e != nil {
 		for i := range c.EVM {
 			c.EVM[i].OperatorFactoryAddress = e
@@ -319,6 +336,22 @@ func (c *Config) loadLegacyEVMEnv() {
 			c.EVM[i].NodePool.SelectionMode = e
 		}
 	}
+	if e := envvar.NewBool("NodeCacheReads").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			if c.EVM[i].NodePool == nil {
+				c.EVM[i].NodePool = &evmcfg.NodePool{}
+			}
+			c.EVM[i].NodePool.CacheReads = e
+		}
+	}
+	if e := envvar.NewString("NodeSendStrategy").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			if c.EVM[i].NodePool == nil {
+				c.EVM[i].NodePool = &evmcfg.NodePool{}
+			}
+			c.EVM[i].NodePool.SendStrategy = e
+		}
+	}
 	for i := range c.EVM {
 		if isZeroPtr(c.EVM[i].NodePool) {
 			c.EVM[i].NodePool = nil
@@ -618,6 +651,11 @@ func (c *Config) loadLegacyEVMEnv() {
 			c.EVM[i].MaxInFlightTransactions = e
 		}
 	}
+	if e := envvar.NewString("EvmMaxQueuedTransactionsPolicy").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].MaxQueuedTransactionsPolicy = e
+		}
+	}
 	if e := envvar.NewBool("EvmNonceAutoSync").ParsePtr(); e != nil {
 		for i := range c.EVM {
 			c.EVM[i].NonceAutoSync = e
@@ -645,6 +683,7 @@ func (c *Config) loadLegacyCoreEnv() {
 	}
 
 	c.Database = &config.Database{
+		AllowSchemaDrift:              envvar.NewBool("DatabaseAllowSchemaDrift").ParsePtr(),
 		DefaultIdleInTxSessionTimeout: mustParseDuration(os.Getenv("DATABASE_DEFAULT_IDLE_IN_TX_SESSION_TIMEOUT")),
 		DefaultLockTimeout:            mustParseDuration(os.Getenv("DATABASE_DEFAULT_LOCK_TIMEOUT")),
 		DefaultQueryTimeout:           mustParseDuration(os.Getenv("DATABASE_DEFAULT_QUERY_TIMEOUT")),
@@ -695,6 +734,32 @@ func (c *Config) loadLegacyCoreEnv() {
 		c.TelemetryIngress = nil
 	}
 
+	c.TelemetryExporter = &config.TelemetryExporter{
+		URL:        envURL("TelemetryExporterURL"),
+		CACertPath: envvar.NewString("TelemetryExporterCACertPath").ParsePtr(),
+		CertPath:   envvar.NewString("TelemetryExporterCertPath").ParsePtr(),
+		KeyPath:    envvar.NewString("TelemetryExporterKeyPath").ParsePtr(),
+	}
+	if isZeroPtr(c.TelemetryExporter) {
+		c.TelemetryExporter = nil
+	}
+
+	c.Alerts = &config.Alerts{
+		CheckInterval:      envDuration("AlertsCheckInterval"),
+		JobErrorsThreshold: envvar.NewUint32("AlertsJobErrorsThreshold").ParsePtr(),
+		JobErrorsWindow:    envDuration("AlertsJobErrorsWindow"),
+		TxStuckThreshold:   envDuration("AlertsTxStuckThreshold"),
+		KeyBalanceThreshold: envvar.New("AlertsKeyBalanceThreshold", func(s string) (e assets.Eth, err error) {
+			err = e.UnmarshalText([]byte(s))
+			return
+		}).ParsePtr(),
+		SlackWebhookURL:     envURL("AlertsSlackWebhookURL"),
+		PagerDutyRoutingKey: envvar.NewString("AlertsPagerDutyRoutingKey").ParsePtr(),
+	}
+	if isZeroPtr(c.Alerts) {
+		c.Alerts = nil
+	}
+
 	c.Log = &config.Log{
 		DatabaseQueries: envvar.NewBool("LogSQL").ParsePtr(),
 		FileDir:         envvar.NewString("LogFileDir").ParsePtr(),
@@ -703,6 +768,7 @@ func (c *Config) loadLegacyCoreEnv() {
 		FileMaxBackups:  envvar.LogFileMaxBackups.ParsePtr(),
 		JSONConsole:     envvar.JSONConsole.ParsePtr(),
 		UnixTS:          envvar.LogUnixTS.ParsePtr(),
+		AuditForwardURL: envURL("AuditLogForwardURL"),
 	}
 	if isZeroPtr(c.Log) {
 		c.Log = nil
@@ -710,15 +776,18 @@ func (c *Config) loadLegacyCoreEnv() {
 
 	c.WebServer = &config.WebServer{
 		AllowOrigins:            envvar.NewString("AllowOrigins").ParsePtr(),
+		AllowHeaders:            envvar.NewString("AllowHeaders").ParsePtr(),
 		BridgeResponseURL:       envURL("BridgeResponseURL"),
 		HTTPWriteTimeout:        envDuration("HTTPServerWriteTimeout"),
 		HTTPPort:                envvar.NewUint16("Port").ParsePtr(),
+		ReadinessStrict:         envvar.NewBool("ReadinessStrict").ParsePtr(),
 		SecureCookies:           envvar.NewBool("SecureCookies").ParsePtr(),
 		SessionTimeout:          envDuration("SessionTimeout"),
 		SessionReaperExpiration: envDuration("ReaperExpiration"),
 		MFA: &config.WebServerMFA{
 			RPID:     envvar.NewString("RPID").ParsePtr(),
 			RPOrigin: envvar.NewString("RPOrigin").ParsePtr(),
+			Enforce:  envvar.NewBool("EnforceWebAuthn").ParsePtr(),
 		},
 		RateLimit: &config.WebServerRateLimit{
 			Authenticated:         envvar.NewInt64("AuthenticatedRateLimit").ParsePtr(),
@@ -748,12 +817,25 @@ func (c *Config) loadLegacyCoreEnv() {
 	}
 
 	c.JobPipeline = &config.JobPipeline{
+		BridgeHealthCheckInterval: envDuration("BridgeHealthCheckInterval"),
+		BridgeHealthCheckPath:     envvar.NewString("BridgeHealthCheckPath").ParsePtr(),
+		BridgeHealthWindow:        envDuration("BridgeHealthWindow"),
 		DefaultHTTPRequestTimeout: envDuration("DefaultHTTPTimeout"),
 		ExternalInitiatorsEnabled: envvar.NewBool("FeatureExternalInitiators").ParsePtr(),
+		IPFSGatewayURL:            envURL("JobPipelineIPFSGatewayURL"),
+		IPFSNodeURL:               envURL("JobPipelineIPFSNodeURL"),
 		MaxRunDuration:            envDuration("JobPipelineMaxRunDuration"),
+		PublishURL:                envURL("JobPipelinePublishURL"),
+		QueueTriggerTopics:        envStringSlice("JobPipelineQueueTriggerTopics"),
+		QueueTriggerURL:           envURL("JobPipelineQueueTriggerURL"),
+		ReaperExportEndpoint:      envURL("JobPipelineReaperExportEndpoint"),
+		ReaperExportURL:           envURL("JobPipelineReaperExportURL"),
 		ReaperInterval:            envDuration("JobPipelineReaperInterval"),
 		ReaperThreshold:           envDuration("JobPipelineReaperThreshold"),
 		ResultWriteQueueDepth:     envvar.NewUint32("JobPipelineResultWriteQueueDepth").ParsePtr(),
+		SpecVariables:             envStringSlice("JobPipelineSpecVariables"),
+		MetricsJobIDLabel:         envvar.NewString("JobPipelineMetricsJobIDLabel").ParsePtr(),
+		MetricsCustomLabels:       envStringSlice("JobPipelineMetricsCustomLabels"),
 	}
 	if p := envvar.NewInt64("DefaultHTTPLimit").ParsePtr(); p != nil {
 		b := utils.FileSize(*p)
@@ -834,7 +916,10 @@ func (c *Config) loadLegacyCoreEnv() {
 		}
 		if ns == v2 || ns == v1v2 {
 			c.P2P.V2 = &config.P2PV2{
-				AnnounceAddresses: envStringSlice("P2PV2AnnounceAddresses"),
+				AnnounceAddresses:               envStringSlice("P2PV2AnnounceAddresses"),
+				BootstrappersDNS:                envvar.NewString("P2PV2BootstrappersDNS").ParsePtr(),
+				BootstrappersDNSRefreshInterval: envDuration("P2PV2BootstrappersDNSRefreshInterval"),
+				CheckBootstrappers:              envvar.NewBool("P2PV2CheckBootstrappers").ParsePtr(),
 				DefaultBootstrappers: envSlice("P2PV2Bootstrappers", func(v *ocrcommontypes.BootstrapperLocator, b []byte) error {
 					fmt.Println("TEST", string(b))
 					return v.UnmarshalText(b)
@@ -0,0 +1,180 @@
+package chainlink
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"strconv"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/pkg/errors"
+)
+
+// Sources of the effective config values
+const (
+	ConfigSourceEnv     = "env"
+	ConfigSourceDB      = "db"
+	ConfigSourceDefault = "default"
+)
+
+const redacted = "xxxxx"
+
+// secretConfigKeys are the config keys whose whole value is secret
+var secretConfigKeys = map[string]bool{
+	"AuthToken":           true,
+	"DSN":                 true,
+	"PagerDutyRoutingKey": true,
+	"SlackWebhookURL":     true,
+}
+
+// EffectiveConfigDump returns the config dumped by ConfigDump with the default
+// values applied and the secrets redacted, and the source of each value, keyed
+// by its dotted TOML path, like EVM.0.GasEstimator.PriceMax.
+func (app *ChainlinkApplication) EffectiveConfigDump(ctx context.Context) (string, map[string]string, error) {
+	data, err := app.loadDBData(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	return effectiveConfigDump(data)
+}
+
+func effectiveConfigDump(data dbData) (string, map[string]string, error) {
+	var fromDB Config
+	if err := fromDB.loadChainsAndNodes(data); err != nil {
+		return "", nil, err
+	}
+	var c Config
+	if err := c.loadChainsAndNodes(data); err != nil {
+		return "", nil, err
+	}
+	c.loadLegacyEVMEnv()
+	c.loadLegacyCoreEnv()
+
+	dbValues, err := flattenConfig(&fromDB)
+	if err != nil {
+		return "", nil, err
+	}
+	values, err := flattenConfig(&c)
+	if err != nil {
+		return "", nil, err
+	}
+
+	c.SetDefaults()
+	s, err := c.TOMLString()
+	if err != nil {
+		return "", nil, err
+	}
+	var effective map[string]interface{}
+	if err = toml.Unmarshal([]byte(s), &effective); err != nil {
+		return "", nil, errors.Wrap(err, "failed to parse effective config")
+	}
+	redactSecrets(effective, false)
+	b, err := toml.Marshal(effective)
+	if err != nil {
+		return "", nil, err
+	}
+
+	sources := map[string]string{}
+	flatten("", effective, func(key string, _ interface{}) {
+		v, set := values[key]
+		dbv, inDB := dbValues[key]
+		switch {
+		case set && (!inDB || !reflect.DeepEqual(v, dbv)):
+			// env vars override the chains config
+			sources[key] = ConfigSourceEnv
+		case set:
+			sources[key] = ConfigSourceDB
+		default:
+			sources[key] = ConfigSourceDefault
+		}
+	})
+	return string(b), sources, nil
+}
+
+// flattenConfig returns the values set in c, keyed by dotted TOML path
+func flattenConfig(c *Config) (map[string]interface{}, error) {
+	s, err := c.TOMLString()
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err = toml.Unmarshal([]byte(s), &m); err != nil {
+		return nil, errors.Wrap(err, "failed to parse config")
+	}
+	values := map[string]interface{}{}
+	flatten("", m, func(key string, v interface{}) {
+		values[key] = v
+	})
+	return values, nil
+}
+
+func flatten(prefix string, v interface{}, fn func(key string, v interface{})) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, e := range t {
+			flatten(joinKey(prefix, k), e, fn)
+		}
+	case []interface{}:
+		if !isTableArray(t) {
+			fn(prefix, v)
+			return
+		}
+		for i, e := range t {
+			flatten(joinKey(prefix, strconv.Itoa(i)), e, fn)
+		}
+	default:
+		fn(prefix, v)
+	}
+}
+
+func joinKey(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
+
+func isTableArray(a []interface{}) bool {
+	for _, e := range a {
+		if _, ok := e.(map[string]interface{}); !ok {
+			return false
+		}
+	}
+	return len(a) > 0
+}
+
+// redactSecrets replaces the secrets of m in place: the secret keys, the
+// passwords of the URLs, and the paths and queries of the node URLs, which
+// often hold API keys
+func redactSecrets(m map[string]interface{}, node bool) {
+	for k, v := range m {
+		switch t := v.(type) {
+		case map[string]interface{}:
+			redactSecrets(t, node)
+		case []interface{}:
+			for _, e := range t {
+				if em, ok := e.(map[string]interface{}); ok {
+					redactSecrets(em, k == "Nodes")
+				}
+			}
+		case string:
+			if secretConfigKeys[k] {
+				m[k] = redacted
+			} else if u, err := url.Parse(t); err == nil && u.Scheme != "" && u.Host != "" {
+				m[k] = redactURL(u, node)
+			}
+		}
+	}
+}
+
+func redactURL(u *url.URL, hidePath bool) string {
+	if _, ok := u.User.Password(); ok {
+		u.User = url.UserPassword(u.User.Username(), redacted)
+	}
+	if hidePath && (len(u.Path) > 1 || u.RawQuery != "") {
+		u.Path = "/" + redacted
+		u.RawPath = ""
+		u.RawQuery = ""
+	}
+	return u.String()
+}
@@ -10,7 +10,6 @@ import (
 	"time"
 
 	"github.com/gin-gonic/contrib/sessions"
-	"github.com/pelletier/go-toml/v2"
 	uuid "github.com/satori/go.uuid"
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
@@ -19,6 +18,9 @@ import (
 	"github.com/smartcontractkit/libocr/commontypes"
 	ocrnetworking "github.com/smartcontractkit/libocr/networking"
 
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	coreconfig "github.com/smartcontractkit/chainlink/core/config"
 	v2 "github.com/smartcontractkit/chainlink/core/config/v2"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
@@ -43,11 +45,15 @@ type generalConfig struct {
 	logLevel        zapcore.Level
 	logSQL          bool
 	logMu           sync.RWMutex
+
+	defaultHTTPTimeout         *models.Duration
+	jobPipelineReaperThreshold *time.Duration
+	overridesMu                sync.RWMutex
 }
 
 func NewGeneralConfig(configToml string, secretsToml string, context *cli.Context) (coreconfig.GeneralConfig, error) {
 	var c Config
-	err := toml.Unmarshal([]byte(configToml), &c)
+	err := decodeTOML(configToml, &c)
 	if err != nil {
 		return nil, err
 	}
@@ -64,7 +70,7 @@ func NewGeneralConfig(configToml string, secretsToml string, context *cli.Contex
 	}
 
 	var s Secrets
-	err = toml.Unmarshal([]byte(secretsToml), &s)
+	err = decodeTOML(secretsToml, &s)
 	if err != nil {
 		return nil, err
 	}
@@ -126,6 +132,18 @@ func (g *generalConfig) EVMEnabled() bool {
 	return false
 }
 
+// firstEVMSetting returns the value of the first EVM chain setting it, or def.
+// It backs the settings which are global in the legacy env config, but set per
+// chain in TOML.
+func firstEVMSetting[T any](evms EVMConfigs, get func(*EVMConfig) *T, def T) T {
+	for _, c := range evms {
+		if v := get(c); v != nil {
+			return *v
+		}
+	}
+	return def
+}
+
 func (g *generalConfig) KeeperCheckUpkeepGasPriceFeatureEnabled() bool {
 	return *g.c.Keeper.UpkeepCheckGasPriceEnabled
 }
@@ -162,10 +180,46 @@ func (g *generalConfig) StarkNetEnabled() bool {
 	return false
 }
 
+func (g *generalConfig) AlertsCheckInterval() time.Duration {
+	return g.c.Alerts.CheckInterval.Duration()
+}
+
+func (g *generalConfig) AlertsJobErrorsThreshold() uint32 {
+	return *g.c.Alerts.JobErrorsThreshold
+}
+
+func (g *generalConfig) AlertsJobErrorsWindow() time.Duration {
+	return g.c.Alerts.JobErrorsWindow.Duration()
+}
+
+func (g *generalConfig) AlertsKeyBalanceThreshold() *assets.Eth {
+	return g.c.Alerts.KeyBalanceThreshold
+}
+
+func (g *generalConfig) AlertsPagerDutyRoutingKey() string {
+	return *g.c.Alerts.PagerDutyRoutingKey
+}
+
+func (g *generalConfig) AlertsSlackWebhookURL() *url.URL {
+	return (*url.URL)(g.c.Alerts.SlackWebhookURL)
+}
+
+func (g *generalConfig) AlertsTxStuckThreshold() time.Duration {
+	return g.c.Alerts.TxStuckThreshold.Duration()
+}
+
+func (g *generalConfig) AllowHeaders() string {
+	return *g.c.WebServer.AllowHeaders
+}
+
 func (g *generalConfig) AllowOrigins() string {
 	return *g.c.WebServer.AllowOrigins
 }
 
+func (g *generalConfig) AuditLogForwardURL() *url.URL {
+	return (*url.URL)(g.c.Log.AuditForwardURL)
+}
+
 func (g *generalConfig) AuthenticatedRateLimit() int64 {
 	return *g.c.WebServer.RateLimit.Authenticated
 }
@@ -240,6 +294,48 @@ func (g *generalConfig) BlockBackfillSkip() bool {
 	panic("implement me")
 }
 
+func (g *generalConfig) LogBroadcasterPolling() bool {
+	return firstEVMSetting(g.c.EVM, func(c *EVMConfig) *bool { return c.LogBroadcasterPolling }, false)
+}
+
+func (g *generalConfig) NodeCacheReads() bool {
+	return firstEVMSetting(g.c.EVM, func(c *EVMConfig) *bool {
+		if c.NodePool == nil {
+			return nil
+		}
+		return c.NodePool.CacheReads
+	}, false)
+}
+
+func (g *generalConfig) NodeSendStrategy() string {
+	return firstEVMSetting(g.c.EVM, func(c *EVMConfig) *string {
+		if c.NodePool == nil {
+			return nil
+		}
+		return c.NodePool.SendStrategy
+	}, client.NodeSendStrategy_Broadcast)
+}
+
+func (g *generalConfig) EvmFinalityTag() string {
+	return firstEVMSetting(g.c.EVM, func(c *EVMConfig) *string { return c.FinalityTag }, "")
+}
+
+func (g *generalConfig) EvmMaxQueuedTransactionsPolicy() string {
+	return firstEVMSetting(g.c.EVM, func(c *EVMConfig) *string { return c.MaxQueuedTransactionsPolicy }, txmgr.EthTxQueuePolicyReject)
+}
+
+func (g *generalConfig) BridgeHealthCheckInterval() time.Duration {
+	return g.c.JobPipeline.BridgeHealthCheckInterval.Duration()
+}
+
+func (g *generalConfig) BridgeHealthCheckPath() string {
+	return *g.c.JobPipeline.BridgeHealthCheckPath
+}
+
+func (g *generalConfig) BridgeHealthWindow() time.Duration {
+	return g.c.JobPipeline.BridgeHealthWindow.Duration()
+}
+
 func (g *generalConfig) BridgeResponseURL() *url.URL {
 	return (*url.URL)(g.c.WebServer.BridgeResponseURL)
 }
@@ -248,6 +344,10 @@ func (g *generalConfig) CertFile() string {
 	return *g.c.WebServer.TLS.CertPath
 }
 
+func (g *generalConfig) DatabaseAllowSchemaDrift() bool {
+	return *g.c.Database.AllowSchemaDrift
+}
+
 func (g *generalConfig) DatabaseBackupDir() string {
 	return *g.c.Database.Backup.Dir
 }
@@ -277,6 +377,11 @@ func (g *generalConfig) DefaultHTTPLimit() int64 {
 }
 
 func (g *generalConfig) DefaultHTTPTimeout() models.Duration {
+	g.overridesMu.RLock()
+	defer g.overridesMu.RUnlock()
+	if g.defaultHTTPTimeout != nil {
+		return *g.defaultHTTPTimeout
+	}
 	return *g.c.JobPipeline.DefaultHTTPRequestTimeout
 }
 
@@ -313,15 +418,62 @@ func (g *generalConfig) JSONConsole() bool {
 	return *g.c.Log.JSONConsole
 }
 
+func (g *generalConfig) JobPipelineIPFSGatewayURL() *url.URL {
+	return (*url.URL)(g.c.JobPipeline.IPFSGatewayURL)
+}
+
+func (g *generalConfig) JobPipelineIPFSNodeURL() *url.URL {
+	return (*url.URL)(g.c.JobPipeline.IPFSNodeURL)
+}
+
 func (g *generalConfig) JobPipelineMaxRunDuration() time.Duration {
 	return g.c.JobPipeline.MaxRunDuration.Duration()
 }
 
+func (g *generalConfig) JobPipelineMetricsCustomLabels() []string {
+	if v := g.c.JobPipeline.MetricsCustomLabels; v != nil {
+		return *v
+	}
+	return nil
+}
+
+func (g *generalConfig) JobPipelineMetricsJobIDLabel() string {
+	return *g.c.JobPipeline.MetricsJobIDLabel
+}
+
+func (g *generalConfig) JobPipelinePublishURL() *url.URL {
+	return (*url.URL)(g.c.JobPipeline.PublishURL)
+}
+
+func (g *generalConfig) JobPipelineQueueTriggerTopics() []string {
+	if v := g.c.JobPipeline.QueueTriggerTopics; v != nil {
+		return *v
+	}
+	return nil
+}
+
+func (g *generalConfig) JobPipelineQueueTriggerURL() *url.URL {
+	return (*url.URL)(g.c.JobPipeline.QueueTriggerURL)
+}
+
+func (g *generalConfig) JobPipelineReaperExportEndpoint() *url.URL {
+	return (*url.URL)(g.c.JobPipeline.ReaperExportEndpoint)
+}
+
+func (g *generalConfig) JobPipelineReaperExportURL() *url.URL {
+	return (*url.URL)(g.c.JobPipeline.ReaperExportURL)
+}
+
 func (g *generalConfig) JobPipelineReaperInterval() time.Duration {
 	return g.c.JobPipeline.ReaperInterval.Duration()
 }
 
 func (g *generalConfig) JobPipelineReaperThreshold() time.Duration {
+	g.overridesMu.RLock()
+	defer g.overridesMu.RUnlock()
+	if g.jobPipelineReaperThreshold != nil {
+		return *g.jobPipelineReaperThreshold
+	}
 	return g.c.JobPipeline.ReaperThreshold.Duration()
 }
 
@@ -329,6 +481,13 @@ func (g *generalConfig) JobPipelineResultWriteQueueDepth() uint64 {
 	return uint64(*g.c.JobPipeline.ResultWriteQueueDepth)
 }
 
+func (g *generalConfig) JobPipelineSpecVariables() []string {
+	if v := g.c.JobPipeline.SpecVariables; v != nil {
+		return *v
+	}
+	return nil
+}
+
 func (g *generalConfig) KeeperDefaultTransactionQueueDepth() uint32 {
 	return *g.c.Keeper.DefaultTransactionQueueDepth
 }
@@ -436,6 +595,14 @@ func (g *generalConfig) RPOrigin() string {
 	return *g.c.WebServer.MFA.RPOrigin
 }
 
+func (g *generalConfig) EnforceWebAuthn() bool {
+	return *g.c.WebServer.MFA.Enforce
+}
+
+func (g *generalConfig) ReadinessStrict() bool {
+	return *g.c.WebServer.ReadinessStrict
+}
+
 func (g *generalConfig) ReaperExpiration() models.Duration {
 	return *g.c.WebServer.SessionReaperExpiration
 }
@@ -496,6 +663,22 @@ func (g *generalConfig) TelemetryIngressServerPubKey() string {
 	return *g.c.TelemetryIngress.ServerPubKey
 }
 
+func (g *generalConfig) TelemetryExporterURL() *url.URL {
+	return (*url.URL)(g.c.TelemetryExporter.URL)
+}
+
+func (g *generalConfig) TelemetryExporterCACertPath() string {
+	return *g.c.TelemetryExporter.CACertPath
+}
+
+func (g *generalConfig) TelemetryExporterCertPath() string {
+	return *g.c.TelemetryExporter.CertPath
+}
+
+func (g *generalConfig) TelemetryExporterKeyPath() string {
+	return *g.c.TelemetryExporter.KeyPath
+}
+
 func (g *generalConfig) TelemetryIngressURL() *url.URL {
 	return (*url.URL)(g.c.TelemetryIngress.URL)
 }
@@ -710,6 +893,39 @@ func (g *generalConfig) P2PV2Bootstrappers() (locators []commontypes.Bootstrappe
 	return nil
 }
 
+func (g *generalConfig) P2PV2BootstrappersDNS() string {
+	if p := g.c.P2P; p != nil {
+		if v2 := p.V2; v2 != nil {
+			if v := v2.BootstrappersDNS; v != nil {
+				return *v
+			}
+		}
+	}
+	return ""
+}
+
+func (g *generalConfig) P2PV2BootstrappersDNSRefreshInterval() models.Duration {
+	if p := g.c.P2P; p != nil {
+		if v2 := p.V2; v2 != nil {
+			if v := v2.BootstrappersDNSRefreshInterval; v != nil {
+				return *v
+			}
+		}
+	}
+	return models.Duration{}
+}
+
+func (g *generalConfig) P2PV2CheckBootstrappers() bool {
+	if p := g.c.P2P; p != nil {
+		if v2 := p.V2; v2 != nil {
+			if v := v2.CheckBootstrappers; v != nil {
+				return *v
+			}
+		}
+	}
+	return false
+}
+
 func (g *generalConfig) P2PV2BootstrappersRaw() (s []string) {
 	if p := g.c.P2P; p != nil {
 		if v2 := p.V2; v2 != nil {
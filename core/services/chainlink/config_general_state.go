@@ -1,8 +1,12 @@
 package chainlink
 
 import (
+	"time"
+
 	uuid "github.com/satori/go.uuid"
 	"go.uber.org/zap/zapcore"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
 func (g *generalConfig) AppID() uuid.UUID {
@@ -42,3 +46,15 @@ func (g *generalConfig) SetLogSQL(logSQL bool) {
 	g.logSQL = logSQL
 	g.logMu.Unlock()
 }
+
+func (g *generalConfig) SetDefaultHTTPTimeout(timeout models.Duration) {
+	g.overridesMu.Lock()
+	g.defaultHTTPTimeout = &timeout
+	g.overridesMu.Unlock()
+}
+
+func (g *generalConfig) SetJobPipelineReaperThreshold(threshold time.Duration) {
+	g.overridesMu.Lock()
+	g.jobPipelineReaperThreshold = &threshold
+	g.overridesMu.Unlock()
+}
@@ -14,3 +14,16 @@ func FakeConfigDump(dbDataJSON []byte) (string, error) {
 
 	return configDump(data)
 }
+
+// FakeEffectiveConfigDump is a test helper for effectiveConfigDump which loads dbData from a JSON string.
+func FakeEffectiveConfigDump(dbDataJSON []byte) (string, map[string]string, error) {
+	var data dbData
+
+	if len(dbDataJSON) > 0 {
+		if err := json.Unmarshal(dbDataJSON, &data); err != nil {
+			return "", nil, err
+		}
+	}
+
+	return effectiveConfigDump(data)
+}
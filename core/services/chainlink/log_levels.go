@@ -0,0 +1,69 @@
+package chainlink
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// PackageLogLevels returns the log levels overriding the global level for
+// packages, keyed by package path, e.g. core/services/pipeline.
+func (app *ChainlinkApplication) PackageLogLevels(ctx context.Context) (map[string]zapcore.Level, error) {
+	q := pg.NewQ(app.sqlxDB, app.logger, app.Config, pg.WithParentCtx(ctx))
+	return loadPackageLogLevels(q)
+}
+
+// SetPackageLogLevel overrides the log level of pkg and its subpackages, or
+// clears the override if lvl is nil. Overrides are persisted and restored when
+// the node starts.
+func (app *ChainlinkApplication) SetPackageLogLevel(ctx context.Context, pkg string, lvl *zapcore.Level) error {
+	pkg = strings.Trim(strings.TrimSpace(pkg), "/")
+	if pkg == "" {
+		return errors.New("package must not be empty")
+	}
+	q := pg.NewQ(app.sqlxDB, app.logger, app.Config, pg.WithParentCtx(ctx))
+	var err error
+	if lvl == nil {
+		err = q.ExecQ(`DELETE FROM package_log_levels WHERE package = $1`, pkg)
+	} else {
+		err = q.ExecQ(`INSERT INTO package_log_levels (package, log_level, created_at, updated_at) VALUES ($1, $2, NOW(), NOW())
+ON CONFLICT (package) DO UPDATE SET log_level = EXCLUDED.log_level, updated_at = NOW()`, pkg, lvl.String())
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to save package log level")
+	}
+	return app.applyPackageLogLevels(q)
+}
+
+// applyPackageLogLevels sets the persisted package log levels on the logger
+func (app *ChainlinkApplication) applyPackageLogLevels(q pg.Q) error {
+	levels, err := loadPackageLogLevels(q)
+	if err != nil {
+		return err
+	}
+	app.logger.SetPackageLogLevels(levels)
+	return nil
+}
+
+func loadPackageLogLevels(q pg.Queryer) (map[string]zapcore.Level, error) {
+	var rows []struct {
+		Package  string
+		LogLevel string
+	}
+	if err := q.Select(&rows, `SELECT package, log_level FROM package_log_levels`); err != nil {
+		return nil, errors.Wrap(err, "failed to load package log levels")
+	}
+	levels := make(map[string]zapcore.Level, len(rows))
+	for _, r := range rows {
+		var lvl zapcore.Level
+		if err := lvl.UnmarshalText([]byte(r.LogLevel)); err != nil {
+			return nil, errors.Wrapf(err, "invalid log level for package %s", r.Package)
+		}
+		levels[r.Package] = lvl
+	}
+	return levels, nil
+}
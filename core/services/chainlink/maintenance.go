@@ -0,0 +1,94 @@
+package chainlink
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+// MaintenanceStatus reports whether the node is in maintenance mode and how
+// much work is still in flight.
+type MaintenanceStatus struct {
+	Enabled              bool
+	InFlightRuns         int64
+	InFlightTransactions uint32
+	// QueuedRuns are waiting for the end of a maintenance window or of the
+	// maintenance mode
+	QueuedRuns int
+}
+
+// ReadyForShutdown returns true once maintenance mode is enabled and all
+// in-flight runs and unbroadcast transactions have drained.
+func (s MaintenanceStatus) ReadyForShutdown() bool {
+	return s.Enabled && s.InFlightRuns == 0 && s.InFlightTransactions == 0
+}
+
+// SetMaintenanceMode stops (or resumes) the pipeline runner from starting new
+// runs. The runs triggered meanwhile (logs, cron, webhooks, etc.) are inserted
+// and queued, so that no request is lost, and start once maintenance mode is
+// left or after a restart. Runs that are already in flight continue until they
+// complete.
+func (app *ChainlinkApplication) SetMaintenanceMode(enabled bool) {
+	if enabled {
+		app.logger.Warn("Entering maintenance mode")
+		app.pipelineRunner.Pause()
+	} else {
+		app.logger.Info("Leaving maintenance mode")
+		app.pipelineRunner.Unpause()
+	}
+}
+
+// MaintenanceStatus returns the current maintenance mode status.
+func (app *ChainlinkApplication) MaintenanceStatus(ctx context.Context) (MaintenanceStatus, error) {
+	status := MaintenanceStatus{
+		Enabled:      app.pipelineRunner.IsPaused(),
+		InFlightRuns: app.pipelineRunner.InFlightRuns(),
+		QueuedRuns:   app.pipelineRunner.QueuedRuns(),
+	}
+	q := pg.NewQ(app.sqlxDB, app.logger, app.Config, pg.WithParentCtx(ctx))
+	count, err := txmgr.CountInFlightTransactions(q)
+	if err != nil {
+		return status, err
+	}
+	status.InFlightTransactions = count
+	return status, nil
+}
+
+// CreateMaintenanceWindow saves a maintenance window, of the job w.JobID or of
+// every job if nil, and applies it to the new runs.
+func (app *ChainlinkApplication) CreateMaintenanceWindow(ctx context.Context, w *pipeline.MaintenanceWindow) error {
+	if err := w.Validate(); err != nil {
+		return err
+	}
+	if w.JobID != nil {
+		if _, err := app.jobORM.FindJob(ctx, *w.JobID); err != nil {
+			return errors.Wrapf(err, "failed to find job %d", *w.JobID)
+		}
+	}
+	if err := app.pipelineORM.CreateMaintenanceWindow(w, pg.WithParentCtx(ctx)); err != nil {
+		return err
+	}
+	return app.reloadMaintenanceWindows(ctx)
+}
+
+// DeleteMaintenanceWindow deletes a maintenance window, the runs it queued
+// start once no other window applies to them.
+func (app *ChainlinkApplication) DeleteMaintenanceWindow(ctx context.Context, id int64) error {
+	if err := app.pipelineORM.DeleteMaintenanceWindow(id, pg.WithParentCtx(ctx)); err != nil {
+		return err
+	}
+	return app.reloadMaintenanceWindows(ctx)
+}
+
+func (app *ChainlinkApplication) reloadMaintenanceWindows(ctx context.Context) error {
+	windows, err := app.pipelineORM.FindMaintenanceWindows(pg.WithParentCtx(ctx))
+	if err != nil {
+		return errors.Wrap(err, "failed to load maintenance windows")
+	}
+	app.pipelineRunner.SetMaintenanceWindows(windows)
+	return nil
+}
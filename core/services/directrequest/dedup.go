@@ -0,0 +1,55 @@
+package directrequest
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var promSuppressedDuplicateRuns = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "direct_request_suppressed_duplicate_runs",
+	Help: "The number of oracle requests not run because their dedup key was seen within the job's dedupTTL",
+}, []string{"job_id", "external_job_id"})
+
+// requestDeduper remembers dedup keys for a fixed TTL so that repeated oracle
+// requests resolving to the same key only trigger a single pipeline run.
+type requestDeduper struct {
+	ttl  time.Duration
+	now  func() time.Time
+	mu   sync.Mutex
+	seen map[string]time.Time
+}
+
+func newRequestDeduper(ttl time.Duration) *requestDeduper {
+	return &requestDeduper{
+		ttl:  ttl,
+		now:  time.Now,
+		seen: make(map[string]time.Time),
+	}
+}
+
+// shouldRun returns false if key was already seen and has not yet expired,
+// otherwise it records key and returns true.
+func (d *requestDeduper) shouldRun(key string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	if expiresAt, ok := d.seen[key]; ok && now.Before(expiresAt) {
+		return false
+	}
+	d.seen[key] = now.Add(d.ttl)
+	d.prune(now)
+	return true
+}
+
+// prune drops all expired keys. Must be called with mu held.
+func (d *requestDeduper) prune(now time.Time) {
+	for key, expiresAt := range d.seen {
+		if !now.Before(expiresAt) {
+			delete(d.seen, key)
+		}
+	}
+}
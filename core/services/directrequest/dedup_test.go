@@ -0,0 +1,30 @@
+package directrequest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestDeduper(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1600000000, 0)
+	d := newRequestDeduper(time.Minute)
+	d.now = func() time.Time { return now }
+
+	assert.True(t, d.shouldRun("a"))
+	assert.False(t, d.shouldRun("a"))
+	assert.True(t, d.shouldRun("b"))
+
+	now = now.Add(59 * time.Second)
+	assert.False(t, d.shouldRun("a"))
+
+	now = now.Add(time.Second)
+	assert.True(t, d.shouldRun("a"))
+	assert.False(t, d.shouldRun("a"))
+
+	// b expired and was pruned when a was recorded again
+	assert.Len(t, d.seen, 1)
+}
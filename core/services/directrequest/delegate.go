@@ -16,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/gethwrappers/generated/operator_wrapper"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/payments"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -27,6 +28,7 @@ type (
 		logger         logger.Logger
 		pipelineRunner pipeline.Runner
 		pipelineORM    pipeline.ORM
+		paymentsORM    payments.ORM
 		chHeads        chan *evmtypes.Head
 		chainSet       evm.ChainSet
 	}
@@ -43,12 +45,14 @@ func NewDelegate(
 	logger logger.Logger,
 	pipelineRunner pipeline.Runner,
 	pipelineORM pipeline.ORM,
+	paymentsORM payments.ORM,
 	chainSet evm.ChainSet,
 ) *Delegate {
 	return &Delegate{
 		logger.Named("DirectRequest"),
 		pipelineRunner,
 		pipelineORM,
+		paymentsORM,
 		make(chan *evmtypes.Head, 1),
 		chainSet,
 	}
@@ -92,20 +96,74 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 		oracle:                   oracle,
 		pipelineRunner:           d.pipelineRunner,
 		pipelineORM:              d.pipelineORM,
+		paymentsORM:              d.paymentsORM,
+		evmChainID:               *utils.NewBig(chain.ID()),
 		job:                      jb,
 		mbOracleRequests:         utils.NewHighCapacityMailbox[log.Broadcast](),
 		mbOracleCancelRequests:   utils.NewHighCapacityMailbox[log.Broadcast](),
+		mbOracleResponses:        utils.NewHighCapacityMailbox[log.Broadcast](),
 		minIncomingConfirmations: concreteSpec.MinIncomingConfirmations.Uint32,
 		requesters:               concreteSpec.Requesters,
 		minContractPayment:       concreteSpec.MinContractPayment,
 		chStop:                   make(chan struct{}),
 	}
+	if concreteSpec.DedupKey.Valid {
+		ttl := concreteSpec.DedupTTL
+		if ttl == 0 {
+			ttl = defaultDedupTTL
+		}
+		logListener.dedupKey = concreteSpec.DedupKey.String
+		logListener.deduper = newRequestDeduper(ttl)
+	}
 	var services []job.ServiceCtx
 	services = append(services, logListener)
 
 	return services, nil
 }
 
+// OnRunFinished marks the payment of the request served by a completed run as
+// earned. Oracle contracts don't log their responses, unlike Operator
+// contracts.
+func (d *Delegate) OnRunFinished(run *pipeline.Run) {
+	if run.Pending || run.State != pipeline.RunStatusCompleted {
+		return
+	}
+	jobID, requestID, ok := requestOfRun(run)
+	if !ok {
+		return
+	}
+	if err := d.paymentsORM.MarkFulfilled(jobID, requestID); err != nil {
+		d.logger.Errorw("Failed to mark payment as fulfilled", "jobID", jobID, "requestId", requestID.Hex(), "err", err)
+	}
+}
+
+// requestOfRun returns the job and the request ID of a run started by an
+// OracleRequest, from its inputs as the run may be loaded from the database
+func requestOfRun(run *pipeline.Run) (jobID int32, requestID common.Hash, ok bool) {
+	inputs, ok := run.Inputs.Val.(map[string]interface{})
+	if !ok {
+		return 0, requestID, false
+	}
+	vars := pipeline.NewVarsFrom(inputs)
+	id, err := vars.Get("jobRun.meta.oracleRequest.requestId")
+	if err != nil {
+		return 0, requestID, false
+	}
+	idHex, ok := id.(string)
+	if !ok {
+		return 0, requestID, false
+	}
+	dbID, err := vars.Get("jobSpec.databaseID")
+	if err != nil {
+		return 0, requestID, false
+	}
+	jid, err := utils.ToDecimal(dbID)
+	if err != nil {
+		return 0, requestID, false
+	}
+	return int32(jid.IntPart()), common.HexToHash(idHex), true
+}
+
 var (
 	_ log.Listener   = &listener{}
 	_ job.ServiceCtx = &listener{}
@@ -118,14 +176,19 @@ type listener struct {
 	oracle                   operator_wrapper.OperatorInterface
 	pipelineRunner           pipeline.Runner
 	pipelineORM              pipeline.ORM
+	paymentsORM              payments.ORM
+	evmChainID               utils.Big
 	job                      job.Job
 	runs                     sync.Map
 	shutdownWaitGroup        sync.WaitGroup
 	mbOracleRequests         *utils.Mailbox[log.Broadcast]
 	mbOracleCancelRequests   *utils.Mailbox[log.Broadcast]
+	mbOracleResponses        *utils.Mailbox[log.Broadcast]
 	minIncomingConfirmations uint32
 	requesters               models.AddressCollection
 	minContractPayment       *assets.Link
+	dedupKey                 string
+	deduper                  *requestDeduper
 	chStop                   chan struct{}
 	utils.StartStopOnce
 }
@@ -139,12 +202,16 @@ func (l *listener) Start(context.Context) error {
 			LogsWithTopics: map[common.Hash][][]log.Topic{
 				operator_wrapper.OperatorOracleRequest{}.Topic():       {{log.Topic(l.job.ExternalIDEncodeBytesToTopic()), log.Topic(l.job.ExternalIDEncodeStringToTopic())}},
 				operator_wrapper.OperatorCancelOracleRequest{}.Topic(): {{log.Topic(l.job.ExternalIDEncodeBytesToTopic()), log.Topic(l.job.ExternalIDEncodeStringToTopic())}},
+				// OracleResponse logs are indexed by request ID, and only logged by
+				// Operator contracts
+				operator_wrapper.OperatorOracleResponse{}.Topic(): nil,
 			},
 			MinIncomingConfirmations: l.minIncomingConfirmations,
 		})
-		l.shutdownWaitGroup.Add(3)
+		l.shutdownWaitGroup.Add(4)
 		go l.processOracleRequests()
 		go l.processCancelOracleRequests()
+		go l.processOracleResponses()
 
 		go func() {
 			<-l.chStop
@@ -191,6 +258,11 @@ func (l *listener) HandleLog(lb log.Broadcast) {
 		if wasOverCapacity {
 			l.logger.Error("CancelOracleRequest log mailbox is over capacity - dropped the oldest log")
 		}
+	case *operator_wrapper.OperatorOracleResponse:
+		wasOverCapacity := l.mbOracleResponses.Deliver(lb)
+		if wasOverCapacity {
+			l.logger.Error("OracleResponse log mailbox is over capacity - dropped the oldest log")
+		}
 	default:
 		l.logger.Warnf("Unexpected log type %T", log)
 	}
@@ -220,6 +292,18 @@ func (l *listener) processCancelOracleRequests() {
 	}
 }
 
+func (l *listener) processOracleResponses() {
+	for {
+		select {
+		case <-l.chStop:
+			l.shutdownWaitGroup.Done()
+			return
+		case <-l.mbOracleResponses.Notify():
+			l.handleReceivedLogs(l.mbOracleResponses)
+		}
+	}
+}
+
 func (l *listener) handleReceivedLogs(mailbox *utils.Mailbox[log.Broadcast]) {
 	for {
 		lb, exists := mailbox.Retrieve()
@@ -234,6 +318,14 @@ func (l *listener) handleReceivedLogs(mailbox *utils.Mailbox[log.Broadcast]) {
 			return
 		}
 
+		// OracleResponse logs are indexed by request ID instead of job ID
+		if lb.RawLog().Topics[0] == (operator_wrapper.OperatorOracleResponse{}).Topic() {
+			if response, ok := lb.DecodedLog().(*operator_wrapper.OperatorOracleResponse); ok {
+				l.handleOracleResponse(response, lb)
+			}
+			continue
+		}
+
 		logJobSpecID := lb.RawLog().Topics[1]
 		if logJobSpecID == (common.Hash{}) || (logJobSpecID != l.job.ExternalIDEncodeStringToTopic() && logJobSpecID != l.job.ExternalIDEncodeBytesToTopic()) {
 			l.logger.Debugw("Skipping Run for Log with wrong Job ID", "logJobSpecID", logJobSpecID)
@@ -312,8 +404,14 @@ func (l *listener) handleOracleRequest(request *operator_wrapper.OperatorOracleR
 		}
 	}
 
+	oracleRequest := oracleRequestToMap(request)
+	if l.isDuplicate(oracleRequest) {
+		l.markLogConsumed(lb)
+		return
+	}
+
 	meta := make(map[string]interface{})
-	meta["oracleRequest"] = oracleRequestToMap(request)
+	meta["oracleRequest"] = oracleRequest
 
 	runCloserChannel := make(chan struct{})
 	runCloserChannelIf, loaded := l.runs.LoadOrStore(formatRequestId(request.RequestId), runCloserChannel)
@@ -345,7 +443,7 @@ func (l *listener) handleOracleRequest(request *operator_wrapper.OperatorOracleR
 	run := pipeline.NewRun(*l.job.PipelineSpec, vars)
 	_, err := l.pipelineRunner.Run(ctx, &run, l.logger, true, func(tx pg.Queryer) error {
 		l.markLogConsumed(lb, pg.WithQueryer(tx))
-		return nil
+		return l.recordPayment(request, pg.WithQueryer(tx))
 	})
 	if ctx.Err() != nil {
 		return
@@ -354,6 +452,45 @@ func (l *listener) handleOracleRequest(request *operator_wrapper.OperatorOracleR
 	}
 }
 
+// recordPayment stores the payment of the request, until it's fulfilled or
+// cancelled
+func (l *listener) recordPayment(request *operator_wrapper.OperatorOracleRequest, qopts ...pg.QOpt) error {
+	if request.Payment == nil {
+		return nil
+	}
+	return l.paymentsORM.RecordRequest(payments.Payment{
+		JobID:           l.job.ID,
+		EVMChainID:      l.evmChainID,
+		ContractAddress: request.Raw.Address,
+		RequestID:       request.RequestId,
+		Requester:       request.Requester,
+		Amount:          assets.Link(*request.Payment),
+		RequestTxHash:   request.Raw.TxHash,
+	}, qopts...)
+}
+
+// isDuplicate reports whether the request's dedup key was already seen within
+// the job's dedupTTL. Requests whose key cannot be resolved are never treated
+// as duplicates.
+func (l *listener) isDuplicate(oracleRequest map[string]interface{}) bool {
+	if l.deduper == nil {
+		return false
+	}
+	key, err := pipeline.VarExpr(l.dedupKey, pipeline.NewVarsFrom(map[string]interface{}{
+		"oracleRequest": oracleRequest,
+	}))()
+	if err != nil {
+		l.logger.Errorw("Could not resolve dedupKey, running request anyway", "dedupKey", l.dedupKey, "err", err)
+		return false
+	}
+	if l.deduper.shouldRun(fmt.Sprintf("%v", key)) {
+		return false
+	}
+	l.logger.Infow("Suppressed duplicate run", "dedupKey", l.dedupKey, "key", key, "requestId", oracleRequest["requestId"])
+	promSuppressedDuplicateRuns.WithLabelValues(fmt.Sprintf("%d", l.job.ID), l.job.ExternalJobID.String()).Inc()
+	return true
+}
+
 func (l *listener) allowRequester(requester common.Address) bool {
 	if len(l.requesters) == 0 {
 		return true
@@ -366,12 +503,25 @@ func (l *listener) allowRequester(requester common.Address) bool {
 	return false
 }
 
-// Cancels runs that haven't been started yet, with the given request ID
+// Cancels runs that haven't been started yet, with the given request ID, and
+// marks the payment of the request as refunded
 func (l *listener) handleCancelOracleRequest(request *operator_wrapper.OperatorCancelOracleRequest, lb log.Broadcast) {
 	runCloserChannelIf, loaded := l.runs.LoadAndDelete(formatRequestId(request.RequestId))
 	if loaded {
 		close(runCloserChannelIf.(chan struct{}))
 	}
+	if err := l.paymentsORM.MarkCancelled(l.job.ID, request.RequestId); err != nil {
+		l.logger.Errorw("Failed to mark payment as cancelled", "requestId", formatRequestId(request.RequestId), "err", err)
+	}
+	l.markLogConsumed(lb)
+}
+
+// Marks the payment of the request as earned. The responses of the other jobs
+// of the contract match no payment of this job.
+func (l *listener) handleOracleResponse(response *operator_wrapper.OperatorOracleResponse, lb log.Broadcast) {
+	if err := l.paymentsORM.MarkFulfilled(l.job.ID, response.RequestId); err != nil {
+		l.logger.Errorw("Failed to mark payment as fulfilled", "requestId", formatRequestId(response.RequestId), "err", err)
+	}
 	l.markLogConsumed(lb)
 }
 
@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	uuid "github.com/satori/go.uuid"
+	"github.com/smartcontractkit/sqlx"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -26,9 +27,11 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/payments"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	pipeline_mocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 func TestDelegate_ServicesForSpec(t *testing.T) {
@@ -40,7 +43,7 @@ func TestDelegate_ServicesForSpec(t *testing.T) {
 	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, Client: ethClient})
 
 	lggr := logger.TestLogger(t)
-	delegate := directrequest.NewDelegate(lggr, runner, nil, cc)
+	delegate := directrequest.NewDelegate(lggr, runner, nil, nil, cc)
 
 	t.Run("Spec without DirectRequestSpec", func(t *testing.T) {
 		spec := job.Job{}
@@ -61,6 +64,8 @@ type DirectRequestUniverse struct {
 	runner         *pipeline_mocks.Runner
 	service        job.ServiceCtx
 	jobORM         job.ORM
+	paymentsORM    payments.ORM
+	db             *sqlx.DB
 	listener       log.Listener
 	logBroadcaster *log_mocks.Broadcaster
 	cleanup        func()
@@ -79,7 +84,8 @@ func NewDirectRequestUniverseWithConfig(t *testing.T, cfg *configtest.TestGenera
 
 	keyStore := cltest.NewKeyStore(t, db, cfg)
 	jobORM := job.NewORM(db, cc, orm, keyStore, lggr, cfg)
-	delegate := directrequest.NewDelegate(lggr, runner, orm, cc)
+	paymentsORM := payments.NewORM(db, lggr, cfg)
+	delegate := directrequest.NewDelegate(lggr, runner, orm, paymentsORM, cc)
 
 	jb := cltest.MakeDirectRequestJobSpec(t)
 	jb.ExternalJobID = uuid.NewV4()
@@ -98,6 +104,8 @@ func NewDirectRequestUniverseWithConfig(t *testing.T, cfg *configtest.TestGenera
 		runner:         runner,
 		service:        service,
 		jobORM:         jobORM,
+		paymentsORM:    paymentsORM,
+		db:             db,
 		listener:       nil,
 		logBroadcaster: broadcaster,
 		cleanup:        func() { jobORM.Close() },
@@ -370,9 +378,9 @@ func TestDelegate_ServicesListenerHandleLog(t *testing.T) {
 
 		runBeganAwaiter := cltest.NewAwaiter()
 		uni.runner.On("Run", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
-			runBeganAwaiter.ItHappened()
 			fn := args.Get(4).(func(pg.Queryer) error)
-			fn(nil)
+			require.NoError(t, fn(uni.db))
+			runBeganAwaiter.ItHappened()
 		}).Once().Return(false, nil)
 
 		err := uni.service.Start(testutils.Context(t))
@@ -388,6 +396,51 @@ func TestDelegate_ServicesListenerHandleLog(t *testing.T) {
 
 		runBeganAwaiter.AwaitOrFail(t, 5*time.Second)
 
+		earnings, err := uni.paymentsORM.FindJobEarnings(uni.spec.ID, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), earnings.Requests)
+		assert.Equal(t, big.NewInt(100), earnings.Pending.ToInt())
+
+		uni.service.Close()
+	})
+
+	t.Run("Log is an OracleResponse", func(t *testing.T) {
+		uni := NewDirectRequestUniverse(t)
+		defer uni.Cleanup()
+
+		requestID := common.Hash{1}
+		require.NoError(t, uni.paymentsORM.RecordRequest(payments.Payment{
+			JobID:      uni.spec.ID,
+			EVMChainID: *utils.NewBigI(0),
+			RequestID:  requestID,
+			Amount:     *assets.NewLinkFromJuels(100),
+		}))
+
+		log := log_mocks.NewBroadcast(t)
+		uni.logBroadcaster.On("WasAlreadyConsumed", mock.Anything, mock.Anything).Return(false, nil)
+		log.On("RawLog").Return(types.Log{
+			Topics: []common.Hash{
+				operator_wrapper.OperatorOracleResponse{}.Topic(),
+				requestID,
+			},
+		})
+		log.On("DecodedLog").Return(&operator_wrapper.OperatorOracleResponse{RequestId: requestID})
+		lbAwaiter := cltest.NewAwaiter()
+		uni.logBroadcaster.On("MarkConsumed", mock.Anything, mock.Anything).Run(func(args mock.Arguments) { lbAwaiter.ItHappened() }).Return(nil)
+
+		err := uni.service.Start(testutils.Context(t))
+		require.NoError(t, err)
+
+		uni.listener.HandleLog(log)
+
+		lbAwaiter.AwaitOrFail(t)
+
+		earnings, err := uni.paymentsORM.FindJobEarnings(uni.spec.ID, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), earnings.Fulfilled)
+		assert.Equal(t, big.NewInt(100), earnings.MonthEarned.ToInt())
+		assert.True(t, earnings.Pending.IsZero())
+
 		uni.service.Close()
 	})
 
@@ -464,9 +517,9 @@ func TestDelegate_ServicesListenerHandleLog(t *testing.T) {
 
 		runBeganAwaiter := cltest.NewAwaiter()
 		uni.runner.On("Run", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
-			runBeganAwaiter.ItHappened()
 			fn := args.Get(4).(func(pg.Queryer) error)
-			fn(nil)
+			require.NoError(t, fn(uni.db))
+			runBeganAwaiter.ItHappened()
 		}).Once().Return(false, nil)
 
 		err := uni.service.Start(testutils.Context(t))
@@ -525,4 +578,96 @@ func TestDelegate_ServicesListenerHandleLog(t *testing.T) {
 
 		uni.service.Close()
 	})
+
+	t.Run("duplicate OracleRequest is suppressed by dedupKey", func(t *testing.T) {
+		cfg := configtest.NewTestGeneralConfig(t)
+		cfg.Overrides.GlobalMinIncomingConfirmations = null.IntFrom(1)
+		uni := NewDirectRequestUniverseWithConfig(t, cfg, func(jb *job.Job) {
+			jb.DirectRequestSpec.DedupKey = null.StringFrom("$(oracleRequest.requestId)")
+			jb.DirectRequestSpec.DedupTTL = time.Hour
+		})
+		defer uni.Cleanup()
+
+		newLog := func() *log_mocks.Broadcast {
+			lb := log_mocks.NewBroadcast(t)
+			lb.On("ReceiptsRoot").Return(common.Hash{}).Maybe()
+			lb.On("TransactionsRoot").Return(common.Hash{}).Maybe()
+			lb.On("StateRoot").Return(common.Hash{}).Maybe()
+			lb.On("RawLog").Return(types.Log{
+				Topics: []common.Hash{
+					{},
+					uni.spec.ExternalIDEncodeStringToTopic(),
+				},
+			})
+			lb.On("DecodedLog").Return(&operator_wrapper.OperatorOracleRequest{
+				CancelExpiration: big.NewInt(0),
+				RequestId:        [32]byte{1},
+			})
+			lb.On("String").Return("").Maybe()
+			return lb
+		}
+
+		uni.logBroadcaster.On("WasAlreadyConsumed", mock.Anything, mock.Anything).Return(false, nil)
+		chConsumed := make(chan struct{}, 2)
+		uni.logBroadcaster.On("MarkConsumed", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			chConsumed <- struct{}{}
+		}).Return(nil)
+
+		uni.runner.On("Run", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			fn := args.Get(4).(func(pg.Queryer) error)
+			fn(nil)
+		}).Once().Return(false, nil)
+
+		err := uni.service.Start(testutils.Context(t))
+		require.NoError(t, err)
+
+		uni.listener.HandleLog(newLog())
+		uni.listener.HandleLog(newLog())
+
+		for i := 0; i < 2; i++ {
+			select {
+			case <-chConsumed:
+			case <-time.After(5 * time.Second):
+				t.Fatal("timed out waiting for logs to be consumed")
+			}
+		}
+
+		uni.service.Close()
+	})
+}
+
+func TestDelegate_OnRunFinished(t *testing.T) {
+	uni := NewDirectRequestUniverse(t)
+	defer uni.Cleanup()
+
+	requestID := common.Hash{1}
+	require.NoError(t, uni.paymentsORM.RecordRequest(payments.Payment{
+		JobID:      uni.spec.ID,
+		EVMChainID: *utils.NewBigI(0),
+		RequestID:  requestID,
+		Amount:     *assets.NewLinkFromJuels(100),
+	}))
+	delegate := directrequest.NewDelegate(logger.TestLogger(t), uni.runner, nil, uni.paymentsORM, nil)
+
+	// inputs of a run loaded from the database
+	inputs := map[string]interface{}{
+		"jobSpec": map[string]interface{}{"databaseID": float64(uni.spec.ID)},
+		"jobRun": map[string]interface{}{
+			"meta": map[string]interface{}{
+				"oracleRequest": map[string]interface{}{"requestId": requestID.Hex()},
+			},
+		},
+	}
+	run := &pipeline.Run{State: pipeline.RunStatusErrored, Inputs: pipeline.JSONSerializable{Val: inputs, Valid: true}}
+	delegate.OnRunFinished(run)
+	earnings, err := uni.paymentsORM.FindJobEarnings(uni.spec.ID, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), earnings.Fulfilled)
+
+	run.State = pipeline.RunStatusCompleted
+	delegate.OnRunFinished(run)
+	earnings, err = uni.paymentsORM.FindJobEarnings(uni.spec.ID, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), earnings.Fulfilled)
+	assert.Equal(t, big.NewInt(100), earnings.TotalEarned.ToInt())
 }
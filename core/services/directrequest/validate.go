@@ -1,13 +1,19 @@
 package directrequest
 
 import (
+	"strings"
+	"time"
+
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
-	"github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/gethwrappers/generated/operator_wrapper"
+	clnull "github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -17,9 +23,14 @@ type DirectRequestToml struct {
 	Requesters               models.AddressCollection `toml:"requesters"`
 	MinContractPayment       *assets.Link             `toml:"minContractPaymentLinkJuels"`
 	EVMChainID               *utils.Big               `toml:"evmChainID"`
-	MinIncomingConfirmations null.Uint32              `toml:"minIncomingConfirmations"`
+	MinIncomingConfirmations clnull.Uint32            `toml:"minIncomingConfirmations"`
+	DedupKey                 null.String              `toml:"dedupKey"`
+	DedupTTL                 time.Duration            `toml:"dedupTTL"`
 }
 
+// defaultDedupTTL is how long a dedup key is remembered when dedupTTL is not set.
+const defaultDedupTTL = 24 * time.Hour
+
 func ValidatedDirectRequestSpec(tomlString string) (job.Job, error) {
 	var jb = job.Job{}
 	tree, err := toml.Load(tomlString)
@@ -41,10 +52,41 @@ func ValidatedDirectRequestSpec(tomlString string) (job.Job, error) {
 		MinContractPayment:       spec.MinContractPayment,
 		EVMChainID:               spec.EVMChainID,
 		MinIncomingConfirmations: spec.MinIncomingConfirmations,
+		DedupKey:                 spec.DedupKey,
+		DedupTTL:                 spec.DedupTTL,
 	}
 
 	if jb.Type != job.DirectRequest {
 		return jb, errors.Errorf("unsupported type %s", jb.Type)
 	}
+	if err = validateDedup(jb.DirectRequestSpec); err != nil {
+		return jb, err
+	}
 	return jb, nil
 }
+
+func validateDedup(spec *job.DirectRequestSpec) error {
+	if spec.DedupTTL < 0 {
+		return errors.New("dedupTTL must not be negative")
+	}
+	if !spec.DedupKey.Valid {
+		if spec.DedupTTL != 0 {
+			return errors.New("dedupTTL requires dedupKey to be set")
+		}
+		return nil
+	}
+	key := strings.TrimSpace(spec.DedupKey.String)
+	if !strings.HasPrefix(key, "$(") || !strings.HasSuffix(key, ")") || strings.Count(key, "$") != 1 {
+		return errors.Errorf("dedupKey must be a variable expression such as $(oracleRequest.requestId), got %q", spec.DedupKey.String)
+	}
+	// Ensure the expression resolves against the decoded log variables the listener provides.
+	if _, err := pipeline.VarExpr(key, pipeline.NewVarsFrom(map[string]interface{}{
+		"oracleRequest": oracleRequestToMap(&operator_wrapper.OperatorOracleRequest{}),
+	}))(); err != nil {
+		return errors.Wrap(err, "invalid dedupKey")
+	}
+	if spec.DedupTTL == 0 {
+		spec.DedupTTL = defaultDedupTTL
+	}
+	return nil
+}
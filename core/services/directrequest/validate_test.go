@@ -76,3 +76,93 @@ func TestValidatedDirectRequestSpec_MinIncomingConfirmations(t *testing.T) {
 		assert.Equal(t, uint32(100), s.DirectRequestSpec.MinIncomingConfirmations.Uint32)
 	})
 }
+
+func TestValidatedDirectRequestSpec_Dedup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no dedupKey specified", func(t *testing.T) {
+		t.Parallel()
+
+		toml := `
+		type                = "directrequest"
+		schemaVersion       = 1
+		name                = "example eth request event spec"
+		observationSource   = """
+		"""
+		`
+
+		s, err := ValidatedDirectRequestSpec(toml)
+		require.NoError(t, err)
+
+		assert.False(t, s.DirectRequestSpec.DedupKey.Valid)
+		assert.Zero(t, s.DirectRequestSpec.DedupTTL)
+	})
+
+	t.Run("dedupKey with default dedupTTL", func(t *testing.T) {
+		t.Parallel()
+
+		toml := `
+		type                = "directrequest"
+		schemaVersion       = 1
+		name                = "example eth request event spec"
+		dedupKey            = "$(oracleRequest.requestId)"
+		observationSource   = """
+		"""
+		`
+
+		s, err := ValidatedDirectRequestSpec(toml)
+		require.NoError(t, err)
+
+		assert.Equal(t, "$(oracleRequest.requestId)", s.DirectRequestSpec.DedupKey.String)
+		assert.Equal(t, 24*time.Hour, s.DirectRequestSpec.DedupTTL)
+	})
+
+	t.Run("dedupKey with dedupTTL", func(t *testing.T) {
+		t.Parallel()
+
+		toml := `
+		type                = "directrequest"
+		schemaVersion       = 1
+		name                = "example eth request event spec"
+		dedupKey            = "$(oracleRequest.data)"
+		dedupTTL            = "10m"
+		observationSource   = """
+		"""
+		`
+
+		s, err := ValidatedDirectRequestSpec(toml)
+		require.NoError(t, err)
+
+		assert.Equal(t, "$(oracleRequest.data)", s.DirectRequestSpec.DedupKey.String)
+		assert.Equal(t, 10*time.Minute, s.DirectRequestSpec.DedupTTL)
+	})
+
+	for _, tt := range []struct {
+		name  string
+		extra string
+		err   string
+	}{
+		{"dedupKey is not an expression", `dedupKey = "requestId"`, "dedupKey must be a variable expression"},
+		{"dedupKey references an unknown field", `dedupKey = "$(oracleRequest.nope)"`, "invalid dedupKey"},
+		{"dedupTTL without dedupKey", `dedupTTL = "1h"`, "dedupTTL requires dedupKey to be set"},
+		{"negative dedupTTL", "dedupKey = \"$(oracleRequest.requestId)\"\ndedupTTL = \"-1h\"", "dedupTTL must not be negative"},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			toml := `
+type                = "directrequest"
+schemaVersion       = 1
+name                = "example eth request event spec"
+` + tt.extra + `
+observationSource   = """
+"""
+`
+
+			_, err := ValidatedDirectRequestSpec(toml)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
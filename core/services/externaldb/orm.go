@@ -0,0 +1,66 @@
+package externaldb
+
+import (
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+type ORM interface {
+	CreateDatabase(db *Database, qopts ...pg.QOpt) error
+	DeleteDatabase(name string, qopts ...pg.QOpt) error
+	Databases(qopts ...pg.QOpt) ([]Database, error)
+}
+
+type orm struct {
+	q       pg.Q
+	ciphers *pg.ColumnCiphers
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig, ciphers *pg.ColumnCiphers) ORM {
+	return &orm{pg.NewQ(db, lggr.Named("ExternalDatabasesORM"), cfg), ciphers}
+}
+
+// CreateDatabase inserts db, setting its timestamps
+func (o *orm) CreateDatabase(db *Database, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	sql := `INSERT INTO external_databases (name, url, created_at, updated_at)
+VALUES (:name, :url, NOW(), NOW())
+RETURNING *`
+	encrypted := *db
+	u, err := o.ciphers.Encrypt(db.URL)
+	if err != nil {
+		return errors.Wrap(err, "CreateDatabase failed")
+	}
+	encrypted.URL = u
+	if err = q.GetNamed(sql, db, encrypted); err != nil {
+		return errors.Wrap(err, "CreateDatabase failed")
+	}
+	return db.decryptURL(o.ciphers)
+}
+
+// DeleteDatabase removes a database.
+// Returns sql.ErrNoRows if it does not exist
+func (o *orm) DeleteDatabase(name string, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	var deletedName string
+	return q.Get(&deletedName, `DELETE FROM external_databases WHERE name = $1 RETURNING name`, name)
+}
+
+// Databases returns all databases
+func (o *orm) Databases(qopts ...pg.QOpt) (dbs []Database, err error) {
+	q := o.q.WithOpts(qopts...)
+	if err = q.Select(&dbs, `SELECT * FROM external_databases ORDER BY name`); err != nil {
+		return nil, errors.Wrap(err, "Databases failed")
+	}
+	for i := range dbs {
+		if err = dbs[i].decryptURL(o.ciphers); err != nil {
+			return nil, errors.Wrap(err, "Databases failed")
+		}
+	}
+	return dbs, nil
+}
@@ -0,0 +1,195 @@
+// Package externaldb holds the external databases registered by the operator,
+// which the dbquery tasks run their read-only queries against.
+package externaldb
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const (
+	// MaxRows is the maximum number of rows a query can return
+	MaxRows = 1000
+
+	maxOpenConns    = 4
+	connMaxIdleTime = 5 * time.Minute
+)
+
+// Registry holds the external databases and their connection pools
+type Registry interface {
+	services.ServiceCtx
+	pipeline.ExternalDatabases
+	Register(db *Database) error
+	Unregister(name string) error
+	Databases() ([]Database, error)
+}
+
+type registry struct {
+	utils.StartStopOnce
+	orm  ORM
+	lggr logger.Logger
+
+	mu    sync.Mutex
+	dbs   map[string]Database
+	pools map[string]*sqlx.DB
+}
+
+var _ Registry = (*registry)(nil)
+
+func NewRegistry(orm ORM, lggr logger.Logger) Registry {
+	return &registry{
+		orm:   orm,
+		lggr:  lggr.Named("ExternalDatabases"),
+		dbs:   make(map[string]Database),
+		pools: make(map[string]*sqlx.DB),
+	}
+}
+
+func (r *registry) Start(ctx context.Context) error {
+	return r.StartOnce("ExternalDatabases", func() error {
+		return r.loadDatabases(pg.WithParentCtx(ctx))
+	})
+}
+
+func (r *registry) Close() error {
+	return r.StopOnce("ExternalDatabases", func() error {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		for name := range r.pools {
+			r.closePool(name)
+		}
+		return nil
+	})
+}
+
+// Register validates and saves db. Only PostgreSQL databases are supported.
+func (r *registry) Register(db *Database) error {
+	if db.Name == "" {
+		return errors.New("database name is required")
+	}
+	u, err := url.Parse(db.URL)
+	if err != nil {
+		return errors.New("invalid database URL")
+	}
+	if u.Scheme != "postgres" && u.Scheme != "postgresql" {
+		return errors.Errorf("invalid database URL %s, scheme must be postgres or postgresql", u.Redacted())
+	}
+	if err = r.orm.CreateDatabase(db); err != nil {
+		return err
+	}
+	return r.loadDatabases()
+}
+
+// Unregister deletes a database and closes its connections.
+// Returns sql.ErrNoRows if it does not exist
+func (r *registry) Unregister(name string) error {
+	if err := r.orm.DeleteDatabase(name); err != nil {
+		return err
+	}
+	return r.loadDatabases()
+}
+
+func (r *registry) Databases() ([]Database, error) {
+	return r.orm.Databases()
+}
+
+func (r *registry) loadDatabases(qopts ...pg.QOpt) error {
+	dbs, err := r.orm.Databases(qopts...)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.dbs = make(map[string]Database, len(dbs))
+	for _, db := range dbs {
+		r.dbs[db.Name] = db
+	}
+	for name := range r.pools {
+		if _, exists := r.dbs[name]; !exists {
+			r.closePool(name)
+		}
+	}
+	return nil
+}
+
+// Query runs query with args in a read-only transaction of the database, and
+// returns its rows keyed by column name. A single statement can be run, as the
+// query is sent with the extended protocol.
+func (r *registry) Query(ctx context.Context, database, query string, args []interface{}) ([]map[string]interface{}, error) {
+	pool, err := r.pool(database)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := pool.BeginTxx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to begin transaction")
+	}
+	defer func() {
+		if rerr := tx.Rollback(); rerr != nil && !errors.Is(rerr, sql.ErrTxDone) {
+			r.lggr.Warnw("Failed to roll back query transaction", "database", database, "err", rerr)
+		}
+	}()
+
+	rows, err := tx.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	results := []map[string]interface{}{}
+	for rows.Next() {
+		if len(results) == MaxRows {
+			return nil, errors.Errorf("query returned more than %d rows", MaxRows)
+		}
+		row := make(map[string]interface{})
+		if err = rows.MapScan(row); err != nil {
+			return nil, err
+		}
+		for column, value := range row {
+			if b, ok := value.([]byte); ok {
+				row[column] = string(b)
+			}
+		}
+		results = append(results, row)
+	}
+	return results, rows.Err()
+}
+
+func (r *registry) pool(name string) (*sqlx.DB, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if pool, exists := r.pools[name]; exists {
+		return pool, nil
+	}
+	db, exists := r.dbs[name]
+	if !exists {
+		return nil, errors.Errorf("database %q is not registered", name)
+	}
+	pool, err := sqlx.Open("pgx", db.URL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open database %q", name)
+	}
+	pool.SetMaxOpenConns(maxOpenConns)
+	pool.SetConnMaxIdleTime(connMaxIdleTime)
+	r.pools[name] = pool
+	return pool, nil
+}
+
+// closePool must be called with mu held
+func (r *registry) closePool(name string) {
+	if err := r.pools[name].Close(); err != nil {
+		r.lggr.Warnw("Failed to close database connections", "database", name, "err", err)
+	}
+	delete(r.pools, name)
+}
@@ -21,4 +21,5 @@ type Config interface {
 	OCRObservationTimeout() time.Duration
 	OCRObservationGracePeriod() time.Duration
 	LogSQL() bool
+	JobPipelineSpecVariables() []string
 }
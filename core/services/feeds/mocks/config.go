@@ -56,6 +56,22 @@ func (_m *Config) FeatureOffchainReporting() bool {
 	return r0
 }
 
+// JobPipelineSpecVariables provides a mock function with given fields:
+func (_m *Config) JobPipelineSpecVariables() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
 // LogSQL provides a mock function with given fields:
 func (_m *Config) LogSQL() bool {
 	ret := _m.Called()
@@ -804,6 +804,11 @@ func (s *service) Unsafe_SetConnectionsManager(connMgr ConnectionsManager) {
 }
 
 func (s *service) generateJob(spec string) (*job.Job, error) {
+	spec, err := job.ExpandSpecVariables(spec, s.cfg.JobPipelineSpecVariables())
+	if err != nil {
+		return nil, err
+	}
+
 	jobType, err := job.ValidateSpec(spec)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to parse job spec TOML")
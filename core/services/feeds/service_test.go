@@ -100,6 +100,7 @@ func setupTestService(t *testing.T) *TestService {
 	keyStore.On("P2P").Return(p2pKeystore)
 	keyStore.On("OCR").Return(ocr1Keystore)
 	keyStore.On("OCR2").Return(ocr2Keystore)
+	cfg.On("JobPipelineSpecVariables").Return(nil).Maybe()
 	svc := feeds.NewService(orm, jobORM, db, spawner, keyStore, cfg, cc, logger.TestLogger(t), "1.0.0")
 	svc.SetConnectionsManager(connMgr)
 
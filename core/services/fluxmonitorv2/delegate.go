@@ -72,7 +72,7 @@ func (d *Delegate) ServicesForSpec(jb job.Job) (services []job.ServiceCtx, err e
 	fm, err := NewFromJobSpec(
 		jb,
 		d.db,
-		NewORM(d.db, d.lggr, chain.Config(), chain.TxManager(), strategy, checker),
+		NewORM(d.db, d.lggr, chain.Config(), chain.TxManager(), strategy, checker, jb.ID, d.pipelineRunner.Budgets()),
 		d.jobORM,
 		d.pipelineORM,
 		NewKeyStore(d.ethKeyStore),
@@ -1026,13 +1026,10 @@ func (fm *FluxMonitor) isValidSubmission(l logger.Logger, answer decimal.Decimal
 	)
 	fm.jobORM.TryRecordError(fm.spec.JobID, "Answer is outside acceptable range")
 
-	jobId := fm.spec.JobID
-	jobName := fm.spec.JobName
 	elapsed := time.Since(started)
-	pipeline.PromPipelineTaskExecutionTime.WithLabelValues(fmt.Sprintf("%d", jobId), jobName, "", job.FluxMonitor.String()).Set(float64(elapsed))
-	pipeline.PromPipelineRunErrors.WithLabelValues(fmt.Sprintf("%d", jobId), jobName).Inc()
-	pipeline.PromPipelineRunTotalTimeToCompletion.WithLabelValues(fmt.Sprintf("%d", jobId), jobName).Set(float64(elapsed))
-	pipeline.PromPipelineTasksTotalFinished.WithLabelValues(fmt.Sprintf("%d", jobId), jobName, "", job.FluxMonitor.String(), "error").Inc()
+	pipeline.RecordTaskRun(fm.spec, "", job.FluxMonitor.String(), elapsed, "error")
+	pipeline.RecordRunError(fm.spec)
+	pipeline.RecordRunTimeToCompletion(fm.spec, elapsed)
 	return false
 }
 
@@ -9,6 +9,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/sqlx"
 )
 
@@ -33,11 +34,15 @@ type orm struct {
 	txm      transmitter
 	strategy txmgr.TxStrategy
 	checker  txmgr.TransmitCheckerSpec
+	jobID    int32
+	budgets  pipeline.Budgets
 	logger   logger.Logger
 }
 
-// NewORM initializes a new ORM
-func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig, txm transmitter, strategy txmgr.TxStrategy, checker txmgr.TransmitCheckerSpec) ORM {
+// NewORM initializes a new ORM. The jobID is recorded on the transactions if it
+// is not zero, and the submissions are skipped while the job exceeds its budget
+// if budgets is not nil.
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig, txm transmitter, strategy txmgr.TxStrategy, checker txmgr.TransmitCheckerSpec, jobID int32, budgets pipeline.Budgets) ORM {
 	namedLogger := lggr.Named("FluxMonitorORM")
 	q := pg.NewQ(db, namedLogger, cfg)
 	return &orm{
@@ -45,6 +50,8 @@ func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig, txm transmitter,
 		txm,
 		strategy,
 		checker,
+		jobID,
+		budgets,
 		namedLogger,
 	}
 }
@@ -118,11 +125,19 @@ func (o *orm) CreateEthTransaction(
 	gasLimit uint32,
 	qopts ...pg.QOpt,
 ) (err error) {
+	var meta *txmgr.EthTxMeta
+	if o.jobID != 0 {
+		meta = &txmgr.EthTxMeta{JobID: &o.jobID}
+		if o.budgets != nil && o.budgets.Exceeded(o.jobID) {
+			return errors.Wrapf(pipeline.ErrJobBudgetExceeded, "Skipped Flux Monitor submission of job %d", o.jobID)
+		}
+	}
 	_, err = o.txm.CreateEthTransaction(txmgr.NewTx{
 		FromAddress:    fromAddress,
 		ToAddress:      toAddress,
 		EncodedPayload: payload,
 		GasLimit:       gasLimit,
+		Meta:           meta,
 		Strategy:       o.strategy,
 		Checker:        o.checker,
 	}, qopts...)
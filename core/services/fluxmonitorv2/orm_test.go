@@ -168,10 +168,11 @@ func TestORM_CreateEthTransaction(t *testing.T) {
 	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
 
 	strategy := txmmocks.NewTxStrategy(t)
+	jobID := int32(1)
 
 	var (
 		txm = txmmocks.NewTxManager(t)
-		orm = fluxmonitorv2.NewORM(db, logger.TestLogger(t), cfg, txm, strategy, txmgr.TransmitCheckerSpec{})
+		orm = fluxmonitorv2.NewORM(db, logger.TestLogger(t), cfg, txm, strategy, txmgr.TransmitCheckerSpec{}, jobID, nil)
 
 		_, from  = cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 		to       = testutils.NewAddress()
@@ -184,9 +185,25 @@ func TestORM_CreateEthTransaction(t *testing.T) {
 		ToAddress:      to,
 		EncodedPayload: payload,
 		GasLimit:       gasLimit,
-		Meta:           nil,
+		Meta:           &txmgr.EthTxMeta{JobID: &jobID},
 		Strategy:       strategy,
 	}).Return(txmgr.EthTx{}, nil).Once()
 
 	orm.CreateEthTransaction(from, to, payload, gasLimit)
 }
+
+type exceededBudgets map[int32]bool
+
+func (b exceededBudgets) Exceeded(jobID int32) bool { return b[jobID] }
+
+func TestORM_CreateEthTransaction_BudgetExceeded(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	txm := txmmocks.NewTxManager(t)
+	orm := fluxmonitorv2.NewORM(db, logger.TestLogger(t), cfg, txm, txmmocks.NewTxStrategy(t), txmgr.TransmitCheckerSpec{}, 1, exceededBudgets{1: true})
+
+	err := orm.CreateEthTransaction(testutils.NewAddress(), testutils.NewAddress(), []byte{1, 0, 0}, 21000)
+	require.ErrorIs(t, err, pipeline.ErrJobBudgetExceeded)
+}
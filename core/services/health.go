@@ -26,6 +26,9 @@ type (
 		// IsHealthy returns the current health of the system.
 		// A system is considered healthy if all checks are passing (no errors)
 		IsHealthy() (healthy bool, errors map[string]error)
+		// Statuses returns the detailed status of every service, including
+		// the subservices of HealthReporters.
+		Statuses() map[string]ServiceStatus
 
 		Start() error
 		Close() error
@@ -36,6 +39,7 @@ type (
 		services   map[string]Checkable
 		stateMutex sync.RWMutex
 		state      map[string]State
+		statuses   map[string]ServiceStatus
 
 		chStop chan struct{}
 		chDone chan struct{}
@@ -48,6 +52,24 @@ type (
 		healthy error
 	}
 
+	// HealthReporter can be implemented by a Checkable made of several
+	// subservices, to report the health of each of them individually.
+	HealthReporter interface {
+		// HealthReport returns the health of each subservice by name.
+		HealthReport() map[string]error
+	}
+
+	// ServiceStatus is the detailed status of a single service.
+	ServiceStatus struct {
+		Ready   error
+		Healthy error
+		// Since is when the service last became healthy or unhealthy.
+		Since time.Time
+		// LastError is the last error reported by Healthy, if any.
+		LastError   error
+		LastErrorAt time.Time
+	}
+
 	Status string
 )
 
@@ -87,6 +109,7 @@ func NewChecker() Checker {
 	c := &checker{
 		services: make(map[string]Checkable, 10),
 		state:    make(map[string]State, 10),
+		statuses: make(map[string]ServiceStatus, 10),
 		chStop:   make(chan struct{}),
 		chDone:   make(chan struct{}),
 	}
@@ -148,6 +171,12 @@ func (c *checker) update() {
 		healthy := s.Healthy()
 
 		state[name] = State{ready, healthy}
+
+		if r, ok := s.(HealthReporter); ok {
+			for sub, err := range r.HealthReport() {
+				state[name+"."+sub] = State{healthy: err}
+			}
+		}
 	}
 
 	// we use a separate lock to avoid holding the lock over state while talking
@@ -155,8 +184,18 @@ func (c *checker) update() {
 	c.stateMutex.Lock()
 	defer c.stateMutex.Unlock()
 
+	now := time.Now()
+	for name := range c.state {
+		if _, ok := state[name]; !ok {
+			// unregistered, or a subservice that went away
+			delete(c.state, name)
+			delete(c.statuses, name)
+			healthStatus.DeleteLabelValues(name)
+		}
+	}
 	for name, state := range state {
 		c.state[name] = state
+		c.statuses[name] = nextStatus(c.statuses[name], state, now)
 
 		value := 0
 		if state.healthy == nil {
@@ -169,6 +208,22 @@ func (c *checker) update() {
 	uptimeSeconds.Add(interval.Seconds())
 }
 
+// nextStatus returns the status of a service after a check with the given
+// result
+func nextStatus(prev ServiceStatus, state State, now time.Time) ServiceStatus {
+	next := prev
+	next.Ready = state.ready
+	next.Healthy = state.healthy
+	if prev.Since.IsZero() || (prev.Healthy == nil) != (state.healthy == nil) {
+		next.Since = now
+	}
+	if state.healthy != nil {
+		next.LastError = state.healthy
+		next.LastErrorAt = now
+	}
+	return next
+}
+
 func (c *checker) Register(name string, service Checkable) error {
 	if service == nil || name == "" {
 		return errors.Errorf("misconfigured check %#v for %v", name, service)
@@ -210,6 +265,17 @@ func (c *checker) IsReady() (ready bool, errors map[string]error) {
 	return
 }
 
+func (c *checker) Statuses() map[string]ServiceStatus {
+	c.stateMutex.RLock()
+	defer c.stateMutex.RUnlock()
+
+	statuses := make(map[string]ServiceStatus, len(c.statuses))
+	for name, status := range c.statuses {
+		statuses[name] = status
+	}
+	return statuses
+}
+
 func (c *checker) IsHealthy() (healthy bool, errors map[string]error) {
 	c.stateMutex.RLock()
 	defer c.stateMutex.RUnlock()
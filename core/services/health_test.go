@@ -62,3 +62,33 @@ func TestCheck(t *testing.T) {
 		assert.Equal(t, test.expected, results, "case %d", i)
 	}
 }
+
+type reporterCheck struct {
+	boolCheck
+	report map[string]error
+}
+
+func (r reporterCheck) HealthReport() map[string]error { return r.report }
+
+func TestCheck_Statuses(t *testing.T) {
+	c := services.NewChecker()
+	require.NoError(t, c.Register("parent", reporterCheck{boolCheck(true), map[string]error{
+		"ok":     nil,
+		"broken": ErrUnhealthy,
+	}}))
+	require.NoError(t, c.Start())
+	t.Cleanup(func() { assert.NoError(t, c.Close()) })
+
+	healthy, results := c.IsHealthy()
+	assert.False(t, healthy)
+	assert.Equal(t, map[string]error{"parent": nil, "parent.ok": nil, "parent.broken": ErrUnhealthy}, results)
+
+	statuses := c.Statuses()
+	require.Len(t, statuses, 3)
+	assert.NoError(t, statuses["parent.ok"].Healthy)
+	assert.NoError(t, statuses["parent.ok"].LastError)
+	assert.False(t, statuses["parent.ok"].Since.IsZero())
+	assert.Equal(t, ErrUnhealthy, statuses["parent.broken"].Healthy)
+	assert.Equal(t, ErrUnhealthy, statuses["parent.broken"].LastError)
+	assert.False(t, statuses["parent.broken"].LastErrorAt.IsZero())
+}
@@ -0,0 +1,151 @@
+// Package ipfs fetches and pins the content of the ipfs pipeline tasks, with
+// an IPFS node's RPC API or an HTTP gateway.
+package ipfs
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/ipfs/go-cid"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	clhttp "github.com/smartcontractkit/chainlink/core/utils/http"
+)
+
+// pinResponseLimit is the maximum size of the responses of the pin API
+const pinResponseLimit = 1 << 16
+
+// Client fetches the content of IPFS paths from the RPC API of an IPFS node,
+// like kubo, or else from an HTTP gateway. Content is only pinned with a node.
+type Client struct {
+	nodeURL    *url.URL
+	gatewayURL *url.URL
+	client     *http.Client
+	lggr       logger.Logger
+}
+
+var _ pipeline.IPFS = (*Client)(nil)
+
+// NewClient returns a Client of the node at nodeURL, like
+// http://localhost:5001, or of the gateway at gatewayURL, like
+// https://ipfs.io. At least one of them must be set.
+func NewClient(nodeURL, gatewayURL *url.URL, client *http.Client, lggr logger.Logger) (*Client, error) {
+	if isEmpty(nodeURL) && isEmpty(gatewayURL) {
+		return nil, errors.New("an IPFS node or gateway URL is required")
+	}
+	c := &Client{client: client, lggr: lggr.Named("IPFS")}
+	if !isEmpty(nodeURL) {
+		c.nodeURL = nodeURL
+	}
+	if !isEmpty(gatewayURL) {
+		c.gatewayURL = gatewayURL
+	}
+	return c, nil
+}
+
+func isEmpty(u *url.URL) bool {
+	return u == nil || u.String() == ""
+}
+
+// ParsePath returns the root CID and the path of an IPFS path, like
+// <CID>/dir/file, ipfs://<CID>/dir/file or /ipfs/<CID>/dir/file
+func ParsePath(ipfsPath string) (cid.Cid, string, error) {
+	p := strings.TrimPrefix(ipfsPath, "ipfs://")
+	p = strings.TrimPrefix(p, "/ipfs/")
+	root, rest, _ := strings.Cut(p, "/")
+	c, err := cid.Decode(root)
+	if err != nil {
+		return cid.Undef, "", errors.Wrapf(err, "invalid IPFS path %q", ipfsPath)
+	}
+	return c, path.Join(c.String(), rest), nil
+}
+
+// Cat returns the content at ipfsPath, failing if it is larger than maxSize
+// bytes
+func (c *Client) Cat(ctx context.Context, ipfsPath string, maxSize int64) ([]byte, error) {
+	_, p, err := ParsePath(ipfsPath)
+	if err != nil {
+		return nil, err
+	}
+	var req *http.Request
+	if c.nodeURL != nil {
+		u := c.apiURL("cat", url.Values{"arg": {p}, "length": {strconv.FormatInt(maxSize+1, 10)}})
+		req, err = http.NewRequestWithContext(ctx, http.MethodPost, u, nil)
+	} else {
+		u := *c.gatewayURL
+		u.Path = path.Join("/", u.Path, "ipfs", p)
+		req, err = http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	content, err := c.send(req, maxSize)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch %s", p)
+	}
+	return content, nil
+}
+
+// Pin pins the root CID of ipfsPath, and its descendants, on the node
+func (c *Client) Pin(ctx context.Context, ipfsPath string) error {
+	if c.nodeURL == nil {
+		return errors.New("an IPFS node URL is required to pin content")
+	}
+	root, _, err := ParsePath(ipfsPath)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.apiURL("pin/add", url.Values{"arg": {root.String()}}), nil)
+	if err != nil {
+		return err
+	}
+	body, err := c.send(req, pinResponseLimit)
+	if err != nil {
+		return errors.Wrapf(err, "failed to pin %s", root)
+	}
+	var resp struct {
+		Pins []string
+	}
+	if err = json.Unmarshal(body, &resp); err != nil {
+		return errors.Wrapf(err, "failed to pin %s: invalid response", root)
+	}
+	c.lggr.Debugw("Pinned IPFS content", "cid", root, "pins", resp.Pins)
+	return nil
+}
+
+func (c *Client) apiURL(command string, query url.Values) string {
+	u := *c.nodeURL
+	u.Path = path.Join("/", u.Path, "api/v0", command)
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+func (c *Client) send(req *http.Request, sizeLimit int64) ([]byte, error) {
+	httpRequest := clhttp.HTTPRequest{
+		Client:  c.client,
+		Request: req,
+		Config:  clhttp.HTTPRequestConfig{SizeLimit: sizeLimit},
+		Logger:  c.lggr,
+	}
+	body, statusCode, _, err := httpRequest.SendRequest()
+	if err != nil {
+		return nil, err
+	}
+	if statusCode >= http.StatusBadRequest {
+		var apiErr struct {
+			Message string
+		}
+		if json.Unmarshal(body, &apiErr) == nil && apiErr.Message != "" {
+			return nil, errors.Errorf("status code %d: %s", statusCode, apiErr.Message)
+		}
+		return nil, errors.Errorf("status code %d", statusCode)
+	}
+	return body, nil
+}
@@ -0,0 +1,103 @@
+package ipfs_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/ipfs"
+)
+
+const testCID = "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"
+
+func TestParsePath(t *testing.T) {
+	t.Parallel()
+
+	for _, p := range []string{testCID, "ipfs://" + testCID, "/ipfs/" + testCID} {
+		c, ipfsPath, err := ipfs.ParsePath(p + "/docs/proof.json")
+		require.NoError(t, err, p)
+		assert.Equal(t, testCID, c.String())
+		assert.Equal(t, testCID+"/docs/proof.json", ipfsPath)
+	}
+
+	_, _, err := ipfs.ParsePath("not-a-cid/docs")
+	assert.Error(t, err)
+}
+
+func TestClient_Node(t *testing.T) {
+	t.Parallel()
+
+	var pinned []string
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		switch r.URL.Path {
+		case "/api/v0/cat":
+			if r.URL.Query().Get("arg") != testCID+"/doc.txt" {
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(`{"Message": "no link named \"missing.txt\"", "Code": 0, "Type": "error"}`))
+				return
+			}
+			assert.Equal(t, "17", r.URL.Query().Get("length"))
+			_, _ = w.Write([]byte("document"))
+		case "/api/v0/pin/add":
+			pinned = append(pinned, r.URL.Query().Get("arg"))
+			_, _ = w.Write([]byte(`{"Pins": ["` + testCID + `"]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(node.Close)
+	nodeURL, err := url.Parse(node.URL)
+	require.NoError(t, err)
+
+	client, err := ipfs.NewClient(nodeURL, nil, node.Client(), logger.TestLogger(t))
+	require.NoError(t, err)
+
+	content, err := client.Cat(testutils.Context(t), "ipfs://"+testCID+"/doc.txt", 16)
+	require.NoError(t, err)
+	assert.Equal(t, "document", string(content))
+
+	_, err = client.Cat(testutils.Context(t), testCID+"/missing.txt", 16)
+	assert.EqualError(t, err, `failed to fetch `+testCID+`/missing.txt: status code 500: no link named "missing.txt"`)
+
+	require.NoError(t, client.Pin(testutils.Context(t), testCID+"/doc.txt"))
+	assert.Equal(t, []string{testCID}, pinned)
+}
+
+func TestClient_Gateway(t *testing.T) {
+	t.Parallel()
+
+	gateway := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "/ipfs/"+testCID+"/doc.txt", r.URL.Path)
+		_, _ = w.Write([]byte("a document larger than the limit"))
+	}))
+	t.Cleanup(gateway.Close)
+	gatewayURL, err := url.Parse(gateway.URL)
+	require.NoError(t, err)
+
+	client, err := ipfs.NewClient(nil, gatewayURL, gateway.Client(), logger.TestLogger(t))
+	require.NoError(t, err)
+
+	content, err := client.Cat(testutils.Context(t), testCID+"/doc.txt", 1024)
+	require.NoError(t, err)
+	assert.Equal(t, "a document larger than the limit", string(content))
+
+	_, err = client.Cat(testutils.Context(t), testCID+"/doc.txt", 16)
+	assert.Error(t, err)
+
+	assert.EqualError(t, client.Pin(testutils.Context(t), testCID), "an IPFS node URL is required to pin content")
+}
+
+func TestNewClient(t *testing.T) {
+	t.Parallel()
+
+	_, err := ipfs.NewClient(nil, &url.URL{}, http.DefaultClient, logger.TestLogger(t))
+	assert.EqualError(t, err, "an IPFS node or gateway URL is required")
+}
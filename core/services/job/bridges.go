@@ -0,0 +1,158 @@
+package job
+
+import (
+	"database/sql"
+	"net/url"
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// BridgeDefinition declares a bridge required by a job spec, e.g.
+//
+//	[[bridges]]
+//	name = "coingecko"
+//	url = "https://adapter.example.com"
+//	confirmations = 3
+//	minimumContractPayment = "0.1 link"
+//	outgoingTokenEnv = "COINGECKO_ADAPTER_TOKEN"
+//	update = true
+//
+// Declared bridges are created along with the job if they do not exist yet.
+// Otherwise the existing bridge must match the definition, unless update is
+// set, in which case it is updated to match it, rotating its outgoing token.
+type BridgeDefinition struct {
+	Name                   string       `toml:"name"`
+	URL                    string       `toml:"url"`
+	Confirmations          *uint32      `toml:"confirmations"`
+	MinimumContractPayment *assets.Link `toml:"minimumContractPayment"`
+	// OutgoingTokenEnv optionally names the environment variable holding the
+	// token sent to the external adapter, so it is not inlined in the spec.
+	OutgoingTokenEnv string `toml:"outgoingTokenEnv"`
+	Update           bool   `toml:"update"`
+}
+
+func (d BridgeDefinition) parse() (bridges.BridgeName, models.WebURL, error) {
+	if d.Name == "" {
+		return "", models.WebURL{}, errors.New("bridge name is required")
+	}
+	name, err := bridges.ParseBridgeName(d.Name)
+	if err != nil {
+		return "", models.WebURL{}, err
+	}
+	u, err := url.ParseRequestURI(d.URL)
+	if err != nil {
+		return "", models.WebURL{}, errors.Wrapf(err, "invalid url for bridge %q", d.Name)
+	}
+	return name, models.WebURL(*u), nil
+}
+
+// request returns the settings of the bridge, the ones the definition leaves
+// out being those of current if not nil
+func (d BridgeDefinition) request(name bridges.BridgeName, u models.WebURL, current *bridges.BridgeType) *bridges.BridgeTypeRequest {
+	btr := &bridges.BridgeTypeRequest{Name: name, URL: u}
+	if current != nil {
+		btr.Confirmations = current.Confirmations
+		btr.MinimumContractPayment = current.MinimumContractPayment
+	}
+	if d.Confirmations != nil {
+		btr.Confirmations = *d.Confirmations
+	}
+	if d.MinimumContractPayment != nil {
+		btr.MinimumContractPayment = d.MinimumContractPayment
+	}
+	return btr
+}
+
+func (d BridgeDefinition) outgoingToken() (string, error) {
+	if d.OutgoingTokenEnv == "" {
+		return "", nil
+	}
+	token := os.Getenv(d.OutgoingTokenEnv)
+	if token == "" {
+		return "", errors.Errorf("environment variable %s referenced by bridge %q is not set", d.OutgoingTokenEnv, d.Name)
+	}
+	return token, nil
+}
+
+func validateBridgeDefinitions(defs []BridgeDefinition) error {
+	seen := make(map[bridges.BridgeName]struct{}, len(defs))
+	for _, d := range defs {
+		name, _, err := d.parse()
+		if err != nil {
+			return err
+		}
+		if _, dup := seen[name]; dup {
+			return errors.Errorf("bridge %q is declared more than once", name)
+		}
+		seen[name] = struct{}{}
+		if _, err = d.outgoingToken(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ensureBridges creates the bridges declared by a job spec that do not exist
+// yet, and updates the existing ones or checks that they match their
+// definition.
+func (o *orm) ensureBridges(defs []BridgeDefinition, qopts ...pg.QOpt) error {
+	for _, d := range defs {
+		name, u, err := d.parse()
+		if err != nil {
+			return err
+		}
+		token, err := d.outgoingToken()
+		if err != nil {
+			return err
+		}
+
+		existing, err := o.bridgeORM.FindBridge(name, qopts...)
+		if errors.Is(err, sql.ErrNoRows) {
+			_, bt, err := bridges.NewBridgeType(d.request(name, u, nil))
+			if err != nil {
+				return err
+			}
+			if token != "" {
+				bt.OutgoingToken = token
+			}
+			if err = o.bridgeORM.CreateBridgeType(bt, qopts...); err != nil {
+				return errors.Wrapf(err, "failed to create bridge %q", name)
+			}
+			continue
+		} else if err != nil {
+			return errors.Wrapf(err, "failed to load bridge %q", name)
+		}
+
+		if d.Update {
+			if err = o.bridgeORM.UpdateBridgeType(&existing, d.request(name, u, &existing), qopts...); err != nil {
+				return errors.Wrapf(err, "failed to update bridge %q", name)
+			}
+			if token != "" && existing.OutgoingToken != token {
+				if err = o.bridgeORM.UpdateBridgeOutgoingToken(&existing, token, qopts...); err != nil {
+					return errors.Wrapf(err, "failed to rotate the outgoing token of bridge %q", name)
+				}
+			}
+			continue
+		}
+
+		if existing.URL.String() != u.String() {
+			return errors.Errorf("bridge %q already exists with url %s, spec declares %s", name, existing.URL.String(), u.String())
+		}
+		if d.Confirmations != nil && existing.Confirmations != *d.Confirmations {
+			return errors.Errorf("bridge %q already exists with %d confirmations, spec declares %d", name, existing.Confirmations, *d.Confirmations)
+		}
+		if d.MinimumContractPayment != nil && (existing.MinimumContractPayment == nil || existing.MinimumContractPayment.Cmp(d.MinimumContractPayment) != 0) {
+			return errors.Errorf("bridge %q already exists with a different minimum contract payment", name)
+		}
+		if token != "" && existing.OutgoingToken != token {
+			return errors.Errorf("bridge %q already exists with a different outgoing token", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package job
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// Dependencies declares what a job spec requires to run, e.g.
+//
+//	[dependencies]
+//	bridges = ["coingecko"]
+//	keys = ["0x9C53c8A4d5a3E7c1bD8E5aB6E3B9b4A6B6e2a5f0"]
+//	jobs = ["0eec7e1d-d0d2-476c-a1a8-72dfb6633f46"]
+//
+// The bridges, keys (by ID, like an ETH address or a P2P peer ID) and jobs (by
+// external job ID) must exist when the job is created and when its services
+// start. The jobs are started before the job when the node starts.
+type Dependencies struct {
+	Bridges []string `toml:"bridges" json:"bridges,omitempty"`
+	Keys    []string `toml:"keys" json:"keys,omitempty"`
+	Jobs    []string `toml:"jobs" json:"jobs,omitempty"`
+}
+
+// Value returns this instance serialized for database storage.
+func (d Dependencies) Value() (driver.Value, error) {
+	return json.Marshal(d)
+}
+
+// Scan reads the database value and returns an instance.
+func (d *Dependencies) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("expected bytes got %T", value)
+	}
+	return json.Unmarshal(b, d)
+}
+
+func (d Dependencies) validate() error {
+	for _, name := range d.Bridges {
+		if _, err := bridges.ParseBridgeName(name); err != nil {
+			return errors.Wrap(err, "invalid dependency bridge")
+		}
+	}
+	for _, key := range d.Keys {
+		if key == "" {
+			return errors.New("invalid dependency key: key ID is empty")
+		}
+	}
+	for _, id := range d.Jobs {
+		if _, err := uuid.FromString(id); err != nil {
+			return errors.Wrapf(err, "invalid dependency job %q, must be an external job ID", id)
+		}
+	}
+	return nil
+}
+
+// AssertDependenciesExist checks that the bridges, keys and jobs the job
+// depends on exist, the jobs not being archived
+func (o *orm) AssertDependenciesExist(deps Dependencies, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	for _, name := range deps.Bridges {
+		if _, err := o.bridgeORM.FindBridge(bridges.BridgeName(name), qopts...); errors.Is(err, sql.ErrNoRows) {
+			return errors.Errorf("dependency bridge %q does not exist", name)
+		} else if err != nil {
+			return errors.Wrapf(err, "failed to find dependency bridge %q", name)
+		}
+	}
+	for _, id := range deps.Keys {
+		if !o.keyExists(id) {
+			return errors.Errorf("dependency key %q does not exist", id)
+		}
+	}
+	for _, id := range deps.Jobs {
+		var archived bool
+		err := q.Get(&archived, `SELECT archived_at IS NOT NULL FROM jobs WHERE external_job_id = $1`, id)
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.Errorf("dependency job %s does not exist", id)
+		} else if err != nil {
+			return errors.Wrapf(err, "failed to find dependency job %s", id)
+		}
+		if archived {
+			return errors.Errorf("dependency job %s is archived", id)
+		}
+	}
+	return nil
+}
+
+// keyExists returns true if any keystore has a key with the ID
+func (o *orm) keyExists(id string) bool {
+	if o.keyStore == nil {
+		return false
+	}
+	if common.IsHexAddress(id) {
+		if _, err := o.keyStore.Eth().Get(common.HexToAddress(id).Hex()); err == nil {
+			return true
+		}
+	}
+	if peerID, err := p2pkey.MakePeerID(id); err == nil {
+		if _, err = o.keyStore.P2P().Get(peerID); err == nil {
+			return true
+		}
+	}
+	if _, err := o.keyStore.OCR().Get(id); err == nil {
+		return true
+	}
+	if _, err := o.keyStore.OCR2().Get(id); err == nil {
+		return true
+	}
+	if _, err := o.keyStore.CSA().Get(id); err == nil {
+		return true
+	}
+	if _, err := o.keyStore.VRF().Get(id); err == nil {
+		return true
+	}
+	return false
+}
+
+// sortByDependencies orders the jobs so that the jobs they depend on come
+// first, keeping the order of the jobs otherwise
+func sortByDependencies(jobs []Job) []Job {
+	byExternalID := make(map[string]int, len(jobs))
+	for i, jb := range jobs {
+		byExternalID[jb.ExternalJobID.String()] = i
+	}
+	sorted := make([]Job, 0, len(jobs))
+	visited := make([]bool, len(jobs))
+	var visit func(i int)
+	visit = func(i int) {
+		if visited[i] {
+			return
+		}
+		visited[i] = true
+		for _, id := range jobs[i].Dependencies.Jobs {
+			if u, err := uuid.FromString(id); err == nil {
+				if j, ok := byExternalID[u.String()]; ok {
+					visit(j)
+				}
+			}
+		}
+		sorted = append(sorted, jobs[i])
+	}
+	for i := range jobs {
+		visit(i)
+	}
+	return sorted
+}
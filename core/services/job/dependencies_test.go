@@ -0,0 +1,46 @@
+package job
+
+import (
+	"testing"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDependencies_validate(t *testing.T) {
+	require.NoError(t, Dependencies{
+		Bridges: []string{"coingecko"},
+		Keys:    []string{"0x613a38AC1659769640aaE063C651F48E0250454C"},
+		Jobs:    []string{"0EEC7E1D-D0D2-476C-A1A8-72DFB6633F46"},
+	}.validate())
+
+	assert.ErrorContains(t, Dependencies{Bridges: []string{"not a bridge"}}.validate(), "invalid dependency bridge")
+	assert.EqualError(t, Dependencies{Keys: []string{""}}.validate(), "invalid dependency key: key ID is empty")
+	assert.ErrorContains(t, Dependencies{Jobs: []string{"bootstrap"}}.validate(), `invalid dependency job "bootstrap", must be an external job ID`)
+}
+
+func Test_sortByDependencies(t *testing.T) {
+	newJob := func(id int32, deps ...uuid.UUID) Job {
+		jb := Job{ID: id, ExternalJobID: uuid.NewV4()}
+		for _, dep := range deps {
+			jb.Dependencies.Jobs = append(jb.Dependencies.Jobs, dep.String())
+		}
+		return jb
+	}
+	bootstrap := newJob(1)
+	ocr := newJob(2, bootstrap.ExternalJobID)
+	ocr.Dependencies.Jobs = append(ocr.Dependencies.Jobs, uuid.NewV4().String())
+	cron := newJob(3)
+	dependent := newJob(4, ocr.ExternalJobID, cron.ExternalJobID)
+
+	ids := func(jobs []Job) (ids []int32) {
+		for _, jb := range jobs {
+			ids = append(ids, jb.ID)
+		}
+		return
+	}
+	assert.Equal(t, []int32{1, 2, 3, 4}, ids(sortByDependencies([]Job{bootstrap, ocr, cron, dependent})))
+	assert.Equal(t, []int32{1, 2, 3, 4}, ids(sortByDependencies([]Job{dependent, ocr, bootstrap, cron})))
+	assert.Equal(t, []int32{3, 1, 2, 4}, ids(sortByDependencies([]Job{cron, dependent, ocr, bootstrap})))
+}
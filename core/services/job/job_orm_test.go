@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 
+	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
@@ -48,7 +50,7 @@ func TestORM(t *testing.T) {
 
 	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
 	orm := job.NewTestORM(t, db, cc, pipelineORM, keyStore, config)
-	borm := bridges.NewORM(db, logger.TestLogger(t), config)
+	borm := bridges.NewORM(db, logger.TestLogger(t), config, nil)
 
 	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{}, config)
 	_, bridge2 := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{}, config)
@@ -177,6 +179,47 @@ func TestORM(t *testing.T) {
 		assert.Equal(t, ocrSpecError2, dbSpecErr2.Description)
 	})
 
+	t.Run("groups job spec errors by class and acknowledges them", func(t *testing.T) {
+		jobSpec := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+		require.NoError(t, orm.CreateJob(jobSpec))
+
+		require.NoError(t, orm.RecordError(jobSpec.ID, "ocr spec 1 errored"))
+		require.NoError(t, orm.RecordError(jobSpec.ID, "tx 0xdeadbeef reverted at block 12345"))
+		require.NoError(t, orm.RecordError(jobSpec.ID, "tx 0xcafe reverted at block 12346"))
+
+		specErrs, err := orm.FindSpecErrorsByJobID(jobSpec.ID, false)
+		require.NoError(t, err)
+		require.Len(t, specErrs, 2)
+		specErr := specErrs[0]
+		assert.Equal(t, "tx <hex> reverted at block <n>", specErr.ErrorClass)
+		assert.Equal(t, "tx 0xcafe reverted at block 12346", specErr.Description)
+		assert.Equal(t, uint(2), specErr.Occurrences)
+
+		require.NoError(t, orm.AcknowledgeError(testutils.Context(t), specErr.ID))
+		require.ErrorIs(t, orm.AcknowledgeError(testutils.Context(t), -1), sql.ErrNoRows)
+
+		// acknowledged errors are still counted, but hidden
+		require.NoError(t, orm.RecordError(jobSpec.ID, "tx 0xbeef reverted at block 12347"))
+		specErrs, err = orm.FindSpecErrorsByJobID(jobSpec.ID, false)
+		require.NoError(t, err)
+		require.Len(t, specErrs, 1)
+		specErrs, err = orm.FindSpecErrorsByJobID(jobSpec.ID, true)
+		require.NoError(t, err)
+		require.Len(t, specErrs, 2)
+		assert.Equal(t, uint(3), specErrs[0].Occurrences)
+		assert.True(t, specErrs[0].AcknowledgedAt.Valid)
+
+		require.NoError(t, orm.AcknowledgeJobErrors(testutils.Context(t), jobSpec.ID))
+		specErrs, err = orm.FindSpecErrorsByJobID(jobSpec.ID, false)
+		require.NoError(t, err)
+		require.Len(t, specErrs, 0)
+
+		require.NoError(t, orm.DismissJobErrors(testutils.Context(t), jobSpec.ID))
+		specErrs, err = orm.FindSpecErrorsByJobID(jobSpec.ID, true)
+		require.NoError(t, err)
+		require.Len(t, specErrs, 0)
+	})
+
 	t.Run("creates a job with a direct request spec", func(t *testing.T) {
 		tree, err := toml.LoadFile("../../testdata/tomlspecs/direct-request-spec.toml")
 		require.NoError(t, err)
@@ -194,7 +237,7 @@ func TestORM(t *testing.T) {
 			{Name: eiFoo.Name, Spec: cltest.JSONFromString(t, `{}`)},
 			{Name: eiBar.Name, Spec: cltest.JSONFromString(t, `{"bar": 1}`)},
 		}
-		eim := webhook.NewExternalInitiatorManager(db, nil, logger.TestLogger(t), config)
+		eim := webhook.NewExternalInitiatorManager(db, nil, logger.TestLogger(t), config, nil)
 		jb, err := webhook.ValidatedWebhookSpec(testspecs.GenerateWebhookSpec(testspecs.WebhookSpecParams{ExternalInitiators: eiWS}).Toml(), eim)
 		require.NoError(t, err)
 
@@ -304,7 +347,7 @@ func TestORM_DeleteJob_DeletesAssociatedRecords(t *testing.T) {
 	})
 
 	t.Run("it deletes records for webhook jobs", func(t *testing.T) {
-		ei := cltest.MustInsertExternalInitiator(t, bridges.NewORM(db, logger.TestLogger(t), config))
+		ei := cltest.MustInsertExternalInitiator(t, bridges.NewORM(db, logger.TestLogger(t), config, nil))
 		jb, webhookSpec := cltest.MustInsertWebhookSpec(t, db)
 		_, err := db.Exec(`INSERT INTO external_initiator_webhook_specs (external_initiator_id, webhook_spec_id, spec) VALUES ($1,$2,$3)`, ei.ID, webhookSpec.ID, `{"ei": "foo", "name": "webhookSpecTwoEIs"}`)
 		require.NoError(t, err)
@@ -319,7 +362,7 @@ func TestORM_DeleteJob_DeletesAssociatedRecords(t *testing.T) {
 	t.Run("does not allow to delete external initiators if they have referencing external_initiator_webhook_specs", func(t *testing.T) {
 		// create new db because this will rollback transaction and poison it
 		db := pgtest.NewSqlxDB(t)
-		ei := cltest.MustInsertExternalInitiator(t, bridges.NewORM(db, logger.TestLogger(t), config))
+		ei := cltest.MustInsertExternalInitiator(t, bridges.NewORM(db, logger.TestLogger(t), config, nil))
 		_, webhookSpec := cltest.MustInsertWebhookSpec(t, db)
 		_, err := db.Exec(`INSERT INTO external_initiator_webhook_specs (external_initiator_id, webhook_spec_id, spec) VALUES ($1,$2,$3)`, ei.ID, webhookSpec.ID, `{"ei": "foo", "name": "webhookSpecTwoEIs"}`)
 		require.NoError(t, err)
@@ -430,6 +473,161 @@ func TestORM_CreateJob_OCRBootstrap(t *testing.T) {
 	cltest.AssertCount(t, db, "jobs", 0)
 }
 
+func TestORM_CreateJob_DeclaredBridges(t *testing.T) {
+	config := evmtest.NewChainScopedConfig(t, cltest.NewTestGeneralConfig(t))
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db, config)
+	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config)
+	bridgesORM := bridges.NewORM(db, logger.TestLogger(t), config, nil)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
+	jobORM := job.NewTestORM(t, db, cc, pipelineORM, keyStore, config)
+
+	specWithBridges := func(task string, defs ...string) string {
+		return fmt.Sprintf(`
+type                = "directrequest"
+schemaVersion       = 1
+contractAddress     = "0x613a38AC1659769640aaE063C651F48E0250454C"
+observationSource   = """
+    ds1 [type=bridge name="%s"];
+"""
+%s
+`, task, strings.Join(defs, "\n"))
+	}
+	bridgeDef := func(name, url string, extra ...string) string {
+		return fmt.Sprintf("[[bridges]]\nname = \"%s\"\nurl = \"%s\"\n%s", name, url, strings.Join(extra, "\n"))
+	}
+
+	t.Run("creates missing bridges with the job", func(t *testing.T) {
+		name := fmt.Sprintf("declared_%s", uuid.NewV4().String()[:8])
+		t.Setenv("DECLARED_BRIDGE_TOKEN", "s3cr3t")
+		jb, err := directrequest.ValidatedDirectRequestSpec(specWithBridges(name,
+			bridgeDef(name, "https://adapter.example.com/a", `outgoingTokenEnv = "DECLARED_BRIDGE_TOKEN"`)))
+		require.NoError(t, err)
+		require.Len(t, jb.Bridges, 1)
+
+		require.NoError(t, jobORM.CreateJob(&jb))
+
+		bt, err := bridgesORM.FindBridge(bridges.MustParseBridgeName(name))
+		require.NoError(t, err)
+		assert.Equal(t, "https://adapter.example.com/a", bt.URL.String())
+		assert.Equal(t, "s3cr3t", bt.OutgoingToken)
+
+		t.Run("accepts an existing matching bridge", func(t *testing.T) {
+			jb2, err := directrequest.ValidatedDirectRequestSpec(specWithBridges(name,
+				bridgeDef(name, "https://adapter.example.com/a")))
+			require.NoError(t, err)
+			require.NoError(t, jobORM.CreateJob(&jb2))
+		})
+	})
+
+	t.Run("rejects a conflicting bridge and rolls back", func(t *testing.T) {
+		_, existing := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: "https://adapter.example.com/b"}, config)
+		newName := fmt.Sprintf("declared_%s", uuid.NewV4().String()[:8])
+		jb, err := directrequest.ValidatedDirectRequestSpec(specWithBridges(existing.Name.String(),
+			bridgeDef(newName, "https://adapter.example.com/c"),
+			bridgeDef(existing.Name.String(), "https://adapter.example.com/other")))
+		require.NoError(t, err)
+
+		var jobsBefore int
+		require.NoError(t, db.Get(&jobsBefore, `SELECT count(*) FROM jobs`))
+
+		err = jobORM.CreateJob(&jb)
+		require.ErrorContains(t, err, "already exists with url")
+
+		_, err = bridgesORM.FindBridge(bridges.MustParseBridgeName(newName))
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		cltest.AssertCount(t, db, "jobs", int64(jobsBefore))
+	})
+
+	t.Run("rejects conflicting confirmations", func(t *testing.T) {
+		_, existing := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: "https://adapter.example.com/d"}, config)
+		jb, err := directrequest.ValidatedDirectRequestSpec(specWithBridges(existing.Name.String(),
+			bridgeDef(existing.Name.String(), "https://adapter.example.com/d", "confirmations = 3")))
+		require.NoError(t, err)
+
+		err = jobORM.CreateJob(&jb)
+		require.ErrorContains(t, err, "already exists with 0 confirmations, spec declares 3")
+	})
+
+	t.Run("updates an existing bridge and rotates its token", func(t *testing.T) {
+		_, existing := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: "https://adapter.example.com/e"}, config)
+		t.Setenv("ROTATED_BRIDGE_TOKEN", "r0t4t3d")
+		jb, err := directrequest.ValidatedDirectRequestSpec(specWithBridges(existing.Name.String(),
+			bridgeDef(existing.Name.String(), "https://adapter.example.com/f",
+				"confirmations = 3", `minimumContractPayment = "0.1 link"`, `outgoingTokenEnv = "ROTATED_BRIDGE_TOKEN"`, "update = true")))
+		require.NoError(t, err)
+
+		require.NoError(t, jobORM.CreateJob(&jb))
+
+		bt, err := bridgesORM.FindBridge(existing.Name)
+		require.NoError(t, err)
+		assert.Equal(t, "https://adapter.example.com/f", bt.URL.String())
+		assert.Equal(t, uint32(3), bt.Confirmations)
+		assert.Equal(t, assets.NewLinkFromJuels(100000000000000000).String(), bt.MinimumContractPayment.String())
+		assert.Equal(t, "r0t4t3d", bt.OutgoingToken)
+	})
+}
+
+func TestORM_CreateJob_Dependencies(t *testing.T) {
+	config := evmtest.NewChainScopedConfig(t, cltest.NewTestGeneralConfig(t))
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db, config)
+	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
+	jobORM := job.NewTestORM(t, db, cc, pipelineORM, keyStore, config)
+
+	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{}, config)
+	_, address := cltest.MustInsertRandomKey(t, keyStore.Eth())
+	bootstrap, err := ocrbootstrap.ValidatedBootstrapSpecToml(testspecs.OCRBootstrapSpec)
+	require.NoError(t, err)
+	require.NoError(t, jobORM.CreateJob(&bootstrap))
+
+	specWithDependencies := func(bridge, key, jobID string) string {
+		return fmt.Sprintf(`
+type                = "directrequest"
+schemaVersion       = 1
+contractAddress     = "0x613a38AC1659769640aaE063C651F48E0250454C"
+observationSource   = """
+    ds1 [type=memo value=1];
+"""
+[dependencies]
+bridges = ["%s"]
+keys = ["%s"]
+jobs = ["%s"]
+`, bridge, key, jobID)
+	}
+
+	jb, err := directrequest.ValidatedDirectRequestSpec(specWithDependencies(bridge.Name.String(), strings.ToLower(address.Hex()), bootstrap.ExternalJobID.String()))
+	require.NoError(t, err)
+	require.NoError(t, jobORM.CreateJob(&jb))
+	found, err := jobORM.FindJob(testutils.Context(t), jb.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{bootstrap.ExternalJobID.String()}, found.Dependencies.Jobs)
+
+	for _, tt := range []struct {
+		name               string
+		bridge, key, jobID string
+		err                string
+	}{
+		{"missing bridge", "missing_bridge", address.Hex(), bootstrap.ExternalJobID.String(), `dependency bridge "missing_bridge" does not exist`},
+		{"missing key", bridge.Name.String(), "0x0000000000000000000000000000000000000001", bootstrap.ExternalJobID.String(), `dependency key "0x0000000000000000000000000000000000000001" does not exist`},
+		{"missing job", bridge.Name.String(), address.Hex(), "0eec7e1d-d0d2-476c-a1a8-72dfb6633f46", "dependency job 0eec7e1d-d0d2-476c-a1a8-72dfb6633f46 does not exist"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			jb, err := directrequest.ValidatedDirectRequestSpec(specWithDependencies(tt.bridge, tt.key, tt.jobID))
+			require.NoError(t, err)
+			require.EqualError(t, jobORM.CreateJob(&jb), tt.err)
+		})
+	}
+
+	t.Run("archived job", func(t *testing.T) {
+		require.NoError(t, jobORM.ArchiveJob(bootstrap.ID))
+		jb, err := directrequest.ValidatedDirectRequestSpec(specWithDependencies(bridge.Name.String(), address.Hex(), bootstrap.ExternalJobID.String()))
+		require.NoError(t, err)
+		require.EqualError(t, jobORM.CreateJob(&jb), fmt.Sprintf("dependency job %s is archived", bootstrap.ExternalJobID))
+	})
+}
+
 func TestORM_CreateJob_OCR_DuplicatedContractAddress(t *testing.T) {
 	config := evmtest.NewChainScopedConfig(t, cltest.NewTestGeneralConfig(t))
 	db := pgtest.NewSqlxDB(t)
@@ -577,6 +775,30 @@ func Test_FindJobs(t *testing.T) {
 		}
 	})
 
+	t.Run("archived jobs are listed separately", func(t *testing.T) {
+		require.NoError(t, orm.ArchiveJob(jb1.ID))
+		// a job is archived once
+		require.ErrorIs(t, orm.ArchiveJob(jb1.ID), sql.ErrNoRows)
+
+		jobs, count, err := orm.FindJobs(0, 2)
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, 1, count)
+		assert.Equal(t, jb2.ID, jobs[0].ID)
+
+		jobs, count, err = orm.FindArchivedJobs(0, 2)
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, 1, count)
+		assert.Equal(t, jb1.ID, jobs[0].ID)
+		assert.True(t, jobs[0].ArchivedAt.Valid)
+
+		// the spec is kept
+		archived, err := orm.FindJob(testutils.Context(t), jb1.ID)
+		require.NoError(t, err)
+		assert.True(t, archived.ArchivedAt.Valid)
+		require.NotNil(t, archived.OCROracleSpec)
+	})
 }
 
 func Test_FindJob(t *testing.T) {
@@ -688,6 +910,50 @@ func Test_FindJobsByPipelineSpecIDs(t *testing.T) {
 	})
 }
 
+func Test_FindJobIDsWithEthKey(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db, config)
+	_, sender := cltest.MustInsertRandomKey(t, keyStore.Eth())
+	_, dependency := cltest.MustInsertRandomKey(t, keyStore.Eth())
+	_, unused := cltest.MustInsertRandomKey(t, keyStore.Eth())
+
+	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
+	orm := job.NewTestORM(t, db, cc, pipelineORM, keyStore, config)
+
+	jb1, err := directrequest.ValidatedDirectRequestSpec(testspecs.DirectRequestSpec)
+	require.NoError(t, err)
+	require.NoError(t, orm.CreateJob(&jb1))
+	etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, cltest.NewTxmORM(t, db, config), 0, 1, sender)
+	pgtest.MustExec(t, db, `UPDATE eth_txes SET meta = jsonb_build_object('JobID', $1::int) WHERE id = $2`, jb1.ID, etx.ID)
+
+	jb2, err := webhook.ValidatedWebhookSpec(`
+type              = "webhook"
+schemaVersion     = 1
+observationSource = """
+	ds [type=multiply input=1 times=2];
+"""
+`, nil)
+	require.NoError(t, err)
+	jb2.Dependencies = job.Dependencies{Keys: []string{strings.ToLower(dependency.Hex())}}
+	require.NoError(t, orm.CreateJob(&jb2))
+
+	ids, err := orm.FindJobIDsWithEthKey(sender)
+	require.NoError(t, err)
+	assert.Equal(t, []int32{jb1.ID}, ids)
+
+	ids, err = orm.FindJobIDsWithEthKey(dependency)
+	require.NoError(t, err)
+	assert.Equal(t, []int32{jb2.ID}, ids)
+
+	ids, err = orm.FindJobIDsWithEthKey(unused)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+}
+
 func Test_FindPipelineRuns(t *testing.T) {
 	t.Parallel()
 
@@ -804,6 +1070,101 @@ func Test_PipelineRunsByJobID(t *testing.T) {
 	})
 }
 
+func Test_FindPipelineRunsByFilter(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+
+	keyStore := cltest.NewKeyStore(t, db, config)
+	require.NoError(t, keyStore.OCR().Add(cltest.DefaultOCRKey))
+	require.NoError(t, keyStore.P2P().Add(cltest.DefaultP2PKey))
+
+	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
+	orm := job.NewTestORM(t, db, cc, pipelineORM, keyStore, config)
+
+	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{}, config)
+	_, bridge2 := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{}, config)
+
+	_, address := cltest.MustInsertRandomKey(t, keyStore.Eth())
+	jb, err := ocr.ValidatedOracleSpecToml(cc,
+		testspecs.GenerateOCRSpec(testspecs.OCRSpecParams{
+			JobID:              uuid.NewV4().String(),
+			TransmitterAddress: address.Hex(),
+			DS1BridgeName:      bridge.Name.String(),
+			DS2BridgeName:      bridge2.Name.String(),
+		}).Toml(),
+	)
+	require.NoError(t, err)
+	require.NoError(t, orm.CreateJob(&jb))
+
+	start := time.Now().Add(-time.Hour).Truncate(time.Second)
+	var runs []pipeline.Run
+	for i := 0; i < 5; i++ {
+		run := pipeline.Run{
+			PipelineSpecID: jb.PipelineSpecID,
+			State:          pipeline.RunStatusRunning,
+			AllErrors:      pipeline.RunErrors{},
+			CreatedAt:      start.Add(time.Duration(i) * time.Minute),
+		}
+		if i%2 == 1 {
+			run.State = pipeline.RunStatusSuspended
+		}
+		require.NoError(t, pipelineORM.CreateRun(&run))
+		runs = append(runs, run)
+	}
+
+	t.Run("pages through the runs of a job, latest first", func(t *testing.T) {
+		filter := job.PipelineRunsFilter{JobID: &jb.ID, Limit: 2}
+		var ids []int64
+		for {
+			page, next, err := orm.FindPipelineRuns(filter)
+			require.NoError(t, err)
+			for _, run := range page {
+				assert.Equal(t, jb.ID, run.PipelineSpec.JobID)
+				ids = append(ids, run.ID)
+			}
+			if next == nil {
+				break
+			}
+			cursor, err := job.ParsePipelineRunsCursor(next.String())
+			require.NoError(t, err)
+			filter.After = &cursor
+		}
+		assert.Equal(t, []int64{runs[4].ID, runs[3].ID, runs[2].ID, runs[1].ID, runs[0].ID}, ids)
+	})
+
+	t.Run("filters by state and creation time, oldest first", func(t *testing.T) {
+		after, before := start.Add(time.Minute), start.Add(4*time.Minute)
+		page, next, err := orm.FindPipelineRuns(job.PipelineRunsFilter{
+			States:        []pipeline.RunStatus{pipeline.RunStatusRunning},
+			CreatedAfter:  &after,
+			CreatedBefore: &before,
+			Ascending:     true,
+			Limit:         10,
+		})
+		require.NoError(t, err)
+		assert.Nil(t, next)
+		require.Len(t, page, 1)
+		assert.Equal(t, runs[2].ID, page[0].ID)
+
+		page, _, err = orm.FindPipelineRuns(job.PipelineRunsFilter{
+			States:    []pipeline.RunStatus{pipeline.RunStatusRunning, pipeline.RunStatusSuspended},
+			Ascending: true,
+			Limit:     10,
+		})
+		require.NoError(t, err)
+		require.Len(t, page, 5)
+		assert.Equal(t, runs[0].ID, page[0].ID)
+	})
+
+	t.Run("rejects invalid cursors", func(t *testing.T) {
+		_, err := job.ParsePipelineRunsCursor("foo")
+		assert.ErrorIs(t, err, job.ErrInvalidCursor)
+	})
+}
+
 func Test_FindPipelineRunIDsByJobID(t *testing.T) {
 	t.Parallel()
 
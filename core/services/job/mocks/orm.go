@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	common "github.com/ethereum/go-ethereum/common"
+
 	context "context"
 
 	job "github.com/smartcontractkit/chainlink/core/services/job"
@@ -22,6 +24,76 @@ type ORM struct {
 	mock.Mock
 }
 
+// AcknowledgeError provides a mock function with given fields: ctx, errorID
+func (_m *ORM) AcknowledgeError(ctx context.Context, errorID int64) error {
+	ret := _m.Called(ctx, errorID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, errorID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AcknowledgeJobErrors provides a mock function with given fields: ctx, jobID
+func (_m *ORM) AcknowledgeJobErrors(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ArchiveJob provides a mock function with given fields: id, qopts
+func (_m *ORM) ArchiveJob(id int32, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int32, ...pg.QOpt) error); ok {
+		r0 = rf(id, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AssertDependenciesExist provides a mock function with given fields: deps, qopts
+func (_m *ORM) AssertDependenciesExist(deps job.Dependencies, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, deps)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(job.Dependencies, ...pg.QOpt) error); ok {
+		r0 = rf(deps, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Close provides a mock function with given fields:
 func (_m *ORM) Close() error {
 	ret := _m.Called()
@@ -113,6 +185,50 @@ func (_m *ORM) DismissError(ctx context.Context, errorID int64) error {
 	return r0
 }
 
+// DismissJobErrors provides a mock function with given fields: ctx, jobID
+func (_m *ORM) DismissJobErrors(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindArchivedJobs provides a mock function with given fields: offset, limit
+func (_m *ORM) FindArchivedJobs(offset int, limit int) ([]job.Job, int, error) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []job.Job
+	if rf, ok := ret.Get(0).(func(int, int) []job.Job); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.Job)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(int, int) int); ok {
+		r1 = rf(offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(int, int) error); ok {
+		r2 = rf(offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // FindJob provides a mock function with given fields: ctx, id
 func (_m *ORM) FindJob(ctx context.Context, id int32) (job.Job, error) {
 	ret := _m.Called(ctx, id)
@@ -213,6 +329,36 @@ func (_m *ORM) FindJobIDsWithBridge(name string) ([]int32, error) {
 	return r0, r1
 }
 
+// FindJobIDsWithEthKey provides a mock function with given fields: address, qopts
+func (_m *ORM) FindJobIDsWithEthKey(address common.Address, qopts ...pg.QOpt) ([]int32, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, address)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []int32
+	if rf, ok := ret.Get(0).(func(common.Address, ...pg.QOpt) []int32); ok {
+		r0 = rf(address, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int32)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, ...pg.QOpt) error); ok {
+		r1 = rf(address, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindJobTx provides a mock function with given fields: id
 func (_m *ORM) FindJobTx(id int32) (job.Job, error) {
 	ret := _m.Called(id)
@@ -352,6 +498,45 @@ func (_m *ORM) FindPipelineRunIDsByJobID(jobID int32, offset int, limit int) ([]
 	return r0, r1
 }
 
+// FindPipelineRuns provides a mock function with given fields: filter, qopts
+func (_m *ORM) FindPipelineRuns(filter job.PipelineRunsFilter, qopts ...pg.QOpt) ([]pipeline.Run, *job.PipelineRunsCursor, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, filter)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []pipeline.Run
+	if rf, ok := ret.Get(0).(func(job.PipelineRunsFilter, ...pg.QOpt) []pipeline.Run); ok {
+		r0 = rf(filter, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.Run)
+		}
+	}
+
+	var r1 *job.PipelineRunsCursor
+	if rf, ok := ret.Get(1).(func(job.PipelineRunsFilter, ...pg.QOpt) *job.PipelineRunsCursor); ok {
+		r1 = rf(filter, qopts...)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*job.PipelineRunsCursor)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(job.PipelineRunsFilter, ...pg.QOpt) error); ok {
+		r2 = rf(filter, qopts...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // FindPipelineRunsByIDs provides a mock function with given fields: ids
 func (_m *ORM) FindPipelineRunsByIDs(ids []int64) ([]pipeline.Run, error) {
 	ret := _m.Called(ids)
@@ -403,6 +588,36 @@ func (_m *ORM) FindSpecError(id int64, qopts ...pg.QOpt) (job.SpecError, error)
 	return r0, r1
 }
 
+// FindSpecErrorsByJobID provides a mock function with given fields: jobID, includeAcknowledged, qopts
+func (_m *ORM) FindSpecErrorsByJobID(jobID int32, includeAcknowledged bool, qopts ...pg.QOpt) ([]job.SpecError, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jobID, includeAcknowledged)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []job.SpecError
+	if rf, ok := ret.Get(0).(func(int32, bool, ...pg.QOpt) []job.SpecError); ok {
+		r0 = rf(jobID, includeAcknowledged, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.SpecError)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int32, bool, ...pg.QOpt) error); ok {
+		r1 = rf(jobID, includeAcknowledged, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindSpecErrorsByJobIDs provides a mock function with given fields: ids, qopts
 func (_m *ORM) FindSpecErrorsByJobIDs(ids []int32, qopts ...pg.QOpt) ([]job.SpecError, error) {
 	_va := make([]interface{}, len(qopts))
@@ -526,6 +741,27 @@ func (_m *ORM) RecordError(jobID int32, description string, qopts ...pg.QOpt) er
 	return r0
 }
 
+// SetJobPaused provides a mock function with given fields: id, paused, qopts
+func (_m *ORM) SetJobPaused(id int32, paused bool, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id, paused)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int32, bool, ...pg.QOpt) error); ok {
+		r0 = rf(id, paused, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // TryRecordError provides a mock function with given fields: jobID, description, qopts
 func (_m *ORM) TryRecordError(jobID int32, description string, qopts ...pg.QOpt) {
 	_va := make([]interface{}, len(qopts))
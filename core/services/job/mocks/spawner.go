@@ -32,6 +32,27 @@ func (_m *Spawner) ActiveJobs() map[int32]job.Job {
 	return r0
 }
 
+// ArchiveJob provides a mock function with given fields: jobID, qopts
+func (_m *Spawner) ArchiveJob(jobID int32, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jobID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int32, ...pg.QOpt) error); ok {
+		r0 = rf(jobID, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Close provides a mock function with given fields:
 func (_m *Spawner) Close() error {
 	ret := _m.Called()
@@ -102,6 +123,27 @@ func (_m *Spawner) Healthy() error {
 	return r0
 }
 
+// PauseJob provides a mock function with given fields: jobID, qopts
+func (_m *Spawner) PauseJob(jobID int32, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jobID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int32, ...pg.QOpt) error); ok {
+		r0 = rf(jobID, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Ready provides a mock function with given fields:
 func (_m *Spawner) Ready() error {
 	ret := _m.Called()
@@ -116,6 +158,41 @@ func (_m *Spawner) Ready() error {
 	return r0
 }
 
+// RestartJob provides a mock function with given fields: ctx, jobID
+func (_m *Spawner) RestartJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResumeJob provides a mock function with given fields: ctx, jobID, qopts
+func (_m *Spawner) ResumeJob(ctx context.Context, jobID int32, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, jobID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, ...pg.QOpt) error); ok {
+		r0 = rf(ctx, jobID, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Spawner) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
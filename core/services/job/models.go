@@ -4,6 +4,7 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -55,6 +56,12 @@ func (t Type) SupportsAsync() bool {
 	return supportsAsync[t]
 }
 
+// SupportsRunDedup returns true if the runs of the type are not referenced by
+// other records, so that they can be deduplicated
+func (t Type) SupportsRunDedup() bool {
+	return supportsRunDedup[t]
+}
+
 func (t Type) SchemaVersion() uint32 {
 	return schemaVersions[t]
 }
@@ -84,6 +91,18 @@ var (
 		BlockhashStore:     false,
 		Bootstrap:          false,
 	}
+	supportsRunDedup = map[Type]bool{
+		Cron:               true,
+		DirectRequest:      false,
+		FluxMonitor:        false,
+		OffchainReporting:  true,
+		OffchainReporting2: true,
+		Keeper:             false,
+		VRF:                false,
+		Webhook:            false,
+		BlockhashStore:     false,
+		Bootstrap:          false,
+	}
 	schemaVersions = map[Type]uint32{
 		Cron:               1,
 		DirectRequest:      1,
@@ -130,8 +149,25 @@ type Job struct {
 	ForwardingAllowed    null.Bool     `toml:"forwardingAllowed"`
 	Name                 null.String
 	MaxTaskDuration      models.Interval
-	Pipeline             pipeline.Pipeline `toml:"observationSource"`
+	Pipeline             pipeline.Pipeline  `toml:"observationSource"`
+	Bridges              []BridgeDefinition `toml:"bridges"`
+	MetricsLabels        MetricsLabels      `toml:"metricsLabels"`
+	RunDedup             *pipeline.RunDedup `toml:"runDedup"`
+	Dependencies         Dependencies       `toml:"dependencies"`
 	CreatedAt            time.Time
+	// ArchivedAt is set when the job is archived: its services are stopped
+	// and it is kept read-only, with its runs, until it is purged
+	ArchivedAt null.Time `toml:"-"`
+	// Paused jobs keep their spec but their services are stopped until they
+	// are resumed
+	Paused bool `toml:"-"`
+	// MonthlyBudget, if set, is the wei the transactions of the job can spend
+	// in a calendar month (UTC) before they are paused
+	MonthlyBudget *assets.Eth `toml:"monthlyBudgetWei"`
+	// AlertErrorsThreshold and AlertErrorsWindow, if set, override the
+	// thresholds of the job errors alert rule for the job
+	AlertErrorsThreshold clnull.Uint32    `toml:"alertErrorsThreshold"`
+	AlertErrorsWindow    *models.Interval `toml:"alertErrorsWindow"`
 }
 
 func ExternalJobIDEncodeStringToTopic(id uuid.UUID) common.Hash {
@@ -166,13 +202,34 @@ func (j *Job) SetID(value string) error {
 	return nil
 }
 
+// SpecError groups the recurring errors of a job by their class. Description
+// is the latest occurrence, CreatedAt and UpdatedAt are when the class was
+// first and last seen.
 type SpecError struct {
-	ID          int64
-	JobID       int32
-	Description string
-	Occurrences uint
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID             int64
+	JobID          int32
+	Description    string
+	ErrorClass     string
+	Occurrences    uint
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	AcknowledgedAt null.Time
+}
+
+var (
+	uuidPattern   = regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`)
+	hexPattern    = regexp.MustCompile(`0x[0-9a-fA-F]+`)
+	numberPattern = regexp.MustCompile(`\b\d{4,}\b`)
+)
+
+// SpecErrorClass returns the class of an error description, with the
+// identifiers, hashes, addresses and long numbers (block numbers, nonces,
+// timestamps...) replaced by placeholders so the occurrences of the same error
+// are grouped.
+func SpecErrorClass(description string) string {
+	class := uuidPattern.ReplaceAllString(description, "<uuid>")
+	class = hexPattern.ReplaceAllString(class, "<hex>")
+	return numberPattern.ReplaceAllString(class, "<n>")
 }
 
 // SetID takes the id as a string and attempts to convert it to an int32. If
@@ -273,6 +330,28 @@ func (r *JSONConfig) Scan(value interface{}) error {
 	return json.Unmarshal(b, &r)
 }
 
+// MetricsLabels are the custom prometheus labels a job adds to its pipeline
+// metrics. Only the labels enabled by the JobPipelineMetricsCustomLabels config
+// are reported.
+type MetricsLabels map[string]string
+
+// Value returns this instance serialized for database storage.
+func (l MetricsLabels) Value() (driver.Value, error) {
+	if l == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(l)
+}
+
+// Scan reads the database value and returns an instance.
+func (l *MetricsLabels) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("expected bytes got %T", value)
+	}
+	return json.Unmarshal(b, l)
+}
+
 // OCR2PluginType defines supported OCR2 plugin types.
 type OCR2PluginType string
 
@@ -331,8 +410,20 @@ type ExternalInitiatorWebhookSpec struct {
 type WebhookSpec struct {
 	ID                            int32 `toml:"-"`
 	ExternalInitiatorWebhookSpecs []ExternalInitiatorWebhookSpec
-	CreatedAt                     time.Time `json:"createdAt" toml:"-"`
-	UpdatedAt                     time.Time `json:"updatedAt" toml:"-"`
+	// PublicTriggerTokenHash is the hex encoded SHA-256 of the token of the
+	// public trigger URL of the job, null if the job has none
+	PublicTriggerTokenHash null.String `json:"-" toml:"-"`
+	// PublicTriggerToken is the token of the public trigger URL, only known
+	// when the job is created
+	PublicTriggerToken string `db:"-" json:"-" toml:"-"`
+	// PublicTriggerRateLimit is the maximum number of runs per minute started
+	// through the public trigger URL
+	PublicTriggerRateLimit null.Int `json:"-" toml:"-"`
+	// PublicTriggerSecret is the key of the HMAC signing the requests to the
+	// public trigger URL, encrypted once stored
+	PublicTriggerSecret null.String `json:"-" toml:"-"`
+	CreatedAt           time.Time   `json:"createdAt" toml:"-"`
+	UpdatedAt           time.Time   `json:"updatedAt" toml:"-"`
 }
 
 func (w WebhookSpec) GetID() string {
@@ -356,6 +447,8 @@ type DirectRequestSpec struct {
 	Requesters                  models.AddressCollection `toml:"requesters"`
 	MinContractPayment          *assets.Link             `toml:"minContractPaymentLinkJuels"`
 	EVMChainID                  *utils.Big               `toml:"evmChainID"`
+	DedupKey                    null.String              `toml:"dedupKey"` // Optional, a $(oracleRequest.x) expression identifying duplicate requests.
+	DedupTTL                    time.Duration            `toml:"dedupTTL"` // Optional, defaults to 24hr if dedupKey is set.
 	CreatedAt                   time.Time                `toml:"-"`
 	UpdatedAt                   time.Time                `toml:"-"`
 }
@@ -465,6 +558,15 @@ type VRFSpec struct {
 	// only.
 	BackoffMaxDelay time.Duration `toml:"backoffMaxDelay"`
 
+	// MinContractPayment is the minimum fee a request must pay for it to be fulfilled. Requests
+	// paying less are skipped rather than fulfilled at a loss. V1 only.
+	MinContractPayment *assets.Link `toml:"minContractPaymentLinkJuels"`
+
+	// LinkEthFeedAddress is the address of a LINK/ETH price feed. If set, the listener estimates
+	// the fulfillment cost at the current gas price before fulfilling a request, and skips requests
+	// whose fee does not cover it. V1 only.
+	LinkEthFeedAddress *ethkey.EIP55Address `toml:"linkEthFeedAddress"`
+
 	CreatedAt time.Time `toml:"-"`
 	UpdatedAt time.Time `toml:"-"`
 }
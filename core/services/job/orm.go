@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -37,6 +38,8 @@ var (
 	ErrNoSuchKeyBundle      = errors.New("no such key bundle exists")
 	ErrNoSuchTransmitterKey = errors.New("no such transmitter key exists")
 	ErrNoSuchPublicKey      = errors.New("no such public key exists")
+	ErrJobNotArchived       = errors.New("job must be archived before being purged")
+	ErrJobPaused            = errors.New("job is paused")
 )
 
 //go:generate mockery --name ORM --output ./mocks/ --case=underscore
@@ -46,19 +49,42 @@ type ORM interface {
 	InsertJob(job *Job, qopts ...pg.QOpt) error
 	CreateJob(jb *Job, qopts ...pg.QOpt) error
 	FindJobs(offset, limit int) ([]Job, int, error)
+	// FindArchivedJobs returns the archived jobs, latest first
+	FindArchivedJobs(offset, limit int) ([]Job, int, error)
 	FindJobTx(id int32) (Job, error)
 	FindJob(ctx context.Context, id int32) (Job, error)
 	FindJobByExternalJobID(uuid uuid.UUID, qopts ...pg.QOpt) (Job, error)
 	FindJobIDByAddress(address ethkey.EIP55Address, qopts ...pg.QOpt) (int32, error)
 	FindJobIDsWithBridge(name string) ([]int32, error)
+	// FindJobIDsWithEthKey returns the IDs of the jobs whose spec uses the ETH
+	// key, which depend on it, or which sent transactions from it
+	FindJobIDsWithEthKey(address common.Address, qopts ...pg.QOpt) ([]int32, error)
 	DeleteJob(id int32, qopts ...pg.QOpt) error
+	// ArchiveJob marks the job as archived, it returns sql.ErrNoRows if the
+	// job doesn't exist or is already archived
+	ArchiveJob(id int32, qopts ...pg.QOpt) error
+	// SetJobPaused pauses or resumes a job, it returns sql.ErrNoRows if the
+	// job doesn't exist or is archived
+	SetJobPaused(id int32, paused bool, qopts ...pg.QOpt) error
 	RecordError(jobID int32, description string, qopts ...pg.QOpt) error
 	// TryRecordError is a helper which calls RecordError and logs the returned error if present.
 	TryRecordError(jobID int32, description string, qopts ...pg.QOpt)
 	DismissError(ctx context.Context, errorID int64) error
+	// DismissJobErrors deletes all the errors of a job
+	DismissJobErrors(ctx context.Context, jobID int32) error
+	// AcknowledgeError hides an error class from the job errors, while its
+	// occurrences are still counted
+	AcknowledgeError(ctx context.Context, errorID int64) error
+	// AcknowledgeJobErrors acknowledges all the errors of a job
+	AcknowledgeJobErrors(ctx context.Context, jobID int32) error
 	FindSpecError(id int64, qopts ...pg.QOpt) (SpecError, error)
+	// FindSpecErrorsByJobID returns the errors of a job, by last seen
+	FindSpecErrorsByJobID(jobID int32, includeAcknowledged bool, qopts ...pg.QOpt) ([]SpecError, error)
 	Close() error
 	PipelineRuns(jobID *int32, offset, size int) ([]pipeline.Run, int, error)
+	// FindPipelineRuns returns a page of the runs matching filter, with their
+	// spec and task runs loaded, and the cursor of the next page if any
+	FindPipelineRuns(filter PipelineRunsFilter, qopts ...pg.QOpt) (runs []pipeline.Run, next *PipelineRunsCursor, err error)
 
 	FindPipelineRunIDsByJobID(jobID int32, offset, limit int) (ids []int64, err error)
 	FindPipelineRunsByIDs(ids []int64) (runs []pipeline.Run, err error)
@@ -69,6 +95,9 @@ type ORM interface {
 
 	FindSpecErrorsByJobIDs(ids []int32, qopts ...pg.QOpt) ([]SpecError, error)
 	FindJobWithoutSpecErrors(id int32) (jb Job, err error)
+	// AssertDependenciesExist checks that the bridges, keys and jobs the job
+	// depends on exist
+	AssertDependenciesExist(deps Dependencies, qopts ...pg.QOpt) error
 }
 
 type orm struct {
@@ -78,6 +107,7 @@ type orm struct {
 	pipelineORM pipeline.ORM
 	lggr        logger.Logger
 	bridgeORM   bridges.ORM
+	ciphers     *pg.ColumnCiphers
 }
 
 var _ ORM = (*orm)(nil)
@@ -91,39 +121,45 @@ func NewORM(
 	cfg pg.LogConfig,
 ) *orm {
 	namedLogger := lggr.Named("JobORM")
+	var ciphers *pg.ColumnCiphers
+	if keyStore != nil {
+		ciphers = keyStore.ColumnCiphers()
+	}
 	return &orm{
 		q:           pg.NewQ(db, namedLogger, cfg),
 		chainSet:    chainSet,
 		keyStore:    keyStore,
 		pipelineORM: pipelineORM,
-		bridgeORM:   bridges.NewORM(db, lggr, cfg),
+		bridgeORM:   bridges.NewORM(db, lggr, cfg, ciphers),
 		lggr:        namedLogger,
+		ciphers:     ciphers,
 	}
 }
 func (o *orm) Close() error {
 	return nil
 }
 
-func (o *orm) assertBridgesExist(p pipeline.Pipeline) error {
+func (o *orm) assertBridgesExist(p pipeline.Pipeline, qopts ...pg.QOpt) error {
 	var bridgeNames = make(map[bridges.BridgeName]struct{})
 	var uniqueBridges []bridges.BridgeName
 	for _, task := range p.Tasks {
 		if task.Type() == pipeline.TaskTypeBridge {
-			// Bridge must exist
-			name := task.(*pipeline.BridgeTask).Name
-			bridge, err := bridges.ParseBridgeName(name)
+			// Bridges must exist
+			names, err := task.(*pipeline.BridgeTask).BridgeNames()
 			if err != nil {
 				return err
 			}
-			if _, have := bridgeNames[bridge]; have {
-				continue
+			for _, bridge := range names {
+				if _, have := bridgeNames[bridge]; have {
+					continue
+				}
+				bridgeNames[bridge] = struct{}{}
+				uniqueBridges = append(uniqueBridges, bridge)
 			}
-			bridgeNames[bridge] = struct{}{}
-			uniqueBridges = append(uniqueBridges, bridge)
 		}
 	}
 	if len(uniqueBridges) != 0 {
-		_, err := o.bridgeORM.FindBridges(uniqueBridges)
+		_, err := o.bridgeORM.FindBridges(uniqueBridges, qopts...)
 		if err != nil {
 			return err
 		}
@@ -137,12 +173,19 @@ func (o *orm) assertBridgesExist(p pipeline.Pipeline) error {
 func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 	q := o.q.WithOpts(qopts...)
 	p := jb.Pipeline
-	if err := o.assertBridgesExist(p); err != nil {
-		return err
-	}
 
 	var jobID int32
 	err := q.Transaction(func(tx pg.Queryer) error {
+		if err := o.ensureBridges(jb.Bridges, pg.WithQueryer(tx)); err != nil {
+			return err
+		}
+		if err := o.assertBridgesExist(p, pg.WithQueryer(tx)); err != nil {
+			return err
+		}
+		if err := o.AssertDependenciesExist(jb.Dependencies, pg.WithQueryer(tx)); err != nil {
+			return err
+		}
+
 		// Autogenerate a job ID if not specified
 		if jb.ExternalJobID == (uuid.UUID{}) {
 			jb.ExternalJobID = uuid.NewV4()
@@ -151,8 +194,8 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 		switch jb.Type {
 		case DirectRequest:
 			var specID int32
-			sql := `INSERT INTO direct_request_specs (contract_address, min_incoming_confirmations, requesters, min_contract_payment, evm_chain_id, created_at, updated_at)
-			VALUES (:contract_address, :min_incoming_confirmations, :requesters, :min_contract_payment, :evm_chain_id, now(), now())
+			sql := `INSERT INTO direct_request_specs (contract_address, min_incoming_confirmations, requesters, min_contract_payment, evm_chain_id, dedup_key, dedup_ttl, created_at, updated_at)
+			VALUES (:contract_address, :min_incoming_confirmations, :requesters, :min_contract_payment, :evm_chain_id, :dedup_key, :dedup_ttl, now(), now())
 			RETURNING id;`
 			if err := pg.PrepareQueryRowx(tx, sql, &specID, jb.DirectRequestSpec); err != nil {
 				return errors.Wrap(err, "failed to create DirectRequestSpec")
@@ -257,7 +300,7 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 				if err != nil {
 					return err
 				}
-				if err2 := o.assertBridgesExist(*feePipeline); err2 != nil {
+				if err2 := o.assertBridgesExist(*feePipeline, pg.WithQueryer(tx)); err2 != nil {
 					return err2
 				}
 			case DKG, OCR2VRF:
@@ -300,14 +343,14 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 				evm_chain_id, from_addresses, poll_period, requested_confs_delay, 
 				request_timeout, chunk_size, batch_coordinator_address, batch_fulfillment_enabled, 
 				batch_fulfillment_gas_multiplier, backoff_initial_delay, backoff_max_delay,
-				max_gas_price_gwei,
+				max_gas_price_gwei, min_contract_payment, link_eth_feed_address,
 				created_at, updated_at)
 			VALUES (
 				:coordinator_address, :public_key, :min_incoming_confirmations, 
 				:evm_chain_id, :from_addresses, :poll_period, :requested_confs_delay, 
 				:request_timeout, :chunk_size, :batch_coordinator_address, :batch_fulfillment_enabled,
 				:batch_fulfillment_gas_multiplier, :backoff_initial_delay, :backoff_max_delay,
-				:max_gas_price_gwei,
+				:max_gas_price_gwei, :min_contract_payment, :link_eth_feed_address,
 				NOW(), NOW())
 			RETURNING id;`
 
@@ -389,22 +432,64 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 
 func (o *orm) InsertWebhookSpec(webhookSpec *WebhookSpec, qopts ...pg.QOpt) error {
 	q := o.q.WithOpts(qopts...)
-	query := `INSERT INTO webhook_specs (created_at, updated_at)
-			VALUES (NOW(), NOW())
+	arg := *webhookSpec
+	if arg.PublicTriggerSecret.Valid {
+		encrypted, err := o.ciphers.Encrypt(arg.PublicTriggerSecret.String)
+		if err != nil {
+			return errors.Wrap(err, "failed to encrypt public trigger secret")
+		}
+		arg.PublicTriggerSecret.String = encrypted
+	}
+	query := `INSERT INTO webhook_specs (public_trigger_token_hash, public_trigger_rate_limit, public_trigger_secret, created_at, updated_at)
+			VALUES (:public_trigger_token_hash, :public_trigger_rate_limit, :public_trigger_secret, NOW(), NOW())
 			RETURNING *;`
-	return q.GetNamed(query, webhookSpec, webhookSpec)
+	return q.GetNamed(query, webhookSpec, &arg)
 }
 
 func (o *orm) InsertJob(job *Job, qopts ...pg.QOpt) error {
 	q := o.q.WithOpts(qopts...)
 	query := `INSERT INTO jobs (pipeline_spec_id, name, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
-				keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, external_job_id, gas_limit, forwarding_allowed, created_at)
+				keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, external_job_id, gas_limit, forwarding_allowed, metrics_labels, run_dedup, dependencies, monthly_budget, alert_errors_threshold, alert_errors_window, created_at)
 		VALUES (:pipeline_spec_id, :name, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
-				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, NOW())
+				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, :metrics_labels, :run_dedup, :dependencies, :monthly_budget, :alert_errors_threshold, :alert_errors_window, NOW())
 		RETURNING *;`
 	return q.GetNamed(query, job, job)
 }
 
+func (o *orm) ArchiveJob(id int32, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	res, cancel, err := q.ExecQIter(`UPDATE jobs SET archived_at = NOW() WHERE id = $1 AND archived_at IS NULL`, id)
+	defer cancel()
+	if err != nil {
+		return errors.Wrap(err, "ArchiveJob failed")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "ArchiveJob failed")
+	}
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (o *orm) SetJobPaused(id int32, paused bool, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	res, cancel, err := q.ExecQIter(`UPDATE jobs SET paused = $2 WHERE id = $1 AND archived_at IS NULL`, id, paused)
+	defer cancel()
+	if err != nil {
+		return errors.Wrap(err, "SetJobPaused failed")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "SetJobPaused failed")
+	}
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
 // DeleteJob removes a job
 func (o *orm) DeleteJob(id int32, qopts ...pg.QOpt) error {
 	o.lggr.Debugw("Deleting job", "jobID", id)
@@ -477,12 +562,13 @@ func (o *orm) DeleteJob(id int32, qopts ...pg.QOpt) error {
 
 func (o *orm) RecordError(jobID int32, description string, qopts ...pg.QOpt) error {
 	q := o.q.WithOpts(qopts...)
-	sql := `INSERT INTO job_spec_errors (job_id, description, occurrences, created_at, updated_at)
-	VALUES ($1, $2, 1, $3, $3)
-	ON CONFLICT (job_id, description) DO UPDATE SET
+	sql := `INSERT INTO job_spec_errors (job_id, description, error_class, occurrences, created_at, updated_at)
+	VALUES ($1, $2, $3, 1, $4, $4)
+	ON CONFLICT (job_id, error_class) DO UPDATE SET
 	occurrences = job_spec_errors.occurrences + 1,
+	description = excluded.description,
 	updated_at = excluded.updated_at`
-	err := q.ExecQ(sql, jobID, description, time.Now())
+	err := q.ExecQ(sql, jobID, description, SpecErrorClass(description), time.Now())
 	// Noop if the job has been deleted.
 	var pqErr *pgconn.PgError
 	ok := errors.As(err, &pqErr)
@@ -515,6 +601,35 @@ func (o *orm) DismissError(ctx context.Context, ID int64) error {
 	return nil
 }
 
+func (o *orm) DismissJobErrors(ctx context.Context, jobID int32) error {
+	q := o.q.WithOpts(pg.WithParentCtx(ctx))
+	err := q.ExecQ("DELETE FROM job_spec_errors WHERE job_id = $1", jobID)
+	return errors.Wrap(err, "failed to dismiss job errors")
+}
+
+func (o *orm) AcknowledgeError(ctx context.Context, ID int64) error {
+	q := o.q.WithOpts(pg.WithParentCtx(ctx))
+	res, cancel, err := q.ExecQIter("UPDATE job_spec_errors SET acknowledged_at = COALESCE(acknowledged_at, NOW()) WHERE id = $1", ID)
+	defer cancel()
+	if err != nil {
+		return errors.Wrap(err, "failed to acknowledge error")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to acknowledge error")
+	}
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (o *orm) AcknowledgeJobErrors(ctx context.Context, jobID int32) error {
+	q := o.q.WithOpts(pg.WithParentCtx(ctx))
+	err := q.ExecQ("UPDATE job_spec_errors SET acknowledged_at = NOW() WHERE job_id = $1 AND acknowledged_at IS NULL", jobID)
+	return errors.Wrap(err, "failed to acknowledge job errors")
+}
+
 func (o *orm) FindSpecError(id int64, qopts ...pg.QOpt) (SpecError, error) {
 	stmt := `SELECT * FROM job_spec_errors WHERE id = $1;`
 
@@ -524,15 +639,24 @@ func (o *orm) FindSpecError(id int64, qopts ...pg.QOpt) (SpecError, error) {
 	return *specErr, errors.Wrap(err, "FindSpecError failed")
 }
 
+// FindJobs returns the jobs which are not archived, latest first
 func (o *orm) FindJobs(offset, limit int) (jobs []Job, count int, err error) {
+	return o.findJobs("archived_at IS NULL", offset, limit)
+}
+
+func (o *orm) FindArchivedJobs(offset, limit int) (jobs []Job, count int, err error) {
+	return o.findJobs("archived_at IS NOT NULL", offset, limit)
+}
+
+func (o *orm) findJobs(filter string, offset, limit int) (jobs []Job, count int, err error) {
 	err = o.q.Transaction(func(tx pg.Queryer) error {
-		sql := `SELECT count(*) FROM jobs;`
+		sql := fmt.Sprintf(`SELECT count(*) FROM jobs WHERE %s;`, filter)
 		err = tx.QueryRowx(sql).Scan(&count)
 		if err != nil {
 			return err
 		}
 
-		sql = `SELECT * FROM jobs ORDER BY created_at DESC, id DESC OFFSET $1 LIMIT $2;`
+		sql = fmt.Sprintf(`SELECT * FROM jobs WHERE %s ORDER BY created_at DESC, id DESC OFFSET $1 LIMIT $2;`, filter)
 		err = tx.Select(&jobs, sql, offset, limit)
 		if err != nil {
 			return err
@@ -718,9 +842,10 @@ func (o *orm) FindJobWithoutSpecErrors(id int32) (jb Job, err error) {
 	return jb, o.LoadEnvConfigVars(&jb)
 }
 
-// FindSpecErrorsByJobIDs returns all jobs spec errors by jobs IDs
+// FindSpecErrorsByJobIDs returns all jobs spec errors by jobs IDs, except the
+// acknowledged ones
 func (o *orm) FindSpecErrorsByJobIDs(ids []int32, qopts ...pg.QOpt) ([]SpecError, error) {
-	stmt := `SELECT * FROM job_spec_errors WHERE job_id = ANY($1);`
+	stmt := `SELECT * FROM job_spec_errors WHERE job_id = ANY($1) AND acknowledged_at IS NULL;`
 
 	var specErrs []SpecError
 	err := o.q.WithOpts(qopts...).Select(&specErrs, stmt, ids)
@@ -728,6 +853,15 @@ func (o *orm) FindSpecErrorsByJobIDs(ids []int32, qopts ...pg.QOpt) ([]SpecError
 	return specErrs, errors.Wrap(err, "FindSpecErrorsByJobIDs failed")
 }
 
+func (o *orm) FindSpecErrorsByJobID(jobID int32, includeAcknowledged bool, qopts ...pg.QOpt) ([]SpecError, error) {
+	stmt := `SELECT * FROM job_spec_errors WHERE job_id = $1 AND ($2 OR acknowledged_at IS NULL) ORDER BY updated_at DESC, id DESC;`
+
+	var specErrs []SpecError
+	err := o.q.WithOpts(qopts...).Select(&specErrs, stmt, jobID, includeAcknowledged)
+
+	return specErrs, errors.Wrap(err, "FindSpecErrorsByJobID failed")
+}
+
 func (o *orm) FindJobByExternalJobID(externalJobID uuid.UUID, qopts ...pg.QOpt) (jb Job, err error) {
 	err = o.findJob(&jb, "external_job_id", externalJobID, qopts...)
 	return
@@ -809,7 +943,7 @@ func (o *orm) FindJobIDsWithBridge(name string) (jids []int32, err error) {
 			}
 			for _, task := range p.Tasks {
 				if task.Type() == pipeline.TaskTypeBridge {
-					if task.(*pipeline.BridgeTask).Name == name {
+					if bt := task.(*pipeline.BridgeTask); bt.Name == name || bridgeGroupHas(bt, name) {
 						jids = append(jids, id)
 					}
 				}
@@ -820,6 +954,37 @@ func (o *orm) FindJobIDsWithBridge(name string) (jids []int32, err error) {
 	return jids, errors.Wrap(err, "FindJobIDsWithBridge failed")
 }
 
+func (o *orm) FindJobIDsWithEthKey(address common.Address, qopts ...pg.QOpt) (jids []int32, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Select(&jids, `SELECT id FROM jobs WHERE
+	ocr_oracle_spec_id IN (SELECT id FROM ocr_oracle_specs WHERE transmitter_address = $1)
+	OR ocr2_oracle_spec_id IN (SELECT id FROM ocr2_oracle_specs WHERE lower(transmitter_id) = lower($2))
+	OR keeper_spec_id IN (SELECT id FROM keeper_specs WHERE from_address = $1)
+	OR vrf_spec_id IN (SELECT id FROM vrf_specs WHERE $1 = ANY(from_addresses))
+	OR blockhash_store_spec_id IN (SELECT id FROM blockhash_store_specs WHERE from_address = $1)
+	OR EXISTS (SELECT 1 FROM jsonb_array_elements_text(dependencies->'keys') AS k WHERE lower(k) = lower($2))
+	OR id IN (SELECT DISTINCT (meta->>'JobID')::int FROM eth_txes WHERE from_address = $1 AND meta->>'JobID' IS NOT NULL)
+ORDER BY id`, address, address.Hex())
+	return jids, errors.Wrap(err, "FindJobIDsWithEthKey failed")
+}
+
+// bridgeGroupHas returns true if name is one of the group of bridges of task
+func bridgeGroupHas(task *pipeline.BridgeTask, name string) bool {
+	if task.Names == "" {
+		return false
+	}
+	names, err := task.BridgeNames()
+	if err != nil {
+		return false
+	}
+	for _, n := range names {
+		if n.String() == strings.ToLower(name) {
+			return true
+		}
+	}
+	return false
+}
+
 // PipelineRunsByJobsIDs returns pipeline runs for multiple jobs, not preloading data
 func (o *orm) PipelineRunsByJobsIDs(ids []int32) (runs []pipeline.Run, err error) {
 	err = o.q.Transaction(func(tx pg.Queryer) error {
@@ -1026,6 +1191,24 @@ func (o *orm) PipelineRuns(jobID *int32, offset, size int) (runs []pipeline.Run,
 	return runs, count, errors.Wrap(err, "PipelineRuns failed")
 }
 
+func (o *orm) FindPipelineRuns(filter PipelineRunsFilter, qopts ...pg.QOpt) (runs []pipeline.Run, next *PipelineRunsCursor, err error) {
+	stmt, args := filter.query()
+	err = o.q.WithOpts(qopts...).Transaction(func(tx pg.Queryer) error {
+		if err = tx.Select(&runs, stmt, args...); err != nil {
+			return errors.Wrap(err, "error loading runs")
+		}
+		if len(runs) > filter.Limit {
+			runs = runs[:filter.Limit]
+			last := runs[len(runs)-1]
+			next = &PipelineRunsCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+		}
+		runs, err = o.loadPipelineRunsRelations(runs, tx)
+		return err
+	})
+
+	return runs, next, errors.Wrap(err, "FindPipelineRuns failed")
+}
+
 func (o *orm) loadPipelineRunsRelations(runs []pipeline.Run, tx pg.Queryer) ([]pipeline.Run, error) {
 	// Postload PipelineSpecs
 	// TODO: We should pull this out into a generic preload function once go has generics
@@ -1162,5 +1345,5 @@ func (r vrfSpecRow) toVRFSpec() *VRFSpec {
 }
 
 func loadJobSpecErrors(tx pg.Queryer, jb *Job) error {
-	return errors.Wrapf(tx.Select(&jb.JobSpecErrors, `SELECT * FROM job_spec_errors WHERE job_id = $1`, jb.ID), "failed to load job spec errors for job %d", jb.ID)
+	return errors.Wrapf(tx.Select(&jb.JobSpecErrors, `SELECT * FROM job_spec_errors WHERE job_id = $1 AND acknowledged_at IS NULL`, jb.ID), "failed to load job spec errors for job %d", jb.ID)
 }
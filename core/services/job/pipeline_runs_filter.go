@@ -0,0 +1,106 @@
+package job
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+// ErrInvalidCursor is returned when parsing a malformed PipelineRunsCursor
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// PipelineRunsCursor is the position of a run in the runs sorted by creation
+// time. It is opaque to API clients.
+type PipelineRunsCursor struct {
+	CreatedAt time.Time
+	ID        int64
+}
+
+// ParsePipelineRunsCursor parses a cursor formatted by PipelineRunsCursor.String
+func ParsePipelineRunsCursor(s string) (PipelineRunsCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return PipelineRunsCursor{}, ErrInvalidCursor
+	}
+	parts := strings.Split(string(b), ",")
+	if len(parts) != 2 {
+		return PipelineRunsCursor{}, ErrInvalidCursor
+	}
+	nanos, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return PipelineRunsCursor{}, ErrInvalidCursor
+	}
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return PipelineRunsCursor{}, ErrInvalidCursor
+	}
+	return PipelineRunsCursor{CreatedAt: time.Unix(0, nanos).UTC(), ID: id}, nil
+}
+
+func (c PipelineRunsCursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d,%d", c.CreatedAt.UnixNano(), c.ID)))
+}
+
+// PipelineRunsFilter selects a page of runs, sorted by creation time
+type PipelineRunsFilter struct {
+	JobID  *int32
+	States []pipeline.RunStatus
+	// CreatedAfter is inclusive, CreatedBefore is exclusive
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	// Ascending returns the oldest runs first, the latest runs are first by
+	// default
+	Ascending bool
+	// After is the cursor of the last run of the previous page, nil for the
+	// first page
+	After *PipelineRunsCursor
+	Limit int
+}
+
+func (f PipelineRunsFilter) query() (string, []interface{}) {
+	var conds []string
+	var args []interface{}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if f.JobID != nil {
+		conds = append(conds, "pipeline_spec_id = (SELECT pipeline_spec_id FROM jobs WHERE id = "+arg(*f.JobID)+")")
+	}
+	if len(f.States) > 0 {
+		states := make([]string, len(f.States))
+		for i, s := range f.States {
+			states[i] = string(s)
+		}
+		conds = append(conds, "state = ANY("+arg(pq.Array(states))+"::pipeline_runs_state[])")
+	}
+	if f.CreatedAfter != nil {
+		conds = append(conds, "created_at >= "+arg(*f.CreatedAfter))
+	}
+	if f.CreatedBefore != nil {
+		conds = append(conds, "created_at < "+arg(*f.CreatedBefore))
+	}
+	order, cmp := "DESC", "<"
+	if f.Ascending {
+		order, cmp = "ASC", ">"
+	}
+	if f.After != nil {
+		conds = append(conds, fmt.Sprintf("(created_at, id) %s (%s, %s)", cmp, arg(f.After.CreatedAt), arg(f.After.ID)))
+	}
+
+	var where string
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+	// one more run is loaded to know if there is a next page
+	stmt := fmt.Sprintf(`SELECT * FROM pipeline_runs %s ORDER BY created_at %s, id %s LIMIT %s`, where, order, order, arg(f.Limit+1))
+	return stmt, args
+}
@@ -27,6 +27,16 @@ type (
 		services.ServiceCtx
 		CreateJob(jb *Job, qopts ...pg.QOpt) error
 		DeleteJob(jobID int32, qopts ...pg.QOpt) error
+		// ArchiveJob stops the services of a job like DeleteJob, but keeps its
+		// spec and runs
+		ArchiveJob(jobID int32, qopts ...pg.QOpt) error
+		// PauseJob stops the services of a job until it is resumed, the job
+		// stays in the active jobs
+		PauseJob(jobID int32, qopts ...pg.QOpt) error
+		ResumeJob(ctx context.Context, jobID int32, qopts ...pg.QOpt) error
+		// RestartJob reloads the spec of an active job from the DB, and
+		// restarts its services unless it is paused
+		RestartJob(ctx context.Context, jobID int32) error
 		ActiveJobs() map[int32]Job
 
 		// NOTE: Prefer to use CreateJob, this is only publicly exposed for use in tests
@@ -110,7 +120,7 @@ func (js *spawner) startAllServices(ctx context.Context) {
 		return
 	}
 
-	for _, spec := range specs {
+	for _, spec := range sortByDependencies(specs) {
 		if err = js.StartService(ctx, spec); err != nil {
 			js.lggr.Errorf("Couldn't start service %v: %v", spec.Name, err)
 		}
@@ -132,11 +142,16 @@ func (js *spawner) stopAllServices() {
 // stopService removes the job from memory and stop the services.
 // It will always delete the job from memory even if closing the services fail.
 func (js *spawner) stopService(jobID int32) {
-	js.lggr.Debugw("Stopping services for job", "jobID", jobID)
 	js.activeJobsMu.Lock()
 	defer js.activeJobsMu.Unlock()
 
-	aj := js.activeJobs[jobID]
+	js.closeServices(jobID, js.activeJobs[jobID])
+	delete(js.activeJobs, jobID)
+}
+
+// closeServices closes the services of a job, activeJobsMu must be held
+func (js *spawner) closeServices(jobID int32, aj activeJob) {
+	js.lggr.Debugw("Stopping services for job", "jobID", jobID)
 
 	for i := len(aj.services) - 1; i >= 0; i-- {
 		service := aj.services[i]
@@ -148,8 +163,6 @@ func (js *spawner) stopService(jobID int32) {
 		}
 	}
 	js.lggr.Debugw("Stopped all services for job", "jobID", jobID)
-
-	delete(js.activeJobs, jobID)
 }
 
 // StartService starts service for the given job spec.
@@ -157,6 +170,11 @@ func (js *spawner) StartService(ctx context.Context, jb Job) error {
 	js.activeJobsMu.Lock()
 	defer js.activeJobsMu.Unlock()
 
+	return js.startService(ctx, jb)
+}
+
+// startService starts the services of a job, activeJobsMu must be held
+func (js *spawner) startService(ctx context.Context, jb Job) error {
 	delegate, exists := js.jobTypeDelegates[jb.Type]
 	if !exists {
 		js.lggr.Errorw("Job type has not been registered with job.Spawner", "type", jb.Type, "jobID", jb.ID)
@@ -167,6 +185,11 @@ func (js *spawner) StartService(ctx context.Context, jb Job) error {
 	// OnJobDeleted before deleting. However, the activeJob will only have services
 	// that it was able to start without an error.
 	aj := activeJob{delegate: delegate, spec: jb}
+	if jb.Paused {
+		js.lggr.Debugw("JobSpawner: Job is paused, not starting its services", "jobID", jb.ID)
+		js.activeJobs[jb.ID] = aj
+		return nil
+	}
 
 	jb.PipelineSpec.JobName = jb.Name.ValueOrZero()
 	jb.PipelineSpec.JobID = jb.ID
@@ -178,6 +201,17 @@ func (js *spawner) StartService(ctx context.Context, jb Job) error {
 	if jb.ForwardingAllowed.Valid {
 		jb.PipelineSpec.ForwardingAllowed = jb.ForwardingAllowed.Bool
 	}
+	jb.PipelineSpec.MetricsLabels = jb.MetricsLabels
+	jb.PipelineSpec.RunDedup = jb.RunDedup
+
+	if err := js.orm.AssertDependenciesExist(jb.Dependencies); err != nil {
+		js.lggr.Errorw("Missing dependency for job, not starting its services", "jobID", jb.ID, "error", err)
+		cctx, cancel := utils.ContextFromChan(js.chStop)
+		defer cancel()
+		js.orm.TryRecordError(jb.ID, err.Error(), pg.WithParentCtx(cctx))
+		js.activeJobs[jb.ID] = aj
+		return nil
+	}
 
 	services, err := delegate.ServicesForSpec(jb)
 	if err != nil {
@@ -243,12 +277,22 @@ func (js *spawner) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 
 // Should not get called before Start()
 func (js *spawner) DeleteJob(jobID int32, qopts ...pg.QOpt) error {
+	return js.removeJob(jobID, "delete", js.orm.DeleteJob, qopts...)
+}
+
+func (js *spawner) ArchiveJob(jobID int32, qopts ...pg.QOpt) error {
+	return js.removeJob(jobID, "archive", js.orm.ArchiveJob, qopts...)
+}
+
+// removeJob stops the services of an active job, once removed from the DB by
+// remove
+func (js *spawner) removeJob(jobID int32, action string, remove func(int32, ...pg.QOpt) error, qopts ...pg.QOpt) error {
 	if jobID == 0 {
-		return errors.New("will not delete job with 0 ID")
+		return errors.Errorf("will not %s job with 0 ID", action)
 	}
 
-	lggr := js.lggr.With("jobID", jobID)
-	lggr.Debugw("Deleting job")
+	lggr := js.lggr.With("jobID", jobID, "action", action)
+	lggr.Debugw("Removing job")
 
 	var aj activeJob
 	var exists bool
@@ -279,9 +323,9 @@ func (js *spawner) DeleteJob(jobID int32, qopts ...pg.QOpt) error {
 		}
 		return ctx
 	}
-	err := js.orm.DeleteJob(jobID, append(qopts, pg.MergeCtx(setCtx))...)
+	err := remove(jobID, append(qopts, pg.MergeCtx(setCtx))...)
 	if err != nil {
-		js.lggr.Errorw("Error deleting job", "jobID", jobID, "error", err)
+		lggr.Errorw("Error removing job", "error", err)
 		return err
 	}
 
@@ -289,8 +333,79 @@ func (js *spawner) DeleteJob(jobID int32, qopts ...pg.QOpt) error {
 	// this will remove the job from memory, which will always happen even if closing the services fail.
 	js.stopService(jobID)
 
-	lggr.Infow("Stopped and deleted job")
+	lggr.Infow("Stopped and removed job")
+
+	return nil
+}
+
+func (js *spawner) PauseJob(jobID int32, qopts ...pg.QOpt) error {
+	js.activeJobsMu.Lock()
+	defer js.activeJobsMu.Unlock()
+
+	aj, exists := js.activeJobs[jobID]
+	if !exists {
+		return errors.Errorf("job not found (id: %v)", jobID)
+	}
+	if aj.spec.Paused {
+		return nil
+	}
+	if err := js.orm.SetJobPaused(jobID, true, qopts...); err != nil {
+		return err
+	}
+
+	js.closeServices(jobID, aj)
+	aj.services = nil
+	aj.spec.Paused = true
+	js.activeJobs[jobID] = aj
 
+	js.lggr.Infow("Paused job", "jobID", jobID)
+	return nil
+}
+
+func (js *spawner) ResumeJob(ctx context.Context, jobID int32, qopts ...pg.QOpt) error {
+	js.activeJobsMu.Lock()
+	defer js.activeJobsMu.Unlock()
+
+	aj, exists := js.activeJobs[jobID]
+	if !exists {
+		return errors.Errorf("job not found (id: %v)", jobID)
+	}
+	if !aj.spec.Paused {
+		return nil
+	}
+	if err := js.orm.SetJobPaused(jobID, false, qopts...); err != nil {
+		return err
+	}
+
+	aj.spec.Paused = false
+	if err := js.startService(ctx, aj.spec); err != nil {
+		return err
+	}
+
+	js.lggr.Infow("Resumed job", "jobID", jobID)
+	return nil
+}
+
+func (js *spawner) RestartJob(ctx context.Context, jobID int32) error {
+	js.activeJobsMu.Lock()
+	defer js.activeJobsMu.Unlock()
+
+	aj, exists := js.activeJobs[jobID]
+	if !exists {
+		return errors.Errorf("job not found (id: %v)", jobID)
+	}
+	jb, err := js.orm.FindJob(ctx, jobID)
+	if err != nil {
+		return err
+	}
+
+	js.closeServices(jobID, aj)
+	delete(js.activeJobs, jobID)
+	if err := js.startService(ctx, jb); err != nil {
+		return err
+	}
+
+	js.lggr.Infow("Restarted job", "jobID", jobID)
 	return nil
 }
 
@@ -305,6 +420,29 @@ func (js *spawner) ActiveJobs() map[int32]Job {
 	return m
 }
 
+// HealthReport implements services.HealthReporter, reporting the health of
+// the services of each active job that can be checked
+func (js *spawner) HealthReport() map[string]error {
+	js.activeJobsMu.RLock()
+	defer js.activeJobsMu.RUnlock()
+
+	report := make(map[string]error)
+	for jobID, aj := range js.activeJobs {
+		for i, service := range aj.services {
+			checkable, ok := service.(services.Checkable)
+			if !ok {
+				continue
+			}
+			name := fmt.Sprintf("%d.%s", jobID, reflect.TypeOf(service).String())
+			if _, exists := report[name]; exists {
+				name = fmt.Sprintf("%s#%d", name, i)
+			}
+			report[name] = checkable.Healthy()
+		}
+	}
+	return report
+}
+
 func (js *spawner) activeJobIDs() []int32 {
 	js.activeJobsMu.RLock()
 	defer js.activeJobsMu.RUnlock()
@@ -232,4 +232,88 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 			return exists
 		}, testutils.WaitTimeout(t), cltest.DBPollingInterval).Should(gomega.Equal(false))
 	})
+
+	t.Run("closes job services on 'PauseJob()' and restarts them on 'ResumeJob()'", func(t *testing.T) {
+		jobA := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+
+		serviceA1 := mocks.NewServiceCtx(t)
+		serviceA2 := mocks.NewServiceCtx(t)
+		serviceA1.On("Start", mock.Anything).Return(nil).Once()
+		serviceA2.On("Start", mock.Anything).Return(nil).Once()
+
+		lggr := logger.TestLogger(t)
+		orm := job.NewTestORM(t, db, cc, pipeline.NewORM(db, lggr, config), keyStore, config)
+		d := ocr.NewDelegate(nil, orm, nil, nil, nil, monitoringEndpoint, cc, logger.TestLogger(t), config)
+		delegateA := &delegate{jobA.Type, []job.ServiceCtx{serviceA1, serviceA2}, 0, nil, d}
+		delegates := map[job.Type]job.Delegate{jobA.Type: delegateA}
+		spawner := job.NewSpawner(orm, config, delegates, db, lggr, nil)
+		require.NoError(t, spawner.Start(testutils.Context(t)))
+
+		require.NoError(t, spawner.CreateJob(jobA))
+		delegateA.jobID = jobA.ID
+
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA2.On("Close").Return(nil).Once()
+		require.NoError(t, spawner.PauseJob(jobA.ID))
+		// pausing twice is a noop
+		require.NoError(t, spawner.PauseJob(jobA.ID))
+
+		// paused jobs stay active, so they can be resumed or deleted
+		require.Contains(t, spawner.ActiveJobs(), jobA.ID)
+		assert.True(t, spawner.ActiveJobs()[jobA.ID].Paused)
+		jb, err := orm.FindJob(testutils.Context(t), jobA.ID)
+		require.NoError(t, err)
+		assert.True(t, jb.Paused)
+
+		// paused jobs are not started with the node
+		require.NoError(t, spawner.Close())
+		spawner = job.NewSpawner(orm, config, delegates, db, lggr, nil)
+		require.NoError(t, spawner.Start(testutils.Context(t)))
+		require.Contains(t, spawner.ActiveJobs(), jobA.ID)
+
+		serviceA1.On("Start", mock.Anything).Return(nil).Once()
+		serviceA2.On("Start", mock.Anything).Return(nil).Once()
+		require.NoError(t, spawner.ResumeJob(testutils.Context(t), jobA.ID))
+		assert.False(t, spawner.ActiveJobs()[jobA.ID].Paused)
+		jb, err = orm.FindJob(testutils.Context(t), jobA.ID)
+		require.NoError(t, err)
+		assert.False(t, jb.Paused)
+
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA2.On("Close").Return(nil).Once()
+		require.NoError(t, spawner.DeleteJob(jobA.ID))
+		require.NoError(t, spawner.Close())
+	})
+
+	t.Run("restarts job services on 'RestartJob()'", func(t *testing.T) {
+		jobA := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+
+		serviceA1 := mocks.NewServiceCtx(t)
+		serviceA2 := mocks.NewServiceCtx(t)
+		serviceA1.On("Start", mock.Anything).Return(nil).Twice()
+		serviceA2.On("Start", mock.Anything).Return(nil).Twice()
+
+		lggr := logger.TestLogger(t)
+		orm := job.NewTestORM(t, db, cc, pipeline.NewORM(db, lggr, config), keyStore, config)
+		d := ocr.NewDelegate(nil, orm, nil, nil, nil, monitoringEndpoint, cc, logger.TestLogger(t), config)
+		delegateA := &delegate{jobA.Type, []job.ServiceCtx{serviceA1, serviceA2}, 0, nil, d}
+		delegates := map[job.Type]job.Delegate{jobA.Type: delegateA}
+		spawner := job.NewSpawner(orm, config, delegates, db, lggr, nil)
+		require.NoError(t, spawner.Start(testutils.Context(t)))
+
+		require.NoError(t, spawner.CreateJob(jobA))
+		delegateA.jobID = jobA.ID
+
+		require.Error(t, spawner.RestartJob(testutils.Context(t), jobA.ID+1))
+
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA2.On("Close").Return(nil).Once()
+		require.NoError(t, spawner.RestartJob(testutils.Context(t), jobA.ID))
+		require.Contains(t, spawner.ActiveJobs(), jobA.ID)
+
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA2.On("Close").Return(nil).Once()
+		require.NoError(t, spawner.DeleteJob(jobA.ID))
+		require.NoError(t, spawner.Close())
+	})
 }
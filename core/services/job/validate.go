@@ -1,16 +1,22 @@
 package job
 
 import (
+	"os"
+	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 )
 
 var (
 	ErrNoPipelineSpec       = errors.New("pipeline spec not specified")
 	ErrInvalidJobType       = errors.New("invalid job type")
 	ErrInvalidSchemaVersion = errors.New("invalid schema version")
+	specVariableRegexp      = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
 	jobTypes                = map[Type]struct{}{
 		Cron:               {},
 		DirectRequest:      {},
@@ -60,9 +66,70 @@ func ValidateSpec(ts string) (Type, error) {
 		return "", errors.Errorf("async=true tasks are not supported for %v", jb.Type)
 	}
 
+	if err := validateBridgeDefinitions(jb.Bridges); err != nil {
+		return "", err
+	}
+	if err := jb.Dependencies.validate(); err != nil {
+		return "", err
+	}
+	for name := range jb.MetricsLabels {
+		if err := pipeline.ValidateMetricsLabelName(name); err != nil {
+			return "", err
+		}
+	}
+	if jb.RunDedup != nil {
+		if !jb.Type.SupportsRunDedup() {
+			return "", errors.Errorf("runDedup is not supported for %v", jb.Type)
+		}
+		if err := jb.RunDedup.Validate(); err != nil {
+			return "", err
+		}
+	}
+	if jb.MonthlyBudget != nil && jb.MonthlyBudget.ToInt().Sign() <= 0 {
+		return "", errors.New("monthlyBudgetWei must be positive")
+	}
+	if jb.AlertErrorsThreshold.Valid && jb.AlertErrorsThreshold.Uint32 == 0 {
+		return "", errors.New("alertErrorsThreshold must be positive")
+	}
+	if jb.AlertErrorsWindow != nil && jb.AlertErrorsWindow.Duration() <= 0 {
+		return "", errors.New("alertErrorsWindow must be positive")
+	}
+
 	if strings.Contains(ts, "<{}>") {
 		return "", errors.Errorf("'<{}>' syntax is not supported. Please use \"{}\" instead")
 	}
 
 	return jb.Type, nil
 }
+
+// ExpandSpecVariables replaces the ${NAME} placeholders of the spec ts with the
+// values of the environment variables NAME, for the names in allowed. The
+// placeholders of other names are left unchanged.
+func ExpandSpecVariables(ts string, allowed []string) (string, error) {
+	allowedNames := make(map[string]struct{}, len(allowed))
+	for _, name := range allowed {
+		allowedNames[name] = struct{}{}
+	}
+	unset := make(map[string]struct{})
+	expanded := specVariableRegexp.ReplaceAllStringFunc(ts, func(placeholder string) string {
+		name := specVariableRegexp.FindStringSubmatch(placeholder)[1]
+		if _, ok := allowedNames[name]; !ok {
+			return placeholder
+		}
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			unset[name] = struct{}{}
+			return placeholder
+		}
+		return value
+	})
+	if len(unset) > 0 {
+		names := make([]string, 0, len(unset))
+		for name := range unset {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return "", errors.Errorf("spec variables are not set: %s", strings.Join(names, ", "))
+	}
+	return expanded, nil
+}
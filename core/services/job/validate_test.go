@@ -91,6 +91,182 @@ ds [type=bridge async=true]
 				require.Error(t, err)
 			},
 		},
+		{
+			name: "invalid metrics label",
+			spec: `
+type="vrf"
+schemaVersion=1
+observationSource="""
+ds [type=http]
+"""
+[metricsLabels]
+feed="ETH/USD"
+job_id="1"
+`,
+			assertion: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, `metrics label "job_id" is reserved`)
+			},
+		},
+		{
+			name: "run dedup",
+			spec: `
+type="cron"
+schemaVersion=1
+schedule="CRON_TZ=UTC * * * * * *"
+observationSource="""
+ds [type=http]
+"""
+[runDedup]
+tolerance=0.5
+`,
+			assertion: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "run dedup not supported",
+			spec: `
+type="webhook"
+schemaVersion=1
+observationSource="""
+ds [type=http]
+"""
+[runDedup]
+tolerance=0.5
+`,
+			assertion: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "runDedup is not supported for webhook")
+			},
+		},
+		{
+			name: "invalid run dedup tolerance",
+			spec: `
+type="cron"
+schemaVersion=1
+schedule="CRON_TZ=UTC * * * * * *"
+observationSource="""
+ds [type=http]
+"""
+[runDedup]
+tolerance=-1.0
+`,
+			assertion: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "invalid run dedup tolerance -1")
+			},
+		},
+		{
+			name: "monthly budget",
+			spec: `
+type="cron"
+schemaVersion=1
+schedule="CRON_TZ=UTC * * * * * *"
+monthlyBudgetWei="1000000000000000000"
+observationSource="""
+ds [type=http]
+"""
+`,
+			assertion: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "invalid monthly budget",
+			spec: `
+type="cron"
+schemaVersion=1
+schedule="CRON_TZ=UTC * * * * * *"
+monthlyBudgetWei="0"
+observationSource="""
+ds [type=http]
+"""
+`,
+			assertion: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "monthlyBudgetWei must be positive")
+			},
+		},
+		{
+			name: "alert errors thresholds",
+			spec: `
+type="cron"
+schemaVersion=1
+schedule="CRON_TZ=UTC * * * * * *"
+alertErrorsThreshold=3
+alertErrorsWindow="5m"
+observationSource="""
+ds [type=http]
+"""
+`,
+			assertion: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "invalid alert errors threshold",
+			spec: `
+type="cron"
+schemaVersion=1
+schedule="CRON_TZ=UTC * * * * * *"
+alertErrorsThreshold=0
+observationSource="""
+ds [type=http]
+"""
+`,
+			assertion: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "alertErrorsThreshold must be positive")
+			},
+		},
+		{
+			name: "duplicate bridge definitions",
+			spec: `
+type="vrf"
+schemaVersion=1
+observationSource="""
+ds [type=bridge name=foo]
+"""
+[[bridges]]
+name="foo"
+url="https://adapter.example.com"
+[[bridges]]
+name="FOO"
+url="https://adapter.example.com"
+`,
+			assertion: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, `bridge "foo" is declared more than once`)
+			},
+		},
+		{
+			name: "invalid bridge url",
+			spec: `
+type="vrf"
+schemaVersion=1
+observationSource="""
+ds [type=bridge name=foo]
+"""
+[[bridges]]
+name="foo"
+url="not a url"
+`,
+			assertion: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, `invalid url for bridge "foo"`)
+			},
+		},
+		{
+			name: "bridge token env not set",
+			spec: `
+type="vrf"
+schemaVersion=1
+observationSource="""
+ds [type=bridge name=foo]
+"""
+[[bridges]]
+name="foo"
+url="https://adapter.example.com"
+outgoingTokenEnv="CL_TEST_UNSET_BRIDGE_TOKEN"
+`,
+			assertion: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "CL_TEST_UNSET_BRIDGE_TOKEN")
+			},
+		},
 		{
 			name: "happy path",
 			spec: `
@@ -113,3 +289,34 @@ ds [type=http]
 		})
 	}
 }
+
+func TestExpandSpecVariables(t *testing.T) {
+	t.Setenv("CL_TEST_FEED_CONTRACT", "0x613a38AC1659769640aaE063C651F48E0250454C")
+	t.Setenv("CL_TEST_CHAIN_ID", "4")
+
+	spec := `
+type="fluxmonitor"
+contractAddress="${CL_TEST_FEED_CONTRACT}"
+evmChainID="${CL_TEST_CHAIN_ID}"
+observationSource="""
+ds [type=bridge name="${CL_TEST_BRIDGE}"];
+"""
+`
+	expanded, err := ExpandSpecVariables(spec, []string{"CL_TEST_FEED_CONTRACT", "CL_TEST_CHAIN_ID"})
+	require.NoError(t, err)
+	require.Equal(t, `
+type="fluxmonitor"
+contractAddress="0x613a38AC1659769640aaE063C651F48E0250454C"
+evmChainID="4"
+observationSource="""
+ds [type=bridge name="${CL_TEST_BRIDGE}"];
+"""
+`, expanded)
+
+	_, err = ExpandSpecVariables(spec, []string{"CL_TEST_FEED_CONTRACT", "CL_TEST_BRIDGE"})
+	require.EqualError(t, err, "spec variables are not set: CL_TEST_BRIDGE")
+
+	expanded, err = ExpandSpecVariables(spec, nil)
+	require.NoError(t, err)
+	require.Equal(t, spec, expanded)
+}
@@ -0,0 +1,104 @@
+package jobtrigger
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	amqp "github.com/rabbitmq/amqp091-go"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// amqpConsumer consumes the queues of a RabbitMQ broker, acknowledging the
+// messages manually.
+type amqpConsumer struct {
+	url      *url.URL
+	prefetch int
+	lggr     logger.Logger
+}
+
+func newAMQPConsumer(brokerURL *url.URL, prefetch int, lggr logger.Logger) *amqpConsumer {
+	return &amqpConsumer{
+		url:      brokerURL,
+		prefetch: prefetch,
+		lggr:     lggr.Named("AMQPConsumer").With("url", brokerURL.Redacted()),
+	}
+}
+
+func (c *amqpConsumer) Consume(ctx context.Context, topics []string, ch chan<- Message) error {
+	b := utils.NewRedialBackoff()
+	for {
+		err := c.consume(ctx, topics, ch)
+		if ctx.Err() != nil {
+			return nil
+		}
+		wait := b.Duration()
+		c.lggr.Errorw("Lost the connection to the broker, reconnecting", "err", err, "wait", wait)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(wait):
+		}
+	}
+}
+
+// consume sends the messages to ch until the connection is lost or ctx is done.
+func (c *amqpConsumer) consume(ctx context.Context, topics []string, ch chan<- Message) error {
+	conn, err := amqp.Dial(c.url.String())
+	if err != nil {
+		return errors.Wrap(err, "failed to dial the broker")
+	}
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	// The deliveries are closed along with the connection
+	defer conn.Close()
+
+	channel, err := conn.Channel()
+	if err != nil {
+		return errors.Wrap(err, "failed to open a channel")
+	}
+	if err = channel.Qos(c.prefetch, 0, false); err != nil {
+		return errors.Wrap(err, "failed to set the prefetch count")
+	}
+	closed := conn.NotifyClose(make(chan *amqp.Error, 1))
+	for _, topic := range topics {
+		deliveries, err2 := channel.Consume(topic, "", false, false, false, false, nil)
+		if err2 != nil {
+			return errors.Wrapf(err2, "failed to consume queue %s", topic)
+		}
+		wg.Add(1)
+		go func(topic string) {
+			defer wg.Done()
+			for d := range deliveries {
+				d := d
+				msg := Message{
+					Topic: topic,
+					ID:    d.MessageId,
+					Body:  d.Body,
+					Ack:   func() error { return d.Ack(false) },
+					Nack:  func(requeue bool) error { return d.Nack(false, requeue) },
+				}
+				select {
+				case ch <- msg:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(topic)
+	}
+	c.lggr.Infow("Consuming job trigger messages", "topics", topics)
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-closed:
+		if err == nil {
+			return errors.New("connection closed")
+		}
+		return err
+	}
+}
@@ -0,0 +1,46 @@
+package jobtrigger
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// The schemes of the broker URLs
+const (
+	SchemeAMQP  = "amqp"
+	SchemeAMQPS = "amqps"
+)
+
+// Message is a job trigger message consumed from a topic of a broker
+type Message struct {
+	Topic string
+	// ID is set by the producer of the message, to deduplicate its deliveries
+	ID   string
+	Body []byte
+	Ack  func() error
+	// Nack returns the message to the broker, which delivers it again if
+	// requeue is true
+	Nack func(requeue bool) error
+}
+
+// Consumer consumes the messages of a broker
+type Consumer interface {
+	// Consume sends the messages of the topics to ch until ctx is done,
+	// reconnecting to the broker if the connection is lost
+	Consume(ctx context.Context, topics []string, ch chan<- Message) error
+}
+
+// NewConsumer returns a Consumer of the broker at brokerURL. The scheme of the
+// URL selects the broker: amqp or amqps for RabbitMQ, whose topics are queues.
+func NewConsumer(brokerURL *url.URL, prefetch int, lggr logger.Logger) (Consumer, error) {
+	switch brokerURL.Scheme {
+	case SchemeAMQP, SchemeAMQPS:
+		return newAMQPConsumer(brokerURL, prefetch, lggr), nil
+	default:
+		return nil, errors.Errorf("invalid broker URL %s: scheme must be %s or %s", brokerURL.Redacted(), SchemeAMQP, SchemeAMQPS)
+	}
+}
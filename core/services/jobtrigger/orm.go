@@ -0,0 +1,41 @@
+package jobtrigger
+
+import (
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// ORM records the messages which started a run, to deduplicate their
+// deliveries. The records are deleted along with their runs by the reaper.
+type ORM interface {
+	// IsProcessed returns true if the message of the topic started a run
+	IsProcessed(topic, messageID string, qopts ...pg.QOpt) (bool, error)
+	// MarkProcessed records that the message of the topic started the run
+	MarkProcessed(topic, messageID string, runID int64, qopts ...pg.QOpt) error
+}
+
+type orm struct {
+	q pg.Q
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig) ORM {
+	return &orm{pg.NewQ(db, lggr.Named("JobTriggerORM"), cfg)}
+}
+
+func (o *orm) IsProcessed(topic, messageID string, qopts ...pg.QOpt) (processed bool, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Get(&processed, `SELECT EXISTS (SELECT 1 FROM job_trigger_messages WHERE topic = $1 AND message_id = $2)`, topic, messageID)
+	return processed, errors.Wrap(err, "IsProcessed failed")
+}
+
+func (o *orm) MarkProcessed(topic, messageID string, runID int64, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	_, err := q.Exec(`INSERT INTO job_trigger_messages (topic, message_id, pipeline_run_id, created_at) VALUES ($1, $2, $3, NOW())
+ON CONFLICT (topic, message_id) DO NOTHING`, topic, messageID, runID)
+	return errors.Wrap(err, "MarkProcessed failed")
+}
@@ -0,0 +1,44 @@
+package jobtrigger_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/jobtrigger"
+)
+
+func TestORM_MarkProcessed(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	orm := jobtrigger.NewORM(db, logger.TestLogger(t), cfg)
+	run := cltest.MustInsertPipelineRun(t, db)
+
+	processed, err := orm.IsProcessed("requests", "1")
+	require.NoError(t, err)
+	assert.False(t, processed)
+
+	require.NoError(t, orm.MarkProcessed("requests", "1", run.ID))
+	// Marking a message again is a no-op
+	require.NoError(t, orm.MarkProcessed("requests", "1", run.ID))
+
+	processed, err = orm.IsProcessed("requests", "1")
+	require.NoError(t, err)
+	assert.True(t, processed)
+	processed, err = orm.IsProcessed("other", "1")
+	require.NoError(t, err)
+	assert.False(t, processed)
+
+	// The messages are deleted along with their runs
+	_, err = db.Exec(`DELETE FROM pipeline_runs WHERE id = $1`, run.ID)
+	require.NoError(t, err)
+	processed, err = orm.IsProcessed("requests", "1")
+	require.NoError(t, err)
+	assert.False(t, processed)
+}
@@ -0,0 +1,190 @@
+// Package jobtrigger starts the runs of webhook jobs from the messages of a
+// message broker, for the deployments whose eventing is queue based.
+package jobtrigger
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// Workers is the number of messages processed concurrently, and the number
+// of unacknowledged messages the broker delivers.
+const Workers = 10
+
+// Trigger runs the webhook job of the topic of each message consumed, with
+// the message as the request body. The messages are acknowledged once their
+// run is created, and the deliveries of the messages which already started a
+// run are skipped, so that each message starts one run.
+type Trigger struct {
+	utils.StartStopOnce
+	consumer Consumer
+	topics   map[string]uuid.UUID
+	runner   webhook.JobRunner
+	orm      ORM
+	lggr     logger.Logger
+
+	chStop chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewTrigger returns a Trigger of the topics, each in the form
+// topic=externalJobID.
+func NewTrigger(topics []string, consumer Consumer, runner webhook.JobRunner, orm ORM, lggr logger.Logger) (*Trigger, error) {
+	jobIDs, err := ParseTopics(topics)
+	if err != nil {
+		return nil, err
+	}
+	return &Trigger{
+		consumer: consumer,
+		topics:   jobIDs,
+		runner:   runner,
+		orm:      orm,
+		lggr:     lggr.Named("JobTrigger"),
+		chStop:   make(chan struct{}),
+	}, nil
+}
+
+// ParseTopics returns the external job IDs of the topics in the form
+// topic=externalJobID.
+func ParseTopics(topics []string) (map[string]uuid.UUID, error) {
+	if len(topics) == 0 {
+		return nil, errors.New("no topics to consume")
+	}
+	jobIDs := make(map[string]uuid.UUID, len(topics))
+	for _, t := range topics {
+		topic, id, ok := strings.Cut(t, "=")
+		if !ok || topic == "" {
+			return nil, errors.Errorf("invalid topic %q: must be topic=externalJobID", t)
+		}
+		jobID, err := uuid.FromString(id)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid topic %q: invalid external job ID", t)
+		}
+		if _, exists := jobIDs[topic]; exists {
+			return nil, errors.Errorf("invalid topic %q: duplicate topic", t)
+		}
+		jobIDs[topic] = jobID
+	}
+	return jobIDs, nil
+}
+
+// Start consumes the topics. It must be started after the job spawner, for
+// the messages not to be rejected before the jobs are running.
+func (t *Trigger) Start(context.Context) error {
+	return t.StartOnce("JobTrigger", func() error {
+		topics := make([]string, 0, len(t.topics))
+		for topic := range t.topics {
+			topics = append(topics, topic)
+		}
+		sort.Strings(topics)
+
+		ch := make(chan Message)
+		t.wg.Add(1)
+		go func() {
+			defer t.wg.Done()
+			ctx, cancel := utils.ContextFromChan(t.chStop)
+			defer cancel()
+			if err := t.consumer.Consume(ctx, topics, ch); err != nil {
+				t.lggr.Errorw("Failed to consume job trigger messages", "err", err)
+			}
+		}()
+		for i := 0; i < Workers; i++ {
+			t.wg.Add(1)
+			go t.work(ch)
+		}
+		return nil
+	})
+}
+
+func (t *Trigger) Close() error {
+	return t.StopOnce("JobTrigger", func() error {
+		close(t.chStop)
+		t.wg.Wait()
+		return nil
+	})
+}
+
+func (t *Trigger) work(ch <-chan Message) {
+	defer t.wg.Done()
+	ctx, cancel := utils.ContextFromChan(t.chStop)
+	defer cancel()
+	for {
+		select {
+		case <-t.chStop:
+			return
+		case msg := <-ch:
+			t.handle(ctx, msg)
+		}
+	}
+}
+
+func (t *Trigger) handle(ctx context.Context, msg Message) {
+	lggr := t.lggr.With("topic", msg.Topic, "messageID", msg.ID)
+	jobID, ok := t.topics[msg.Topic]
+	if !ok {
+		lggr.Errorw("Rejecting message of an unknown topic")
+		t.nack(lggr, msg, false)
+		return
+	}
+	lggr = lggr.With("externalJobID", jobID)
+
+	if msg.ID == "" {
+		lggr.Warn("Message has no ID, its deliveries cannot be deduplicated")
+	} else {
+		processed, err := t.orm.IsProcessed(msg.Topic, msg.ID, pg.WithParentCtx(ctx))
+		if err != nil {
+			lggr.Errorw("Failed to check if the message was processed, requeueing it", "err", err)
+			t.nack(lggr, msg, true)
+			return
+		}
+		if processed {
+			lggr.Debug("Skipping message which already started a run")
+			t.ack(lggr, msg)
+			return
+		}
+	}
+
+	meta := pipeline.JSONSerializable{Val: map[string]interface{}{"topic": msg.Topic, "messageID": msg.ID}, Valid: true}
+	runID, err := t.runner.RunJob(ctx, jobID, string(msg.Body), meta)
+	if errors.Is(err, webhook.ErrJobNotExists) {
+		lggr.Errorw("Rejecting message of a topic whose webhook job is not running")
+		t.nack(lggr, msg, false)
+		return
+	} else if err != nil {
+		lggr.Errorw("Failed to run the job, requeueing the message", "err", err)
+		t.nack(lggr, msg, true)
+		return
+	}
+	lggr.Debugw("Message started a run", "runID", runID)
+
+	if msg.ID != "" {
+		if err = t.orm.MarkProcessed(msg.Topic, msg.ID, runID, pg.WithParentCtx(ctx)); err != nil {
+			// The message could start another run if it is delivered again
+			lggr.Errorw("Failed to record the run of the message", "runID", runID, "err", err)
+		}
+	}
+	t.ack(lggr, msg)
+}
+
+func (t *Trigger) ack(lggr logger.Logger, msg Message) {
+	if err := msg.Ack(); err != nil {
+		lggr.Errorw("Failed to acknowledge message", "err", err)
+	}
+}
+
+func (t *Trigger) nack(lggr logger.Logger, msg Message, requeue bool) {
+	if err := msg.Nack(requeue); err != nil {
+		lggr.Errorw("Failed to reject message", "requeue", requeue, "err", err)
+	}
+}
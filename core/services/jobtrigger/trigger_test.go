@@ -0,0 +1,163 @@
+package jobtrigger_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/jobtrigger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/webhook"
+)
+
+// sequentialConsumer sends each message once the previous one is
+// acknowledged or rejected, and records the outcomes.
+type sequentialConsumer struct {
+	msgs     []jobtrigger.Message
+	topics   []string
+	outcomes []string
+	done     chan struct{}
+}
+
+func (c *sequentialConsumer) Consume(ctx context.Context, topics []string, ch chan<- jobtrigger.Message) error {
+	c.topics = topics
+	outcome := make(chan string, 1)
+	for _, msg := range c.msgs {
+		msg := msg
+		msg.Ack = func() error {
+			outcome <- "ack " + msg.ID
+			return nil
+		}
+		msg.Nack = func(requeue bool) error {
+			outcome <- fmt.Sprintf("nack %s requeue=%t", msg.ID, requeue)
+			return nil
+		}
+		select {
+		case ch <- msg:
+		case <-ctx.Done():
+			return nil
+		}
+		c.outcomes = append(c.outcomes, <-outcome)
+	}
+	close(c.done)
+	<-ctx.Done()
+	return nil
+}
+
+type fakeRunner struct {
+	jobID uuid.UUID
+	runs  []string
+}
+
+func (r *fakeRunner) RunJob(_ context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error) {
+	if jobUUID != r.jobID {
+		return 0, webhook.ErrJobNotExists
+	}
+	if requestBody == "fail" {
+		return 0, errors.New("kaboom")
+	}
+	r.runs = append(r.runs, fmt.Sprintf("%s %v", requestBody, meta.Val))
+	return int64(len(r.runs)), nil
+}
+
+type fakeORM struct {
+	mu        sync.Mutex
+	processed map[string]int64
+}
+
+func (o *fakeORM) IsProcessed(topic, messageID string, _ ...pg.QOpt) (bool, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	_, ok := o.processed[topic+"/"+messageID]
+	return ok, nil
+}
+
+func (o *fakeORM) MarkProcessed(topic, messageID string, runID int64, _ ...pg.QOpt) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.processed[topic+"/"+messageID] = runID
+	return nil
+}
+
+func TestTrigger(t *testing.T) {
+	t.Parallel()
+
+	jobID := uuid.NewV4()
+	deletedJobID := uuid.NewV4()
+	consumer := &sequentialConsumer{
+		msgs: []jobtrigger.Message{
+			{Topic: "requests", ID: "1", Body: []byte("first")},
+			{Topic: "requests", ID: "1", Body: []byte("first")},
+			{Topic: "requests", ID: "2", Body: []byte("fail")},
+			{Topic: "deleted", ID: "3", Body: []byte("orphan")},
+			{Topic: "requests", Body: []byte("anonymous")},
+		},
+		done: make(chan struct{}),
+	}
+	runner := &fakeRunner{jobID: jobID}
+	orm := &fakeORM{processed: make(map[string]int64)}
+
+	trigger, err := jobtrigger.NewTrigger([]string{"requests=" + jobID.String(), "deleted=" + deletedJobID.String()}, consumer, runner, orm, logger.TestLogger(t))
+	require.NoError(t, err)
+	require.NoError(t, trigger.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, trigger.Close()) })
+
+	select {
+	case <-consumer.done:
+	case <-testutils.Context(t).Done():
+		t.Fatal("timed out waiting for the messages to be processed")
+	}
+
+	assert.Equal(t, []string{"deleted", "requests"}, consumer.topics)
+	assert.Equal(t, []string{
+		"ack 1",
+		"ack 1",
+		"nack 2 requeue=true",
+		"nack 3 requeue=false",
+		"ack ",
+	}, consumer.outcomes)
+	assert.Equal(t, []string{
+		"first map[messageID:1 topic:requests]",
+		"anonymous map[messageID: topic:requests]",
+	}, runner.runs)
+	assert.Equal(t, map[string]int64{"requests/1": 1}, orm.processed)
+}
+
+func TestParseTopics(t *testing.T) {
+	t.Parallel()
+
+	jobID := uuid.NewV4()
+	for _, tt := range []struct {
+		name   string
+		topics []string
+		exp    map[string]uuid.UUID
+		expErr string
+	}{
+		{"valid", []string{"requests=" + jobID.String()}, map[string]uuid.UUID{"requests": jobID}, ""},
+		{"no topics", nil, nil, "no topics to consume"},
+		{"missing job ID", []string{"requests"}, nil, `invalid topic "requests": must be topic=externalJobID`},
+		{"missing topic", []string{"=" + jobID.String()}, nil, fmt.Sprintf(`invalid topic "=%s": must be topic=externalJobID`, jobID)},
+		{"invalid job ID", []string{"requests=42"}, nil, `invalid topic "requests=42": invalid external job ID: uuid: incorrect UUID length: 42`},
+		{"duplicate topic", []string{"requests=" + jobID.String(), "requests=" + jobID.String()}, nil, fmt.Sprintf(`invalid topic "requests=%s": duplicate topic`, jobID)},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			jobIDs, err := jobtrigger.ParseTopics(tt.topics)
+			if tt.expErr != "" {
+				assert.EqualError(t, err, tt.expErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.exp, jobIDs)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package keyrotation
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// ETHState is the step of an ETH key rotation
+type ETHState string
+
+const (
+	// ETHStateMigratingJobs rotations created the new key, and switch the
+	// jobs from the old key to it
+	ETHStateMigratingJobs ETHState = "migrating_jobs"
+	// ETHStateWaitingForPipelines rotations wait for the jobs referring to the
+	// old key in their pipeline to be updated or deleted, unless they are
+	// forced
+	ETHStateWaitingForPipelines ETHState = "waiting_for_pipelines"
+	// ETHStateWaitingForTransactions rotations wait for the transactions of
+	// the old key to be finalized
+	ETHStateWaitingForTransactions ETHState = "waiting_for_transactions"
+	// ETHStateSweeping rotations wait for the transfer of the remaining ETH
+	// of the old key to the new key to be finalized
+	ETHStateSweeping ETHState = "sweeping"
+	// ETHStateCompleted rotations disabled the old key
+	ETHStateCompleted ETHState = "completed"
+	// ETHStateErrored rotations stopped on an error which cannot be retried,
+	// like a failed sweep transaction
+	ETHStateErrored ETHState = "errored"
+)
+
+// ErrETHKeyAlreadyRotating is returned when rotating a key which has a pending
+// rotation on the chain already
+var ErrETHKeyAlreadyRotating = errors.New("key has a pending rotation already")
+
+// ErrETHRotationNotPending is returned when forcing a rotation which is
+// completed or errored
+var ErrETHRotationNotPending = errors.New("rotation is not pending")
+
+// ETHKeyRotation replaces an ETH key on a chain with a new key, in steps
+// advanced by the ETHRotator: the jobs are switched to the new key, the jobs
+// referring to the old key in their pipeline are updated, then the
+// transactions of the old key are finalized, its remaining ETH is swept to the
+// new key, and it is disabled.
+type ETHKeyRotation struct {
+	ID         int64
+	EVMChainID utils.Big
+	OldAddress common.Address
+	NewAddress common.Address
+	State      ETHState
+	// JobIDs are the jobs switched to the new key
+	JobIDs pq.Int32Array
+	// PipelineJobIDs are the jobs whose pipeline refers to the old key, like
+	// the from of an ethtx task. They are not switched, and the rotation waits
+	// for their pipeline to be updated, unless it is forced.
+	PipelineJobIDs pq.Int32Array
+	// Forced rotations do not wait for the PipelineJobIDs, whose transactions
+	// fail once the old key is disabled
+	Forced bool
+	// SweepEthTxID is the transfer of the remaining ETH, if any was left
+	SweepEthTxID null.Int
+	// Error is the last error of the rotation, retried unless it is errored
+	Error       null.String
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	CompletedAt null.Time
+}
+
+// Pending returns true if the rotation is neither completed nor errored
+func (r ETHKeyRotation) Pending() bool {
+	return r.State != ETHStateCompleted && r.State != ETHStateErrored
+}
@@ -0,0 +1,187 @@
+package keyrotation
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// CreateETHRotation inserts r, setting its ID and timestamps
+func (o *orm) CreateETHRotation(r *ETHKeyRotation, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	sql := `INSERT INTO eth_key_rotations (evm_chain_id, old_address, new_address, state, job_ids, created_at, updated_at)
+VALUES (:evm_chain_id, :old_address, :new_address, :state, '{}', NOW(), NOW())
+RETURNING *`
+	return errors.Wrap(q.GetNamed(sql, r, r), "CreateETHRotation failed")
+}
+
+func (o *orm) FindETHRotation(id int64, qopts ...pg.QOpt) (r ETHKeyRotation, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Get(&r, `SELECT * FROM eth_key_rotations WHERE id = $1`, id)
+	return r, errors.Wrap(err, "FindETHRotation failed")
+}
+
+func (o *orm) ETHRotations(qopts ...pg.QOpt) (rs []ETHKeyRotation, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Select(&rs, `SELECT * FROM eth_key_rotations ORDER BY id DESC`)
+	return rs, errors.Wrap(err, "ETHRotations failed")
+}
+
+func (o *orm) PendingETHRotations(qopts ...pg.QOpt) (rs []ETHKeyRotation, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Select(&rs, `SELECT * FROM eth_key_rotations WHERE state NOT IN ($1, $2) ORDER BY id`, ETHStateCompleted, ETHStateErrored)
+	return rs, errors.Wrap(err, "PendingETHRotations failed")
+}
+
+// MigrateETHRotationJobs updates the transmitter and from addresses of the
+// OCR, OCR2, keeper, VRF and blockhash store specs. The specs without a chain
+// run on the default chain, so they are switched only when it is the chain of
+// the rotation. The jobs whose pipeline refers to the old key, like the from
+// of an ethtx task, are recorded without being switched, and the rotation
+// waits for them unless it is forced.
+func (o *orm) MigrateETHRotationJobs(id int64, defaultChain bool, qopts ...pg.QOpt) (r ETHKeyRotation, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Transaction(func(tx pg.Queryer) error {
+		if err = tx.Get(&r, `SELECT * FROM eth_key_rotations WHERE id = $1 FOR UPDATE`, id); err != nil {
+			return errors.Wrap(err, "failed to load rotation")
+		}
+		if r.State != ETHStateMigratingJobs {
+			return errors.Errorf("rotation is %s, not %s", r.State, ETHStateMigratingJobs)
+		}
+
+		var jobIDs []int32
+		err = tx.Select(&jobIDs, `WITH
+ocr AS (
+	UPDATE ocr_oracle_specs SET transmitter_address = $2, updated_at = NOW()
+	WHERE transmitter_address = $1 AND (evm_chain_id = $3 OR (evm_chain_id IS NULL AND $7)) RETURNING id
+), ocr2 AS (
+	UPDATE ocr2_oracle_specs SET transmitter_id = $5, updated_at = NOW()
+	WHERE lower(transmitter_id) = lower($4) AND relay = 'evm' AND relay_config->>'chainID' = $6 RETURNING id
+), keeper AS (
+	UPDATE keeper_specs SET from_address = $2, updated_at = NOW()
+	WHERE from_address = $1 AND (evm_chain_id = $3 OR (evm_chain_id IS NULL AND $7)) RETURNING id
+), vrf AS (
+	UPDATE vrf_specs SET from_addresses = array_replace(from_addresses, $1::bytea, $2::bytea), updated_at = NOW()
+	WHERE $1 = ANY(from_addresses) AND (evm_chain_id = $3 OR (evm_chain_id IS NULL AND $7)) RETURNING id
+), bhs AS (
+	UPDATE blockhash_store_specs SET from_address = $2, updated_at = NOW()
+	WHERE from_address = $1 AND (evm_chain_id = $3 OR (evm_chain_id IS NULL AND $7)) RETURNING id
+)
+SELECT id FROM jobs WHERE
+	ocr_oracle_spec_id IN (SELECT id FROM ocr)
+	OR ocr2_oracle_spec_id IN (SELECT id FROM ocr2)
+	OR keeper_spec_id IN (SELECT id FROM keeper)
+	OR vrf_spec_id IN (SELECT id FROM vrf)
+	OR blockhash_store_spec_id IN (SELECT id FROM bhs)
+ORDER BY id`, r.OldAddress, r.NewAddress, r.EVMChainID, r.OldAddress.Hex(), r.NewAddress.Hex(), r.EVMChainID.String(), defaultChain)
+		if err != nil {
+			return errors.Wrap(err, "failed to switch the specs to the new key")
+		}
+
+		var pipelineJobIDs []int32
+		if pipelineJobIDs, err = findPipelineJobIDs(tx, r.OldAddress); err != nil {
+			return err
+		}
+
+		err = tx.Get(&r, `UPDATE eth_key_rotations SET state = $2, job_ids = $3, pipeline_job_ids = $4, error = NULL, updated_at = NOW() WHERE id = $1 RETURNING *`,
+			id, nextPipelineState(r, pipelineJobIDs), pq.Int32Array(jobIDs), pq.Int32Array(pipelineJobIDs))
+		return errors.Wrap(err, "failed to update rotation")
+	})
+	return r, err
+}
+
+// UpdateETHRotationPipelineJobs finds the jobs whose pipeline refers to the
+// old key again. The rotation waits for transactions once there are none left,
+// or it is forced.
+func (o *orm) UpdateETHRotationPipelineJobs(id int64, qopts ...pg.QOpt) (r ETHKeyRotation, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Transaction(func(tx pg.Queryer) error {
+		if err = tx.Get(&r, `SELECT * FROM eth_key_rotations WHERE id = $1 FOR UPDATE`, id); err != nil {
+			return errors.Wrap(err, "failed to load rotation")
+		}
+		if r.State != ETHStateWaitingForPipelines {
+			return errors.Errorf("rotation is %s, not %s", r.State, ETHStateWaitingForPipelines)
+		}
+
+		var pipelineJobIDs []int32
+		if pipelineJobIDs, err = findPipelineJobIDs(tx, r.OldAddress); err != nil {
+			return err
+		}
+
+		err = tx.Get(&r, `UPDATE eth_key_rotations SET state = $2, pipeline_job_ids = $3, error = NULL, updated_at = NOW() WHERE id = $1 RETURNING *`,
+			id, nextPipelineState(r, pipelineJobIDs), pq.Int32Array(pipelineJobIDs))
+		return errors.Wrap(err, "failed to update rotation")
+	})
+	return r, err
+}
+
+// ForceETHRotation stops the pending rotation from waiting for the jobs
+// referring to the old key in their pipeline
+func (o *orm) ForceETHRotation(id int64, qopts ...pg.QOpt) (r ETHKeyRotation, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Transaction(func(tx pg.Queryer) error {
+		if err = tx.Get(&r, `SELECT * FROM eth_key_rotations WHERE id = $1 FOR UPDATE`, id); err != nil {
+			return err
+		}
+		if !r.Pending() {
+			return errors.Wrapf(ErrETHRotationNotPending, "rotation is %s", r.State)
+		}
+		return tx.Get(&r, `UPDATE eth_key_rotations SET forced = true, updated_at = NOW() WHERE id = $1 RETURNING *`, id)
+	})
+	return r, errors.Wrap(err, "ForceETHRotation failed")
+}
+
+// findPipelineJobIDs returns the jobs whose pipeline refers to the address
+func findPipelineJobIDs(q pg.Queryer, address common.Address) (ids []int32, err error) {
+	err = q.Select(&ids, `SELECT jobs.id FROM jobs
+JOIN pipeline_specs ON pipeline_specs.id = jobs.pipeline_spec_id
+WHERE pipeline_specs.dot_dag_source ILIKE '%' || $1 || '%'
+ORDER BY jobs.id`, address.Hex())
+	return ids, errors.Wrap(err, "failed to find the pipelines referring to the old key")
+}
+
+// nextPipelineState returns the state of the rotation given the jobs referring
+// to its old key in their pipeline
+func nextPipelineState(r ETHKeyRotation, pipelineJobIDs []int32) ETHState {
+	if len(pipelineJobIDs) > 0 && !r.Forced {
+		return ETHStateWaitingForPipelines
+	}
+	return ETHStateWaitingForTransactions
+}
+
+// UpdateETHRotation saves the state, sweep transaction, error and completion
+// time of r
+func (o *orm) UpdateETHRotation(r *ETHKeyRotation, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	sql := `UPDATE eth_key_rotations SET state = :state, sweep_eth_tx_id = :sweep_eth_tx_id, error = :error, completed_at = :completed_at, updated_at = NOW()
+WHERE id = :id
+RETURNING *`
+	return errors.Wrap(q.GetNamed(sql, r, r), "UpdateETHRotation failed")
+}
+
+// CountUnfinalizedETHTransactions counts the transactions from the address
+// which are not finished yet, or confirmed in a block after finalizedBlock
+func (o *orm) CountUnfinalizedETHTransactions(chainID utils.Big, address common.Address, finalizedBlock int64, qopts ...pg.QOpt) (count int, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Get(&count, `SELECT count(*) FROM eth_txes WHERE from_address = $1 AND evm_chain_id = $2 AND (
+	state IN ('unstarted', 'in_progress', 'unconfirmed', 'confirmed_missing_receipt')
+	OR (state = 'confirmed' AND EXISTS (
+		SELECT 1 FROM eth_tx_attempts
+		JOIN eth_receipts ON eth_receipts.tx_hash = eth_tx_attempts.hash
+		WHERE eth_tx_attempts.eth_tx_id = eth_txes.id AND eth_receipts.block_number > $3
+	))
+)`, address, chainID, finalizedBlock)
+	return count, errors.Wrap(err, "CountUnfinalizedETHTransactions failed")
+}
+
+// EthTxState returns the state of the transaction, or sql.ErrNoRows if it was
+// deleted
+func (o *orm) EthTxState(id int64, qopts ...pg.QOpt) (state txmgr.EthTxState, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Get(&state, `SELECT state FROM eth_txes WHERE id = $1`, id)
+	return state, errors.Wrap(err, "EthTxState failed")
+}
@@ -0,0 +1,185 @@
+package keyrotation_test
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keyrotation"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestORM_MigrateETHRotationJobs(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	orm := keyrotation.NewORM(db, logger.TestLogger(t), cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	_, oldAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	_, newAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	jb := cltest.MustInsertV2JobSpec(t, db, oldAddress)
+	cltest.MustInsertV2JobSpec(t, db, testutils.NewAddress())
+	pipelineJob := cltest.MustInsertV2JobSpec(t, db, testutils.NewAddress())
+	_, err := db.Exec(`UPDATE pipeline_specs SET dot_dag_source = $1 WHERE id = $2`,
+		fmt.Sprintf(`tx [type=ethtx from="[\\"%s\\"]"]`, strings.ToLower(oldAddress.Hex())), pipelineJob.PipelineSpecID)
+	require.NoError(t, err)
+
+	rotation := keyrotation.ETHKeyRotation{
+		EVMChainID: *utils.NewBig(&cltest.FixtureChainID),
+		OldAddress: oldAddress,
+		NewAddress: newAddress,
+		State:      keyrotation.ETHStateMigratingJobs,
+	}
+	require.NoError(t, orm.CreateETHRotation(&rotation))
+	assert.NotZero(t, rotation.ID)
+
+	t.Run("only one pending rotation per key and chain", func(t *testing.T) {
+		duplicate := rotation
+		require.Error(t, orm.CreateETHRotation(&duplicate))
+	})
+
+	migrated, err := orm.MigrateETHRotationJobs(rotation.ID, true)
+	require.NoError(t, err)
+	assert.Equal(t, keyrotation.ETHStateWaitingForPipelines, migrated.State)
+	assert.Equal(t, []int32{jb.ID}, []int32(migrated.JobIDs))
+	assert.Equal(t, []int32{pipelineJob.ID}, []int32(migrated.PipelineJobIDs))
+
+	var transmitter common.Address
+	require.NoError(t, db.Get(&transmitter, `SELECT transmitter_address FROM ocr_oracle_specs WHERE id = $1`, *jb.OCROracleSpecID))
+	assert.Equal(t, newAddress, transmitter)
+
+	_, err = orm.MigrateETHRotationJobs(rotation.ID, true)
+	assert.Error(t, err)
+
+	t.Run("waits for the pipelines referring to the old key", func(t *testing.T) {
+		waiting, err := orm.UpdateETHRotationPipelineJobs(rotation.ID)
+		require.NoError(t, err)
+		assert.Equal(t, keyrotation.ETHStateWaitingForPipelines, waiting.State)
+		assert.Equal(t, []int32{pipelineJob.ID}, []int32(waiting.PipelineJobIDs))
+
+		_, err = db.Exec(`UPDATE pipeline_specs SET dot_dag_source = $1 WHERE id = $2`,
+			fmt.Sprintf(`tx [type=ethtx from="[\\"%s\\"]"]`, strings.ToLower(newAddress.Hex())), pipelineJob.PipelineSpecID)
+		require.NoError(t, err)
+		migrated, err = orm.UpdateETHRotationPipelineJobs(rotation.ID)
+		require.NoError(t, err)
+		assert.Equal(t, keyrotation.ETHStateWaitingForTransactions, migrated.State)
+		assert.Empty(t, migrated.PipelineJobIDs)
+
+		_, err = orm.UpdateETHRotationPipelineJobs(rotation.ID)
+		assert.Error(t, err)
+	})
+
+	pending, err := orm.PendingETHRotations()
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+
+	migrated.State = keyrotation.ETHStateErrored
+	require.NoError(t, orm.UpdateETHRotation(&migrated))
+	pending, err = orm.PendingETHRotations()
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+func TestORM_ForceETHRotation(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	orm := keyrotation.NewORM(db, logger.TestLogger(t), cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	_, oldAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	_, newAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	pipelineJob := cltest.MustInsertV2JobSpec(t, db, testutils.NewAddress())
+	_, err := db.Exec(`UPDATE pipeline_specs SET dot_dag_source = $1 WHERE id = $2`,
+		fmt.Sprintf(`tx [type=ethtx from="[\\"%s\\"]"]`, oldAddress.Hex()), pipelineJob.PipelineSpecID)
+	require.NoError(t, err)
+
+	rotation := keyrotation.ETHKeyRotation{
+		EVMChainID: *utils.NewBig(&cltest.FixtureChainID),
+		OldAddress: oldAddress,
+		NewAddress: newAddress,
+		State:      keyrotation.ETHStateMigratingJobs,
+	}
+	require.NoError(t, orm.CreateETHRotation(&rotation))
+	assert.False(t, rotation.Forced)
+
+	forced, err := orm.ForceETHRotation(rotation.ID)
+	require.NoError(t, err)
+	assert.True(t, forced.Forced)
+
+	migrated, err := orm.MigrateETHRotationJobs(rotation.ID, true)
+	require.NoError(t, err)
+	assert.Equal(t, keyrotation.ETHStateWaitingForTransactions, migrated.State)
+	assert.Equal(t, []int32{pipelineJob.ID}, []int32(migrated.PipelineJobIDs))
+
+	migrated.State = keyrotation.ETHStateErrored
+	require.NoError(t, orm.UpdateETHRotation(&migrated))
+	_, err = orm.ForceETHRotation(rotation.ID)
+	assert.ErrorIs(t, err, keyrotation.ErrETHRotationNotPending)
+
+	_, err = orm.ForceETHRotation(rotation.ID + 1)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestORM_MigrateETHRotationJobs_NonDefaultChain(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	orm := keyrotation.NewORM(db, logger.TestLogger(t), cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	_, oldAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	_, newAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	// The spec has no chain, so it runs on the default chain
+	jb := cltest.MustInsertV2JobSpec(t, db, oldAddress)
+
+	rotation := keyrotation.ETHKeyRotation{
+		EVMChainID: *utils.NewBig(&cltest.FixtureChainID),
+		OldAddress: oldAddress,
+		NewAddress: newAddress,
+		State:      keyrotation.ETHStateMigratingJobs,
+	}
+	require.NoError(t, orm.CreateETHRotation(&rotation))
+
+	migrated, err := orm.MigrateETHRotationJobs(rotation.ID, false)
+	require.NoError(t, err)
+	assert.Empty(t, migrated.JobIDs)
+
+	var transmitter common.Address
+	require.NoError(t, db.Get(&transmitter, `SELECT transmitter_address FROM ocr_oracle_specs WHERE id = $1`, *jb.OCROracleSpecID))
+	assert.Equal(t, oldAddress, transmitter)
+}
+
+func TestORM_CountUnfinalizedETHTransactions(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	orm := keyrotation.NewORM(db, logger.TestLogger(t), cfg)
+	txmORM := cltest.NewTxmORM(t, db, cfg)
+	_, from := cltest.MustInsertRandomKey(t, cltest.NewKeyStore(t, db, cfg).Eth())
+	chainID := *utils.NewBig(&cltest.FixtureChainID)
+
+	cltest.MustInsertConfirmedEthTxWithReceipt(t, txmORM, from, 0, 10)
+	count, err := orm.CountUnfinalizedETHTransactions(chainID, from, 9)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	count, err = orm.CountUnfinalizedETHTransactions(chainID, from, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txmORM, 1, from)
+	count, err = orm.CountUnfinalizedETHTransactions(chainID, from, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
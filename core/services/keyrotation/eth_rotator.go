@@ -0,0 +1,366 @@
+package keyrotation
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// ETHRotationPollInterval is how often the pending ETH key rotations are
+// advanced
+const ETHRotationPollInterval = 15 * time.Second
+
+// sweepFeeMultiplier is how many times the estimated fee of the sweep
+// transaction is left on the old key to pay for it, in case the gas price
+// rises before it is sent
+const sweepFeeMultiplier = 2
+
+// ETHRotator replaces ETH keys with new keys, advancing their rotations in the
+// background until the old keys are disabled. The jobs sending from any
+// enabled key of the chain keep using the old key until it is disabled, so the
+// rotations wait for their transactions too. The rotations wait for the jobs
+// referring to the old key in their pipeline to be updated, unless they are
+// forced.
+type ETHRotator interface {
+	services.ServiceCtx
+	// Rotate creates a new key for the chain, with the spend limits and
+	// funding thresholds of the old key, and starts the rotation from the old
+	// key to it
+	Rotate(chainID *big.Int, oldAddress common.Address) (ETHKeyRotation, error)
+	FindETHRotation(id int64, qopts ...pg.QOpt) (ETHKeyRotation, error)
+	ETHRotations(qopts ...pg.QOpt) ([]ETHKeyRotation, error)
+	// ForceETHRotation disables the old key of the rotation even though jobs
+	// still refer to it in their pipeline
+	ForceETHRotation(id int64) (ETHKeyRotation, error)
+}
+
+type ethRotator struct {
+	utils.StartStopOnce
+	orm      ORM
+	keyStore keystore.Eth
+	chains   evm.ChainSet
+	spawner  job.Spawner
+	interval time.Duration
+	lggr     logger.Logger
+
+	chWake chan struct{}
+	chStop chan struct{}
+	wgDone sync.WaitGroup
+}
+
+var _ ETHRotator = (*ethRotator)(nil)
+
+// NewETHRotator returns an ETHRotator advancing the rotations every interval
+func NewETHRotator(orm ORM, keyStore keystore.Eth, chains evm.ChainSet, spawner job.Spawner, interval time.Duration, lggr logger.Logger) ETHRotator {
+	return &ethRotator{
+		orm:      orm,
+		keyStore: keyStore,
+		chains:   chains,
+		spawner:  spawner,
+		interval: interval,
+		lggr:     lggr.Named("ETHKeyRotator"),
+		chWake:   make(chan struct{}, 1),
+		chStop:   make(chan struct{}),
+	}
+}
+
+func (r *ethRotator) Start(context.Context) error {
+	return r.StartOnce("ETHKeyRotator", func() error {
+		r.wgDone.Add(1)
+		go r.run()
+		return nil
+	})
+}
+
+func (r *ethRotator) Close() error {
+	return r.StopOnce("ETHKeyRotator", func() error {
+		close(r.chStop)
+		r.wgDone.Wait()
+		return nil
+	})
+}
+
+func (r *ethRotator) Rotate(chainID *big.Int, oldAddress common.Address) (ETHKeyRotation, error) {
+	if _, err := r.chains.Get(chainID); err != nil {
+		return ETHKeyRotation{}, err
+	}
+	state, err := r.keyStore.GetState(oldAddress.Hex(), chainID)
+	if err != nil {
+		return ETHKeyRotation{}, err
+	}
+	if state.Disabled {
+		return ETHKeyRotation{}, errors.Errorf("key %s is disabled for chain %s", oldAddress.Hex(), chainID)
+	}
+	pending, err := r.orm.PendingETHRotations()
+	if err != nil {
+		return ETHKeyRotation{}, err
+	}
+	for _, rotation := range pending {
+		if rotation.EVMChainID.ToInt().Cmp(chainID) == 0 && rotation.OldAddress == oldAddress {
+			return ETHKeyRotation{}, errors.Wrapf(ErrETHKeyAlreadyRotating, "rotation %d", rotation.ID)
+		}
+	}
+
+	key, err := r.keyStore.Create(chainID)
+	if err != nil {
+		return ETHKeyRotation{}, errors.Wrap(err, "failed to create ETH key")
+	}
+	newAddress := key.Address
+	if err = r.keyStore.SetSpendLimits(newAddress, chainID, state.SpendLimits); err != nil {
+		return ETHKeyRotation{}, errors.Wrap(err, "failed to copy spend limits")
+	}
+	if err = r.keyStore.SetFundingThresholds(newAddress, chainID, state.FundingThresholds); err != nil {
+		return ETHKeyRotation{}, errors.Wrap(err, "failed to copy funding thresholds")
+	}
+
+	rotation := ETHKeyRotation{
+		EVMChainID: *utils.NewBig(chainID),
+		OldAddress: oldAddress,
+		NewAddress: newAddress,
+		State:      ETHStateMigratingJobs,
+	}
+	if err = r.orm.CreateETHRotation(&rotation); err != nil {
+		return ETHKeyRotation{}, err
+	}
+	r.lggr.Infow("Started ETH key rotation", "id", rotation.ID, "evmChainID", chainID, "oldAddress", oldAddress, "newAddress", newAddress)
+
+	r.wake()
+	return rotation, nil
+}
+
+func (r *ethRotator) ForceETHRotation(id int64) (ETHKeyRotation, error) {
+	rotation, err := r.orm.ForceETHRotation(id)
+	if err != nil {
+		return ETHKeyRotation{}, err
+	}
+	r.lggr.Warnw("Forced ETH key rotation, the jobs referring to the old key in their pipeline will fail to send transactions once it is disabled", "id", rotation.ID, "jobIDs", rotation.PipelineJobIDs)
+
+	r.wake()
+	return rotation, nil
+}
+
+// wake advances the rotations without waiting for the next poll
+func (r *ethRotator) wake() {
+	select {
+	case r.chWake <- struct{}{}:
+	default:
+	}
+}
+
+func (r *ethRotator) FindETHRotation(id int64, qopts ...pg.QOpt) (ETHKeyRotation, error) {
+	return r.orm.FindETHRotation(id, qopts...)
+}
+
+func (r *ethRotator) ETHRotations(qopts ...pg.QOpt) ([]ETHKeyRotation, error) {
+	return r.orm.ETHRotations(qopts...)
+}
+
+func (r *ethRotator) run() {
+	defer r.wgDone.Done()
+	ctx, cancel := utils.ContextFromChan(r.chStop)
+	defer cancel()
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		r.advanceAll(ctx)
+		select {
+		case <-r.chStop:
+			return
+		case <-ticker.C:
+		case <-r.chWake:
+		}
+	}
+}
+
+// advanceAll advances each pending rotation as far as it can go. The errors
+// are saved in the rotations, which are retried on the next poll.
+func (r *ethRotator) advanceAll(ctx context.Context) {
+	rotations, err := r.orm.PendingETHRotations(pg.WithParentCtx(ctx))
+	if err != nil {
+		r.lggr.Errorw("Failed to load pending ETH key rotations", "err", err)
+		return
+	}
+	for _, rotation := range rotations {
+		for rotation.Pending() {
+			next, err := r.step(ctx, rotation)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				r.lggr.Errorw("Failed to advance ETH key rotation", "id", rotation.ID, "state", rotation.State, "err", err)
+				rotation.Error = null.StringFrom(err.Error())
+				if err = r.orm.UpdateETHRotation(&rotation, pg.WithParentCtx(ctx)); err != nil {
+					r.lggr.Errorw("Failed to save ETH key rotation error", "id", rotation.ID, "err", err)
+				}
+				break
+			}
+			if next.State == rotation.State {
+				if next.Error.Valid {
+					// Waiting again after an error
+					next.Error = null.String{}
+					if err = r.orm.UpdateETHRotation(&next, pg.WithParentCtx(ctx)); err != nil {
+						r.lggr.Errorw("Failed to clear ETH key rotation error", "id", rotation.ID, "err", err)
+					}
+				}
+				break
+			}
+			r.lggr.Infow("Advanced ETH key rotation", "id", rotation.ID, "from", rotation.State, "to", next.State)
+			rotation = next
+		}
+	}
+}
+
+// step moves the rotation to its next state, or returns it unchanged while it
+// waits for transactions
+func (r *ethRotator) step(ctx context.Context, rotation ETHKeyRotation) (ETHKeyRotation, error) {
+	chain, err := r.chains.Get(rotation.EVMChainID.ToInt())
+	if err != nil {
+		return rotation, err
+	}
+	switch rotation.State {
+	case ETHStateMigratingJobs:
+		defaultChain, err := r.chains.Default()
+		if err != nil {
+			return rotation, errors.Wrap(err, "failed to get default chain")
+		}
+		isDefault := defaultChain.ID().Cmp(chain.ID()) == 0
+		next, err := r.orm.MigrateETHRotationJobs(rotation.ID, isDefault, pg.WithParentCtx(ctx))
+		if err != nil {
+			return rotation, err
+		}
+		if next.State == ETHStateWaitingForPipelines {
+			r.lggr.Warnw("Jobs refer to the old key in their pipeline, the rotation waits until they are updated or it is forced", "id", rotation.ID, "jobIDs", next.PipelineJobIDs)
+		}
+		r.restartJobs(ctx, next)
+		return next, nil
+	case ETHStateWaitingForPipelines:
+		return r.orm.UpdateETHRotationPipelineJobs(rotation.ID, pg.WithParentCtx(ctx))
+	case ETHStateWaitingForTransactions:
+		finalized, err := r.finalized(ctx, chain, rotation)
+		if err != nil || !finalized {
+			return rotation, err
+		}
+		return r.sweep(ctx, chain, rotation)
+	case ETHStateSweeping:
+		if rotation.SweepEthTxID.Valid {
+			state, err := r.orm.EthTxState(rotation.SweepEthTxID.Int64, pg.WithParentCtx(ctx))
+			if err != nil {
+				return rotation, err
+			}
+			if state == txmgr.EthTxFatalError {
+				rotation.State = ETHStateErrored
+				rotation.Error = null.StringFrom(fmt.Sprintf("sweep transaction %d failed", rotation.SweepEthTxID.Int64))
+				return rotation, r.orm.UpdateETHRotation(&rotation, pg.WithParentCtx(ctx))
+			}
+		}
+		finalized, err := r.finalized(ctx, chain, rotation)
+		if err != nil || !finalized {
+			return rotation, err
+		}
+		return r.complete(ctx, rotation)
+	default:
+		return rotation, errors.Errorf("unexpected state %s", rotation.State)
+	}
+}
+
+// restartJobs restarts the active jobs switched to the new key. The jobs
+// failing to restart keep their old services until the node restarts, but the
+// rotation goes on anyway.
+func (r *ethRotator) restartJobs(ctx context.Context, rotation ETHKeyRotation) {
+	active := r.spawner.ActiveJobs()
+	for _, jobID := range rotation.JobIDs {
+		if _, ok := active[jobID]; !ok {
+			continue
+		}
+		if err := r.spawner.RestartJob(ctx, jobID); err != nil {
+			r.lggr.Errorw("Failed to restart job switched to the new key", "id", rotation.ID, "jobID", jobID, "err", err)
+		}
+	}
+}
+
+// finalized returns true once the transactions of the old key are finished,
+// and confirmed at least the finality depth of the chain ago
+func (r *ethRotator) finalized(ctx context.Context, chain evm.Chain, rotation ETHKeyRotation) (bool, error) {
+	head, err := chain.Client().HeadByNumber(ctx, nil)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get latest head")
+	}
+	if head == nil {
+		return false, errors.New("failed to get latest head: no head")
+	}
+	finalizedBlock := head.Number - int64(chain.Config().EvmFinalityDepth())
+	count, err := r.orm.CountUnfinalizedETHTransactions(rotation.EVMChainID, rotation.OldAddress, finalizedBlock, pg.WithParentCtx(ctx))
+	return count == 0, err
+}
+
+// sweep transfers the balance of the old key to the new key, less the fee of
+// the transfer. A sweep sent again after a failed save waits for the first
+// one, and leaves too little ETH to be sent.
+func (r *ethRotator) sweep(ctx context.Context, chain evm.Chain, rotation ETHKeyRotation) (ETHKeyRotation, error) {
+	balance, err := chain.Client().BalanceAt(ctx, rotation.OldAddress, nil)
+	if err != nil {
+		return rotation, errors.Wrap(err, "failed to get balance of old key")
+	}
+	estimator := chain.TxManager().GetGasEstimator()
+	if estimator == nil {
+		return rotation, errors.New("no gas estimator for the chain")
+	}
+	cfg := chain.Config()
+	gasLimit := cfg.EvmGasLimitTransfer()
+	maxGasPrice := cfg.KeySpecificMaxGasPriceWei(rotation.OldAddress)
+	var gasPrice *big.Int
+	if cfg.EvmEIP1559DynamicFees() {
+		var fee gas.DynamicFee
+		fee, _, err = estimator.GetDynamicFee(gasLimit, maxGasPrice)
+		gasPrice = fee.FeeCap
+	} else {
+		gasPrice, _, err = estimator.GetLegacyGas(nil, gasLimit, maxGasPrice)
+	}
+	if err != nil {
+		return rotation, errors.Wrap(err, "failed to estimate gas price of sweep transaction")
+	}
+
+	reserve := new(big.Int).Mul(gasPrice, big.NewInt(int64(gasLimit)*sweepFeeMultiplier))
+	value := new(big.Int).Sub(balance, reserve)
+	if value.Sign() <= 0 {
+		r.lggr.Infow("Not enough ETH left on old key to sweep", "id", rotation.ID, "balance", balance)
+		return r.complete(ctx, rotation)
+	}
+	etx, err := chain.TxManager().SendEther(chain.ID(), rotation.OldAddress, rotation.NewAddress, assets.Eth(*value), gasLimit)
+	if err != nil {
+		return rotation, errors.Wrap(err, "failed to send sweep transaction")
+	}
+	rotation.State = ETHStateSweeping
+	rotation.SweepEthTxID = null.IntFrom(etx.ID)
+	rotation.Error = null.String{}
+	return rotation, r.orm.UpdateETHRotation(&rotation, pg.WithParentCtx(ctx))
+}
+
+// complete disables the old key on the chain of the rotation
+func (r *ethRotator) complete(ctx context.Context, rotation ETHKeyRotation) (ETHKeyRotation, error) {
+	if err := r.keyStore.Disable(rotation.OldAddress, rotation.EVMChainID.ToInt(), pg.WithParentCtx(ctx)); err != nil {
+		return rotation, errors.Wrap(err, "failed to disable old key")
+	}
+	rotation.State = ETHStateCompleted
+	rotation.Error = null.String{}
+	rotation.CompletedAt = null.TimeFrom(time.Now())
+	return rotation, r.orm.UpdateETHRotation(&rotation, pg.WithParentCtx(ctx))
+}
@@ -0,0 +1,107 @@
+package keyrotation_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	gasmocks "github.com/smartcontractkit/chainlink/core/chains/evm/gas/mocks"
+	txmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	jobmocks "github.com/smartcontractkit/chainlink/core/services/job/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/keyrotation"
+)
+
+func TestETHRotator_Rotate(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	keyStore := cltest.NewKeyStore(t, db, cfg)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	txm := txmmocks.NewTxManager(t)
+	chains := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, Client: ethClient, KeyStore: keyStore.Eth(), TxManager: txm})
+	chain := evmtest.MustGetDefaultChain(t, chains)
+	spawner := jobmocks.NewSpawner(t)
+	orm := keyrotation.NewORM(db, logger.TestLogger(t), cfg)
+	rotator := keyrotation.NewETHRotator(orm, keyStore.Eth(), chains, spawner, time.Hour, logger.TestLogger(t))
+
+	_, oldAddress := cltest.MustInsertRandomKey(t, keyStore.Eth())
+	jb := cltest.MustInsertV2JobSpec(t, db, oldAddress)
+	pipelineJob := cltest.MustInsertV2JobSpec(t, db, testutils.NewAddress())
+	_, err := db.Exec(`UPDATE pipeline_specs SET dot_dag_source = $1 WHERE id = $2`,
+		fmt.Sprintf(`tx [type=ethtx from="[\\"%s\\"]"]`, oldAddress.Hex()), pipelineJob.PipelineSpecID)
+	require.NoError(t, err)
+	txmORM := cltest.NewTxmORM(t, db, cfg)
+	cltest.MustInsertConfirmedEthTxWithReceipt(t, txmORM, oldAddress, 0, 1)
+
+	t.Run("unknown key", func(t *testing.T) {
+		_, err := rotator.Rotate(chain.ID(), testutils.NewAddress())
+		assert.Error(t, err)
+	})
+
+	rotation, err := rotator.Rotate(chain.ID(), oldAddress)
+	require.NoError(t, err)
+	assert.Equal(t, keyrotation.ETHStateMigratingJobs, rotation.State)
+	_, err = keyStore.Eth().Get(rotation.NewAddress.Hex())
+	require.NoError(t, err)
+
+	_, err = rotator.Rotate(chain.ID(), oldAddress)
+	assert.ErrorIs(t, err, keyrotation.ErrETHKeyAlreadyRotating)
+
+	// The sweep transaction is confirmed as soon as it is sent
+	sweepTx := cltest.MustInsertConfirmedEthTxWithReceipt(t, txmORM, oldAddress, 1, 2)
+	gasLimit := chain.Config().EvmGasLimitTransfer()
+	estimator := gasmocks.NewEstimator(t)
+	estimator.On("GetLegacyGas", mock.Anything, gasLimit, mock.Anything).Return(big.NewInt(10), gasLimit, nil)
+	txm.On("GetGasEstimator").Return(estimator)
+	swept := new(big.Int).Sub(big.NewInt(1e18), big.NewInt(20*int64(gasLimit)))
+	txm.On("SendEther", chain.ID(), oldAddress, rotation.NewAddress, assets.Eth(*swept), gasLimit).Return(sweepTx, nil).Once()
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(&evmtypes.Head{Number: 1000}, nil)
+	ethClient.On("BalanceAt", mock.Anything, oldAddress, (*big.Int)(nil)).Return(big.NewInt(1e18), nil).Once()
+	spawner.On("ActiveJobs").Return(map[int32]job.Job{jb.ID: jb}).Once()
+	spawner.On("RestartJob", mock.Anything, jb.ID).Return(nil).Once()
+
+	require.NoError(t, rotator.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, rotator.Close()) })
+
+	// The job referring to the old key in its pipeline blocks the rotation
+	// until it is forced
+	gomega.NewWithT(t).Eventually(func() keyrotation.ETHState {
+		rotation, err = rotator.FindETHRotation(rotation.ID)
+		require.NoError(t, err)
+		return rotation.State
+	}, testutils.WaitTimeout(t), time.Second).Should(gomega.Equal(keyrotation.ETHStateWaitingForPipelines))
+	assert.Equal(t, []int32{pipelineJob.ID}, []int32(rotation.PipelineJobIDs))
+	require.NoError(t, keyStore.Eth().CheckEnabled(oldAddress, chain.ID()))
+
+	_, err = rotator.ForceETHRotation(rotation.ID)
+	require.NoError(t, err)
+
+	gomega.NewWithT(t).Eventually(func() keyrotation.ETHState {
+		rotation, err = rotator.FindETHRotation(rotation.ID)
+		require.NoError(t, err)
+		return rotation.State
+	}, testutils.WaitTimeout(t), time.Second).Should(gomega.Equal(keyrotation.ETHStateCompleted))
+
+	assert.Equal(t, []int32{jb.ID}, []int32(rotation.JobIDs))
+	assert.True(t, rotation.Forced)
+	assert.Equal(t, sweepTx.ID, rotation.SweepEthTxID.Int64)
+	assert.False(t, rotation.Error.Valid)
+	assert.True(t, rotation.CompletedAt.Valid)
+	assert.Error(t, keyStore.Eth().CheckEnabled(oldAddress, chain.ID()))
+	require.NoError(t, keyStore.Eth().CheckEnabled(rotation.NewAddress, chain.ID()))
+}
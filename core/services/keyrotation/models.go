@@ -0,0 +1,61 @@
+package keyrotation
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+)
+
+// KeyType is the type of the key bundles of a rotation
+type KeyType string
+
+const (
+	KeyTypeOCR  KeyType = "ocr"
+	KeyTypeOCR2 KeyType = "ocr2"
+)
+
+// State is the progress of a rotation
+type State string
+
+const (
+	// StateStaged rotations have a new key bundle, not used by the jobs yet
+	StateStaged State = "staged"
+	// StateCompleted rotations switched the jobs to their new key bundle
+	StateCompleted State = "completed"
+)
+
+var (
+	// ErrRotationNotStaged is returned when completing or cancelling a
+	// rotation which is already completed
+	ErrRotationNotStaged = errors.New("rotation is not staged")
+	// ErrKeyBundleAlreadyStaged is returned when staging a rotation of a key
+	// bundle which has a staged rotation already
+	ErrKeyBundleAlreadyStaged = errors.New("key bundle has a staged rotation already")
+)
+
+// Rotation replaces a key bundle used by OCR or OCR2 jobs with a new one. The
+// new key bundle is staged until the on-chain config lists it, then the
+// rotation is completed, switching all the jobs at once.
+type Rotation struct {
+	ID             int64
+	KeyType        KeyType
+	OldKeyBundleID string
+	NewKeyBundleID string
+	State          State
+	// JobIDs are the jobs switched to the new key bundle, once completed
+	JobIDs      pq.Int32Array
+	CreatedAt   time.Time
+	CompletedAt null.Time
+}
+
+// ValidateKeyType returns an error if t is not a known key type
+func ValidateKeyType(t KeyType) error {
+	switch t {
+	case KeyTypeOCR, KeyTypeOCR2:
+		return nil
+	default:
+		return errors.Errorf("invalid key type %q, must be %s or %s", t, KeyTypeOCR, KeyTypeOCR2)
+	}
+}
@@ -0,0 +1,143 @@
+package keyrotation
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+type ORM interface {
+	CreateRotation(r *Rotation, qopts ...pg.QOpt) error
+	// FindRotation returns sql.ErrNoRows if the rotation does not exist
+	FindRotation(id int64, qopts ...pg.QOpt) (Rotation, error)
+	Rotations(qopts ...pg.QOpt) ([]Rotation, error)
+	// DeleteStagedRotation returns sql.ErrNoRows if the rotation does not
+	// exist, and ErrRotationNotStaged if it is completed
+	DeleteStagedRotation(id int64, qopts ...pg.QOpt) error
+	// CompleteRotation switches the specs using the old key bundle of a
+	// staged rotation to its new key bundle, in a single transaction, and
+	// returns the completed rotation with the IDs of their jobs
+	CompleteRotation(id int64, qopts ...pg.QOpt) (Rotation, error)
+
+	CreateETHRotation(r *ETHKeyRotation, qopts ...pg.QOpt) error
+	// FindETHRotation returns sql.ErrNoRows if the rotation does not exist
+	FindETHRotation(id int64, qopts ...pg.QOpt) (ETHKeyRotation, error)
+	// ETHRotations returns all the ETH key rotations, the latest first
+	ETHRotations(qopts ...pg.QOpt) ([]ETHKeyRotation, error)
+	// PendingETHRotations returns the pending ETH key rotations, oldest first
+	PendingETHRotations(qopts ...pg.QOpt) ([]ETHKeyRotation, error)
+	// MigrateETHRotationJobs switches the specs using the old key of a
+	// rotation on its chain to its new key, in a single transaction, and
+	// returns the rotation waiting for pipelines or transactions with the IDs
+	// of their jobs. The specs without a chain are switched only if
+	// defaultChain is set.
+	MigrateETHRotationJobs(id int64, defaultChain bool, qopts ...pg.QOpt) (ETHKeyRotation, error)
+	// UpdateETHRotationPipelineJobs returns the rotation waiting for
+	// pipelines with the jobs still referring to the old key in their
+	// pipeline, or waiting for transactions if there are none or it is forced
+	UpdateETHRotationPipelineJobs(id int64, qopts ...pg.QOpt) (ETHKeyRotation, error)
+	// ForceETHRotation returns sql.ErrNoRows if the rotation does not exist,
+	// and ErrETHRotationNotPending if it is completed or errored
+	ForceETHRotation(id int64, qopts ...pg.QOpt) (ETHKeyRotation, error)
+	UpdateETHRotation(r *ETHKeyRotation, qopts ...pg.QOpt) error
+	CountUnfinalizedETHTransactions(chainID utils.Big, address common.Address, finalizedBlock int64, qopts ...pg.QOpt) (int, error)
+	EthTxState(id int64, qopts ...pg.QOpt) (txmgr.EthTxState, error)
+}
+
+type orm struct {
+	q pg.Q
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig) ORM {
+	return &orm{pg.NewQ(db, lggr.Named("KeyRotationORM"), cfg)}
+}
+
+// CreateRotation inserts r, setting its ID and creation time
+func (o *orm) CreateRotation(r *Rotation, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	sql := `INSERT INTO ocr_key_rotations (key_type, old_key_bundle_id, new_key_bundle_id, state, job_ids, created_at)
+VALUES (:key_type, :old_key_bundle_id, :new_key_bundle_id, :state, '{}', NOW())
+RETURNING *`
+	return errors.Wrap(q.GetNamed(sql, r, r), "CreateRotation failed")
+}
+
+func (o *orm) FindRotation(id int64, qopts ...pg.QOpt) (r Rotation, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Get(&r, `SELECT * FROM ocr_key_rotations WHERE id = $1`, id)
+	return r, errors.Wrap(err, "FindRotation failed")
+}
+
+// Rotations returns all the rotations, the latest first
+func (o *orm) Rotations(qopts ...pg.QOpt) (rs []Rotation, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Select(&rs, `SELECT * FROM ocr_key_rotations ORDER BY id DESC`)
+	return rs, errors.Wrap(err, "Rotations failed")
+}
+
+func (o *orm) DeleteStagedRotation(id int64, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	return q.Transaction(func(tx pg.Queryer) error {
+		var state State
+		if err := tx.Get(&state, `SELECT state FROM ocr_key_rotations WHERE id = $1 FOR UPDATE`, id); err != nil {
+			return errors.Wrap(err, "DeleteStagedRotation failed")
+		}
+		if state != StateStaged {
+			return ErrRotationNotStaged
+		}
+		_, err := tx.Exec(`DELETE FROM ocr_key_rotations WHERE id = $1`, id)
+		return errors.Wrap(err, "DeleteStagedRotation failed")
+	})
+}
+
+func (o *orm) CompleteRotation(id int64, qopts ...pg.QOpt) (r Rotation, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Transaction(func(tx pg.Queryer) error {
+		if err = tx.Get(&r, `SELECT * FROM ocr_key_rotations WHERE id = $1 FOR UPDATE`, id); err != nil {
+			return errors.Wrap(err, "failed to load rotation")
+		}
+		if r.State != StateStaged {
+			return ErrRotationNotStaged
+		}
+
+		var jobIDs []int32
+		switch r.KeyType {
+		case KeyTypeOCR:
+			// The OCR key bundle IDs are stored as bytes
+			var oldID, newID models.Sha256Hash
+			if oldID, err = models.Sha256HashFromHex(r.OldKeyBundleID); err != nil {
+				return errors.Wrap(err, "invalid old key bundle ID")
+			}
+			if newID, err = models.Sha256HashFromHex(r.NewKeyBundleID); err != nil {
+				return errors.Wrap(err, "invalid new key bundle ID")
+			}
+			err = tx.Select(&jobIDs, `WITH updated AS (
+	UPDATE ocr_oracle_specs SET encrypted_ocr_key_bundle_id = $2, updated_at = NOW() WHERE encrypted_ocr_key_bundle_id = $1 RETURNING id
+)
+SELECT jobs.id FROM jobs JOIN updated ON jobs.ocr_oracle_spec_id = updated.id ORDER BY jobs.id`, oldID, newID)
+		case KeyTypeOCR2:
+			err = tx.Select(&jobIDs, `WITH updated AS (
+	UPDATE ocr2_oracle_specs SET ocr_key_bundle_id = $2, updated_at = NOW() WHERE ocr_key_bundle_id = $1 RETURNING id
+)
+SELECT jobs.id FROM jobs JOIN updated ON jobs.ocr2_oracle_spec_id = updated.id ORDER BY jobs.id`, r.OldKeyBundleID, r.NewKeyBundleID)
+		default:
+			return ValidateKeyType(r.KeyType)
+		}
+		if err != nil {
+			return errors.Wrap(err, "failed to switch the specs to the new key bundle")
+		}
+
+		err = tx.Get(&r, `UPDATE ocr_key_rotations SET state = $2, job_ids = $3, completed_at = NOW() WHERE id = $1 RETURNING *`,
+			id, StateCompleted, pq.Int32Array(jobIDs))
+		return errors.Wrap(err, "failed to complete rotation")
+	})
+	return r, err
+}
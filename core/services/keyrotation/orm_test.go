@@ -0,0 +1,91 @@
+package keyrotation_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keyrotation"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+const newOCRKeyBundleID = "0e2f8d2c5bb9f6dd9d4dc8a5e4e8e1b7a0e2f8d2c5bb9f6dd9d4dc8a5e4e8e1b"
+
+func TestORM_CompleteRotation(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	orm := keyrotation.NewORM(db, logger.TestLogger(t), cfg)
+	jb := cltest.MustInsertV2JobSpec(t, db, testutils.NewAddress())
+
+	rotation := keyrotation.Rotation{
+		KeyType:        keyrotation.KeyTypeOCR,
+		OldKeyBundleID: cltest.DefaultOCRKeyBundleID,
+		NewKeyBundleID: newOCRKeyBundleID,
+		State:          keyrotation.StateStaged,
+	}
+	require.NoError(t, orm.CreateRotation(&rotation))
+	assert.NotZero(t, rotation.ID)
+	assert.Empty(t, rotation.JobIDs)
+
+	t.Run("only one staged rotation per key bundle", func(t *testing.T) {
+		duplicate := rotation
+		require.Error(t, orm.CreateRotation(&duplicate))
+	})
+
+	completed, err := orm.CompleteRotation(rotation.ID)
+	require.NoError(t, err)
+	assert.Equal(t, keyrotation.StateCompleted, completed.State)
+	assert.Equal(t, []int32{jb.ID}, []int32(completed.JobIDs))
+	assert.True(t, completed.CompletedAt.Valid)
+
+	var keyBundleID models.Sha256Hash
+	require.NoError(t, db.Get(&keyBundleID, `SELECT encrypted_ocr_key_bundle_id FROM ocr_oracle_specs WHERE id = $1`, *jb.OCROracleSpecID))
+	assert.Equal(t, newOCRKeyBundleID, keyBundleID.String())
+
+	found, err := orm.FindRotation(rotation.ID)
+	require.NoError(t, err)
+	assert.Equal(t, completed.JobIDs, found.JobIDs)
+
+	_, err = orm.CompleteRotation(rotation.ID)
+	assert.ErrorIs(t, err, keyrotation.ErrRotationNotStaged)
+	assert.ErrorIs(t, orm.DeleteStagedRotation(rotation.ID), keyrotation.ErrRotationNotStaged)
+
+	_, err = orm.CompleteRotation(rotation.ID + 1)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestORM_DeleteStagedRotation(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	orm := keyrotation.NewORM(db, logger.TestLogger(t), cfg)
+
+	rotation := keyrotation.Rotation{
+		KeyType:        keyrotation.KeyTypeOCR2,
+		OldKeyBundleID: "old",
+		NewKeyBundleID: "new",
+		State:          keyrotation.StateStaged,
+	}
+	require.NoError(t, orm.CreateRotation(&rotation))
+
+	rotations, err := orm.Rotations()
+	require.NoError(t, err)
+	require.Len(t, rotations, 1)
+	assert.Equal(t, rotation.ID, rotations[0].ID)
+
+	require.NoError(t, orm.DeleteStagedRotation(rotation.ID))
+	assert.ErrorIs(t, orm.DeleteStagedRotation(rotation.ID), sql.ErrNoRows)
+
+	rotations, err = orm.Rotations()
+	require.NoError(t, err)
+	assert.Empty(t, rotations)
+}
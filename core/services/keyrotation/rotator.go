@@ -0,0 +1,138 @@
+package keyrotation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// KeyStore creates the new key bundles of the rotations
+type KeyStore interface {
+	OCR() keystore.OCR
+	OCR2() keystore.OCR2
+}
+
+// Rotator replaces the key bundles of the OCR and OCR2 jobs in two steps:
+// Stage creates a new key bundle, to be added to the on-chain config with
+// setConfig, then Complete switches all the jobs using the old key bundle to
+// the new one at once.
+type Rotator interface {
+	// Stage creates a new key bundle, of the same type and chain type as
+	// oldKeyBundleID, and a staged rotation from oldKeyBundleID to it
+	Stage(keyType KeyType, oldKeyBundleID string, qopts ...pg.QOpt) (Rotation, error)
+	// Complete switches the jobs using the old key bundle of a staged
+	// rotation to its new key bundle, and restarts them
+	Complete(ctx context.Context, id int64) (Rotation, error)
+	// Cancel deletes a staged rotation, keeping its new key bundle
+	Cancel(id int64, qopts ...pg.QOpt) error
+	Rotations(qopts ...pg.QOpt) ([]Rotation, error)
+}
+
+type rotator struct {
+	orm      ORM
+	keyStore KeyStore
+	spawner  job.Spawner
+	lggr     logger.Logger
+}
+
+var _ Rotator = (*rotator)(nil)
+
+func NewRotator(orm ORM, keyStore KeyStore, spawner job.Spawner, lggr logger.Logger) Rotator {
+	return &rotator{
+		orm:      orm,
+		keyStore: keyStore,
+		spawner:  spawner,
+		lggr:     lggr.Named("KeyRotator"),
+	}
+}
+
+func (r *rotator) Stage(keyType KeyType, oldKeyBundleID string, qopts ...pg.QOpt) (Rotation, error) {
+	if err := ValidateKeyType(keyType); err != nil {
+		return Rotation{}, err
+	}
+	rotations, err := r.orm.Rotations(qopts...)
+	if err != nil {
+		return Rotation{}, err
+	}
+	for _, rotation := range rotations {
+		if rotation.State == StateStaged && rotation.KeyType == keyType && rotation.OldKeyBundleID == oldKeyBundleID {
+			return Rotation{}, errors.Wrapf(ErrKeyBundleAlreadyStaged, "rotation %d", rotation.ID)
+		}
+	}
+
+	var newKeyBundleID string
+	switch keyType {
+	case KeyTypeOCR:
+		if _, err = r.keyStore.OCR().Get(oldKeyBundleID); err != nil {
+			return Rotation{}, err
+		}
+		key, err := r.keyStore.OCR().Create()
+		if err != nil {
+			return Rotation{}, errors.Wrap(err, "failed to create OCR key bundle")
+		}
+		newKeyBundleID = key.ID()
+	case KeyTypeOCR2:
+		old, err := r.keyStore.OCR2().Get(oldKeyBundleID)
+		if err != nil {
+			return Rotation{}, err
+		}
+		key, err := r.keyStore.OCR2().Create(old.ChainType())
+		if err != nil {
+			return Rotation{}, errors.Wrap(err, "failed to create OCR2 key bundle")
+		}
+		newKeyBundleID = key.ID()
+	}
+
+	rotation := Rotation{
+		KeyType:        keyType,
+		OldKeyBundleID: oldKeyBundleID,
+		NewKeyBundleID: newKeyBundleID,
+		State:          StateStaged,
+	}
+	if err = r.orm.CreateRotation(&rotation, qopts...); err != nil {
+		return Rotation{}, err
+	}
+	r.lggr.Infow("Staged key bundle rotation", "id", rotation.ID, "keyType", keyType, "oldKeyBundleID", oldKeyBundleID, "newKeyBundleID", newKeyBundleID)
+	return rotation, nil
+}
+
+// Complete restarts the switched jobs once their specs are all updated. The
+// jobs failing to restart keep their old services until the node restarts,
+// but the rotation is completed anyway.
+func (r *rotator) Complete(ctx context.Context, id int64) (Rotation, error) {
+	rotation, err := r.orm.CompleteRotation(id, pg.WithParentCtx(ctx))
+	if err != nil {
+		return Rotation{}, err
+	}
+	r.lggr.Infow("Completed key bundle rotation", "id", rotation.ID, "keyType", rotation.KeyType, "newKeyBundleID", rotation.NewKeyBundleID, "jobIDs", rotation.JobIDs)
+
+	active := r.spawner.ActiveJobs()
+	var merr error
+	for _, jobID := range rotation.JobIDs {
+		if _, ok := active[jobID]; !ok {
+			// Archived jobs are switched, but not running
+			continue
+		}
+		if err = r.spawner.RestartJob(ctx, jobID); err != nil {
+			merr = multierr.Append(merr, errors.Wrapf(err, "job %d", jobID))
+		}
+	}
+	if merr != nil {
+		return rotation, errors.Wrap(merr, "rotation completed, but failed to restart jobs")
+	}
+	return rotation, nil
+}
+
+func (r *rotator) Cancel(id int64, qopts ...pg.QOpt) error {
+	return r.orm.DeleteStagedRotation(id, qopts...)
+}
+
+func (r *rotator) Rotations(qopts ...pg.QOpt) ([]Rotation, error) {
+	return r.orm.Rotations(qopts...)
+}
@@ -0,0 +1,100 @@
+package keyrotation_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	jobmocks "github.com/smartcontractkit/chainlink/core/services/job/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/keyrotation"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+func TestRotator_StageComplete(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	keyStore := cltest.NewKeyStore(t, db, cfg)
+	spawner := jobmocks.NewSpawner(t)
+	rotator := keyrotation.NewRotator(keyrotation.NewORM(db, logger.TestLogger(t), cfg), keyStore, spawner, logger.TestLogger(t))
+
+	oldKey, err := keyStore.OCR().Create()
+	require.NoError(t, err)
+	active := cltest.MustInsertV2JobSpec(t, db, testutils.NewAddress())
+	archived := cltest.MustInsertV2JobSpec(t, db, testutils.NewAddress())
+	oldKeyBundleID := models.MustSha256HashFromHex(oldKey.ID())
+	_, err = db.Exec(`UPDATE ocr_oracle_specs SET encrypted_ocr_key_bundle_id = $1`, &oldKeyBundleID)
+	require.NoError(t, err)
+
+	t.Run("invalid key bundles", func(t *testing.T) {
+		_, err := rotator.Stage("ocr3", oldKey.ID())
+		assert.Error(t, err)
+		_, err = rotator.Stage(keyrotation.KeyTypeOCR, cltest.DefaultOCRKeyBundleID)
+		assert.Error(t, err)
+		_, err = rotator.Stage(keyrotation.KeyTypeOCR2, oldKey.ID())
+		assert.Error(t, err)
+	})
+
+	rotation, err := rotator.Stage(keyrotation.KeyTypeOCR, oldKey.ID())
+	require.NoError(t, err)
+	assert.Equal(t, keyrotation.StateStaged, rotation.State)
+	assert.Equal(t, oldKey.ID(), rotation.OldKeyBundleID)
+	newKey, err := keyStore.OCR().Get(rotation.NewKeyBundleID)
+	require.NoError(t, err)
+
+	_, err = rotator.Stage(keyrotation.KeyTypeOCR, oldKey.ID())
+	assert.ErrorIs(t, err, keyrotation.ErrKeyBundleAlreadyStaged)
+
+	spawner.On("ActiveJobs").Return(map[int32]job.Job{active.ID: active}).Once()
+	spawner.On("RestartJob", mock.Anything, active.ID).Return(errors.New("restart failed")).Once()
+	completed, err := rotator.Complete(testutils.Context(t), rotation.ID)
+	require.ErrorContains(t, err, "restart failed")
+	assert.Equal(t, keyrotation.StateCompleted, completed.State)
+	assert.ElementsMatch(t, []int32{active.ID, archived.ID}, completed.JobIDs)
+
+	var keyBundleIDs []models.Sha256Hash
+	require.NoError(t, db.Select(&keyBundleIDs, `SELECT encrypted_ocr_key_bundle_id FROM ocr_oracle_specs`))
+	for _, id := range keyBundleIDs {
+		assert.Equal(t, newKey.ID(), id.String())
+	}
+
+	assert.ErrorIs(t, rotator.Cancel(rotation.ID), keyrotation.ErrRotationNotStaged)
+}
+
+func TestRotator_StageCancel_OCR2(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	keyStore := cltest.NewKeyStore(t, db, cfg)
+	rotator := keyrotation.NewRotator(keyrotation.NewORM(db, logger.TestLogger(t), cfg), keyStore, jobmocks.NewSpawner(t), logger.TestLogger(t))
+
+	oldKey, err := keyStore.OCR2().Create("evm")
+	require.NoError(t, err)
+
+	rotation, err := rotator.Stage(keyrotation.KeyTypeOCR2, oldKey.ID())
+	require.NoError(t, err)
+	newKey, err := keyStore.OCR2().Get(rotation.NewKeyBundleID)
+	require.NoError(t, err)
+	assert.Equal(t, oldKey.ChainType(), newKey.ChainType())
+
+	require.NoError(t, rotator.Cancel(rotation.ID))
+	rotations, err := rotator.Rotations()
+	require.NoError(t, err)
+	assert.Empty(t, rotations)
+
+	// The new key bundle is kept, and the key bundle can be staged again
+	_, err = keyStore.OCR2().Get(newKey.ID())
+	require.NoError(t, err)
+	_, err = rotator.Stage(keyrotation.KeyTypeOCR2, oldKey.ID())
+	require.NoError(t, err)
+}
@@ -1,11 +1,13 @@
 package keystore
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
@@ -13,6 +15,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/remotesigner"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 )
 
@@ -26,10 +29,16 @@ type Eth interface {
 	Delete(id string) (ethkey.KeyV2, error)
 	Import(keyJSON []byte, password string, chainIDs ...*big.Int) (ethkey.KeyV2, error)
 	Export(id string, password string) ([]byte, error)
+	// AddRemote adds a key whose transactions are signed by the configured
+	// remote signer instead of a private key held by the node
+	AddRemote(signer ethkey.RemoteSigner, chainIDs ...*big.Int) (ethkey.KeyV2, error)
+	GetRemoteSigner(id string) (ethkey.RemoteSigner, error)
 
 	Enable(address common.Address, chainID *big.Int, qopts ...pg.QOpt) error
 	Disable(address common.Address, chainID *big.Int, qopts ...pg.QOpt) error
 	Reset(address common.Address, chainID *big.Int, nonce int64, qopts ...pg.QOpt) error
+	SetSpendLimits(address common.Address, chainID *big.Int, limits ethkey.SpendLimits, qopts ...pg.QOpt) error
+	SetFundingThresholds(address common.Address, chainID *big.Int, thresholds ethkey.FundingThresholds, qopts ...pg.QOpt) error
 
 	GetNextNonce(address common.Address, chainID *big.Int, qopts ...pg.QOpt) (int64, error)
 	IncrementNextNonce(address common.Address, chainID *big.Int, currentNonce int64, qopts ...pg.QOpt) error
@@ -51,6 +60,16 @@ type Eth interface {
 	XXXTestingOnlyAdd(key ethkey.KeyV2)
 }
 
+// remoteEthKey is an eth key signed by a remote signer
+type remoteEthKey struct {
+	key    ethkey.KeyV2
+	config ethkey.RemoteSigner
+	signer remotesigner.Signer
+}
+
+// remoteSignerTimeout bounds each request to a remote signer
+const remoteSignerTimeout = 30 * time.Second
+
 type eth struct {
 	*keyManager
 	subscribers   [](chan struct{})
@@ -90,6 +109,9 @@ func (ks *eth) getAll() (keys []ethkey.KeyV2) {
 	for _, key := range ks.keyRing.Eth {
 		keys = append(keys, key)
 	}
+	for _, remote := range ks.remoteEthKeys {
+		keys = append(keys, remote.key)
+	}
 	sort.Slice(keys, func(i, j int) bool { return keys[i].Cmp(keys[j]) < 0 })
 	return
 }
@@ -153,7 +175,7 @@ func (ks *eth) Import(keyJSON []byte, password string, chainIDs ...*big.Int) (et
 		return ethkey.KeyV2{}, errors.Wrap(err, "EthKeyStore#ImportKey failed to decrypt key")
 	}
 	key := ethkey.FromPrivateKey(dKey.PrivateKey)
-	if _, found := ks.keyRing.Eth[key.ID()]; found {
+	if _, err = ks.getByID(key.ID()); err == nil {
 		return ethkey.KeyV2{}, fmt.Errorf("key with ID %s already exists", key.ID())
 	}
 	err = ks.add(key, chainIDs...)
@@ -174,9 +196,63 @@ func (ks *eth) Export(id string, password string) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	if key.IsRemote() {
+		return nil, errors.Errorf("key %s is signed by a remote signer and cannot be exported", id)
+	}
 	return key.ToEncryptedJSON(password, ks.scryptParams)
 }
 
+func (ks *eth) AddRemote(cfg ethkey.RemoteSigner, chainIDs ...*big.Int) (ethkey.KeyV2, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ethkey.KeyV2{}, ErrLocked
+	}
+	key := ethkey.FromAddress(cfg.Address.Address())
+	if _, err := ks.getByID(key.ID()); err == nil {
+		return ethkey.KeyV2{}, fmt.Errorf("key with ID %s already exists", key.ID())
+	}
+	signer, err := remotesigner.New(cfg)
+	if err != nil {
+		return ethkey.KeyV2{}, err
+	}
+	err = ks.orm.q.Transaction(func(tx pg.Queryer) error {
+		if err2 := ks.orm.insertRemoteSigner(&cfg, pg.WithQueryer(tx)); err2 != nil {
+			return err2
+		}
+		for _, chainID := range chainIDs {
+			if err2 := ks.enable(key.Address, chainID, pg.WithQueryer(tx)); err2 != nil {
+				return err2
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		ks.logger.ErrorIfClosing(signer, "remote signer")
+		return ethkey.KeyV2{}, errors.Wrap(err, "unable to add remote eth key")
+	}
+	if ks.remoteEthKeys == nil {
+		ks.remoteEthKeys = make(map[string]remoteEthKey)
+	}
+	ks.remoteEthKeys[key.ID()] = remoteEthKey{key, cfg, signer}
+	ks.notify()
+	ks.logger.Infow(fmt.Sprintf("Added EVM key with ID %s signed by %s remote signer", key.ID(), cfg.Type), "address", key.ID(), "type", cfg.Type, "url", cfg.URL, "evmChainIDs", chainIDs)
+	return key, nil
+}
+
+func (ks *eth) GetRemoteSigner(id string) (ethkey.RemoteSigner, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return ethkey.RemoteSigner{}, ErrLocked
+	}
+	remote, found := ks.remoteEthKeys[id]
+	if !found {
+		return ethkey.RemoteSigner{}, errors.Errorf("no remote signer for eth key with id %s", id)
+	}
+	return remote.config, nil
+}
+
 // Get the next nonce for the given key and chain. It is safest to always to go the DB for this
 func (ks *eth) GetNextNonce(address common.Address, chainID *big.Int, qopts ...pg.QOpt) (nonce int64, err error) {
 	if !ks.exists(address) {
@@ -225,8 +301,7 @@ func (ks *eth) IncrementNextNonce(address common.Address, chainID *big.Int, curr
 func (ks *eth) Enable(address common.Address, chainID *big.Int, qopts ...pg.QOpt) error {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
-	_, found := ks.keyRing.Eth[address.Hex()]
-	if !found {
+	if _, err := ks.getByID(address.Hex()); err != nil {
 		return errors.Errorf("no key exists with ID %s", address.Hex())
 	}
 	return ks.enable(address, chainID, qopts...)
@@ -239,7 +314,7 @@ func (ks *eth) enable(address common.Address, chainID *big.Int, qopts ...pg.QOpt
 VALUES ($1, 0, false, $2, NOW(), NOW()) ON CONFLICT (evm_chain_id, address) DO UPDATE SET
 disabled=false,
 updated_at=NOW()
-RETURNING id, next_nonce, address, evm_chain_id, disabled, created_at, updated_at;`
+RETURNING id, next_nonce, address, evm_chain_id, disabled, max_daily_spend, max_in_flight_transactions, enabled_job_types, low_balance, critical_balance, funding_job_id, created_at, updated_at;`
 	q := ks.orm.q.WithOpts(qopts...)
 	if err := q.Get(state, sql, address, chainID.String()); err != nil {
 		return errors.Wrap(err, "failed to insert evm_key_state")
@@ -252,8 +327,7 @@ RETURNING id, next_nonce, address, evm_chain_id, disabled, created_at, updated_a
 func (ks *eth) Disable(address common.Address, chainID *big.Int, qopts ...pg.QOpt) error {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
-	_, found := ks.keyRing.Eth[address.Hex()]
-	if !found {
+	if _, err := ks.getByID(address.Hex()); err != nil {
 		return errors.Errorf("no key exists with ID %s", address.Hex())
 	}
 	return ks.disable(address, chainID, qopts...)
@@ -271,6 +345,42 @@ func (ks *eth) disable(address common.Address, chainID *big.Int, qopts ...pg.QOp
 	return nil
 }
 
+// SetSpendLimits replaces the spend limits of the key/chain
+func (ks *eth) SetSpendLimits(address common.Address, chainID *big.Int, limits ethkey.SpendLimits, qopts ...pg.QOpt) error {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	state, exists := ks.keyStates.KeyIDChainID[address.Hex()][chainID.String()]
+	if !exists {
+		return errors.Errorf("state not found for address %s, chainID %s", address.Hex(), chainID.String())
+	}
+	q := ks.orm.q.WithOpts(qopts...)
+	_, err := q.Exec(`UPDATE evm_key_states SET max_daily_spend = $1, max_in_flight_transactions = $2, enabled_job_types = $3, updated_at = NOW() WHERE address = $4 AND evm_chain_id = $5`,
+		limits.MaxDailySpend, limits.MaxInFlightTransactions, limits.EnabledJobTypes, address, chainID.String())
+	if err != nil {
+		return errors.Wrap(err, "failed to set spend limits")
+	}
+	state.SpendLimits = limits
+	return nil
+}
+
+// SetFundingThresholds replaces the funding thresholds of the key/chain
+func (ks *eth) SetFundingThresholds(address common.Address, chainID *big.Int, thresholds ethkey.FundingThresholds, qopts ...pg.QOpt) error {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	state, exists := ks.keyStates.KeyIDChainID[address.Hex()][chainID.String()]
+	if !exists {
+		return errors.Errorf("state not found for address %s, chainID %s", address.Hex(), chainID.String())
+	}
+	q := ks.orm.q.WithOpts(qopts...)
+	_, err := q.Exec(`UPDATE evm_key_states SET low_balance = $1, critical_balance = $2, funding_job_id = $3, updated_at = NOW() WHERE address = $4 AND evm_chain_id = $5`,
+		thresholds.LowBalance, thresholds.CriticalBalance, thresholds.FundingJobID, address, chainID.String())
+	if err != nil {
+		return errors.Wrap(err, "failed to set funding thresholds")
+	}
+	state.FundingThresholds = thresholds
+	return nil
+}
+
 // Reset the key/chain nonce to the given one
 func (ks *eth) Reset(address common.Address, chainID *big.Int, nonce int64, qopts ...pg.QOpt) error {
 	q := ks.orm.q.WithOpts(qopts...)
@@ -305,13 +415,27 @@ func (ks *eth) Delete(id string) (ethkey.KeyV2, error) {
 	if err != nil {
 		return ethkey.KeyV2{}, err
 	}
-	err = ks.safeRemoveKey(key, func(tx pg.Queryer) error {
-		_, err2 := tx.Exec(`DELETE FROM evm_key_states WHERE address = $1`, key.Address)
-		return err2
-	})
+	if key.IsRemote() {
+		err = ks.orm.q.Transaction(func(tx pg.Queryer) error {
+			if _, err2 := tx.Exec(`DELETE FROM evm_key_states WHERE address = $1`, key.Address); err2 != nil {
+				return err2
+			}
+			_, err2 := tx.Exec(`DELETE FROM evm_key_remote_signers WHERE address = $1`, key.Address)
+			return err2
+		})
+	} else {
+		err = ks.safeRemoveKey(key, func(tx pg.Queryer) error {
+			_, err2 := tx.Exec(`DELETE FROM evm_key_states WHERE address = $1`, key.Address)
+			return err2
+		})
+	}
 	if err != nil {
 		return ethkey.KeyV2{}, errors.Wrap(err, "unable to remove eth key")
 	}
+	if remote, found := ks.remoteEthKeys[id]; found {
+		ks.logger.ErrorIfClosing(remote.signer, "remote signer")
+		delete(ks.remoteEthKeys, id)
+	}
 	ks.keyStates.delete(key.Address)
 	ks.notify()
 	return key, nil
@@ -335,19 +459,33 @@ func (ks *eth) SubscribeToKeyChanges() (ch chan struct{}, unsub func()) {
 }
 
 func (ks *eth) SignTx(address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
-	ks.lock.RLock()
-	defer ks.lock.RUnlock()
-	if ks.isLocked() {
-		return nil, ErrLocked
-	}
-	key, err := ks.getByID(address.Hex())
+	remote, key, err := ks.getSigningKey(address)
 	if err != nil {
 		return nil, err
 	}
+	if remote != nil {
+		// the lock is not held while waiting on the remote signer
+		ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+		defer cancel()
+		return remote.SignTx(ctx, address, tx, chainID)
+	}
 	signer := types.LatestSignerForChainID(chainID)
 	return types.SignTx(tx, signer, key.ToEcdsaPrivKey())
 }
 
+func (ks *eth) getSigningKey(address common.Address) (remotesigner.Signer, ethkey.KeyV2, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ethkey.KeyV2{}, ErrLocked
+	}
+	if remote, found := ks.remoteEthKeys[address.Hex()]; found {
+		return remote.signer, remote.key, nil
+	}
+	key, err := ks.getByID(address.Hex())
+	return nil, key, err
+}
+
 // EnabledKeysForChain returns all keys that are enabled for the given chain
 func (ks *eth) EnabledKeysForChain(chainID *big.Int) (sendingKeys []ethkey.KeyV2, err error) {
 	if chainID == nil {
@@ -548,6 +686,9 @@ func (ks *eth) XXXTestingOnlyAdd(key ethkey.KeyV2) {
 
 // caller must hold lock!
 func (ks *eth) getByID(id string) (ethkey.KeyV2, error) {
+	if remote, found := ks.remoteEthKeys[id]; found {
+		return remote.key, nil
+	}
 	key, found := ks.keyRing.Eth[id]
 	if !found {
 		return ethkey.KeyV2{}, fmt.Errorf("unable to find eth key with id %s", id)
@@ -558,8 +699,8 @@ func (ks *eth) getByID(id string) (ethkey.KeyV2, error) {
 func (ks *eth) exists(address common.Address) bool {
 	ks.lock.RLock()
 	defer ks.lock.RUnlock()
-	_, found := ks.keyRing.Eth[address.Hex()]
-	return found
+	_, err := ks.getByID(address.Hex())
+	return err == nil
 }
 
 // caller must hold lock!
@@ -575,7 +716,7 @@ func (ks *eth) keysForChain(chainID *big.Int, includeDisabled bool) (keys []ethk
 	}
 	for keyID, state := range states {
 		if includeDisabled || !state.Disabled {
-			k := ks.keyRing.Eth[keyID]
+			k, _ := ks.getByID(keyID)
 			keys = append(keys, k)
 		}
 	}
@@ -628,3 +769,21 @@ func (ks *eth) notify() {
 		}
 	}
 }
+
+// loadRemoteEthKeys creates the remote signers configured in the DB
+func loadRemoteEthKeys(orm ksORM) (map[string]remoteEthKey, error) {
+	configs, err := orm.loadRemoteSigners()
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[string]remoteEthKey, len(configs))
+	for _, cfg := range configs {
+		signer, err := remotesigner.New(cfg)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid remote signer for key %s", cfg.Address)
+		}
+		key := ethkey.FromAddress(cfg.Address.Address())
+		keys[key.ID()] = remoteEthKey{key, cfg, signer}
+	}
+	return keys, nil
+}
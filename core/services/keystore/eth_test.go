@@ -2,25 +2,33 @@ package keystore_test
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"sort"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/atomic"
+	"gopkg.in/guregu/null.v4"
 
 	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/remotesigner"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -609,6 +617,102 @@ func Test_EthKeyStore_Reset(t *testing.T) {
 	})
 }
 
+func Test_EthKeyStore_SetSpendLimits(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	keyStore := cltest.NewKeyStore(t, db, cfg)
+	ks := keyStore.Eth()
+
+	k1, _ := cltest.MustInsertRandomKey(t, ks, testutils.FixtureChainID)
+
+	t.Run("when no state matches address/chain ID", func(t *testing.T) {
+		err := ks.SetSpendLimits(k1.Address, testutils.NewRandomEVMChainID(), ethkey.SpendLimits{})
+		require.ErrorContains(t, err, "state not found")
+	})
+
+	t.Run("persists the limits", func(t *testing.T) {
+		limits := ethkey.SpendLimits{
+			MaxDailySpend:           utils.NewBigI(1_000_000),
+			MaxInFlightTransactions: null.IntFrom(5),
+			EnabledJobTypes:         []string{"vrf", "keeper"},
+		}
+		require.NoError(t, ks.SetSpendLimits(k1.Address, testutils.FixtureChainID, limits))
+
+		state, err := ks.GetState(k1.Address.Hex(), testutils.FixtureChainID)
+		require.NoError(t, err)
+		assert.Equal(t, limits, state.SpendLimits)
+
+		// reload from the database
+		reloaded := keystore.New(db, utils.FastScryptParams, logger.TestLogger(t), cfg)
+		require.NoError(t, reloaded.Unlock(cltest.Password))
+		state, err = reloaded.Eth().GetState(k1.Address.Hex(), testutils.FixtureChainID)
+		require.NoError(t, err)
+		assert.Equal(t, "1000000", state.MaxDailySpend.String())
+		assert.Equal(t, int64(5), state.MaxInFlightTransactions.Int64)
+		assert.Equal(t, []string{"vrf", "keeper"}, []string(state.EnabledJobTypes))
+		assert.True(t, state.AllowsJobType("vrf"))
+		assert.False(t, state.AllowsJobType("offchainreporting"))
+	})
+
+	t.Run("clears the limits", func(t *testing.T) {
+		require.NoError(t, ks.SetSpendLimits(k1.Address, testutils.FixtureChainID, ethkey.SpendLimits{}))
+
+		state, err := ks.GetState(k1.Address.Hex(), testutils.FixtureChainID)
+		require.NoError(t, err)
+		assert.Nil(t, state.MaxDailySpend)
+		assert.False(t, state.MaxInFlightTransactions.Valid)
+		assert.True(t, state.AllowsJobType("offchainreporting"))
+	})
+}
+
+func Test_EthKeyStore_SetFundingThresholds(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	keyStore := cltest.NewKeyStore(t, db, cfg)
+	ks := keyStore.Eth()
+
+	k1, _ := cltest.MustInsertRandomKey(t, ks, testutils.FixtureChainID)
+
+	t.Run("when no state matches address/chain ID", func(t *testing.T) {
+		err := ks.SetFundingThresholds(k1.Address, testutils.NewRandomEVMChainID(), ethkey.FundingThresholds{})
+		require.ErrorContains(t, err, "state not found")
+	})
+
+	t.Run("persists the thresholds", func(t *testing.T) {
+		thresholds := ethkey.FundingThresholds{
+			LowBalance:      utils.NewBigI(1_000),
+			CriticalBalance: utils.NewBigI(100),
+		}
+		require.NoError(t, ks.SetFundingThresholds(k1.Address, testutils.FixtureChainID, thresholds))
+
+		reloaded := keystore.New(db, utils.FastScryptParams, logger.TestLogger(t), cfg)
+		require.NoError(t, reloaded.Unlock(cltest.Password))
+		state, err := reloaded.Eth().GetState(k1.Address.Hex(), testutils.FixtureChainID)
+		require.NoError(t, err)
+		assert.Equal(t, "1000", state.LowBalance.String())
+		assert.Equal(t, "100", state.CriticalBalance.String())
+		assert.False(t, state.FundingJobID.Valid)
+
+		assert.Equal(t, ethkey.FundingStatusOK, state.Status(big.NewInt(1_001)))
+		assert.Equal(t, ethkey.FundingStatusLow, state.Status(big.NewInt(1_000)))
+		assert.Equal(t, ethkey.FundingStatusCritical, state.Status(big.NewInt(100)))
+		assert.Equal(t, ethkey.FundingStatusUnknown, state.Status(nil))
+	})
+
+	t.Run("clears the thresholds", func(t *testing.T) {
+		require.NoError(t, ks.SetFundingThresholds(k1.Address, testutils.FixtureChainID, ethkey.FundingThresholds{}))
+
+		state, err := ks.GetState(k1.Address.Hex(), testutils.FixtureChainID)
+		require.NoError(t, err)
+		assert.False(t, state.FundingThresholds.IsSet())
+		assert.Equal(t, ethkey.FundingStatusUnknown, state.Status(big.NewInt(0)))
+	})
+}
+
 func Test_GetNextNonce(t *testing.T) {
 	t.Parallel()
 
@@ -724,6 +828,92 @@ func Test_EthKeyStore_Delete(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_EthKeyStore_AddRemote(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	keyStore := cltest.NewKeyStore(t, db, cfg)
+	ks := keyStore.Eth()
+
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(privKey.PublicKey)
+	chainID := testutils.FixtureChainID
+
+	vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Transaction hexutil.Bytes `json:"transaction"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		tx := new(types.Transaction)
+		require.NoError(t, tx.UnmarshalBinary(req.Transaction))
+		signed, err := types.SignTx(tx, types.LatestSignerForChainID(chainID), privKey)
+		require.NoError(t, err)
+		raw, err := signed.MarshalBinary()
+		require.NoError(t, err)
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{"signed_transaction": hexutil.Bytes(raw)},
+		}))
+	}))
+	defer vault.Close()
+	t.Setenv(remotesigner.VaultTokenEnv, "s3cr3t")
+
+	remote := ethkey.RemoteSigner{
+		Address: ethkey.EIP55AddressFromAddress(address),
+		Type:    remotesigner.TypeVault,
+		URL:     vault.URL,
+		KeyName: "node",
+	}
+
+	_, err = ks.AddRemote(ethkey.RemoteSigner{Address: remote.Address, Type: "ledger", URL: vault.URL}, chainID)
+	require.ErrorContains(t, err, `unknown remote signer type "ledger"`)
+
+	key, err := ks.AddRemote(remote, chainID)
+	require.NoError(t, err)
+	assert.Equal(t, address, key.Address)
+	assert.True(t, key.IsRemote())
+	testutils.AssertCount(t, db, "evm_key_remote_signers", 1)
+
+	_, err = ks.AddRemote(remote, chainID)
+	require.ErrorContains(t, err, "already exists")
+
+	stored, err := ks.GetRemoteSigner(address.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, remotesigner.TypeVault, stored.Type)
+	assert.Equal(t, "node", stored.KeyName)
+
+	keys, err := ks.EnabledKeysForChain(chainID)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, address, keys[0].Address)
+
+	_, err = ks.Export(address.Hex(), cltest.Password)
+	require.ErrorContains(t, err, "cannot be exported")
+
+	tx := types.NewTransaction(0, testutils.NewAddress(), big.NewInt(53), 21000, big.NewInt(1000000000), []byte{1, 2, 3, 4})
+	signed, err := ks.SignTx(address, tx, chainID)
+	require.NoError(t, err)
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+	require.NoError(t, err)
+	assert.Equal(t, address, sender)
+
+	t.Run("remote keys are loaded on unlock", func(t *testing.T) {
+		keyStore2 := keystore.New(db, utils.FastScryptParams, logger.TestLogger(t), cfg)
+		require.NoError(t, keyStore2.Unlock(cltest.Password))
+		key2, err := keyStore2.Eth().Get(address.Hex())
+		require.NoError(t, err)
+		assert.True(t, key2.IsRemote())
+		_, err = keyStore2.Eth().SignTx(address, tx, chainID)
+		require.NoError(t, err)
+	})
+
+	_, err = ks.Delete(address.Hex())
+	require.NoError(t, err)
+	testutils.AssertCount(t, db, "evm_key_remote_signers", 0)
+	testutils.AssertCount(t, db, "evm_key_states", 0)
+	_, err = ks.Get(address.Hex())
+	require.Error(t, err)
+}
+
 func Test_EthKeyStore_CheckEnabled(t *testing.T) {
 	t.Parallel()
 
@@ -31,6 +31,8 @@ func (m *master) ExportedSave() error {
 func (m *master) ResetXXXTestOnly() {
 	m.keyRing = newKeyRing()
 	m.keyStates = newKeyStates()
+	m.remoteEthKeys = nil
+	m.roleKeyIDs = nil
 	m.password = ""
 }
 
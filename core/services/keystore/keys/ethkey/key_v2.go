@@ -64,6 +64,20 @@ func FromPrivateKey(privKey *ecdsa.PrivateKey) (key KeyV2) {
 	}
 }
 
+// FromAddress returns a key holding only an address, for keys whose private
+// key is held by a remote signer
+func FromAddress(address common.Address) KeyV2 {
+	return KeyV2{
+		Address:      address,
+		EIP55Address: EIP55AddressFromAddress(address),
+	}
+}
+
+// IsRemote returns true if the private key is not held by the node
+func (key KeyV2) IsRemote() bool {
+	return key.privateKey == nil
+}
+
 func (key KeyV2) ID() string {
 	return key.Address.Hex()
 }
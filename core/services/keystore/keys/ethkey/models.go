@@ -1,8 +1,13 @@
 package ethkey
 
 import (
+	"math/big"
 	"time"
 
+	"github.com/lib/pq"
+	uuid "github.com/satori/go.uuid"
+	"gopkg.in/guregu/null.v4"
+
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -14,11 +19,108 @@ type State struct {
 	// truth is always the DB
 	NextNonce int64
 	Disabled  bool
+	SpendLimits
+	FundingThresholds
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	lastUsed  time.Time
 }
 
+// SpendLimits are optional per key and chain limits enforced by the tx
+// manager when creating transactions from the key
+type SpendLimits struct {
+	// MaxDailySpend caps the wei spent (value plus maximum fees) by
+	// transactions created from the key in the last 24 hours
+	MaxDailySpend *utils.Big
+	// MaxInFlightTransactions caps the number of unconfirmed transactions
+	MaxInFlightTransactions null.Int
+	// EnabledJobTypes restricts the job types allowed to send from the key
+	EnabledJobTypes pq.StringArray
+}
+
+// AllowsJobType returns true if the limits allow transactions from jobs of
+// the given type
+func (l SpendLimits) AllowsJobType(jobType string) bool {
+	if len(l.EnabledJobTypes) == 0 {
+		return true
+	}
+	for _, t := range l.EnabledJobTypes {
+		if t == jobType {
+			return true
+		}
+	}
+	return false
+}
+
+// FundingThresholds are optional per key and chain balance levels watched by
+// the balance monitor
+type FundingThresholds struct {
+	// LowBalance and CriticalBalance are the wei balances at or below which
+	// the key is reported as low or critical
+	LowBalance      *utils.Big
+	CriticalBalance *utils.Big
+	// FundingJobID is the external job ID of a webhook job run to top up the
+	// key when its balance drops to a threshold
+	FundingJobID uuid.NullUUID
+}
+
+type FundingStatus string
+
+const (
+	FundingStatusUnknown  FundingStatus = "unknown"
+	FundingStatusOK       FundingStatus = "ok"
+	FundingStatusLow      FundingStatus = "low"
+	FundingStatusCritical FundingStatus = "critical"
+)
+
+// Severity orders the statuses from unknown (0) to critical (3)
+func (s FundingStatus) Severity() int {
+	switch s {
+	case FundingStatusOK:
+		return 1
+	case FundingStatusLow:
+		return 2
+	case FundingStatusCritical:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// IsSet returns true if any threshold is configured
+func (t FundingThresholds) IsSet() bool {
+	return t.LowBalance != nil || t.CriticalBalance != nil
+}
+
+// Status returns the funding status of balance, which is unknown if balance
+// is nil or no threshold is set
+func (t FundingThresholds) Status(balance *big.Int) FundingStatus {
+	if balance == nil || !t.IsSet() {
+		return FundingStatusUnknown
+	}
+	if t.CriticalBalance != nil && balance.Cmp(t.CriticalBalance.ToInt()) <= 0 {
+		return FundingStatusCritical
+	}
+	if t.LowBalance != nil && balance.Cmp(t.LowBalance.ToInt()) <= 0 {
+		return FundingStatusLow
+	}
+	return FundingStatusOK
+}
+
+// RemoteSigner configures a key whose transactions are signed by an external
+// signer, so that its private key is never held by the node
+type RemoteSigner struct {
+	Address EIP55Address
+	// Type is one of clef, vault or grpc
+	Type string
+	URL  string
+	// KeyName identifies the key on the signer, if the signer does not
+	// address keys by their address
+	KeyName   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
 func (s State) KeyID() string {
 	return s.Address.Hex()
 }
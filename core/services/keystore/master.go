@@ -1,6 +1,7 @@
 package keystore
 
 import (
+	"crypto/subtle"
 	"fmt"
 	"math/big"
 	"reflect"
@@ -27,7 +28,10 @@ import (
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
-var ErrLocked = errors.New("Keystore is locked")
+var (
+	ErrLocked        = errors.New("Keystore is locked")
+	ErrWrongPassword = errors.New("incorrect keystore password")
+)
 
 // DefaultEVMChainIDFunc is a func for getting a default evm chain ID -
 // necessary because it is lazily evaluated
@@ -48,8 +52,13 @@ type Master interface {
 	StarkNet() StarkNet
 	VRF() VRF
 	Unlock(password string) error
+	ChangePassword(oldPassword, newPassword string) error
+	ChangeRolePassword(role Role, oldPassword, newPassword string) error
+	RotateColumnKey() error
+	ColumnCiphers() *pg.ColumnCiphers
 	Migrate(vrfPassword string, f DefaultEVMChainIDFunc) error
 	IsEmpty() (bool, error)
+	IsLocked() bool
 }
 
 type master struct {
@@ -73,10 +82,11 @@ func New(db *sqlx.DB, scryptParams utils.ScryptParams, lggr logger.Logger, cfg p
 
 func newMaster(db *sqlx.DB, scryptParams utils.ScryptParams, lggr logger.Logger, cfg pg.LogConfig) *master {
 	km := &keyManager{
-		orm:          NewORM(db, lggr, cfg),
-		scryptParams: scryptParams,
-		lock:         &sync.RWMutex{},
-		logger:       lggr.Named("KeyStore"),
+		orm:           NewORM(db, lggr, cfg),
+		scryptParams:  scryptParams,
+		lock:          &sync.RWMutex{},
+		logger:        lggr.Named("KeyStore"),
+		columnCiphers: pg.NewColumnCiphers(),
 	}
 
 	return &master{
@@ -240,9 +250,17 @@ type keyManager struct {
 	scryptParams utils.ScryptParams
 	keyRing      *keyRing
 	keyStates    *keyStates
-	lock         *sync.RWMutex
-	password     string
-	logger       logger.Logger
+	// remoteEthKeys are the eth keys signed by remote signers, by key ID
+	remoteEthKeys map[string]remoteEthKey
+	// roleKeyIDs are the key IDs of the role key rings saved, which are
+	// encrypted again only when their keys change
+	roleKeyIDs map[Role]string
+	lock       *sync.RWMutex
+	password   string
+	logger     logger.Logger
+	// columnCiphers encrypt the secrets stored in the database with the
+	// column keys of the key ring
+	columnCiphers *pg.ColumnCiphers
 }
 
 func (km *keyManager) Unlock(password string) error {
@@ -263,6 +281,10 @@ func (km *keyManager) Unlock(password string) error {
 	if err != nil {
 		return errors.Wrap(err, "unable to decrypt encrypted key ring")
 	}
+	roleKeyIDs, err := km.decryptRoleKeyRings(kr)
+	if err != nil {
+		return err
+	}
 	kr.logPubKeys(km.logger)
 	km.keyRing = kr
 
@@ -272,17 +294,192 @@ func (km *keyManager) Unlock(password string) error {
 	}
 	km.keyStates = ks
 
+	remoteEthKeys, err := loadRemoteEthKeys(km.orm)
+	if err != nil {
+		return errors.Wrap(err, "unable to load remote signers")
+	}
+	km.remoteEthKeys = remoteEthKeys
+	km.roleKeyIDs = roleKeyIDs
+
 	km.password = password
+
+	if len(kr.ColumnKeys) == 0 && len(ekr.EncryptedKeys) > 0 {
+		// key rings saved before column encryption have no column key yet
+		err = km.save()
+	} else {
+		err = km.columnCiphers.Register(kr.ColumnKeys...)
+	}
+	if err != nil {
+		km.password = ""
+		return errors.Wrap(err, "unable to load column keys")
+	}
 	return nil
 }
 
+// caller must hold lock!
+func (km *keyManager) decryptRoleKeyRings(kr *keyRing) (map[Role]string, error) {
+	ekrs, err := km.orm.getEncryptedRoleKeyRings()
+	if err != nil {
+		return nil, err
+	}
+	roleKeyIDs := make(map[Role]string)
+	for _, ekr := range ekrs {
+		password, ok := kr.RolePasswords[ekr.Role]
+		if !ok {
+			return nil, errors.Errorf("no password for the key ring of role %s", ekr.Role)
+		}
+		roleRing, err := ekr.Decrypt(password)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to decrypt the key ring of role %s", ekr.Role)
+		}
+		kr.setRole(ekr.Role, roleRing)
+		roleKeyIDs[ekr.Role] = kr.roleKeyIDs(ekr.Role)
+	}
+	return roleKeyIDs, nil
+}
+
+// ChangePassword re-encrypts the keyring with newPassword. The keyring is
+// stored as a single row, so all key material is rotated atomically. The keys
+// of the roles with a password of their own stay encrypted with it, but their
+// passwords are stored in the keyring.
+func (km *keyManager) ChangePassword(oldPassword, newPassword string) error {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	if km.isLocked() {
+		return ErrLocked
+	}
+	if subtle.ConstantTimeCompare([]byte(oldPassword), []byte(km.password)) != 1 {
+		return ErrWrongPassword
+	}
+	if err := utils.VerifyPasswordComplexity(newPassword); err != nil {
+		return err
+	}
+	password := km.password
+	km.password = newPassword
+	if err := km.save(); err != nil {
+		km.password = password
+		return err
+	}
+	km.logger.Info("Keystore password changed")
+	return nil
+}
+
+// ChangeRolePassword encrypts the keys of role with newPassword, in a key ring
+// of their own. oldPassword is the keystore password if the role has no
+// password of its own yet. Unlocking the keystore still unlocks every role.
+func (km *keyManager) ChangeRolePassword(role Role, oldPassword, newPassword string) error {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	if km.isLocked() {
+		return ErrLocked
+	}
+	if _, ok := roleFields[role]; !ok {
+		return errors.Errorf("unknown keystore role %s", role)
+	}
+	password, hasPassword := km.keyRing.RolePasswords[role]
+	if !hasPassword {
+		password = km.password
+	}
+	if subtle.ConstantTimeCompare([]byte(oldPassword), []byte(password)) != 1 {
+		return ErrWrongPassword
+	}
+	if err := utils.VerifyPasswordComplexity(newPassword); err != nil {
+		return err
+	}
+	keyIDs, saved := km.roleKeyIDs[role]
+	km.keyRing.RolePasswords[role] = newPassword
+	// the key ring of the role is encrypted again even if its keys did not change
+	delete(km.roleKeyIDs, role)
+	if err := km.save(); err != nil {
+		if hasPassword {
+			km.keyRing.RolePasswords[role] = password
+		} else {
+			delete(km.keyRing.RolePasswords, role)
+		}
+		if saved {
+			km.roleKeyIDs[role] = keyIDs
+		}
+		return err
+	}
+	km.logger.Infow("Keystore role password changed", "role", role)
+	return nil
+}
+
+// RotateColumnKey adds a new column key and re-encrypts every encrypted
+// column with it, including values still stored as plaintext.
+func (km *keyManager) RotateColumnKey() error {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	if km.isLocked() {
+		return ErrLocked
+	}
+	key, err := pg.NewColumnKey()
+	if err != nil {
+		return err
+	}
+	c, err := pg.NewColumnCipher(key)
+	if err != nil {
+		return err
+	}
+	columnKeys := km.keyRing.ColumnKeys
+	km.keyRing.ColumnKeys = append(columnKeys[:len(columnKeys):len(columnKeys)], key)
+	err = km.save(func(tx pg.Queryer) error {
+		return km.columnCiphers.ReencryptColumns(tx, c)
+	})
+	if err != nil {
+		km.keyRing.ColumnKeys = columnKeys
+		return errors.Wrap(err, "unable to rotate column key")
+	}
+	km.logger.Info("Column key rotated")
+	return nil
+}
+
+// ColumnCiphers returns the ciphers of the column keys, which encrypt the
+// secrets stored in the database once the keystore is unlocked
+func (km *keyManager) ColumnCiphers() *pg.ColumnCiphers {
+	return km.columnCiphers
+}
+
 // caller must hold lock!
 func (km *keyManager) save(callbacks ...func(pg.Queryer) error) error {
+	if len(km.keyRing.ColumnKeys) == 0 {
+		key, err := pg.NewColumnKey()
+		if err != nil {
+			return err
+		}
+		km.keyRing.ColumnKeys = [][]byte{key}
+	}
 	ekb, err := km.keyRing.Encrypt(km.password, km.scryptParams)
 	if err != nil {
 		return errors.Wrap(err, "unable to encrypt keyRing")
 	}
-	return km.orm.saveEncryptedKeyRing(&ekb, callbacks...)
+	// the role key rings are saved in the same transaction as the keyring
+	// holding their passwords
+	roleKeyIDs := make(map[Role]string)
+	var saveCallbacks []func(pg.Queryer) error
+	for _, role := range Roles {
+		if _, ok := km.keyRing.RolePasswords[role]; !ok {
+			continue
+		}
+		keyIDs := km.keyRing.roleKeyIDs(role)
+		roleKeyIDs[role] = keyIDs
+		if saved, ok := km.roleKeyIDs[role]; ok && saved == keyIDs {
+			continue
+		}
+		rekb, err := km.keyRing.EncryptRole(role, km.scryptParams)
+		if err != nil {
+			return errors.Wrapf(err, "unable to encrypt the key ring of role %s", role)
+		}
+		saveCallbacks = append(saveCallbacks, func(tx pg.Queryer) error {
+			return km.orm.saveEncryptedRoleKeyRing(&rekb, pg.WithQueryer(tx))
+		})
+	}
+	saveCallbacks = append(saveCallbacks, callbacks...)
+	if err = km.orm.saveEncryptedKeyRing(&ekb, saveCallbacks...); err != nil {
+		return err
+	}
+	km.roleKeyIDs = roleKeyIDs
+	return km.columnCiphers.Register(km.keyRing.ColumnKeys...)
 }
 
 // caller must hold lock!
@@ -328,6 +525,13 @@ func (km *keyManager) safeRemoveKey(unknownKey Key, callbacks ...func(pg.Queryer
 	return nil
 }
 
+// IsLocked returns true if the keystore has not been unlocked yet
+func (km *keyManager) IsLocked() bool {
+	km.lock.RLock()
+	defer km.lock.RUnlock()
+	return km.isLocked()
+}
+
 // caller must hold lock!
 func (km *keyManager) isLocked() bool {
 	return len(km.password) == 0
@@ -2,12 +2,16 @@ package keystore_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -60,3 +64,125 @@ func TestMasterKeystore_Unlock_Save(t *testing.T) {
 		require.NoError(t, keyStore.Unlock(cltest.Password))
 	})
 }
+
+func TestMasterKeystore_ChangePassword(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	keyStore := keystore.ExposedNewMaster(t, db, cfg)
+	const newPassword = "n3wp4ssw0rdAlsoLongEnough"
+
+	require.ErrorIs(t, keyStore.ChangePassword(cltest.Password, newPassword), keystore.ErrLocked)
+
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+	key, _ := cltest.MustInsertRandomKey(t, keyStore.Eth())
+	vrfKey, err := keyStore.VRF().Create()
+	require.NoError(t, err)
+
+	require.ErrorIs(t, keyStore.ChangePassword("wrong password", newPassword), keystore.ErrWrongPassword)
+	require.ErrorContains(t, keyStore.ChangePassword(cltest.Password, "short"), "password is less than 16 characters long")
+
+	require.NoError(t, keyStore.ChangePassword(cltest.Password, newPassword))
+
+	keyStore.ResetXXXTestOnly()
+	require.Error(t, keyStore.Unlock(cltest.Password))
+	require.NoError(t, keyStore.Unlock(newPassword))
+
+	_, err = keyStore.Eth().Get(key.ID())
+	assert.NoError(t, err)
+	_, err = keyStore.VRF().Get(vrfKey.ID())
+	assert.NoError(t, err)
+}
+
+func TestMasterKeystore_ChangeRolePassword(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	keyStore := keystore.ExposedNewMaster(t, db, cfg)
+	const rolePassword = "r0l3p4ssw0rdAlsoLongEnough"
+	const newPassword = "n3wp4ssw0rdAlsoLongEnough"
+
+	require.ErrorIs(t, keyStore.ChangeRolePassword(keystore.RoleVRF, cltest.Password, rolePassword), keystore.ErrLocked)
+
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+	key, _ := cltest.MustInsertRandomKey(t, keyStore.Eth())
+	vrfKey, err := keyStore.VRF().Create()
+	require.NoError(t, err)
+
+	require.ErrorContains(t, keyStore.ChangeRolePassword("csa", cltest.Password, rolePassword), "unknown keystore role")
+	require.ErrorIs(t, keyStore.ChangeRolePassword(keystore.RoleVRF, "wrong password", rolePassword), keystore.ErrWrongPassword)
+	require.ErrorContains(t, keyStore.ChangeRolePassword(keystore.RoleVRF, cltest.Password, "short"), "password is less than 16 characters long")
+
+	// the keystore password is the password of a role without one
+	require.NoError(t, keyStore.ChangeRolePassword(keystore.RoleVRF, cltest.Password, rolePassword))
+	cltest.AssertCount(t, db, "encrypted_role_key_rings", 1)
+	require.ErrorIs(t, keyStore.ChangeRolePassword(keystore.RoleVRF, cltest.Password, newPassword), keystore.ErrWrongPassword)
+
+	vrfKey2, err := keyStore.VRF().Create()
+	require.NoError(t, err)
+
+	// the keystore password is changed independently
+	require.NoError(t, keyStore.ChangePassword(cltest.Password, newPassword))
+
+	keyStore.ResetXXXTestOnly()
+	require.NoError(t, keyStore.Unlock(newPassword))
+	_, err = keyStore.Eth().Get(key.ID())
+	assert.NoError(t, err)
+	_, err = keyStore.VRF().Get(vrfKey.ID())
+	assert.NoError(t, err)
+	_, err = keyStore.VRF().Get(vrfKey2.ID())
+	assert.NoError(t, err)
+
+	require.NoError(t, keyStore.ChangeRolePassword(keystore.RoleVRF, rolePassword, newPassword+"2"))
+
+	// the VRF keys are not in the keystore key ring anymore
+	_, err = db.Exec(`DELETE FROM encrypted_role_key_rings`)
+	require.NoError(t, err)
+	keyStore.ResetXXXTestOnly()
+	require.NoError(t, keyStore.Unlock(newPassword))
+	_, err = keyStore.Eth().Get(key.ID())
+	assert.NoError(t, err)
+	_, err = keyStore.VRF().Get(vrfKey.ID())
+	assert.Error(t, err)
+}
+
+func TestMasterKeystore_RotateColumnKey(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	keyStore := keystore.ExposedNewMaster(t, db, cfg)
+	outgoingToken := func(name string) (token string) {
+		require.NoError(t, db.Get(&token, `SELECT outgoing_token FROM bridge_types WHERE name = $1`, name))
+		return token
+	}
+
+	require.ErrorIs(t, keyStore.RotateColumnKey(), keystore.ErrLocked)
+
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+	cltest.MustInsertRandomKey(t, keyStore.Eth())
+
+	_, bt := cltest.NewBridgeType(t, cltest.BridgeOpts{})
+	require.NoError(t, bridges.NewORM(db, logger.TestLogger(t), cfg, keyStore.ColumnCiphers()).CreateBridgeType(bt))
+	stored := outgoingToken(bt.Name.String())
+	assert.True(t, strings.HasPrefix(stored, "enc:v1:"))
+	assert.NotContains(t, stored, bt.OutgoingToken)
+
+	require.NoError(t, keyStore.RotateColumnKey())
+	rotated := outgoingToken(bt.Name.String())
+	assert.True(t, strings.HasPrefix(rotated, "enc:v1:"))
+	assert.NotEqual(t, stored, rotated)
+
+	// the column keys are loaded with the key ring
+	restarted := keystore.ExposedNewMaster(t, db, cfg)
+	require.NoError(t, restarted.Unlock(cltest.Password))
+	found, err := bridges.NewORM(db, logger.TestLogger(t), cfg, restarted.ColumnCiphers()).FindBridge(bt.Name)
+	require.NoError(t, err)
+	assert.Equal(t, bt.OutgoingToken, found.OutgoingToken)
+
+	// another keystore cannot decrypt them
+	_, err = bridges.NewORM(db, logger.TestLogger(t), cfg, keystore.ExposedNewMaster(t, db, cfg).ColumnCiphers()).FindBridge(bt.Name)
+	assert.ErrorContains(t, err, "is not available")
+}
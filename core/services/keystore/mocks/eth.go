@@ -20,6 +20,34 @@ type Eth struct {
 	mock.Mock
 }
 
+// AddRemote provides a mock function with given fields: signer, chainIDs
+func (_m *Eth) AddRemote(signer ethkey.RemoteSigner, chainIDs ...*big.Int) (ethkey.KeyV2, error) {
+	_va := make([]interface{}, len(chainIDs))
+	for _i := range chainIDs {
+		_va[_i] = chainIDs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, signer)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 ethkey.KeyV2
+	if rf, ok := ret.Get(0).(func(ethkey.RemoteSigner, ...*big.Int) ethkey.KeyV2); ok {
+		r0 = rf(signer, chainIDs...)
+	} else {
+		r0 = ret.Get(0).(ethkey.KeyV2)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(ethkey.RemoteSigner, ...*big.Int) error); ok {
+		r1 = rf(signer, chainIDs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CheckEnabled provides a mock function with given fields: address, chainID
 func (_m *Eth) CheckEnabled(address common.Address, chainID *big.Int) error {
 	ret := _m.Called(address, chainID)
@@ -262,6 +290,27 @@ func (_m *Eth) GetNextNonce(address common.Address, chainID *big.Int, qopts ...p
 	return r0, r1
 }
 
+// GetRemoteSigner provides a mock function with given fields: id
+func (_m *Eth) GetRemoteSigner(id string) (ethkey.RemoteSigner, error) {
+	ret := _m.Called(id)
+
+	var r0 ethkey.RemoteSigner
+	if rf, ok := ret.Get(0).(func(string) ethkey.RemoteSigner); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(ethkey.RemoteSigner)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRoundRobinAddress provides a mock function with given fields: chainID, addresses
 func (_m *Eth) GetRoundRobinAddress(chainID *big.Int, addresses ...common.Address) (common.Address, error) {
 	_va := make([]interface{}, len(addresses))
@@ -429,6 +478,48 @@ func (_m *Eth) Reset(address common.Address, chainID *big.Int, nonce int64, qopt
 	return r0
 }
 
+// SetFundingThresholds provides a mock function with given fields: address, chainID, thresholds, qopts
+func (_m *Eth) SetFundingThresholds(address common.Address, chainID *big.Int, thresholds ethkey.FundingThresholds, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, address, chainID, thresholds)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(common.Address, *big.Int, ethkey.FundingThresholds, ...pg.QOpt) error); ok {
+		r0 = rf(address, chainID, thresholds, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetSpendLimits provides a mock function with given fields: address, chainID, limits, qopts
+func (_m *Eth) SetSpendLimits(address common.Address, chainID *big.Int, limits ethkey.SpendLimits, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, address, chainID, limits)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(common.Address, *big.Int, ethkey.SpendLimits, ...pg.QOpt) error); ok {
+		r0 = rf(address, chainID, limits, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SignTx provides a mock function with given fields: fromAddress, tx, chainID
 func (_m *Eth) SignTx(fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
 	ret := _m.Called(fromAddress, tx, chainID)
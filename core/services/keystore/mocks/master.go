@@ -5,6 +5,8 @@ package mocks
 import (
 	keystore "github.com/smartcontractkit/chainlink/core/services/keystore"
 	mock "github.com/stretchr/testify/mock"
+
+	pg "github.com/smartcontractkit/chainlink/core/services/pg"
 )
 
 // Master is an autogenerated mock type for the Master type
@@ -28,6 +30,50 @@ func (_m *Master) CSA() keystore.CSA {
 	return r0
 }
 
+// ChangePassword provides a mock function with given fields: oldPassword, newPassword
+func (_m *Master) ChangePassword(oldPassword string, newPassword string) error {
+	ret := _m.Called(oldPassword, newPassword)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(oldPassword, newPassword)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ChangeRolePassword provides a mock function with given fields: role, oldPassword, newPassword
+func (_m *Master) ChangeRolePassword(role keystore.Role, oldPassword string, newPassword string) error {
+	ret := _m.Called(role, oldPassword, newPassword)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(keystore.Role, string, string) error); ok {
+		r0 = rf(role, oldPassword, newPassword)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ColumnCiphers provides a mock function with given fields:
+func (_m *Master) ColumnCiphers() *pg.ColumnCiphers {
+	ret := _m.Called()
+
+	var r0 *pg.ColumnCiphers
+	if rf, ok := ret.Get(0).(func() *pg.ColumnCiphers); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pg.ColumnCiphers)
+		}
+	}
+
+	return r0
+}
+
 // DKGEncrypt provides a mock function with given fields:
 func (_m *Master) DKGEncrypt() keystore.DKGEncrypt {
 	ret := _m.Called()
@@ -97,6 +143,20 @@ func (_m *Master) IsEmpty() (bool, error) {
 	return r0, r1
 }
 
+// IsLocked provides a mock function with given fields:
+func (_m *Master) IsLocked() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // Migrate provides a mock function with given fields: vrfPassword, f
 func (_m *Master) Migrate(vrfPassword string, f keystore.DefaultEVMChainIDFunc) error {
 	ret := _m.Called(vrfPassword, f)
@@ -159,6 +219,20 @@ func (_m *Master) P2P() keystore.P2P {
 	return r0
 }
 
+// RotateColumnKey provides a mock function with given fields:
+func (_m *Master) RotateColumnKey() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Solana provides a mock function with given fields:
 func (_m *Master) Solana() keystore.Solana {
 	ret := _m.Called()
@@ -33,6 +33,10 @@ type encryptedKeyRing struct {
 }
 
 func (ekr encryptedKeyRing) Decrypt(password string) (*keyRing, error) {
+	return ekr.decrypt(adulteratedPassword(password))
+}
+
+func (ekr encryptedKeyRing) decrypt(adulteratedPassword string) (*keyRing, error) {
 	if len(ekr.EncryptedKeys) == 0 {
 		return newKeyRing(), nil
 	}
@@ -41,7 +45,7 @@ func (ekr encryptedKeyRing) Decrypt(password string) (*keyRing, error) {
 	if err != nil {
 		return nil, err
 	}
-	marshalledRawKeyRingJson, err := gethkeystore.DecryptDataV3(cryptoJSON, adulteratedPassword(password))
+	marshalledRawKeyRingJson, err := gethkeystore.DecryptDataV3(cryptoJSON, adulteratedPassword)
 	if err != nil {
 		return nil, err
 	}
@@ -147,45 +151,66 @@ type keyRing struct {
 	VRF        map[string]vrfkey.KeyV2
 	DKGSign    map[string]dkgsignkey.Key
 	DKGEncrypt map[string]dkgencryptkey.Key
+	// ColumnKeys encrypt secrets stored in the database, the last one being
+	// active. Previous keys are kept to decrypt values written during rotation.
+	ColumnKeys [][]byte
+	// RolePasswords are the passwords of the roles whose keys are encrypted
+	// in a key ring of their own
+	RolePasswords map[Role]string
 }
 
 func newKeyRing() *keyRing {
 	return &keyRing{
-		CSA:        make(map[string]csakey.KeyV2),
-		Eth:        make(map[string]ethkey.KeyV2),
-		OCR:        make(map[string]ocrkey.KeyV2),
-		OCR2:       make(map[string]ocr2key.KeyBundle),
-		P2P:        make(map[string]p2pkey.KeyV2),
-		Solana:     make(map[string]solkey.Key),
-		Terra:      make(map[string]terrakey.Key),
-		StarkNet:   make(map[string]starkkey.Key),
-		VRF:        make(map[string]vrfkey.KeyV2),
-		DKGSign:    make(map[string]dkgsignkey.Key),
-		DKGEncrypt: make(map[string]dkgencryptkey.Key),
+		CSA:           make(map[string]csakey.KeyV2),
+		Eth:           make(map[string]ethkey.KeyV2),
+		OCR:           make(map[string]ocrkey.KeyV2),
+		OCR2:          make(map[string]ocr2key.KeyBundle),
+		P2P:           make(map[string]p2pkey.KeyV2),
+		Solana:        make(map[string]solkey.Key),
+		Terra:         make(map[string]terrakey.Key),
+		StarkNet:      make(map[string]starkkey.Key),
+		VRF:           make(map[string]vrfkey.KeyV2),
+		DKGSign:       make(map[string]dkgsignkey.Key),
+		DKGEncrypt:    make(map[string]dkgencryptkey.Key),
+		RolePasswords: make(map[Role]string),
 	}
 }
 
+// Encrypt encrypts the key ring with password, except the keys of the roles
+// with a password of their own, which are encrypted by EncryptRole
 func (kr *keyRing) Encrypt(password string, scryptParams utils.ScryptParams) (ekr encryptedKeyRing, err error) {
-	marshalledRawKeyRingJson, err := json.Marshal(kr.raw())
+	rawKeys := kr.raw()
+	for role := range kr.RolePasswords {
+		rawKeys = rawKeys.withoutRole(role)
+	}
+	encryptedKeys, err := encryptRawKeyRing(rawKeys, adulteratedPassword(password), scryptParams)
 	if err != nil {
 		return ekr, err
 	}
+	return encryptedKeyRing{
+		EncryptedKeys: encryptedKeys,
+	}, nil
+}
+
+func encryptRawKeyRing(rawKeys rawKeyRing, adulteratedPassword string, scryptParams utils.ScryptParams) ([]byte, error) {
+	marshalledRawKeyRingJson, err := json.Marshal(rawKeys)
+	if err != nil {
+		return nil, err
+	}
 	cryptoJSON, err := gethkeystore.EncryptDataV3(
 		marshalledRawKeyRingJson,
-		[]byte(adulteratedPassword(password)),
+		[]byte(adulteratedPassword),
 		scryptParams.N,
 		scryptParams.P,
 	)
 	if err != nil {
-		return ekr, errors.Wrapf(err, "could not encrypt key ring")
+		return nil, errors.Wrapf(err, "could not encrypt key ring")
 	}
 	encryptedKeys, err := json.Marshal(&cryptoJSON)
 	if err != nil {
-		return ekr, errors.Wrapf(err, "could not encode cryptoJSON")
+		return nil, errors.Wrapf(err, "could not encode cryptoJSON")
 	}
-	return encryptedKeyRing{
-		EncryptedKeys: encryptedKeys,
-	}, nil
+	return encryptedKeys, nil
 }
 
 func (kr *keyRing) raw() (rawKeys rawKeyRing) {
@@ -222,6 +247,8 @@ func (kr *keyRing) raw() (rawKeys rawKeyRing) {
 	for _, dkgEncryptKey := range kr.DKGEncrypt {
 		rawKeys.DKGEncrypt = append(rawKeys.DKGEncrypt, dkgEncryptKey.Raw())
 	}
+	rawKeys.ColumnKeys = kr.ColumnKeys
+	rawKeys.RolePasswords = kr.RolePasswords
 	return rawKeys
 }
 
@@ -310,17 +337,19 @@ func (kr *keyRing) logPubKeys(lggr logger.Logger) {
 // it holds only the essential key information to avoid adding unnecessary data
 // (like public keys) to the database
 type rawKeyRing struct {
-	Eth        []ethkey.Raw
-	CSA        []csakey.Raw
-	OCR        []ocrkey.Raw
-	OCR2       []ocr2key.Raw
-	P2P        []p2pkey.Raw
-	Solana     []solkey.Raw
-	Terra      []terrakey.Raw
-	StarkNet   []starkkey.Raw
-	VRF        []vrfkey.Raw
-	DKGSign    []dkgsignkey.Raw
-	DKGEncrypt []dkgencryptkey.Raw
+	Eth           []ethkey.Raw
+	CSA           []csakey.Raw
+	OCR           []ocrkey.Raw
+	OCR2          []ocr2key.Raw
+	P2P           []p2pkey.Raw
+	Solana        []solkey.Raw
+	Terra         []terrakey.Raw
+	StarkNet      []starkkey.Raw
+	VRF           []vrfkey.Raw
+	DKGSign       []dkgsignkey.Raw
+	DKGEncrypt    []dkgencryptkey.Raw
+	ColumnKeys    [][]byte
+	RolePasswords map[Role]string `json:",omitempty"`
 }
 
 func (rawKeys rawKeyRing) keys() (*keyRing, error) {
@@ -369,6 +398,10 @@ func (rawKeys rawKeyRing) keys() (*keyRing, error) {
 		dkgEncryptKey := rawDKGEncryptKey.Key()
 		keyRing.DKGEncrypt[dkgEncryptKey.ID()] = dkgEncryptKey
 	}
+	keyRing.ColumnKeys = rawKeys.ColumnKeys
+	for role, password := range rawKeys.RolePasswords {
+		keyRing.RolePasswords[role] = password
+	}
 	return keyRing, nil
 }
 
@@ -64,10 +64,22 @@ func (orm ksORM) getEncryptedKeyRing() (kr encryptedKeyRing, err error) {
 	return kr, nil
 }
 
+func (orm ksORM) getEncryptedRoleKeyRings() (krs []encryptedRoleKeyRing, err error) {
+	err = orm.q.Select(&krs, `SELECT role, encrypted_keys, updated_at FROM encrypted_role_key_rings ORDER BY role`)
+	return krs, errors.Wrap(err, "error loading encrypted_role_key_rings from DB")
+}
+
+func (orm ksORM) saveEncryptedRoleKeyRing(kr *encryptedRoleKeyRing, qopts ...pg.QOpt) error {
+	q := orm.q.WithOpts(qopts...)
+	_, err := q.Exec(`INSERT INTO encrypted_role_key_rings (role, encrypted_keys, updated_at) VALUES ($1, $2, NOW())
+ON CONFLICT (role) DO UPDATE SET encrypted_keys = EXCLUDED.encrypted_keys, updated_at = EXCLUDED.updated_at`, kr.Role, kr.EncryptedKeys)
+	return errors.Wrapf(err, "while saving the key ring of role %s", kr.Role)
+}
+
 func (orm ksORM) loadKeyStates() (*keyStates, error) {
 	ks := newKeyStates()
 	var ethkeystates []*ethkey.State
-	if err := orm.q.Select(&ethkeystates, `SELECT id, address, evm_chain_id, next_nonce, disabled, created_at, updated_at FROM evm_key_states`); err != nil {
+	if err := orm.q.Select(&ethkeystates, `SELECT id, address, evm_chain_id, next_nonce, disabled, max_daily_spend, max_in_flight_transactions, enabled_job_types, low_balance, critical_balance, funding_job_id, created_at, updated_at FROM evm_key_states`); err != nil {
 		return ks, errors.Wrap(err, "error loading evm_key_states from DB")
 	}
 	for _, state := range ethkeystates {
@@ -76,6 +88,18 @@ func (orm ksORM) loadKeyStates() (*keyStates, error) {
 	return ks, nil
 }
 
+func (orm ksORM) loadRemoteSigners() (signers []ethkey.RemoteSigner, err error) {
+	err = orm.q.Select(&signers, `SELECT address, type, url, key_name, created_at, updated_at FROM evm_key_remote_signers`)
+	return signers, errors.Wrap(err, "error loading evm_key_remote_signers from DB")
+}
+
+func (orm ksORM) insertRemoteSigner(signer *ethkey.RemoteSigner, qopts ...pg.QOpt) error {
+	q := orm.q.WithOpts(qopts...)
+	err := q.Get(signer, `INSERT INTO evm_key_remote_signers (address, type, url, key_name, created_at, updated_at)
+VALUES ($1, $2, $3, $4, NOW(), NOW()) RETURNING address, type, url, key_name, created_at, updated_at`, signer.Address, signer.Type, signer.URL, signer.KeyName)
+	return errors.Wrap(err, "failed to insert evm_key_remote_signer")
+}
+
 // getNextNonce returns evm_key_states.next_nonce for the given address
 func (orm ksORM) getNextNonce(address common.Address, chainID *big.Int, qopts ...pg.QOpt) (nonce int64, err error) {
 	q := orm.q.WithOpts(qopts...)
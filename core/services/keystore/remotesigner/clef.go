@@ -0,0 +1,87 @@
+package remotesigner
+
+import (
+	"context"
+	"math/big"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+// clefSigner signs transactions with clef's account_signTransaction
+// JSON-RPC method. The key is addressed by its address.
+type clefSigner struct {
+	client *rpc.Client
+}
+
+var _ Signer = (*clefSigner)(nil)
+
+type clefSendTxArgs struct {
+	From                 common.Address    `json:"from"`
+	To                   *common.Address   `json:"to,omitempty"`
+	Gas                  hexutil.Uint64    `json:"gas"`
+	GasPrice             *hexutil.Big      `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big      `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big      `json:"maxPriorityFeePerGas,omitempty"`
+	Value                hexutil.Big       `json:"value"`
+	Nonce                hexutil.Uint64    `json:"nonce"`
+	Data                 hexutil.Bytes     `json:"data"`
+	AccessList           *types.AccessList `json:"accessList,omitempty"`
+	ChainID              *hexutil.Big      `json:"chainId"`
+}
+
+type clefSignTxResult struct {
+	Raw hexutil.Bytes `json:"raw"`
+}
+
+func newClefSigner(u *url.URL) (*clefSigner, error) {
+	client, err := rpc.DialHTTP(u.String())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create clef client")
+	}
+	return &clefSigner{client}, nil
+}
+
+func (s *clefSigner) SignTx(ctx context.Context, address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	args := clefSendTxArgs{
+		From:    address,
+		To:      tx.To(),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   hexutil.Big(*tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    tx.Data(),
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	switch tx.Type() {
+	case types.DynamicFeeTxType:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+		accessList := tx.AccessList()
+		args.AccessList = &accessList
+	case types.AccessListTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+		accessList := tx.AccessList()
+		args.AccessList = &accessList
+	default:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	}
+
+	var result clefSignTxResult
+	if err := s.client.CallContext(ctx, &result, "account_signTransaction", args); err != nil {
+		return nil, errors.Wrap(err, "clef failed to sign transaction")
+	}
+	signed, err := decodeSignedTx(result.Raw)
+	if err != nil {
+		return nil, err
+	}
+	return verifySignedTx(address, tx, signed, chainID)
+}
+
+func (s *clefSigner) Close() error {
+	s.client.Close()
+	return nil
+}
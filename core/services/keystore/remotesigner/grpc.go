@@ -0,0 +1,85 @@
+package remotesigner
+
+import (
+	"context"
+	"crypto/tls"
+	"math/big"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// GRPCSignTransactionMethod is the full name of the unary method called on
+// gRPC signer services. Both its request and response are
+// google.protobuf.Struct messages: the request holds the address, keyName,
+// chainId and the hex encoded unsigned transaction, and the response holds
+// the hex encoded signedTransaction.
+const GRPCSignTransactionMethod = "/chainlink.remotesigner.v1.Signer/SignTransaction"
+
+// grpcSigner signs transactions with a gRPC signer service. The url scheme is
+// grpcs for TLS connections and grpc otherwise.
+type grpcSigner struct {
+	conn    *grpc.ClientConn
+	keyName string
+}
+
+var _ Signer = (*grpcSigner)(nil)
+
+func newGRPCSigner(u *url.URL, keyName string) (*grpcSigner, error) {
+	var creds credentials.TransportCredentials
+	switch u.Scheme {
+	case "grpcs":
+		creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	case "grpc":
+		creds = insecure.NewCredentials()
+	default:
+		return nil, errors.Errorf("invalid grpc signer url scheme %q, must be grpc or grpcs", u.Scheme)
+	}
+	// Dial does not block, the connection is established on first use
+	conn, err := grpc.Dial(u.Host, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create grpc signer connection")
+	}
+	return &grpcSigner{conn, keyName}, nil
+}
+
+func (s *grpcSigner) SignTx(ctx context.Context, address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	unsigned, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode transaction")
+	}
+	req, err := structpb.NewStruct(map[string]interface{}{
+		"address":     address.Hex(),
+		"keyName":     s.keyName,
+		"chainId":     chainID.String(),
+		"transaction": hexutil.Encode(unsigned),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(structpb.Struct)
+	if err = s.conn.Invoke(ctx, GRPCSignTransactionMethod, req, resp); err != nil {
+		return nil, errors.Wrap(err, "grpc signer failed to sign transaction")
+	}
+	b, err := hexutil.Decode(resp.GetFields()["signedTransaction"].GetStringValue())
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid signedTransaction in grpc signer response")
+	}
+	signed, err := decodeSignedTx(b)
+	if err != nil {
+		return nil, err
+	}
+	return verifySignedTx(address, tx, signed, chainID)
+}
+
+func (s *grpcSigner) Close() error {
+	return s.conn.Close()
+}
@@ -0,0 +1,72 @@
+package remotesigner
+
+import (
+	"context"
+	"math/big"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+)
+
+const (
+	TypeClef  = "clef"
+	TypeVault = "vault"
+	TypeGRPC  = "grpc"
+)
+
+// Signer signs transactions for keys whose private key is held outside of
+// the node's keystore
+type Signer interface {
+	SignTx(ctx context.Context, address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	Close() error
+}
+
+// New returns the Signer for the given configuration
+func New(cfg ethkey.RemoteSigner) (Signer, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid remote signer url")
+	}
+	if u.Host == "" {
+		return nil, errors.Errorf("invalid remote signer url %q: missing host", cfg.URL)
+	}
+	switch cfg.Type {
+	case TypeClef:
+		return newClefSigner(u)
+	case TypeVault:
+		return newVaultSigner(u, cfg.KeyName), nil
+	case TypeGRPC:
+		return newGRPCSigner(u, cfg.KeyName)
+	default:
+		return nil, errors.Errorf("unknown remote signer type %q, must be one of %s, %s or %s", cfg.Type, TypeClef, TypeVault, TypeGRPC)
+	}
+}
+
+// verifySignedTx checks that the remote signer signed the requested
+// transaction with the requested key
+func verifySignedTx(address common.Address, tx, signed *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	signer := types.LatestSignerForChainID(chainID)
+	if signer.Hash(signed) != signer.Hash(tx) {
+		return nil, errors.New("remote signer returned a different transaction than the one requested")
+	}
+	sender, err := types.Sender(signer, signed)
+	if err != nil {
+		return nil, errors.Wrap(err, "remote signer returned an invalid signature")
+	}
+	if sender != address {
+		return nil, errors.Errorf("remote signer signed with %s, expected %s", sender.Hex(), address.Hex())
+	}
+	return signed, nil
+}
+
+func decodeSignedTx(b []byte) (*types.Transaction, error) {
+	signed := new(types.Transaction)
+	if err := signed.UnmarshalBinary(b); err != nil {
+		return nil, errors.Wrap(err, "failed to decode signed transaction")
+	}
+	return signed, nil
+}
@@ -0,0 +1,198 @@
+package remotesigner_test
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/remotesigner"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	address := ethkey.EIP55AddressFromAddress(testutils.NewAddress())
+	tests := []struct {
+		name  string
+		cfg   ethkey.RemoteSigner
+		error string
+	}{
+		{"clef", ethkey.RemoteSigner{Address: address, Type: remotesigner.TypeClef, URL: "http://localhost:8550"}, ""},
+		{"vault", ethkey.RemoteSigner{Address: address, Type: remotesigner.TypeVault, URL: "https://vault:8200/v1/ethereum/sign", KeyName: "node"}, ""},
+		{"grpc", ethkey.RemoteSigner{Address: address, Type: remotesigner.TypeGRPC, URL: "grpcs://signer:443"}, ""},
+		{"grpc bad scheme", ethkey.RemoteSigner{Address: address, Type: remotesigner.TypeGRPC, URL: "http://signer:443"}, `invalid grpc signer url scheme "http"`},
+		{"missing host", ethkey.RemoteSigner{Address: address, Type: remotesigner.TypeClef, URL: "localhost"}, "missing host"},
+		{"unknown type", ethkey.RemoteSigner{Address: address, Type: "ledger", URL: "http://localhost:8550"}, `unknown remote signer type "ledger"`},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			signer, err := remotesigner.New(tt.cfg)
+			if tt.error != "" {
+				require.ErrorContains(t, err, tt.error)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, signer.Close())
+		})
+	}
+}
+
+func TestClefSigner_SignTx(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	chainID := big.NewInt(1337)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+			Params []struct {
+				From                 common.Address  `json:"from"`
+				To                   *common.Address `json:"to"`
+				Gas                  hexutil.Uint64  `json:"gas"`
+				GasPrice             *hexutil.Big    `json:"gasPrice"`
+				MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas"`
+				MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas"`
+				Value                hexutil.Big     `json:"value"`
+				Nonce                hexutil.Uint64  `json:"nonce"`
+				Data                 hexutil.Bytes   `json:"data"`
+				ChainID              *hexutil.Big    `json:"chainId"`
+			} `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, "account_signTransaction", req.Method)
+		args := req.Params[0]
+		assert.Equal(t, chainID, args.ChainID.ToInt())
+
+		var tx *types.Transaction
+		if args.MaxFeePerGas != nil {
+			tx = types.NewTx(&types.DynamicFeeTx{
+				ChainID: args.ChainID.ToInt(), Nonce: uint64(args.Nonce), GasTipCap: args.MaxPriorityFeePerGas.ToInt(), GasFeeCap: args.MaxFeePerGas.ToInt(),
+				Gas: uint64(args.Gas), To: args.To, Value: args.Value.ToInt(), Data: args.Data,
+			})
+		} else {
+			tx = types.NewTx(&types.LegacyTx{
+				Nonce: uint64(args.Nonce), GasPrice: args.GasPrice.ToInt(), Gas: uint64(args.Gas), To: args.To, Value: args.Value.ToInt(), Data: args.Data,
+			})
+		}
+		raw := mustSign(t, tx, args.ChainID.ToInt(), key)
+
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"result":  map[string]interface{}{"raw": hexutil.Bytes(raw)},
+		}))
+	}))
+	defer server.Close()
+
+	signer, err := remotesigner.New(ethkey.RemoteSigner{Address: ethkey.EIP55AddressFromAddress(address), Type: remotesigner.TypeClef, URL: server.URL})
+	require.NoError(t, err)
+	defer signer.Close()
+
+	to := testutils.NewAddress()
+	txs := map[string]*types.Transaction{
+		"legacy": types.NewTransaction(3, to, big.NewInt(53), 21000, big.NewInt(1000000000), []byte{1, 2, 3, 4}),
+		"dynamic fee": types.NewTx(&types.DynamicFeeTx{
+			ChainID: chainID, Nonce: 4, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(100), Gas: 21000, To: &to, Value: big.NewInt(53),
+		}),
+	}
+	for name, tx := range txs {
+		tx := tx
+		t.Run(name, func(t *testing.T) {
+			signed, err := signer.SignTx(testutils.Context(t), address, tx, chainID)
+			require.NoError(t, err)
+			sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+			require.NoError(t, err)
+			assert.Equal(t, address, sender)
+			assert.Equal(t, tx.Nonce(), signed.Nonce())
+		})
+	}
+
+	t.Run("signed by another key", func(t *testing.T) {
+		other := testutils.NewAddress()
+		_, err := signer.SignTx(testutils.Context(t), other, txs["legacy"], chainID)
+		require.ErrorContains(t, err, "remote signer signed with")
+	})
+}
+
+func TestVaultSigner_SignTx(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	chainID := big.NewInt(1337)
+
+	server := newFakeVault(t, key, "s3cr3t")
+	defer server.Close()
+
+	signer, err := remotesigner.New(ethkey.RemoteSigner{Address: ethkey.EIP55AddressFromAddress(address), Type: remotesigner.TypeVault, URL: server.URL, KeyName: "node"})
+	require.NoError(t, err)
+	defer signer.Close()
+
+	tx := types.NewTransaction(0, testutils.NewAddress(), big.NewInt(53), 21000, big.NewInt(1000000000), []byte{1, 2, 3, 4})
+
+	t.Setenv(remotesigner.VaultTokenEnv, "")
+	_, err = signer.SignTx(testutils.Context(t), address, tx, chainID)
+	require.ErrorContains(t, err, "VAULT_TOKEN must be set")
+
+	t.Setenv(remotesigner.VaultTokenEnv, "wrong")
+	_, err = signer.SignTx(testutils.Context(t), address, tx, chainID)
+	require.ErrorContains(t, err, "status 403: permission denied")
+
+	t.Setenv(remotesigner.VaultTokenEnv, "s3cr3t")
+	signed, err := signer.SignTx(testutils.Context(t), address, tx, chainID)
+	require.NoError(t, err)
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+	require.NoError(t, err)
+	assert.Equal(t, address, sender)
+}
+
+// newFakeVault returns a server implementing the vault sign endpoint for key
+func newFakeVault(t *testing.T, key *ecdsa.PrivateKey, token string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != token {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+		var req struct {
+			ChainID     string        `json:"chain_id"`
+			Transaction hexutil.Bytes `json:"transaction"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		chainID, ok := new(big.Int).SetString(req.ChainID, 10)
+		require.True(t, ok)
+		tx := new(types.Transaction)
+		require.NoError(t, tx.UnmarshalBinary(req.Transaction))
+
+		raw := mustSign(t, tx, chainID, key)
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{"signed_transaction": hexutil.Bytes(raw)},
+		}))
+	}))
+}
+
+func mustSign(t *testing.T, tx *types.Transaction, chainID *big.Int, key *ecdsa.PrivateKey) []byte {
+	signed, err := types.SignTx(tx, types.LatestSignerForChainID(chainID), key)
+	require.NoError(t, err)
+	raw, err := signed.MarshalBinary()
+	require.NoError(t, err)
+	return raw
+}
@@ -0,0 +1,106 @@
+package remotesigner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+// VaultTokenEnv names the environment variable holding the token used to
+// authenticate against Vault
+const VaultTokenEnv = "VAULT_TOKEN"
+
+// vaultSigner signs transactions through a HashiCorp Vault secrets engine
+// holding the key. The url is the engine's sign endpoint, which receives
+//
+//	{"address": "0x...", "key_name": "...", "chain_id": "1", "transaction": "0x<unsigned RLP>"}
+//
+// and responds with
+//
+//	{"data": {"signed_transaction": "0x<signed RLP>"}}
+type vaultSigner struct {
+	url     string
+	keyName string
+	client  *http.Client
+}
+
+var _ Signer = (*vaultSigner)(nil)
+
+type vaultSignRequest struct {
+	Address     common.Address `json:"address"`
+	KeyName     string         `json:"key_name,omitempty"`
+	ChainID     string         `json:"chain_id"`
+	Transaction hexutil.Bytes  `json:"transaction"`
+}
+
+type vaultSignResponse struct {
+	Data struct {
+		SignedTransaction hexutil.Bytes `json:"signed_transaction"`
+	} `json:"data"`
+	Errors []string `json:"errors"`
+}
+
+func newVaultSigner(u *url.URL, keyName string) *vaultSigner {
+	return &vaultSigner{u.String(), keyName, &http.Client{}}
+}
+
+func (s *vaultSigner) SignTx(ctx context.Context, address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	token := os.Getenv(VaultTokenEnv)
+	if token == "" {
+		return nil, errors.Errorf("%s must be set to sign with vault", VaultTokenEnv)
+	}
+	unsigned, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode transaction")
+	}
+	body, err := json.Marshal(vaultSignRequest{address, s.keyName, chainID.String(), unsigned})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Vault-Token", token)
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "vault request failed")
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read vault response")
+	}
+	var result vaultSignResponse
+	if err = json.Unmarshal(respBody, &result); err != nil && resp.StatusCode == http.StatusOK {
+		return nil, errors.Wrap(err, "failed to decode vault response")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("vault failed to sign transaction: status %d: %s", resp.StatusCode, strings.Join(result.Errors, "; "))
+	}
+
+	signed, err := decodeSignedTx(result.Data.SignedTransaction)
+	if err != nil {
+		return nil, err
+	}
+	return verifySignedTx(address, tx, signed, chainID)
+}
+
+func (s *vaultSigner) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,119 @@
+package keystore
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// Role groups the key types which can be encrypted with a password of their
+// own, instead of the keystore password
+type Role string
+
+const (
+	// RoleTransmitter holds the keys sending transactions: EVM, Solana, Terra
+	// and StarkNet keys
+	RoleTransmitter Role = "transmitter"
+	// RoleOCR holds the OCR, OCR2 and DKG keys
+	RoleOCR Role = "ocr"
+	// RoleVRF holds the VRF keys
+	RoleVRF Role = "vrf"
+	// RoleP2P holds the P2P keys
+	RoleP2P Role = "p2p"
+)
+
+// Roles are all the roles, CSA keys and column keys always being encrypted
+// with the keystore password
+var Roles = []Role{RoleTransmitter, RoleOCR, RoleVRF, RoleP2P}
+
+// roleFields are the fields of keyRing and rawKeyRing holding the keys of
+// each role
+var roleFields = map[Role][]string{
+	RoleTransmitter: {"Eth", "Solana", "Terra", "StarkNet"},
+	RoleOCR:         {"OCR", "OCR2", "DKGSign", "DKGEncrypt"},
+	RoleVRF:         {"VRF"},
+	RoleP2P:         {"P2P"},
+}
+
+// ParseRole returns the role named s
+func ParseRole(s string) (Role, error) {
+	for _, role := range Roles {
+		if string(role) == s {
+			return role, nil
+		}
+	}
+	return "", fmt.Errorf("unknown keystore role %q, must be one of %v", s, Roles)
+}
+
+// encryptedRoleKeyRing holds the keys of a role with a password of its own
+type encryptedRoleKeyRing struct {
+	Role          Role
+	EncryptedKeys []byte
+	UpdatedAt     time.Time
+}
+
+func (ekr encryptedRoleKeyRing) Decrypt(password string) (*keyRing, error) {
+	return encryptedKeyRing{EncryptedKeys: ekr.EncryptedKeys}.decrypt(adulteratedRolePassword(ekr.Role, password))
+}
+
+// onlyRole returns the raw keys of role
+func (rawKeys rawKeyRing) onlyRole(role Role) (roleKeys rawKeyRing) {
+	src := reflect.ValueOf(rawKeys)
+	dst := reflect.ValueOf(&roleKeys).Elem()
+	for _, field := range roleFields[role] {
+		dst.FieldByName(field).Set(src.FieldByName(field))
+	}
+	return roleKeys
+}
+
+// withoutRole returns the raw keys, except those of role
+func (rawKeys rawKeyRing) withoutRole(role Role) rawKeyRing {
+	v := reflect.ValueOf(&rawKeys).Elem()
+	for _, field := range roleFields[role] {
+		f := v.FieldByName(field)
+		f.Set(reflect.Zero(f.Type()))
+	}
+	return rawKeys
+}
+
+// setRole replaces the keys of role by those of roleRing
+func (kr *keyRing) setRole(role Role, roleRing *keyRing) {
+	dst := reflect.ValueOf(kr).Elem()
+	src := reflect.ValueOf(roleRing).Elem()
+	for _, field := range roleFields[role] {
+		dst.FieldByName(field).Set(src.FieldByName(field))
+	}
+}
+
+// roleKeyIDs returns the sorted IDs of the keys of role, identifying the
+// content of its key ring
+func (kr *keyRing) roleKeyIDs(role Role) string {
+	v := reflect.ValueOf(kr).Elem()
+	var ids []string
+	for _, field := range roleFields[role] {
+		for _, id := range v.FieldByName(field).MapKeys() {
+			ids = append(ids, field+"/"+id.String())
+		}
+	}
+	sort.Strings(ids)
+	return strings.Join(ids, ",")
+}
+
+// EncryptRole encrypts the keys of role with its password
+func (kr *keyRing) EncryptRole(role Role, scryptParams utils.ScryptParams) (ekr encryptedRoleKeyRing, err error) {
+	encryptedKeys, err := encryptRawKeyRing(kr.raw().onlyRole(role), adulteratedRolePassword(role, kr.RolePasswords[role]), scryptParams)
+	if err != nil {
+		return ekr, err
+	}
+	return encryptedRoleKeyRing{Role: role, EncryptedKeys: encryptedKeys}, nil
+}
+
+// adulteratedRolePassword prevents the password of a role from decrypting the
+// keys of another role, or the master key ring
+func adulteratedRolePassword(role Role, password string) string {
+	return string(role) + "-password-" + password
+}
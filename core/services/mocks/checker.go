@@ -100,6 +100,22 @@ func (_m *Checker) Start() error {
 	return r0
 }
 
+// Statuses provides a mock function with given fields:
+func (_m *Checker) Statuses() map[string]services.ServiceStatus {
+	ret := _m.Called()
+
+	var r0 map[string]services.ServiceStatus
+	if rf, ok := ret.Get(0).(func() map[string]services.ServiceStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]services.ServiceStatus)
+		}
+	}
+
+	return r0
+}
+
 // Unregister provides a mock function with given fields: name
 func (_m *Checker) Unregister(name string) error {
 	ret := _m.Called(name)
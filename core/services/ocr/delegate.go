@@ -149,8 +149,9 @@ func (d Delegate) ServicesForSpec(jb job.Job) (services []job.ServiceCtx, err er
 		// ParseBootstrapPeers() does not distinguish between no p2pv2Bootstrappers field
 		//  present in job spec, and p2pv2Bootstrappers = [].  So even if an empty list is
 		//  passed explicitly, this will still fall back to using the V2 bootstappers defined
-		//  in P2PV2_BOOTSTRAPPERS config var.  Only a non-empty list will override the default list.
-		v2Bootstrappers = peerWrapper.Config().P2PV2Bootstrappers()
+		//  in P2PV2_BOOTSTRAPPERS config var, or resolved from P2PV2_BOOTSTRAPPERS_DNS.  Only a non-empty list will
+		//  override the default list.
+		v2Bootstrappers = peerWrapper.P2PV2Bootstrappers()
 	}
 
 	ocrLogger := logger.NewOCRWrapper(lggr, chain.Config().OCRTraceLogging(), func(msg string) {
@@ -231,7 +232,7 @@ func (d Delegate) ServicesForSpec(jb job.Job) (services []job.ServiceCtx, err er
 			concreteSpec.ContractAddress.Address(),
 			contractCaller,
 			contractABI,
-			ocrcommon.NewTransmitter(chain.TxManager(), concreteSpec.TransmitterAddress.Address(), gasLimit, forwardingAllowed, strategy, checker),
+			ocrcommon.NewTransmitter(chain.TxManager(), concreteSpec.TransmitterAddress.Address(), gasLimit, forwardingAllowed, strategy, checker, jb.ID, d.pipelineRunner.Budgets()),
 			chain.LogBroadcaster(),
 			tracker,
 			chain.ID(),
@@ -271,7 +272,7 @@ func (d Delegate) ServicesForSpec(jb job.Job) (services []job.ServiceCtx, err er
 			ContractTransmitter:          contractTransmitter,
 			ContractConfigTracker:        tracker,
 			PrivateKeys:                  ocrkey,
-			BinaryNetworkEndpointFactory: peerWrapper.Peer,
+			BinaryNetworkEndpointFactory: peerWrapper.JobPeer(jb.ID),
 			Logger:                       ocrLogger,
 			V1Bootstrappers:              v1BootstrapPeers,
 			V2Bootstrappers:              v2Bootstrappers,
@@ -134,7 +134,7 @@ func (d Delegate) ServicesForSpec(jobSpec job.Job) ([]job.ServiceCtx, error) {
 		"DatabaseTimeout", lc.DatabaseTimeout,
 	)
 
-	bootstrapPeers, err := ocrcommon.GetValidatedBootstrapPeers(spec.P2PV2Bootstrappers, peerWrapper.Config().P2PV2Bootstrappers())
+	bootstrapPeers, err := ocrcommon.GetValidatedBootstrapPeers(spec.P2PV2Bootstrappers, peerWrapper.P2PV2Bootstrappers())
 	if err != nil {
 		return nil, err
 	}
@@ -315,7 +315,7 @@ func (d Delegate) ServicesForSpec(jobSpec job.Job) ([]job.ServiceCtx, error) {
 		oracles, err2 := ocr2vrf.NewOCR2VRF(ocr2vrf.DKGVRFArgs{
 			VRFLogger:                    vrfLogger,
 			DKGLogger:                    dkgLogger,
-			BinaryNetworkEndpointFactory: peerWrapper.Peer2,
+			BinaryNetworkEndpointFactory: peerWrapper.JobPeer2(jobSpec.ID),
 			V2Bootstrappers:              bootstrapPeers,
 			OffchainKeyring:              kb,
 			OnchainKeyring:               kb,
@@ -374,7 +374,7 @@ func (d Delegate) ServicesForSpec(jobSpec job.Job) ([]job.ServiceCtx, error) {
 	}
 
 	oracle, err := libocr2.NewOracle(libocr2.OracleArgs{
-		BinaryNetworkEndpointFactory: peerWrapper.Peer2,
+		BinaryNetworkEndpointFactory: peerWrapper.JobPeer2(jobSpec.ID),
 		V2Bootstrappers:              bootstrapPeers,
 		ContractTransmitter:          ocr2Provider.ContractTransmitter(),
 		ContractConfigTracker:        ocr2Provider.ContractConfigTracker(),
@@ -7,11 +7,19 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
 // The PluginConfig struct contains the custom arguments needed for the Median plugin.
 type PluginConfig struct {
 	JuelsPerFeeCoinPipeline string `json:"juelsPerFeeCoinSource"`
+	// FallbackObservationSource is the pipeline observing instead of the
+	// observation source of the job when it fails, or when it takes longer than
+	// FallbackLatencyBudget.
+	FallbackObservationSource string `json:"fallbackObservationSource"`
+	// FallbackLatencyBudget bounds the observation source of the job, when
+	// there is a fallback. Zero does not bound it.
+	FallbackLatencyBudget models.Duration `json:"fallbackLatencyBudget"`
 }
 
 // ValidatePluginConfig validates the arguments for the Median plugin.
@@ -20,5 +28,13 @@ func ValidatePluginConfig(config PluginConfig) error {
 		return errors.Wrap(err, "invalid juelsPerFeeCoinSource pipeline")
 	}
 
+	if config.FallbackObservationSource != "" {
+		if _, err := pipeline.Parse(config.FallbackObservationSource); err != nil {
+			return errors.Wrap(err, "invalid fallbackObservationSource pipeline")
+		}
+	} else if !config.FallbackLatencyBudget.IsInstant() {
+		return errors.New("fallbackLatencyBudget requires a fallbackObservationSource")
+	}
+
 	return nil
 }
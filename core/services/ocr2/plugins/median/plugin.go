@@ -62,14 +62,29 @@ func (m *Median) GetPluginFactory() (ocr2types.ReportingPluginFactory, error) {
 		DotDagSource: m.pluginConfig.JuelsPerFeeCoinPipeline,
 		CreatedAt:    time.Now(),
 	}
-	return median.NumericalMedianFactory{
-		ContractTransmitter: m.ocr2Provider.MedianContract(),
-		DataSource: ocrcommon.NewDataSourceV2(m.pipelineRunner,
-			m.jb,
-			*m.jb.PipelineSpec,
+	dataSource := ocrcommon.NewDataSourceV2(m.pipelineRunner,
+		m.jb,
+		*m.jb.PipelineSpec,
+		m.lggr,
+		m.runResults,
+	)
+	if m.pluginConfig.FallbackObservationSource != "" {
+		// The runs of the fallback are not saved, as those of juelsPerFeeCoinSource
+		fallbackPipelineSpec := pipeline.Spec{
+			ID:           m.jb.ID,
+			DotDagSource: m.pluginConfig.FallbackObservationSource,
+			CreatedAt:    time.Now(),
+		}
+		dataSource = ocrcommon.NewFallbackDataSource(dataSource,
+			ocrcommon.NewInMemoryDataSource(m.pipelineRunner, m.jb, fallbackPipelineSpec, m.lggr),
+			m.pluginConfig.FallbackLatencyBudget.Duration(),
+			m.jb.ID,
 			m.lggr,
-			m.runResults,
-		),
+		)
+	}
+	return median.NumericalMedianFactory{
+		ContractTransmitter:       m.ocr2Provider.MedianContract(),
+		DataSource:                dataSource,
 		JuelsPerFeeCoinDataSource: ocrcommon.NewInMemoryDataSource(m.pipelineRunner, m.jb, juelsPerFeeCoinPipelineSpec, m.lggr),
 		OnchainConfigCodec:        m.ocr2Provider.OnchainConfigCodec(),
 		ReportCodec:               m.ocr2Provider.ReportCodec(),
@@ -12,6 +12,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	dkgconfig "github.com/smartcontractkit/chainlink/core/services/ocr2/plugins/dkg/config"
+	medianconfig "github.com/smartcontractkit/chainlink/core/services/ocr2/plugins/median/config"
 	ocr2vrfconfig "github.com/smartcontractkit/chainlink/core/services/ocr2/plugins/ocr2vrf/config"
 	"github.com/smartcontractkit/chainlink/core/services/ocrcommon"
 	"github.com/smartcontractkit/chainlink/core/services/relay"
@@ -96,6 +97,7 @@ func validateSpec(tree *toml.Tree, spec job.Job) error {
 		if spec.Pipeline.Source == "" {
 			return errors.New("no pipeline specified")
 		}
+		return validateMedianSpec(spec.OCR2OracleSpec.PluginConfig)
 	case job.DKG:
 		return validateDKGSpec(spec.OCR2OracleSpec.PluginConfig)
 	case job.OCR2VRF:
@@ -109,6 +111,18 @@ func validateSpec(tree *toml.Tree, spec job.Job) error {
 	return nil
 }
 
+func validateMedianSpec(jsonConfig job.JSONConfig) error {
+	if jsonConfig == nil {
+		return nil
+	}
+	var pluginConfig medianconfig.PluginConfig
+	err := json.Unmarshal(jsonConfig.Bytes(), &pluginConfig)
+	if err != nil {
+		return errors.Wrap(err, "error while unmarshaling plugin config")
+	}
+	return medianconfig.ValidatePluginConfig(pluginConfig)
+}
+
 func validateDKGSpec(jsonConfig job.JSONConfig) error {
 	if jsonConfig == nil {
 		return errors.New("pluginConfig is empty")
@@ -579,6 +579,86 @@ KeyID               = "6f3b82406688b8ddb944c6f2e6d808f014c8fa8d568d639c25019568c
 				require.Contains(t, err.Error(), "validation error for keyID")
 			},
 		},
+		{
+			name: "median fallback observation source",
+			toml: `
+type               = "offchainreporting2"
+pluginType         = "median"
+schemaVersion      = 1
+relay              = "evm"
+contractID         = "0x613a38AC1659769640aaE063C651F48E0250454C"
+observationSource  = """
+ds1          [type=bridge name=voter_turnout];
+ds1_parse    [type=jsonparse path="one,two"];
+ds1 -> ds1_parse -> answer1;
+answer1      [type=median index=0];
+"""
+[relayConfig]
+chainID = 1337
+[pluginConfig]
+fallbackLatencyBudget = "2s"
+fallbackObservationSource = """
+ds1          [type=bridge name=backup_voter_turnout];
+ds1_parse    [type=jsonparse path="one,two"];
+ds1 -> ds1_parse -> answer1;
+answer1      [type=median index=0];
+"""
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.NoError(t, err)
+				var pc medianconfig.PluginConfig
+				require.NoError(t, json.Unmarshal(os.OCR2OracleSpec.PluginConfig.Bytes(), &pc))
+				assert.Equal(t, 2*time.Second, pc.FallbackLatencyBudget.Duration())
+				assert.Contains(t, pc.FallbackObservationSource, "backup_voter_turnout")
+			},
+		},
+		{
+			name: "median fallback latency budget without source",
+			toml: `
+type               = "offchainreporting2"
+pluginType         = "median"
+schemaVersion      = 1
+relay              = "evm"
+contractID         = "0x613a38AC1659769640aaE063C651F48E0250454C"
+observationSource  = """
+ds1          [type=bridge name=voter_turnout];
+ds1_parse    [type=jsonparse path="one,two"];
+ds1 -> ds1_parse -> answer1;
+answer1      [type=median index=0];
+"""
+[relayConfig]
+chainID = 1337
+[pluginConfig]
+fallbackLatencyBudget = "2s"
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.EqualError(t, err, "fallbackLatencyBudget requires a fallbackObservationSource")
+			},
+		},
+		{
+			name: "median invalid fallback observation source",
+			toml: `
+type               = "offchainreporting2"
+pluginType         = "median"
+schemaVersion      = 1
+relay              = "evm"
+contractID         = "0x613a38AC1659769640aaE063C651F48E0250454C"
+observationSource  = """
+ds1          [type=bridge name=voter_turnout];
+ds1_parse    [type=jsonparse path="one,two"];
+ds1 -> ds1_parse -> answer1;
+answer1      [type=median index=0];
+"""
+[relayConfig]
+chainID = 1337
+[pluginConfig]
+fallbackObservationSource = "->"
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "invalid fallbackObservationSource pipeline")
+			},
+		},
 	}
 
 	for _, tc := range tt {
@@ -0,0 +1,209 @@
+package ocrcommon
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	p2ppeer "github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	ocrcommontypes "github.com/smartcontractkit/libocr/commontypes"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const (
+	defaultBootstrapperDNSRefreshInterval = 5 * time.Minute
+	// bootstrapperDNSTimeout bounds each resolution of the bootstrappers
+	bootstrapperDNSTimeout = 10 * time.Second
+)
+
+// DNSResolver looks up the records of P2PV2_BOOTSTRAPPERS_DNS, implemented by
+// net.Resolver
+type DNSResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+	LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+}
+
+type BootstrapperDiscoveryConfig interface {
+	P2PV2BootstrappersDNS() string
+	P2PV2BootstrappersDNSRefreshInterval() models.Duration
+}
+
+// BootstrapperDiscovery resolves the default bootstrapper peers from DNS
+// periodically, and restarts the running jobs using them when they change
+type BootstrapperDiscovery struct {
+	utils.StartStopOnce
+
+	name        string
+	interval    time.Duration
+	resolver    DNSResolver
+	peerWrapper *SingletonPeerWrapper
+	spawner     job.Spawner
+	lggr        logger.Logger
+	chStop      chan struct{}
+	wg          sync.WaitGroup
+}
+
+func NewBootstrapperDiscovery(cfg BootstrapperDiscoveryConfig, resolver DNSResolver, peerWrapper *SingletonPeerWrapper, spawner job.Spawner, lggr logger.Logger) *BootstrapperDiscovery {
+	interval := cfg.P2PV2BootstrappersDNSRefreshInterval().Duration()
+	if interval <= 0 {
+		interval = defaultBootstrapperDNSRefreshInterval
+	}
+	return &BootstrapperDiscovery{
+		name:        cfg.P2PV2BootstrappersDNS(),
+		interval:    interval,
+		resolver:    resolver,
+		peerWrapper: peerWrapper,
+		spawner:     spawner,
+		lggr:        lggr.Named("BootstrapperDiscovery"),
+		chStop:      make(chan struct{}),
+	}
+}
+
+// Start resolves the bootstrappers before the jobs are started. The jobs use
+// P2PV2_BOOTSTRAPPERS until the first successful resolution.
+func (d *BootstrapperDiscovery) Start(ctx context.Context) error {
+	return d.StartOnce("BootstrapperDiscovery", func() error {
+		if _, err := d.refresh(ctx); err != nil {
+			d.lggr.Errorw("Failed to resolve bootstrappers, falling back to P2PV2_BOOTSTRAPPERS", "name", d.name, "err", err)
+		}
+		d.wg.Add(1)
+		go d.run()
+		return nil
+	})
+}
+
+func (d *BootstrapperDiscovery) Close() error {
+	return d.StopOnce("BootstrapperDiscovery", func() error {
+		close(d.chStop)
+		d.wg.Wait()
+		return nil
+	})
+}
+
+func (d *BootstrapperDiscovery) run() {
+	defer d.wg.Done()
+	ctx, cancel := utils.ContextFromChan(d.chStop)
+	defer cancel()
+
+	ticker := time.NewTicker(utils.WithJitter(d.interval))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-d.chStop:
+			return
+		case <-ticker.C:
+			changed, err := d.refresh(ctx)
+			if err != nil {
+				d.lggr.Warnw("Failed to resolve bootstrappers, keeping the previous ones", "name", d.name, "err", err)
+				continue
+			}
+			if changed {
+				d.restartJobs(ctx)
+			}
+		}
+	}
+}
+
+func (d *BootstrapperDiscovery) refresh(ctx context.Context) (changed bool, err error) {
+	ctx, cancel := context.WithTimeout(ctx, bootstrapperDNSTimeout)
+	defer cancel()
+	locators, err := resolveBootstrappers(ctx, d.resolver, d.name)
+	if err != nil {
+		return false, err
+	}
+	changed = d.peerWrapper.setDNSBootstrappers(locators)
+	if changed {
+		d.lggr.Infow("Resolved new bootstrappers", "name", d.name, "bootstrappers", locators)
+	}
+	return changed, nil
+}
+
+// restartJobs restarts the running OCR and OCR2 jobs using the default
+// bootstrappers, as their endpoints are created with them
+func (d *BootstrapperDiscovery) restartJobs(ctx context.Context) {
+	for jobID, jb := range d.spawner.ActiveJobs() {
+		if !usesDefaultBootstrappers(jb) {
+			continue
+		}
+		if err := d.spawner.RestartJob(ctx, jobID); err != nil {
+			d.lggr.Errorw("Failed to restart job with the new bootstrappers", "jobID", jobID, "err", err)
+			continue
+		}
+		d.lggr.Infow("Restarted job with the new bootstrappers", "jobID", jobID)
+	}
+}
+
+func usesDefaultBootstrappers(jb job.Job) bool {
+	switch {
+	case jb.Type == job.OffchainReporting && jb.OCROracleSpec != nil:
+		return len(jb.OCROracleSpec.P2PV2Bootstrappers) == 0
+	case jb.Type == job.OffchainReporting2 && jb.OCR2OracleSpec != nil:
+		return len(jb.OCR2OracleSpec.P2PV2Bootstrappers) == 0
+	}
+	return false
+}
+
+// resolveBootstrappers returns the bootstrappers of the TXT records of name,
+// in the form of P2PV2_BOOTSTRAPPERS, and of its SRV records, whose targets
+// have a TXT record with their peer ID. They are sorted to be compared.
+func resolveBootstrappers(ctx context.Context, resolver DNSResolver, name string) ([]ocrcommontypes.BootstrapperLocator, error) {
+	addrs := make(map[string][]string)
+
+	txts, err := resolver.LookupTXT(ctx, name)
+	if err != nil && !isDNSNotFound(err) {
+		return nil, errors.Wrapf(err, "TXT lookup of %s failed", name)
+	}
+	for _, txt := range txts {
+		var locator ocrcommontypes.BootstrapperLocator
+		if err = locator.UnmarshalText([]byte(strings.TrimSpace(txt))); err != nil {
+			return nil, errors.Wrapf(err, "invalid bootstrapper in TXT record %q of %s", txt, name)
+		}
+		addrs[locator.PeerID] = append(addrs[locator.PeerID], locator.Addrs...)
+	}
+
+	_, srvs, err := resolver.LookupSRV(ctx, "", "", name)
+	if err != nil && !isDNSNotFound(err) {
+		return nil, errors.Wrapf(err, "SRV lookup of %s failed", name)
+	}
+	for _, srv := range srvs {
+		target := strings.TrimSuffix(srv.Target, ".")
+		ids, err := resolver.LookupTXT(ctx, target)
+		if err != nil {
+			return nil, errors.Wrapf(err, "peer ID lookup of SRV target %s failed", target)
+		}
+		if len(ids) != 1 {
+			return nil, errors.Errorf("SRV target %s must have a single TXT record with its peer ID, got %d", target, len(ids))
+		}
+		peerID := strings.TrimSpace(ids[0])
+		if _, err = p2ppeer.Decode(peerID); err != nil {
+			return nil, errors.Wrapf(err, "invalid peer ID in TXT record of SRV target %s", target)
+		}
+		addrs[peerID] = append(addrs[peerID], net.JoinHostPort(target, strconv.Itoa(int(srv.Port))))
+	}
+
+	if len(addrs) == 0 {
+		return nil, errors.Errorf("no bootstrapper found in the TXT or SRV records of %s", name)
+	}
+	locators := make([]ocrcommontypes.BootstrapperLocator, 0, len(addrs))
+	for peerID, peerAddrs := range addrs {
+		peerAddrs = uniqueStrings(peerAddrs)
+		sort.Strings(peerAddrs)
+		locators = append(locators, ocrcommontypes.BootstrapperLocator{PeerID: peerID, Addrs: peerAddrs})
+	}
+	sort.Slice(locators, func(i, j int) bool { return locators[i].PeerID < locators[j].PeerID })
+	return locators, nil
+}
+
+func isDNSNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
@@ -0,0 +1,139 @@
+package ocrcommon
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	ocrcommontypes "github.com/smartcontractkit/libocr/commontypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	configmocks "github.com/smartcontractkit/chainlink/core/config/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	jobmocks "github.com/smartcontractkit/chainlink/core/services/job/mocks"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+const (
+	peerID1 = "12D3KooWMoejJznyDuEk5aX6GvbjaG12UzeornPCBNzMRqdwrFJw"
+	peerID2 = "12D3KooWPjceQrSwdWXPyLLeABRXmuqt69Rg3sBYbU1Nft9HyQ6X"
+)
+
+type fakeDNSResolver struct {
+	mu  sync.Mutex
+	txt map[string][]string
+	srv map[string][]*net.SRV
+}
+
+func (r *fakeDNSResolver) LookupTXT(_ context.Context, name string) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if txts, ok := r.txt[name]; ok {
+		return txts, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+func (r *fakeDNSResolver) LookupSRV(_ context.Context, _, _, name string) (string, []*net.SRV, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if srvs, ok := r.srv[name]; ok {
+		return name, srvs, nil
+	}
+	return "", nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+func (r *fakeDNSResolver) setTXT(name string, txts ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.txt[name] = txts
+}
+
+func Test_ResolveBootstrappers(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+
+	t.Run("TXT and SRV records", func(t *testing.T) {
+		resolver := &fakeDNSResolver{
+			txt: map[string][]string{
+				"bootstrappers.example.com": {peerID2 + "@b.example.com:1000", peerID1 + "@a.example.com:1000"},
+				"c.example.com":             {peerID2},
+			},
+			srv: map[string][]*net.SRV{
+				"bootstrappers.example.com": {{Target: "c.example.com.", Port: 2000}},
+			},
+		}
+		locators, err := resolveBootstrappers(ctx, resolver, "bootstrappers.example.com")
+		require.NoError(t, err)
+		assert.Equal(t, []ocrcommontypes.BootstrapperLocator{
+			{PeerID: peerID1, Addrs: []string{"a.example.com:1000"}},
+			{PeerID: peerID2, Addrs: []string{"b.example.com:1000", "c.example.com:2000"}},
+		}, locators)
+	})
+
+	t.Run("no records", func(t *testing.T) {
+		resolver := &fakeDNSResolver{}
+		_, err := resolveBootstrappers(ctx, resolver, "bootstrappers.example.com")
+		assert.EqualError(t, err, "no bootstrapper found in the TXT or SRV records of bootstrappers.example.com")
+	})
+
+	t.Run("invalid TXT record", func(t *testing.T) {
+		resolver := &fakeDNSResolver{txt: map[string][]string{"bootstrappers.example.com": {"foo"}}}
+		_, err := resolveBootstrappers(ctx, resolver, "bootstrappers.example.com")
+		assert.ErrorContains(t, err, `invalid bootstrapper in TXT record "foo"`)
+	})
+
+	t.Run("SRV target without peer ID", func(t *testing.T) {
+		resolver := &fakeDNSResolver{srv: map[string][]*net.SRV{
+			"bootstrappers.example.com": {{Target: "c.example.com.", Port: 2000}},
+		}}
+		_, err := resolveBootstrappers(ctx, resolver, "bootstrappers.example.com")
+		assert.ErrorContains(t, err, "peer ID lookup of SRV target c.example.com failed")
+	})
+}
+
+func Test_BootstrapperDiscovery(t *testing.T) {
+	t.Parallel()
+
+	cfg := configmocks.NewGeneralConfig(t)
+	cfg.On("P2PV2BootstrappersDNS").Return("bootstrappers.example.com")
+	cfg.On("P2PV2BootstrappersDNSRefreshInterval").Return(models.MustMakeDuration(10 * time.Millisecond))
+	pw := NewSingletonPeerWrapper(nil, cfg, nil, logger.TestLogger(t))
+
+	resolver := &fakeDNSResolver{txt: map[string][]string{
+		"bootstrappers.example.com": {peerID1 + "@a.example.com:1000"},
+	}}
+	spawner := jobmocks.NewSpawner(t)
+	d := NewBootstrapperDiscovery(cfg, resolver, pw, spawner, logger.TestLogger(t))
+
+	require.NoError(t, d.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, d.Close()) })
+	assert.Equal(t, []ocrcommontypes.BootstrapperLocator{{PeerID: peerID1, Addrs: []string{"a.example.com:1000"}}}, pw.P2PV2Bootstrappers())
+
+	restarted := make(chan int32)
+	spawner.On("ActiveJobs").Return(map[int32]job.Job{
+		1: {ID: 1, Type: job.OffchainReporting2, OCR2OracleSpec: &job.OCR2OracleSpec{}},
+		2: {ID: 2, Type: job.OffchainReporting2, OCR2OracleSpec: &job.OCR2OracleSpec{P2PV2Bootstrappers: pq.StringArray{peerID1 + "@a.example.com:1000"}}},
+		3: {ID: 3, Type: job.Cron},
+	}).Once()
+	spawner.On("RestartJob", mock.Anything, int32(1)).Return(nil).Run(func(args mock.Arguments) {
+		restarted <- args.Get(1).(int32)
+	}).Once()
+
+	resolver.setTXT("bootstrappers.example.com", peerID2+"@b.example.com:1000")
+	select {
+	case jobID := <-restarted:
+		assert.Equal(t, int32(1), jobID)
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for the job to be restarted")
+	}
+	assert.Equal(t, []ocrcommontypes.BootstrapperLocator{{PeerID: peerID2, Addrs: []string{"b.example.com:1000"}}}, pw.P2PV2Bootstrappers())
+}
@@ -3,9 +3,12 @@ package ocrcommon
 import (
 	"context"
 	"math/big"
+	"strconv"
 	"sync"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
 
 	"github.com/smartcontractkit/chainlink/core/bridges"
@@ -18,6 +21,23 @@ import (
 	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting/types"
 )
 
+// The reasons for observing with the fallback data source
+const (
+	FallbackReasonError   = "error"
+	FallbackReasonLatency = "latency"
+)
+
+var (
+	promObservationFallbacks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ocr_observation_fallbacks",
+		Help: "The number of observations of the given job made with its fallback observation source, by reason: error or latency of the primary one",
+	}, []string{"job_id", "reason"})
+	promObservationFallbackErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ocr_observation_fallback_errors",
+		Help: "The number of observations of the given job which failed with both the primary and the fallback observation sources",
+	}, []string{"job_id"})
+)
+
 // inMemoryDataSource is an abstraction over the process of initiating a pipeline run
 // and returning the result. Additionally, it converts the result to an
 // ocrtypes.Observation (*big.Int), as expected by the offchain reporting library.
@@ -177,3 +197,57 @@ func (ds *dataSource) Observe(ctx context.Context) (ocrtypes.Observation, error)
 
 	return ds.inMemoryDataSource.parse(finalResult)
 }
+
+// fallbackDataSource observes with the fallback data source when the primary
+// one fails, or takes longer than the latency budget
+type fallbackDataSource struct {
+	primary       median.DataSource
+	fallback      median.DataSource
+	latencyBudget time.Duration
+	jobID         string
+	lggr          logger.Logger
+}
+
+// NewFallbackDataSource returns a data source observing with primary, or with
+// fallback when primary fails or takes longer than latencyBudget. A zero
+// latencyBudget does not bound primary.
+func NewFallbackDataSource(primary, fallback median.DataSource, latencyBudget time.Duration, jobID int32, lggr logger.Logger) median.DataSource {
+	return &fallbackDataSource{
+		primary:       primary,
+		fallback:      fallback,
+		latencyBudget: latencyBudget,
+		jobID:         strconv.Itoa(int(jobID)),
+		lggr:          lggr,
+	}
+}
+
+func (ds *fallbackDataSource) Observe(ctx context.Context) (*big.Int, error) {
+	primaryCtx := ctx
+	if ds.latencyBudget > 0 {
+		var cancel context.CancelFunc
+		primaryCtx, cancel = context.WithTimeout(ctx, ds.latencyBudget)
+		defer cancel()
+	}
+	observation, err := ds.primary.Observe(primaryCtx)
+	if err == nil {
+		return observation, nil
+	}
+	if ctx.Err() != nil {
+		// No time is left for the fallback
+		return nil, err
+	}
+
+	reason := FallbackReasonError
+	if errors.Is(primaryCtx.Err(), context.DeadlineExceeded) {
+		reason = FallbackReasonLatency
+	}
+	promObservationFallbacks.WithLabelValues(ds.jobID, reason).Inc()
+	ds.lggr.Warnw("Primary observation source failed, observing with the fallback one", "reason", reason, "err", err)
+
+	observation, fallbackErr := ds.fallback.Observe(ctx)
+	if fallbackErr != nil {
+		promObservationFallbackErrors.WithLabelValues(ds.jobID).Inc()
+		return nil, errors.Wrapf(fallbackErr, "fallback observation source failed, after the primary one failed with: %v", err)
+	}
+	return observation, nil
+}
@@ -1,7 +1,13 @@
 package ocrcommon_test
 
 import (
+	"context"
+	"math/big"
 	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -58,3 +64,65 @@ func Test_NewDataSourceV2(t *testing.T) {
 	assert.Equal(t, mockValue, val.String())   // returns expected value after pipeline run
 	assert.Equal(t, pipeline.Run{}, <-resChan) // expected data properly passed to channel
 }
+
+type dataSourceFunc func(ctx context.Context) (*big.Int, error)
+
+func (f dataSourceFunc) Observe(ctx context.Context) (*big.Int, error) {
+	return f(ctx)
+}
+
+func Test_FallbackDataSource(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	ok := func(v int64) dataSourceFunc {
+		return func(context.Context) (*big.Int, error) { return big.NewInt(v), nil }
+	}
+	failing := dataSourceFunc(func(context.Context) (*big.Int, error) { return nil, errors.New("adapter down") })
+	slow := dataSourceFunc(func(ctx context.Context) (*big.Int, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+	fallbacks := func(jobID, reason string) float64 {
+		return testutil.ToFloat64(ocrcommon.PromObservationFallbacks.WithLabelValues(jobID, reason))
+	}
+
+	t.Run("primary", func(t *testing.T) {
+		ds := ocrcommon.NewFallbackDataSource(ok(1), ok(2), time.Second, 1, lggr)
+		val, err := ds.Observe(testutils.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), val.Int64())
+		assert.Equal(t, float64(0), fallbacks("1", ocrcommon.FallbackReasonError))
+	})
+
+	t.Run("primary error", func(t *testing.T) {
+		ds := ocrcommon.NewFallbackDataSource(failing, ok(2), 0, 2, lggr)
+		val, err := ds.Observe(testutils.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), val.Int64())
+		assert.Equal(t, float64(1), fallbacks("2", ocrcommon.FallbackReasonError))
+		assert.Equal(t, float64(0), fallbacks("2", ocrcommon.FallbackReasonLatency))
+	})
+
+	t.Run("primary latency", func(t *testing.T) {
+		ds := ocrcommon.NewFallbackDataSource(slow, ok(2), 10*time.Millisecond, 3, lggr)
+		val, err := ds.Observe(testutils.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), val.Int64())
+		assert.Equal(t, float64(1), fallbacks("3", ocrcommon.FallbackReasonLatency))
+	})
+
+	t.Run("both fail", func(t *testing.T) {
+		ds := ocrcommon.NewFallbackDataSource(failing, failing, 0, 4, lggr)
+		_, err := ds.Observe(testutils.Context(t))
+		assert.EqualError(t, err, "fallback observation source failed, after the primary one failed with: adapter down: adapter down")
+		assert.Equal(t, float64(1), testutil.ToFloat64(ocrcommon.PromObservationFallbackErrors.WithLabelValues("4")))
+	})
+
+	t.Run("no time left", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(testutils.Context(t))
+		cancel()
+		ds := ocrcommon.NewFallbackDataSource(slow, ok(2), time.Second, 5, lggr)
+		_, err := ds.Observe(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, float64(0), fallbacks("5", ocrcommon.FallbackReasonError))
+	})
+}
@@ -0,0 +1,6 @@
+package ocrcommon
+
+var (
+	PromObservationFallbacks      = promObservationFallbacks
+	PromObservationFallbackErrors = promObservationFallbackErrors
+)
@@ -0,0 +1,430 @@
+package ocrcommon
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	ocrcommontypes "github.com/smartcontractkit/libocr/commontypes"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting/types"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2/types"
+)
+
+// Messages logged by the ragep2p host of libocr, tracking the connectivity
+// to the remote peers
+const (
+	p2pLogConnectionEstablished = "Connection established"
+	p2pLogConnectionExited      = "authenticatedConnectionLoop: exited"
+	p2pLogDialError             = "Dial error"
+	p2pLogRemotePeerID          = "remotePeerID"
+)
+
+// p2pPingTimeout bounds the dial of each address of a pinged peer
+const p2pPingTimeout = 5 * time.Second
+
+// P2PStatus is the connectivity of the node to the peers of its OCR jobs
+type P2PStatus struct {
+	PeerID          string
+	NetworkingStack string
+	// AnnounceAddresses are the addresses the other peers dial to reach the
+	// node
+	AnnounceAddresses []string
+	Jobs              []JobP2PStatus
+}
+
+// JobP2PStatus is the connectivity of an OCR job to the peers of its current
+// config. OCR2VRF jobs have an endpoint for both the DKG and the VRF configs.
+type JobP2PStatus struct {
+	JobID        int32
+	ConfigDigest string
+	Peers        []PeerP2PStatus
+}
+
+// PeerP2PStatus is the connectivity to a remote peer. Connected and the dial
+// failures are only tracked by the v2 networking stack.
+type PeerP2PStatus struct {
+	PeerID           string
+	Connected        bool
+	DialFailures     int64
+	LastDialError    string
+	MessagesSent     int64
+	BytesSent        int64
+	MessagesReceived int64
+	BytesReceived    int64
+	LastReceivedAt   *time.Time
+}
+
+// P2PPingResult is the outcome of dialing an address of a remote peer
+type P2PPingResult struct {
+	Address string
+	Latency time.Duration
+	Error   string
+}
+
+// P2PPing is the connectivity to a remote peer, and the outcome of dialing
+// its known addresses
+type P2PPing struct {
+	PeerP2PStatus
+	Results []P2PPingResult
+}
+
+type remotePeer struct {
+	connections   int
+	dialFailures  int64
+	lastDialError string
+}
+
+// p2pTracker records the connections to the remote peers, from the logs of
+// libocr, and the traffic of the OCR endpoints of each job
+type p2pTracker struct {
+	mu        sync.RWMutex
+	peers     map[string]*remotePeer
+	endpoints map[int32]map[*trackedEndpoint]struct{}
+}
+
+func newP2PTracker() *p2pTracker {
+	return &p2pTracker{
+		peers:     make(map[string]*remotePeer),
+		endpoints: make(map[int32]map[*trackedEndpoint]struct{}),
+	}
+}
+
+// Caller must hold mu
+func (t *p2pTracker) remotePeer(peerID string) *remotePeer {
+	rp, ok := t.peers[peerID]
+	if !ok {
+		rp = &remotePeer{}
+		t.peers[peerID] = rp
+	}
+	return rp
+}
+
+func (t *p2pTracker) onLog(msg string, fields ocrcommontypes.LogFields) {
+	switch msg {
+	case p2pLogConnectionEstablished, p2pLogConnectionExited, p2pLogDialError:
+	default:
+		return
+	}
+	v, ok := fields[p2pLogRemotePeerID]
+	if !ok {
+		return
+	}
+	peerID := fmt.Sprint(v)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	rp := t.remotePeer(peerID)
+	switch msg {
+	case p2pLogConnectionEstablished:
+		rp.connections++
+	case p2pLogConnectionExited:
+		if rp.connections > 0 {
+			rp.connections--
+		}
+	case p2pLogDialError:
+		rp.dialFailures++
+		rp.lastDialError = fmt.Sprint(fields["error"])
+	}
+}
+
+func (t *p2pTracker) addEndpoint(e *trackedEndpoint) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.endpoints[e.jobID] == nil {
+		t.endpoints[e.jobID] = make(map[*trackedEndpoint]struct{})
+	}
+	t.endpoints[e.jobID][e] = struct{}{}
+}
+
+func (t *p2pTracker) removeEndpoint(e *trackedEndpoint) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.endpoints[e.jobID], e)
+	if len(t.endpoints[e.jobID]) == 0 {
+		delete(t.endpoints, e.jobID)
+	}
+}
+
+// Caller must hold mu
+func (t *p2pTracker) peerStatus(peerID string) PeerP2PStatus {
+	s := PeerP2PStatus{PeerID: peerID}
+	if rp, ok := t.peers[peerID]; ok {
+		s.Connected = rp.connections > 0
+		s.DialFailures = rp.dialFailures
+		s.LastDialError = rp.lastDialError
+	}
+	return s
+}
+
+func (t *p2pTracker) jobs(selfPeerID string) []JobP2PStatus {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	jobs := []JobP2PStatus{}
+	for jobID, endpoints := range t.endpoints {
+		for e := range endpoints {
+			js := JobP2PStatus{JobID: jobID, ConfigDigest: e.configDigest, Peers: []PeerP2PStatus{}}
+			for i, peerID := range e.peerIDs {
+				if peerID == selfPeerID {
+					continue
+				}
+				s := t.peerStatus(peerID)
+				s.MessagesSent = e.traffic[i].messagesSent
+				s.BytesSent = e.traffic[i].bytesSent
+				s.MessagesReceived = e.traffic[i].messagesReceived
+				s.BytesReceived = e.traffic[i].bytesReceived
+				if !e.traffic[i].lastReceivedAt.IsZero() {
+					lastReceivedAt := e.traffic[i].lastReceivedAt
+					s.LastReceivedAt = &lastReceivedAt
+				}
+				js.Peers = append(js.Peers, s)
+			}
+			jobs = append(jobs, js)
+		}
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		if jobs[i].JobID != jobs[j].JobID {
+			return jobs[i].JobID < jobs[j].JobID
+		}
+		return jobs[i].ConfigDigest < jobs[j].ConfigDigest
+	})
+	return jobs
+}
+
+// peer returns the status of a remote peer, summing its traffic over all the
+// endpoints, and the addresses of the bootstrappers it is listed as
+func (t *p2pTracker) peer(peerID string) (PeerP2PStatus, []string) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	s := t.peerStatus(peerID)
+	var addresses []string
+	for _, endpoints := range t.endpoints {
+		for e := range endpoints {
+			for i, id := range e.peerIDs {
+				if id != peerID {
+					continue
+				}
+				s.MessagesSent += e.traffic[i].messagesSent
+				s.BytesSent += e.traffic[i].bytesSent
+				s.MessagesReceived += e.traffic[i].messagesReceived
+				s.BytesReceived += e.traffic[i].bytesReceived
+				if lastReceivedAt := e.traffic[i].lastReceivedAt; !lastReceivedAt.IsZero() && (s.LastReceivedAt == nil || lastReceivedAt.After(*s.LastReceivedAt)) {
+					s.LastReceivedAt = &lastReceivedAt
+				}
+			}
+			for _, locator := range e.v2Bootstrappers {
+				if locator.PeerID == peerID {
+					addresses = append(addresses, locator.Addrs...)
+				}
+			}
+		}
+	}
+	return s, addresses
+}
+
+// wrapLogger wraps the logger of the libocr peer, to track the connections
+func (t *p2pTracker) wrapLogger(lggr ocrcommontypes.Logger) ocrcommontypes.Logger {
+	return &p2pTrackingLogger{lggr, t}
+}
+
+type p2pTrackingLogger struct {
+	ocrcommontypes.Logger
+	tracker *p2pTracker
+}
+
+func (l *p2pTrackingLogger) Info(msg string, fields ocrcommontypes.LogFields) {
+	l.tracker.onLog(msg, fields)
+	l.Logger.Info(msg, fields)
+}
+
+func (l *p2pTrackingLogger) Warn(msg string, fields ocrcommontypes.LogFields) {
+	l.tracker.onLog(msg, fields)
+	l.Logger.Warn(msg, fields)
+}
+
+type endpointFactory struct {
+	ocrtypes.BinaryNetworkEndpointFactory
+	tracker *p2pTracker
+	jobID   int32
+}
+
+func (f *endpointFactory) NewEndpoint(cd ocrtypes.ConfigDigest, peerIDs []string,
+	v1bootstrappers []string, v2bootstrappers []ocrcommontypes.BootstrapperLocator,
+	failureThreshold int, tokenBucketRefillRate float64, tokenBucketSize int,
+) (ocrcommontypes.BinaryNetworkEndpoint, error) {
+	e, err := f.BinaryNetworkEndpointFactory.NewEndpoint(cd, peerIDs, v1bootstrappers, v2bootstrappers, failureThreshold, tokenBucketRefillRate, tokenBucketSize)
+	if err != nil {
+		return nil, err
+	}
+	return newTrackedEndpoint(e, f.tracker, f.jobID, cd.Hex(), peerIDs, v2bootstrappers), nil
+}
+
+type endpointFactory2 struct {
+	ocr2types.BinaryNetworkEndpointFactory
+	tracker *p2pTracker
+	jobID   int32
+}
+
+func (f *endpointFactory2) NewEndpoint(cd ocr2types.ConfigDigest, peerIDs []string,
+	v2bootstrappers []ocrcommontypes.BootstrapperLocator, fThreshold int, limits ocr2types.BinaryNetworkEndpointLimits,
+) (ocrcommontypes.BinaryNetworkEndpoint, error) {
+	e, err := f.BinaryNetworkEndpointFactory.NewEndpoint(cd, peerIDs, v2bootstrappers, fThreshold, limits)
+	if err != nil {
+		return nil, err
+	}
+	return newTrackedEndpoint(e, f.tracker, f.jobID, cd.Hex(), peerIDs, v2bootstrappers), nil
+}
+
+type peerTraffic struct {
+	messagesSent     int64
+	bytesSent        int64
+	messagesReceived int64
+	bytesReceived    int64
+	lastReceivedAt   time.Time
+}
+
+// trackedEndpoint counts the messages sent to and received from each oracle
+// of an endpoint
+type trackedEndpoint struct {
+	ocrcommontypes.BinaryNetworkEndpoint
+	tracker         *p2pTracker
+	jobID           int32
+	configDigest    string
+	peerIDs         []string
+	v2Bootstrappers []ocrcommontypes.BootstrapperLocator
+	// traffic is guarded by tracker.mu, and indexed by oracle ID
+	traffic []peerTraffic
+
+	chReceive chan ocrcommontypes.BinaryMessageWithSender
+	chStop    chan struct{}
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+}
+
+func newTrackedEndpoint(e ocrcommontypes.BinaryNetworkEndpoint, tracker *p2pTracker, jobID int32, configDigest string,
+	peerIDs []string, v2Bootstrappers []ocrcommontypes.BootstrapperLocator) *trackedEndpoint {
+	return &trackedEndpoint{
+		BinaryNetworkEndpoint: e,
+		tracker:               tracker,
+		jobID:                 jobID,
+		configDigest:          configDigest,
+		peerIDs:               peerIDs,
+		v2Bootstrappers:       v2Bootstrappers,
+		traffic:               make([]peerTraffic, len(peerIDs)),
+		chReceive:             make(chan ocrcommontypes.BinaryMessageWithSender),
+		chStop:                make(chan struct{}),
+	}
+}
+
+func (e *trackedEndpoint) Start() error {
+	if err := e.BinaryNetworkEndpoint.Start(); err != nil {
+		return err
+	}
+	e.tracker.addEndpoint(e)
+	e.wg.Add(1)
+	go e.forwardReceived()
+	return nil
+}
+
+func (e *trackedEndpoint) forwardReceived() {
+	defer e.wg.Done()
+	chInner := e.BinaryNetworkEndpoint.Receive()
+	for {
+		select {
+		case msg, ok := <-chInner:
+			if !ok {
+				close(e.chReceive)
+				return
+			}
+			e.record(int(msg.Sender), func(t *peerTraffic) {
+				t.messagesReceived++
+				t.bytesReceived += int64(len(msg.Msg))
+				t.lastReceivedAt = time.Now()
+			})
+			select {
+			case e.chReceive <- msg:
+			case <-e.chStop:
+				return
+			}
+		case <-e.chStop:
+			return
+		}
+	}
+}
+
+func (e *trackedEndpoint) record(oracleID int, update func(*peerTraffic)) {
+	if oracleID < 0 || oracleID >= len(e.traffic) {
+		return
+	}
+	e.tracker.mu.Lock()
+	defer e.tracker.mu.Unlock()
+	update(&e.traffic[oracleID])
+}
+
+func (e *trackedEndpoint) SendTo(payload []byte, to ocrcommontypes.OracleID) {
+	e.record(int(to), func(t *peerTraffic) {
+		t.messagesSent++
+		t.bytesSent += int64(len(payload))
+	})
+	e.BinaryNetworkEndpoint.SendTo(payload, to)
+}
+
+// Broadcast counts the message as sent to every oracle, the own oracle is
+// skipped by the status
+func (e *trackedEndpoint) Broadcast(payload []byte) {
+	e.tracker.mu.Lock()
+	for i := range e.traffic {
+		e.traffic[i].messagesSent++
+		e.traffic[i].bytesSent += int64(len(payload))
+	}
+	e.tracker.mu.Unlock()
+	e.BinaryNetworkEndpoint.Broadcast(payload)
+}
+
+func (e *trackedEndpoint) Receive() <-chan ocrcommontypes.BinaryMessageWithSender {
+	return e.chReceive
+}
+
+func (e *trackedEndpoint) Close() (err error) {
+	e.closeOnce.Do(func() {
+		close(e.chStop)
+		e.wg.Wait()
+		e.tracker.removeEndpoint(e)
+	})
+	return e.BinaryNetworkEndpoint.Close()
+}
+
+// normalizePeerID strips the p2p_ prefix of the peer IDs of the keystore
+func normalizePeerID(peerID string) string {
+	return strings.TrimPrefix(peerID, "p2p_")
+}
+
+// pingAddresses dials each address over TCP, measuring the time to connect
+func pingAddresses(ctx context.Context, addresses []string) []P2PPingResult {
+	results := make([]P2PPingResult, len(addresses))
+	var wg sync.WaitGroup
+	for i, address := range addresses {
+		wg.Add(1)
+		go func(i int, address string) {
+			defer wg.Done()
+			results[i].Address = address
+			dialer := net.Dialer{Timeout: p2pPingTimeout}
+			start := time.Now()
+			conn, err := dialer.DialContext(ctx, "tcp", address)
+			if err != nil {
+				results[i].Error = errors.Wrap(err, "dial failed").Error()
+				return
+			}
+			results[i].Latency = time.Since(start)
+			_ = conn.Close()
+		}(i, address)
+	}
+	wg.Wait()
+	return results
+}
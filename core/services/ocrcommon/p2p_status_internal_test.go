@@ -0,0 +1,118 @@
+package ocrcommon
+
+import (
+	"net"
+	"testing"
+
+	ocrcommontypes "github.com/smartcontractkit/libocr/commontypes"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+type fakeEndpoint struct {
+	chReceive chan ocrcommontypes.BinaryMessageWithSender
+	sent      int
+	closed    bool
+}
+
+func (e *fakeEndpoint) SendTo([]byte, ocrcommontypes.OracleID) { e.sent++ }
+func (e *fakeEndpoint) Broadcast([]byte)                       { e.sent++ }
+func (e *fakeEndpoint) Receive() <-chan ocrcommontypes.BinaryMessageWithSender {
+	return e.chReceive
+}
+func (e *fakeEndpoint) Start() error { return nil }
+func (e *fakeEndpoint) Close() error {
+	e.closed = true
+	return nil
+}
+
+type fakeEndpointFactory2 struct {
+	endpoint *fakeEndpoint
+}
+
+func (f *fakeEndpointFactory2) NewEndpoint(ocr2types.ConfigDigest, []string, []ocrcommontypes.BootstrapperLocator, int, ocr2types.BinaryNetworkEndpointLimits) (ocrcommontypes.BinaryNetworkEndpoint, error) {
+	return f.endpoint, nil
+}
+
+func (f *fakeEndpointFactory2) PeerID() string { return "self" }
+
+func Test_P2PTracker(t *testing.T) {
+	t.Parallel()
+
+	tracker := newP2PTracker()
+	lggr := tracker.wrapLogger(logger.NewOCRWrapper(logger.TestLogger(t), false, func(string) {}))
+
+	lggr.Warn(p2pLogDialError, ocrcommontypes.LogFields{p2pLogRemotePeerID: "peer1", "error": "connection refused"})
+	lggr.Info(p2pLogConnectionEstablished, ocrcommontypes.LogFields{p2pLogRemotePeerID: "peer1"})
+	lggr.Info(p2pLogConnectionEstablished, ocrcommontypes.LogFields{p2pLogRemotePeerID: "peer2"})
+	lggr.Info(p2pLogConnectionExited, ocrcommontypes.LogFields{p2pLogRemotePeerID: "peer2"})
+	lggr.Info("Unrelated", ocrcommontypes.LogFields{p2pLogRemotePeerID: "peer2"})
+
+	inner := &fakeEndpoint{chReceive: make(chan ocrcommontypes.BinaryMessageWithSender)}
+	factory := &endpointFactory2{&fakeEndpointFactory2{inner}, tracker, 42}
+	bootstrappers := []ocrcommontypes.BootstrapperLocator{{PeerID: "peer2", Addrs: []string{"127.0.0.1:1"}}}
+	endpoint, err := factory.NewEndpoint(ocr2types.ConfigDigest{1}, []string{"self", "peer1", "peer2"}, bootstrappers, 1, ocr2types.BinaryNetworkEndpointLimits{})
+	require.NoError(t, err)
+	require.NoError(t, endpoint.Start())
+
+	endpoint.SendTo([]byte("abc"), 1)
+	endpoint.Broadcast([]byte("abcdef"))
+	assert.Equal(t, 2, inner.sent)
+
+	inner.chReceive <- ocrcommontypes.BinaryMessageWithSender{Msg: []byte("ab"), Sender: 2}
+	received := <-endpoint.Receive()
+	assert.Equal(t, ocrcommontypes.OracleID(2), received.Sender)
+
+	jobs := tracker.jobs("self")
+	require.Len(t, jobs, 1)
+	assert.Equal(t, int32(42), jobs[0].JobID)
+	assert.Equal(t, ocr2types.ConfigDigest{1}.Hex(), jobs[0].ConfigDigest)
+	require.Len(t, jobs[0].Peers, 2)
+
+	peer1 := jobs[0].Peers[0]
+	assert.Equal(t, "peer1", peer1.PeerID)
+	assert.True(t, peer1.Connected)
+	assert.Equal(t, int64(1), peer1.DialFailures)
+	assert.Equal(t, "connection refused", peer1.LastDialError)
+	assert.Equal(t, int64(2), peer1.MessagesSent)
+	assert.Equal(t, int64(9), peer1.BytesSent)
+	assert.Nil(t, peer1.LastReceivedAt)
+
+	peer2 := jobs[0].Peers[1]
+	assert.False(t, peer2.Connected)
+	assert.Equal(t, int64(1), peer2.MessagesSent)
+	assert.Equal(t, int64(1), peer2.MessagesReceived)
+	assert.Equal(t, int64(2), peer2.BytesReceived)
+	assert.NotNil(t, peer2.LastReceivedAt)
+
+	status, addresses := tracker.peer("peer2")
+	assert.Equal(t, int64(1), status.MessagesReceived)
+	assert.Equal(t, []string{"127.0.0.1:1"}, addresses)
+
+	require.NoError(t, endpoint.Close())
+	assert.True(t, inner.closed)
+	assert.Empty(t, tracker.jobs("self"))
+}
+
+func Test_PingAddresses(t *testing.T) {
+	t.Parallel()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, ln.Close()) })
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	require.NoError(t, closed.Close())
+
+	results := pingAddresses(testutils.Context(t), []string{ln.Addr().String(), closed.Addr().String()})
+	require.Len(t, results, 2)
+	assert.Equal(t, ln.Addr().String(), results[0].Address)
+	assert.Empty(t, results[0].Error)
+	assert.Positive(t, results[0].Latency)
+	assert.Equal(t, closed.Addr().String(), results[1].Address)
+	assert.Contains(t, results[1].Error, "dial failed")
+}
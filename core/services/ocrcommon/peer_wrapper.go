@@ -2,6 +2,13 @@ package ocrcommon
 
 import (
 	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	p2ppeerstore "github.com/libp2p/go-libp2p-core/peerstore"
 
@@ -11,6 +18,7 @@ import (
 
 	p2ppeer "github.com/libp2p/go-libp2p-core/peer"
 	"github.com/pkg/errors"
+	ocrcommontypes "github.com/smartcontractkit/libocr/commontypes"
 	ocrnetworking "github.com/smartcontractkit/libocr/networking"
 	ocrnetworkingtypes "github.com/smartcontractkit/libocr/networking/types"
 	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting/types"
@@ -18,6 +26,7 @@ import (
 	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -52,6 +61,11 @@ type (
 		lggr          logger.Logger
 		PeerID        p2pkey.PeerID
 		pstoreWrapper *Pstorewrapper
+		tracker       *p2pTracker
+
+		// resolved by BootstrapperDiscovery
+		dnsBootstrappersMu sync.RWMutex
+		dnsBootstrappers   []ocrcommontypes.BootstrapperLocator
 
 		// V1V2 adapter
 		Peer *peerAdapter
@@ -99,6 +113,7 @@ func NewSingletonPeerWrapper(keyStore keystore.Master, config PeerWrapperConfig,
 		config:   config,
 		db:       db,
 		lggr:     lggr.Named("SingletonPeerWrapper"),
+		tracker:  newP2PTracker(),
 	}
 }
 
@@ -156,7 +171,7 @@ func (p *SingletonPeerWrapper) Start(context.Context) error {
 		peerConfig := ocrnetworking.PeerConfig{
 			NetworkingStack: p.config.P2PNetworkingStack(),
 			PrivKey:         key.PrivKey,
-			Logger:          logger.NewOCRWrapper(p.lggr, p.config.OCRTraceLogging(), func(string) {}),
+			Logger:          p.tracker.wrapLogger(logger.NewOCRWrapper(p.lggr, p.config.OCRTraceLogging(), func(string) {})),
 
 			// V1 config
 			V1ListenIP:                         p.config.P2PListenIP(),
@@ -218,3 +233,189 @@ func (p *SingletonPeerWrapper) Close() error {
 func (p *SingletonPeerWrapper) Config() PeerWrapperConfig {
 	return p.config
 }
+
+// JobPeer returns the OCR1 peer of a job, tracking the traffic of its
+// endpoints for the P2P status
+func (p *SingletonPeerWrapper) JobPeer(jobID int32) *peerAdapter {
+	if p.Peer == nil {
+		return nil
+	}
+	return &peerAdapter{
+		&endpointFactory{p.Peer.BinaryNetworkEndpointFactory, p.tracker, jobID},
+		p.Peer.BootstrapperFactory,
+	}
+}
+
+// JobPeer2 returns the OCR2 peer of a job, tracking the traffic of its
+// endpoints for the P2P status
+func (p *SingletonPeerWrapper) JobPeer2(jobID int32) *peerAdapter2 {
+	if p.Peer2 == nil {
+		return nil
+	}
+	return &peerAdapter2{
+		&endpointFactory2{p.Peer2.BinaryNetworkEndpointFactory, p.tracker, jobID},
+		p.Peer2.BootstrapperFactory,
+	}
+}
+
+// P2PV2Bootstrappers returns the default v2 bootstrapper peers of the jobs,
+// resolved from P2PV2_BOOTSTRAPPERS_DNS if set, or P2PV2_BOOTSTRAPPERS
+func (p *SingletonPeerWrapper) P2PV2Bootstrappers() []ocrcommontypes.BootstrapperLocator {
+	p.dnsBootstrappersMu.RLock()
+	defer p.dnsBootstrappersMu.RUnlock()
+	if p.dnsBootstrappers != nil {
+		return p.dnsBootstrappers
+	}
+	return p.config.P2PV2Bootstrappers()
+}
+
+// setDNSBootstrappers returns whether the resolved bootstrappers changed
+func (p *SingletonPeerWrapper) setDNSBootstrappers(locators []ocrcommontypes.BootstrapperLocator) bool {
+	p.dnsBootstrappersMu.Lock()
+	defer p.dnsBootstrappersMu.Unlock()
+	if reflect.DeepEqual(p.dnsBootstrappers, locators) {
+		return false
+	}
+	p.dnsBootstrappers = locators
+	return true
+}
+
+// Status returns the connectivity to the peers of the running OCR jobs
+func (p *SingletonPeerWrapper) Status() (P2PStatus, error) {
+	if !p.IsStarted() {
+		return P2PStatus{}, errors.New("peer is not started")
+	}
+	ns := p.config.P2PNetworkingStack()
+	status := P2PStatus{
+		PeerID:            p.PeerID.Raw(),
+		NetworkingStack:   ns.String(),
+		AnnounceAddresses: []string{},
+		Jobs:              p.tracker.jobs(p.PeerID.Raw()),
+	}
+	if ns == ocrnetworking.NetworkingStackV1 || ns == ocrnetworking.NetworkingStackV1V2 {
+		ip, port := p.config.P2PAnnounceIP(), p.config.P2PAnnouncePort()
+		if ip == nil {
+			ip = p.config.P2PListenIP()
+		}
+		if port == 0 {
+			port = p.config.P2PListenPort()
+		}
+		status.AnnounceAddresses = append(status.AnnounceAddresses, net.JoinHostPort(ip.String(), strconv.Itoa(int(port))))
+	}
+	if ns == ocrnetworking.NetworkingStackV2 || ns == ocrnetworking.NetworkingStackV1V2 {
+		// NewPeer falls back to the listen addresses as well
+		addresses := p.config.P2PV2AnnounceAddresses()
+		if len(addresses) == 0 {
+			addresses = p.config.P2PV2ListenAddresses()
+		}
+		status.AnnounceAddresses = append(status.AnnounceAddresses, addresses...)
+	}
+	return status, nil
+}
+
+// Ping returns the connectivity to a remote peer, and dials its addresses.
+// The addresses of the bootstrappers are known from the config and the jobs,
+// the others must be given.
+func (p *SingletonPeerWrapper) Ping(ctx context.Context, peerID string, addresses []string) (P2PPing, error) {
+	if !p.IsStarted() {
+		return P2PPing{}, errors.New("peer is not started")
+	}
+	peerID = normalizePeerID(peerID)
+	status, known := p.tracker.peer(peerID)
+	if len(addresses) == 0 {
+		for _, locator := range p.P2PV2Bootstrappers() {
+			if locator.PeerID == peerID {
+				known = append(known, locator.Addrs...)
+			}
+		}
+		addresses = uniqueStrings(known)
+	}
+	if len(addresses) == 0 {
+		return P2PPing{}, errors.Errorf("no known address for peer %s, the address must be given", peerID)
+	}
+	return P2PPing{PeerP2PStatus: status, Results: pingAddresses(ctx, addresses)}, nil
+}
+
+// ErrBootstrapperUnreachable is returned by CheckBootstrappers when none of
+// the addresses of a bootstrap peer accepts connections
+var ErrBootstrapperUnreachable = errors.New("bootstrap peer is unreachable")
+
+// bootstrapperCheckTimeout bounds CheckBootstrappers, leaving the rest of the
+// request time to create the job
+const bootstrapperCheckTimeout = 2 * time.Second
+
+// CheckBootstrappers dials the v2 bootstrap peers of an OCR or OCR2 job,
+// falling back to the default ones like the delegates do, and errors
+// unless each of them is reachable at one of its addresses at least
+func (p *SingletonPeerWrapper) CheckBootstrappers(ctx context.Context, jb job.Job) error {
+	if p.config.P2PNetworkingStack() == ocrnetworking.NetworkingStackV1 {
+		return nil
+	}
+	var specPeers []string
+	switch {
+	case jb.Type == job.OffchainReporting && jb.OCROracleSpec != nil:
+		specPeers = jb.OCROracleSpec.P2PV2Bootstrappers
+	case jb.Type == job.OffchainReporting2 && jb.OCR2OracleSpec != nil:
+		specPeers = jb.OCR2OracleSpec.P2PV2Bootstrappers
+	default:
+		return nil
+	}
+	bootstrappers, err := ParseBootstrapPeers(specPeers)
+	if err != nil {
+		return err
+	}
+	if len(bootstrappers) == 0 {
+		bootstrappers = p.P2PV2Bootstrappers()
+	}
+
+	var addresses []string
+	for _, locator := range bootstrappers {
+		// A bootstrap node may list itself
+		if normalizePeerID(locator.PeerID) == p.PeerID.Raw() {
+			continue
+		}
+		addresses = append(addresses, locator.Addrs...)
+	}
+	if len(addresses) == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, bootstrapperCheckTimeout)
+	defer cancel()
+	results := make(map[string]P2PPingResult, len(addresses))
+	for _, result := range pingAddresses(ctx, uniqueStrings(addresses)) {
+		results[result.Address] = result
+	}
+
+	var unreachable []string
+	for _, locator := range bootstrappers {
+		if len(locator.Addrs) == 0 || normalizePeerID(locator.PeerID) == p.PeerID.Raw() {
+			continue
+		}
+		var dialErrors []string
+		for _, address := range locator.Addrs {
+			if result := results[address]; result.Error != "" {
+				dialErrors = append(dialErrors, result.Error)
+			}
+		}
+		if len(dialErrors) == len(locator.Addrs) {
+			unreachable = append(unreachable, fmt.Sprintf("%s (%s)", locator.PeerID, strings.Join(dialErrors, "; ")))
+		}
+	}
+	if len(unreachable) > 0 {
+		return fmt.Errorf("%w: %s; check the addresses in the job's p2pv2Bootstrappers or in P2PV2_BOOTSTRAPPERS, and that the bootstrap peers accept connections from this node",
+			ErrBootstrapperUnreachable, strings.Join(unreachable, ", "))
+	}
+	return nil
+}
+
+func uniqueStrings(ss []string) []string {
+	seen := make(map[string]struct{}, len(ss))
+	var unique []string
+	for _, s := range ss {
+		if _, ok := seen[s]; !ok {
+			seen[s] = struct{}{}
+			unique = append(unique, s)
+		}
+	}
+	return unique
+}
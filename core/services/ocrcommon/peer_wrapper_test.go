@@ -1,19 +1,25 @@
 package ocrcommon_test
 
 import (
+	"net"
 	"testing"
 
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/services/ocrcommon"
 	"gopkg.in/guregu/null.v4"
 
+	"github.com/lib/pq"
 	p2ppeer "github.com/libp2p/go-libp2p-core/peer"
+	ocrnetworking "github.com/smartcontractkit/libocr/networking"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	configmocks "github.com/smartcontractkit/chainlink/core/config/mocks"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -91,3 +97,55 @@ func Test_SingletonPeerWrapper_Start(t *testing.T) {
 		require.Contains(t, pw.Start(testutils.Context(t)).Error(), "unable to find P2P key with id")
 	})
 }
+
+func Test_SingletonPeerWrapper_CheckBootstrappers(t *testing.T) {
+	t.Parallel()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, ln.Close()) })
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	require.NoError(t, closed.Close())
+
+	const bootstrapperID = "12D3KooWPjceQrSwdWXPyLLeABRXmuqt69Rg3sBYbU1Nft9HyQ6X"
+	reachable := bootstrapperID + "@" + ln.Addr().String()
+	unreachable := bootstrapperID + "@" + closed.Addr().String()
+
+	cfg := configmocks.NewGeneralConfig(t)
+	cfg.On("P2PNetworkingStack").Return(ocrnetworking.NetworkingStackV2)
+	pw := ocrcommon.NewSingletonPeerWrapper(nil, cfg, nil, logger.TestLogger(t))
+
+	t.Run("reachable bootstrapper", func(t *testing.T) {
+		jb := job.Job{Type: job.OffchainReporting2, OCR2OracleSpec: &job.OCR2OracleSpec{P2PV2Bootstrappers: pq.StringArray{reachable}}}
+		require.NoError(t, pw.CheckBootstrappers(testutils.Context(t), jb))
+	})
+
+	t.Run("bootstrapper reachable at one of its addresses", func(t *testing.T) {
+		jb := job.Job{Type: job.OffchainReporting, OCROracleSpec: &job.OCROracleSpec{
+			P2PV2Bootstrappers: pq.StringArray{unreachable + "/" + ln.Addr().String()},
+		}}
+		require.NoError(t, pw.CheckBootstrappers(testutils.Context(t), jb))
+	})
+
+	t.Run("unreachable bootstrapper", func(t *testing.T) {
+		jb := job.Job{Type: job.OffchainReporting2, OCR2OracleSpec: &job.OCR2OracleSpec{P2PV2Bootstrappers: pq.StringArray{reachable, unreachable}}}
+		err := pw.CheckBootstrappers(testutils.Context(t), jb)
+		require.ErrorIs(t, err, ocrcommon.ErrBootstrapperUnreachable)
+		assert.Contains(t, err.Error(), bootstrapperID)
+		assert.Contains(t, err.Error(), "dial failed")
+	})
+
+	t.Run("falls back to the configured bootstrappers", func(t *testing.T) {
+		locators, err := ocrcommon.ParseBootstrapPeers([]string{unreachable})
+		require.NoError(t, err)
+		cfg.On("P2PV2Bootstrappers").Return(locators).Once()
+
+		jb := job.Job{Type: job.OffchainReporting2, OCR2OracleSpec: &job.OCR2OracleSpec{}}
+		require.ErrorIs(t, pw.CheckBootstrappers(testutils.Context(t), jb), ocrcommon.ErrBootstrapperUnreachable)
+	})
+
+	t.Run("other job types", func(t *testing.T) {
+		require.NoError(t, pw.CheckBootstrappers(testutils.Context(t), job.Job{Type: job.Cron}))
+	})
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 )
 
 type txManager interface {
@@ -26,10 +27,14 @@ type transmitter struct {
 	forwardingAllowed bool
 	strategy          txmgr.TxStrategy
 	checker           txmgr.TransmitCheckerSpec
+	jobID             int32
+	budgets           pipeline.Budgets
 }
 
-// NewTransmitter creates a new eth transmitter
-func NewTransmitter(txm txManager, fromAddress common.Address, gasLimit uint32, forwardingAllowed bool, strategy txmgr.TxStrategy, checker txmgr.TransmitCheckerSpec) Transmitter {
+// NewTransmitter creates a new eth transmitter. The jobID is recorded on the
+// transactions if it is not zero, and the transmissions are skipped while the
+// job exceeds its budget if budgets is not nil.
+func NewTransmitter(txm txManager, fromAddress common.Address, gasLimit uint32, forwardingAllowed bool, strategy txmgr.TxStrategy, checker txmgr.TransmitCheckerSpec, jobID int32, budgets pipeline.Budgets) Transmitter {
 	return &transmitter{
 		txm:               txm,
 		fromAddress:       fromAddress,
@@ -37,19 +42,34 @@ func NewTransmitter(txm txManager, fromAddress common.Address, gasLimit uint32,
 		forwardingAllowed: forwardingAllowed,
 		strategy:          strategy,
 		checker:           checker,
+		jobID:             jobID,
+		budgets:           budgets,
 	}
 }
 
 func (t *transmitter) CreateEthTransaction(ctx context.Context, toAddress common.Address, payload []byte) error {
+	var meta *txmgr.EthTxMeta
+	if t.jobID != 0 {
+		meta = &txmgr.EthTxMeta{JobID: &t.jobID}
+		if t.budgets != nil && t.budgets.Exceeded(t.jobID) {
+			return errors.Wrapf(pipeline.ErrJobBudgetExceeded, "Skipped OCR transmission of job %d", t.jobID)
+		}
+	}
 	_, err := t.txm.CreateEthTransaction(txmgr.NewTx{
 		FromAddress:    t.fromAddress,
 		ToAddress:      toAddress,
 		EncodedPayload: payload,
 		GasLimit:       t.gasLimit,
+		Meta:           meta,
 		Forwardable:    t.forwardingAllowed,
 		Strategy:       t.strategy,
 		Checker:        t.checker,
+		Priority:       true,
 	}, pg.WithParentCtx(ctx))
+	if errors.Is(err, txmgr.ErrHeldInRetryBuffer) {
+		// The transmission is sent once the database recovers
+		return nil
+	}
 	return errors.Wrap(err, "Skipped OCR transmission")
 }
 
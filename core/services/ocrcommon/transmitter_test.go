@@ -3,6 +3,7 @@ package ocrcommon_test
 import (
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/services/ocrcommon"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 )
 
 func Test_Transmitter_CreateEthTransaction(t *testing.T) {
@@ -30,7 +32,7 @@ func Test_Transmitter_CreateEthTransaction(t *testing.T) {
 	txm := txmmocks.NewTxManager(t)
 	strategy := txmmocks.NewTxStrategy(t)
 
-	transmitter := ocrcommon.NewTransmitter(txm, fromAddress, gasLimit, forwardingAllowed, strategy, txmgr.TransmitCheckerSpec{})
+	transmitter := ocrcommon.NewTransmitter(txm, fromAddress, gasLimit, forwardingAllowed, strategy, txmgr.TransmitCheckerSpec{}, 0, nil)
 
 	txm.On("CreateEthTransaction", txmgr.NewTx{
 		FromAddress:    fromAddress,
@@ -39,6 +41,55 @@ func Test_Transmitter_CreateEthTransaction(t *testing.T) {
 		GasLimit:       gasLimit,
 		Meta:           nil,
 		Strategy:       strategy,
+		Priority:       true,
 	}, mock.Anything).Return(txmgr.EthTx{}, nil).Once()
 	require.NoError(t, transmitter.CreateEthTransaction(testutils.Context(t), toAddress, payload))
 }
+
+func Test_Transmitter_CreateEthTransaction_JobID(t *testing.T) {
+	t.Parallel()
+
+	fromAddress := testutils.NewAddress()
+	toAddress := testutils.NewAddress()
+	payload := []byte{1, 2, 3}
+	jobID := int32(42)
+	txm := txmmocks.NewTxManager(t)
+	strategy := txmmocks.NewTxStrategy(t)
+
+	transmitter := ocrcommon.NewTransmitter(txm, fromAddress, 1000, false, strategy, txmgr.TransmitCheckerSpec{}, jobID, nil)
+
+	txm.On("CreateEthTransaction", mock.MatchedBy(func(newTx txmgr.NewTx) bool {
+		return newTx.Meta != nil && newTx.Meta.JobID != nil && *newTx.Meta.JobID == jobID
+	}), mock.Anything).Return(txmgr.EthTx{}, nil).Once()
+	require.NoError(t, transmitter.CreateEthTransaction(testutils.Context(t), toAddress, payload))
+}
+
+func Test_Transmitter_CreateEthTransaction_HeldInRetryBuffer(t *testing.T) {
+	t.Parallel()
+
+	txm := txmmocks.NewTxManager(t)
+	strategy := txmmocks.NewTxStrategy(t)
+	transmitter := ocrcommon.NewTransmitter(txm, testutils.NewAddress(), 1000, false, strategy, txmgr.TransmitCheckerSpec{}, 0, nil)
+
+	txm.On("CreateEthTransaction", mock.Anything, mock.Anything).Return(txmgr.EthTx{}, txmgr.ErrHeldInRetryBuffer).Once()
+	require.NoError(t, transmitter.CreateEthTransaction(testutils.Context(t), testutils.NewAddress(), []byte{1, 2, 3}))
+
+	txm.On("CreateEthTransaction", mock.Anything, mock.Anything).Return(txmgr.EthTx{}, errors.New("boom")).Once()
+	require.Error(t, transmitter.CreateEthTransaction(testutils.Context(t), testutils.NewAddress(), []byte{1, 2, 3}))
+}
+
+type exceededBudgets map[int32]bool
+
+func (b exceededBudgets) Exceeded(jobID int32) bool { return b[jobID] }
+
+func Test_Transmitter_CreateEthTransaction_BudgetExceeded(t *testing.T) {
+	t.Parallel()
+
+	txm := txmmocks.NewTxManager(t)
+	strategy := txmmocks.NewTxStrategy(t)
+	transmitter := ocrcommon.NewTransmitter(txm, testutils.NewAddress(), 1000, false, strategy, txmgr.TransmitCheckerSpec{}, 42, exceededBudgets{42: true})
+
+	err := transmitter.CreateEthTransaction(testutils.Context(t), testutils.NewAddress(), []byte{1, 2, 3})
+	require.ErrorIs(t, err, pipeline.ErrJobBudgetExceeded)
+	txm.AssertNotCalled(t, "CreateEthTransaction", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,165 @@
+package otlp
+
+import (
+	"sort"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Field numbers of the OTLP logs messages, see
+// https://github.com/open-telemetry/opentelemetry-proto/blob/main/opentelemetry/proto/logs/v1/logs.proto
+const (
+	// ExportLogsServiceRequest
+	fieldResourceLogs protowire.Number = 1
+
+	// ResourceLogs
+	fieldResource  protowire.Number = 1
+	fieldScopeLogs protowire.Number = 2
+
+	// Resource
+	fieldResourceAttributes protowire.Number = 1
+
+	// ScopeLogs
+	fieldScope      protowire.Number = 1
+	fieldLogRecords protowire.Number = 2
+
+	// InstrumentationScope
+	fieldScopeName    protowire.Number = 1
+	fieldScopeVersion protowire.Number = 2
+
+	// LogRecord
+	fieldTimeUnixNano         protowire.Number = 1
+	fieldSeverityNumber       protowire.Number = 2
+	fieldBody                 protowire.Number = 5
+	fieldAttributes           protowire.Number = 6
+	fieldObservedTimeUnixNano protowire.Number = 11
+
+	// KeyValue
+	fieldKey   protowire.Number = 1
+	fieldValue protowire.Number = 2
+
+	// AnyValue
+	fieldStringValue protowire.Number = 1
+	fieldBytesValue  protowire.Number = 7
+)
+
+// Severity numbers of the OTLP log records
+const (
+	severityInfo  = 9
+	severityError = 17
+)
+
+// rawCodec passes the messages through as they are, since the OTLP messages
+// are encoded by hand rather than generated from the proto files
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v interface{}) ([]byte, error) {
+	switch m := v.(type) {
+	case []byte:
+		return m, nil
+	case *[]byte:
+		return *m, nil
+	}
+	return nil, errUnsupportedMessage
+}
+
+func (rawCodec) Unmarshal(data []byte, v interface{}) error {
+	m, ok := v.(*[]byte)
+	if !ok {
+		return errUnsupportedMessage
+	}
+	*m = append((*m)[:0], data...)
+	return nil
+}
+
+// Name is the content subtype sent to the collector
+func (rawCodec) Name() string {
+	return "proto"
+}
+
+// encodeExportLogsRequest encodes an ExportLogsServiceRequest holding events
+// as log records of a single resource and scope
+func encodeExportLogsRequest(resource map[string]string, scopeName, scopeVersion string, events []Event) []byte {
+	var res []byte
+	res = appendAttributes(res, fieldResourceAttributes, resource)
+
+	var scope []byte
+	scope = appendString(scope, fieldScopeName, scopeName)
+	scope = appendString(scope, fieldScopeVersion, scopeVersion)
+
+	var scopeLogs []byte
+	scopeLogs = appendMessage(scopeLogs, fieldScope, scope)
+	for _, e := range events {
+		scopeLogs = appendMessage(scopeLogs, fieldLogRecords, encodeLogRecord(e))
+	}
+
+	var resourceLogs []byte
+	resourceLogs = appendMessage(resourceLogs, fieldResource, res)
+	resourceLogs = appendMessage(resourceLogs, fieldScopeLogs, scopeLogs)
+
+	return appendMessage(nil, fieldResourceLogs, resourceLogs)
+}
+
+func encodeLogRecord(e Event) []byte {
+	var b []byte
+	b = protowire.AppendTag(b, fieldTimeUnixNano, protowire.Fixed64Type)
+	b = protowire.AppendFixed64(b, uint64(e.Time.UnixNano()))
+
+	severity := severityInfo
+	if e.Error {
+		severity = severityError
+	}
+	b = protowire.AppendTag(b, fieldSeverityNumber, protowire.VarintType)
+	b = protowire.AppendVarint(b, uint64(severity))
+
+	if len(e.Body) > 0 {
+		b = appendMessage(b, fieldBody, encodeAnyValue(e.Body))
+	}
+
+	attrs := make(map[string]string, len(e.Attributes)+1)
+	for k, v := range e.Attributes {
+		attrs[k] = v
+	}
+	attrs["event.name"] = e.Name
+	b = appendAttributes(b, fieldAttributes, attrs)
+
+	b = protowire.AppendTag(b, fieldObservedTimeUnixNano, protowire.Fixed64Type)
+	return protowire.AppendFixed64(b, uint64(e.Time.UnixNano()))
+}
+
+// encodeAnyValue encodes body as a string if it is valid UTF-8, and as bytes
+// otherwise
+func encodeAnyValue(body []byte) []byte {
+	if utf8.Valid(body) {
+		return appendString(nil, fieldStringValue, string(body))
+	}
+	b := protowire.AppendTag(nil, fieldBytesValue, protowire.BytesType)
+	return protowire.AppendBytes(b, body)
+}
+
+// appendAttributes appends attrs as KeyValue messages, sorted by key
+func appendAttributes(b []byte, num protowire.Number, attrs map[string]string) []byte {
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		var kv []byte
+		kv = appendString(kv, fieldKey, k)
+		kv = appendMessage(kv, fieldValue, appendString(nil, fieldStringValue, attrs[k]))
+		b = appendMessage(b, num, kv)
+	}
+	return b
+}
+
+func appendString(b []byte, num protowire.Number, s string) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, s)
+}
+
+func appendMessage(b []byte, num protowire.Number, msg []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, msg)
+}
@@ -0,0 +1,281 @@
+package otlp
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	uuid "github.com/satori/go.uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/static"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// ExportLogsMethod is the full name of the OTLP method the events are sent to
+const ExportLogsMethod = "/opentelemetry.proto.collector.logs.v1.LogsService/Export"
+
+const (
+	// bufferSize is the number of events buffered for export, events exported
+	// while the buffer is full are dropped
+	bufferSize      = 1000
+	maxBatchSize    = 100
+	flushInterval   = time.Second
+	sendTimeout     = 10 * time.Second
+	maxSendAttempts = 5
+	scopeName       = "chainlink"
+)
+
+var errUnsupportedMessage = errors.New("unsupported message type")
+
+var (
+	promExportedEvents = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "otlp_exporter_exported_events",
+		Help: "Number of telemetry events exported to the OTLP collector",
+	})
+	promDroppedEvents = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "otlp_exporter_dropped_events",
+		Help: "Number of telemetry events dropped because the buffer was full or the OTLP collector could not be reached",
+	})
+)
+
+// Config configures the OTLP collector the events are exported to
+type Config interface {
+	AppID() uuid.UUID
+	TelemetryExporterURL() *url.URL
+	TelemetryExporterCACertPath() string
+	TelemetryExporterCertPath() string
+	TelemetryExporterKeyPath() string
+}
+
+// Event is exported as an OTLP log record
+type Event struct {
+	Name       string
+	Time       time.Time
+	Error      bool
+	Attributes map[string]string
+	Body       []byte
+}
+
+// Exporter streams job run summaries, OCR telemetry and transaction events to
+// an OTLP collector over gRPC, in batches
+type Exporter interface {
+	services.ServiceCtx
+	// Export queues e for export, without blocking
+	Export(e Event)
+	// ExportRun exports the summary of a finished pipeline run
+	ExportRun(run *pipeline.Run)
+	// ExportTx exports a change of state of a transaction
+	ExportTx(e txmgr.TxEvent)
+}
+
+type exporter struct {
+	utils.StartStopOnce
+	conn     *grpc.ClientConn
+	resource map[string]string
+	lggr     logger.Logger
+
+	chEvents chan Event
+	chStop   chan struct{}
+	wgDone   sync.WaitGroup
+}
+
+var _ Exporter = (*exporter)(nil)
+
+// NewExporter returns an Exporter sending to the collector at
+// TelemetryExporterURL. The url scheme is grpcs for TLS connections, with a
+// client certificate if TelemetryExporterCertPath is set, and grpc otherwise.
+func NewExporter(cfg Config, lggr logger.Logger) (Exporter, error) {
+	creds, err := transportCredentials(cfg)
+	if err != nil {
+		return nil, err
+	}
+	// Dial does not block, the connection is established on first use
+	conn, err := grpc.Dial(cfg.TelemetryExporterURL().Host, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create telemetry exporter connection")
+	}
+	return &exporter{
+		conn: conn,
+		resource: map[string]string{
+			"service.name":        "chainlink",
+			"service.version":     static.Version,
+			"service.instance.id": cfg.AppID().String(),
+		},
+		lggr:     lggr.Named("TelemetryExporter"),
+		chEvents: make(chan Event, bufferSize),
+		chStop:   make(chan struct{}),
+	}, nil
+}
+
+func transportCredentials(cfg Config) (credentials.TransportCredentials, error) {
+	u := cfg.TelemetryExporterURL()
+	switch u.Scheme {
+	case "grpc":
+		return insecure.NewCredentials(), nil
+	case "grpcs":
+	default:
+		return nil, errors.Errorf("invalid telemetry exporter url scheme %q, must be grpc or grpcs", u.Scheme)
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if path := cfg.TelemetryExporterCACertPath(); path != "" {
+		pem, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read telemetry exporter CA certificate")
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, errors.Errorf("no certificates found in %s", path)
+		}
+	}
+	if certPath, keyPath := cfg.TelemetryExporterCertPath(), cfg.TelemetryExporterKeyPath(); certPath != "" || keyPath != "" {
+		cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load telemetry exporter client certificate")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+func (e *exporter) Start(context.Context) error {
+	return e.StartOnce("TelemetryExporter", func() error {
+		e.wgDone.Add(1)
+		go e.run()
+		return nil
+	})
+}
+
+func (e *exporter) Close() error {
+	return e.StopOnce("TelemetryExporter", func() error {
+		close(e.chStop)
+		e.wgDone.Wait()
+		return e.conn.Close()
+	})
+}
+
+func (e *exporter) Export(ev Event) {
+	select {
+	case e.chEvents <- ev:
+	default:
+		promDroppedEvents.Inc()
+		e.lggr.Warnw("Telemetry exporter buffer is full, dropping event", "event", ev.Name)
+	}
+}
+
+func (e *exporter) ExportRun(run *pipeline.Run) {
+	attrs := map[string]string{
+		"job.id":    strconv.Itoa(int(run.PipelineSpec.JobID)),
+		"job.name":  run.PipelineSpec.JobName,
+		"job.type":  run.PipelineSpec.JobType,
+		"run.id":    strconv.FormatInt(run.ID, 10),
+		"run.state": string(run.State),
+	}
+	if run.FinishedAt.Valid {
+		attrs["run.duration_ms"] = strconv.FormatInt(run.FinishedAt.Time.Sub(run.CreatedAt).Milliseconds(), 10)
+	}
+	if run.HasFatalErrors() {
+		attrs["run.error"] = run.FatalErrors.ToError().Error()
+	}
+	e.Export(Event{
+		Name:       "pipeline_run",
+		Time:       time.Now(),
+		Error:      run.HasFatalErrors(),
+		Attributes: attrs,
+		Body:       []byte(fmt.Sprintf("Pipeline run %d of job %d %s", run.ID, run.PipelineSpec.JobID, run.State)),
+	})
+}
+
+func (e *exporter) ExportTx(tx txmgr.TxEvent) {
+	attrs := map[string]string{
+		"evm.chain_id": tx.EVMChainID.String(),
+		"tx.id":        strconv.FormatInt(tx.EthTxID, 10),
+		"tx.from":      tx.FromAddress.Hex(),
+		"tx.to":        tx.ToAddress.Hex(),
+		"tx.state":     string(tx.State),
+	}
+	if tx.PipelineTaskRunID.Valid {
+		attrs["pipeline.task_run_id"] = tx.PipelineTaskRunID.UUID.String()
+	}
+	if tx.TxHash != nil {
+		attrs["tx.hash"] = tx.TxHash.Hex()
+	}
+	if tx.Error != "" {
+		attrs["tx.error"] = tx.Error
+	}
+	e.Export(Event{
+		Name:       "eth_tx",
+		Time:       tx.Time,
+		Error:      tx.State == txmgr.EthTxFatalError,
+		Attributes: attrs,
+		Body:       []byte(fmt.Sprintf("Transaction %d %s", tx.EthTxID, tx.State)),
+	})
+}
+
+func (e *exporter) run() {
+	defer e.wgDone.Done()
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	var batch []Event
+	for {
+		select {
+		case <-e.chStop:
+			return
+		case ev := <-e.chEvents:
+			batch = append(batch, ev)
+			if len(batch) < maxBatchSize {
+				continue
+			}
+		case <-ticker.C:
+			if len(batch) == 0 {
+				continue
+			}
+		}
+		e.send(batch)
+		batch = nil
+	}
+}
+
+// send exports batch, retrying with backoff up to maxSendAttempts times
+func (e *exporter) send(batch []Event) {
+	req := encodeExportLogsRequest(e.resource, scopeName, static.Version, batch)
+	b := utils.NewRedialBackoff()
+	for attempt := 1; ; attempt++ {
+		ctx, cancel := utils.ContextFromChanWithDeadline(e.chStop, sendTimeout)
+		var resp []byte
+		err := e.conn.Invoke(ctx, ExportLogsMethod, req, &resp, grpc.ForceCodec(rawCodec{}))
+		cancel()
+		if err == nil {
+			promExportedEvents.Add(float64(len(batch)))
+			return
+		}
+		if attempt == maxSendAttempts {
+			promDroppedEvents.Add(float64(len(batch)))
+			e.lggr.Errorw("Failed to export telemetry, dropping events", "err", err, "events", len(batch))
+			return
+		}
+		e.lggr.Warnw("Failed to export telemetry, retrying", "err", err, "attempt", attempt)
+		select {
+		case <-e.chStop:
+			return
+		case <-time.After(b.Duration()):
+		}
+	}
+}
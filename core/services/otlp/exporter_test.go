@@ -0,0 +1,112 @@
+package otlp_test
+
+import (
+	"bytes"
+	"net"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/otlp"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+type testConfig struct {
+	url *url.URL
+}
+
+func (c testConfig) AppID() uuid.UUID                    { return uuid.NewV4() }
+func (c testConfig) TelemetryExporterURL() *url.URL      { return c.url }
+func (c testConfig) TelemetryExporterCACertPath() string { return "" }
+func (c testConfig) TelemetryExporterCertPath() string   { return "" }
+func (c testConfig) TelemetryExporterKeyPath() string    { return "" }
+
+// rawCodec receives the requests without decoding them
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v interface{}) ([]byte, error) { return *(v.(*[]byte)), nil }
+func (rawCodec) Unmarshal(data []byte, v interface{}) error {
+	*(v.(*[]byte)) = append([]byte{}, data...)
+	return nil
+}
+func (rawCodec) Name() string { return "proto" }
+
+func newCollector(t *testing.T) (*url.URL, chan []byte) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	chRequests := make(chan []byte, 10)
+	srv := grpc.NewServer(grpc.ForceServerCodec(rawCodec{}), grpc.UnknownServiceHandler(func(_ interface{}, stream grpc.ServerStream) error {
+		var req []byte
+		if err := stream.RecvMsg(&req); err != nil {
+			return err
+		}
+		if method, _ := grpc.MethodFromServerStream(stream); method != otlp.ExportLogsMethod {
+			return status.Errorf(codes.Unimplemented, "unknown method %s", method)
+		}
+		chRequests <- req
+		resp := []byte{}
+		return stream.SendMsg(&resp)
+	}))
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	return &url.URL{Scheme: "grpc", Host: lis.Addr().String()}, chRequests
+}
+
+func TestExporter(t *testing.T) {
+	u, chRequests := newCollector(t)
+	exporter, err := otlp.NewExporter(testConfig{u}, logger.TestLogger(t))
+	require.NoError(t, err)
+	require.NoError(t, exporter.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, exporter.Close()) })
+
+	now := time.Now()
+	exporter.ExportRun(&pipeline.Run{
+		ID:           42,
+		PipelineSpec: pipeline.Spec{JobID: 7, JobName: "my-job", JobType: "directrequest"},
+		State:        pipeline.RunStatusCompleted,
+		CreatedAt:    now.Add(-time.Second),
+		FinishedAt:   null.TimeFrom(now),
+	})
+	hash := common.HexToHash("0x1234")
+	exporter.ExportTx(txmgr.TxEvent{
+		EthTxID:    3,
+		EVMChainID: testutils.FixtureChainID,
+		State:      txmgr.EthTxUnconfirmed,
+		TxHash:     &hash,
+		Time:       now,
+	})
+
+	// the events may be exported in one or more batches
+	var req []byte
+	for !bytes.Contains(req, []byte("pipeline_run")) || !bytes.Contains(req, []byte("eth_tx")) {
+		select {
+		case r := <-chRequests:
+			req = append(req, r...)
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatal("timed out waiting for export")
+		}
+	}
+
+	for _, s := range []string{"service.name", "chainlink", "pipeline_run", "my-job", "directrequest", "run.duration_ms", "1000", "eth_tx", hash.Hex(), string(txmgr.EthTxUnconfirmed)} {
+		assert.True(t, bytes.Contains(req, []byte(s)), "request does not contain %q", s)
+	}
+}
+
+func TestNewExporter_InvalidScheme(t *testing.T) {
+	_, err := otlp.NewExporter(testConfig{&url.URL{Scheme: "http", Host: "localhost:4317"}}, logger.TestLogger(t))
+	require.EqualError(t, err, `invalid telemetry exporter url scheme "http", must be grpc or grpcs`)
+}
@@ -0,0 +1,44 @@
+package otlp
+
+import (
+	"time"
+
+	ocrtypes "github.com/smartcontractkit/libocr/commontypes"
+
+	"github.com/smartcontractkit/chainlink/core/services/telemetry"
+)
+
+var _ telemetry.MonitoringEndpointGenerator = &MonitoringEndpointGenerator{}
+
+// MonitoringEndpointGenerator exports the OCR telemetry sent to the endpoints
+// of another generator
+type MonitoringEndpointGenerator struct {
+	gen      telemetry.MonitoringEndpointGenerator
+	exporter Exporter
+}
+
+func NewMonitoringEndpointGenerator(gen telemetry.MonitoringEndpointGenerator, exporter Exporter) *MonitoringEndpointGenerator {
+	return &MonitoringEndpointGenerator{gen, exporter}
+}
+
+// GenMonitoringEndpoint creates a monitoring endpoint for telemetry
+func (g *MonitoringEndpointGenerator) GenMonitoringEndpoint(contractID string) ocrtypes.MonitoringEndpoint {
+	return &monitoringEndpoint{g.gen.GenMonitoringEndpoint(contractID), g.exporter, contractID}
+}
+
+type monitoringEndpoint struct {
+	endpoint   ocrtypes.MonitoringEndpoint
+	exporter   Exporter
+	contractID string
+}
+
+// SendLog sends the telemetry to the wrapped endpoint and exports it
+func (e *monitoringEndpoint) SendLog(log []byte) {
+	e.endpoint.SendLog(log)
+	e.exporter.Export(Event{
+		Name:       "ocr_telemetry",
+		Time:       time.Now(),
+		Attributes: map[string]string{"ocr.contract_id": e.contractID},
+		Body:       log,
+	})
+}
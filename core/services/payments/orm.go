@@ -0,0 +1,124 @@
+package payments
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// Payment is the LINK paid by an OracleRequest to an oracle or operator
+// contract served by a job. The payment is locked in the contract until the
+// request is fulfilled, or refunded if it's cancelled.
+type Payment struct {
+	JobID           int32
+	EVMChainID      utils.Big
+	ContractAddress common.Address
+	RequestID       common.Hash
+	Requester       common.Address
+	Amount          assets.Link
+	RequestTxHash   common.Hash
+}
+
+// JobEarnings is the LINK earned by a job, with the wei spent by its
+// transactions to reconcile them
+type JobEarnings struct {
+	JobID int32
+	Name  null.String
+	// MonthEarned is the LINK earned since the start of the month
+	MonthEarned assets.Link
+	TotalEarned assets.Link
+	// Pending is the LINK of the requests not fulfilled nor cancelled yet
+	Pending   assets.Link
+	Requests  int64
+	Fulfilled int64
+	Cancelled int64
+	// MonthSpend and TotalSpend are the wei spent by the transactions of the
+	// job, as accounted for its budget
+	MonthSpend assets.Eth
+	TotalSpend assets.Eth
+}
+
+// ORM stores the payments of the requests served by the jobs
+type ORM interface {
+	// RecordRequest stores a requested payment, unless its request was
+	// already recorded
+	RecordRequest(p Payment, qopts ...pg.QOpt) error
+	// MarkFulfilled marks the requested payment of the job as earned
+	MarkFulfilled(jobID int32, requestID common.Hash, qopts ...pg.QOpt) error
+	// MarkCancelled marks the requested payment of the job as refunded
+	MarkCancelled(jobID int32, requestID common.Hash, qopts ...pg.QOpt) error
+	// JobEarnings returns the earnings of the jobs with payments, with their
+	// earnings since monthStart
+	JobEarnings(monthStart time.Time, qopts ...pg.QOpt) ([]JobEarnings, error)
+	// FindJobEarnings returns the earnings of the job, or sql.ErrNoRows if it
+	// doesn't exist
+	FindJobEarnings(jobID int32, monthStart time.Time, qopts ...pg.QOpt) (JobEarnings, error)
+}
+
+type orm struct {
+	q pg.Q
+}
+
+var _ ORM = (*orm)(nil)
+
+// NewORM returns an ORM of the payments of the jobs
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig) ORM {
+	return &orm{pg.NewQ(db, lggr.Named("PaymentsORM"), cfg)}
+}
+
+func (o *orm) RecordRequest(p Payment, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	_, err := q.Exec(`INSERT INTO link_payments (job_id, evm_chain_id, contract_address, request_id, requester, amount, state, request_tx_hash, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, 'requested', $7, NOW())
+ON CONFLICT (evm_chain_id, contract_address, request_id) DO NOTHING`, p.JobID, p.EVMChainID, p.ContractAddress, p.RequestID, p.Requester, p.Amount, p.RequestTxHash)
+	return errors.Wrap(err, "RecordRequest failed")
+}
+
+func (o *orm) MarkFulfilled(jobID int32, requestID common.Hash, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	_, err := q.Exec(`UPDATE link_payments SET state = 'fulfilled', fulfilled_at = NOW() WHERE job_id = $1 AND request_id = $2 AND state = 'requested'`, jobID, requestID)
+	return errors.Wrap(err, "MarkFulfilled failed")
+}
+
+func (o *orm) MarkCancelled(jobID int32, requestID common.Hash, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	_, err := q.Exec(`UPDATE link_payments SET state = 'cancelled', cancelled_at = NOW() WHERE job_id = $1 AND request_id = $2 AND state = 'requested'`, jobID, requestID)
+	return errors.Wrap(err, "MarkCancelled failed")
+}
+
+const selectJobEarnings = `SELECT jobs.id AS job_id, jobs.name,
+	COALESCE(SUM(link_payments.amount) FILTER (WHERE link_payments.state = 'fulfilled' AND link_payments.fulfilled_at >= $1), 0) AS month_earned,
+	COALESCE(SUM(link_payments.amount) FILTER (WHERE link_payments.state = 'fulfilled'), 0) AS total_earned,
+	COALESCE(SUM(link_payments.amount) FILTER (WHERE link_payments.state = 'requested'), 0) AS pending,
+	COUNT(link_payments.id) AS requests,
+	COUNT(link_payments.id) FILTER (WHERE link_payments.state = 'fulfilled') AS fulfilled,
+	COUNT(link_payments.id) FILTER (WHERE link_payments.state = 'cancelled') AS cancelled,
+	COALESCE((SELECT SUM(fee) FROM job_spends WHERE job_spends.job_id = jobs.id AND job_spends.confirmed_at >= $1), 0) AS month_spend,
+	COALESCE((SELECT SUM(fee) FROM job_spends WHERE job_spends.job_id = jobs.id), 0) AS total_spend
+FROM jobs
+LEFT JOIN link_payments ON link_payments.job_id = jobs.id`
+
+func (o *orm) JobEarnings(monthStart time.Time, qopts ...pg.QOpt) (earnings []JobEarnings, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Select(&earnings, selectJobEarnings+`
+WHERE link_payments.id IS NOT NULL
+GROUP BY jobs.id
+ORDER BY jobs.id`, monthStart)
+	return earnings, errors.Wrap(err, "JobEarnings failed")
+}
+
+func (o *orm) FindJobEarnings(jobID int32, monthStart time.Time, qopts ...pg.QOpt) (earnings JobEarnings, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Get(&earnings, selectJobEarnings+`
+WHERE jobs.id = $2
+GROUP BY jobs.id`, monthStart, jobID)
+	return earnings, errors.Wrap(err, "FindJobEarnings failed")
+}
@@ -0,0 +1,79 @@
+package payments_test
+
+import (
+	"database/sql"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/payments"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestORM_JobEarnings(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	_, from := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+	jb := cltest.MustInsertV2JobSpec(t, db, from)
+	orm := payments.NewORM(db, logger.TestLogger(t), cfg)
+
+	contract := testutils.NewAddress()
+	for i, amount := range []int64{100, 200, 400} {
+		require.NoError(t, orm.RecordRequest(payments.Payment{
+			JobID:           jb.ID,
+			EVMChainID:      *utils.NewBigI(0),
+			ContractAddress: contract,
+			RequestID:       common.Hash{byte(i + 1)},
+			Requester:       testutils.NewAddress(),
+			Amount:          *assets.NewLinkFromJuels(amount),
+			RequestTxHash:   utils.NewHash(),
+		}))
+	}
+	// the request is recorded once
+	require.NoError(t, orm.RecordRequest(payments.Payment{
+		JobID:           jb.ID,
+		EVMChainID:      *utils.NewBigI(0),
+		ContractAddress: contract,
+		RequestID:       common.Hash{1},
+		Amount:          *assets.NewLinkFromJuels(1000),
+	}))
+	require.NoError(t, orm.MarkFulfilled(jb.ID, common.Hash{1}))
+	require.NoError(t, orm.MarkCancelled(jb.ID, common.Hash{2}))
+	// a fulfilled payment can't be cancelled
+	require.NoError(t, orm.MarkCancelled(jb.ID, common.Hash{1}))
+	pgtest.MustExec(t, db, `INSERT INTO job_spends (eth_tx_id, job_id, evm_chain_id, gas_used, fee, confirmed_at) VALUES (1, $1, 0, 21000, 42000, NOW())`, jb.ID)
+
+	earnings, err := orm.JobEarnings(time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Len(t, earnings, 1)
+	assert.Equal(t, jb.ID, earnings[0].JobID)
+	assert.Equal(t, int64(3), earnings[0].Requests)
+	assert.Equal(t, int64(1), earnings[0].Fulfilled)
+	assert.Equal(t, int64(1), earnings[0].Cancelled)
+	assert.Equal(t, big.NewInt(100), earnings[0].MonthEarned.ToInt())
+	assert.Equal(t, big.NewInt(100), earnings[0].TotalEarned.ToInt())
+	assert.Equal(t, big.NewInt(400), earnings[0].Pending.ToInt())
+	assert.Equal(t, big.NewInt(42000), earnings[0].MonthSpend.ToInt())
+	assert.Equal(t, big.NewInt(42000), earnings[0].TotalSpend.ToInt())
+
+	found, err := orm.FindJobEarnings(jb.ID, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, found.MonthEarned.IsZero())
+	assert.Equal(t, big.NewInt(100), found.TotalEarned.ToInt())
+	assert.Zero(t, found.MonthSpend.ToInt().Sign())
+
+	_, err = orm.FindJobEarnings(jb.ID+1, time.Now())
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
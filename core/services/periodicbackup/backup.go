@@ -23,8 +23,17 @@ var (
 	filePattern        = "cl_backup_%s.dump"
 	minBackupFrequency = time.Minute
 
-	excludedDataFromTables = []string{
+	// TransientTables hold state that is only valid while a node is running,
+	// their data is never backed up
+	TransientTables = []string{
+		"lease_lock",
+		"sessions",
+	}
+	// LiteExcludedTables are the tables whose data is excluded from lite
+	// backups
+	LiteExcludedTables = []string{
 		"pipeline_runs",
+		"pipeline_run_heartbeats",
 		"pipeline_task_runs",
 	}
 )
@@ -165,8 +174,11 @@ func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
 		"-F", "c", // format: custom (zipped)
 	}
 
+	for _, table := range TransientTables {
+		args = append(args, fmt.Sprintf("--exclude-table-data=%s", table))
+	}
 	if backup.mode == config.DatabaseBackupModeLite {
-		for _, table := range excludedDataFromTables {
+		for _, table := range LiteExcludedTables {
 			args = append(args, fmt.Sprintf("--exclude-table-data=%s", table))
 		}
 	}
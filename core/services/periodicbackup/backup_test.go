@@ -42,6 +42,7 @@ func TestPeriodicBackup_RunBackup(t *testing.T) {
 	assert.Equal(t, file.Size(), result.size)
 	assert.Contains(t, result.path, "backup/cl_backup_0.9.9")
 	assert.NotContains(t, result.pgDumpArguments, "--exclude-table-data=pipeline_task_runs")
+	assert.Contains(t, result.pgDumpArguments, "--exclude-table-data=lease_lock")
 }
 
 func TestPeriodicBackup_RunBackupInLiteMode(t *testing.T) {
@@ -0,0 +1,202 @@
+package pg
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// ColumnKeySize is the size of the AES-256 keys used to encrypt columns
+const ColumnKeySize = 32
+
+// encryptedColumnPrefix marks values encrypted with a column key. Values
+// without it are legacy plaintext and are returned as is.
+const encryptedColumnPrefix = "enc:v1:"
+
+// EncryptedColumn is a table column holding secrets encrypted at rest
+type EncryptedColumn struct {
+	Table string
+	// Key is the primary key column of Table
+	Key    string
+	Column string
+}
+
+// EncryptedColumns lists every column encrypted with the column key
+var EncryptedColumns = []EncryptedColumn{
+	{Table: "bridge_types", Key: "name", Column: "outgoing_token"},
+	{Table: "external_initiators", Key: "id", Column: "outgoing_token"},
+	{Table: "external_initiators", Key: "id", Column: "outgoing_secret"},
+	{Table: "external_databases", Key: "name", Column: "url"},
+	{Table: "run_output_subscriptions", Key: "id", Column: "secret"},
+	{Table: "webhook_specs", Key: "id", Column: "public_trigger_secret"},
+}
+
+// ColumnCipher encrypts column values with AES-256-GCM
+type ColumnCipher struct {
+	id   string
+	aead cipher.AEAD
+}
+
+// NewColumnCipher returns a cipher for a key of ColumnKeySize bytes
+func NewColumnCipher(key []byte) (*ColumnCipher, error) {
+	if len(key) != ColumnKeySize {
+		return nil, errors.Errorf("column key must be %d bytes, got %d", ColumnKeySize, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(key)
+	return &ColumnCipher{id: hex.EncodeToString(hash[:4]), aead: aead}, nil
+}
+
+// NewColumnKey returns a random column key
+func NewColumnKey() ([]byte, error) {
+	key := make([]byte, ColumnKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, errors.Wrap(err, "failed to generate column key")
+	}
+	return key, nil
+}
+
+// Encrypt returns plaintext sealed with the cipher's key, tagged with the key ID
+func (c *ColumnCipher) Encrypt(plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", errors.Wrap(err, "failed to generate nonce")
+	}
+	sealed := c.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return fmt.Sprintf("%s%s:%s", encryptedColumnPrefix, c.id, base64.StdEncoding.EncodeToString(sealed)), nil
+}
+
+func (c *ColumnCipher) decrypt(sealed []byte) (string, error) {
+	nonceSize := c.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return "", errors.New("encrypted column value is too short")
+	}
+	plaintext, err := c.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], nil)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to decrypt column value")
+	}
+	return string(plaintext), nil
+}
+
+// ColumnCiphers holds the ciphers of every column key of the keystore. Values
+// are encrypted with the active one, and decrypted with whichever one they are
+// tagged with. Until a key is registered, or if it is nil, values are stored
+// as is.
+type ColumnCiphers struct {
+	mu     sync.RWMutex
+	active *ColumnCipher
+	byID   map[string]*ColumnCipher
+}
+
+// NewColumnCiphers returns a ColumnCiphers without any key
+func NewColumnCiphers() *ColumnCiphers {
+	return &ColumnCiphers{byID: make(map[string]*ColumnCipher)}
+}
+
+// Register makes keys available to decrypt column values, and the last one
+// the key new values are encrypted with
+func (cs *ColumnCiphers) Register(keys ...[]byte) error {
+	ciphers := make([]*ColumnCipher, len(keys))
+	for i, key := range keys {
+		c, err := NewColumnCipher(key)
+		if err != nil {
+			return err
+		}
+		ciphers[i] = c
+	}
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	for _, c := range ciphers {
+		cs.byID[c.id] = c
+		cs.active = c
+	}
+	return nil
+}
+
+// Encrypt encrypts plaintext with the active column key. The keystore
+// registers its column keys when unlocked; until then values are stored as is.
+func (cs *ColumnCiphers) Encrypt(plaintext string) (string, error) {
+	if cs == nil {
+		return plaintext, nil
+	}
+	cs.mu.RLock()
+	active := cs.active
+	cs.mu.RUnlock()
+	if active == nil {
+		return plaintext, nil
+	}
+	return active.Encrypt(plaintext)
+}
+
+// Decrypt returns the plaintext of a value written by Encrypt
+func (cs *ColumnCiphers) Decrypt(value string) (string, error) {
+	if !strings.HasPrefix(value, encryptedColumnPrefix) {
+		return value, nil
+	}
+	id, encoded, ok := strings.Cut(strings.TrimPrefix(value, encryptedColumnPrefix), ":")
+	if !ok {
+		return "", errors.New("malformed encrypted column value")
+	}
+	var c *ColumnCipher
+	if cs != nil {
+		cs.mu.RLock()
+		c = cs.byID[id]
+		cs.mu.RUnlock()
+	}
+	if c == nil {
+		return "", errors.Errorf("column key %s is not available, is the keystore unlocked?", id)
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", errors.Wrap(err, "malformed encrypted column value")
+	}
+	return c.decrypt(sealed)
+}
+
+// ReencryptColumns rewrites every value of EncryptedColumns with c,
+// including values still stored as plaintext
+func (cs *ColumnCiphers) ReencryptColumns(q Queryer, c *ColumnCipher) error {
+	for _, col := range EncryptedColumns {
+		var rows []struct {
+			Key   string
+			Value string
+		}
+		sql := fmt.Sprintf(`SELECT %s::text AS key, %s AS value FROM %s WHERE %s IS NOT NULL FOR UPDATE`, col.Key, col.Column, col.Table, col.Column)
+		if err := q.Select(&rows, sql); err != nil {
+			return errors.Wrapf(err, "failed to load %s.%s", col.Table, col.Column)
+		}
+		update := fmt.Sprintf(`UPDATE %s SET %s = $1 WHERE %s::text = $2`, col.Table, col.Column, col.Key)
+		for _, row := range rows {
+			plaintext, err := cs.Decrypt(row.Value)
+			if err != nil {
+				return errors.Wrapf(err, "failed to decrypt %s.%s of %s", col.Table, col.Column, row.Key)
+			}
+			encrypted, err := c.Encrypt(plaintext)
+			if err != nil {
+				return err
+			}
+			if _, err = q.Exec(update, encrypted, row.Key); err != nil {
+				return errors.Wrapf(err, "failed to update %s.%s of %s", col.Table, col.Column, row.Key)
+			}
+		}
+	}
+	return nil
+}
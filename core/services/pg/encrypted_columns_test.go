@@ -0,0 +1,78 @@
+package pg
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestColumnEncryption(t *testing.T) {
+	key, err := NewColumnKey()
+	require.NoError(t, err)
+	c, err := NewColumnCipher(key)
+	require.NoError(t, err)
+	ciphers := NewColumnCiphers()
+
+	t.Run("passes plaintext through", func(t *testing.T) {
+		plaintext, err := ciphers.Decrypt("legacy-token")
+		require.NoError(t, err)
+		assert.Equal(t, "legacy-token", plaintext)
+	})
+
+	t.Run("requires the key to be registered", func(t *testing.T) {
+		encrypted, err := c.Encrypt("token")
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(encrypted, encryptedColumnPrefix+c.id+":"))
+		_, err = ciphers.Decrypt(encrypted)
+		assert.ErrorContains(t, err, "is not available")
+
+		require.NoError(t, ciphers.Register(key))
+		plaintext, err := ciphers.Decrypt(encrypted)
+		require.NoError(t, err)
+		assert.Equal(t, "token", plaintext)
+	})
+
+	t.Run("encrypts with the last registered key", func(t *testing.T) {
+		newKey, err := NewColumnKey()
+		require.NoError(t, err)
+		require.NoError(t, ciphers.Register(key, newKey))
+		newCipher, err := NewColumnCipher(newKey)
+		require.NoError(t, err)
+
+		encrypted, err := ciphers.Encrypt("token")
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(encrypted, encryptedColumnPrefix+newCipher.id+":"))
+		assert.NotContains(t, encrypted, "token")
+		plaintext, err := ciphers.Decrypt(encrypted)
+		require.NoError(t, err)
+		assert.Equal(t, "token", plaintext)
+	})
+
+	t.Run("rejects tampered values", func(t *testing.T) {
+		encrypted, err := ciphers.Encrypt("token")
+		require.NoError(t, err)
+		tampered := encrypted[:len(encrypted)-2] + "AA"
+		if tampered == encrypted {
+			tampered = encrypted[:len(encrypted)-2] + "BB"
+		}
+		_, err = ciphers.Decrypt(tampered)
+		assert.Error(t, err)
+	})
+
+	t.Run("passes values through without keys", func(t *testing.T) {
+		var none *ColumnCiphers
+		stored, err := none.Encrypt("token")
+		require.NoError(t, err)
+		assert.Equal(t, "token", stored)
+
+		encrypted, err := ciphers.Encrypt("token")
+		require.NoError(t, err)
+		_, err = none.Decrypt(encrypted)
+		assert.ErrorContains(t, err, "is not available")
+	})
+
+	_, err = NewColumnCipher([]byte("short"))
+	assert.ErrorContains(t, err, "column key must be 32 bytes")
+}
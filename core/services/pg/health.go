@@ -0,0 +1,38 @@
+package pg
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+)
+
+const healthCheckTimeout = 5 * time.Second
+
+// DBHealthChecker reports whether the database can be reached, so that it is
+// included in the node's health checks.
+type DBHealthChecker struct {
+	db *sqlx.DB
+}
+
+// NewDBHealthChecker returns a checkable for db
+func NewDBHealthChecker(db *sqlx.DB) *DBHealthChecker {
+	return &DBHealthChecker{db}
+}
+
+// Ready returns an error if the database cannot be pinged
+func (c *DBHealthChecker) Ready() error {
+	return c.ping()
+}
+
+// Healthy returns an error if the database cannot be pinged
+func (c *DBHealthChecker) Healthy() error {
+	return c.ping()
+}
+
+func (c *DBHealthChecker) ping() error {
+	ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+	defer cancel()
+	return errors.Wrap(c.db.PingContext(ctx), "failed to ping database")
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
 
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -19,11 +21,12 @@ type LockedDB interface {
 }
 
 type lockedDb struct {
-	cfg          config.GeneralConfig
-	lggr         logger.Logger
-	db           *sqlx.DB
-	leaseLock    LeaseLock
-	advisoryLock AdvisoryLock
+	cfg            config.GeneralConfig
+	lggr           logger.Logger
+	db             *sqlx.DB
+	leaseLock      LeaseLock
+	advisoryLock   AdvisoryLock
+	statsCollector prometheus.Collector
 }
 
 // NewLockedDB creates a new instance of LockedDB.
@@ -57,6 +60,12 @@ func (l *lockedDb) Open(ctx context.Context) (err error) {
 		// l.db will be nil in case of error
 		return errors.Wrap(err, "failed to open db")
 	}
+	// Export the connection pool stats, e.g. to monitor its saturation
+	l.statsCollector = collectors.NewDBStatsCollector(l.db.DB, "chainlink")
+	if err2 := prometheus.Register(l.statsCollector); err2 != nil {
+		l.lggr.Warnw("Failed to register DB stats collector", "err", err2)
+		l.statsCollector = nil
+	}
 	revert := func() {
 		// Let Open() return the actual error, while l.Close() error is just logged.
 		if err2 := l.Close(); err2 != nil {
@@ -99,8 +108,13 @@ func (l *lockedDb) Close() error {
 		l.db = nil
 		l.advisoryLock = nil
 		l.leaseLock = nil
+		l.statsCollector = nil
 	}()
 
+	if l.statsCollector != nil {
+		prometheus.Unregister(l.statsCollector)
+	}
+
 	// Step 1: release DB locks
 	if l.advisoryLock != nil {
 		l.advisoryLock.Release()
@@ -60,6 +60,7 @@ type (
 	}
 
 	Config interface {
+		BridgeHealthWindow() time.Duration
 		BridgeResponseURL() *url.URL
 		DatabaseURL() url.URL
 		DefaultHTTPLimit() int64
@@ -366,6 +367,16 @@ const (
 	TaskTypeHexEncode        TaskType = "hexencode"
 	TaskTypeBase64Decode     TaskType = "base64decode"
 	TaskTypeBase64Encode     TaskType = "base64encode"
+	TaskTypeVerifySignature  TaskType = "verifysignature"
+	TaskTypePublish          TaskType = "publish"
+	TaskTypeDBQuery          TaskType = "dbquery"
+	TaskTypeIPFS             TaskType = "ipfs"
+	TaskTypeKeccak256        TaskType = "keccak256"
+	TaskTypeSHA256           TaskType = "sha256"
+	TaskTypeMerkleRoot       TaskType = "merkleroot"
+	TaskTypeMerkleProof      TaskType = "merkleproof"
+	TaskTypeDeviation        TaskType = "deviation"
+	TaskTypeSetState         TaskType = "setstate"
 
 	// Testing only.
 	TaskTypePanic TaskType = "panic"
@@ -460,6 +471,27 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &Base64DecodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeBase64Encode:
 		task = &Base64EncodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeVerifySignature:
+		task = &VerifySignatureTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypePublish:
+		task = &PublishTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeDBQuery:
+		task = &DBQueryTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeIPFS:
+		task = &IPFSTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeKeccak256:
+		task = &Keccak256Task{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeSHA256:
+		task = &SHA256Task{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeMerkleRoot:
+		task = &MerkleRootTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeMerkleProof:
+		task = &MerkleProofTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeDeviation:
+		// failEarly by default, to skip the rest of the run within the thresholds
+		task = &DeviationTask{BaseTask: BaseTask{id: ID, dotID: dotID, FailEarly: true}}
+	case TaskTypeSetState:
+		task = &SetStateTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	default:
 		return nil, errors.Errorf(`unknown task type: "%v"`, taskType)
 	}
@@ -490,6 +522,17 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 	if err != nil {
 		return nil, err
 	}
+
+	// invalid response schemas are rejected with the job, not on each run
+	switch t := task.(type) {
+	case *BridgeTask:
+		_, err = ParseResponseSchema(t.ResponseSchema)
+	case *HTTPTask:
+		_, err = ParseResponseSchema(t.ResponseSchema)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "task %s", dotID)
+	}
 	return task, nil
 }
 
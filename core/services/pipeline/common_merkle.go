@@ -0,0 +1,96 @@
+package pipeline
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+const (
+	HashFunctionKeccak256 = "keccak256"
+	HashFunctionSHA256    = "sha256"
+)
+
+func sha256Hash(data []byte) []byte {
+	h := sha256.Sum256(data)
+	return h[:]
+}
+
+func hashFunction(name string) (func(data ...[]byte) []byte, error) {
+	switch strings.ToLower(name) {
+	case HashFunctionKeccak256:
+		return crypto.Keccak256, nil
+	case HashFunctionSHA256:
+		return func(data ...[]byte) []byte {
+			return sha256Hash(bytes.Join(data, nil))
+		}, nil
+	default:
+		return nil, errors.Wrapf(ErrBadInput, "unsupported hash function %q", name)
+	}
+}
+
+// merkleTree returns the levels of the Merkle tree of leaves, from the leaves
+// to the root. The last node of a level with an odd number of nodes is moved
+// up to the next level as is. With sortPairs, the nodes of each pair are
+// sorted before being hashed, like the MerkleProof library of OpenZeppelin
+// expects, so that the proofs do not need the positions of the nodes.
+func merkleTree(leaves [][]byte, hash func(data ...[]byte) []byte, sortPairs bool) ([][][]byte, error) {
+	if len(leaves) == 0 {
+		return nil, errors.Wrap(ErrBadInput, "at least one leaf is required")
+	}
+	levels := [][][]byte{leaves}
+	for level := leaves; len(level) > 1; {
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+				continue
+			}
+			left, right := level[i], level[i+1]
+			if sortPairs && bytes.Compare(left, right) > 0 {
+				left, right = right, left
+			}
+			next = append(next, hash(left, right))
+		}
+		levels = append(levels, next)
+		level = next
+	}
+	return levels, nil
+}
+
+// merkleProof returns the sibling nodes from the leaf at index to the root
+func merkleProof(levels [][][]byte, index int) ([][]byte, error) {
+	if index < 0 || index >= len(levels[0]) {
+		return nil, errors.Wrapf(ErrBadInput, "leaf index %d out of range, the tree has %d leaves", index, len(levels[0]))
+	}
+	var proof [][]byte
+	for _, level := range levels[:len(levels)-1] {
+		sibling := index ^ 1
+		if sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+		index /= 2
+	}
+	return proof, nil
+}
+
+// merkleLeaves converts the leaves of the merkle tasks to bytes, and hashes
+// them if hashLeaves is set
+func merkleLeaves(values SliceParam, hash func(data ...[]byte) []byte, hashLeaves bool) ([][]byte, error) {
+	leaves := make([][]byte, len(values))
+	for i, value := range values {
+		var leaf BytesParam
+		if err := leaf.UnmarshalPipelineParam(value); err != nil {
+			return nil, errors.Wrapf(err, "leaf %d", i)
+		}
+		if hashLeaves {
+			leaves[i] = hash(leaf)
+		} else {
+			leaves[i] = leaf
+		}
+	}
+	return leaves, nil
+}
@@ -159,6 +159,13 @@ func TestUnmarshalTaskFromMap(t *testing.T) {
 		{pipeline.TaskTypeConditional, &pipeline.ConditionalTask{}},
 		{pipeline.TaskTypeHexDecode, &pipeline.HexDecodeTask{}},
 		{pipeline.TaskTypeBase64Decode, &pipeline.Base64DecodeTask{}},
+		{pipeline.TaskTypeVerifySignature, &pipeline.VerifySignatureTask{}},
+		{pipeline.TaskTypeKeccak256, &pipeline.Keccak256Task{}},
+		{pipeline.TaskTypeSHA256, &pipeline.SHA256Task{}},
+		{pipeline.TaskTypeMerkleRoot, &pipeline.MerkleRootTask{}},
+		{pipeline.TaskTypeMerkleProof, &pipeline.MerkleProofTask{}},
+		{pipeline.TaskTypeDeviation, &pipeline.DeviationTask{}},
+		{pipeline.TaskTypeSetState, &pipeline.SetStateTask{}},
 	}
 
 	for _, test := range tests {
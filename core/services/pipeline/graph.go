@@ -208,6 +208,24 @@ func (p *Pipeline) ByDotID(id string) Task {
 	return nil
 }
 
+// Attributes returns the attributes of the task with the DOT ID, like its type
+// and params, as written in the spec
+func (p *Pipeline) Attributes(dotID string) map[string]string {
+	if p.tree == nil {
+		return nil
+	}
+	for nodes := p.tree.Nodes(); nodes.Next(); {
+		if node, is := nodes.Node().(*GraphNode); is && node.dotID == dotID {
+			attrs := make(map[string]string, len(node.attrs))
+			for k, v := range node.attrs {
+				attrs[k] = v
+			}
+			return attrs
+		}
+	}
+	return nil
+}
+
 func Parse(text string) (*Pipeline, error) {
 	g := NewGraph()
 	err := g.UnmarshalText([]byte(text))
@@ -249,3 +249,18 @@ func TestGraph_ImplicitDependencies(t *testing.T) {
 	require.True(t, g.HasEdgeFromTo(nodes["b"], nodes["c"]))
 	require.True(t, g.HasEdgeFromTo(nodes["c"], nodes["d"]))
 }
+
+func TestPipeline_Attributes(t *testing.T) {
+	t.Parallel()
+
+	p, err := pipeline.Parse(`
+		ds   [type=http method=GET url="https://chain.link/eth_usd"];
+		mult [type=multiply times=<100>];
+		ds -> mult;
+	`)
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]string{"type": "http", "method": "GET", "url": "https://chain.link/eth_usd"}, p.Attributes("ds"))
+	require.Equal(t, map[string]string{"type": "multiply", "times": "100"}, p.Attributes("mult"))
+	require.Nil(t, p.Attributes("missing"))
+}
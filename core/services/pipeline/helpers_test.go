@@ -56,3 +56,30 @@ func (t *ETHTxTask) HelperSetDependencies(cc evm.ChainSet, keyStore ETHKeyStore,
 	t.specGasLimit = specGasLimit
 	t.jobType = jobType
 }
+
+func (t *ETHTxTask) HelperSetBudgets(budgets Budgets, jobID int32) {
+	t.budgets = budgets
+	t.jobID = jobID
+}
+
+func (t *PublishTask) HelperSetDependencies(publisher Publisher, jobID int32, jobName string) {
+	t.publisher = publisher
+	t.jobID = jobID
+	t.jobName = jobName
+}
+
+func (t *DBQueryTask) HelperSetDependencies(databases ExternalDatabases) {
+	t.databases = databases
+}
+
+func (t *IPFSTask) HelperSetDependencies(config Config, ipfs IPFS) {
+	t.config = config
+	t.ipfs = ipfs
+}
+
+func (t *DeviationTask) HelperSetDependencies(orm ORM, specID int32) {
+	t.orm = orm
+	t.specID = specID
+}
+
+var PromReaperDeletedRuns = promReaperDeletedRuns
@@ -0,0 +1,173 @@
+package pipeline
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// MaintenanceWindowAction sets what happens to the new runs submitting
+// transactions during a maintenance window
+type MaintenanceWindowAction string
+
+const (
+	// MaintenanceWindowActionSkip rejects the runs
+	MaintenanceWindowActionSkip MaintenanceWindowAction = "skip"
+	// MaintenanceWindowActionQueue stores the runs, and starts them once the
+	// window ends
+	MaintenanceWindowActionQueue MaintenanceWindowAction = "queue"
+)
+
+// ErrRunSkippedMaintenanceWindow is returned when a new run submitting
+// transactions is started during a maintenance window
+var ErrRunSkippedMaintenanceWindow = errors.New("run skipped during a maintenance window")
+
+const maintenanceWindowsCheckInterval = time.Second
+
+// MaintenanceWindow is a period during which the new runs submitting
+// transactions, of a job or of every job, are skipped or queued
+type MaintenanceWindow struct {
+	ID int64
+	// JobID is nil for the windows applying to every job
+	JobID     *int32
+	StartsAt  time.Time
+	EndsAt    time.Time
+	Action    MaintenanceWindowAction
+	Reason    string
+	CreatedAt time.Time
+}
+
+// Validate returns an error if the window is malformed
+func (w MaintenanceWindow) Validate() error {
+	if !w.EndsAt.After(w.StartsAt) {
+		return errors.New("maintenance window must end after it starts")
+	}
+	switch w.Action {
+	case MaintenanceWindowActionSkip, MaintenanceWindowActionQueue:
+		return nil
+	default:
+		return errors.Errorf("invalid maintenance window action %q, must be %s or %s", w.Action, MaintenanceWindowActionSkip, MaintenanceWindowActionQueue)
+	}
+}
+
+// Active returns true if the window is in progress at t
+func (w MaintenanceWindow) Active(t time.Time) bool {
+	return !t.Before(w.StartsAt) && t.Before(w.EndsAt)
+}
+
+type queuedRun struct {
+	run                    *Run
+	l                      logger.Logger
+	saveSuccessfulTaskRuns bool
+}
+
+// SetMaintenanceWindows replaces the maintenance windows of the runner
+func (r *runner) SetMaintenanceWindows(windows []MaintenanceWindow) {
+	r.windowsMu.Lock()
+	defer r.windowsMu.Unlock()
+	r.windows = windows
+}
+
+// QueuedRuns returns the number of runs waiting for the end of a maintenance
+// window or for the runner to be unpaused
+func (r *runner) QueuedRuns() int {
+	r.queueMu.Lock()
+	defer r.queueMu.Unlock()
+	return len(r.queue)
+}
+
+// maintenanceWindowAction returns the action of the maintenance windows
+// applying to the run, if it submits transactions and has not started yet.
+// Skipping takes precedence when windows overlap.
+func (r *runner) maintenanceWindowAction(run *Run, p *Pipeline) MaintenanceWindowAction {
+	if !submitsTransactions(p) {
+		return ""
+	}
+	for _, tr := range run.PipelineTaskRuns {
+		if tr.FinishedAt.Valid {
+			return ""
+		}
+	}
+	return r.activeMaintenanceWindowAction(run.PipelineSpec.JobID)
+}
+
+func (r *runner) activeMaintenanceWindowAction(jobID int32) MaintenanceWindowAction {
+	r.windowsMu.RLock()
+	defer r.windowsMu.RUnlock()
+	now := time.Now()
+	var action MaintenanceWindowAction
+	for _, w := range r.windows {
+		if !w.Active(now) || (w.JobID != nil && *w.JobID != jobID) {
+			continue
+		}
+		if w.Action == MaintenanceWindowActionSkip {
+			return MaintenanceWindowActionSkip
+		}
+		action = MaintenanceWindowActionQueue
+	}
+	return action
+}
+
+func (r *runner) enqueueRun(run *Run, l logger.Logger, saveSuccessfulTaskRuns bool) {
+	l.Infow("Run queued until the end of the maintenance window or of the pause", "runID", run.ID)
+	r.queueMu.Lock()
+	defer r.queueMu.Unlock()
+	r.queue = append(r.queue, queuedRun{run, l, saveSuccessfulTaskRuns})
+}
+
+// runQueuedRunsLoop starts the queued runs once the runner is unpaused and no
+// maintenance window applies to them anymore
+func (r *runner) runQueuedRunsLoop() {
+	defer r.wgDone.Done()
+	ctx, cancel := utils.ContextFromChan(r.chStop)
+	defer cancel()
+
+	ticker := time.NewTicker(maintenanceWindowsCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.chStop:
+			return
+		case <-ticker.C:
+		}
+
+		if r.paused.Load() {
+			continue
+		}
+		r.queueMu.Lock()
+		var ready []queuedRun
+		waiting := r.queue[:0]
+		for _, q := range r.queue {
+			if r.activeMaintenanceWindowAction(q.run.PipelineSpec.JobID) == "" {
+				ready = append(ready, q)
+			} else {
+				waiting = append(waiting, q)
+			}
+		}
+		r.queue = waiting
+		r.queueMu.Unlock()
+
+		for _, q := range ready {
+			q := q
+			r.wgDone.Add(1)
+			go func() {
+				defer r.wgDone.Done()
+				if _, err := r.Run(ctx, q.run, q.l, q.saveSuccessfulTaskRuns, nil); err != nil && ctx.Err() == nil {
+					q.l.Errorw("Queued run failed", "runID", q.run.ID, "err", err)
+				}
+			}()
+		}
+	}
+}
+
+func submitsTransactions(p *Pipeline) bool {
+	for _, task := range p.Tasks {
+		if task.Type() == TaskTypeETHTx {
+			return true
+		}
+	}
+	return false
+}
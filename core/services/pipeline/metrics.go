@@ -0,0 +1,195 @@
+package pipeline
+
+import (
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Values of the JobPipelineMetricsJobIDLabel config, setting how the job_id
+// label of the pipeline metrics is reported
+const (
+	// MetricsJobIDLabelKeep reports the job ID
+	MetricsJobIDLabelKeep = "keep"
+	// MetricsJobIDLabelHash reports one of jobIDLabelHashBuckets values
+	// derived from the job ID, bounding the cardinality of the metrics
+	MetricsJobIDLabelHash = "hash"
+	// MetricsJobIDLabelDrop reports an empty job_id label, which prometheus
+	// treats as absent
+	MetricsJobIDLabelDrop = "drop"
+)
+
+const jobIDLabelHashBuckets = 256
+
+var (
+	labelNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	// reservedLabelNames are the labels set by the node
+	reservedLabelNames = map[string]bool{"job_id": true, "job_name": true, "task_id": true, "task_type": true, "status": true}
+)
+
+// ValidateMetricsLabelName returns an error if name can't be used as a custom
+// label of the pipeline metrics
+func ValidateMetricsLabelName(name string) error {
+	if !labelNameRegexp.MatchString(name) || strings.HasPrefix(name, "__") {
+		return errors.Errorf("invalid metrics label name %q", name)
+	}
+	if reservedLabelNames[name] {
+		return errors.Errorf("metrics label %q is reserved", name)
+	}
+	return nil
+}
+
+type promMetrics struct {
+	jobIDLabel   string
+	customLabels []string
+
+	taskExecutionTime        *prometheus.GaugeVec
+	runErrors                *prometheus.CounterVec
+	runTotalTimeToCompletion *prometheus.GaugeVec
+	tasksTotalFinished       *prometheus.CounterVec
+}
+
+var (
+	metricsMu sync.RWMutex
+	metrics   = mustRegisterMetrics(newPromMetrics(MetricsJobIDLabelKeep, nil))
+)
+
+func newPromMetrics(jobIDLabel string, customLabels []string) *promMetrics {
+	labels := func(names ...string) []string {
+		return append(append([]string{"job_id", "job_name"}, names...), customLabels...)
+	}
+	return &promMetrics{
+		jobIDLabel:   jobIDLabel,
+		customLabels: customLabels,
+		taskExecutionTime: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "pipeline_task_execution_time",
+			Help: "How long each pipeline task took to execute",
+		},
+			labels("task_id", "task_type"),
+		),
+		runErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "pipeline_run_errors",
+			Help: "Number of errors for each pipeline spec",
+		},
+			labels(),
+		),
+		runTotalTimeToCompletion: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "pipeline_run_total_time_to_completion",
+			Help: "How long each pipeline run took to finish (from the moment it was created)",
+		},
+			labels(),
+		),
+		tasksTotalFinished: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "pipeline_tasks_total_finished",
+			Help: "The total number of pipeline tasks which have finished",
+		},
+			labels("task_id", "task_type", "status"),
+		),
+	}
+}
+
+func (m *promMetrics) collectors() []prometheus.Collector {
+	return []prometheus.Collector{m.taskExecutionTime, m.runErrors, m.runTotalTimeToCompletion, m.tasksTotalFinished}
+}
+
+func mustRegisterMetrics(m *promMetrics) *promMetrics {
+	prometheus.MustRegister(m.collectors()...)
+	return m
+}
+
+// ConfigureMetrics sets how the job_id label of the pipeline metrics is
+// reported, and which custom labels declared by the job specs are added to
+// them. Changing the labels resets the metrics.
+func ConfigureMetrics(jobIDLabel string, customLabels []string) error {
+	switch jobIDLabel {
+	case MetricsJobIDLabelKeep, MetricsJobIDLabelHash, MetricsJobIDLabelDrop:
+	default:
+		return errors.Errorf("invalid job_id label mode %q, must be one of %s, %s or %s", jobIDLabel, MetricsJobIDLabelKeep, MetricsJobIDLabelHash, MetricsJobIDLabelDrop)
+	}
+	seen := make(map[string]bool, len(customLabels))
+	for _, name := range customLabels {
+		if err := ValidateMetricsLabelName(name); err != nil {
+			return err
+		}
+		if seen[name] {
+			return errors.Errorf("duplicate metrics label %q", name)
+		}
+		seen[name] = true
+	}
+
+	metricsMu.Lock()
+	defer metricsMu.Unlock()
+	if metrics.jobIDLabel == jobIDLabel && equalStrings(metrics.customLabels, customLabels) {
+		return nil
+	}
+	for _, c := range metrics.collectors() {
+		prometheus.Unregister(c)
+	}
+	metrics = mustRegisterMetrics(newPromMetrics(jobIDLabel, customLabels))
+	return nil
+}
+
+func (m *promMetrics) labels(spec Spec) prometheus.Labels {
+	labels := prometheus.Labels{"job_name": spec.JobName}
+	switch m.jobIDLabel {
+	case MetricsJobIDLabelHash:
+		h := fnv.New32a()
+		_, _ = fmt.Fprintf(h, "%d", spec.JobID)
+		labels["job_id"] = fmt.Sprintf("%02x", h.Sum32()%jobIDLabelHashBuckets)
+	case MetricsJobIDLabelDrop:
+		labels["job_id"] = ""
+	default:
+		labels["job_id"] = fmt.Sprintf("%d", spec.JobID)
+	}
+	for _, name := range m.customLabels {
+		labels[name] = spec.MetricsLabels[name]
+	}
+	return labels
+}
+
+// RecordTaskRun records the execution time and the status of a task of spec
+func RecordTaskRun(spec Spec, taskID string, taskType string, elapsed time.Duration, status string) {
+	metricsMu.RLock()
+	defer metricsMu.RUnlock()
+
+	labels := metrics.labels(spec)
+	labels["task_id"] = taskID
+	labels["task_type"] = taskType
+	metrics.taskExecutionTime.With(labels).Set(float64(elapsed))
+	labels["status"] = status
+	metrics.tasksTotalFinished.With(labels).Inc()
+}
+
+// RecordRunError counts an errored run of spec
+func RecordRunError(spec Spec) {
+	metricsMu.RLock()
+	defer metricsMu.RUnlock()
+
+	metrics.runErrors.With(metrics.labels(spec)).Inc()
+}
+
+// RecordRunTimeToCompletion records how long a run of spec took to finish
+func RecordRunTimeToCompletion(spec Spec, runTime time.Duration) {
+	metricsMu.RLock()
+	defer metricsMu.RUnlock()
+
+	metrics.runTotalTimeToCompletion.With(metrics.labels(spec)).Set(float64(runTime))
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
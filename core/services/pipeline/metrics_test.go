@@ -0,0 +1,44 @@
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigureMetrics(t *testing.T) {
+	t.Cleanup(func() { require.NoError(t, ConfigureMetrics(MetricsJobIDLabelKeep, nil)) })
+
+	assert.EqualError(t, ConfigureMetrics("foo", nil), `invalid job_id label mode "foo", must be one of keep, hash or drop`)
+	assert.EqualError(t, ConfigureMetrics(MetricsJobIDLabelKeep, []string{"job_name"}), `metrics label "job_name" is reserved`)
+	assert.EqualError(t, ConfigureMetrics(MetricsJobIDLabelKeep, []string{"feed-name"}), `invalid metrics label name "feed-name"`)
+	assert.EqualError(t, ConfigureMetrics(MetricsJobIDLabelKeep, []string{"__feed"}), `invalid metrics label name "__feed"`)
+	assert.EqualError(t, ConfigureMetrics(MetricsJobIDLabelKeep, []string{"feed", "feed"}), `duplicate metrics label "feed"`)
+
+	spec := Spec{JobID: 42, JobName: "job", MetricsLabels: map[string]string{"feed": "ETH/USD", "unused": "foo"}}
+
+	t.Run("adds the custom labels", func(t *testing.T) {
+		require.NoError(t, ConfigureMetrics(MetricsJobIDLabelKeep, []string{"feed", "env"}))
+		RecordRunError(spec)
+		assert.Equal(t, 1.0, testutil.ToFloat64(metrics.runErrors.With(prometheus.Labels{"job_id": "42", "job_name": "job", "feed": "ETH/USD", "env": ""})))
+	})
+
+	t.Run("hashes the job ID", func(t *testing.T) {
+		require.NoError(t, ConfigureMetrics(MetricsJobIDLabelHash, nil))
+		jobID := metrics.labels(spec)["job_id"]
+		assert.Len(t, jobID, 2)
+		assert.NotEqual(t, "42", jobID)
+		RecordRunError(spec)
+		assert.Equal(t, 1.0, testutil.ToFloat64(metrics.runErrors.With(prometheus.Labels{"job_id": jobID, "job_name": "job"})))
+	})
+
+	t.Run("drops the job ID", func(t *testing.T) {
+		require.NoError(t, ConfigureMetrics(MetricsJobIDLabelDrop, nil))
+		RecordTaskRun(spec, "ds1", "http", 0, "completed")
+		RecordTaskRun(Spec{JobID: 43, JobName: "job"}, "ds1", "http", 0, "completed")
+		assert.Equal(t, 2.0, testutil.ToFloat64(metrics.tasksTotalFinished.With(prometheus.Labels{"job_id": "", "job_name": "job", "task_id": "ds1", "task_type": "http", "status": "completed"})))
+	})
+}
@@ -22,6 +22,27 @@ type ORM struct {
 	mock.Mock
 }
 
+// CreateMaintenanceWindow provides a mock function with given fields: w, qopts
+func (_m *ORM) CreateMaintenanceWindow(w *pipeline.MaintenanceWindow, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, w)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*pipeline.MaintenanceWindow, ...pg.QOpt) error); ok {
+		r0 = rf(w, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateRun provides a mock function with given fields: run, qopts
 func (_m *ORM) CreateRun(run *pipeline.Run, qopts ...pg.QOpt) error {
 	_va := make([]interface{}, len(qopts))
@@ -71,6 +92,27 @@ func (_m *ORM) CreateSpec(_a0 pipeline.Pipeline, maxTaskTimeout models.Interval,
 	return r0, r1
 }
 
+// DeleteMaintenanceWindow provides a mock function with given fields: id, qopts
+func (_m *ORM) DeleteMaintenanceWindow(id int64, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, ...pg.QOpt) error); ok {
+		r0 = rf(id, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteRun provides a mock function with given fields: id
 func (_m *ORM) DeleteRun(id int64) error {
 	ret := _m.Called(id)
@@ -85,6 +127,20 @@ func (_m *ORM) DeleteRun(id int64) error {
 	return r0
 }
 
+// DeleteRuns provides a mock function with given fields: ctx, ids
+func (_m *ORM) DeleteRuns(ctx context.Context, ids []int64) error {
+	ret := _m.Called(ctx, ids)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) error); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteRunsOlderThan provides a mock function with given fields: _a0, _a1
 func (_m *ORM) DeleteRunsOlderThan(_a0 context.Context, _a1 time.Duration) error {
 	ret := _m.Called(_a0, _a1)
@@ -99,6 +155,63 @@ func (_m *ORM) DeleteRunsOlderThan(_a0 context.Context, _a1 time.Duration) error
 	return r0
 }
 
+// FindLastValue provides a mock function with given fields: specID, dotID, qopts
+func (_m *ORM) FindLastValue(specID int32, dotID string, qopts ...pg.QOpt) (pipeline.LastValue, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, specID, dotID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 pipeline.LastValue
+	if rf, ok := ret.Get(0).(func(int32, string, ...pg.QOpt) pipeline.LastValue); ok {
+		r0 = rf(specID, dotID, qopts...)
+	} else {
+		r0 = ret.Get(0).(pipeline.LastValue)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int32, string, ...pg.QOpt) error); ok {
+		r1 = rf(specID, dotID, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindMaintenanceWindows provides a mock function with given fields: qopts
+func (_m *ORM) FindMaintenanceWindows(qopts ...pg.QOpt) ([]pipeline.MaintenanceWindow, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []pipeline.MaintenanceWindow
+	if rf, ok := ret.Get(0).(func(...pg.QOpt) []pipeline.MaintenanceWindow); ok {
+		r0 = rf(qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.MaintenanceWindow)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(...pg.QOpt) error); ok {
+		r1 = rf(qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindRun provides a mock function with given fields: id
 func (_m *ORM) FindRun(id int64) (pipeline.Run, error) {
 	ret := _m.Called(id)
@@ -120,6 +233,59 @@ func (_m *ORM) FindRun(id int64) (pipeline.Run, error) {
 	return r0, r1
 }
 
+// FindRunsFinishedBefore provides a mock function with given fields: ctx, before, limit
+func (_m *ORM) FindRunsFinishedBefore(ctx context.Context, before time.Time, limit int) ([]pipeline.Run, error) {
+	ret := _m.Called(ctx, before, limit)
+
+	var r0 []pipeline.Run
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []pipeline.Run); ok {
+		r0 = rf(ctx, before, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.Run)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, before, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindState provides a mock function with given fields: specID, qopts
+func (_m *ORM) FindState(specID int32, qopts ...pg.QOpt) (map[string]interface{}, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, specID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 map[string]interface{}
+	if rf, ok := ret.Get(0).(func(int32, ...pg.QOpt) map[string]interface{}); ok {
+		r0 = rf(specID, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]interface{})
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int32, ...pg.QOpt) error); ok {
+		r1 = rf(specID, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllRuns provides a mock function with given fields:
 func (_m *ORM) GetAllRuns() ([]pipeline.Run, error) {
 	ret := _m.Called()
@@ -20,6 +20,22 @@ type Runner struct {
 	mock.Mock
 }
 
+// Budgets provides a mock function with given fields:
+func (_m *Runner) Budgets() pipeline.Budgets {
+	ret := _m.Called()
+
+	var r0 pipeline.Budgets
+	if rf, ok := ret.Get(0).(func() pipeline.Budgets); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(pipeline.Budgets)
+		}
+	}
+
+	return r0
+}
+
 // Close provides a mock function with given fields:
 func (_m *Runner) Close() error {
 	ret := _m.Called()
@@ -106,6 +122,20 @@ func (_m *Runner) Healthy() error {
 	return r0
 }
 
+// InFlightRuns provides a mock function with given fields:
+func (_m *Runner) InFlightRuns() int64 {
+	ret := _m.Called()
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
 // InsertFinishedRun provides a mock function with given fields: run, saveSuccessfulTaskRuns, qopts
 func (_m *Runner) InsertFinishedRun(run *pipeline.Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) error {
 	_va := make([]interface{}, len(qopts))
@@ -148,11 +178,49 @@ func (_m *Runner) InsertFinishedRuns(runs []*pipeline.Run, saveSuccessfulTaskRun
 	return r0
 }
 
+// IsPaused provides a mock function with given fields:
+func (_m *Runner) IsPaused() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// OnRunCreated provides a mock function with given fields: _a0
+func (_m *Runner) OnRunCreated(_a0 func(*pipeline.Run)) {
+	_m.Called(_a0)
+}
+
 // OnRunFinished provides a mock function with given fields: _a0
 func (_m *Runner) OnRunFinished(_a0 func(*pipeline.Run)) {
 	_m.Called(_a0)
 }
 
+// Pause provides a mock function with given fields:
+func (_m *Runner) Pause() {
+	_m.Called()
+}
+
+// QueuedRuns provides a mock function with given fields:
+func (_m *Runner) QueuedRuns() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
 // Ready provides a mock function with given fields:
 func (_m *Runner) Ready() error {
 	ret := _m.Called()
@@ -202,6 +270,11 @@ func (_m *Runner) Run(ctx context.Context, run *pipeline.Run, l logger.Logger, s
 	return r0, r1
 }
 
+// SetMaintenanceWindows provides a mock function with given fields: windows
+func (_m *Runner) SetMaintenanceWindows(windows []pipeline.MaintenanceWindow) {
+	_m.Called(windows)
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Runner) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -216,6 +289,11 @@ func (_m *Runner) Start(_a0 context.Context) error {
 	return r0
 }
 
+// Unpause provides a mock function with given fields:
+func (_m *Runner) Unpause() {
+	_m.Called()
+}
+
 type mockConstructorTestingTNewRunner interface {
 	mock.TestingT
 	Cleanup(func())
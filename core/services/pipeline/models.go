@@ -28,6 +28,11 @@ type Spec struct {
 	JobID   int32  `json:"-"`
 	JobName string `json:"-"`
 	JobType string `json:"-"`
+	// MetricsLabels are the custom labels of the prometheus metrics of the job
+	MetricsLabels map[string]string `json:"-"`
+	// RunDedup, if set, stores the successful runs only when their outputs
+	// change
+	RunDedup *RunDedup `json:"-"`
 }
 
 func (s Spec) Pipeline() (*Pipeline, error) {
@@ -108,6 +113,21 @@ func (r *Run) ByDotID(id string) *TaskRun {
 	return nil
 }
 
+// StateChanges returns the values of the setstate tasks of the run by key, if
+// the run is successful
+func (r *Run) StateChanges() map[string]interface{} {
+	if r.HasErrors() {
+		return nil
+	}
+	changes := make(map[string]interface{})
+	for _, tr := range r.PipelineTaskRuns {
+		if task, ok := tr.task.(*SetStateTask); ok && tr.Output.Valid {
+			changes[task.Key] = tr.Output.Val
+		}
+	}
+	return changes
+}
+
 func (r *Run) StringOutputs() ([]*string, error) {
 	// The UI expects all outputs to be strings.
 	var outputs []*string
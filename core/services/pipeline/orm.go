@@ -3,9 +3,13 @@ package pipeline
 import (
 	"context"
 	"database/sql"
+	"strconv"
 	"time"
 
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	uuid "github.com/satori/go.uuid"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -66,6 +70,40 @@ const KeepersObservationSource = `
     encode_check_upkeep_tx -> check_upkeep_tx -> decode_check_upkeep_tx -> calculate_perform_data_len -> perform_data_lessthan_limit -> check_perform_data_limit -> encode_perform_upkeep_tx -> simulate_perform_upkeep_tx -> decode_check_perform_tx -> check_success -> perform_upkeep_tx
 `
 
+var (
+	promORMQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "pipeline_orm_query_duration_seconds",
+		Help:    "How long each pipeline ORM operation took, including the time waiting for a database connection",
+		Buckets: []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+	},
+		[]string{"operation", "success"},
+	)
+	promReaperDeletedRuns = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pipeline_runs_reaper_deleted_runs",
+		Help: "The total number of old pipeline runs deleted by the reaper",
+	})
+	promReaperExportedRuns = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pipeline_runs_reaper_exported_runs",
+		Help: "The total number of old pipeline runs exported by the reaper before deleting them",
+	})
+	promDeduplicatedRuns = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pipeline_runs_deduplicated",
+		Help: "The total number of finished pipeline runs recorded as a heartbeat of the previous run, as their outputs did not change",
+	})
+	promReaperDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "pipeline_runs_reaper_duration_seconds",
+		Help:    "How long each phase of the pipeline runs reaper took",
+		Buckets: []float64{.1, .5, 1, 5, 10, 30, 60, 120, 300, 600},
+	},
+		[]string{"phase"},
+	)
+)
+
+// observeQuery records the duration of the ORM operation since start
+func observeQuery(operation string, start time.Time, err error) {
+	promORMQueryDuration.WithLabelValues(operation, strconv.FormatBool(err == nil)).Observe(time.Since(start).Seconds())
+}
+
 //go:generate mockery --name ORM --output ./mocks/ --case=underscore
 
 type ORM interface {
@@ -82,10 +120,29 @@ type ORM interface {
 	InsertFinishedRuns(run []*Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) (err error)
 
 	DeleteRunsOlderThan(context.Context, time.Duration) error
+	// FindRunsFinishedBefore returns up to limit runs finished before
+	// before, with their task runs, sorted by ID
+	FindRunsFinishedBefore(ctx context.Context, before time.Time, limit int) ([]Run, error)
+	// DeleteRuns deletes the runs, with their task runs
+	DeleteRuns(ctx context.Context, ids []int64) error
 	FindRun(id int64) (Run, error)
 	GetAllRuns() ([]Run, error)
 	GetUnfinishedRuns(context.Context, time.Time, func(run Run) error) error
 	GetQ() pg.Q
+
+	CreateMaintenanceWindow(w *MaintenanceWindow, qopts ...pg.QOpt) error
+	// DeleteMaintenanceWindow returns sql.ErrNoRows if the window doesn't exist
+	DeleteMaintenanceWindow(id int64, qopts ...pg.QOpt) error
+	// FindMaintenanceWindows returns the windows that have not ended yet,
+	// sorted by start time
+	FindMaintenanceWindows(qopts ...pg.QOpt) ([]MaintenanceWindow, error)
+
+	// FindLastValue returns the last value of the deviation task dotID of the
+	// spec, or sql.ErrNoRows if no successful run of the spec stored one
+	FindLastValue(specID int32, dotID string, qopts ...pg.QOpt) (LastValue, error)
+	// FindState returns the state of the spec, set by the setstate tasks of
+	// its successful runs
+	FindState(specID int32, qopts ...pg.QOpt) (map[string]interface{}, error)
 }
 
 type orm struct {
@@ -100,6 +157,7 @@ func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig) *orm {
 }
 
 func (o *orm) CreateSpec(pipeline Pipeline, maxTaskDuration models.Interval, qopts ...pg.QOpt) (id int32, err error) {
+	defer func(start time.Time) { observeQuery("CreateSpec", start, err) }(time.Now())
 	q := o.q.WithOpts(qopts...)
 	sql := `INSERT INTO pipeline_specs (dot_dag_source, max_task_duration, created_at)
 	VALUES ($1, $2, NOW())
@@ -109,6 +167,7 @@ func (o *orm) CreateSpec(pipeline Pipeline, maxTaskDuration models.Interval, qop
 }
 
 func (o *orm) CreateRun(run *Run, qopts ...pg.QOpt) (err error) {
+	defer func(start time.Time) { observeQuery("CreateRun", start, err) }(time.Now())
 	if run.CreatedAt.IsZero() {
 		return errors.New("run.CreatedAt must be set")
 	}
@@ -140,17 +199,20 @@ func (o *orm) CreateRun(run *Run, qopts ...pg.QOpt) (err error) {
 }
 
 // InsertRun inserts a run into the database
-func (o *orm) InsertRun(run *Run, qopts ...pg.QOpt) error {
+func (o *orm) InsertRun(run *Run, qopts ...pg.QOpt) (err error) {
+	defer func(start time.Time) { observeQuery("InsertRun", start, err) }(time.Now())
 	q := o.q.WithOpts(qopts...)
 	sql := `INSERT INTO pipeline_runs (pipeline_spec_id, meta, all_errors, fatal_errors, inputs, outputs, created_at, finished_at, state)
 		VALUES (:pipeline_spec_id, :meta, :all_errors, :fatal_errors, :inputs, :outputs, :created_at, :finished_at, :state)
 		RETURNING *;`
-	return q.GetNamed(sql, run, run)
+	err = q.GetNamed(sql, run, run)
+	return err
 }
 
 // StoreRun will persist a partially executed run before suspending, or finish a run.
 // If `restart` is true, then new task run data is available and the run should be resumed immediately.
 func (o *orm) StoreRun(run *Run, qopts ...pg.QOpt) (restart bool, err error) {
+	defer func(start time.Time) { observeQuery("StoreRun", start, err) }(time.Now())
 	q := o.q.WithOpts(qopts...)
 	err = q.Transaction(func(tx pg.Queryer) error {
 		finished := run.FinishedAt.Valid
@@ -202,6 +264,12 @@ func (o *orm) StoreRun(run *Run, qopts ...pg.QOpt) (restart bool, err error) {
 			if _, err = sqlx.NamedExec(tx, sql, run); err != nil {
 				return errors.Wrap(err, "StoreRun")
 			}
+			if err = storeLastValues(tx, run); err != nil {
+				return errors.Wrap(err, "StoreRun")
+			}
+			if err = storeState(tx, run); err != nil {
+				return errors.Wrap(err, "StoreRun")
+			}
 		}
 
 		sql := `
@@ -231,13 +299,15 @@ func (o *orm) StoreRun(run *Run, qopts ...pg.QOpt) (restart bool, err error) {
 }
 
 // DeleteRun cleans up a run that failed and is marked failEarly (should leave no trace of the run)
-func (o *orm) DeleteRun(id int64) error {
+func (o *orm) DeleteRun(id int64) (err error) {
+	defer func(start time.Time) { observeQuery("DeleteRun", start, err) }(time.Now())
 	// NOTE: this will cascade and wipe pipeline_task_runs too
-	_, err := o.q.Exec(`DELETE FROM pipeline_runs WHERE id = $1`, id)
+	_, err = o.q.Exec(`DELETE FROM pipeline_runs WHERE id = $1`, id)
 	return err
 }
 
 func (o *orm) UpdateTaskRunResult(taskID uuid.UUID, result Result) (run Run, start bool, err error) {
+	defer func(start time.Time) { observeQuery("UpdateTaskRunResult", start, err) }(time.Now())
 	if result.OutputDB().Valid && result.ErrorDB().Valid {
 		panic("run result must specify either output or error, not both")
 	}
@@ -276,9 +346,10 @@ func (o *orm) UpdateTaskRunResult(taskID uuid.UUID, result Result) (run Run, sta
 }
 
 // InsertFinishedRuns inserts all the given runs into the database.
-func (o *orm) InsertFinishedRuns(runs []*Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) error {
+func (o *orm) InsertFinishedRuns(runs []*Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) (err error) {
+	defer func(start time.Time) { observeQuery("InsertFinishedRuns", start, err) }(time.Now())
 	q := o.q.WithOpts(qopts...)
-	err := q.Transaction(func(tx pg.Queryer) error {
+	err = q.Transaction(func(tx pg.Queryer) error {
 		pipelineRunsQuery := `
 INSERT INTO pipeline_runs 
 	(pipeline_spec_id, meta, all_errors, fatal_errors, inputs, outputs, created_at, finished_at, state)
@@ -304,6 +375,12 @@ RETURNING id
 			for j := range run.PipelineTaskRuns {
 				run.PipelineTaskRuns[j].PipelineRunID = runIDs[i]
 			}
+			if errL := storeLastValues(tx, run); errL != nil {
+				return errL
+			}
+			if errS := storeState(tx, run); errS != nil {
+				return errS
+			}
 		}
 
 		pipelineTaskRunsQuery := `
@@ -321,7 +398,8 @@ VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created
 		_, errE := tx.NamedExec(pipelineTaskRunsQuery, pipelineTaskRuns)
 		return errors.Wrap(errE, "insert pipeline task runs")
 	})
-	return errors.Wrap(err, "InsertFinishedRuns failed")
+	err = errors.Wrap(err, "InsertFinishedRuns failed")
+	return err
 }
 
 func (o *orm) checkFinishedRun(run *Run, saveSuccessfulTaskRuns bool) error {
@@ -344,13 +422,31 @@ func (o *orm) checkFinishedRun(run *Run, saveSuccessfulTaskRuns bool) error {
 // If saveSuccessfulTaskRuns = false, we only save errored runs.
 // That way if the job is run frequently (such as OCR) we avoid saving a large number of successful task runs
 // which do not provide much value.
+// If the spec of the run has a RunDedup and the run is successful with the
+// same outputs as the last stored run of the spec, only a heartbeat of that
+// run is inserted, and run.ID is left unset.
 func (o *orm) InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) (err error) {
+	defer func(start time.Time) { observeQuery("InsertFinishedRun", start, err) }(time.Now())
 	if err = o.checkFinishedRun(run, saveSuccessfulTaskRuns); err != nil {
 		return err
 	}
 
 	q := o.q.WithOpts(qopts...)
 	err = q.Transaction(func(tx pg.Queryer) error {
+		if err = storeLastValues(tx, run); err != nil {
+			return err
+		}
+		if err = storeState(tx, run); err != nil {
+			return err
+		}
+
+		if dedup := run.PipelineSpec.RunDedup; dedup != nil && !run.HasErrors() {
+			deduplicated, err2 := insertHeartbeatIfUnchanged(tx, run, *dedup)
+			if err2 != nil || deduplicated {
+				return err2
+			}
+		}
+
 		sql := `INSERT INTO pipeline_runs (pipeline_spec_id, meta, all_errors, fatal_errors, inputs, outputs, created_at, finished_at, state)
 		VALUES (:pipeline_spec_id, :meta, :all_errors, :fatal_errors, :inputs, :outputs, :created_at, :finished_at, :state)
 		RETURNING id;`
@@ -382,6 +478,71 @@ func (o *orm) InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...
 	return errors.Wrap(err, "InsertFinishedRun failed")
 }
 
+// insertHeartbeatIfUnchanged inserts a heartbeat of the last stored run of the
+// spec of run, if their outputs are the same
+func insertHeartbeatIfUnchanged(tx pg.Queryer, run *Run, dedup RunDedup) (bool, error) {
+	var last struct {
+		ID      int64
+		Outputs []byte
+	}
+	err := tx.Get(&last, `SELECT id, outputs FROM pipeline_runs WHERE pipeline_spec_id = $1 ORDER BY created_at DESC, id DESC LIMIT 1`, run.PipelineSpecID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Wrap(err, "failed to load the last run")
+	}
+	outputs, err := run.Outputs.MarshalJSON()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to encode outputs")
+	}
+	if !dedup.Unchanged(outputs, last.Outputs) {
+		return false, nil
+	}
+	_, err = tx.Exec(`INSERT INTO pipeline_run_heartbeats (pipeline_run_id, created_at, finished_at) VALUES ($1, $2, $3)`, last.ID, run.CreatedAt, run.FinishedAt)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to insert pipeline_run_heartbeat")
+	}
+	promDeduplicatedRuns.Inc()
+	return true, nil
+}
+
+// storeLastValues stores the outputs of the deviation tasks of run as the last
+// values of its spec, if the run is successful
+func storeLastValues(tx pg.Queryer, run *Run) error {
+	if run.HasErrors() {
+		return nil
+	}
+	for _, tr := range run.PipelineTaskRuns {
+		if tr.Type != TaskTypeDeviation || !tr.Output.Valid {
+			continue
+		}
+		var value DecimalParam
+		if err := value.UnmarshalPipelineParam(tr.Output.Val); err != nil {
+			return errors.Wrapf(err, "invalid output of deviation task %s", tr.DotID)
+		}
+		_, err := tx.Exec(`INSERT INTO pipeline_last_values (pipeline_spec_id, dot_id, value, updated_at) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (pipeline_spec_id, dot_id) DO UPDATE SET value = EXCLUDED.value, updated_at = EXCLUDED.updated_at`,
+			run.PipelineSpecID, tr.DotID, value.Decimal(), run.FinishedAt)
+		if err != nil {
+			return errors.Wrap(err, "failed to store the last value")
+		}
+	}
+	return nil
+}
+
+// storeState stores the state changes of run
+func storeState(tx pg.Queryer, run *Run) error {
+	for key, value := range run.StateChanges() {
+		_, err := tx.Exec(`INSERT INTO pipeline_state (pipeline_spec_id, key, value, updated_at) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (pipeline_spec_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = EXCLUDED.updated_at`,
+			run.PipelineSpecID, key, JSONSerializable{Val: value, Valid: true}, run.FinishedAt)
+		if err != nil {
+			return errors.Wrapf(err, "failed to store the state %s", key)
+		}
+	}
+	return nil
+}
+
 // DeleteRunsOlderThan deletes all pipeline_runs that have been finished for a certain threshold to free DB space
 // Caller is expected to set timeout on calling context.
 func (o *orm) DeleteRunsOlderThan(ctx context.Context, threshold time.Duration) error {
@@ -414,6 +575,7 @@ WHERE pipeline_runs.id = batched_pipeline_runs.id`,
 		if err != nil {
 			return count, errors.Wrap(err, "DeleteRunsOlderThan failed to get rows affected")
 		}
+		promReaperDeletedRuns.Add(float64(rowsAffected))
 
 		return uint(rowsAffected), err
 	})
@@ -422,9 +584,11 @@ WHERE pipeline_runs.id = batched_pipeline_runs.id`,
 	}
 
 	deleteTS := time.Now()
+	promReaperDuration.WithLabelValues("delete").Observe(deleteTS.Sub(start).Seconds())
 
 	o.lggr.Debugw("pipeline_runs reaper DELETE query completed", "duration", deleteTS.Sub(start))
 	defer func(start time.Time) {
+		promReaperDuration.WithLabelValues("vacuum").Observe(time.Since(start).Seconds())
 		o.lggr.Debugw("pipeline_runs reaper VACUUM ANALYZE query completed", "duration", time.Since(start))
 	}(deleteTS)
 
@@ -438,6 +602,7 @@ WHERE pipeline_runs.id = batched_pipeline_runs.id`,
 }
 
 func (o *orm) FindRun(id int64) (r Run, err error) {
+	defer func(start time.Time) { observeQuery("FindRun", start, err) }(time.Now())
 	var runs []*Run
 	err = o.q.Transaction(func(tx pg.Queryer) error {
 		if err = tx.Select(&runs, `SELECT * from pipeline_runs WHERE id = $1 LIMIT 1`, id); err != nil {
@@ -451,7 +616,38 @@ func (o *orm) FindRun(id int64) (r Run, err error) {
 	return *runs[0], err
 }
 
+func (o *orm) FindRunsFinishedBefore(ctx context.Context, before time.Time, limit int) (runs []Run, err error) {
+	defer func(start time.Time) { observeQuery("FindRunsFinishedBefore", start, err) }(time.Now())
+	var runsPtrs []*Run
+	q := o.q.WithOpts(pg.WithParentCtxInheritTimeout(ctx))
+	err = q.Transaction(func(tx pg.Queryer) error {
+		err = tx.Select(&runsPtrs, `SELECT * FROM pipeline_runs WHERE finished_at < $1 ORDER BY id ASC LIMIT $2`, before, limit)
+		if err != nil {
+			return errors.Wrap(err, "failed to load runs")
+		}
+		return loadAssociations(tx, runsPtrs)
+	})
+	runs = make([]Run, len(runsPtrs))
+	for i, runPtr := range runsPtrs {
+		runs[i] = *runPtr
+	}
+	return runs, err
+}
+
+func (o *orm) DeleteRuns(ctx context.Context, ids []int64) (err error) {
+	defer func(start time.Time) { observeQuery("DeleteRuns", start, err) }(time.Now())
+	q := o.q.WithOpts(pg.WithParentCtxInheritTimeout(ctx))
+	// NOTE: this will cascade and wipe pipeline_task_runs too
+	err = q.ExecQ(`DELETE FROM pipeline_runs WHERE id = ANY($1)`, pq.Array(ids))
+	if err != nil {
+		return errors.Wrap(err, "DeleteRuns failed")
+	}
+	promReaperDeletedRuns.Add(float64(len(ids)))
+	return nil
+}
+
 func (o *orm) GetAllRuns() (runs []Run, err error) {
+	defer func(start time.Time) { observeQuery("GetAllRuns", start, err) }(time.Now())
 	var runsPtrs []*Run
 	err = o.q.Transaction(func(tx pg.Queryer) error {
 		err = tx.Select(&runsPtrs, `SELECT * from pipeline_runs ORDER BY created_at ASC, id ASC`)
@@ -542,3 +738,57 @@ func loadAssociations(q pg.Queryer, runs []*Run) error {
 func (o *orm) GetQ() pg.Q {
 	return o.q
 }
+
+func (o *orm) CreateMaintenanceWindow(w *MaintenanceWindow, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	sql := `INSERT INTO maintenance_windows (job_id, starts_at, ends_at, action, reason, created_at)
+	VALUES (:job_id, :starts_at, :ends_at, :action, :reason, NOW())
+	RETURNING *;`
+	return errors.Wrap(q.GetNamed(sql, w, w), "CreateMaintenanceWindow failed")
+}
+
+func (o *orm) DeleteMaintenanceWindow(id int64, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	result, err := q.Exec(`DELETE FROM maintenance_windows WHERE id = $1`, id)
+	if err != nil {
+		return errors.Wrap(err, "DeleteMaintenanceWindow failed")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "DeleteMaintenanceWindow failed to get rows affected")
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (o *orm) FindMaintenanceWindows(qopts ...pg.QOpt) (windows []MaintenanceWindow, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Select(&windows, `SELECT * FROM maintenance_windows WHERE ends_at > NOW() ORDER BY starts_at, id`)
+	return windows, errors.Wrap(err, "FindMaintenanceWindows failed")
+}
+
+func (o *orm) FindLastValue(specID int32, dotID string, qopts ...pg.QOpt) (value LastValue, err error) {
+	defer func(start time.Time) { observeQuery("FindLastValue", start, err) }(time.Now())
+	q := o.q.WithOpts(qopts...)
+	err = q.Get(&value, `SELECT value, updated_at FROM pipeline_last_values WHERE pipeline_spec_id = $1 AND dot_id = $2`, specID, dotID)
+	return value, err
+}
+
+func (o *orm) FindState(specID int32, qopts ...pg.QOpt) (state map[string]interface{}, err error) {
+	defer func(start time.Time) { observeQuery("FindState", start, err) }(time.Now())
+	q := o.q.WithOpts(qopts...)
+	var rows []struct {
+		Key   string
+		Value JSONSerializable
+	}
+	if err = q.Select(&rows, `SELECT key, value FROM pipeline_state WHERE pipeline_spec_id = $1`, specID); err != nil {
+		return nil, errors.Wrap(err, "FindState failed")
+	}
+	state = make(map[string]interface{}, len(rows))
+	for _, row := range rows {
+		state[row.Key] = row.Value.Val
+	}
+	return state, nil
+}
@@ -1,10 +1,13 @@
 package pipeline_test
 
 import (
+	"database/sql"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	uuid "github.com/satori/go.uuid"
+	"github.com/shopspring/decimal"
 	"github.com/smartcontractkit/sqlx"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -181,6 +184,105 @@ func TestInsertFinishedRuns(t *testing.T) {
 
 }
 
+func Test_PipelineORM_InsertFinishedRun_RunDedup(t *testing.T) {
+	db, orm := setupLiteORM(t)
+
+	p, err := pipeline.Parse(`ds [type=memo value=1]`)
+	require.NoError(t, err)
+	specID, err := orm.CreateSpec(*p, models.Interval(time.Minute))
+	require.NoError(t, err)
+	spec := pipeline.Spec{ID: specID, RunDedup: &pipeline.RunDedup{Tolerance: 1}}
+
+	insert := func(output string) pipeline.Run {
+		now := time.Now()
+		run := pipeline.Run{
+			PipelineSpecID: specID,
+			PipelineSpec:   spec,
+			State:          pipeline.RunStatusCompleted,
+			AllErrors:      pipeline.RunErrors{null.String{}},
+			FatalErrors:    pipeline.RunErrors{null.String{}},
+			Outputs:        pipeline.JSONSerializable{Val: []interface{}{output}, Valid: true},
+			CreatedAt:      now,
+			FinishedAt:     null.TimeFrom(now),
+		}
+		require.NoError(t, orm.InsertFinishedRun(&run, false))
+		return run
+	}
+
+	first := insert("100")
+	require.NotZero(t, first.ID)
+	// within 1% of the last stored run
+	assert.Zero(t, insert("100.5").ID)
+	assert.Zero(t, insert("99.2").ID)
+	changed := insert("102")
+	require.NotZero(t, changed.ID)
+	assert.Zero(t, insert("102").ID)
+
+	var heartbeats []int64
+	require.NoError(t, db.Select(&heartbeats, `SELECT pipeline_run_id FROM pipeline_run_heartbeats ORDER BY id`))
+	assert.Equal(t, []int64{first.ID, first.ID, changed.ID}, heartbeats)
+
+	var runs int
+	require.NoError(t, db.Get(&runs, `SELECT count(*) FROM pipeline_runs WHERE pipeline_spec_id = $1`, specID))
+	assert.Equal(t, 2, runs)
+
+	// the heartbeats are deleted with their run
+	require.NoError(t, orm.DeleteRun(first.ID))
+	require.NoError(t, db.Get(&runs, `SELECT count(*) FROM pipeline_run_heartbeats`))
+	assert.Equal(t, 1, runs)
+}
+
+func Test_PipelineORM_InsertFinishedRun_LastValues(t *testing.T) {
+	_, orm := setupLiteORM(t)
+
+	p, err := pipeline.Parse(`deviation [type=deviation threshold=1]`)
+	require.NoError(t, err)
+	specID, err := orm.CreateSpec(*p, models.Interval(time.Minute))
+	require.NoError(t, err)
+
+	insert := func(output interface{}, runErr null.String) {
+		now := time.Now()
+		run := pipeline.Run{
+			PipelineSpecID: specID,
+			State:          pipeline.RunStatusCompleted,
+			AllErrors:      pipeline.RunErrors{runErr},
+			FatalErrors:    pipeline.RunErrors{runErr},
+			Outputs:        pipeline.JSONSerializable{Val: []interface{}{output}, Valid: true},
+			CreatedAt:      now,
+			FinishedAt:     null.TimeFrom(now),
+			PipelineTaskRuns: []pipeline.TaskRun{{
+				ID:         uuid.NewV4(),
+				Type:       pipeline.TaskTypeDeviation,
+				DotID:      "deviation",
+				Output:     pipeline.JSONSerializable{Val: output, Valid: true},
+				CreatedAt:  now,
+				FinishedAt: null.TimeFrom(now),
+			}},
+		}
+		require.NoError(t, orm.InsertFinishedRun(&run, true))
+	}
+
+	_, err = orm.FindLastValue(specID, "deviation")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	insert(decimal.RequireFromString("100.5"), null.String{})
+	last, err := orm.FindLastValue(specID, "deviation")
+	require.NoError(t, err)
+	assert.Equal(t, "100.5", last.Value.String())
+	assert.False(t, last.UpdatedAt.IsZero())
+
+	// the values of the failed runs are not stored
+	insert(decimal.NewFromInt(102), null.StringFrom("transaction reverted"))
+	last, err = orm.FindLastValue(specID, "deviation")
+	require.NoError(t, err)
+	assert.Equal(t, "100.5", last.Value.String())
+
+	insert("102", null.String{})
+	last, err = orm.FindLastValue(specID, "deviation")
+	require.NoError(t, err)
+	assert.Equal(t, "102", last.Value.String())
+}
+
 // Tests that inserting run results, then later updating the run results via upsert will work correctly.
 func Test_PipelineORM_StoreRun_ShouldUpsert(t *testing.T) {
 	_, orm := setupLiteORM(t)
@@ -459,8 +561,11 @@ func Test_PipelineORM_DeleteRunsOlderThan(t *testing.T) {
 		runsIds = append(runsIds, run.ID)
 	}
 
+	deletedBefore := testutil.ToFloat64(pipeline.PromReaperDeletedRuns)
+
 	err := orm.DeleteRunsOlderThan(testutils.Context(t), 1*time.Second)
 	assert.NoError(t, err)
+	assert.Equal(t, float64(len(runsIds)), testutil.ToFloat64(pipeline.PromReaperDeletedRuns)-deletedBefore)
 
 	for _, runId := range runsIds {
 		_, err := orm.FindRun(runId)
@@ -468,6 +573,51 @@ func Test_PipelineORM_DeleteRunsOlderThan(t *testing.T) {
 	}
 }
 
+func Test_PipelineORM_FindRunsFinishedBefore_DeleteRuns(t *testing.T) {
+	_, orm := setupORM(t, "")
+
+	now := time.Now()
+	var runs []*pipeline.Run
+	for i := 0; i < 3; i++ {
+		run := mustInsertAsyncRun(t, orm)
+		run.PipelineTaskRuns = []pipeline.TaskRun{{
+			ID:            uuid.NewV4(),
+			PipelineRunID: run.ID,
+			Type:          "median",
+			DotID:         "answer2",
+			Output:        pipeline.JSONSerializable{Val: 1, Valid: true},
+			CreatedAt:     now,
+			FinishedAt:    null.TimeFrom(now),
+		}}
+		run.State = pipeline.RunStatusCompleted
+		run.FinishedAt = null.TimeFrom(now.Add(time.Duration(i-2) * time.Hour))
+		run.Outputs = pipeline.JSONSerializable{Val: 1, Valid: true}
+		_, err := orm.StoreRun(run)
+		require.NoError(t, err)
+		runs = append(runs, run)
+	}
+
+	found, err := orm.FindRunsFinishedBefore(testutils.Context(t), now.Add(-time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, found, 2)
+	assert.Equal(t, runs[0].ID, found[0].ID)
+	assert.Equal(t, runs[1].ID, found[1].ID)
+	assert.Len(t, found[0].PipelineTaskRuns, 1)
+
+	found, err = orm.FindRunsFinishedBefore(testutils.Context(t), now.Add(-time.Minute), 1)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, runs[0].ID, found[0].ID)
+
+	require.NoError(t, orm.DeleteRuns(testutils.Context(t), []int64{runs[0].ID, runs[1].ID}))
+	_, err = orm.FindRun(runs[0].ID)
+	require.Error(t, err)
+	_, err = orm.FindRun(runs[1].ID)
+	require.Error(t, err)
+	_, err = orm.FindRun(runs[2].ID)
+	require.NoError(t, err)
+}
+
 func Test_GetUnfinishedRuns_Keepers(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,208 @@
+package pipeline
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// The types of a ResponseSchema, as in JSON Schema
+const (
+	SchemaTypeArray   = "array"
+	SchemaTypeBoolean = "boolean"
+	SchemaTypeInteger = "integer"
+	SchemaTypeNull    = "null"
+	SchemaTypeNumber  = "number"
+	SchemaTypeObject  = "object"
+	SchemaTypeString  = "string"
+)
+
+var schemaTypes = map[string]bool{
+	SchemaTypeArray:   true,
+	SchemaTypeBoolean: true,
+	SchemaTypeInteger: true,
+	SchemaTypeNull:    true,
+	SchemaTypeNumber:  true,
+	SchemaTypeObject:  true,
+	SchemaTypeString:  true,
+}
+
+// ResponseSchema is the expected shape of the JSON response of a bridge or
+// http task. It is the subset of JSON Schema made of the type, properties,
+// required and items keywords, e.g.
+//
+//	{"type": "object", "required": ["data"], "properties": {
+//	    "data": {"type": "object", "required": ["result"], "properties": {
+//	        "result": {"type": ["number", "string"]}}}}}
+type ResponseSchema struct {
+	// Type is a type or a list of types, any type is valid if empty
+	Type       SchemaTypes                `json:"type"`
+	Properties map[string]*ResponseSchema `json:"properties"`
+	Required   []string                   `json:"required"`
+	Items      *ResponseSchema            `json:"items"`
+}
+
+// SchemaTypes are the valid types of a value
+type SchemaTypes []string
+
+func (st *SchemaTypes) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*st = SchemaTypes{s}
+		return nil
+	}
+	var ss []string
+	if err := json.Unmarshal(b, &ss); err != nil {
+		return errors.New("type must be a string or a list of strings")
+	}
+	*st = ss
+	return nil
+}
+
+// ErrSchemaViolation is returned when a response does not match its schema
+type ErrSchemaViolation struct {
+	// Path is the JSONPath of the invalid value, like $.data.result
+	Path string
+	Msg  string
+}
+
+func (e ErrSchemaViolation) Error() string {
+	return fmt.Sprintf("schema violation at %s: %s", e.Path, e.Msg)
+}
+
+// ParseResponseSchema parses a ResponseSchema from JSON, nil if s is empty.
+// Unsupported keywords are errors, rather than being silently ignored.
+func ParseResponseSchema(s string) (*ResponseSchema, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.DisallowUnknownFields()
+	var schema ResponseSchema
+	if err := dec.Decode(&schema); err != nil {
+		return nil, errors.Wrap(err, "invalid response schema")
+	}
+	if err := schema.validate("$"); err != nil {
+		return nil, errors.Wrap(err, "invalid response schema")
+	}
+	return &schema, nil
+}
+
+func (s *ResponseSchema) validate(path string) error {
+	for _, t := range s.Type {
+		if !schemaTypes[t] {
+			return errors.Errorf("%s: unknown type %q", path, t)
+		}
+	}
+	for _, name := range sortedKeys(s.Properties) {
+		if s.Properties[name] == nil {
+			return errors.Errorf("%s: property %q has no schema", path, name)
+		}
+		if err := s.Properties[name].validate(propertyPath(path, name)); err != nil {
+			return err
+		}
+	}
+	if s.Items != nil {
+		return s.Items.validate(path + "[*]")
+	}
+	return nil
+}
+
+// Validate returns an ErrSchemaViolation if response is not JSON matching s
+func (s *ResponseSchema) Validate(response []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(response))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return ErrSchemaViolation{Path: "$", Msg: fmt.Sprintf("response is not JSON: %v", err)}
+	}
+	if dec.More() {
+		return ErrSchemaViolation{Path: "$", Msg: "response is not JSON: unexpected data after the top-level value"}
+	}
+	return s.validateValue("$", v)
+}
+
+func (s *ResponseSchema) validateValue(path string, v interface{}) error {
+	if len(s.Type) > 0 && !s.hasTypeOf(v) {
+		return ErrSchemaViolation{Path: path, Msg: fmt.Sprintf("expected %s, got %s", strings.Join(s.Type, " or "), typeOf(v))}
+	}
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for _, name := range s.Required {
+			if _, ok := t[name]; !ok {
+				return ErrSchemaViolation{Path: propertyPath(path, name), Msg: "required property is missing"}
+			}
+		}
+		for _, name := range sortedKeys(s.Properties) {
+			if pv, ok := t[name]; ok {
+				if err := s.Properties[name].validateValue(propertyPath(path, name), pv); err != nil {
+					return err
+				}
+			}
+		}
+	case []interface{}:
+		if s.Items != nil {
+			for i, e := range t {
+				if err := s.Items.validateValue(fmt.Sprintf("%s[%d]", path, i), e); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (s *ResponseSchema) hasTypeOf(v interface{}) bool {
+	actual := typeOf(v)
+	for _, t := range s.Type {
+		if t == actual || (t == SchemaTypeNumber && actual == SchemaTypeInteger) {
+			return true
+		}
+	}
+	return false
+}
+
+func typeOf(v interface{}) string {
+	switch t := v.(type) {
+	case nil:
+		return SchemaTypeNull
+	case bool:
+		return SchemaTypeBoolean
+	case json.Number:
+		if f, ok := new(big.Float).SetString(t.String()); ok && f.IsInt() {
+			return SchemaTypeInteger
+		}
+		return SchemaTypeNumber
+	case string:
+		return SchemaTypeString
+	case []interface{}:
+		return SchemaTypeArray
+	default:
+		return SchemaTypeObject
+	}
+}
+
+var identifierRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+func propertyPath(path string, name string) string {
+	if identifierRegexp.MatchString(name) {
+		return path + "." + name
+	}
+	return path + "[" + strconv.Quote(name) + "]"
+}
+
+func sortedKeys(m map[string]*ResponseSchema) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,109 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestParseResponseSchema(t *testing.T) {
+	t.Parallel()
+
+	schema, err := pipeline.ParseResponseSchema("")
+	require.NoError(t, err)
+	assert.Nil(t, schema)
+
+	schema, err = pipeline.ParseResponseSchema(`{"type":"array","items":{"type":["integer","null"]}}`)
+	require.NoError(t, err)
+	assert.Equal(t, pipeline.SchemaTypes{"array"}, schema.Type)
+	assert.Equal(t, pipeline.SchemaTypes{"integer", "null"}, schema.Items.Type)
+
+	for _, tt := range []struct {
+		name   string
+		schema string
+		err    string
+	}{
+		{"not JSON", `{"type":`, "invalid response schema: unexpected EOF"},
+		{"unsupported keyword", `{"type":"number","minimum":0}`, `invalid response schema: json: unknown field "minimum"`},
+		{"unknown type", `{"properties":{"data":{"type":"decimal"}}}`, `invalid response schema: $.data: unknown type "decimal"`},
+		{"invalid type", `{"type":1}`, "invalid response schema: type must be a string or a list of strings"},
+		{"null property", `{"properties":{"data":null}}`, `invalid response schema: $: property "data" has no schema`},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := pipeline.ParseResponseSchema(tt.schema)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestResponseSchema_Validate(t *testing.T) {
+	t.Parallel()
+
+	schema, err := pipeline.ParseResponseSchema(`{
+		"type": "object",
+		"required": ["data"],
+		"properties": {
+			"data": {
+				"type": "object",
+				"required": ["result"],
+				"properties": {
+					"result": {"type": "number"},
+					"count": {"type": "integer"},
+					"sources": {"type": "array", "items": {"type": "string"}},
+					"final": {"type": "boolean"},
+					"error message": {"type": ["string", "null"]}
+				}
+			}
+		}
+	}`)
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		name     string
+		response string
+		err      string
+	}{
+		{"valid", `{"data":{"result":1.5,"count":3,"sources":["a","b"],"final":true,"error message":null}}`, ""},
+		{"integer is a number", `{"data":{"result":2}}`, ""},
+		{"integral float is an integer", `{"data":{"result":2,"count":3.0}}`, ""},
+		{"extra properties", `{"data":{"result":2,"extra":{}},"jobRunID":"1"}`, ""},
+		{"not JSON", `<html>`, "schema violation at $: response is not JSON"},
+		{"trailing data", `{"data":{"result":2}} {}`, "schema violation at $: response is not JSON"},
+		{"wrong root type", `[]`, "schema violation at $: expected object, got array"},
+		{"missing property", `{"result":2}`, "schema violation at $.data: required property is missing"},
+		{"null property", `{"data":null}`, "schema violation at $.data: expected object, got null"},
+		{"wrong property type", `{"data":{"result":"2"}}`, "schema violation at $.data.result: expected number, got string"},
+		{"float is not an integer", `{"data":{"result":2,"count":3.5}}`, "schema violation at $.data.count: expected integer, got number"},
+		{"wrong item type", `{"data":{"result":2,"sources":["a",1]}}`, "schema violation at $.data.sources[1]: expected string, got integer"},
+		{"quoted property", `{"data":{"result":2,"error message":false}}`, `schema violation at $.data["error message"]: expected string or null, got boolean`},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := schema.Validate([]byte(tt.response))
+			if tt.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+			var violation pipeline.ErrSchemaViolation
+			assert.ErrorAs(t, err, &violation)
+		})
+	}
+}
+
+func TestParse_ResponseSchema(t *testing.T) {
+	t.Parallel()
+
+	_, err := pipeline.Parse(`ds [type=http url="https://chain.link" responseSchema=<{"type":"object"}>]`)
+	require.NoError(t, err)
+
+	_, err = pipeline.Parse(`ds [type=bridge name=foo responseSchema=<{"type":"decimal"}>]`)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `task ds: invalid response schema: $: unknown type "decimal"`)
+}
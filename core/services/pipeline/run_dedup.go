@@ -0,0 +1,116 @@
+package pipeline
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// RunDedup stores a successful run of a spec only when its outputs changed
+// from the last stored run of the spec. The other runs are recorded as compact
+// heartbeats of the last stored run, deleted with it by the reaper.
+type RunDedup struct {
+	// Tolerance is the change of a numeric output, in percent, up to which it
+	// is unchanged. Other outputs must be equal.
+	Tolerance float64 `toml:"tolerance" json:"tolerance"`
+}
+
+// Validate returns an error if the tolerance is invalid
+func (d RunDedup) Validate() error {
+	if d.Tolerance < 0 || math.IsNaN(d.Tolerance) || math.IsInf(d.Tolerance, 0) {
+		return errors.Errorf("invalid run dedup tolerance %v, must be a positive percentage", d.Tolerance)
+	}
+	return nil
+}
+
+// Value returns this instance serialized for database storage.
+func (d RunDedup) Value() (driver.Value, error) {
+	return json.Marshal(d)
+}
+
+// Scan reads the database value and returns an instance.
+func (d *RunDedup) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("expected bytes got %T", value)
+	}
+	return json.Unmarshal(b, d)
+}
+
+// Unchanged returns true if the JSON outputs of a run are the same as the
+// previous ones, within the tolerance
+func (d RunDedup) Unchanged(outputs []byte, previous []byte) bool {
+	a, err := decodeOutputs(outputs)
+	if err != nil {
+		return false
+	}
+	b, err := decodeOutputs(previous)
+	if err != nil {
+		return false
+	}
+	return equalWithin(a, b, decimal.NewFromFloat(d.Tolerance).Div(decimal.NewFromInt(100)))
+}
+
+func decodeOutputs(b []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var v interface{}
+	err := dec.Decode(&v)
+	return v, err
+}
+
+// equalWithin compares the numbers, including the numeric strings most tasks
+// output, by relative difference, and the other values by equality
+func equalWithin(a, b interface{}, tolerance decimal.Decimal) bool {
+	if da, ok := outputDecimal(a); ok {
+		if db, ok := outputDecimal(b); ok {
+			return da.Sub(db).Abs().LessThanOrEqual(db.Abs().Mul(tolerance))
+		}
+	}
+	switch ta := a.(type) {
+	case map[string]interface{}:
+		tb, ok := b.(map[string]interface{})
+		if !ok || len(ta) != len(tb) {
+			return false
+		}
+		for k, va := range ta {
+			vb, ok := tb[k]
+			if !ok || !equalWithin(va, vb, tolerance) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		tb, ok := b.([]interface{})
+		if !ok || len(ta) != len(tb) {
+			return false
+		}
+		for i := range ta {
+			if !equalWithin(ta[i], tb[i], tolerance) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+
+func outputDecimal(v interface{}) (decimal.Decimal, bool) {
+	var s string
+	switch t := v.(type) {
+	case json.Number:
+		s = t.String()
+	case string:
+		s = t
+	default:
+		return decimal.Decimal{}, false
+	}
+	d, err := decimal.NewFromString(s)
+	return d, err == nil
+}
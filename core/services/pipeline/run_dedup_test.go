@@ -0,0 +1,52 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestRunDedup_Validate(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, pipeline.RunDedup{}.Validate())
+	assert.NoError(t, pipeline.RunDedup{Tolerance: 0.5}.Validate())
+	assert.EqualError(t, pipeline.RunDedup{Tolerance: -1}.Validate(), "invalid run dedup tolerance -1, must be a positive percentage")
+}
+
+func TestRunDedup_Unchanged(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name      string
+		tolerance float64
+		outputs   string
+		previous  string
+		unchanged bool
+	}{
+		{"same number", 0, `[1234]`, `[1234]`, true},
+		{"same decimal string", 0, `["1234.5"]`, `["1234.50"]`, true},
+		{"number and numeric string", 0, `[1234]`, `["1234"]`, true},
+		{"changed number", 0, `[1234]`, `[1235]`, false},
+		{"within tolerance", 1, `["1010"]`, `["1000"]`, true},
+		{"within tolerance below", 1, `["990"]`, `["1000"]`, true},
+		{"beyond tolerance", 1, `["1011"]`, `["1000"]`, false},
+		{"from zero", 1, `[0.001]`, `[0]`, false},
+		{"nested", 1, `[{"price":"1005","sources":["a","b"]}]`, `[{"price":"1000","sources":["a","b"]}]`, true},
+		{"changed nested string", 1, `[{"price":"1000","sources":["a","c"]}]`, `[{"price":"1000","sources":["a","b"]}]`, false},
+		{"extra key", 1, `[{"price":"1000","round":1}]`, `[{"price":"1000"}]`, false},
+		{"more outputs", 0, `[1, 2]`, `[1]`, false},
+		{"same strings", 0, `["0xabc", true, null]`, `["0xabc", true, null]`, true},
+		{"changed strings", 0, `["0xabc"]`, `["0xabd"]`, false},
+		{"null and number", 0, `[null]`, `[0]`, false},
+		{"no previous outputs", 0, `[1]`, ``, false},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			d := pipeline.RunDedup{Tolerance: tt.tolerance}
+			assert.Equal(t, tt.unchanged, d.Unchanged([]byte(tt.outputs), []byte(tt.previous)))
+		})
+	}
+}
@@ -9,9 +9,8 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
-	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
 	uuid "github.com/satori/go.uuid"
+	"go.uber.org/atomic"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
@@ -46,9 +45,46 @@ type Runner interface {
 	// Note that the spec MUST have a DOT graph for this to work.
 	ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, vars Vars, l logger.Logger, saveSuccessfulTaskRuns bool) (runID int64, finalResult FinalResult, err error)
 
+	// OnRunCreated registers a function called after an async run is
+	// inserted, before its tasks are executed
+	OnRunCreated(func(*Run))
+	// OnRunFinished registers a function called after each run finishes
 	OnRunFinished(func(*Run))
+
+	// Pause stops new runs from being started until Unpause is called: they
+	// are inserted and queued instead. Runs that are already in flight,
+	// including suspended runs that get resumed, are allowed to finish.
+	Pause()
+	Unpause()
+	IsPaused() bool
+	// InFlightRuns returns the number of runs currently being executed.
+	InFlightRuns() int64
+
+	// SetMaintenanceWindows replaces the maintenance windows during which the
+	// new runs submitting transactions are skipped or queued
+	SetMaintenanceWindows(windows []MaintenanceWindow)
+	// QueuedRuns returns the number of runs waiting for the end of a
+	// maintenance window or for the runner to be unpaused
+	QueuedRuns() int
+
+	// Budgets returns the budgets checked before submitting the transactions
+	// of the jobs, or nil if they are not checked
+	Budgets() Budgets
+}
+
+// ErrRunnerPaused is returned when a new in-memory run is started while the
+// runner is paused, since it can't be queued.
+var ErrRunnerPaused = errors.New("pipeline runner is paused for maintenance")
+
+// RunExporter saves the runs deleted by the reaper, elsewhere than in the
+// database
+type RunExporter interface {
+	ExportRuns(ctx context.Context, runs []Run) error
 }
 
+// exportBatchSize is the number of runs exported, then deleted, at once
+const exportBatchSize = 1000
+
 type runner struct {
 	orm                    ORM
 	config                 Config
@@ -56,48 +92,33 @@ type runner struct {
 	ethKeyStore            ETHKeyStore
 	vrfKeyStore            VRFKeyStore
 	runReaperWorker        utils.SleeperTask
+	runExporter            RunExporter
+	publisher              Publisher
+	externalDatabases      ExternalDatabases
+	ipfs                   IPFS
+	columnCiphers          *pg.ColumnCiphers
+	budgets                Budgets
 	lggr                   logger.Logger
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
 
+	paused   atomic.Bool
+	inFlight atomic.Int64
+
+	windowsMu sync.RWMutex
+	windows   []MaintenanceWindow
+	queueMu   sync.Mutex
+	queue     []queuedRun
+
+	runCreated []func(*Run)
 	// test helper
-	runFinished func(*Run)
+	runFinished []func(*Run)
 
 	utils.StartStopOnce
 	chStop chan struct{}
 	wgDone sync.WaitGroup
 }
 
-var (
-	// PromPipelineTaskExecutionTime reports how long each pipeline task took to execute
-	// TODO: Make private again after
-	// https://app.clubhouse.io/chainlinklabs/story/6065/hook-keeper-up-to-use-tasks-in-the-pipeline
-	PromPipelineTaskExecutionTime = promauto.NewGaugeVec(prometheus.GaugeOpts{
-		Name: "pipeline_task_execution_time",
-		Help: "How long each pipeline task took to execute",
-	},
-		[]string{"job_id", "job_name", "task_id", "task_type"},
-	)
-	PromPipelineRunErrors = promauto.NewCounterVec(prometheus.CounterOpts{
-		Name: "pipeline_run_errors",
-		Help: "Number of errors for each pipeline spec",
-	},
-		[]string{"job_id", "job_name"},
-	)
-	PromPipelineRunTotalTimeToCompletion = promauto.NewGaugeVec(prometheus.GaugeOpts{
-		Name: "pipeline_run_total_time_to_completion",
-		Help: "How long each pipeline run took to finish (from the moment it was created)",
-	},
-		[]string{"job_id", "job_name"},
-	)
-	PromPipelineTasksTotalFinished = promauto.NewCounterVec(prometheus.CounterOpts{
-		Name: "pipeline_tasks_total_finished",
-		Help: "The total number of pipeline tasks which have finished",
-	},
-		[]string{"job_id", "job_name", "task_id", "task_type", "status"},
-	)
-)
-
 func NewRunner(orm ORM, config Config, chainSet evm.ChainSet, ethks ETHKeyStore, vrfks VRFKeyStore, lggr logger.Logger, httpClient, unrestrictedHTTPClient *http.Client) *runner {
 	r := &runner{
 		orm:                    orm,
@@ -107,7 +128,6 @@ func NewRunner(orm ORM, config Config, chainSet evm.ChainSet, ethks ETHKeyStore,
 		vrfKeyStore:            vrfks,
 		chStop:                 make(chan struct{}),
 		wgDone:                 sync.WaitGroup{},
-		runFinished:            func(*Run) {},
 		lggr:                   lggr.Named("PipelineRunner"),
 		httpClient:             httpClient,
 		unrestrictedHTTPClient: unrestrictedHTTPClient,
@@ -121,8 +141,9 @@ func NewRunner(orm ORM, config Config, chainSet evm.ChainSet, ethks ETHKeyStore,
 // Start starts Runner.
 func (r *runner) Start(context.Context) error {
 	return r.StartOnce("PipelineRunner", func() error {
-		r.wgDone.Add(1)
+		r.wgDone.Add(2)
 		go r.scheduleUnfinishedRuns()
+		go r.runQueuedRunsLoop()
 		if r.config.JobPipelineReaperInterval() != time.Duration(0) {
 			r.wgDone.Add(1)
 			go r.runReaperLoop()
@@ -193,8 +214,12 @@ func NewRun(spec Spec, vars Vars) Run {
 	}
 }
 
+func (r *runner) OnRunCreated(fn func(*Run)) {
+	r.runCreated = append(r.runCreated, fn)
+}
+
 func (r *runner) OnRunFinished(fn func(*Run)) {
-	r.runFinished = fn
+	r.runFinished = append(r.runFinished, fn)
 }
 
 // Be careful with the ctx passed in here: it applies to requests in individual
@@ -206,12 +231,25 @@ func (r *runner) ExecuteRun(
 	l logger.Logger,
 ) (Run, TaskRunResults, error) {
 	run := NewRun(spec, vars)
+	if r.paused.Load() {
+		return run, nil, ErrRunnerPaused
+	}
+	r.inFlight.Inc()
+	defer r.inFlight.Dec()
 
 	pipeline, err := r.initializePipeline(&run)
 
 	if err != nil {
 		return run, nil, err
 	}
+	// in-memory runs can't be queued
+	if r.maintenanceWindowAction(&run, pipeline) != "" {
+		return run, nil, ErrRunSkippedMaintenanceWindow
+	}
+
+	if err = r.loadState(ctx, &run, pipeline, vars); err != nil {
+		return run, nil, err
+	}
 
 	taskRunResults := r.run(ctx, pipeline, &run, vars, l)
 
@@ -240,6 +278,7 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 		case TaskTypeBridge:
 			task.(*BridgeTask).config = r.config
 			task.(*BridgeTask).queryer = r.orm.GetQ()
+			task.(*BridgeTask).ciphers = r.columnCiphers
 			// URL is "safe" because it comes from the node's own database. We
 			// must use the unrestrictedHTTPClient because some node operators
 			// may run external adapters on their own hardware
@@ -263,6 +302,20 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 			task.(*ETHTxTask).specGasLimit = run.PipelineSpec.GasLimit
 			task.(*ETHTxTask).jobType = run.PipelineSpec.JobType
 			task.(*ETHTxTask).forwardingAllowed = run.PipelineSpec.ForwardingAllowed
+			task.(*ETHTxTask).jobID = run.PipelineSpec.JobID
+			task.(*ETHTxTask).budgets = r.budgets
+		case TaskTypePublish:
+			task.(*PublishTask).publisher = r.publisher
+			task.(*PublishTask).jobID = run.PipelineSpec.JobID
+			task.(*PublishTask).jobName = run.PipelineSpec.JobName
+		case TaskTypeDBQuery:
+			task.(*DBQueryTask).databases = r.externalDatabases
+		case TaskTypeIPFS:
+			task.(*IPFSTask).config = r.config
+			task.(*IPFSTask).ipfs = r.ipfs
+		case TaskTypeDeviation:
+			task.(*DeviationTask).orm = r.orm
+			task.(*DeviationTask).specID = run.PipelineSpecID
 		default:
 		}
 	}
@@ -280,10 +333,41 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 	return pipeline, nil
 }
 
+// loadState sets the state of the spec of run in vars, if its pipeline reads
+// $(state.*) variables. The keys of the setstate tasks are nil until set.
+func (r *runner) loadState(ctx context.Context, run *Run, pipeline *Pipeline, vars Vars) error {
+	if !usesState(run.PipelineSpec.DotDagSource) {
+		return nil
+	}
+	state := make(map[string]interface{})
+	// Runs which are not stored, like the simulated ones, have no state
+	if r.orm != nil && run.PipelineSpecID != 0 {
+		var err error
+		if state, err = r.orm.FindState(run.PipelineSpecID, pg.WithParentCtx(ctx)); err != nil {
+			return errors.Wrap(err, "failed to load the pipeline state")
+		}
+	}
+	for _, task := range pipeline.Tasks {
+		if setState, ok := task.(*SetStateTask); ok {
+			if _, exists := state[setState.Key]; !exists {
+				state[setState.Key] = nil
+			}
+		}
+	}
+	return vars.Set(stateVar, state)
+}
+
 func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars, l logger.Logger) TaskRunResults {
 	l = l.With("jobID", run.PipelineSpec.JobID, "jobName", run.PipelineSpec.JobName)
 	l.Debug("Initiating tasks for pipeline run of spec")
 
+	// The run ID is only known once the run has been inserted
+	for _, task := range pipeline.Tasks {
+		if ethTxTask, ok := task.(*ETHTxTask); ok {
+			ethTxTask.pipelineRunID = run.ID
+		}
+	}
+
 	scheduler := newScheduler(pipeline, run, vars, l)
 	go scheduler.Run()
 
@@ -330,7 +414,7 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 		// NOTE: runTime can be very long now because it'll include suspend
 		runTime := run.FinishedAt.Time.Sub(run.CreatedAt)
 		l.Debugw("Finished all tasks for pipeline run", "specID", run.PipelineSpecID, "runTime", runTime)
-		PromPipelineRunTotalTimeToCompletion.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Set(float64(runTime))
+		RecordRunTimeToCompletion(run.PipelineSpec, runTime)
 	}
 
 	// Update run results
@@ -377,7 +461,7 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 
 		if run.HasFatalErrors() {
 			run.State = RunStatusErrored
-			PromPipelineRunErrors.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Inc()
+			RecordRunError(run.PipelineSpec)
 		} else {
 			run.State = RunStatusCompleted
 		}
@@ -451,14 +535,13 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 func logTaskRunToPrometheus(trr TaskRunResult, spec Spec) {
 	elapsed := trr.FinishedAt.Time.Sub(trr.CreatedAt)
 
-	PromPipelineTaskExecutionTime.WithLabelValues(fmt.Sprintf("%d", spec.JobID), spec.JobName, trr.Task.DotID(), string(trr.Task.Type())).Set(float64(elapsed))
 	var status string
 	if trr.Result.Error != nil {
 		status = "error"
 	} else {
 		status = "completed"
 	}
-	PromPipelineTasksTotalFinished.WithLabelValues(fmt.Sprintf("%d", spec.JobID), spec.JobName, trr.Task.DotID(), string(trr.Task.Type()), status).Inc()
+	RecordTaskRun(spec, trr.Task.DotID(), string(trr.Task.Type()), elapsed, status)
 }
 
 // ExecuteAndInsertFinishedRun executes a run in memory then inserts the finished run/task run records, returning the final result
@@ -478,22 +561,39 @@ func (r *runner) ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, var
 	if err = r.orm.InsertFinishedRun(&run, saveSuccessfulTaskRuns); err != nil {
 		return 0, finalResult, errors.Wrapf(err, "error inserting finished results for spec ID %v", spec.ID)
 	}
+	r.notifyRunFinished(&run)
 	return run.ID, finalResult, nil
 
 }
 
 func (r *runner) Run(ctx context.Context, run *Run, l logger.Logger, saveSuccessfulTaskRuns bool, fn func(tx pg.Queryer) error) (incomplete bool, err error) {
+	// Resumed runs (with an ID) are let through so that in-flight work can
+	// drain, new runs are inserted and queued until the runner is unpaused.
+	paused := run.ID == 0 && r.paused.Load()
+	r.inFlight.Inc()
+	defer r.inFlight.Dec()
+
 	pipeline, err := r.initializePipeline(run)
 	if err != nil {
 		return false, err
 	}
 
-	preinsert := pipeline.RequiresPreInsert()
+	// runs submitting transactions are always inserted before being
+	// executed, so they can be queued. Runs already queued wait for the end
+	// of skip windows too.
+	windowAction := r.maintenanceWindowAction(run, pipeline)
+	if windowAction == MaintenanceWindowActionSkip && run.ID == 0 {
+		return false, ErrRunSkippedMaintenanceWindow
+	}
+
+	// runs with an ID were inserted before, when queued
+	preinsert := pipeline.RequiresPreInsert() || paused || run.ID != 0
+	created := preinsert && run.ID == 0
 
 	q := r.orm.GetQ().WithOpts(pg.WithParentCtx(ctx))
 	err = q.Transaction(func(tx pg.Queryer) error {
 		// OPTIMISATION: avoid an extra db write if there is no async tasks present or if this is a resumed run
-		if preinsert && run.ID == 0 {
+		if created {
 			now := time.Now()
 			// initialize certain task params
 			for _, task := range pipeline.Tasks {
@@ -523,9 +623,23 @@ func (r *runner) Run(ctx context.Context, run *Run, l logger.Logger, saveSuccess
 	if err != nil {
 		return false, err
 	}
+	if created {
+		for _, fn := range r.runCreated {
+			fn(run)
+		}
+	}
+	if windowAction != "" || paused {
+		r.enqueueRun(run, l, saveSuccessfulTaskRuns)
+		return true, nil
+	}
+
+	vars := NewVarsFrom(run.Inputs.Val.(map[string]interface{}))
+	if err = r.loadState(ctx, run, pipeline, vars); err != nil {
+		return false, err
+	}
 
 	for {
-		r.run(ctx, pipeline, run, NewVarsFrom(run.Inputs.Val.(map[string]interface{})), l)
+		r.run(ctx, pipeline, run, vars, l)
 
 		if preinsert {
 			// FailSilently = run failed and task was marked failEarly. skip StoreRun and instead delete all trace of it
@@ -561,12 +675,34 @@ func (r *runner) Run(ctx context.Context, run *Run, l logger.Logger, saveSuccess
 			}
 		}
 
-		r.runFinished(run)
+		r.notifyRunFinished(run)
 
 		return run.Pending, err
 	}
 }
 
+// Pause stops new runs from being started.
+func (r *runner) Pause() {
+	if !r.paused.Swap(true) {
+		r.lggr.Warn("Pipeline runner paused, new runs will be queued")
+	}
+}
+
+// Unpause allows new runs to be started again.
+func (r *runner) Unpause() {
+	if r.paused.Swap(false) {
+		r.lggr.Info("Pipeline runner unpaused")
+	}
+}
+
+func (r *runner) IsPaused() bool {
+	return r.paused.Load()
+}
+
+func (r *runner) InFlightRuns() int64 {
+	return r.inFlight.Load()
+}
+
 func (r *runner) ResumeRun(taskID uuid.UUID, value interface{}, err error) error {
 	run, start, err := r.orm.UpdateTaskRunResult(taskID, Result{
 		Value: value,
@@ -591,11 +727,28 @@ func (r *runner) ResumeRun(taskID uuid.UUID, value interface{}, err error) error
 }
 
 func (r *runner) InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) error {
-	return r.orm.InsertFinishedRun(run, saveSuccessfulTaskRuns, qopts...)
+	if err := r.orm.InsertFinishedRun(run, saveSuccessfulTaskRuns, qopts...); err != nil {
+		return err
+	}
+	r.notifyRunFinished(run)
+	return nil
 }
 
 func (r *runner) InsertFinishedRuns(runs []*Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) error {
-	return r.orm.InsertFinishedRuns(runs, saveSuccessfulTaskRuns, qopts...)
+	if err := r.orm.InsertFinishedRuns(runs, saveSuccessfulTaskRuns, qopts...); err != nil {
+		return err
+	}
+	for _, run := range runs {
+		r.notifyRunFinished(run)
+	}
+	return nil
+}
+
+// notifyRunFinished calls the OnRunFinished hooks with a run once it is stored
+func (r *runner) notifyRunFinished(run *Run) {
+	for _, fn := range r.runFinished {
+		fn(run)
+	}
 }
 
 func (r *runner) runReaper() {
@@ -603,7 +756,12 @@ func (r *runner) runReaper() {
 	ctx, cancel := utils.ContextFromChanWithDeadline(r.chStop, r.config.JobPipelineReaperInterval())
 	defer cancel()
 
-	err := r.orm.DeleteRunsOlderThan(ctx, r.config.JobPipelineReaperThreshold())
+	var err error
+	if r.runExporter != nil {
+		err = r.exportAndDeleteRuns(ctx, time.Now().Add(-r.config.JobPipelineReaperThreshold()))
+	} else {
+		err = r.orm.DeleteRunsOlderThan(ctx, r.config.JobPipelineReaperThreshold())
+	}
 	if err != nil {
 		r.lggr.Errorw("Pipeline run reaper failed", "error", err)
 	} else {
@@ -611,6 +769,79 @@ func (r *runner) runReaper() {
 	}
 }
 
+// SetRunExporter sets the exporter of the runs deleted by the reaper. It must
+// be called before the runner is started.
+func (r *runner) SetRunExporter(exporter RunExporter) {
+	r.runExporter = exporter
+}
+
+// SetPublisher sets the publisher of the results of the publish tasks. It must
+// be called before the runner is started.
+func (r *runner) SetPublisher(publisher Publisher) {
+	r.publisher = publisher
+}
+
+// SetColumnCiphers sets the ciphers decrypting the outgoing tokens of the
+// bridges. It must be called before the runner is started.
+func (r *runner) SetColumnCiphers(ciphers *pg.ColumnCiphers) {
+	r.columnCiphers = ciphers
+}
+
+// SetExternalDatabases sets the databases queried by the dbquery tasks. It
+// must be called before the runner is started.
+func (r *runner) SetExternalDatabases(databases ExternalDatabases) {
+	r.externalDatabases = databases
+}
+
+// SetIPFS sets the IPFS client of the ipfs tasks. It must be called before the
+// runner is started.
+func (r *runner) SetIPFS(ipfs IPFS) {
+	r.ipfs = ipfs
+}
+
+// SetBudgets sets the budgets checked by the ethtx tasks, and by the job
+// services submitting transactions. It must be called before the runner is
+// started and the jobs are spawned.
+func (r *runner) SetBudgets(budgets Budgets) {
+	r.budgets = budgets
+}
+
+func (r *runner) Budgets() Budgets {
+	return r.budgets
+}
+
+// exportAndDeleteRuns exports the runs finished before before by batches, and
+// deletes each batch once exported. The runs failing to export are kept.
+func (r *runner) exportAndDeleteRuns(ctx context.Context, before time.Time) error {
+	start := time.Now()
+	defer func() {
+		promReaperDuration.WithLabelValues("export").Observe(time.Since(start).Seconds())
+	}()
+	for {
+		runs, err := r.orm.FindRunsFinishedBefore(ctx, before, exportBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(runs) == 0 {
+			return nil
+		}
+		if err = r.runExporter.ExportRuns(ctx, runs); err != nil {
+			return errors.Wrap(err, "failed to export runs, they are not deleted")
+		}
+		promReaperExportedRuns.Add(float64(len(runs)))
+		ids := make([]int64, len(runs))
+		for i, run := range runs {
+			ids[i] = run.ID
+		}
+		if err = r.orm.DeleteRuns(ctx, ids); err != nil {
+			return err
+		}
+		if len(runs) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
 // init task: Searches the database for runs stuck in the 'running' state while the node was previously killed.
 // We pick up those runs and resume execution.
 func (r *runner) scheduleUnfinishedRuns() {
@@ -30,6 +30,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
 	"github.com/smartcontractkit/sqlx"
@@ -486,6 +487,61 @@ succeed2 -> final;
 	assert.Equal(t, "10.5", finalResult.Values[0].(decimal.Decimal).String())
 }
 
+func Test_PipelineRunner_OnRunFinished(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	r, orm := newRunner(t, db, cfg)
+	lggr := logger.TestLogger(t)
+	spec := pipeline.Spec{DotDagSource: `a [type=memo value=10]`}
+
+	var finished []*pipeline.Run
+	r.OnRunFinished(func(run *pipeline.Run) {
+		finished = append(finished, run)
+	})
+
+	t.Run("OCR-style run executed then inserted", func(t *testing.T) {
+		finished = nil
+		run, _, err := r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), lggr)
+		require.NoError(t, err)
+
+		orm.On("InsertFinishedRun", &run, false).Return(nil).Once()
+		require.NoError(t, r.InsertFinishedRun(&run, false))
+		require.Len(t, finished, 1)
+		assert.Same(t, &run, finished[0])
+	})
+
+	t.Run("runs inserted in a batch", func(t *testing.T) {
+		finished = nil
+		run1, _, err := r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), lggr)
+		require.NoError(t, err)
+		run2, _, err := r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), lggr)
+		require.NoError(t, err)
+
+		runs := []*pipeline.Run{&run1, &run2}
+		orm.On("InsertFinishedRuns", runs, false).Return(nil).Once()
+		require.NoError(t, r.InsertFinishedRuns(runs, false))
+		assert.Equal(t, runs, finished)
+	})
+
+	t.Run("run executed and inserted", func(t *testing.T) {
+		finished = nil
+		orm.On("InsertFinishedRun", mock.AnythingOfType("*pipeline.Run"), true).Return(nil).Once()
+		_, _, err := r.ExecuteAndInsertFinishedRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), lggr, true)
+		require.NoError(t, err)
+		require.Len(t, finished, 1)
+	})
+
+	t.Run("not called if the run is not inserted", func(t *testing.T) {
+		finished = nil
+		run, _, err := r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), lggr)
+		require.NoError(t, err)
+
+		orm.On("InsertFinishedRun", &run, false).Return(errors.New("connection refused")).Once()
+		require.Error(t, r.InsertFinishedRun(&run, false))
+		assert.Empty(t, finished)
+	})
+}
+
 func Test_PipelineRunner_MultipleOutputs(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 	cfg := cltest.NewTestGeneralConfig(t)
@@ -910,3 +966,125 @@ en->de
 	require.NoError(t, err)
 	assert.Equal(t, inputBytes, result.Value)
 }
+
+func Test_PipelineRunner_State(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	lggr := logger.TestLogger(t)
+	orm := pipeline.NewORM(db, lggr, cfg)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg})
+	r := pipeline.NewRunner(orm, cfg, cc, cltest.NewKeyStore(t, db, cfg).Eth(), nil, lggr, nil, nil)
+
+	source := `
+read  [type=memo value=<$(state.cursor)>];
+set   [type=setstate key=cursor value=<$(jobRun.cursor)>];
+check [type=conditional data="$(jobRun.ok)"];
+`
+	p, err := pipeline.Parse(source)
+	require.NoError(t, err)
+	specID, err := orm.CreateSpec(*p, models.Interval(time.Minute))
+	require.NoError(t, err)
+	spec := pipeline.Spec{ID: specID, DotDagSource: source}
+
+	execute := func(cursor string, ok bool) interface{} {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"jobRun": map[string]interface{}{"cursor": cursor, "ok": ok},
+		})
+		runID, _, err := r.ExecuteAndInsertFinishedRun(testutils.Context(t), spec, vars, lggr, true)
+		require.NoError(t, err)
+		run, err := orm.FindRun(runID)
+		require.NoError(t, err)
+		return run.ByDotID("read").Output.Val
+	}
+
+	// the keys of the setstate tasks are nil until set
+	assert.Nil(t, execute("page-2", true))
+	assert.Equal(t, "page-2", execute("page-3", true))
+	// the state is not stored with the failed runs
+	assert.Equal(t, "page-3", execute("page-4", false))
+	assert.Equal(t, "page-3", execute("page-4", true))
+
+	state, err := orm.FindState(specID)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"cursor": "page-4"}, state)
+}
+
+func Test_PipelineRunner_Pause(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	r, orm := newRunner(t, db, cfg)
+	lggr := logger.TestLogger(t)
+	spec := pipeline.Spec{
+		DotDagSource: `
+a [type=lowercase input="FOO"]
+`,
+	}
+
+	assert.False(t, r.IsPaused())
+	r.Pause()
+	assert.True(t, r.IsPaused())
+
+	_, _, err := r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), lggr)
+	require.ErrorIs(t, err, pipeline.ErrRunnerPaused)
+
+	// new runs are inserted and queued instead
+	orm.On("CreateRun", mock.AnythingOfType("*pipeline.Run"), mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(0).(*pipeline.Run).ID = 1
+	}).Once()
+	run := pipeline.NewRun(spec, pipeline.NewVarsFrom(nil))
+	incomplete, err := r.Run(testutils.Context(t), &run, lggr, false, nil)
+	require.NoError(t, err)
+	assert.True(t, incomplete)
+	assert.Equal(t, int64(1), run.ID)
+	assert.Equal(t, 1, r.QueuedRuns())
+
+	r.Unpause()
+	assert.False(t, r.IsPaused())
+
+	// the queued run is stored, not inserted again, once started
+	orm.On("StoreRun", mock.AnythingOfType("*pipeline.Run"), mock.Anything).Return(false, nil).Once()
+	incomplete, err = r.Run(testutils.Context(t), &run, lggr, false, nil)
+	require.NoError(t, err)
+	assert.False(t, incomplete)
+
+	_, trrs, err := r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), lggr)
+	require.NoError(t, err)
+	require.Len(t, trrs, 1)
+	assert.Equal(t, int64(0), r.InFlightRuns())
+}
+
+func Test_PipelineRunner_MaintenanceWindows(t *testing.T) {
+	cfg := cltest.NewTestGeneralConfig(t)
+	r, _ := newRunner(t, pgtest.NewSqlxDB(t), cfg)
+	lggr := logger.TestLogger(t)
+
+	txSpec := pipeline.Spec{JobID: 1, DotDagSource: `a [type=ethtx]`}
+	memoSpec := pipeline.Spec{JobID: 1, DotDagSource: `a [type=memo value=1]`}
+	otherJob := int32(2)
+	now := time.Now()
+
+	r.SetMaintenanceWindows([]pipeline.MaintenanceWindow{
+		{JobID: &otherJob, StartsAt: now.Add(-time.Minute), EndsAt: now.Add(time.Hour), Action: pipeline.MaintenanceWindowActionSkip},
+		{StartsAt: now.Add(time.Hour), EndsAt: now.Add(2 * time.Hour), Action: pipeline.MaintenanceWindowActionSkip},
+	})
+	_, _, err := r.ExecuteRun(testutils.Context(t), txSpec, pipeline.NewVarsFrom(nil), lggr)
+	assert.NotErrorIs(t, err, pipeline.ErrRunSkippedMaintenanceWindow)
+
+	r.SetMaintenanceWindows([]pipeline.MaintenanceWindow{
+		{StartsAt: now.Add(-time.Minute), EndsAt: now.Add(time.Hour), Action: pipeline.MaintenanceWindowActionQueue},
+	})
+	_, _, err = r.ExecuteRun(testutils.Context(t), txSpec, pipeline.NewVarsFrom(nil), lggr)
+	assert.ErrorIs(t, err, pipeline.ErrRunSkippedMaintenanceWindow)
+
+	// runs not submitting transactions are not affected
+	_, trrs, err := r.ExecuteRun(testutils.Context(t), memoSpec, pipeline.NewVarsFrom(nil), lggr)
+	require.NoError(t, err)
+	assert.False(t, trrs.FinalResult(lggr).HasErrors())
+}
+
+func Test_MaintenanceWindow_Validate(t *testing.T) {
+	now := time.Now()
+	assert.NoError(t, pipeline.MaintenanceWindow{StartsAt: now, EndsAt: now.Add(time.Second), Action: pipeline.MaintenanceWindowActionQueue}.Validate())
+	assert.Error(t, pipeline.MaintenanceWindow{StartsAt: now, EndsAt: now, Action: pipeline.MaintenanceWindowActionSkip}.Validate())
+	assert.Error(t, pipeline.MaintenanceWindow{StartsAt: now, EndsAt: now.Add(time.Second), Action: "pause"}.Validate())
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"time"
 
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
@@ -23,11 +24,14 @@ type BridgeTask struct {
 	BaseTask `mapstructure:",squash"`
 
 	Name              string `json:"name"`
+	Names             string `json:"names"`
 	RequestData       string `json:"requestData"`
 	IncludeInputAtKey string `json:"includeInputAtKey"`
 	Async             string `json:"async"`
+	ResponseSchema    string `json:"responseSchema"`
 
 	queryer    pg.Queryer
+	ciphers    *pg.ColumnCiphers
 	config     Config
 	httpClient *http.Client
 }
@@ -52,18 +56,23 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		includeInputAtKey StringParam
 	)
 	err = multierr.Combine(
-		errors.Wrap(ResolveParam(&name, From(NonemptyString(t.Name))), "name"),
+		errors.Wrap(t.resolveName(&name), "name"),
 		errors.Wrap(ResolveParam(&requestData, From(VarExpr(t.RequestData, vars), JSONWithVarExprs(t.RequestData, vars, false), nil)), "requestData"),
 		errors.Wrap(ResolveParam(&includeInputAtKey, From(t.IncludeInputAtKey)), "includeInputAtKey"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	schema, err := ParseResponseSchema(t.ResponseSchema)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "responseSchema")}, runInfo
+	}
 
-	url, err := t.getBridgeURLFromName(name)
+	bridge, err := t.getBridge(name)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	url := URLParam(bridge.URL)
 
 	var metaMap MapParam
 
@@ -106,7 +115,11 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	requestCtx, cancel := httpRequestCtx(ctx, t, t.config)
 	defer cancel()
 
-	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(requestCtx, lggr, "POST", URLParam(url), []string{}, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+	// makeHTTPRequest encodes requestData to the same bytes as requestDataJSON
+	timestamp, signature := bridges.SignRequest(bridge.OutgoingToken, requestDataJSON, time.Now())
+	reqHeaders := []string{bridges.TimestampHeader, timestamp, bridges.SignatureHeader, signature}
+
+	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
 	if err != nil {
 		return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
 	}
@@ -125,15 +138,21 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		}
 	}
 
+	promHTTPFetchTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
+	promHTTPResponseBodySize.WithLabelValues(t.DotID()).Set(float64(len(responseBytes)))
+
+	if schema != nil {
+		if err = schema.Validate(responseBytes); err != nil {
+			return Result{Error: err}, runInfo
+		}
+	}
+
 	// NOTE: We always stringify the response since this is required for all current jobs.
 	// If a binary response is required we might consider adding an adapter
 	// flag such as  "BinaryMode: true" which passes through raw binary as the
 	// value instead.
 	result = Result{Value: string(responseBytes)}
 
-	promHTTPFetchTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
-	promHTTPResponseBodySize.WithLabelValues(t.DotID()).Set(float64(len(responseBytes)))
-
 	lggr.Debugw("Bridge task: fetched answer",
 		"answer", result.Value,
 		"url", url.String(),
@@ -142,13 +161,61 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	return result, runInfo
 }
 
-func (t BridgeTask) getBridgeURLFromName(name StringParam) (URLParam, error) {
-	var bt bridges.BridgeType
-	err := t.queryer.Get(&bt, "SELECT * FROM bridge_types WHERE name = $1", string(name))
+// BridgeNames returns the names of the bridges the task may call: its name, or
+// the group of bridges of its names, a JSON list
+func (t *BridgeTask) BridgeNames() ([]bridges.BridgeName, error) {
+	if t.Names == "" {
+		name, err := bridges.ParseBridgeName(t.Name)
+		if err != nil {
+			return nil, err
+		}
+		return []bridges.BridgeName{name}, nil
+	}
+	if t.Name != "" {
+		return nil, errors.New("name and names are mutually exclusive")
+	}
+	var strs []string
+	if err := json.Unmarshal([]byte(t.Names), &strs); err != nil {
+		return nil, errors.Wrap(err, "names must be a JSON list of bridge names")
+	}
+	if len(strs) == 0 {
+		return nil, errors.New("names must not be empty")
+	}
+	names := make([]bridges.BridgeName, len(strs))
+	for i, s := range strs {
+		name, err := bridges.ParseBridgeName(s)
+		if err != nil {
+			return nil, err
+		}
+		names[i] = name
+	}
+	return names, nil
+}
+
+// resolveName sets name to the bridge of the task or, with a group of bridges,
+// to the healthiest one
+func (t *BridgeTask) resolveName(name *StringParam) error {
+	if t.Names == "" {
+		return ResolveParam(name, From(NonemptyString(t.Name)))
+	}
+	names, err := t.BridgeNames()
+	if err != nil {
+		return err
+	}
+	health, err := bridges.LoadBridgeHealth(t.queryer, names, time.Now().Add(-t.config.BridgeHealthWindow()))
+	if err != nil {
+		return err
+	}
+	*name = StringParam(bridges.RankBridges(names, health)[0])
+	return nil
+}
+
+func (t BridgeTask) getBridge(name StringParam) (bt bridges.BridgeType, err error) {
+	err = t.queryer.Get(&bt, "SELECT * FROM bridge_types WHERE name = $1", string(name))
 	if err != nil {
-		return URLParam{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+		return bt, errors.Wrapf(err, "could not find bridge with name '%s'", name)
 	}
-	return URLParam(bt.URL), nil
+	return bt, errors.Wrapf(bt.DecryptSecrets(t.ciphers), "could not decrypt bridge with name '%s'", name)
 }
 
 func withRunInfo(request MapParam, meta MapParam) MapParam {
@@ -9,6 +9,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
@@ -152,6 +153,43 @@ func TestBridgeTask_Happy(t *testing.T) {
 	require.Equal(t, decimal.NewFromInt(9700), x.Data.Result)
 }
 
+func TestBridgeTask_SignsRequest(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+
+	var timestamp, signature string
+	var body []byte
+	s1 := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		timestamp = r.Header.Get(bridges.TimestampHeader)
+		signature = r.Header.Get(bridges.SignatureHeader)
+		var err error
+		body, err = ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"result":1}}`))
+	}))
+	defer s1.Close()
+
+	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: s1.URL}, cfg)
+
+	task := pipeline.BridgeTask{
+		BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+		Name:        bridge.Name.String(),
+		RequestData: btcUSDPairing,
+	}
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	task.HelperSetDependencies(cfg, db, uuid.UUID{}, c)
+
+	result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, result.Error)
+
+	require.NotEmpty(t, timestamp)
+	require.NotEmpty(t, signature)
+	assert.NoError(t, bridges.VerifyRequest(bridge.OutgoingToken, body, timestamp, signature, time.Minute, time.Now()))
+}
+
 func TestBridgeTask_AsyncJobPendingState(t *testing.T) {
 	t.Parallel()
 
@@ -596,6 +634,60 @@ func TestBridgeTask_ErrorIfBridgeMissing(t *testing.T) {
 	assert.Contains(t, result.Error.Error(), "could not find bridge with name 'foo'")
 }
 
+func TestBridgeTask_Names(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	orm := bridges.NewORM(db, logger.TestLogger(t), cfg, nil)
+
+	flapping := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer flapping.Close()
+	healthy := httptest.NewServer(fakePriceResponder(t, utils.MustUnmarshalToMap(btcUSDPairing), decimal.NewFromInt(9700), "", nil))
+	defer healthy.Close()
+
+	_, flappingBridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: flapping.URL}, cfg)
+	_, healthyBridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: healthy.URL}, cfg)
+	now := time.Now()
+	for _, check := range []bridges.BridgeHealthCheck{
+		{BridgeName: flappingBridge.Name, Success: false, CreatedAt: now},
+		{BridgeName: flappingBridge.Name, Success: true, CreatedAt: now},
+		{BridgeName: healthyBridge.Name, Success: true, CreatedAt: now},
+	} {
+		check := check
+		require.NoError(t, orm.CreateBridgeHealthCheck(&check))
+	}
+
+	task := pipeline.BridgeTask{
+		BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+		Names:       fmt.Sprintf(`["%s", "%s"]`, flappingBridge.Name, healthyBridge.Name),
+		RequestData: btcUSDPairing,
+	}
+	names, err := task.BridgeNames()
+	require.NoError(t, err)
+	assert.Equal(t, []bridges.BridgeName{flappingBridge.Name, healthyBridge.Name}, names)
+
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	task.HelperSetDependencies(cfg, db, uuid.UUID{}, c)
+	result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, result.Error)
+	require.NotNil(t, result.Value)
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, task := range []pipeline.BridgeTask{
+			{Name: "foo", Names: `["bar"]`},
+			{Names: `[]`},
+			{Names: `bar`},
+			{Names: `["b@r"]`},
+		} {
+			_, err := task.BridgeNames()
+			assert.Error(t, err, task.Names)
+		}
+	})
+}
+
 // Sample input taken from
 // https://github.com/smartcontractkit/price-adapters#chainlink-price-request-adapters
 func TestAdapterResponse_UnmarshalJSON_Happy(t *testing.T) {
@@ -0,0 +1,96 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// ExternalDatabases runs the read-only queries of the dbquery tasks against
+// the external databases registered by the operator
+type ExternalDatabases interface {
+	Query(ctx context.Context, database, query string, args []interface{}) ([]map[string]interface{}, error)
+}
+
+// Return types:
+//
+//	[]interface{} of map[string]interface{}, one per row
+type DBQueryTask struct {
+	BaseTask `mapstructure:",squash"`
+	Database string `json:"database"`
+	Query    string `json:"query"`
+	Params   string `json:"params"`
+
+	databases ExternalDatabases
+}
+
+var _ Task = (*DBQueryTask)(nil)
+
+func (t *DBQueryTask) Type() TaskType {
+	return TaskTypeDBQuery
+}
+
+func (t *DBQueryTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		database StringParam
+		query    StringParam
+		params   SliceParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&database, From(NonemptyString(t.Database))), "database"),
+		errors.Wrap(ResolveParam(&query, From(NonemptyString(t.Query))), "query"),
+		errors.Wrap(ResolveParam(&params, From(VarExpr(t.Params, vars), JSONWithVarExprs(t.Params, vars, false), nil)), "params"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if t.databases == nil {
+		return Result{Error: errors.New("external databases are not available")}, runInfo
+	}
+
+	args := make([]interface{}, len(params))
+	for i, param := range params {
+		if args[i], err = queryArg(param); err != nil {
+			return Result{Error: errors.Wrapf(err, "params: invalid param %d", i)}, runInfo
+		}
+	}
+
+	rows, err := t.databases.Query(ctx, string(database), string(query), args)
+	if err != nil {
+		return Result{Error: errors.Wrapf(err, "failed to query database %s", database)}, retryableRunInfo()
+	}
+	lggr.Debugw("Queried external database", "database", database, "rows", len(rows))
+
+	value := make([]interface{}, len(rows))
+	for i, row := range rows {
+		value[i] = row
+	}
+	return Result{Value: value}, runInfo
+}
+
+// queryArg converts the params the database driver does not support: big
+// integers are passed as strings, and maps and slices as JSON.
+func queryArg(param interface{}) (interface{}, error) {
+	switch v := param.(type) {
+	case *big.Int:
+		return v.String(), nil
+	case big.Int:
+		return v.String(), nil
+	case map[string]interface{}, []interface{}:
+		b, err := json.Marshal(v)
+		return string(b), err
+	default:
+		return v, nil
+	}
+}
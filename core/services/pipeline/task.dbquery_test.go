@@ -0,0 +1,83 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+type fakeExternalDatabases struct {
+	database string
+	query    string
+	args     []interface{}
+	rows     []map[string]interface{}
+	err      error
+}
+
+func (d *fakeExternalDatabases) Query(_ context.Context, database, query string, args []interface{}) ([]map[string]interface{}, error) {
+	d.database = database
+	d.query = query
+	d.args = args
+	return d.rows, d.err
+}
+
+func TestDBQueryTask(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns the rows", func(t *testing.T) {
+		databases := &fakeExternalDatabases{rows: []map[string]interface{}{{"price": "1234.5"}}}
+		task := pipeline.DBQueryTask{
+			BaseTask: pipeline.NewBaseTask(0, "dbquery", nil, nil, 0),
+			Database: "warehouse",
+			Query:    "SELECT price FROM prices WHERE pair = $1 AND day = $2",
+			Params:   `["ETH/USD", $(day)]`,
+		}
+		task.HelperSetDependencies(databases)
+
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"day": "2022-09-01"})
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.NoError(t, result.Error)
+		assert.False(t, runInfo.IsRetryable)
+		assert.Equal(t, []interface{}{map[string]interface{}{"price": "1234.5"}}, result.Value)
+
+		assert.Equal(t, "warehouse", databases.database)
+		assert.Equal(t, "SELECT price FROM prices WHERE pair = $1 AND day = $2", databases.query)
+		assert.Equal(t, []interface{}{"ETH/USD", "2022-09-01"}, databases.args)
+	})
+
+	t.Run("without params", func(t *testing.T) {
+		databases := &fakeExternalDatabases{rows: []map[string]interface{}{}}
+		task := pipeline.DBQueryTask{BaseTask: pipeline.NewBaseTask(0, "dbquery", nil, nil, 0), Database: "warehouse", Query: "SELECT 1"}
+		task.HelperSetDependencies(databases)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		assert.Equal(t, []interface{}{}, result.Value)
+		assert.Empty(t, databases.args)
+	})
+
+	t.Run("missing database", func(t *testing.T) {
+		task := pipeline.DBQueryTask{BaseTask: pipeline.NewBaseTask(0, "dbquery", nil, nil, 0), Query: "SELECT 1"}
+		task.HelperSetDependencies(&fakeExternalDatabases{})
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), "database")
+	})
+
+	t.Run("query error is retryable", func(t *testing.T) {
+		task := pipeline.DBQueryTask{BaseTask: pipeline.NewBaseTask(0, "dbquery", nil, nil, 0), Database: "warehouse", Query: "SELECT 1"}
+		task.HelperSetDependencies(&fakeExternalDatabases{err: errors.New("connection refused")})
+
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.EqualError(t, result.Error, "failed to query database warehouse: connection refused")
+		assert.True(t, runInfo.IsRetryable)
+	})
+}
@@ -0,0 +1,121 @@
+package pipeline
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// ErrWithinDeviationThreshold is the error of the deviation tasks whose value
+// did not deviate enough from the last value
+var ErrWithinDeviationThreshold = errors.New("within deviation threshold")
+
+// LastValue is the value of a deviation task in the last successful run of
+// its spec
+type LastValue struct {
+	Value     decimal.Decimal
+	UpdatedAt time.Time
+}
+
+// DeviationTask compares its input to the last value of the task, the value
+// of the last successful run of the job, and fails when it is within the
+// thresholds. Deviation tasks are failEarly by default, so that the rest of
+// the run is skipped and the run is not stored, like flux monitor jobs only
+// submit the answers which deviate from the last one.
+//
+// Like the flux monitor, the value must deviate by more than
+// absoluteThreshold, and by at least threshold percent of the last value.
+// Both default to 0, so that any change deviates. The value always deviates
+// if there is no last value yet, or if the last value is older than
+// heartbeat.
+//
+// Return types:
+//
+//	decimal.Decimal (the value, stored as the last value if the run succeeds)
+type DeviationTask struct {
+	BaseTask          `mapstructure:",squash"`
+	Input             string `json:"input"`
+	Threshold         string `json:"threshold"`
+	AbsoluteThreshold string `json:"absoluteThreshold"`
+	Heartbeat         string `json:"heartbeat"`
+
+	orm    ORM
+	specID int32
+}
+
+var _ Task = (*DeviationTask)(nil)
+
+func (t *DeviationTask) Type() TaskType {
+	return TaskTypeDeviation
+}
+
+func (t *DeviationTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		value             DecimalParam
+		threshold         DecimalParam
+		absoluteThreshold DecimalParam
+		heartbeat         StringParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&value, From(VarExpr(t.Input, vars), NonemptyString(t.Input), Input(inputs, 0))), "input"),
+		errors.Wrap(ResolveParam(&threshold, From(VarExpr(t.Threshold, vars), NonemptyString(t.Threshold), 0)), "threshold"),
+		errors.Wrap(ResolveParam(&absoluteThreshold, From(VarExpr(t.AbsoluteThreshold, vars), NonemptyString(t.AbsoluteThreshold), 0)), "absoluteThreshold"),
+		errors.Wrap(ResolveParam(&heartbeat, From(NonemptyString(t.Heartbeat), "0s")), "heartbeat"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	heartbeatDuration, err := time.ParseDuration(heartbeat.String())
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "heartbeat: %v", err)}, runInfo
+	}
+
+	// Runs which are not stored, like the simulated ones, have no last value
+	if t.orm == nil || t.specID == 0 {
+		return Result{Value: value.Decimal()}, runInfo
+	}
+	last, err := t.orm.FindLastValue(t.specID, t.DotID(), pg.WithParentCtx(ctx))
+	if errors.Is(err, sql.ErrNoRows) {
+		return Result{Value: value.Decimal()}, runInfo
+	} else if err != nil {
+		return Result{Error: errors.Wrap(err, "failed to load the last value")}, retryableRunInfo()
+	}
+
+	if heartbeatDuration > 0 && time.Since(last.UpdatedAt) >= heartbeatDuration {
+		lggr.Debugw("Deviation task heartbeat", "value", value.Decimal(), "lastValue", last.Value, "lastUpdatedAt", last.UpdatedAt)
+		return Result{Value: value.Decimal()}, runInfo
+	}
+	if !outsideDeviation(last.Value, value.Decimal(), threshold.Decimal(), absoluteThreshold.Decimal()) {
+		return Result{Error: errors.Wrapf(ErrWithinDeviationThreshold, "value %s, last value %s", value.Decimal(), last.Value)}, runInfo
+	}
+	return Result{Value: value.Decimal()}, runInfo
+}
+
+// outsideDeviation returns true if next deviates from last by more than
+// absoluteThreshold and by at least threshold percent of last
+func outsideDeviation(last, next, threshold, absoluteThreshold decimal.Decimal) bool {
+	diff := next.Sub(last).Abs()
+	if threshold.IsZero() && absoluteThreshold.IsZero() {
+		return !diff.IsZero()
+	}
+	if !diff.GreaterThan(absoluteThreshold) {
+		return false
+	}
+	if last.IsZero() {
+		return !next.IsZero()
+	}
+	percentage := diff.Div(last.Abs()).Mul(decimal.NewFromInt(100))
+	return percentage.GreaterThanOrEqual(threshold)
+}
@@ -0,0 +1,102 @@
+package pipeline_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+)
+
+func TestDeviationTask(t *testing.T) {
+	t.Parallel()
+
+	const specID = int32(42)
+	last := pipeline.LastValue{Value: decimal.NewFromInt(100), UpdatedAt: time.Now()}
+
+	tests := []struct {
+		name              string
+		input             string
+		threshold         string
+		absoluteThreshold string
+		heartbeat         string
+		last              pipeline.LastValue
+		lastErr           error
+		deviates          bool
+	}{
+		{"no last value", "100", "1", "", "", pipeline.LastValue{}, sql.ErrNoRows, true},
+		{"unchanged without thresholds", "100", "", "", "", last, nil, false},
+		{"changed without thresholds", "100.01", "", "", "", last, nil, true},
+		{"within threshold", "100.9", "1", "", "", last, nil, false},
+		{"at threshold", "99", "1", "", "", last, nil, true},
+		{"within absolute threshold", "102", "1", "2", "", last, nil, false},
+		{"outside both thresholds", "102.5", "1", "2", "", last, nil, true},
+		{"within threshold before heartbeat", "100", "1", "", "1h", last, nil, false},
+		{"heartbeat", "100", "1", "", "1h", pipeline.LastValue{Value: decimal.NewFromInt(100), UpdatedAt: time.Now().Add(-2 * time.Hour)}, nil, true},
+		{"zero last value", "1", "1", "", "", pipeline.LastValue{Value: decimal.Zero, UpdatedAt: time.Now()}, nil, true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			orm := mocks.NewORM(t)
+			orm.On("FindLastValue", specID, "deviation", mock.Anything).Return(test.last, test.lastErr)
+
+			task := pipeline.DeviationTask{
+				BaseTask:          pipeline.NewBaseTask(0, "deviation", nil, nil, 0),
+				Threshold:         test.threshold,
+				AbsoluteThreshold: test.absoluteThreshold,
+				Heartbeat:         test.heartbeat,
+			}
+			task.HelperSetDependencies(orm, specID)
+
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: test.input}})
+			assert.False(t, runInfo.IsRetryable)
+			if test.deviates {
+				require.NoError(t, result.Error)
+				assert.Equal(t, decimal.RequireFromString(test.input).String(), result.Value.(decimal.Decimal).String())
+			} else {
+				require.ErrorIs(t, result.Error, pipeline.ErrWithinDeviationThreshold)
+			}
+		})
+	}
+
+	t.Run("failEarly by default", func(t *testing.T) {
+		p, err := pipeline.Parse(`
+			deviation [type=deviation threshold=1];
+			deviation_no_fail_early [type=deviation threshold=1 failEarly=false];
+		`)
+		require.NoError(t, err)
+		assert.True(t, p.ByDotID("deviation").Base().FailEarly)
+		assert.False(t, p.ByDotID("deviation_no_fail_early").Base().FailEarly)
+	})
+
+	t.Run("database error is retryable", func(t *testing.T) {
+		orm := mocks.NewORM(t)
+		orm.On("FindLastValue", specID, "deviation", mock.Anything).Return(pipeline.LastValue{}, errors.New("connection refused"))
+
+		task := pipeline.DeviationTask{BaseTask: pipeline.NewBaseTask(0, "deviation", nil, nil, 0), Input: "100"}
+		task.HelperSetDependencies(orm, specID)
+
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.EqualError(t, result.Error, "failed to load the last value: connection refused")
+		assert.True(t, runInfo.IsRetryable)
+	})
+
+	t.Run("without ORM", func(t *testing.T) {
+		task := pipeline.DeviationTask{BaseTask: pipeline.NewBaseTask(0, "deviation", nil, nil, 0), Input: "100"}
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		assert.Equal(t, "100", result.Value.(decimal.Decimal).String())
+	})
+}
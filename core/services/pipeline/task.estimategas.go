@@ -71,28 +71,39 @@ func (t *EstimateGasLimitTask) Run(ctx context.Context, lggr logger.Logger, vars
 	}
 	maximumGasLimit := SelectGasLimit(chain.Config(), t.jobType, t.specGasLimit)
 	to := common.Address(toAddr)
-	gasLimit, err := chain.Client().EstimateGas(ctx, ethereum.CallMsg{
+	gasLimit, err := estimateGasLimit(ctx, lggr, chain.Client(), ethereum.CallMsg{
 		From: common.Address(fromAddr),
 		To:   &to,
 		Data: data,
-	})
+	}, multiplier.Decimal(), maximumGasLimit)
+	if err != nil {
+		return Result{Error: err}, retryableRunInfo()
+	}
+	return Result{Value: gasLimit}, runInfo
+}
+
+// estimateGasLimit estimates the gas limit of a call against the pending state
+// and applies the multiplier. It falls back to maximumGasLimit if the call
+// cannot be estimated, and never returns more than maximumGasLimit.
+func estimateGasLimit(ctx context.Context, lggr logger.Logger, estimator GasEstimator, call ethereum.CallMsg, multiplier decimal.Decimal, maximumGasLimit uint32) (uint32, error) {
+	gasLimit, err := estimator.EstimateGas(ctx, call)
 	if err != nil {
 		// Fallback to the maximum conceivable gas limit
 		// if we're unable to call estimate gas for whatever reason.
 		lggr.Warnw("EstimateGas: unable to estimate, fallback to configured limit", "err", err, "fallback", maximumGasLimit)
-		return Result{Value: maximumGasLimit}, runInfo
+		return maximumGasLimit, nil
 	}
 	gasLimitDecimal, err := decimal.NewFromString(strconv.FormatUint(gasLimit, 10))
 	if err != nil {
-		return Result{Error: err}, retryableRunInfo()
+		return 0, err
 	}
-	newExp := int64(gasLimitDecimal.Exponent()) + int64(multiplier.Decimal().Exponent())
+	newExp := int64(gasLimitDecimal.Exponent()) + int64(multiplier.Exponent())
 	if newExp > math.MaxInt32 || newExp < math.MinInt32 {
-		return Result{Error: ErrMultiplyOverlow}, retryableRunInfo()
+		return 0, ErrMultiplyOverlow
 	}
-	gasLimitWithMultiplier := gasLimitDecimal.Mul(multiplier.Decimal()).Truncate(0).BigInt()
+	gasLimitWithMultiplier := gasLimitDecimal.Mul(multiplier).Truncate(0).BigInt()
 	if !gasLimitWithMultiplier.IsUint64() {
-		return Result{Error: ErrInvalidMultiplier}, retryableRunInfo()
+		return 0, ErrInvalidMultiplier
 	}
 	gasLimitFinal := uint32(gasLimitWithMultiplier.Uint64())
 	if gasLimitFinal > maximumGasLimit {
@@ -102,5 +113,5 @@ func (t *EstimateGasLimitTask) Run(ctx context.Context, lggr logger.Logger, vars
 		)
 		gasLimitFinal = maximumGasLimit
 	}
-	return Result{Value: gasLimitFinal}, runInfo
+	return gasLimitFinal, nil
 }
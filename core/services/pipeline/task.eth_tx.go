@@ -6,9 +6,11 @@ import (
 	"reflect"
 	"strconv"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 	"go.uber.org/multierr"
 	"gopkg.in/guregu/null.v4"
 
@@ -22,6 +24,7 @@ import (
 //
 // Return types:
 //     nil
+//     map[string]interface{} (the receipt of the transaction, once it has minConfirmations)
 //
 type ETHTxTask struct {
 	BaseTask         `mapstructure:",squash"`
@@ -37,12 +40,24 @@ type ETHTxTask struct {
 	FailOnRevert    string `json:"failOnRevert"`
 	EVMChainID      string `json:"evmChainID" mapstructure:"evmChainID"`
 	TransmitChecker string `json:"transmitChecker"`
+	// GasPricePercentile, if set, overrides the gas price percentile of the
+	// block history estimator for this transaction
+	// It has no effect with other gas estimators
+	GasPricePercentile string `json:"gasPricePercentile"`
+	// EstimateGasLimit, if set, estimates the gas limit of the transaction
+	// against the pending state and multiplies it by EstimateGasLimitMultiplier
+	// It falls back to gasLimit if the estimation fails, and never exceeds it
+	EstimateGasLimit           string `json:"estimateGasLimit"`
+	EstimateGasLimitMultiplier string `json:"estimateGasLimitMultiplier"`
 
 	forwardingAllowed bool
 	specGasLimit      *uint32
 	keyStore          ETHKeyStore
 	chainSet          evm.ChainSet
 	jobType           string
+	jobID             int32
+	pipelineRunID     int64
+	budgets           Budgets
 }
 
 //go:generate mockery --name ETHKeyStore --output ./mocks/ --case=underscore
@@ -51,13 +66,22 @@ type ETHKeyStore interface {
 	GetRoundRobinAddress(chainID *big.Int, addrs ...common.Address) (common.Address, error)
 }
 
+// Budgets tells which jobs exceeded their monthly budget
+type Budgets interface {
+	Exceeded(jobID int32) bool
+}
+
+// ErrJobBudgetExceeded is returned by the ethtx tasks, and the transmitters,
+// of the jobs which exceeded their monthly budget
+var ErrJobBudgetExceeded = errors.New("job exceeded its monthly budget")
+
 var _ Task = (*ETHTxTask)(nil)
 
 func (t *ETHTxTask) Type() TaskType {
 	return TaskTypeETHTx
 }
 
-func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
 	var chainID StringParam
 	err := errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.EVMChainID, vars), NonemptyString(t.EVMChainID), "")), "evmChainID")
 	if err != nil {
@@ -74,6 +98,9 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 	if err != nil {
 		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
 	}
+	if t.budgets != nil && t.budgets.Exceeded(t.jobID) {
+		return Result{Error: errors.Wrapf(ErrJobBudgetExceeded, "job %d", t.jobID)}, runInfo
+	}
 
 	maximumGasLimit := SelectGasLimit(cfg, t.jobType, t.specGasLimit)
 
@@ -86,6 +113,9 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		maybeMinConfirmations MaybeUint64Param
 		transmitCheckerMap    MapParam
 		failOnRevert          BoolParam
+		gasPricePercentile    MaybeUint64Param
+		estimateGas           BoolParam
+		estimateMultiplier    DecimalParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&fromAddrs, From(VarExpr(t.From, vars), JSONWithVarExprs(t.From, vars, false), NonemptyString(t.From), nil)), "from"),
@@ -96,6 +126,10 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		errors.Wrap(ResolveParam(&maybeMinConfirmations, From(t.MinConfirmations)), "minConfirmations"),
 		errors.Wrap(ResolveParam(&transmitCheckerMap, From(VarExpr(t.TransmitChecker, vars), JSONWithVarExprs(t.TransmitChecker, vars, false), MapParam{})), "transmitChecker"),
 		errors.Wrap(ResolveParam(&failOnRevert, From(NonemptyString(t.FailOnRevert), false)), "failOnRevert"),
+		errors.Wrap(ResolveParam(&gasPricePercentile, From(VarExpr(t.GasPricePercentile, vars), t.GasPricePercentile)), "gasPricePercentile"),
+		errors.Wrap(ResolveParam(&estimateGas, From(NonemptyString(t.EstimateGasLimit), false)), "estimateGasLimit"),
+		// Default to 1, i.e. exactly what estimateGas suggests
+		errors.Wrap(ResolveParam(&estimateMultiplier, From(VarExpr(t.EstimateGasLimitMultiplier, vars), NonemptyString(t.EstimateGasLimitMultiplier), decimal.New(1, 0))), "estimateGasLimitMultiplier"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -112,7 +146,15 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		return Result{Error: err}, runInfo
 	}
 	txMeta.FailOnRevert = null.BoolFrom(bool(failOnRevert))
+	if percentile, isSet := gasPricePercentile.Uint64(); isSet {
+		if percentile > 100 {
+			return Result{Error: errors.Wrapf(ErrBadInput, "gasPricePercentile: must be between 0 and 100, got %d", percentile)}, runInfo
+		}
+		p := uint16(percentile)
+		txMeta.GasPricePercentile = &p
+	}
 	setJobIDOnMeta(lggr, vars, txMeta)
+	t.setRunOnMeta(txMeta)
 
 	transmitChecker, err := decodeTransmitChecker(transmitCheckerMap)
 	if err != nil {
@@ -126,6 +168,19 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while querying keystore: %v", err)}, retryableRunInfo()
 	}
 
+	if estimateGas {
+		to := common.Address(toAddr)
+		estimate, err := estimateGasLimit(ctx, lggr, chain.Client(), ethereum.CallMsg{
+			From: fromAddr,
+			To:   &to,
+			Data: data,
+		}, estimateMultiplier.Decimal(), uint32(gasLimit))
+		if err != nil {
+			return Result{Error: errors.Wrap(err, "estimateGasLimit")}, retryableRunInfo()
+		}
+		gasLimit = Uint64Param(estimate)
+	}
+
 	// NOTE: This can be easily adjusted later to allow job specs to specify the details of which strategy they would like
 	strategy := txmgr.NewSendEveryStrategy()
 
@@ -138,6 +193,8 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		Forwardable:    t.forwardingAllowed,
 		Strategy:       strategy,
 		Checker:        transmitChecker,
+		// VRF fulfillments must be sent even if the database is degraded
+		Priority: t.jobType == VRFJobType,
 	}
 
 	if minOutgoingConfirmations > 0 {
@@ -147,7 +204,10 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 	}
 
 	_, err = txManager.CreateEthTransaction(newTx)
-	if err != nil {
+	if errors.Is(err, txmgr.ErrHeldInRetryBuffer) {
+		// The transaction is inserted once the database recovers
+		lggr.Warnw("Database unavailable, transaction held until it recovers", "err", err)
+	} else if err != nil {
 		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while creating transaction: %v", err)}, retryableRunInfo()
 	}
 
@@ -223,6 +283,17 @@ func decodeTransmitChecker(checkerMap MapParam) (txmgr.TransmitCheckerSpec, erro
 	return transmitChecker, nil
 }
 
+// setRunOnMeta records the pipeline run and task that created the tx, so that
+// it can be traced back to them
+func (t *ETHTxTask) setRunOnMeta(meta *txmgr.EthTxMeta) {
+	taskName := t.DotID()
+	meta.TaskName = &taskName
+	if t.pipelineRunID != 0 {
+		runID := t.pipelineRunID
+		meta.RunID = &runID
+	}
+}
+
 // txMeta is really only used for logging, so this is best-effort
 func setJobIDOnMeta(lggr logger.Logger, vars Vars, meta *txmgr.EthTxMeta) {
 	jobID, err := vars.Get("jobSpec.databaseID")
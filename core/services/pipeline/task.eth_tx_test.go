@@ -3,6 +3,7 @@ package pipeline_test
 import (
 	"testing"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -10,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	txmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr/mocks"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
@@ -26,6 +28,7 @@ func TestETHTxTask(t *testing.T) {
 	jid := int32(321)
 	reqID := common.HexToHash("0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2")
 	reqTxHash := common.HexToHash("0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8")
+	taskName := "ethtx"
 	specGasLimit := uint32(123)
 	const defaultGasLimit int64 = 999
 	const drJobTypeGasLimit uint32 = 789
@@ -73,6 +76,7 @@ func TestETHTxTask(t *testing.T) {
 				jobID := int32(321)
 				addr := common.HexToAddress("0x2E396ecbc8223Ebc16EC45136228AE5EDB649943")
 				txMeta := &txmgr.EthTxMeta{
+					TaskName:      &taskName,
 					JobID:         &jobID,
 					RequestID:     &reqID,
 					RequestTxHash: &reqTxHash,
@@ -123,6 +127,7 @@ func TestETHTxTask(t *testing.T) {
 				data := []byte("foobar")
 				gasLimit := uint32(12345)
 				txMeta := &txmgr.EthTxMeta{
+					TaskName:      &taskName,
 					JobID:         &jid,
 					RequestID:     &reqID,
 					RequestTxHash: &reqTxHash,
@@ -171,6 +176,7 @@ func TestETHTxTask(t *testing.T) {
 				data := []byte("foobar")
 				gasLimit := uint32(12345)
 				txMeta := &txmgr.EthTxMeta{
+					TaskName:      &taskName,
 					JobID:         &jid,
 					RequestID:     &reqID,
 					RequestTxHash: &reqTxHash,
@@ -219,6 +225,7 @@ func TestETHTxTask(t *testing.T) {
 				data := []byte("foobar")
 				gasLimit := uint32(12345)
 				txMeta := &txmgr.EthTxMeta{
+					TaskName:      &taskName,
 					JobID:         &jid,
 					RequestID:     &reqID,
 					RequestTxHash: &reqTxHash,
@@ -256,7 +263,7 @@ func TestETHTxTask(t *testing.T) {
 				to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
 				data := []byte("foobar")
 				gasLimit := uint32(12345)
-				txMeta := &txmgr.EthTxMeta{FailOnRevert: null.BoolFrom(false)}
+				txMeta := &txmgr.EthTxMeta{FailOnRevert: null.BoolFrom(false), TaskName: &taskName}
 				keyStore.On("GetRoundRobinAddress", testutils.FixtureChainID, from).Return(from, nil)
 				txManager.On("CreateEthTransaction", txmgr.NewTx{
 					FromAddress:    from,
@@ -289,6 +296,7 @@ func TestETHTxTask(t *testing.T) {
 				to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
 				data := []byte("foobar")
 				txMeta := &txmgr.EthTxMeta{
+					TaskName:      &taskName,
 					JobID:         &jid,
 					RequestID:     &reqID,
 					RequestTxHash: &reqTxHash,
@@ -326,6 +334,7 @@ func TestETHTxTask(t *testing.T) {
 				to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
 				data := []byte("foobar")
 				txMeta := &txmgr.EthTxMeta{
+					TaskName:      &taskName,
 					JobID:         &jid,
 					RequestID:     &reqID,
 					RequestTxHash: &reqTxHash,
@@ -394,6 +403,7 @@ func TestETHTxTask(t *testing.T) {
 				data := []byte("foobar")
 				gasLimit := uint32(12345)
 				txMeta := &txmgr.EthTxMeta{
+					TaskName:      &taskName,
 					JobID:         &jid,
 					RequestID:     &reqID,
 					RequestTxHash: &reqTxHash,
@@ -586,3 +596,192 @@ func TestETHTxTask(t *testing.T) {
 		})
 	}
 }
+
+func TestETHTxTask_GasPricePercentile(t *testing.T) {
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+
+	newTask := func(t *testing.T, gasPricePercentile string, setupTxManager func(txManager *txmmocks.TxManager)) pipeline.ETHTxTask {
+		task := pipeline.ETHTxTask{
+			BaseTask:           pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+			From:               `[ "0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c" ]`,
+			To:                 "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
+			Data:               "foobar",
+			MinConfirmations:   "0",
+			GasPricePercentile: gasPricePercentile,
+		}
+
+		keyStore := keystoremocks.NewEth(t)
+		keyStore.On("GetRoundRobinAddress", testutils.FixtureChainID, from).Return(from, nil).Maybe()
+		txManager := txmmocks.NewTxManager(t)
+		setupTxManager(txManager)
+		db := pgtest.NewSqlxDB(t)
+		cfg := configtest.NewTestGeneralConfig(t)
+
+		cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, TxManager: txManager, KeyStore: keyStore})
+		task.HelperSetDependencies(cc, keyStore, nil, pipeline.DirectRequestJobType)
+		return task
+	}
+
+	t.Run("sets the percentile on the tx meta", func(t *testing.T) {
+		task := newTask(t, "$(percentile)", func(txManager *txmmocks.TxManager) {
+			txManager.On("CreateEthTransaction", mock.MatchedBy(func(tx txmgr.NewTx) bool {
+				return tx.Meta.GasPricePercentile != nil && *tx.Meta.GasPricePercentile == 80
+			})).Return(txmgr.EthTx{}, nil)
+		})
+
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"percentile": 80})
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.NoError(t, result.Error)
+	})
+
+	t.Run("leaves the percentile unset by default", func(t *testing.T) {
+		task := newTask(t, "", func(txManager *txmmocks.TxManager) {
+			txManager.On("CreateEthTransaction", mock.MatchedBy(func(tx txmgr.NewTx) bool {
+				return tx.Meta.GasPricePercentile == nil
+			})).Return(txmgr.EthTx{}, nil)
+		})
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+	})
+
+	t.Run("errors if the percentile is greater than 100", func(t *testing.T) {
+		task := newTask(t, "101", func(txManager *txmmocks.TxManager) {})
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.Error(t, result.Error)
+		assert.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+		assert.Contains(t, result.Error.Error(), "gasPricePercentile")
+	})
+}
+
+func TestETHTxTask_EstimateGasLimit(t *testing.T) {
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+
+	newTask := func(t *testing.T, ethClient *evmmocks.Client, expectedGasLimit uint32) pipeline.ETHTxTask {
+		task := pipeline.ETHTxTask{
+			BaseTask:                   pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+			From:                       `[ "0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c" ]`,
+			To:                         "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
+			Data:                       "foobar",
+			GasLimit:                   "100000",
+			MinConfirmations:           "0",
+			EstimateGasLimit:           "true",
+			EstimateGasLimitMultiplier: "1.5",
+		}
+
+		keyStore := keystoremocks.NewEth(t)
+		keyStore.On("GetRoundRobinAddress", testutils.FixtureChainID, from).Return(from, nil)
+		txManager := txmmocks.NewTxManager(t)
+		txManager.On("CreateEthTransaction", mock.MatchedBy(func(tx txmgr.NewTx) bool {
+			return tx.GasLimit == expectedGasLimit
+		})).Return(txmgr.EthTx{}, nil)
+		db := pgtest.NewSqlxDB(t)
+		cfg := configtest.NewTestGeneralConfig(t)
+
+		cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, TxManager: txManager, KeyStore: keyStore, Client: ethClient})
+		task.HelperSetDependencies(cc, keyStore, nil, pipeline.DirectRequestJobType)
+		return task
+	}
+
+	t.Run("applies the multiplier to the estimate", func(t *testing.T) {
+		ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("EstimateGas", mock.Anything, mock.MatchedBy(func(call ethereum.CallMsg) bool {
+			return call.From == from
+		})).Return(uint64(50000), nil)
+		task := newTask(t, ethClient, 75000)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+	})
+
+	t.Run("does not exceed the gas limit", func(t *testing.T) {
+		ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("EstimateGas", mock.Anything, mock.Anything).Return(uint64(90000), nil)
+		task := newTask(t, ethClient, 100000)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+	})
+
+	t.Run("falls back to the gas limit if the estimation fails", func(t *testing.T) {
+		ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("EstimateGas", mock.Anything, mock.Anything).Return(uint64(0), errors.New("execution reverted"))
+		task := newTask(t, ethClient, 100000)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+	})
+}
+
+type exceededBudgets map[int32]bool
+
+func (b exceededBudgets) Exceeded(jobID int32) bool { return b[jobID] }
+
+func TestETHTxTask_Budget(t *testing.T) {
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+
+	newTask := func(t *testing.T, jobID int32, txManager *txmmocks.TxManager) pipeline.ETHTxTask {
+		task := pipeline.ETHTxTask{
+			BaseTask:         pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+			From:             `[ "0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c" ]`,
+			To:               "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
+			Data:             "foobar",
+			MinConfirmations: "0",
+		}
+
+		keyStore := keystoremocks.NewEth(t)
+		keyStore.On("GetRoundRobinAddress", testutils.FixtureChainID, from).Return(from, nil).Maybe()
+		db := pgtest.NewSqlxDB(t)
+		cfg := configtest.NewTestGeneralConfig(t)
+
+		cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, TxManager: txManager, KeyStore: keyStore})
+		task.HelperSetDependencies(cc, keyStore, nil, pipeline.DirectRequestJobType)
+		task.HelperSetBudgets(exceededBudgets{42: true}, jobID)
+		return task
+	}
+
+	t.Run("sends the transactions of the jobs within budget", func(t *testing.T) {
+		txManager := txmmocks.NewTxManager(t)
+		txManager.On("CreateEthTransaction", mock.Anything).Return(txmgr.EthTx{}, nil)
+		task := newTask(t, 1, txManager)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+	})
+
+	t.Run("errors for the jobs over budget", func(t *testing.T) {
+		task := newTask(t, 42, txmmocks.NewTxManager(t))
+
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.Error(t, result.Error)
+		assert.Equal(t, pipeline.ErrJobBudgetExceeded, errors.Cause(result.Error))
+		assert.False(t, runInfo.IsRetryable)
+	})
+}
+
+func TestETHTxTask_RetryBuffer(t *testing.T) {
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	task := pipeline.ETHTxTask{
+		BaseTask:         pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+		From:             `[ "0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c" ]`,
+		To:               "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
+		Data:             "foobar",
+		MinConfirmations: "0",
+	}
+
+	keyStore := keystoremocks.NewEth(t)
+	keyStore.On("GetRoundRobinAddress", testutils.FixtureChainID, from).Return(from, nil)
+	txManager := txmmocks.NewTxManager(t)
+	txManager.On("CreateEthTransaction", mock.MatchedBy(func(newTx txmgr.NewTx) bool {
+		return newTx.Priority
+	})).Return(txmgr.EthTx{}, txmgr.ErrHeldInRetryBuffer)
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, TxManager: txManager, KeyStore: keyStore})
+	task.HelperSetDependencies(cc, keyStore, nil, pipeline.VRFJobType)
+
+	// the fulfillment is sent once the database recovers
+	result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, result.Error)
+}
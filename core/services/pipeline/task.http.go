@@ -25,6 +25,7 @@ type HTTPTask struct {
 	RequestData                    string `json:"requestData"`
 	AllowUnrestrictedNetworkAccess string
 	Headers                        string
+	ResponseSchema                 string `json:"responseSchema"`
 
 	config                 Config
 	httpClient             *http.Client
@@ -82,6 +83,10 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	if len(reqHeaders)%2 != 0 {
 		return Result{Error: errors.Errorf("headers must have an even number of elements")}, runInfo
 	}
+	schema, err := ParseResponseSchema(t.ResponseSchema)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "responseSchema")}, runInfo
+	}
 
 	requestDataJSON, err := json.Marshal(requestData)
 	if err != nil {
@@ -122,6 +127,12 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	promHTTPFetchTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
 	promHTTPResponseBodySize.WithLabelValues(t.DotID()).Set(float64(len(responseBytes)))
 
+	if schema != nil {
+		if err = schema.Validate(responseBytes); err != nil {
+			return Result{Error: err}, runInfo
+		}
+	}
+
 	// NOTE: We always stringify the response since this is required for all current jobs.
 	// If a binary response is required we might consider adding an adapter
 	// flag such as  "BinaryMode: true" which passes through raw binary as the
@@ -404,3 +404,48 @@ func TestHTTPTask_Headers(t *testing.T) {
 		assert.Equal(t, []string{"Content-Length", "38", "Content-Type", "footype", "User-Agent", "Go-http-client/1.1", "X-Header-1", "foo", "X-Header-2", "bar"}, allHeaders(headers))
 	})
 }
+
+func TestHTTPTask_ResponseSchema(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestGeneralConfig(t)
+	s1 := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"result":"9700"}}`))
+	}))
+	defer s1.Close()
+
+	tests := []struct {
+		name   string
+		schema string
+		err    string
+	}{
+		{"no schema", "", ""},
+		{"valid", `{"type":"object","properties":{"data":{"required":["result"],"properties":{"result":{"type":["number","string"]}}}}}`, ""},
+		{"wrong type", `{"properties":{"data":{"properties":{"result":{"type":"number"}}}}}`, "schema violation at $.data.result: expected number, got string"},
+		{"missing property", `{"properties":{"data":{"required":["timestamp"]}}}`, "schema violation at $.data.timestamp: required property is missing"},
+		{"invalid schema", `{"type":"decimal"}`, `responseSchema: invalid response schema: $: unknown type "decimal"`},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			task := pipeline.HTTPTask{
+				BaseTask:       pipeline.NewBaseTask(0, "http", nil, nil, 0),
+				Method:         "GET",
+				URL:            s1.URL,
+				ResponseSchema: tt.schema,
+			}
+			c := clhttptest.NewTestLocalOnlyHTTPClient()
+			task.HelperSetDependencies(config, c, c)
+
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			assert.False(t, runInfo.IsRetryable)
+			if tt.err == "" {
+				require.NoError(t, result.Error)
+				assert.Equal(t, `{"data":{"result":"9700"}}`, result.Value)
+			} else {
+				require.EqualError(t, result.Error, tt.err)
+			}
+		})
+	}
+}
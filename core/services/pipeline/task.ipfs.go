@@ -0,0 +1,79 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// IPFS fetches and pins the content of the ipfs tasks
+type IPFS interface {
+	// Cat returns the content at ipfsPath, like <CID>/dir/file, failing if it
+	// is larger than maxSize bytes
+	Cat(ctx context.Context, ipfsPath string, maxSize int64) ([]byte, error)
+	// Pin pins the root CID of ipfsPath
+	Pin(ctx context.Context, ipfsPath string) error
+}
+
+// Return types:
+//
+//	string
+type IPFSTask struct {
+	BaseTask `mapstructure:",squash"`
+	CID      string `json:"cid"`
+	Pin      string `json:"pin"`
+	MaxSize  string `json:"maxSize"`
+
+	config Config
+	ipfs   IPFS
+}
+
+var _ Task = (*IPFSTask)(nil)
+
+func (t *IPFSTask) Type() TaskType {
+	return TaskTypeIPFS
+}
+
+func (t *IPFSTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		ipfsPath StringParam
+		pin      BoolParam
+		maxSize  Uint64Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&ipfsPath, From(VarExpr(t.CID, vars), NonemptyString(t.CID), Input(inputs, 0))), "cid"),
+		errors.Wrap(ResolveParam(&pin, From(NonemptyString(t.Pin), false)), "pin"),
+		errors.Wrap(ResolveParam(&maxSize, From(NonemptyString(t.MaxSize), t.config.DefaultHTTPLimit())), "maxSize"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if t.ipfs == nil {
+		return Result{Error: errors.New("no IPFS node or gateway, JOB_PIPELINE_IPFS_NODE_URL or JOB_PIPELINE_IPFS_GATEWAY_URL must be set")}, runInfo
+	}
+
+	requestCtx, cancel := httpRequestCtx(ctx, t, t.config)
+	defer cancel()
+
+	content, err := t.ipfs.Cat(requestCtx, string(ipfsPath), int64(maxSize))
+	if err != nil {
+		return Result{Error: err}, retryableRunInfo()
+	}
+	if pin {
+		if err = t.ipfs.Pin(requestCtx, string(ipfsPath)); err != nil {
+			return Result{Error: err}, retryableRunInfo()
+		}
+	}
+	lggr.Debugw("IPFS task fetched content", "path", ipfsPath, "size", len(content), "pinned", pin)
+
+	return Result{Value: string(content)}, runInfo
+}
@@ -0,0 +1,89 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+const testCID = "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"
+
+type fakeIPFS struct {
+	content []byte
+	catErr  error
+	pinErr  error
+	maxSize int64
+	pinned  []string
+}
+
+func (f *fakeIPFS) Cat(_ context.Context, _ string, maxSize int64) ([]byte, error) {
+	f.maxSize = maxSize
+	return f.content, f.catErr
+}
+
+func (f *fakeIPFS) Pin(_ context.Context, ipfsPath string) error {
+	f.pinned = append(f.pinned, ipfsPath)
+	return f.pinErr
+}
+
+func TestIPFSTask(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestGeneralConfig(t)
+
+	t.Run("fetches the content", func(t *testing.T) {
+		ipfs := &fakeIPFS{content: []byte(`{"proof": "0x1234"}`)}
+		task := pipeline.IPFSTask{BaseTask: pipeline.NewBaseTask(0, "ipfs", nil, nil, 0), CID: "$(cid)"}
+		task.HelperSetDependencies(config, ipfs)
+
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"cid": testCID})
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.NoError(t, result.Error)
+		assert.False(t, runInfo.IsRetryable)
+		assert.Equal(t, `{"proof": "0x1234"}`, result.Value)
+		assert.Equal(t, config.DefaultHTTPLimit(), ipfs.maxSize)
+		assert.Empty(t, ipfs.pinned)
+	})
+
+	t.Run("fetches the input and pins it", func(t *testing.T) {
+		ipfs := &fakeIPFS{content: []byte("document")}
+		task := pipeline.IPFSTask{BaseTask: pipeline.NewBaseTask(0, "ipfs", nil, nil, 0), Pin: "true", MaxSize: "1024"}
+		task.HelperSetDependencies(config, ipfs)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: testCID + "/doc.txt"}})
+		require.NoError(t, result.Error)
+		assert.Equal(t, "document", result.Value)
+		assert.Equal(t, int64(1024), ipfs.maxSize)
+		assert.Equal(t, []string{testCID + "/doc.txt"}, ipfs.pinned)
+	})
+
+	t.Run("errors are retryable", func(t *testing.T) {
+		task := pipeline.IPFSTask{BaseTask: pipeline.NewBaseTask(0, "ipfs", nil, nil, 0), CID: testCID, Pin: "true"}
+		task.HelperSetDependencies(config, &fakeIPFS{catErr: errors.New("gateway timeout")})
+
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.EqualError(t, result.Error, "gateway timeout")
+		assert.True(t, runInfo.IsRetryable)
+
+		task.HelperSetDependencies(config, &fakeIPFS{pinErr: errors.New("no IPFS node")})
+		result, runInfo = task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.EqualError(t, result.Error, "no IPFS node")
+		assert.True(t, runInfo.IsRetryable)
+	})
+
+	t.Run("no IPFS node or gateway", func(t *testing.T) {
+		task := pipeline.IPFSTask{BaseTask: pipeline.NewBaseTask(0, "ipfs", nil, nil, 0), CID: testCID}
+		task.HelperSetDependencies(config, nil)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.EqualError(t, result.Error, "no IPFS node or gateway, JOB_PIPELINE_IPFS_NODE_URL or JOB_PIPELINE_IPFS_GATEWAY_URL must be set")
+	})
+}
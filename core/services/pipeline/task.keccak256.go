@@ -0,0 +1,46 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// Keccak256Task hashes its input with keccak256. Hex strings with the 0x
+// prefix are hashed as the bytes they encode, other strings as their UTF-8
+// bytes.
+//
+// Return types:
+//
+//	bytes
+type Keccak256Task struct {
+	BaseTask `mapstructure:",squash"`
+	Input    string `json:"input"`
+}
+
+var _ Task = (*Keccak256Task)(nil)
+
+func (t *Keccak256Task) Type() TaskType {
+	return TaskTypeKeccak256
+}
+
+func (t *Keccak256Task) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var input BytesParam
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&input, From(VarExpr(t.Input, vars), NonemptyString(t.Input), Input(inputs, 0))), "input"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	return Result{Value: crypto.Keccak256(input)}, runInfo
+}
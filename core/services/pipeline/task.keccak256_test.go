@@ -0,0 +1,53 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestKeccak256Task(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		input  interface{}
+		result string
+	}{
+		{"string", "hello", "0x1c8aff950685c2ed4bc3174f3472287b56d9517b9c948127319a09a7a36deac8"},
+		{"hex string", "0x68656c6c6f", "0x1c8aff950685c2ed4bc3174f3472287b56d9517b9c948127319a09a7a36deac8"},
+		{"bytes", []byte("hello"), "0x1c8aff950685c2ed4bc3174f3472287b56d9517b9c948127319a09a7a36deac8"},
+		{"empty bytes", []byte{}, "0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.Keccak256Task{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0)}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: test.input}})
+			assert.False(t, runInfo.IsRetryable)
+			require.NoError(t, result.Error)
+			assert.Equal(t, test.result, hexutil.Encode(result.Value.([]byte)))
+		})
+	}
+
+	t.Run("input param", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"foo": map[string]interface{}{"bar": "hello"}})
+		task := pipeline.Keccak256Task{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Input: "$(foo.bar)"}
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.NoError(t, result.Error)
+		assert.Equal(t, "0x1c8aff950685c2ed4bc3174f3472287b56d9517b9c948127319a09a7a36deac8", hexutil.Encode(result.Value.([]byte)))
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		task := pipeline.Keccak256Task{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0)}
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: 42}})
+		require.ErrorIs(t, result.Error, pipeline.ErrBadInput)
+	})
+}
@@ -0,0 +1,79 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// MerkleProofTask computes the proof of the leaf at index in the Merkle tree
+// of a list of leaves, built like MerkleRootTask does.
+//
+// Return types:
+//
+//	[]interface{} (the sibling hashes, as bytes, from the leaf to the root)
+type MerkleProofTask struct {
+	BaseTask   `mapstructure:",squash"`
+	Leaves     string `json:"leaves"`
+	Index      string `json:"index"`
+	Hash       string `json:"hash"`
+	HashLeaves string `json:"hashLeaves"`
+	SortPairs  string `json:"sortPairs"`
+}
+
+var _ Task = (*MerkleProofTask)(nil)
+
+func (t *MerkleProofTask) Type() TaskType {
+	return TaskTypeMerkleProof
+}
+
+func (t *MerkleProofTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		values     SliceParam
+		index      Uint64Param
+		hashName   StringParam
+		hashLeaves BoolParam
+		sortPairs  BoolParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&values, From(VarExpr(t.Leaves, vars), JSONWithVarExprs(t.Leaves, vars, false), Input(inputs, 0))), "leaves"),
+		errors.Wrap(ResolveParam(&index, From(VarExpr(t.Index, vars), NonemptyString(t.Index))), "index"),
+		errors.Wrap(ResolveParam(&hashName, From(NonemptyString(t.Hash), HashFunctionKeccak256)), "hash"),
+		errors.Wrap(ResolveParam(&hashLeaves, From(NonemptyString(t.HashLeaves), true)), "hashLeaves"),
+		errors.Wrap(ResolveParam(&sortPairs, From(NonemptyString(t.SortPairs), true)), "sortPairs"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	hash, err := hashFunction(hashName.String())
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	leaves, err := merkleLeaves(values, hash, bool(hashLeaves))
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "leaves")}, runInfo
+	}
+	levels, err := merkleTree(leaves, hash, bool(sortPairs))
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	proof, err := merkleProof(levels, int(index))
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	value := make([]interface{}, len(proof))
+	for i, node := range proof {
+		value[i] = node
+	}
+	return Result{Value: value}, runInfo
+}
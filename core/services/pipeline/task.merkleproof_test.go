@@ -0,0 +1,51 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestMerkleProofTask(t *testing.T) {
+	t.Parallel()
+
+	leaves := []interface{}{"a", "b", "c", "d", "e"}
+
+	rootTask := pipeline.MerkleRootTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0)}
+	result, _ := rootTask.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: leaves}})
+	require.NoError(t, result.Error)
+	root := result.Value.([]byte)
+
+	for i, leaf := range leaves {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"index": i})
+		task := pipeline.MerkleProofTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Index: "$(index)"}
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{{Value: leaves}})
+		assert.False(t, runInfo.IsRetryable)
+		require.NoError(t, result.Error)
+
+		// Verify the proof like the MerkleProof library of OpenZeppelin does
+		node := crypto.Keccak256([]byte(leaf.(string)))
+		for _, sibling := range result.Value.([]interface{}) {
+			node = hashSortedPair(node, sibling.([]byte))
+		}
+		assert.Equal(t, root, node, "leaf %d", i)
+	}
+
+	t.Run("index out of range", func(t *testing.T) {
+		task := pipeline.MerkleProofTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Index: "5"}
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: leaves}})
+		require.EqualError(t, result.Error, "leaf index 5 out of range, the tree has 5 leaves: bad input for task")
+	})
+
+	t.Run("index is required", func(t *testing.T) {
+		task := pipeline.MerkleProofTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0)}
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: leaves}})
+		require.ErrorIs(t, result.Error, pipeline.ErrParameterEmpty)
+	})
+}
@@ -0,0 +1,72 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// MerkleRootTask computes the root of the Merkle tree of a list of leaves, so
+// that a contract can verify any of them with a proof from MerkleProofTask.
+//
+// The leaves are bytes or hex strings, hashed with the hash function first
+// unless hashLeaves is false. Pairs are sorted before being hashed unless
+// sortPairs is false, like the MerkleProof library of OpenZeppelin expects.
+//
+// Return types:
+//
+//	bytes
+type MerkleRootTask struct {
+	BaseTask   `mapstructure:",squash"`
+	Leaves     string `json:"leaves"`
+	Hash       string `json:"hash"`
+	HashLeaves string `json:"hashLeaves"`
+	SortPairs  string `json:"sortPairs"`
+}
+
+var _ Task = (*MerkleRootTask)(nil)
+
+func (t *MerkleRootTask) Type() TaskType {
+	return TaskTypeMerkleRoot
+}
+
+func (t *MerkleRootTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		values     SliceParam
+		hashName   StringParam
+		hashLeaves BoolParam
+		sortPairs  BoolParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&values, From(VarExpr(t.Leaves, vars), JSONWithVarExprs(t.Leaves, vars, false), Input(inputs, 0))), "leaves"),
+		errors.Wrap(ResolveParam(&hashName, From(NonemptyString(t.Hash), HashFunctionKeccak256)), "hash"),
+		errors.Wrap(ResolveParam(&hashLeaves, From(NonemptyString(t.HashLeaves), true)), "hashLeaves"),
+		errors.Wrap(ResolveParam(&sortPairs, From(NonemptyString(t.SortPairs), true)), "sortPairs"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	hash, err := hashFunction(hashName.String())
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	leaves, err := merkleLeaves(values, hash, bool(hashLeaves))
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "leaves")}, runInfo
+	}
+	levels, err := merkleTree(leaves, hash, bool(sortPairs))
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	return Result{Value: levels[len(levels)-1][0]}, runInfo
+}
@@ -0,0 +1,83 @@
+package pipeline_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+// hashSortedPair hashes a pair of nodes like the MerkleProof library of
+// OpenZeppelin does
+func hashSortedPair(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	return crypto.Keccak256(a, b)
+}
+
+func TestMerkleRootTask(t *testing.T) {
+	t.Parallel()
+
+	a, b, c := crypto.Keccak256([]byte("a")), crypto.Keccak256([]byte("b")), crypto.Keccak256([]byte("c"))
+
+	t.Run("odd number of leaves", func(t *testing.T) {
+		task := pipeline.MerkleRootTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0)}
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: []interface{}{"a", "b", "c"}}})
+		assert.False(t, runInfo.IsRetryable)
+		require.NoError(t, result.Error)
+		assert.Equal(t, hashSortedPair(hashSortedPair(a, b), c), result.Value)
+	})
+
+	t.Run("single leaf", func(t *testing.T) {
+		task := pipeline.MerkleRootTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0)}
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: []interface{}{"a"}}})
+		require.NoError(t, result.Error)
+		assert.Equal(t, a, result.Value)
+	})
+
+	t.Run("leaves param with variables", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"enc1": a, "enc2": b})
+		task := pipeline.MerkleRootTask{
+			BaseTask:   pipeline.NewBaseTask(0, "task", nil, nil, 0),
+			Leaves:     `[$(enc1), $(enc2)]`,
+			HashLeaves: "false",
+		}
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.NoError(t, result.Error)
+		assert.Equal(t, hashSortedPair(a, b), result.Value)
+	})
+
+	t.Run("sha256 without sorting pairs", func(t *testing.T) {
+		task := pipeline.MerkleRootTask{
+			BaseTask:  pipeline.NewBaseTask(0, "task", nil, nil, 0),
+			Hash:      "sha256",
+			SortPairs: "false",
+		}
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: []interface{}{"b", "a"}}})
+		require.NoError(t, result.Error)
+		b, a := sha256.Sum256([]byte("b")), sha256.Sum256([]byte("a"))
+		root := sha256.Sum256(append(b[:], a[:]...))
+		assert.Equal(t, root[:], result.Value)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		task := pipeline.MerkleRootTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0)}
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: []interface{}{}}})
+		require.ErrorIs(t, result.Error, pipeline.ErrBadInput)
+
+		result, _ = task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: []interface{}{"a", 42}}})
+		require.ErrorContains(t, result.Error, "leaf 1")
+
+		task.Hash = "md5"
+		result, _ = task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: []interface{}{"a"}}})
+		require.EqualError(t, result.Error, `unsupported hash function "md5": bad input for task`)
+	})
+}
@@ -0,0 +1,92 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// Publisher publishes the payloads of the publish tasks to the topics of a
+// message broker
+type Publisher interface {
+	Publish(ctx context.Context, topic string, payload []byte) error
+}
+
+// PublishedResultSchemaVersion is the version of the PublishedResult schema,
+// incremented on breaking changes
+const PublishedResultSchemaVersion = 1
+
+// PublishedResult is the JSON payload of the messages of the publish tasks
+type PublishedResult struct {
+	SchemaVersion int         `json:"schemaVersion"`
+	JobID         int32       `json:"jobID"`
+	JobName       string      `json:"jobName,omitempty"`
+	Topic         string      `json:"topic"`
+	Value         ObjectParam `json:"value"`
+	PublishedAt   time.Time   `json:"publishedAt"`
+}
+
+// Return types:
+//
+//	the value published
+type PublishTask struct {
+	BaseTask `mapstructure:",squash"`
+	Topic    string `json:"topic"`
+	Value    string `json:"value"`
+
+	publisher Publisher
+	jobID     int32
+	jobName   string
+}
+
+var _ Task = (*PublishTask)(nil)
+
+func (t *PublishTask) Type() TaskType {
+	return TaskTypePublish
+}
+
+func (t *PublishTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		topic StringParam
+		value ObjectParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&topic, From(NonemptyString(t.Topic))), "topic"),
+		errors.Wrap(ResolveParam(&value, From(JSONWithVarExprs(t.Value, vars, false), Input(inputs, 0))), "value"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if t.publisher == nil {
+		return Result{Error: errors.New("no message broker to publish to, JOB_PIPELINE_PUBLISH_URL must be set")}, runInfo
+	}
+
+	payload, err := json.Marshal(PublishedResult{
+		SchemaVersion: PublishedResultSchemaVersion,
+		JobID:         t.jobID,
+		JobName:       t.jobName,
+		Topic:         string(topic),
+		Value:         value,
+		PublishedAt:   time.Now().UTC(),
+	})
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "failed to encode the payload")}, runInfo
+	}
+	if err = t.publisher.Publish(ctx, string(topic), payload); err != nil {
+		return Result{Error: errors.Wrapf(err, "failed to publish to topic %s", topic)}, retryableRunInfo()
+	}
+	lggr.Debugw("Published result", "topic", topic)
+
+	return Result{Value: value}, runInfo
+}
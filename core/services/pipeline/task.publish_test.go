@@ -0,0 +1,100 @@
+package pipeline_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+type fakePublisher struct {
+	topic   string
+	payload []byte
+	err     error
+}
+
+func (p *fakePublisher) Publish(_ context.Context, topic string, payload []byte) error {
+	p.topic = topic
+	p.payload = payload
+	return p.err
+}
+
+func TestPublishTask(t *testing.T) {
+	t.Parallel()
+
+	t.Run("publishes the input", func(t *testing.T) {
+		publisher := &fakePublisher{}
+		task := pipeline.PublishTask{BaseTask: pipeline.NewBaseTask(0, "publish", nil, nil, 0), Topic: "eth-usd"}
+		task.HelperSetDependencies(publisher, 42, "ETH/USD feed")
+
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: 1234.5}})
+		require.NoError(t, result.Error)
+		assert.False(t, runInfo.IsRetryable)
+		assert.Equal(t, `"1234.5"`, result.Value.(pipeline.ObjectParam).String())
+
+		assert.Equal(t, "eth-usd", publisher.topic)
+		var published struct {
+			SchemaVersion int             `json:"schemaVersion"`
+			JobID         int32           `json:"jobID"`
+			JobName       string          `json:"jobName"`
+			Topic         string          `json:"topic"`
+			Value         json.RawMessage `json:"value"`
+			PublishedAt   time.Time       `json:"publishedAt"`
+		}
+		require.NoError(t, json.Unmarshal(publisher.payload, &published))
+		assert.Equal(t, pipeline.PublishedResultSchemaVersion, published.SchemaVersion)
+		assert.Equal(t, int32(42), published.JobID)
+		assert.Equal(t, "ETH/USD feed", published.JobName)
+		assert.Equal(t, "eth-usd", published.Topic)
+		assert.Equal(t, `"1234.5"`, string(published.Value))
+		assert.False(t, published.PublishedAt.IsZero())
+	})
+
+	t.Run("publishes the value", func(t *testing.T) {
+		publisher := &fakePublisher{}
+		task := pipeline.PublishTask{BaseTask: pipeline.NewBaseTask(0, "publish", nil, nil, 0), Topic: "eth-usd", Value: `{"price": $(price)}`}
+		task.HelperSetDependencies(publisher, 42, "")
+
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"price": 1234.5})
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.NoError(t, result.Error)
+
+		var published map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(publisher.payload, &published))
+		assert.JSONEq(t, `{"price": 1234.5}`, string(published["value"]))
+		assert.NotContains(t, published, "jobName")
+	})
+
+	t.Run("missing topic", func(t *testing.T) {
+		task := pipeline.PublishTask{BaseTask: pipeline.NewBaseTask(0, "publish", nil, nil, 0)}
+		task.HelperSetDependencies(&fakePublisher{}, 42, "")
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: 1}})
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), "topic")
+	})
+
+	t.Run("no publisher", func(t *testing.T) {
+		task := pipeline.PublishTask{BaseTask: pipeline.NewBaseTask(0, "publish", nil, nil, 0), Topic: "eth-usd"}
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: 1}})
+		require.EqualError(t, result.Error, "no message broker to publish to, JOB_PIPELINE_PUBLISH_URL must be set")
+	})
+
+	t.Run("publish error is retryable", func(t *testing.T) {
+		task := pipeline.PublishTask{BaseTask: pipeline.NewBaseTask(0, "publish", nil, nil, 0), Topic: "eth-usd"}
+		task.HelperSetDependencies(&fakePublisher{err: errors.New("connection refused")}, 42, "")
+
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: 1}})
+		require.EqualError(t, result.Error, "failed to publish to topic eth-usd: connection refused")
+		assert.True(t, runInfo.IsRetryable)
+	})
+}
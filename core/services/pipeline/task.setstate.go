@@ -0,0 +1,66 @@
+package pipeline
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// stateVar is the variable holding the state of the job in its runs
+const stateVar = "state"
+
+var stateKeyRegexp = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+
+// usesState returns true if the pipeline source reads $(state.*) variables
+func usesState(source string) bool {
+	for _, match := range variableRegexp.FindAllStringSubmatch(source, -1) {
+		if keypath := match[1]; keypath == stateVar || strings.HasPrefix(keypath, stateVar+KeypathSeparator) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetStateTask sets the key of the state of the job to its value, or input,
+// so that the next runs read it with $(state.<key>). Like the last values of
+// the deviation tasks, the state is only stored with the successful runs.
+//
+// Return types:
+//
+//	ObjectParam (the value)
+type SetStateTask struct {
+	BaseTask `mapstructure:",squash"`
+	Key      string `json:"key"`
+	Value    string `json:"value"`
+}
+
+var _ Task = (*SetStateTask)(nil)
+
+func (t *SetStateTask) Type() TaskType {
+	return TaskTypeSetState
+}
+
+func (t *SetStateTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	// The key is a literal, so that the state changes of a run are known
+	// from its task runs
+	if !stateKeyRegexp.MatchString(t.Key) {
+		return Result{Error: errors.Wrapf(ErrBadInput, "key: %q must only contain letters, digits and underscores", t.Key)}, runInfo
+	}
+
+	var value ObjectParam
+	err = errors.Wrap(ResolveParam(&value, From(JSONWithVarExprs(t.Value, vars, false), Input(inputs, 0))), "value")
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	return Result{Value: value}, runInfo
+}
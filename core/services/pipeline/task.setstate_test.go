@@ -0,0 +1,42 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestSetStateTask(t *testing.T) {
+	t.Parallel()
+
+	t.Run("sets the value", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"decode": map[string]interface{}{"roundId": 42}})
+		task := pipeline.SetStateTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Key: "lastRound", Value: "$(decode.roundId)"}
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		assert.False(t, runInfo.IsRetryable)
+		require.NoError(t, result.Error)
+		value := result.Value.(pipeline.ObjectParam)
+		assert.Equal(t, pipeline.DecimalType, value.Type)
+		assert.Equal(t, "42", value.DecimalValue.Decimal().String())
+	})
+
+	t.Run("sets the input", func(t *testing.T) {
+		task := pipeline.SetStateTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Key: "cursor"}
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "page-2"}})
+		require.NoError(t, result.Error)
+		assert.Equal(t, pipeline.ObjectParam{Type: pipeline.StringType, StringValue: "page-2"}, result.Value)
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		for _, key := range []string{"", "a.b", "$(foo)", "a b"} {
+			task := pipeline.SetStateTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Key: key}
+			result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: 1}})
+			require.ErrorIs(t, result.Error, pipeline.ErrBadInput, key)
+		}
+	})
+}
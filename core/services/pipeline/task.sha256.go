@@ -0,0 +1,44 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// SHA256Task hashes its input with sha256. Hex strings with the 0x prefix are
+// hashed as the bytes they encode, other strings as their UTF-8 bytes.
+//
+// Return types:
+//
+//	bytes
+type SHA256Task struct {
+	BaseTask `mapstructure:",squash"`
+	Input    string `json:"input"`
+}
+
+var _ Task = (*SHA256Task)(nil)
+
+func (t *SHA256Task) Type() TaskType {
+	return TaskTypeSHA256
+}
+
+func (t *SHA256Task) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var input BytesParam
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&input, From(VarExpr(t.Input, vars), NonemptyString(t.Input), Input(inputs, 0))), "input"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	return Result{Value: sha256Hash(input)}, runInfo
+}
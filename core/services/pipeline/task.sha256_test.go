@@ -0,0 +1,39 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestSHA256Task(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		input  interface{}
+		result string
+	}{
+		{"string", "hello", "0x2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"},
+		{"hex string", "0x68656c6c6f", "0x2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"},
+		{"bytes", []byte("hello"), "0x2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"},
+		{"empty bytes", []byte{}, "0xe3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.SHA256Task{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0)}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: test.input}})
+			assert.False(t, runInfo.IsRetryable)
+			require.NoError(t, result.Error)
+			assert.Equal(t, test.result, hexutil.Encode(result.Value.([]byte)))
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package pipeline_test
+
+import (
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestVerifySignatureTask(t *testing.T) {
+	t.Parallel()
+
+	payload := []byte(`{"price":"1234.56"}`)
+
+	ecKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ecSig, err := crypto.Sign(crypto.Keccak256(payload), ecKey)
+	require.NoError(t, err)
+	ecSigV27 := make([]byte, len(ecSig))
+	copy(ecSigV27, ecSig)
+	ecSigV27[64] += 27
+	ecAddress := crypto.PubkeyToAddress(ecKey.PublicKey)
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	edPub, edPriv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	edSig := ed25519.Sign(edPriv, payload)
+
+	tests := []struct {
+		name      string
+		payload   []byte
+		signature string
+		publicKey string
+		scheme    string
+		error     string
+	}{
+		// success
+		{"ecdsa address", payload, hexutil.Encode(ecSig), ecAddress.Hex(), "", ""},
+		{"ecdsa address v=27", payload, hexutil.Encode(ecSigV27), ecAddress.Hex(), "ecdsa", ""},
+		{"ecdsa compressed key", payload, hexutil.Encode(ecSig), hexutil.Encode(crypto.CompressPubkey(&ecKey.PublicKey)), "ecdsa", ""},
+		{"ecdsa uncompressed key", payload, hexutil.Encode(ecSig), hexutil.Encode(crypto.FromECDSAPub(&ecKey.PublicKey)), "ECDSA", ""},
+		{"ed25519", payload, hexutil.Encode(edSig), hexutil.Encode(edPub), "ed25519", ""},
+
+		// failure
+		{"ecdsa tampered payload", []byte(`{"price":"9999.99"}`), hexutil.Encode(ecSig), ecAddress.Hex(), "ecdsa", "signature verification failed"},
+		{"ecdsa wrong key", payload, hexutil.Encode(ecSig), crypto.PubkeyToAddress(otherKey.PublicKey).Hex(), "ecdsa", "signature verification failed"},
+		{"ecdsa short signature", payload, hexutil.Encode(ecSig[:64]), ecAddress.Hex(), "ecdsa", "ecdsa signature must be 65 bytes"},
+		{"ecdsa bad key length", payload, hexutil.Encode(ecSig), "0x1234", "ecdsa", "ecdsa public key must be"},
+		{"ed25519 tampered payload", []byte("tampered"), hexutil.Encode(edSig), hexutil.Encode(edPub), "ed25519", "signature verification failed"},
+		{"ed25519 bad key length", payload, hexutil.Encode(edSig), ecAddress.Hex(), "ed25519", "ed25519 public key must be 32 bytes"},
+		{"unknown scheme", payload, hexutil.Encode(edSig), hexutil.Encode(edPub), "rsa", `unsupported signature scheme "rsa"`},
+		{"missing signature", payload, "", hexutil.Encode(edPub), "ed25519", "signature"},
+		{"missing public key", payload, hexutil.Encode(edSig), "", "ed25519", "public"},
+	}
+
+	for _, test := range tests {
+		test := test
+		assertOK := func(result pipeline.Result, runInfo pipeline.RunInfo) {
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.error == "" {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.payload, result.Value)
+			} else {
+				require.ErrorContains(t, result.Error, test.error)
+			}
+		}
+		t.Run(test.name, func(t *testing.T) {
+			t.Run("payload from job DAG", func(t *testing.T) {
+				vars := pipeline.NewVarsFrom(nil)
+				task := pipeline.VerifySignatureTask{
+					BaseTask:  pipeline.NewBaseTask(0, "task", nil, nil, 0),
+					Signature: test.signature,
+					PublicKey: test.publicKey,
+					Scheme:    test.scheme,
+				}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{{Value: test.payload}}))
+			})
+			t.Run("with vars", func(t *testing.T) {
+				vars := pipeline.NewVarsFrom(map[string]interface{}{
+					"foo": map[string]interface{}{
+						"payload":   test.payload,
+						"signature": test.signature,
+						"publicKey": test.publicKey,
+					},
+				})
+				task := pipeline.VerifySignatureTask{
+					BaseTask:  pipeline.NewBaseTask(0, "task", nil, nil, 0),
+					Payload:   "$(foo.payload)",
+					Signature: "$(foo.signature)",
+					PublicKey: "$(foo.publicKey)",
+					Scheme:    test.scheme,
+				}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{}))
+			})
+		})
+	}
+}
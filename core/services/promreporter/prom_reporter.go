@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -29,6 +30,10 @@ type (
 		wgDone       sync.WaitGroup
 		reportPeriod time.Duration
 
+		// reportedPending holds the (chain, address, state) label sets reported on the last
+		// head, so that they can be reset to zero once they have no pending transactions left.
+		reportedPending map[pendingTxKey]struct{}
+
 		utils.StartStopOnce
 	}
 
@@ -38,9 +43,17 @@ type (
 		SetMaxUnconfirmedBlocks(*big.Int, int64)
 		SetPipelineRunsQueued(n int)
 		SetPipelineTaskRunsQueued(n int)
+		SetPendingTransactionsByAddress(*big.Int, common.Address, string, int64)
+		SetMaxPendingTxAgeByAddress(*big.Int, common.Address, float64)
 	}
 
 	defaultBackend struct{}
+
+	pendingTxKey struct {
+		evmChainID  string
+		fromAddress common.Address
+		state       string
+	}
 )
 
 var (
@@ -56,6 +69,14 @@ var (
 		Name: "max_unconfirmed_blocks",
 		Help: "The max number of blocks any currently unconfirmed transaction has been unconfirmed for",
 	}, []string{"evmChainID"})
+	promPendingTransactions = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pending_transactions",
+		Help: "Number of transactions that are unstarted, in progress or unconfirmed, by chain, from address and state",
+	}, []string{"evmChainID", "fromAddress", "state"})
+	promMaxPendingTxAge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "max_pending_tx_age",
+		Help: "The length of time since the oldest pending (unstarted, in progress or unconfirmed) transaction was created (in seconds), by chain and from address. Will be 0 if there are no pending transactions.",
+	}, []string{"evmChainID", "fromAddress"})
 	promPipelineRunsQueued = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "pipeline_runs_queued",
 		Help: "The total number of pipeline runs that are awaiting execution",
@@ -78,6 +99,14 @@ func (defaultBackend) SetMaxUnconfirmedBlocks(evmChainID *big.Int, n int64) {
 	promMaxUnconfirmedBlocks.WithLabelValues(evmChainID.String()).Set(float64(n))
 }
 
+func (defaultBackend) SetPendingTransactionsByAddress(evmChainID *big.Int, fromAddress common.Address, state string, n int64) {
+	promPendingTransactions.WithLabelValues(evmChainID.String(), fromAddress.Hex(), state).Set(float64(n))
+}
+
+func (defaultBackend) SetMaxPendingTxAgeByAddress(evmChainID *big.Int, fromAddress common.Address, s float64) {
+	promMaxPendingTxAge.WithLabelValues(evmChainID.String(), fromAddress.Hex()).Set(s)
+}
+
 func (defaultBackend) SetPipelineRunsQueued(n int) {
 	promPipelineTaskRunsQueued.Set(float64(n))
 }
@@ -106,6 +135,8 @@ func NewPromReporter(db *sql.DB, lggr logger.Logger, opts ...interface{}) *promR
 		newHeads:     utils.NewMailbox[*evmtypes.Head](1),
 		chStop:       chStop,
 		reportPeriod: period,
+
+		reportedPending: make(map[pendingTxKey]struct{}),
 	}
 }
 
@@ -160,6 +191,7 @@ func (pr *promReporter) reportHeadMetrics(ctx context.Context, head *evmtypes.He
 		errors.Wrap(pr.reportPendingEthTxes(ctx, evmChainID), "reportPendingEthTxes failed"),
 		errors.Wrap(pr.reportMaxUnconfirmedAge(ctx, evmChainID), "reportMaxUnconfirmedAge failed"),
 		errors.Wrap(pr.reportMaxUnconfirmedBlocks(ctx, head), "reportMaxUnconfirmedBlocks failed"),
+		errors.Wrap(pr.reportPendingEthTxesByAddress(ctx, evmChainID), "reportPendingEthTxesByAddress failed"),
 	)
 
 	if err != nil && ctx.Err() == nil {
@@ -210,6 +242,66 @@ AND eth_txes.state = 'unconfirmed'`, head.EVMChainID.String()).Scan(&earliestUnc
 	return nil
 }
 
+// reportPendingEthTxesByAddress reports pending transaction counts and the age of the oldest
+// pending transaction per from address, so that a single wedged key can be alerted on.
+func (pr *promReporter) reportPendingEthTxesByAddress(ctx context.Context, evmChainID *big.Int) (err error) {
+	rows, err := pr.db.QueryContext(ctx, `
+SELECT from_address, state, count(*), min(created_at) FROM eth_txes
+WHERE state IN ('unstarted', 'in_progress', 'unconfirmed')
+AND evm_chain_id = $1
+GROUP BY from_address, state`, evmChainID.String())
+	if err != nil {
+		return errors.Wrap(err, "failed to query for pending eth_txes by address")
+	}
+	defer func() {
+		err = multierr.Combine(err, rows.Close())
+	}()
+
+	now := time.Now()
+	counts := make(map[pendingTxKey]int64)
+	oldest := make(map[common.Address]time.Time)
+	for rows.Next() {
+		var (
+			key       = pendingTxKey{evmChainID: evmChainID.String()}
+			count     int64
+			createdAt time.Time
+		)
+		if err = rows.Scan(&key.fromAddress, &key.state, &count, &createdAt); err != nil {
+			return errors.Wrap(err, "unexpected error scanning row")
+		}
+		counts[key] = count
+		if o, ok := oldest[key.fromAddress]; !ok || createdAt.Before(o) {
+			oldest[key.fromAddress] = createdAt
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	// Reset label sets that no longer have any pending transactions.
+	for key := range pr.reportedPending {
+		if key.evmChainID != evmChainID.String() {
+			continue
+		}
+		if _, ok := counts[key]; !ok {
+			pr.backend.SetPendingTransactionsByAddress(evmChainID, key.fromAddress, key.state, 0)
+			delete(pr.reportedPending, key)
+			if _, ok = oldest[key.fromAddress]; !ok {
+				pr.backend.SetMaxPendingTxAgeByAddress(evmChainID, key.fromAddress, 0)
+			}
+		}
+	}
+
+	for key, count := range counts {
+		pr.backend.SetPendingTransactionsByAddress(evmChainID, key.fromAddress, key.state, count)
+		pr.reportedPending[key] = struct{}{}
+	}
+	for fromAddress, createdAt := range oldest {
+		pr.backend.SetMaxPendingTxAgeByAddress(evmChainID, fromAddress, now.Sub(createdAt).Seconds())
+	}
+	return nil
+}
+
 func (pr *promReporter) reportPipelineRunStats(ctx context.Context) (err error) {
 	rows, err := pr.db.QueryContext(ctx, `
 SELECT pipeline_run_id FROM pipeline_task_runs WHERE finished_at IS NULL
@@ -67,6 +67,10 @@ func Test_PromReporter_OnNewLongestChain(t *testing.T) {
 			return s > 0
 		})).Return()
 		backend.On("SetMaxUnconfirmedBlocks", big.NewInt(0), int64(35)).Return()
+		backend.On("SetPendingTransactionsByAddress", big.NewInt(0), fromAddress, "unconfirmed", int64(3)).Return()
+		backend.On("SetMaxPendingTxAgeByAddress", big.NewInt(0), fromAddress, mock.MatchedBy(func(s float64) bool {
+			return s > 0
+		})).Return()
 		backend.On("SetPipelineTaskRunsQueued", 0).Return()
 		backend.On("SetPipelineRunsQueued", 0).
 			Run(func(args mock.Arguments) {
@@ -119,4 +123,47 @@ func Test_PromReporter_OnNewLongestChain(t *testing.T) {
 
 		require.Eventually(t, func() bool { return subscribeCalls.Load() >= 1 }, 12*time.Second, 100*time.Millisecond)
 	})
+
+	t.Run("resets pending eth_txes by address once they are confirmed", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		cfg := cltest.NewTestGeneralConfig(t)
+		borm := cltest.NewTxmORM(t, db, cfg)
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+		_, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 0, fromAddress)
+
+		var pendingCalls, resetCalls atomic.Int32
+
+		backend := mocks.NewPrometheusBackend(t)
+		backend.On("SetUnconfirmedTransactions", big.NewInt(0), mock.Anything).Return()
+		backend.On("SetMaxUnconfirmedAge", big.NewInt(0), mock.Anything).Return()
+		backend.On("SetMaxUnconfirmedBlocks", big.NewInt(0), mock.Anything).Return()
+		backend.On("SetPipelineTaskRunsQueued", 0).Return().Maybe()
+		backend.On("SetPipelineRunsQueued", 0).Return().Maybe()
+		backend.On("SetMaxPendingTxAgeByAddress", big.NewInt(0), fromAddress, mock.Anything).Return()
+		backend.On("SetPendingTransactionsByAddress", big.NewInt(0), fromAddress, "unconfirmed", int64(1)).
+			Run(func(args mock.Arguments) {
+				pendingCalls.Inc()
+			}).
+			Return().Once()
+		backend.On("SetPendingTransactionsByAddress", big.NewInt(0), fromAddress, "unconfirmed", int64(0)).
+			Run(func(args mock.Arguments) {
+				resetCalls.Inc()
+			}).
+			Return().Once()
+
+		reporter := promreporter.NewPromReporter(db.DB, logger.TestLogger(t), backend, 10*time.Millisecond)
+		reporter.Start(testutils.Context(t))
+		defer reporter.Close()
+
+		head := newHead()
+		reporter.OnNewLongestChain(testutils.Context(t), &head)
+		require.Eventually(t, func() bool { return pendingCalls.Load() == 1 }, 12*time.Second, 100*time.Millisecond)
+
+		require.NoError(t, utils.JustError(db.Exec(`UPDATE eth_txes SET state = 'confirmed' WHERE id = $1`, etx.ID)))
+
+		reporter.OnNewLongestChain(testutils.Context(t), &head)
+		require.Eventually(t, func() bool { return resetCalls.Load() == 1 }, 12*time.Second, 100*time.Millisecond)
+	})
 }
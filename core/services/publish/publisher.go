@@ -0,0 +1,146 @@
+// Package publish publishes the results of the publish pipeline tasks to a
+// message broker, for the off-chain consumers of the job results.
+package publish
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	amqp "github.com/rabbitmq/amqp091-go"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// The schemes of the broker URLs
+const (
+	SchemeAMQP  = "amqp"
+	SchemeAMQPS = "amqps"
+)
+
+// Exchange is the RabbitMQ exchange the results are published to, with their
+// topic as routing key. It is the topic exchange every broker declares, to
+// which the consumers bind their own queues.
+const Exchange = "amq.topic"
+
+// Publisher publishes persistent messages to a RabbitMQ broker, and waits for
+// the broker to confirm each of them. It connects to the broker on the first
+// publish, and connects again once the connection is lost.
+type Publisher struct {
+	utils.StartStopOnce
+	url  *url.URL
+	lggr logger.Logger
+
+	mu      sync.Mutex
+	conn    *amqp.Connection
+	channel *amqp.Channel
+}
+
+var _ pipeline.Publisher = (*Publisher)(nil)
+
+// NewPublisher returns a Publisher to the broker at brokerURL, whose scheme
+// must be amqp or amqps.
+func NewPublisher(brokerURL *url.URL, lggr logger.Logger) (*Publisher, error) {
+	switch brokerURL.Scheme {
+	case SchemeAMQP, SchemeAMQPS:
+	default:
+		return nil, errors.Errorf("invalid broker URL %s: scheme must be %s or %s", brokerURL.Redacted(), SchemeAMQP, SchemeAMQPS)
+	}
+	return &Publisher{
+		url:  brokerURL,
+		lggr: lggr.Named("Publisher").With("url", brokerURL.Redacted()),
+	}, nil
+}
+
+func (p *Publisher) Start(context.Context) error {
+	return p.StartOnce("Publisher", func() error { return nil })
+}
+
+func (p *Publisher) Close() error {
+	return p.StopOnce("Publisher", func() error {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		if p.conn == nil {
+			return nil
+		}
+		return p.conn.Close()
+	})
+}
+
+// Publish publishes payload to topic, and returns once the broker confirmed it.
+func (p *Publisher) Publish(ctx context.Context, topic string, payload []byte) error {
+	confirmation, err := p.publish(ctx, topic, payload)
+	if err != nil {
+		return err
+	}
+	acked := make(chan bool, 1)
+	go func() {
+		// The pending confirmations are rejected when the channel is closed
+		acked <- confirmation.Wait()
+	}()
+	select {
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "timed out waiting for the broker to confirm the message")
+	case ok := <-acked:
+		if !ok {
+			return errors.New("broker rejected the message")
+		}
+		return nil
+	}
+}
+
+func (p *Publisher) publish(ctx context.Context, topic string, payload []byte) (*amqp.DeferredConfirmation, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err := p.Ready(); err != nil {
+		return nil, err
+	}
+	if p.channel == nil || p.channel.IsClosed() {
+		if err := p.connect(); err != nil {
+			return nil, err
+		}
+	}
+	confirmation, err := p.channel.PublishWithDeferredConfirmWithContext(ctx, Exchange, topic, false, false, amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		Timestamp:    time.Now(),
+		Body:         payload,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to publish the message")
+	}
+	return confirmation, nil
+}
+
+// connect replaces the connection and the channel, which must be in confirm
+// mode for the messages to be confirmed.
+func (p *Publisher) connect() error {
+	if p.conn != nil && !p.conn.IsClosed() {
+		if err := p.conn.Close(); err != nil {
+			p.lggr.Warnw("Failed to close the connection to the broker", "err", err)
+		}
+	}
+	p.conn, p.channel = nil, nil
+
+	conn, err := amqp.Dial(p.url.String())
+	if err != nil {
+		return errors.Wrap(err, "failed to dial the broker")
+	}
+	channel, err := conn.Channel()
+	if err == nil {
+		err = errors.Wrap(channel.Confirm(false), "failed to enable publisher confirms")
+	} else {
+		err = errors.Wrap(err, "failed to open a channel")
+	}
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	p.lggr.Info("Connected to the broker")
+	p.conn, p.channel = conn, channel
+	return nil
+}
@@ -147,7 +147,8 @@ func newContractTransmitter(lggr logger.Logger, rargs relaytypes.RelayArgs, tran
 		configWatcher.contractAddress,
 		configWatcher.chain.Client(),
 		configWatcher.contractABI,
-		ocrcommon.NewTransmitter(configWatcher.chain.TxManager(), transmitterAddress, gasLimit, rargs.ForwardingAllowed, strategy, txm.TransmitCheckerSpec{}),
+		// The relayer is only given the ID of the OCR2 spec, not of the job
+		ocrcommon.NewTransmitter(configWatcher.chain.TxManager(), transmitterAddress, gasLimit, rargs.ForwardingAllowed, strategy, txm.TransmitCheckerSpec{}, 0, nil),
 		configWatcher.chain.LogPoller(),
 		lggr,
 	)
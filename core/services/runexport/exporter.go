@@ -0,0 +1,163 @@
+package runexport
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+// The schemes of the export URLs
+const (
+	SchemeS3  = "s3"
+	SchemeGCS = "gs"
+)
+
+const (
+	gcsEndpoint   = "https://storage.googleapis.com"
+	gcsRegion     = "auto"
+	defaultRegion = "us-east-1"
+)
+
+// Exporter writes the runs deleted by the reaper to an object storage bucket,
+// each batch as a gzipped NDJSON object, one run per line
+type Exporter struct {
+	bucket *Bucket
+	prefix string
+	lggr   logger.Logger
+}
+
+var _ pipeline.RunExporter = (*Exporter)(nil)
+
+// NewExporter returns an Exporter to exportURL, like s3://bucket/prefix or
+// gs://bucket/prefix. The default endpoint of the scheme can be overridden by
+// endpoint, for other S3 compatible storages. The HMAC keys and the region are
+// read from the standard AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY,
+// AWS_SESSION_TOKEN and AWS_REGION env vars.
+func NewExporter(exportURL *url.URL, endpoint *url.URL, client *http.Client, lggr logger.Logger) (*Exporter, error) {
+	if exportURL.Host == "" {
+		return nil, errors.Errorf("invalid export URL %s: missing bucket", exportURL.Redacted())
+	}
+	region := os.Getenv("AWS_REGION")
+	var defaultEndpoint string
+	switch exportURL.Scheme {
+	case SchemeS3:
+		if region == "" {
+			region = defaultRegion
+		}
+		defaultEndpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", region)
+	case SchemeGCS:
+		region = gcsRegion
+		defaultEndpoint = gcsEndpoint
+	default:
+		return nil, errors.Errorf("invalid export URL %s: scheme must be %s or %s", exportURL.Redacted(), SchemeS3, SchemeGCS)
+	}
+	if endpoint == nil || endpoint.String() == "" {
+		var err error
+		if endpoint, err = url.Parse(defaultEndpoint); err != nil {
+			return nil, err
+		}
+	}
+
+	creds := Credentials{
+		AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+	}
+	if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+		return nil, errors.New("AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY must be set to export runs")
+	}
+
+	return &Exporter{
+		bucket: &Bucket{
+			Endpoint:    endpoint,
+			Name:        exportURL.Host,
+			Region:      region,
+			Credentials: creds,
+			Client:      client,
+		},
+		prefix: strings.Trim(exportURL.Path, "/"),
+		lggr:   lggr.Named("RunExporter"),
+	}, nil
+}
+
+// Run is the exported form of a pipeline run, a line of the objects
+type Run struct {
+	ID             int64                     `json:"id"`
+	JobID          int32                     `json:"jobID,omitempty"`
+	JobName        string                    `json:"jobName,omitempty"`
+	PipelineSpecID int32                     `json:"pipelineSpecID"`
+	State          pipeline.RunStatus        `json:"state"`
+	Meta           pipeline.JSONSerializable `json:"meta"`
+	Inputs         pipeline.JSONSerializable `json:"inputs"`
+	Outputs        pipeline.JSONSerializable `json:"outputs"`
+	AllErrors      pipeline.RunErrors        `json:"allErrors"`
+	FatalErrors    pipeline.RunErrors        `json:"fatalErrors"`
+	CreatedAt      time.Time                 `json:"createdAt"`
+	FinishedAt     null.Time                 `json:"finishedAt"`
+	TaskRuns       []pipeline.TaskRun        `json:"taskRuns"`
+}
+
+// NewRun returns the exported form of run
+func NewRun(run pipeline.Run) Run {
+	return Run{
+		ID:             run.ID,
+		JobID:          run.PipelineSpec.JobID,
+		JobName:        run.PipelineSpec.JobName,
+		PipelineSpecID: run.PipelineSpecID,
+		State:          run.State,
+		Meta:           run.Meta,
+		Inputs:         run.Inputs,
+		Outputs:        run.Outputs,
+		AllErrors:      run.AllErrors,
+		FatalErrors:    run.FatalErrors,
+		CreatedAt:      run.CreatedAt,
+		FinishedAt:     run.FinishedAt,
+		TaskRuns:       run.PipelineTaskRuns,
+	}
+}
+
+// ExportRuns writes runs, sorted by ID, to the object
+// <prefix>/<YYYY>/<MM>/<DD>/runs-<first ID>-<last ID>.ndjson.gz, dated by the
+// creation of the first run. Exporting the same runs again overwrites it.
+func (e *Exporter) ExportRuns(ctx context.Context, runs []pipeline.Run) error {
+	if len(runs) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	enc := json.NewEncoder(gz)
+	for _, run := range runs {
+		if err := enc.Encode(NewRun(run)); err != nil {
+			return errors.Wrapf(err, "failed to encode run %d", run.ID)
+		}
+	}
+	if err := gz.Close(); err != nil {
+		return errors.Wrap(err, "failed to compress runs")
+	}
+
+	key := ObjectKey(e.prefix, runs[0].CreatedAt, runs[0].ID, runs[len(runs)-1].ID)
+	if err := e.bucket.Put(ctx, key, buf.Bytes(), "application/gzip"); err != nil {
+		return err
+	}
+	e.lggr.Debugw("Exported runs", "key", key, "runs", len(runs), "bytes", buf.Len())
+	return nil
+}
+
+// ObjectKey returns the key of the object of the runs from firstID to lastID
+func ObjectKey(prefix string, createdAt time.Time, firstID, lastID int64) string {
+	return path.Join(prefix, createdAt.UTC().Format("2006/01/02"), fmt.Sprintf("runs-%d-%d.ndjson.gz", firstID, lastID))
+}
@@ -0,0 +1,162 @@
+package runexport_test
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/runexport"
+)
+
+func mustParseURL(t *testing.T, s string) *url.URL {
+	u, err := url.Parse(s)
+	require.NoError(t, err)
+	return u
+}
+
+func setCredentials(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKID")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+	t.Setenv("AWS_SESSION_TOKEN", "")
+	t.Setenv("AWS_REGION", "")
+}
+
+func TestNewExporter(t *testing.T) {
+	lggr := logger.TestLogger(t)
+
+	t.Run("invalid URL", func(t *testing.T) {
+		setCredentials(t)
+		_, err := runexport.NewExporter(mustParseURL(t, "https://bucket/prefix"), nil, http.DefaultClient, lggr)
+		assert.EqualError(t, err, "invalid export URL https://bucket/prefix: scheme must be s3 or gs")
+		_, err = runexport.NewExporter(mustParseURL(t, "s3:///prefix"), nil, http.DefaultClient, lggr)
+		assert.EqualError(t, err, "invalid export URL s3:///prefix: missing bucket")
+	})
+
+	t.Run("missing credentials", func(t *testing.T) {
+		setCredentials(t)
+		t.Setenv("AWS_SECRET_ACCESS_KEY", "")
+		_, err := runexport.NewExporter(mustParseURL(t, "gs://bucket/prefix"), nil, http.DefaultClient, lggr)
+		assert.EqualError(t, err, "AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY must be set to export runs")
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		setCredentials(t)
+		_, err := runexport.NewExporter(mustParseURL(t, "s3://bucket"), nil, http.DefaultClient, lggr)
+		assert.NoError(t, err)
+		_, err = runexport.NewExporter(mustParseURL(t, "gs://bucket/prefix"), nil, http.DefaultClient, lggr)
+		assert.NoError(t, err)
+	})
+}
+
+func TestExporter_ExportRuns(t *testing.T) {
+	setCredentials(t)
+
+	var (
+		mu         sync.Mutex
+		path, auth string
+		body       []byte
+		status     = http.StatusOK
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, http.MethodPut, r.Method)
+		path = r.URL.Path
+		auth = r.Header.Get("Authorization")
+		var err error
+		body, err = io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	exporter, err := runexport.NewExporter(mustParseURL(t, "s3://runs/node-1/"), mustParseURL(t, server.URL), server.Client(), logger.TestLogger(t))
+	require.NoError(t, err)
+
+	createdAt := time.Date(2022, 9, 1, 23, 0, 0, 0, time.UTC)
+	runs := []pipeline.Run{
+		{
+			ID:             7,
+			PipelineSpecID: 3,
+			PipelineSpec:   pipeline.Spec{JobID: 2, JobName: "feed"},
+			State:          pipeline.RunStatusCompleted,
+			Outputs:        pipeline.JSONSerializable{Val: []interface{}{"100"}, Valid: true},
+			CreatedAt:      createdAt,
+			FinishedAt:     null.TimeFrom(createdAt.Add(time.Second)),
+		},
+		{
+			ID:             9,
+			PipelineSpecID: 3,
+			PipelineSpec:   pipeline.Spec{JobID: 2, JobName: "feed"},
+			State:          pipeline.RunStatusErrored,
+			CreatedAt:      createdAt.Add(time.Minute),
+			FinishedAt:     null.TimeFrom(createdAt.Add(time.Minute + time.Second)),
+		},
+	}
+	require.NoError(t, exporter.ExportRuns(context.Background(), runs))
+
+	mu.Lock()
+	assert.Equal(t, "/runs/node-1/2022/09/01/runs-7-9.ndjson.gz", path)
+	assert.True(t, strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKID/"), auth)
+	uploaded := body
+	mu.Unlock()
+
+	gz, err := gzip.NewReader(bytes.NewReader(uploaded))
+	require.NoError(t, err)
+	scanner := bufio.NewScanner(gz)
+	var exported []runexport.Run
+	for scanner.Scan() {
+		var run runexport.Run
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &run))
+		exported = append(exported, run)
+	}
+	require.NoError(t, scanner.Err())
+	require.Len(t, exported, 2)
+	assert.Equal(t, int64(7), exported[0].ID)
+	assert.Equal(t, "feed", exported[0].JobName)
+	assert.Equal(t, pipeline.RunStatusCompleted, exported[0].State)
+	assert.Equal(t, int64(9), exported[1].ID)
+	assert.Equal(t, pipeline.RunStatusErrored, exported[1].State)
+
+	t.Run("no runs", func(t *testing.T) {
+		mu.Lock()
+		path = ""
+		mu.Unlock()
+		require.NoError(t, exporter.ExportRuns(context.Background(), nil))
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Empty(t, path)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		mu.Lock()
+		status = http.StatusForbidden
+		mu.Unlock()
+		err := exporter.ExportRuns(context.Background(), runs)
+		assert.EqualError(t, err, "failed to put node-1/2022/09/01/runs-7-9.ndjson.gz: status 403: ")
+	})
+}
+
+func TestObjectKey(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2022, 1, 2, 3, 4, 5, 0, time.FixedZone("", 5*3600))
+	assert.Equal(t, "2022/01/01/runs-1-10.ndjson.gz", runexport.ObjectKey("", createdAt, 1, 10))
+	assert.Equal(t, "a/b/2022/01/01/runs-1-10.ndjson.gz", runexport.ObjectKey("a/b", createdAt, 1, 10))
+}
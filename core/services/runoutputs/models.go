@@ -0,0 +1,71 @@
+package runoutputs
+
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// Subscription is a URL that receives the outputs of finished pipeline runs.
+// Unset filters match every run.
+type Subscription struct {
+	ID  int64
+	URL models.WebURL
+	// Secret is the key used to sign the deliveries
+	Secret    string
+	JobID     null.Int
+	JobType   null.String
+	Status    null.String
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (s *Subscription) decryptSecret(ciphers *pg.ColumnCiphers) (err error) {
+	s.Secret, err = ciphers.Decrypt(s.Secret)
+	return err
+}
+
+// Matches returns true if run passes all of the subscription's filters
+func (s Subscription) Matches(run *pipeline.Run) bool {
+	if s.JobID.Valid && s.JobID.Int64 != int64(run.PipelineSpec.JobID) {
+		return false
+	}
+	if s.JobType.Valid && s.JobType.String != run.PipelineSpec.JobType {
+		return false
+	}
+	if s.Status.Valid && s.Status.String != string(run.State) {
+		return false
+	}
+	return true
+}
+
+// RunOutput is the body POSTed to subscribers
+type RunOutput struct {
+	RunID      int64                     `json:"runID"`
+	JobID      int32                     `json:"jobID"`
+	JobName    string                    `json:"jobName"`
+	JobType    string                    `json:"jobType"`
+	Status     pipeline.RunStatus        `json:"status"`
+	Outputs    pipeline.JSONSerializable `json:"outputs"`
+	Errors     pipeline.RunErrors        `json:"errors"`
+	CreatedAt  time.Time                 `json:"createdAt"`
+	FinishedAt null.Time                 `json:"finishedAt"`
+}
+
+func newRunOutput(run *pipeline.Run) RunOutput {
+	return RunOutput{
+		RunID:      run.ID,
+		JobID:      run.PipelineSpec.JobID,
+		JobName:    run.PipelineSpec.JobName,
+		JobType:    run.PipelineSpec.JobType,
+		Status:     run.State,
+		Outputs:    run.Outputs,
+		Errors:     run.FatalErrors,
+		CreatedAt:  run.CreatedAt,
+		FinishedAt: run.FinishedAt,
+	}
+}
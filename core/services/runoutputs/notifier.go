@@ -0,0 +1,208 @@
+package runoutputs
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// SignatureHeader carries the hex encoded HMAC-SHA256 of the request body,
+// keyed with the subscription's secret
+const SignatureHeader = "X-Chainlink-Signature"
+
+const (
+	// queueSize is the number of finished runs buffered for delivery, runs
+	// finishing while the queue is full are dropped
+	queueSize       = 100
+	deliveryTimeout = 10 * time.Second
+	secretLength    = 32
+)
+
+// Notifier POSTs the outputs of finished pipeline runs to the subscribed URLs
+type Notifier interface {
+	services.ServiceCtx
+	// Notify queues the run for delivery to the matching subscriptions
+	Notify(run *pipeline.Run)
+	Subscribe(sub *Subscription) error
+	Unsubscribe(id int64) error
+	Subscriptions() ([]Subscription, error)
+}
+
+type delivery struct {
+	output RunOutput
+	subs   []Subscription
+}
+
+type notifier struct {
+	utils.StartStopOnce
+	orm    ORM
+	client *http.Client
+	lggr   logger.Logger
+
+	subsMu sync.RWMutex
+	subs   []Subscription
+
+	chDeliveries chan delivery
+	chStop       chan struct{}
+	wgDone       sync.WaitGroup
+}
+
+var _ Notifier = (*notifier)(nil)
+
+func NewNotifier(orm ORM, client *http.Client, lggr logger.Logger) Notifier {
+	return &notifier{
+		orm:          orm,
+		client:       client,
+		lggr:         lggr.Named("RunOutputsNotifier"),
+		chDeliveries: make(chan delivery, queueSize),
+		chStop:       make(chan struct{}),
+	}
+}
+
+func (n *notifier) Start(ctx context.Context) error {
+	return n.StartOnce("RunOutputsNotifier", func() error {
+		if err := n.loadSubscriptions(pg.WithParentCtx(ctx)); err != nil {
+			return err
+		}
+		n.wgDone.Add(1)
+		go n.run()
+		return nil
+	})
+}
+
+func (n *notifier) Close() error {
+	return n.StopOnce("RunOutputsNotifier", func() error {
+		close(n.chStop)
+		n.wgDone.Wait()
+		return nil
+	})
+}
+
+func (n *notifier) Notify(run *pipeline.Run) {
+	if run.PipelineSpec.JobID == 0 {
+		return
+	}
+	var subs []Subscription
+	n.subsMu.RLock()
+	for _, sub := range n.subs {
+		if sub.Matches(run) {
+			subs = append(subs, sub)
+		}
+	}
+	n.subsMu.RUnlock()
+	if len(subs) == 0 {
+		return
+	}
+
+	select {
+	case n.chDeliveries <- delivery{newRunOutput(run), subs}:
+	default:
+		n.lggr.Errorw("Run outputs queue is full, dropping run", "runID", run.ID, "jobID", run.PipelineSpec.JobID)
+	}
+}
+
+// Subscribe validates and saves sub, generating its secret
+func (n *notifier) Subscribe(sub *Subscription) error {
+	if sub.URL.Scheme != "http" && sub.URL.Scheme != "https" {
+		return errors.Errorf("invalid url %q, must be http or https", sub.URL.String())
+	}
+	if sub.Status.Valid && sub.Status.String != string(pipeline.RunStatusCompleted) && sub.Status.String != string(pipeline.RunStatusErrored) {
+		return errors.Errorf("invalid status %q, must be %s or %s", sub.Status.String, pipeline.RunStatusCompleted, pipeline.RunStatusErrored)
+	}
+	sub.Secret = utils.NewSecret(secretLength)
+	if err := n.orm.CreateSubscription(sub); err != nil {
+		return err
+	}
+	return n.loadSubscriptions()
+}
+
+// Unsubscribe deletes a subscription.
+// Returns sql.ErrNoRows if it does not exist
+func (n *notifier) Unsubscribe(id int64) error {
+	if err := n.orm.DeleteSubscription(id); err != nil {
+		return err
+	}
+	return n.loadSubscriptions()
+}
+
+func (n *notifier) Subscriptions() ([]Subscription, error) {
+	return n.orm.Subscriptions()
+}
+
+func (n *notifier) loadSubscriptions(qopts ...pg.QOpt) error {
+	subs, err := n.orm.Subscriptions(qopts...)
+	if err != nil {
+		return err
+	}
+	n.subsMu.Lock()
+	defer n.subsMu.Unlock()
+	n.subs = subs
+	return nil
+}
+
+func (n *notifier) run() {
+	defer n.wgDone.Done()
+	for {
+		select {
+		case <-n.chStop:
+			return
+		case d := <-n.chDeliveries:
+			body, err := json.Marshal(d.output)
+			if err != nil {
+				n.lggr.Errorw("Failed to encode run output", "runID", d.output.RunID, "err", err)
+				continue
+			}
+			for _, sub := range d.subs {
+				if err = n.deliver(sub, body); err != nil {
+					n.lggr.Warnw("Failed to deliver run output", "runID", d.output.RunID, "subscriptionID", sub.ID, "url", sub.URL.String(), "err", err)
+				}
+			}
+		}
+	}
+}
+
+func (n *notifier) deliver(sub Subscription, body []byte) error {
+	ctx, cancel := utils.ContextFromChanWithDeadline(n.chStop, deliveryTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(sub.Secret, body))
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return errors.Errorf("subscriber responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of body keyed with secret, as sent
+// in the SignatureHeader
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
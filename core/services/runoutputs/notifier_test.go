@@ -0,0 +1,135 @@
+package runoutputs_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/runoutputs"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+type received struct {
+	output    runoutputs.RunOutput
+	signature string
+	body      []byte
+}
+
+func newSubscriber(t *testing.T) (*httptest.Server, chan received) {
+	ch := make(chan received, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var output runoutputs.RunOutput
+		require.NoError(t, json.Unmarshal(body, &output))
+		ch <- received{output, r.Header.Get(runoutputs.SignatureHeader), body}
+	}))
+	t.Cleanup(server.Close)
+	return server, ch
+}
+
+func recv(t *testing.T, ch chan received) received {
+	select {
+	case r := <-ch:
+		return r
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for delivery")
+	}
+	return received{}
+}
+
+func mustParseURL(t *testing.T, s string) models.WebURL {
+	u, err := url.Parse(s)
+	require.NoError(t, err)
+	return models.WebURL(*u)
+}
+
+func newRun(id int64, jobID int32, jobType string, state pipeline.RunStatus) *pipeline.Run {
+	return &pipeline.Run{
+		ID:           id,
+		PipelineSpec: pipeline.Spec{JobID: jobID, JobName: "job", JobType: jobType},
+		State:        state,
+		Outputs:      pipeline.JSONSerializable{Val: []interface{}{"42"}, Valid: true},
+		CreatedAt:    time.Now(),
+		FinishedAt:   null.TimeFrom(time.Now()),
+	}
+}
+
+func TestNotifier(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	lggr := logger.TestLogger(t)
+	cfg := pgtest.NewPGCfg(false)
+	n := runoutputs.NewNotifier(runoutputs.NewORM(db, lggr, cfg, nil), http.DefaultClient, lggr)
+	require.NoError(t, n.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, n.Close()) })
+
+	allServer, allCh := newSubscriber(t)
+	errorsServer, errorsCh := newSubscriber(t)
+
+	all := runoutputs.Subscription{URL: mustParseURL(t, allServer.URL)}
+	require.NoError(t, n.Subscribe(&all))
+	assert.NotEmpty(t, all.Secret)
+	keeperErrors := runoutputs.Subscription{
+		URL:     mustParseURL(t, errorsServer.URL),
+		JobType: null.StringFrom("keeper"),
+		Status:  null.StringFrom(string(pipeline.RunStatusErrored)),
+	}
+	require.NoError(t, n.Subscribe(&keeperErrors))
+
+	t.Run("validates subscriptions", func(t *testing.T) {
+		err := n.Subscribe(&runoutputs.Subscription{URL: mustParseURL(t, "ftp://example.com")})
+		require.ErrorContains(t, err, "must be http or https")
+		err = n.Subscribe(&runoutputs.Subscription{URL: mustParseURL(t, allServer.URL), Status: null.StringFrom("running")})
+		require.ErrorContains(t, err, `invalid status "running"`)
+	})
+
+	t.Run("delivers signed outputs to matching subscriptions", func(t *testing.T) {
+		n.Notify(newRun(1, 7, "keeper", pipeline.RunStatusCompleted))
+		n.Notify(newRun(2, 7, "keeper", pipeline.RunStatusErrored))
+
+		for _, runID := range []int64{1, 2} {
+			r := recv(t, allCh)
+			assert.Equal(t, runID, r.output.RunID)
+			assert.Equal(t, int32(7), r.output.JobID)
+			assert.Equal(t, runoutputs.Sign(all.Secret, r.body), r.signature)
+		}
+		r := recv(t, errorsCh)
+		assert.Equal(t, int64(2), r.output.RunID)
+		assert.Equal(t, pipeline.RunStatusErrored, r.output.Status)
+		assert.Equal(t, runoutputs.Sign(keeperErrors.Secret, r.body), r.signature)
+	})
+
+	t.Run("stops delivering after unsubscribing", func(t *testing.T) {
+		require.NoError(t, n.Unsubscribe(all.ID))
+		require.ErrorIs(t, n.Unsubscribe(all.ID), sql.ErrNoRows)
+
+		n.Notify(newRun(3, 7, "keeper", pipeline.RunStatusErrored))
+		r := recv(t, errorsCh)
+		assert.Equal(t, int64(3), r.output.RunID)
+		select {
+		case r = <-allCh:
+			t.Fatalf("unexpected delivery of run %d", r.output.RunID)
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		subs, err := n.Subscriptions()
+		require.NoError(t, err)
+		require.Len(t, subs, 1)
+		assert.Equal(t, keeperErrors.ID, subs[0].ID)
+	})
+}
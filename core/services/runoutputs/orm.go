@@ -0,0 +1,66 @@
+package runoutputs
+
+import (
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+type ORM interface {
+	CreateSubscription(sub *Subscription, qopts ...pg.QOpt) error
+	DeleteSubscription(id int64, qopts ...pg.QOpt) error
+	Subscriptions(qopts ...pg.QOpt) ([]Subscription, error)
+}
+
+type orm struct {
+	q       pg.Q
+	ciphers *pg.ColumnCiphers
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig, ciphers *pg.ColumnCiphers) ORM {
+	return &orm{pg.NewQ(db, lggr.Named("RunOutputsORM"), cfg), ciphers}
+}
+
+// CreateSubscription inserts sub, setting its ID and timestamps
+func (o *orm) CreateSubscription(sub *Subscription, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	sql := `INSERT INTO run_output_subscriptions (url, secret, job_id, job_type, status, created_at, updated_at)
+VALUES (:url, :secret, :job_id, :job_type, :status, NOW(), NOW())
+RETURNING *`
+	encrypted := *sub
+	secret, err := o.ciphers.Encrypt(sub.Secret)
+	if err != nil {
+		return errors.Wrap(err, "CreateSubscription failed")
+	}
+	encrypted.Secret = secret
+	if err = q.GetNamed(sql, sub, encrypted); err != nil {
+		return errors.Wrap(err, "CreateSubscription failed")
+	}
+	return sub.decryptSecret(o.ciphers)
+}
+
+// DeleteSubscription removes a subscription.
+// Returns sql.ErrNoRows if it does not exist
+func (o *orm) DeleteSubscription(id int64, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	var deletedID int64
+	return q.Get(&deletedID, `DELETE FROM run_output_subscriptions WHERE id = $1 RETURNING id`, id)
+}
+
+// Subscriptions returns all subscriptions
+func (o *orm) Subscriptions(qopts ...pg.QOpt) (subs []Subscription, err error) {
+	q := o.q.WithOpts(qopts...)
+	if err = q.Select(&subs, `SELECT * FROM run_output_subscriptions ORDER BY id`); err != nil {
+		return nil, errors.Wrap(err, "Subscriptions failed")
+	}
+	for i := range subs {
+		if err = subs[i].decryptSecret(o.ciphers); err != nil {
+			return nil, errors.Wrap(err, "Subscriptions failed")
+		}
+	}
+	return subs, nil
+}
@@ -0,0 +1,218 @@
+package updates
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	uuid "github.com/satori/go.uuid"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// Topic is a kind of updates clients subscribe to
+type Topic string
+
+const (
+	TopicRuns  Topic = "runs"
+	TopicTxes  Topic = "txes"
+	TopicHeads Topic = "heads"
+)
+
+// Topics are all the topics, clients subscribe to them by default
+var Topics = []Topic{TopicRuns, TopicTxes, TopicHeads}
+
+// Update types
+const (
+	TypeRunCreated   = "jobRunCreated"
+	TypeRunCompleted = "jobRunCompleted"
+	TypeTxState      = "txStateChanged"
+	TypeHead         = "newHead"
+)
+
+// bufferSize is the number of updates buffered per subscription. A
+// subscription falling further behind is closed, its client has to fetch the
+// current state again.
+const bufferSize = 100
+
+// Update is a message pushed to the subscribed clients
+type Update struct {
+	Type string      `json:"type"`
+	Data interface{} `json:"data"`
+}
+
+// Run is the data of the run updates
+type Run struct {
+	ID             int64              `json:"id"`
+	JobID          int32              `json:"jobID"`
+	PipelineSpecID int32              `json:"pipelineSpecID"`
+	State          pipeline.RunStatus `json:"state"`
+	Errors         []*string          `json:"errors,omitempty"`
+	CreatedAt      time.Time          `json:"createdAt"`
+	FinishedAt     null.Time          `json:"finishedAt"`
+}
+
+// Tx is the data of the transaction updates
+type Tx struct {
+	ID                int64            `json:"id"`
+	EVMChainID        *utils.Big       `json:"evmChainID"`
+	From              common.Address   `json:"from"`
+	To                common.Address   `json:"to"`
+	State             txmgr.EthTxState `json:"state"`
+	Hash              *common.Hash     `json:"hash"`
+	Error             string           `json:"error,omitempty"`
+	PipelineTaskRunID uuid.NullUUID    `json:"pipelineTaskRunID"`
+	Time              time.Time        `json:"time"`
+}
+
+// Head is the data of the head updates
+type Head struct {
+	EVMChainID *utils.Big  `json:"evmChainID"`
+	Number     int64       `json:"number"`
+	Hash       common.Hash `json:"hash"`
+	Timestamp  time.Time   `json:"timestamp"`
+}
+
+// Broadcaster fans out the changes of runs, transactions and heads to the
+// subscribed clients. Publishing never blocks.
+type Broadcaster interface {
+	// Subscribe returns the updates of topics, and a function to unsubscribe.
+	// The channel is closed on unsubscribe, or if the subscriber falls behind.
+	Subscribe(topics ...Topic) (<-chan Update, func())
+
+	// OnRunCreated is a pipeline.Runner hook
+	OnRunCreated(run *pipeline.Run)
+	// OnRunFinished is a pipeline.Runner hook
+	OnRunFinished(run *pipeline.Run)
+	// OnTxEvent is a txmgr.TxEventCallback
+	OnTxEvent(e txmgr.TxEvent)
+	// OnNewLongestChain implements httypes.HeadTrackable
+	OnNewLongestChain(ctx context.Context, head *evmtypes.Head)
+}
+
+type subscription struct {
+	topics map[Topic]bool
+	ch     chan Update
+}
+
+type broadcaster struct {
+	lggr logger.Logger
+
+	mu   sync.RWMutex
+	subs map[*subscription]struct{}
+}
+
+var _ Broadcaster = (*broadcaster)(nil)
+
+// NewBroadcaster returns a Broadcaster without subscribers
+func NewBroadcaster(lggr logger.Logger) Broadcaster {
+	return &broadcaster{
+		lggr: lggr.Named("UpdatesBroadcaster"),
+		subs: make(map[*subscription]struct{}),
+	}
+}
+
+func (b *broadcaster) Subscribe(topics ...Topic) (<-chan Update, func()) {
+	if len(topics) == 0 {
+		topics = Topics
+	}
+	sub := &subscription{
+		topics: make(map[Topic]bool, len(topics)),
+		ch:     make(chan Update, bufferSize),
+	}
+	for _, t := range topics {
+		sub.topics[t] = true
+	}
+
+	b.mu.Lock()
+	b.subs[sub] = struct{}{}
+	b.mu.Unlock()
+
+	return sub.ch, func() { b.unsubscribe(sub) }
+}
+
+func (b *broadcaster) unsubscribe(sub *subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.subs[sub]; ok {
+		delete(b.subs, sub)
+		close(sub.ch)
+	}
+}
+
+func (b *broadcaster) publish(topic Topic, u Update) {
+	var lagging []*subscription
+	b.mu.RLock()
+	for sub := range b.subs {
+		if !sub.topics[topic] {
+			continue
+		}
+		select {
+		case sub.ch <- u:
+		default:
+			lagging = append(lagging, sub)
+		}
+	}
+	b.mu.RUnlock()
+
+	for _, sub := range lagging {
+		b.lggr.Warnw("Subscriber is falling behind, closing its subscription", "topic", topic)
+		b.unsubscribe(sub)
+	}
+}
+
+func (b *broadcaster) OnRunCreated(run *pipeline.Run) {
+	b.publish(TopicRuns, Update{TypeRunCreated, newRun(run)})
+}
+
+func (b *broadcaster) OnRunFinished(run *pipeline.Run) {
+	// runs waiting on async tasks are not completed yet
+	if run.Pending {
+		return
+	}
+	b.publish(TopicRuns, Update{TypeRunCompleted, newRun(run)})
+}
+
+func (b *broadcaster) OnTxEvent(e txmgr.TxEvent) {
+	b.publish(TopicTxes, Update{TypeTxState, Tx{
+		ID:                e.EthTxID,
+		EVMChainID:        utils.NewBig(e.EVMChainID),
+		From:              e.FromAddress,
+		To:                e.ToAddress,
+		State:             e.State,
+		Hash:              e.TxHash,
+		Error:             e.Error,
+		PipelineTaskRunID: e.PipelineTaskRunID,
+		Time:              e.Time,
+	}})
+}
+
+func (b *broadcaster) OnNewLongestChain(_ context.Context, head *evmtypes.Head) {
+	b.publish(TopicHeads, Update{TypeHead, Head{
+		EVMChainID: head.EVMChainID,
+		Number:     head.Number,
+		Hash:       head.Hash,
+		Timestamp:  head.Timestamp,
+	}})
+}
+
+func newRun(run *pipeline.Run) Run {
+	r := Run{
+		ID:             run.ID,
+		JobID:          run.PipelineSpec.JobID,
+		PipelineSpecID: run.PipelineSpecID,
+		State:          run.State,
+		CreatedAt:      run.CreatedAt,
+		FinishedAt:     run.FinishedAt,
+	}
+	if run.HasFatalErrors() {
+		r.Errors = run.StringFatalErrors()
+	}
+	return r
+}
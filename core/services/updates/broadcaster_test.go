@@ -0,0 +1,71 @@
+package updates_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/updates"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestBroadcaster(t *testing.T) {
+	t.Parallel()
+
+	b := updates.NewBroadcaster(logger.TestLogger(t))
+	chAll, unsubscribeAll := b.Subscribe()
+	defer unsubscribeAll()
+	chRuns, unsubscribeRuns := b.Subscribe(updates.TopicRuns)
+
+	run := &pipeline.Run{ID: 1, PipelineSpecID: 2, PipelineSpec: pipeline.Spec{JobID: 3}, State: pipeline.RunStatusRunning}
+	b.OnRunCreated(run)
+	// pending runs are not completed
+	run.Pending = true
+	b.OnRunFinished(run)
+	run.Pending, run.State = false, pipeline.RunStatusCompleted
+	b.OnRunFinished(run)
+	b.OnTxEvent(txmgr.TxEvent{EthTxID: 4, EVMChainID: big.NewInt(5), State: txmgr.EthTxConfirmed})
+	b.OnNewLongestChain(testutils.Context(t), &evmtypes.Head{Number: 6, EVMChainID: utils.NewBigI(5)})
+
+	assert.Equal(t, updates.Update{Type: updates.TypeRunCreated, Data: updates.Run{ID: 1, JobID: 3, PipelineSpecID: 2, State: pipeline.RunStatusRunning}}, <-chRuns)
+	assert.Equal(t, updates.Update{Type: updates.TypeRunCompleted, Data: updates.Run{ID: 1, JobID: 3, PipelineSpecID: 2, State: pipeline.RunStatusCompleted}}, <-chRuns)
+	assert.Empty(t, chRuns)
+
+	var types []string
+	for i := 0; i < 4; i++ {
+		types = append(types, (<-chAll).Type)
+	}
+	assert.Equal(t, []string{updates.TypeRunCreated, updates.TypeRunCompleted, updates.TypeTxState, updates.TypeHead}, types)
+
+	unsubscribeRuns()
+	_, ok := <-chRuns
+	assert.False(t, ok)
+	// unsubscribing twice is a noop
+	unsubscribeRuns()
+}
+
+func TestBroadcaster_SlowSubscriber(t *testing.T) {
+	t.Parallel()
+
+	b := updates.NewBroadcaster(logger.TestLogger(t))
+	ch, unsubscribe := b.Subscribe(updates.TopicHeads)
+	defer unsubscribe()
+
+	// publishing never blocks, the subscription is closed instead
+	for i := 0; i < 1000; i++ {
+		b.OnNewLongestChain(testutils.Context(t), &evmtypes.Head{Number: int64(i)})
+	}
+
+	var n int
+	for range ch {
+		n++
+	}
+	require.Equal(t, 100, n)
+}
@@ -159,7 +159,15 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 				newLogDeduper(int(chain.Config().EvmFinalityDepth())))}, nil
 		}
 		if _, ok := task.(*pipeline.VRFTask); ok {
-			return []job.ServiceCtx{&listenerV1{
+			var aggregator *aggregator_v3_interface.AggregatorV3Interface
+			if jb.VRFSpec.LinkEthFeedAddress != nil {
+				aggregator, err = aggregator_v3_interface.NewAggregatorV3Interface(jb.VRFSpec.LinkEthFeedAddress.Address(), chain.Client())
+				if err != nil {
+					return nil, errors.Wrap(err, "create LINK/ETH feed wrapper")
+				}
+			}
+
+			lsn := &listenerV1{
 				cfg:             chain.Config(),
 				l:               lV1,
 				headBroadcaster: chain.HeadBroadcaster(),
@@ -180,7 +188,14 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 				blockNumberToReqID: pairing.New(),
 				reqAdded:           func() {},
 				deduper:            newLogDeduper(int(chain.Config().EvmFinalityDepth())),
-			}}, nil
+				ethClient:          chain.Client(),
+				vrfks:              d.ks.VRF(),
+			}
+			// Avoid storing a typed nil in the interface field.
+			if aggregator != nil {
+				lsn.aggregator = aggregator
+			}
+			return []job.ServiceCtx{lsn}, nil
 		}
 	}
 	return nil, errors.New("invalid job spec expected a vrf task")
@@ -8,6 +8,7 @@ import (
 
 	"github.com/smartcontractkit/sqlx"
 
+	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/headtracker"
 	httypes "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/types"
@@ -31,9 +32,11 @@ import (
 	"github.com/smartcontractkit/chainlink/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -517,3 +520,97 @@ func TestFulfilledCheck(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, len(runs), 0)
 }
+
+func TestUnderpaidCheck(t *testing.T) {
+	vuni, listener, jb := setup(t)
+	listener.job.VRFSpec.MinContractPayment = assets.NewLinkFromJuels(100)
+	vuni.lb.On("WasAlreadyConsumed", mock.Anything, mock.Anything).Return(false, nil)
+	done := make(chan struct{})
+	vuni.lb.On("MarkConsumed", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		done <- struct{}{}
+	}).Return(nil).Once()
+	// Not fulfilled yet
+	vuni.ec.On("CallContract", mock.Anything, mock.Anything, mock.Anything).Return(generateCallbackReturnValues(t, false), nil)
+
+	added := make(chan struct{})
+	listener.reqAdded = func() {
+		added <- struct{}{}
+	}
+	requestID := utils.NewHash()
+	blockHash := utils.NewHash()
+	listener.HandleLog(log.NewLogBroadcast(
+		types.Log{
+			Data: bytes.Join([][]byte{
+				vuni.vrfkey.PublicKey.MustHash().Bytes(), // key hash
+				common.BigToHash(big.NewInt(42)).Bytes(), // seed
+				utils.NewHash().Bytes(),                  // sender
+				common.BigToHash(big.NewInt(99)).Bytes(), // fee
+				requestID.Bytes()},                       // requestID
+				[]byte{}),
+			Topics: []common.Hash{
+				VRFRandomnessRequestLogTopic(),
+				jb.ExternalIDEncodeBytesToTopic(), // jobID STRING
+			},
+			BlockNumber: 10,
+			BlockHash:   blockHash,
+		}, vuni.cid, nil))
+
+	waitForChannel(t, added, time.Second, "request not queued")
+	listener.OnNewLongestChain(testutils.Context(t), &evmtypes.Head{Number: 16})
+	waitForChannel(t, done, time.Second, "log not consumed")
+
+	// Should consume the log with no run, and record why once committed
+	testutils.AssertEventually(t, func() bool {
+		var status string
+		err := listener.q.Get(&status, `SELECT status FROM vrf_requests WHERE job_id = $1 AND request_id = $2 AND block_hash = $3`,
+			jb.ID, utils.NewBig(requestID.Big()), blockHash)
+		return err == nil && status == string(reasonUnderpaid)
+	})
+	runs, err := vuni.prm.GetAllRuns()
+	require.NoError(t, err)
+	require.Equal(t, len(runs), 0)
+}
+
+func TestEstimateFulfillmentGas(t *testing.T) {
+	vuni, listener, _ := setup(t)
+	configured := listener.cfg.EvmGasLimitDefault()
+	if listener.cfg.EvmGasLimitVRFJobType() != nil {
+		configured = *listener.cfg.EvmGasLimitVRFJobType()
+	}
+	req := request{req: &solidity_vrf_coordinator_interface.VRFCoordinatorRandomnessRequest{
+		KeyHash: vuni.vrfkey.PublicKey.MustHash(),
+		Seed:    big.NewInt(42),
+		Sender:  utils.RandomAddress(),
+		Raw:     types.Log{BlockNumber: 10, BlockHash: utils.NewHash()},
+	}}
+	isFulfillment := mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+		return msg.To != nil && *msg.To == listener.coordinator.Address() && len(msg.Data) > 0
+	})
+	ctx := testutils.Context(t)
+	lggr := logger.TestLogger(t)
+
+	t.Run("uses the estimate", func(t *testing.T) {
+		vuni.ec.On("EstimateGas", mock.Anything, isFulfillment).Return(uint64(123456), nil).Once()
+		assert.Equal(t, uint32(123456), listener.estimateFulfillmentGas(ctx, req, common.Address{}, lggr))
+	})
+
+	t.Run("caps the estimate at the configured limit", func(t *testing.T) {
+		vuni.ec.On("EstimateGas", mock.Anything, isFulfillment).Return(uint64(configured)+1, nil).Once()
+		assert.Equal(t, configured, listener.estimateFulfillmentGas(ctx, req, common.Address{}, lggr))
+	})
+
+	t.Run("falls back to the configured limit if the estimate fails", func(t *testing.T) {
+		vuni.ec.On("EstimateGas", mock.Anything, isFulfillment).Return(uint64(0), errors.New("execution reverted")).Once()
+		assert.Equal(t, configured, listener.estimateFulfillmentGas(ctx, req, common.Address{}, lggr))
+	})
+
+	t.Run("falls back to the configured limit if there is no key for the request", func(t *testing.T) {
+		unknownKey := req
+		unknownKey.req = &solidity_vrf_coordinator_interface.VRFCoordinatorRandomnessRequest{
+			KeyHash: utils.NewHash(),
+			Seed:    big.NewInt(42),
+			Raw:     types.Log{BlockNumber: 10, BlockHash: utils.NewHash()},
+		}
+		assert.Equal(t, configured, listener.estimateFulfillmentGas(ctx, unknownKey, common.Address{}, lggr))
+	})
+}
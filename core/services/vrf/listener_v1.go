@@ -8,19 +8,24 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
 	heaps "github.com/theodesp/go-heaps"
 	"github.com/theodesp/go-heaps/pairing"
 
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
 	httypes "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/types"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/gethwrappers/generated/aggregator_v3_interface"
 	"github.com/smartcontractkit/chainlink/core/gethwrappers/generated/solidity_vrf_coordinator_interface"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/recovery"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -78,6 +83,14 @@ type listenerV1 struct {
 
 	// deduper prevents processing duplicate requests from the log broadcaster.
 	deduper *logDeduper
+
+	// aggregator is the LINK/ETH feed used to check that a request's fee covers the
+	// fulfillment cost. It is nil if the job spec does not set linkEthFeedAddress.
+	aggregator aggregator_v3_interface.AggregatorV3InterfaceInterface
+	// ethClient and vrfks are used to estimate the gas of a request's fulfillment
+	// when checking it is covered by the fee.
+	ethClient evmclient.Client
+	vrfks     keystore.VRF
 }
 
 // Note that we have 2 seconds to do this processing
@@ -177,7 +190,7 @@ func (a fulfilledReq) Compare(b heaps.Item) int {
 	}
 }
 
-// Remove all entries 10000 blocks or older
+// Remove all entries requestPruneHorizon blocks or older
 // to avoid a memory leak.
 func (lsn *listenerV1) pruneConfirmedRequestCounts() {
 	lsn.respCountMu.Lock()
@@ -185,7 +198,7 @@ func (lsn *listenerV1) pruneConfirmedRequestCounts() {
 	min := lsn.blockNumberToReqID.FindMin()
 	for min != nil {
 		m := min.(fulfilledReq)
-		if m.blockNumber > (lsn.getLatestHead() - 10000) {
+		if m.blockNumber > (lsn.getLatestHead() - requestPruneHorizon) {
 			break
 		}
 		delete(lsn.respCount, m.reqID)
@@ -218,6 +231,11 @@ func (lsn *listenerV1) runHeadListener(unsubscribe func()) {
 				defer lsn.reqsMu.Unlock()
 				lsn.reqs = append(lsn.reqs, toRetry...)
 				lsn.pruneConfirmedRequestCounts()
+				if latestHead := lsn.getLatestHead(); latestHead > requestPruneHorizon {
+					if err := pruneObservedRequests(lsn.q, lsn.job.ID, latestHead-requestPruneHorizon); err != nil {
+						lsn.l.Errorw("Failed to prune skipped requests", "err", err)
+					}
+				}
 			})
 		}
 	}
@@ -402,6 +420,25 @@ func (lsn *listenerV1) ProcessRequest(ctx context.Context, req request) bool {
 		return true
 	}
 
+	// Check that the request pays enough to be worth fulfilling.
+	if underpaid, err := lsn.isUnderpaid(ctx, req, lggr); err != nil {
+		lggr.Warnw("Unable to check request payment, processing anyways", "err", err)
+	} else if underpaid {
+		err = lsn.q.Transaction(func(tx pg.Queryer) error {
+			reqID := new(big.Int).SetBytes(req.req.RequestID[:])
+			if err2 := recordSkippedRequest(lsn.q, lsn.job.ID, reqID, req.req.Raw.BlockHash, req.req.Raw.BlockNumber, reasonUnderpaid, pg.WithQueryer(tx)); err2 != nil {
+				return err2
+			}
+			return lsn.logBroadcaster.MarkConsumed(req.lb, pg.WithQueryer(tx))
+		})
+		if err != nil {
+			lggr.Errorw("Failed to record the underpaid request as skipped", "err", err)
+			return false
+		}
+		incDroppedReqs(lsn.job.Name.ValueOrZero(), lsn.job.ExternalJobID, v1, reasonUnderpaid)
+		return true
+	}
+
 	lggr.Infow("Processing log request")
 
 	vars := pipeline.NewVarsFrom(map[string]interface{}{
@@ -452,6 +489,105 @@ func (lsn *listenerV1) ProcessRequest(ctx context.Context, req request) bool {
 	return true
 }
 
+// isUnderpaid returns true if the request's fee is below the job's minimum contract payment, or,
+// if a LINK/ETH feed is configured, below the estimated cost of fulfilling it at the current gas price.
+func (lsn *listenerV1) isUnderpaid(ctx context.Context, req request, lggr logger.Logger) (bool, error) {
+	fee := req.req.Fee
+	if min := lsn.job.VRFSpec.MinContractPayment; min != nil && min.ToInt().Cmp(fee) > 0 {
+		lggr.Warnw("Request fee is below the minimum contract payment, skipping it",
+			"minContractPayment", min.String())
+		return true, nil
+	}
+	if lsn.aggregator == nil {
+		return false, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, callbacksTimeout)
+	defer cancel()
+
+	var fromAddress common.Address
+	if from := lsn.fromAddresses(); len(from) > 0 {
+		fromAddress = from[0]
+	}
+	gasLimit := lsn.estimateFulfillmentGas(ctx, req, fromAddress, lggr)
+	maxGasPriceWei := lsn.cfg.KeySpecificMaxGasPriceWei(fromAddress)
+	gasPriceWei, gasLimit, err := lsn.txm.GetGasEstimator().GetLegacyGas(nil, gasLimit, maxGasPriceWei)
+	if err != nil {
+		return false, errors.Wrap(err, "estimate gas price")
+	}
+
+	roundData, err := lsn.aggregator.LatestRoundData(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return false, errors.Wrap(err, "get aggregator latestRoundData")
+	}
+	costJuels, err := EstimateFulfillmentCostJuels(gasLimit, gasPriceWei, roundData.Answer)
+	if err != nil {
+		return false, err
+	}
+	if costJuels.Cmp(fee) > 0 {
+		lggr.Warnw("Request fee does not cover the estimated fulfillment cost, skipping it",
+			"gasLimit", gasLimit,
+			"gasPriceWei", gasPriceWei,
+			"weiPerUnitLink", roundData.Answer,
+			"estimatedCostJuels", costJuels)
+		return true, nil
+	}
+	return false, nil
+}
+
+// estimateFulfillmentGas estimates the gas of the request's fulfillRandomnessRequest
+// call with eth_estimateGas, falling back to the configured gas limit of VRF jobs if the
+// fulfillment cannot be simulated.
+func (lsn *listenerV1) estimateFulfillmentGas(ctx context.Context, req request, from common.Address, lggr logger.Logger) uint32 {
+	gasLimit := lsn.cfg.EvmGasLimitDefault()
+	if lsn.cfg.EvmGasLimitVRFJobType() != nil {
+		gasLimit = *lsn.cfg.EvmGasLimitVRFJobType()
+	}
+	if lsn.ethClient == nil || lsn.vrfks == nil {
+		return gasLimit
+	}
+
+	fulfillment, err := SimulateFulfillment(lsn.vrfks, SimulationRequest{
+		KeyHash:   req.req.KeyHash,
+		Seed:      req.req.Seed,
+		Sender:    req.req.Sender,
+		BlockHash: req.req.Raw.BlockHash,
+		BlockNum:  req.req.Raw.BlockNumber,
+	})
+	if err != nil {
+		lggr.Warnw("Could not build the fulfillment, using the configured gas limit", "err", err, "gasLimit", gasLimit)
+		return gasLimit
+	}
+	coordinator := lsn.coordinator.Address()
+	estimate, err := lsn.ethClient.EstimateGas(ctx, ethereum.CallMsg{
+		From: from,
+		To:   &coordinator,
+		Data: fulfillment.FulfillCalldata,
+	})
+	if err != nil {
+		lggr.Warnw("Could not estimate the fulfillment gas, using the configured gas limit", "err", err, "gasLimit", gasLimit)
+		return gasLimit
+	}
+	if estimate > uint64(gasLimit) {
+		// The fulfillment is sent with the configured gas limit, so it cannot cost more.
+		return gasLimit
+	}
+	return uint32(estimate)
+}
+
+// EstimateFulfillmentCostJuels returns the cost in juels of a fulfillment transaction using
+// gasLimit gas at gasPriceWei, given the LINK/ETH price weiPerUnitLink.
+func EstimateFulfillmentCostJuels(gasLimit uint32, gasPriceWei, weiPerUnitLink *big.Int) (*big.Int, error) {
+	if weiPerUnitLink.Sign() <= 0 {
+		return nil, errors.New("wei per unit link must be positive")
+	}
+	costWei := new(big.Int).Mul(big.NewInt(int64(gasLimit)), gasPriceWei)
+	// Multiply by 1e18 first so that we don't lose digits to truncation when we divide
+	// by weiPerUnitLink
+	numerator := costWei.Mul(costWei, big.NewInt(1e18))
+	return numerator.Quo(numerator, weiPerUnitLink), nil
+}
+
 // Close complies with job.Service
 func (lsn *listenerV1) Close() error {
 	return lsn.StopOnce("VRFListener", func() error {
@@ -273,6 +273,44 @@ func (lsn *listenerV2) setLatestHead(head *evmtypes.Head) {
 // OnNewLongestChain is called by the head broadcaster when a new head is available.
 func (lsn *listenerV2) OnNewLongestChain(ctx context.Context, head *evmtypes.Head) {
 	lsn.setLatestHead(head)
+	lsn.dropOrphanedRequests(head)
+}
+
+// dropOrphanedRequests drops pending requests whose block is no longer part of the canonical
+// chain. If such a request is re-mined, the log broadcaster delivers it again in its new block,
+// and fulfillment transactions orphaned by the reorg are rebroadcast by the txm.
+func (lsn *listenerV2) dropOrphanedRequests(head *evmtypes.Head) {
+	orphaned := lsn.removePendingRequests(func(r pendingRequest) bool {
+		canonical := head.HashAtHeight(int64(r.req.Raw.BlockNumber))
+		return canonical != (common.Hash{}) && canonical != r.req.Raw.BlockHash
+	})
+	for _, r := range orphaned {
+		lsn.l.Warnw("Dropping request from orphaned block",
+			"reqID", r.req.RequestId.String(),
+			"blockNumber", r.req.Raw.BlockNumber,
+			"blockHash", r.req.Raw.BlockHash,
+			"canonicalBlockHash", head.HashAtHeight(int64(r.req.Raw.BlockNumber)))
+		incDroppedReqs(lsn.job.Name.ValueOrZero(), lsn.job.ExternalJobID, v2, reasonReorg)
+		if err := deleteObservedRequest(lsn.q, lsn.job.ID, r.req.RequestId, r.req.Raw.BlockHash); err != nil {
+			lsn.l.Errorw("Failed to delete orphaned request", "err", err, "reqID", r.req.RequestId.String())
+		}
+	}
+}
+
+// removePendingRequests removes and returns all pending requests matching the given predicate.
+func (lsn *listenerV2) removePendingRequests(remove func(pendingRequest) bool) (removed []pendingRequest) {
+	lsn.reqsMu.Lock()
+	defer lsn.reqsMu.Unlock()
+	var toKeep []pendingRequest
+	for _, r := range lsn.reqs {
+		if remove(r) {
+			removed = append(removed, r)
+		} else {
+			toKeep = append(toKeep, r)
+		}
+	}
+	lsn.reqs = toKeep
+	return removed
 }
 
 func (lsn *listenerV2) getLatestHead() uint64 {
@@ -331,7 +369,7 @@ func nextTry(retries int, initial, max time.Duration, last time.Time) time.Time
 	return last.Add(delay)
 }
 
-// Remove all entries 10000 blocks or older
+// Remove all entries requestPruneHorizon blocks or older
 // to avoid a memory leak.
 func (lsn *listenerV2) pruneConfirmedRequestCounts() {
 	lsn.respCountMu.Lock()
@@ -339,7 +377,7 @@ func (lsn *listenerV2) pruneConfirmedRequestCounts() {
 	min := lsn.blockNumberToReqID.FindMin()
 	for min != nil {
 		m := min.(fulfilledReqV2)
-		if m.blockNumber > (lsn.getLatestHead() - 10000) {
+		if m.blockNumber > (lsn.getLatestHead() - requestPruneHorizon) {
 			break
 		}
 		delete(lsn.respCount, m.reqID)
@@ -412,6 +450,11 @@ func (lsn *listenerV2) processPendingVRFRequests(ctx context.Context) {
 		lsn.l.Infow("No pending requests ready for processing")
 		return
 	}
+	// The requests are kept until the job is within its monthly budget again
+	if budgets := lsn.pipelineRunner.Budgets(); budgets != nil && budgets.Exceeded(lsn.job.ID) {
+		lsn.l.Warnw("Job exceeded its monthly budget, skipping fulfillments", "jobID", lsn.job.ID)
+		return
+	}
 	for subID, reqs := range confirmed {
 		sub, err := lsn.coordinator.GetSubscription(&bind.CallOpts{
 			Context: ctx,
@@ -446,6 +489,11 @@ func (lsn *listenerV2) processPendingVRFRequests(ctx context.Context) {
 		}
 	}
 	lsn.pruneConfirmedRequestCounts()
+	if latestHead := lsn.getLatestHead(); latestHead > requestPruneHorizon {
+		if err := pruneObservedRequests(lsn.q, lsn.job.ID, latestHead-requestPruneHorizon); err != nil {
+			lsn.l.Errorw("Failed to prune observed requests", "err", err)
+		}
+	}
 }
 
 // MaybeSubtractReservedLink figures out how much LINK is reserved for other VRF requests that
@@ -803,6 +851,7 @@ func (lsn *listenerV2) processRequestsPerSub(
 					EncodedPayload: hexutil.MustDecode(p.payload),
 					GasLimit:       p.gasLimit,
 					Meta: &txmgr.EthTxMeta{
+						JobID:         &lsn.job.ID,
 						RequestID:     &requestID,
 						MaxLink:       &maxLinkString,
 						SubID:         &p.req.req.SubId,
@@ -1153,6 +1202,23 @@ func (lsn *listenerV2) handleLog(lb log.Broadcast, minConfs uint32) {
 		return
 	}
 
+	prevBlockHash, err := upsertObservedRequest(lsn.q, lsn.job.ID, req.RequestId, req.Raw.BlockHash, req.Raw.BlockNumber)
+	if err != nil {
+		lsn.l.Errorw("Failed to record request", "err", err, "reqID", req.RequestId.String(), "txHash", req.Raw.TxHash)
+	} else if prevBlockHash != nil && *prevBlockHash != req.Raw.BlockHash {
+		// The request was re-mined in a different block after a reorg. Any copy still pending from
+		// the previous block would produce a proof for the wrong block hash, so drop it.
+		stale := lsn.removePendingRequests(func(r pendingRequest) bool {
+			return r.req.RequestId.Cmp(req.RequestId) == 0 && r.req.Raw.BlockHash == *prevBlockHash
+		})
+		lsn.l.Warnw("Request re-emitted in a different block after reorg",
+			"reqID", req.RequestId.String(),
+			"prevBlockHash", *prevBlockHash,
+			"blockHash", req.Raw.BlockHash,
+			"blockNumber", req.Raw.BlockNumber,
+			"droppedStale", len(stale))
+	}
+
 	confirmedAt := lsn.getConfirmedAt(req, minConfs)
 	lsn.l.Infow("VRFListenerV2: Received log request", "reqID", req.RequestId, "confirmedAt", confirmedAt, "subID", req.SubId, "sender", req.Sender)
 	lsn.reqsMu.Lock()
@@ -29,3 +29,17 @@ func TestListener_EstimateFeeJuels(t *testing.T) {
 	require.Nil(t, actual)
 	require.Error(t, err)
 }
+
+func TestListener_EstimateFulfillmentCostJuels(t *testing.T) {
+	gasLimit := uint32(500_000)
+	gasPriceWei := assets.GWei(30)
+	weiPerUnitLink := big.NewInt(5898160000000000)
+	actual, err := vrf.EstimateFulfillmentCostJuels(gasLimit, gasPriceWei, weiPerUnitLink)
+	require.NoError(t, err)
+	expected := big.NewInt(2543166004313209543)
+	require.True(t, actual.Cmp(expected) == 0, "expected:", expected.String(), "actual:", actual.String())
+
+	actual, err = vrf.EstimateFulfillmentCostJuels(gasLimit, gasPriceWei, big.NewInt(0))
+	require.Nil(t, actual)
+	require.Error(t, err)
+}
@@ -17,6 +17,8 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/job"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
@@ -266,3 +268,68 @@ func TestListener_Backoff(t *testing.T) {
 		})
 	}
 }
+
+func TestListener_DropOrphanedRequests(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	vuni := buildVrfUni(t, db, cfg)
+	j, err := ValidatedVRFSpec(testspecs.GenerateVRFSpec(testspecs.VRFSpecParams{
+		PublicKey: vuni.vrfkey.PublicKey.String(),
+	}).Toml())
+	require.NoError(t, err)
+	require.NoError(t, vuni.jrm.CreateJob(&j))
+
+	lggr := logger.TestLogger(t)
+	listener := &listenerV2{
+		l:   lggr,
+		q:   pg.NewQ(db, lggr, cfg),
+		job: j,
+	}
+
+	newReq := func(reqID int64, blockNumber uint64, blockHash common.Hash) pendingRequest {
+		req := pendingRequest{req: &vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{
+			RequestId: big.NewInt(reqID),
+			Raw: types.Log{
+				BlockNumber: blockNumber,
+				BlockHash:   blockHash,
+			},
+		}}
+		prev, err2 := upsertObservedRequest(listener.q, j.ID, req.req.RequestId, blockHash, blockNumber)
+		require.NoError(t, err2)
+		require.Nil(t, prev)
+		return req
+	}
+
+	h9, h10, h10Reorged := utils.NewHash(), utils.NewHash(), utils.NewHash()
+	listener.reqs = []pendingRequest{
+		newReq(1, 9, h9),
+		newReq(2, 10, h10),
+		// Block 5 is older than the head chain we know about, so it is kept.
+		newReq(3, 5, utils.NewHash()),
+	}
+
+	head := &evmtypes.Head{Number: 11, Hash: utils.NewHash(), Parent: &evmtypes.Head{
+		Number: 10, Hash: h10Reorged, Parent: &evmtypes.Head{
+			Number: 9, Hash: h9,
+		},
+	}}
+	listener.dropOrphanedRequests(head)
+
+	require.Len(t, listener.reqs, 2)
+	assert.Equal(t, int64(1), listener.reqs[0].req.RequestId.Int64())
+	assert.Equal(t, int64(3), listener.reqs[1].req.RequestId.Int64())
+
+	var count int
+	require.NoError(t, db.Get(&count, `SELECT count(*) FROM vrf_requests WHERE job_id = $1`, j.ID))
+	assert.Equal(t, 2, count)
+
+	// The orphaned request is re-mined in the canonical block.
+	prev, err := upsertObservedRequest(listener.q, j.ID, big.NewInt(1), h10Reorged, 10)
+	require.NoError(t, err)
+	require.NotNil(t, prev)
+	assert.Equal(t, h9, *prev)
+
+	require.NoError(t, pruneObservedRequests(listener.q, j.ID, 6))
+	require.NoError(t, db.Get(&count, `SELECT count(*) FROM vrf_requests WHERE job_id = $1`, j.ID))
+	assert.Equal(t, 1, count)
+}
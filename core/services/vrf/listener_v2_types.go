@@ -157,6 +157,7 @@ func (lsn *listenerV2) processBatch(
 			GasLimit:       totalGasLimitBumped,
 			Strategy:       txmgr.NewSendEveryStrategy(),
 			Meta: &txmgr.EthTxMeta{
+				JobID:           &lsn.job.ID,
 				RequestIDs:      reqIDHashes,
 				MaxLink:         &maxLinkStr,
 				SubID:           &subID,
@@ -26,6 +26,14 @@ const (
 
 	// reasonAge describes when a VRF request is dropped due to its age.
 	reasonAge dropReason = "age"
+
+	// reasonUnderpaid describes when a VRF request is dropped because its fee is below the
+	// job's minimum payment or the estimated fulfillment cost.
+	reasonUnderpaid dropReason = "underpaid"
+
+	// reasonReorg describes when a VRF request is dropped because the block it was included in
+	// is no longer part of the canonical chain.
+	reasonReorg dropReason = "reorg"
 )
 
 var (
@@ -0,0 +1,64 @@
+package vrf
+
+import (
+	"database/sql"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// The vrf_requests table records the block each VRF v2 request was last observed in, so that
+// the listener can tell when a request has been re-emitted in a different block after a reorg,
+// even across node restarts. It also records the VRF v1 requests skipped without being
+// fulfilled, with their dropReason as status instead of "observed".
+
+// upsertObservedRequest records the block a request was observed in, and returns the block
+// hash it was previously recorded with, if any.
+func upsertObservedRequest(q pg.Q, jobID int32, requestID *big.Int, blockHash common.Hash, blockNumber uint64) (prevBlockHash *common.Hash, err error) {
+	err = q.Transaction(func(tx pg.Queryer) error {
+		var prev common.Hash
+		err2 := tx.Get(&prev, `SELECT block_hash FROM vrf_requests WHERE job_id = $1 AND request_id = $2 FOR UPDATE`,
+			jobID, utils.NewBig(requestID))
+		if err2 == nil {
+			prevBlockHash = &prev
+		} else if !errors.Is(err2, sql.ErrNoRows) {
+			return err2
+		}
+		_, err2 = tx.Exec(`INSERT INTO vrf_requests (job_id, request_id, block_hash, block_number, created_at)
+VALUES ($1, $2, $3, $4, NOW())
+ON CONFLICT (job_id, request_id) DO UPDATE SET block_hash = EXCLUDED.block_hash, block_number = EXCLUDED.block_number`,
+			jobID, utils.NewBig(requestID), blockHash, blockNumber)
+		return err2
+	})
+	return prevBlockHash, errors.Wrap(err, "upsertObservedRequest failed")
+}
+
+// recordSkippedRequest records a request skipped without being fulfilled, and the reason why.
+func recordSkippedRequest(q pg.Q, jobID int32, requestID *big.Int, blockHash common.Hash, blockNumber uint64, reason dropReason, qopts ...pg.QOpt) error {
+	err := q.WithOpts(qopts...).ExecQ(`INSERT INTO vrf_requests (job_id, request_id, block_hash, block_number, status, created_at)
+VALUES ($1, $2, $3, $4, $5, NOW())
+ON CONFLICT (job_id, request_id) DO UPDATE SET block_hash = EXCLUDED.block_hash, block_number = EXCLUDED.block_number, status = EXCLUDED.status`,
+		jobID, utils.NewBig(requestID), blockHash, blockNumber, string(reason))
+	return errors.Wrap(err, "recordSkippedRequest failed")
+}
+
+// deleteObservedRequest removes a request, provided it is still recorded in the given block.
+func deleteObservedRequest(q pg.Q, jobID int32, requestID *big.Int, blockHash common.Hash) error {
+	err := q.ExecQ(`DELETE FROM vrf_requests WHERE job_id = $1 AND request_id = $2 AND block_hash = $3`,
+		jobID, utils.NewBig(requestID), blockHash)
+	return errors.Wrap(err, "deleteObservedRequest failed")
+}
+
+// requestPruneHorizon is how many blocks the listeners keep track of a request
+// for, both in memory and in vrf_requests, before pruning it.
+const requestPruneHorizon = 10000
+
+// pruneObservedRequests removes all requests observed in blocks before the given block number.
+func pruneObservedRequests(q pg.Q, jobID int32, beforeBlock uint64) error {
+	err := q.ExecQ(`DELETE FROM vrf_requests WHERE job_id = $1 AND block_number < $2`, jobID, beforeBlock)
+	return errors.Wrap(err, "pruneObservedRequests failed")
+}
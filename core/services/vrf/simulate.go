@@ -0,0 +1,106 @@
+package vrf
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/gethwrappers/generated/solidity_vrf_consumer_interface"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/vrf/proof"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var consumerABI = evmtypes.MustGetABI(solidity_vrf_consumer_interface.VRFConsumerABI)
+
+// SimulationRequest describes a VRF v1 RandomnessRequest to simulate a
+// fulfillment for.
+type SimulationRequest struct {
+	KeyHash   common.Hash
+	Seed      *big.Int // pre-seed, as emitted in the RandomnessRequest log
+	Sender    common.Address
+	BlockHash common.Hash // hash of the block containing the request
+	BlockNum  uint64      // number of the block containing the request
+}
+
+// SimulatedFulfillment is what the node would submit in response to a
+// SimulationRequest. Nothing is sent on-chain.
+type SimulatedFulfillment struct {
+	RequestID  common.Hash
+	KeyID      string
+	Proof      []byte   // marshaled proof passed to the coordinator
+	Randomness *big.Int // VRF output delivered to the consumer
+	// FulfillCalldata is the calldata for VRFCoordinator.fulfillRandomnessRequest
+	FulfillCalldata []byte
+	// CallbackCalldata is the calldata the coordinator sends to
+	// CallbackAddress.rawFulfillRandomness
+	CallbackCalldata []byte
+	CallbackAddress  common.Address
+}
+
+// SimulateFulfillment generates the proof and calldata a VRF v1 job would use
+// to fulfill req with the key matching req.KeyHash.
+func SimulateFulfillment(ks keystore.VRF, req SimulationRequest) (SimulatedFulfillment, error) {
+	if req.Seed == nil {
+		return SimulatedFulfillment{}, errors.New("seed is required")
+	}
+	if req.BlockHash == (common.Hash{}) {
+		return SimulatedFulfillment{}, errors.New("blockHash is required")
+	}
+	preSeed, err := proof.BigToSeed(req.Seed)
+	if err != nil {
+		return SimulatedFulfillment{}, errors.Wrap(err, "invalid seed")
+	}
+
+	keys, err := ks.GetAll()
+	if err != nil {
+		return SimulatedFulfillment{}, errors.Wrap(err, "could not load VRF keys")
+	}
+	var keyID string
+	for _, k := range keys {
+		if k.PublicKey.MustHash() == req.KeyHash {
+			keyID = k.ID()
+			break
+		}
+	}
+	if keyID == "" {
+		return SimulatedFulfillment{}, errors.Errorf("no VRF key found with hash %s", req.KeyHash)
+	}
+
+	s := proof.PreSeedData{PreSeed: preSeed, BlockHash: req.BlockHash, BlockNum: req.BlockNum}
+	p, err := ks.GenerateProof(keyID, proof.FinalSeed(s))
+	if err != nil {
+		return SimulatedFulfillment{}, errors.Wrap(err, "could not generate proof")
+	}
+	onChainProof, err := proof.GenerateProofResponseFromProof(p, s)
+	if err != nil {
+		return SimulatedFulfillment{}, errors.Wrap(err, "could not marshal proof")
+	}
+
+	soliditySeed, err := utils.Uint256ToBytes(req.Seed)
+	if err != nil {
+		return SimulatedFulfillment{}, errors.Wrap(err, "invalid seed")
+	}
+	requestID := utils.MustHash(string(append(req.KeyHash[:], soliditySeed...)))
+
+	fulfillCalldata, err := coordinatorABIValues().coordinatorABI.Pack(fulfillMethodName, onChainProof[:])
+	if err != nil {
+		return SimulatedFulfillment{}, errors.Wrap(err, "could not pack fulfillRandomnessRequest calldata")
+	}
+	callbackCalldata, err := consumerABI.Pack("rawFulfillRandomness", requestID, p.Output)
+	if err != nil {
+		return SimulatedFulfillment{}, errors.Wrap(err, "could not pack rawFulfillRandomness calldata")
+	}
+
+	return SimulatedFulfillment{
+		RequestID:        requestID,
+		KeyID:            keyID,
+		Proof:            onChainProof[:],
+		Randomness:       p.Output,
+		FulfillCalldata:  fulfillCalldata,
+		CallbackCalldata: callbackCalldata,
+		CallbackAddress:  req.Sender,
+	}, nil
+}
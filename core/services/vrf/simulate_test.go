@@ -0,0 +1,75 @@
+package vrf_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/services/vrf"
+	"github.com/smartcontractkit/chainlink/core/services/vrf/proof"
+)
+
+func TestSimulateFulfillment(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	ks := cltest.NewKeyStore(t, db, cfg)
+	key, err := ks.VRF().Create()
+	require.NoError(t, err)
+
+	req := vrf.SimulationRequest{
+		KeyHash:   key.PublicKey.MustHash(),
+		Seed:      big.NewInt(42),
+		Sender:    common.HexToAddress("0x2bB4B32d1C6b3C9eB6C1e0E8F6bA0Ba6C0aB1e47"),
+		BlockHash: common.HexToHash("0x1234"),
+		BlockNum:  100,
+	}
+
+	t.Run("generates a verifiable proof and calldata", func(t *testing.T) {
+		f, err := vrf.SimulateFulfillment(ks.VRF(), req)
+		require.NoError(t, err)
+
+		assert.Equal(t, key.ID(), f.KeyID)
+		assert.Equal(t, req.Sender, f.CallbackAddress)
+		log := vrf.RandomnessRequestLog{KeyHash: req.KeyHash, Seed: req.Seed}
+		assert.Equal(t, log.ComputedRequestID(), f.RequestID)
+
+		var onChain proof.MarshaledOnChainResponse
+		require.Len(t, f.Proof, proof.OnChainResponseLength)
+		copy(onChain[:], f.Proof)
+		resp, err := proof.UnmarshalProofResponse(onChain)
+		require.NoError(t, err)
+		assert.Equal(t, req.BlockNum, resp.BlockNum)
+		preSeed, err := proof.BigToSeed(req.Seed)
+		require.NoError(t, err)
+		p, err := resp.CryptoProof(proof.PreSeedData{PreSeed: preSeed, BlockHash: req.BlockHash, BlockNum: req.BlockNum})
+		require.NoError(t, err)
+		assert.Equal(t, p.Output, f.Randomness)
+
+		// 4 byte selector, offset, length and the proof itself
+		assert.Len(t, f.FulfillCalldata, 4+32+32+proof.OnChainResponseLength+(32-proof.OnChainResponseLength%32)%32)
+		// 4 byte selector, requestId and randomness
+		assert.Len(t, f.CallbackCalldata, 4+32+32)
+		assert.Equal(t, f.RequestID.Bytes(), f.CallbackCalldata[4:36])
+		assert.Equal(t, f.Randomness, new(big.Int).SetBytes(f.CallbackCalldata[36:]))
+	})
+
+	t.Run("unknown key hash", func(t *testing.T) {
+		r := req
+		r.KeyHash = common.HexToHash("0xdeadbeef")
+		_, err := vrf.SimulateFulfillment(ks.VRF(), r)
+		require.ErrorContains(t, err, "no VRF key found")
+	})
+
+	t.Run("missing block hash", func(t *testing.T) {
+		r := req
+		r.BlockHash = common.Hash{}
+		_, err := vrf.SimulateFulfillment(ks.VRF(), r)
+		require.ErrorContains(t, err, "blockHash is required")
+	})
+}
@@ -30,6 +30,8 @@ chunkSize = 25
 backoffInitialDelay = "1m"
 backoffMaxDelay = "2h"
 maxGasPriceGWei = 200
+minContractPaymentLinkJuels = "100000000000000000"
+linkEthFeedAddress = "0x9FBDa871d559710256a2502A2517b794B482Db40"
 observationSource = """
 decode_log   [type=ethabidecodelog
               abi="RandomnessRequest(bytes32 keyHash,uint256 seed,bytes32 indexed jobID,address sender,uint256 fee,bytes32 requestID)"
@@ -56,6 +58,8 @@ decode_log->vrf->encode_tx->submit_tx
 				assert.Equal(t, "0xB3b7874F13387D44a3398D298B075B7A3505D8d4", s.VRFSpec.CoordinatorAddress.String())
 				assert.Equal(t, "0x79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f8179800", s.VRFSpec.PublicKey.String())
 				assert.Equal(t, uint32(200), *s.VRFSpec.MaxGasPriceGWei)
+				assert.Equal(t, "100000000000000000", s.VRFSpec.MinContractPayment.ToInt().String())
+				assert.Equal(t, "0x9FBDa871d559710256a2502A2517b794B482Db40", s.VRFSpec.LinkEthFeedAddress.String())
 				require.Equal(t, 168*time.Hour, s.VRFSpec.RequestTimeout)
 				require.Equal(t, time.Minute, s.VRFSpec.BackoffInitialDelay)
 				require.Equal(t, 2*time.Hour, s.VRFSpec.BackoffMaxDelay)
@@ -469,6 +473,8 @@ decode_log->vrf->encode_tx->submit_tx
 				assert.Equal(t, "0xB3b7874F13387D44a3398D298B075B7A3505D8d4", s.VRFSpec.CoordinatorAddress.String())
 				assert.Equal(t, "0x79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f8179800", s.VRFSpec.PublicKey.String())
 				assert.Nil(t, s.VRFSpec.MaxGasPriceGWei)
+				assert.Nil(t, s.VRFSpec.MinContractPayment)
+				assert.Nil(t, s.VRFSpec.LinkEthFeedAddress)
 				require.Equal(t, 168*time.Hour, s.VRFSpec.RequestTimeout)
 				require.Equal(t, time.Minute, s.VRFSpec.BackoffInitialDelay)
 				require.Equal(t, 2*time.Hour, s.VRFSpec.BackoffMaxDelay)
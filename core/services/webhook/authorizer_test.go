@@ -21,7 +21,7 @@ import (
 )
 
 func newBridgeORM(t *testing.T, db *sqlx.DB, cfg pg.LogConfig) bridges.ORM {
-	return bridges.NewORM(db, logger.TestLogger(t), cfg)
+	return bridges.NewORM(db, logger.TestLogger(t), cfg, nil)
 }
 
 type eiEnabledCfg struct{}
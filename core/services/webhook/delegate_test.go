@@ -2,22 +2,27 @@ package webhook_test
 
 import (
 	"testing"
+	"time"
 
 	uuid "github.com/satori/go.uuid"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/pkg/errors"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pipelinetest"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	pipelinemocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
 	webhookmocks "github.com/smartcontractkit/chainlink/core/services/webhook/mocks"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
 func TestWebhookDelegate(t *testing.T) {
@@ -93,3 +98,57 @@ func TestWebhookDelegate(t *testing.T) {
 	_, err = delegate.WebhookJobRunner().RunJob(testutils.Context(t), spec.ExternalJobID, requestBody, meta)
 	require.Equal(t, webhook.ErrJobNotExists, errors.Cause(err))
 }
+
+func TestWebhookDelegate_InMemoryORM(t *testing.T) {
+	// The runs are executed by the pipeline runner and stored without a database
+	orm := pipelinetest.NewInMemoryORM(t)
+	lggr := logger.TestLogger(t)
+	runner := pipeline.NewRunner(orm, configtest.NewTestGeneralConfig(t), nil, nil, nil, lggr, nil, nil)
+	delegate := webhook.NewDelegate(runner, new(webhookmocks.ExternalInitiatorManager), lggr)
+
+	source := `
+parse [type=jsonparse data="$(jobRun.requestBody)" path="value"];
+meta  [type=memo value=<$(jobRun.meta)>];
+`
+	p, err := pipeline.Parse(source)
+	require.NoError(t, err)
+	specID, err := orm.CreateSpec(*p, models.Interval(time.Minute))
+	require.NoError(t, err)
+	spec := job.Job{
+		ID:             1,
+		Type:           job.Webhook,
+		SchemaVersion:  1,
+		ExternalJobID:  uuid.NewV4(),
+		WebhookSpec:    &job.WebhookSpec{},
+		PipelineSpecID: specID,
+		PipelineSpec:   &pipeline.Spec{ID: specID, JobID: 1, DotDagSource: source},
+	}
+
+	services, err := delegate.ServicesForSpec(spec)
+	require.NoError(t, err)
+	require.Len(t, services, 1)
+	require.NoError(t, services[0].Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, services[0].Close()) })
+
+	meta := pipeline.JSONSerializable{Val: "bar", Valid: true}
+	runID, err := delegate.WebhookJobRunner().RunJob(testutils.Context(t), spec.ExternalJobID, `{"value": "foo"}`, meta)
+	require.NoError(t, err)
+
+	run, err := orm.FindRun(runID)
+	require.NoError(t, err)
+	assert.Equal(t, pipeline.RunStatusCompleted, run.State)
+	assert.Equal(t, specID, run.PipelineSpecID)
+	assert.ElementsMatch(t, []interface{}{"foo", "bar"}, run.Outputs.Val)
+	assert.Len(t, run.PipelineTaskRuns, 2)
+
+	// The failed runs are stored too
+	runID, err = delegate.WebhookJobRunner().RunJob(testutils.Context(t), spec.ExternalJobID, `{}`, meta)
+	require.NoError(t, err)
+	run, err = orm.FindRun(runID)
+	require.NoError(t, err)
+	assert.Equal(t, pipeline.RunStatusErrored, run.State)
+
+	runs, err := orm.GetAllRuns()
+	require.NoError(t, err)
+	assert.Len(t, runs, 2)
+}
@@ -36,16 +36,19 @@ type HTTPClient interface {
 type externalInitiatorManager struct {
 	q          pg.Q
 	httpclient HTTPClient
+	ciphers    *pg.ColumnCiphers
 }
 
 var _ ExternalInitiatorManager = (*externalInitiatorManager)(nil)
 
-// NewExternalInitiatorManager returns the concrete externalInitiatorManager
-func NewExternalInitiatorManager(db *sqlx.DB, httpclient HTTPClient, lggr logger.Logger, cfg pg.LogConfig) *externalInitiatorManager {
+// NewExternalInitiatorManager returns the concrete externalInitiatorManager,
+// decrypting the outgoing tokens of the external initiators with ciphers
+func NewExternalInitiatorManager(db *sqlx.DB, httpclient HTTPClient, lggr logger.Logger, cfg pg.LogConfig, ciphers *pg.ColumnCiphers) *externalInitiatorManager {
 	namedLogger := lggr.Named("ExternalInitiatorManager")
 	return &externalInitiatorManager{
 		q:          pg.NewQ(db, namedLogger, cfg),
 		httpclient: httpclient,
+		ciphers:    ciphers,
 	}
 }
 
@@ -123,6 +126,11 @@ func (m externalInitiatorManager) eagerLoadExternalInitiator(q pg.Queryer, txs [
 	if err := sqlx.Select(q, &externalInitiators, `SELECT * FROM external_initiators WHERE external_initiators.id = ANY($1);`, pq.Array(ids)); err != nil {
 		return err
 	}
+	for i := range externalInitiators {
+		if err := externalInitiators[i].DecryptSecrets(m.ciphers); err != nil {
+			return err
+		}
+	}
 
 	eiMap := make(map[int64]bridges.ExternalInitiator)
 	for _, externalInitiator := range externalInitiators {
@@ -167,7 +175,10 @@ func (m externalInitiatorManager) DeleteJob(webhookSpecID int32) error {
 func (m externalInitiatorManager) FindExternalInitiatorByName(name string) (bridges.ExternalInitiator, error) {
 	var exi bridges.ExternalInitiator
 	err := m.q.Get(&exi, "SELECT * FROM external_initiators WHERE lower(external_initiators.name) = lower($1)", name)
-	return exi, err
+	if err != nil {
+		return exi, err
+	}
+	return exi, exi.DecryptSecrets(m.ciphers)
 }
 
 // JobSpecNotice is sent to the External Initiator when JobSpecs are created.
@@ -0,0 +1,87 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"strings"
+
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// DefaultPublicTriggerRateLimit is the number of runs per minute a public
+// trigger URL starts at most, unless the job sets publicTriggerRateLimit
+const DefaultPublicTriggerRateLimit = 60
+
+// PublicTriggerSignatureHeader is the header of the signature of the requests
+// to the public trigger URLs with a secret, "sha256=" followed by the hex
+// encoded HMAC-SHA256 of the body, as sent by GitHub webhooks
+const PublicTriggerSignatureHeader = "X-Hub-Signature-256"
+
+// PublicTrigger is the public trigger URL of a webhook job
+type PublicTrigger struct {
+	ExternalJobID uuid.UUID
+	// RateLimit is the maximum number of runs per minute
+	RateLimit int64
+	// Secret is the key of the HMAC signing the requests, empty if they are
+	// not signed
+	Secret string
+}
+
+// NewPublicTriggerToken returns a random token for the path of a public
+// trigger URL
+func NewPublicTriggerToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "failed to generate public trigger token")
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// HashPublicTriggerToken returns the hash the token is stored as
+func HashPublicTriggerToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// FindPublicTrigger returns the public trigger of the job with the token, its
+// secret decrypted with ciphers, or sql.ErrNoRows if there is none
+func FindPublicTrigger(ctx context.Context, db *sql.DB, ciphers *pg.ColumnCiphers, token string) (trigger PublicTrigger, err error) {
+	row := db.QueryRowContext(ctx, `
+SELECT jobs.external_job_id, webhook_specs.public_trigger_rate_limit, COALESCE(webhook_specs.public_trigger_secret, '')
+FROM webhook_specs
+JOIN jobs ON jobs.webhook_spec_id = webhook_specs.id
+WHERE webhook_specs.public_trigger_token_hash = $1`, HashPublicTriggerToken(token))
+
+	var secret string
+	if err = row.Scan(&trigger.ExternalJobID, &trigger.RateLimit, &secret); err != nil {
+		return trigger, err
+	}
+	trigger.Secret, err = ciphers.Decrypt(secret)
+	return trigger, errors.Wrap(err, "failed to decrypt public trigger secret")
+}
+
+// VerifySignature returns an error unless signature, the value of the
+// PublicTriggerSignatureHeader, is the signature of body with the secret of
+// the trigger
+func (t PublicTrigger) VerifySignature(body []byte, signature string) error {
+	if signature == "" {
+		return errors.Errorf("missing %s header", PublicTriggerSignatureHeader)
+	}
+	mac, err := hex.DecodeString(strings.TrimPrefix(signature, "sha256="))
+	if err != nil {
+		return errors.Wrap(err, "invalid signature")
+	}
+	h := hmac.New(sha256.New, []byte(t.Secret))
+	h.Write(body)
+	if !hmac.Equal(mac, h.Sum(nil)) {
+		return errors.New("signature mismatch")
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package webhook_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/core/services/webhook"
+)
+
+func TestPublicTrigger_VerifySignature(t *testing.T) {
+	t.Parallel()
+
+	trigger := webhook.PublicTrigger{Secret: "s3cr3t"}
+	body := []byte(`{"action": "published"}`)
+	h := hmac.New(sha256.New, []byte("s3cr3t"))
+	h.Write(body)
+	signature := "sha256=" + hex.EncodeToString(h.Sum(nil))
+
+	assert.NoError(t, trigger.VerifySignature(body, signature))
+	assert.EqualError(t, trigger.VerifySignature([]byte("{}"), signature), "signature mismatch")
+	assert.EqualError(t, trigger.VerifySignature(body, ""), "missing X-Hub-Signature-256 header")
+	assert.Error(t, trigger.VerifySignature(body, "sha256=zz"))
+}
+
+func TestNewPublicTriggerToken(t *testing.T) {
+	t.Parallel()
+
+	token, err := webhook.NewPublicTriggerToken()
+	assert.NoError(t, err)
+	other, err := webhook.NewPublicTriggerToken()
+	assert.NoError(t, err)
+	assert.NotEqual(t, token, other)
+	assert.Len(t, webhook.HashPublicTriggerToken(token), 64)
+	assert.NotEqual(t, token, webhook.HashPublicTriggerToken(token))
+}
@@ -1,9 +1,12 @@
 package webhook
 
 import (
+	"os"
+
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -16,6 +19,15 @@ type TOMLWebhookSpecExternalInitiator struct {
 
 type TOMLWebhookSpec struct {
 	ExternalInitiators []TOMLWebhookSpecExternalInitiator `toml:"externalInitiators"`
+	// PublicTrigger exposes an unauthenticated URL starting runs of the job
+	PublicTrigger bool `toml:"publicTrigger"`
+	// PublicTriggerRateLimit is the maximum number of runs per minute started
+	// through the public trigger URL, DefaultPublicTriggerRateLimit if unset
+	PublicTriggerRateLimit *uint32 `toml:"publicTriggerRateLimit"`
+	// PublicTriggerSecretEnv optionally names the environment variable holding
+	// the key of the HMAC the requests to the public trigger URL must be
+	// signed with
+	PublicTriggerSecretEnv string `toml:"publicTriggerSecretEnv"`
 }
 
 func ValidatedWebhookSpec(tomlString string, externalInitiatorManager ExternalInitiatorManager) (jb job.Job, err error) {
@@ -60,6 +72,42 @@ func ValidatedWebhookSpec(tomlString string, externalInitiatorManager ExternalIn
 	jb.WebhookSpec = &job.WebhookSpec{
 		ExternalInitiatorWebhookSpecs: externalInitiatorWebhookSpecs,
 	}
+	if err = setPublicTrigger(jb.WebhookSpec, tomlSpec); err != nil {
+		return jb, err
+	}
 
 	return jb, nil
 }
+
+func setPublicTrigger(spec *job.WebhookSpec, tomlSpec TOMLWebhookSpec) error {
+	if !tomlSpec.PublicTrigger {
+		if tomlSpec.PublicTriggerRateLimit != nil || tomlSpec.PublicTriggerSecretEnv != "" {
+			return errors.New("publicTriggerRateLimit and publicTriggerSecretEnv require publicTrigger = true")
+		}
+		return nil
+	}
+
+	rateLimit := uint32(DefaultPublicTriggerRateLimit)
+	if tomlSpec.PublicTriggerRateLimit != nil {
+		rateLimit = *tomlSpec.PublicTriggerRateLimit
+	}
+	if rateLimit == 0 {
+		return errors.New("publicTriggerRateLimit must be positive")
+	}
+	if tomlSpec.PublicTriggerSecretEnv != "" {
+		secret := os.Getenv(tomlSpec.PublicTriggerSecretEnv)
+		if secret == "" {
+			return errors.Errorf("environment variable %s referenced by publicTriggerSecretEnv is not set", tomlSpec.PublicTriggerSecretEnv)
+		}
+		spec.PublicTriggerSecret = null.StringFrom(secret)
+	}
+
+	token, err := NewPublicTriggerToken()
+	if err != nil {
+		return err
+	}
+	spec.PublicTriggerToken = token
+	spec.PublicTriggerTokenHash = null.StringFrom(HashPublicTriggerToken(token))
+	spec.PublicTriggerRateLimit = null.IntFrom(int64(rateLimit))
+	return nil
+}
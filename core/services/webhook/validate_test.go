@@ -142,6 +142,38 @@ func TestValidatedWebJobSpec(t *testing.T) {
 				require.EqualError(t, err, "unable to find external initiator named bar: something exploded; unable to find external initiator named baz: something exploded")
 			},
 		},
+		{
+			name: "with a public trigger",
+			toml: `
+            type            = "webhook"
+            schemaVersion   = 1
+            publicTrigger   = true
+            observationSource   = """
+                ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+            """
+            `,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				assert.Len(t, s.WebhookSpec.PublicTriggerToken, 64)
+				assert.Equal(t, webhook.HashPublicTriggerToken(s.WebhookSpec.PublicTriggerToken), s.WebhookSpec.PublicTriggerTokenHash.String)
+				assert.Equal(t, int64(webhook.DefaultPublicTriggerRateLimit), s.WebhookSpec.PublicTriggerRateLimit.Int64)
+				assert.False(t, s.WebhookSpec.PublicTriggerSecret.Valid)
+			},
+		},
+		{
+			name: "public trigger settings without a public trigger",
+			toml: `
+            type            = "webhook"
+            schemaVersion   = 1
+            publicTriggerRateLimit = 10
+            observationSource   = """
+                ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+            """
+            `,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.EqualError(t, err, "publicTriggerRateLimit and publicTriggerSecretEnv require publicTrigger = true")
+			},
+		},
 	}
 	for _, tc := range tt {
 		tc := tc
@@ -156,3 +188,25 @@ func TestValidatedWebJobSpec(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatedWebJobSpec_PublicTriggerSecret(t *testing.T) {
+	toml := `
+	type                   = "webhook"
+	schemaVersion          = 1
+	publicTrigger          = true
+	publicTriggerRateLimit = 10
+	publicTriggerSecretEnv = "TEST_PUBLIC_TRIGGER_SECRET"
+	observationSource      = """
+		ds [type=http method=GET url="https://chain.link/ETH-USD"];
+	"""
+	`
+
+	_, err := webhook.ValidatedWebhookSpec(toml, new(webhookmocks.ExternalInitiatorManager))
+	require.EqualError(t, err, "environment variable TEST_PUBLIC_TRIGGER_SECRET referenced by publicTriggerSecretEnv is not set")
+
+	t.Setenv("TEST_PUBLIC_TRIGGER_SECRET", "s3cr3t")
+	s, err := webhook.ValidatedWebhookSpec(toml, new(webhookmocks.ExternalInitiatorManager))
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t", s.WebhookSpec.PublicTriggerSecret.String)
+	assert.Equal(t, int64(10), s.WebhookSpec.PublicTriggerRateLimit.Int64)
+}
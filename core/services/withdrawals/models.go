@@ -0,0 +1,55 @@
+package withdrawals
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// MinCheckInterval is the shortest interval between the checks of a withdrawal
+const MinCheckInterval = time.Minute
+
+// ErrWithdrawalExists is returned when creating a withdrawal for a contract
+// which has one already
+var ErrWithdrawalExists = errors.New("contract has a withdrawal already")
+
+// Withdrawal withdraws the LINK earned by an Oracle or Operator contract to
+// its recipient, whenever its withdrawable LINK reaches the threshold. The
+// withdrawable LINK is checked every CheckInterval, and the withdraw
+// transactions are sent from FromAddress, which must own the contract.
+type Withdrawal struct {
+	ID              int64
+	EVMChainID      utils.Big
+	ContractAddress common.Address
+	FromAddress     common.Address
+	Recipient       common.Address
+	Threshold       assets.Link
+	CheckInterval   models.Interval
+	LastCheckedAt   null.Time
+	// LastEthTxID is the last withdraw transaction, the next withdrawal waits
+	// for it to be confirmed
+	LastEthTxID     null.Int
+	LastAmount      *assets.Link
+	LastWithdrawnAt null.Time
+	// Error is the error of the last check, retried on the next one
+	Error     null.String
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ValidateWithdrawal checks the threshold and the interval of w
+func ValidateWithdrawal(w Withdrawal) error {
+	if w.Threshold.Cmp(assets.NewLinkFromJuels(0)) <= 0 {
+		return errors.New("threshold must be positive")
+	}
+	if w.CheckInterval.Duration() < MinCheckInterval {
+		return errors.Errorf("interval must be at least %s", MinCheckInterval)
+	}
+	return nil
+}
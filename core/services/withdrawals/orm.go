@@ -0,0 +1,97 @@
+package withdrawals
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// ORM stores the automatic withdrawals of the Oracle and Operator contracts
+type ORM interface {
+	// CreateWithdrawal inserts w, setting its ID and timestamps
+	CreateWithdrawal(w *Withdrawal, qopts ...pg.QOpt) error
+	// FindWithdrawal returns sql.ErrNoRows if the withdrawal does not exist
+	FindWithdrawal(id int64, qopts ...pg.QOpt) (Withdrawal, error)
+	Withdrawals(qopts ...pg.QOpt) ([]Withdrawal, error)
+	// DeleteWithdrawal returns sql.ErrNoRows if the withdrawal does not exist
+	DeleteWithdrawal(id int64, qopts ...pg.QOpt) error
+	// DueWithdrawals returns the withdrawals never checked, or last checked at
+	// least their interval before now
+	DueWithdrawals(now time.Time, qopts ...pg.QOpt) ([]Withdrawal, error)
+	// UpdateWithdrawal saves the result of the last check of w
+	UpdateWithdrawal(w *Withdrawal, qopts ...pg.QOpt) error
+	// EthTxState returns the state of the transaction, or sql.ErrNoRows if it
+	// was deleted
+	EthTxState(id int64, qopts ...pg.QOpt) (txmgr.EthTxState, error)
+	GetQ() pg.Q
+}
+
+type orm struct {
+	q pg.Q
+}
+
+var _ ORM = (*orm)(nil)
+
+// NewORM returns an ORM of the automatic withdrawals
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig) ORM {
+	return &orm{pg.NewQ(db, lggr.Named("WithdrawalsORM"), cfg)}
+}
+
+func (o *orm) CreateWithdrawal(w *Withdrawal, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	sql := `INSERT INTO link_withdrawals (evm_chain_id, contract_address, from_address, recipient, threshold, check_interval, created_at, updated_at)
+VALUES (:evm_chain_id, :contract_address, :from_address, :recipient, :threshold, :check_interval, NOW(), NOW())
+RETURNING *`
+	return errors.Wrap(q.GetNamed(sql, w, w), "CreateWithdrawal failed")
+}
+
+func (o *orm) FindWithdrawal(id int64, qopts ...pg.QOpt) (w Withdrawal, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Get(&w, `SELECT * FROM link_withdrawals WHERE id = $1`, id)
+	return w, errors.Wrap(err, "FindWithdrawal failed")
+}
+
+func (o *orm) Withdrawals(qopts ...pg.QOpt) (ws []Withdrawal, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Select(&ws, `SELECT * FROM link_withdrawals ORDER BY id`)
+	return ws, errors.Wrap(err, "Withdrawals failed")
+}
+
+func (o *orm) DeleteWithdrawal(id int64, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	var deleted int64
+	err := q.Get(&deleted, `DELETE FROM link_withdrawals WHERE id = $1 RETURNING id`, id)
+	return errors.Wrap(err, "DeleteWithdrawal failed")
+}
+
+func (o *orm) DueWithdrawals(now time.Time, qopts ...pg.QOpt) (ws []Withdrawal, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Select(&ws, `SELECT * FROM link_withdrawals
+WHERE last_checked_at IS NULL OR last_checked_at + check_interval / 1000 * interval '1 microsecond' <= $1
+ORDER BY id`, now)
+	return ws, errors.Wrap(err, "DueWithdrawals failed")
+}
+
+func (o *orm) UpdateWithdrawal(w *Withdrawal, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	sql := `UPDATE link_withdrawals SET last_checked_at = :last_checked_at, last_eth_tx_id = :last_eth_tx_id, last_amount = :last_amount,
+	last_withdrawn_at = :last_withdrawn_at, error = :error, updated_at = NOW()
+WHERE id = :id
+RETURNING *`
+	return errors.Wrap(q.GetNamed(sql, w, w), "UpdateWithdrawal failed")
+}
+
+func (o *orm) GetQ() pg.Q {
+	return o.q
+}
+
+func (o *orm) EthTxState(id int64, qopts ...pg.QOpt) (state txmgr.EthTxState, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Get(&state, `SELECT state FROM eth_txes WHERE id = $1`, id)
+	return state, errors.Wrap(err, "EthTxState failed")
+}
@@ -0,0 +1,66 @@
+package withdrawals_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/withdrawals"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestORM_DueWithdrawals(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	orm := withdrawals.NewORM(db, logger.TestLogger(t), cfg)
+
+	w := withdrawals.Withdrawal{
+		EVMChainID:      *utils.NewBig(&cltest.FixtureChainID),
+		ContractAddress: testutils.NewAddress(),
+		FromAddress:     testutils.NewAddress(),
+		Recipient:       testutils.NewAddress(),
+		Threshold:       *assets.NewLinkFromJuels(1e18),
+		CheckInterval:   models.Interval(time.Hour),
+	}
+	require.NoError(t, orm.CreateWithdrawal(&w))
+	assert.NotZero(t, w.ID)
+
+	t.Run("only one withdrawal per contract", func(t *testing.T) {
+		duplicate := w
+		require.Error(t, orm.CreateWithdrawal(&duplicate))
+	})
+
+	now := time.Now()
+	due, err := orm.DueWithdrawals(now)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+
+	w.LastCheckedAt = null.TimeFrom(now)
+	w.Error = null.StringFrom("boom")
+	require.NoError(t, orm.UpdateWithdrawal(&w))
+	assert.Equal(t, "boom", w.Error.String)
+
+	due, err = orm.DueWithdrawals(now.Add(59 * time.Minute))
+	require.NoError(t, err)
+	assert.Empty(t, due)
+	due, err = orm.DueWithdrawals(now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Len(t, due, 1)
+
+	require.NoError(t, orm.DeleteWithdrawal(w.ID))
+	assert.ErrorIs(t, orm.DeleteWithdrawal(w.ID), sql.ErrNoRows)
+	_, err = orm.FindWithdrawal(w.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -0,0 +1,255 @@
+package withdrawals
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/gethwrappers/generated/operator_wrapper"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// PollInterval is how often the due withdrawals are checked
+const PollInterval = 15 * time.Second
+
+// Oracle and Operator contracts share the withdraw and withdrawable functions
+var operatorABI = evmtypes.MustGetABI(operator_wrapper.OperatorABI)
+
+// Withdrawer withdraws the LINK earned by the Oracle and Operator contracts of
+// the withdrawals, through the transaction manager
+type Withdrawer interface {
+	services.ServiceCtx
+	// CreateWithdrawal validates w, and inserts it to be checked right away
+	CreateWithdrawal(w *Withdrawal) error
+	FindWithdrawal(id int64, qopts ...pg.QOpt) (Withdrawal, error)
+	Withdrawals(qopts ...pg.QOpt) ([]Withdrawal, error)
+	DeleteWithdrawal(id int64, qopts ...pg.QOpt) error
+}
+
+type withdrawer struct {
+	utils.StartStopOnce
+	orm      ORM
+	keyStore keystore.Eth
+	chains   evm.ChainSet
+	interval time.Duration
+	lggr     logger.Logger
+
+	chWake chan struct{}
+	chStop chan struct{}
+	wgDone sync.WaitGroup
+}
+
+var _ Withdrawer = (*withdrawer)(nil)
+
+// NewWithdrawer returns a Withdrawer checking the due withdrawals every
+// interval
+func NewWithdrawer(orm ORM, keyStore keystore.Eth, chains evm.ChainSet, interval time.Duration, lggr logger.Logger) Withdrawer {
+	return &withdrawer{
+		orm:      orm,
+		keyStore: keyStore,
+		chains:   chains,
+		interval: interval,
+		lggr:     lggr.Named("LinkWithdrawer"),
+		chWake:   make(chan struct{}, 1),
+		chStop:   make(chan struct{}),
+	}
+}
+
+func (w *withdrawer) Start(context.Context) error {
+	return w.StartOnce("LinkWithdrawer", func() error {
+		w.wgDone.Add(1)
+		go w.run()
+		return nil
+	})
+}
+
+func (w *withdrawer) Close() error {
+	return w.StopOnce("LinkWithdrawer", func() error {
+		close(w.chStop)
+		w.wgDone.Wait()
+		return nil
+	})
+}
+
+func (w *withdrawer) CreateWithdrawal(withdrawal *Withdrawal) error {
+	if err := ValidateWithdrawal(*withdrawal); err != nil {
+		return err
+	}
+	chainID := withdrawal.EVMChainID.ToInt()
+	if _, err := w.chains.Get(chainID); err != nil {
+		return err
+	}
+	if err := w.keyStore.CheckEnabled(withdrawal.FromAddress, chainID); err != nil {
+		return err
+	}
+	existing, err := w.orm.Withdrawals()
+	if err != nil {
+		return err
+	}
+	for _, e := range existing {
+		if e.EVMChainID.Cmp(&withdrawal.EVMChainID) == 0 && e.ContractAddress == withdrawal.ContractAddress {
+			return errors.Wrapf(ErrWithdrawalExists, "withdrawal %d", e.ID)
+		}
+	}
+
+	if err = w.orm.CreateWithdrawal(withdrawal); err != nil {
+		return err
+	}
+	w.lggr.Infow("Created LINK withdrawal", "id", withdrawal.ID, "evmChainID", chainID, "contract", withdrawal.ContractAddress, "threshold", withdrawal.Threshold.String())
+
+	select {
+	case w.chWake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (w *withdrawer) FindWithdrawal(id int64, qopts ...pg.QOpt) (Withdrawal, error) {
+	return w.orm.FindWithdrawal(id, qopts...)
+}
+
+func (w *withdrawer) Withdrawals(qopts ...pg.QOpt) ([]Withdrawal, error) {
+	return w.orm.Withdrawals(qopts...)
+}
+
+func (w *withdrawer) DeleteWithdrawal(id int64, qopts ...pg.QOpt) error {
+	return w.orm.DeleteWithdrawal(id, qopts...)
+}
+
+func (w *withdrawer) run() {
+	defer w.wgDone.Done()
+	ctx, cancel := utils.ContextFromChan(w.chStop)
+	defer cancel()
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		w.checkAll(ctx)
+		select {
+		case <-w.chStop:
+			return
+		case <-ticker.C:
+		case <-w.chWake:
+		}
+	}
+}
+
+// checkAll checks the due withdrawals. The errors are saved in the
+// withdrawals, which are retried on their next check.
+func (w *withdrawer) checkAll(ctx context.Context) {
+	withdrawals, err := w.orm.DueWithdrawals(time.Now(), pg.WithParentCtx(ctx))
+	if err != nil {
+		w.lggr.Errorw("Failed to load due LINK withdrawals", "err", err)
+		return
+	}
+	for i := range withdrawals {
+		withdrawal := withdrawals[i]
+		saved, err := w.check(ctx, &withdrawal)
+		if ctx.Err() != nil {
+			return
+		}
+		if saved {
+			continue
+		}
+		withdrawal.LastCheckedAt = null.TimeFrom(time.Now())
+		withdrawal.Error = null.String{}
+		if err != nil {
+			w.lggr.Errorw("Failed to check LINK withdrawal", "id", withdrawal.ID, "contract", withdrawal.ContractAddress, "err", err)
+			withdrawal.Error = null.StringFrom(err.Error())
+		}
+		if err = w.orm.UpdateWithdrawal(&withdrawal, pg.WithParentCtx(ctx)); err != nil {
+			w.lggr.Errorw("Failed to save LINK withdrawal", "id", withdrawal.ID, "err", err)
+		}
+	}
+}
+
+// check sends a withdraw transaction of the withdrawable LINK of the contract
+// if it reached the threshold, unless the last withdraw transaction is still
+// pending. saved is true if the withdrawal needs no update: when its last
+// transaction is pending, it is checked again on the next poll, and when a new
+// one is sent, it is saved with the transaction.
+func (w *withdrawer) check(ctx context.Context, withdrawal *Withdrawal) (saved bool, err error) {
+	if withdrawal.LastEthTxID.Valid {
+		var state txmgr.EthTxState
+		state, err = w.orm.EthTxState(withdrawal.LastEthTxID.Int64, pg.WithParentCtx(ctx))
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return false, err
+		}
+		switch state {
+		case txmgr.EthTxUnstarted, txmgr.EthTxInProgress, txmgr.EthTxUnconfirmed, txmgr.EthTxConfirmedMissingReceipt:
+			return true, nil
+		case txmgr.EthTxFatalError:
+			w.lggr.Warnw("Last LINK withdraw transaction failed", "id", withdrawal.ID, "ethTxID", withdrawal.LastEthTxID.Int64)
+		}
+	}
+
+	chain, err := w.chains.Get(withdrawal.EVMChainID.ToInt())
+	if err != nil {
+		return false, err
+	}
+	contract, err := operator_wrapper.NewOperator(withdrawal.ContractAddress, chain.Client())
+	if err != nil {
+		return false, errors.Wrap(err, "failed to create contract wrapper")
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	owner, err := contract.Owner(opts)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get owner of contract")
+	}
+	if owner != withdrawal.FromAddress {
+		return false, errors.Errorf("key %s is not the owner %s of the contract", withdrawal.FromAddress.Hex(), owner.Hex())
+	}
+	withdrawable, err := contract.Withdrawable(opts)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get withdrawable LINK of contract")
+	}
+	if withdrawable.Cmp(withdrawal.Threshold.ToInt()) < 0 {
+		return false, nil
+	}
+
+	payload, err := operatorABI.Pack("withdraw", withdrawal.Recipient, withdrawable)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to encode withdraw call")
+	}
+	// The transaction is created with the update of the withdrawal, so that
+	// it can't be sent without being recorded as the last one, and sent again
+	updated := *withdrawal
+	updated.LastCheckedAt = null.TimeFrom(time.Now())
+	updated.LastAmount = (*assets.Link)(new(big.Int).Set(withdrawable))
+	updated.LastWithdrawnAt = updated.LastCheckedAt
+	updated.Error = null.String{}
+	err = w.orm.GetQ().WithOpts(pg.WithParentCtx(ctx)).Transaction(func(tx pg.Queryer) error {
+		etx, txErr := chain.TxManager().CreateEthTransaction(txmgr.NewTx{
+			FromAddress:    withdrawal.FromAddress,
+			ToAddress:      withdrawal.ContractAddress,
+			EncodedPayload: payload,
+			GasLimit:       chain.Config().EvmGasLimitDefault(),
+			Strategy:       txmgr.NewSendEveryStrategy(),
+		}, pg.WithQueryer(tx))
+		if txErr != nil {
+			return errors.Wrap(txErr, "failed to create withdraw transaction")
+		}
+		updated.LastEthTxID = null.IntFrom(etx.ID)
+		return w.orm.UpdateWithdrawal(&updated, pg.WithQueryer(tx))
+	})
+	if err != nil {
+		return false, err
+	}
+	w.lggr.Infow("Withdrawing LINK", "id", withdrawal.ID, "contract", withdrawal.ContractAddress, "recipient", withdrawal.Recipient, "amount", withdrawable, "ethTxID", updated.LastEthTxID.Int64)
+	*withdrawal = updated
+	return true, nil
+}
@@ -0,0 +1,152 @@
+package withdrawals_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	txmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/gethwrappers/generated/operator_wrapper"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/withdrawals"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var operatorABI = evmtypes.MustGetABI(operator_wrapper.OperatorABI)
+
+// mockCall returns result to the calls of the method of the contract
+func mockCall(ethClient *evmmocks.Client, contract common.Address, method string, result []byte) {
+	selector := operatorABI.Methods[method].ID
+	ethClient.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+		return msg.To != nil && *msg.To == contract && bytes.HasPrefix(msg.Data, selector)
+	}), (*big.Int)(nil)).Return(result, nil)
+}
+
+func TestWithdrawer(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	keyStore := cltest.NewKeyStore(t, db, cfg)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	txm := txmmocks.NewTxManager(t)
+	chains := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, Client: ethClient, KeyStore: keyStore.Eth(), TxManager: txm})
+	chain := evmtest.MustGetDefaultChain(t, chains)
+	orm := withdrawals.NewORM(db, logger.TestLogger(t), cfg)
+	withdrawer := withdrawals.NewWithdrawer(orm, keyStore.Eth(), chains, time.Hour, logger.TestLogger(t))
+
+	_, fromAddress := cltest.MustInsertRandomKey(t, keyStore.Eth())
+	recipient := testutils.NewAddress()
+	newWithdrawal := func(contract common.Address) withdrawals.Withdrawal {
+		return withdrawals.Withdrawal{
+			EVMChainID:      *utils.NewBig(chain.ID()),
+			ContractAddress: contract,
+			FromAddress:     fromAddress,
+			Recipient:       recipient,
+			Threshold:       *assets.NewLinkFromJuels(1e18),
+			CheckInterval:   models.Interval(time.Hour),
+		}
+	}
+
+	t.Run("invalid withdrawals", func(t *testing.T) {
+		w := newWithdrawal(testutils.NewAddress())
+		w.Threshold = *assets.NewLinkFromJuels(0)
+		assert.EqualError(t, withdrawer.CreateWithdrawal(&w), "threshold must be positive")
+
+		w = newWithdrawal(testutils.NewAddress())
+		w.CheckInterval = models.Interval(time.Second)
+		assert.EqualError(t, withdrawer.CreateWithdrawal(&w), "interval must be at least 1m0s")
+
+		w = newWithdrawal(testutils.NewAddress())
+		w.FromAddress = testutils.NewAddress()
+		assert.Error(t, withdrawer.CreateWithdrawal(&w))
+	})
+
+	// Withdraws its 2 LINK
+	withdrawn := newWithdrawal(testutils.NewAddress())
+	require.NoError(t, withdrawer.CreateWithdrawal(&withdrawn))
+	mockCall(ethClient, withdrawn.ContractAddress, "owner", common.LeftPadBytes(fromAddress.Bytes(), 32))
+	mockCall(ethClient, withdrawn.ContractAddress, "withdrawable", common.LeftPadBytes(big.NewInt(2e18).Bytes(), 32))
+
+	// Below its threshold
+	below := newWithdrawal(testutils.NewAddress())
+	require.NoError(t, withdrawer.CreateWithdrawal(&below))
+	mockCall(ethClient, below.ContractAddress, "owner", common.LeftPadBytes(fromAddress.Bytes(), 32))
+	mockCall(ethClient, below.ContractAddress, "withdrawable", common.LeftPadBytes(big.NewInt(5e17).Bytes(), 32))
+
+	// Owned by another address
+	notOwned := newWithdrawal(testutils.NewAddress())
+	require.NoError(t, withdrawer.CreateWithdrawal(&notOwned))
+	mockCall(ethClient, notOwned.ContractAddress, "owner", common.LeftPadBytes(testutils.NewAddress().Bytes(), 32))
+
+	// Failing to create its transaction
+	failing := newWithdrawal(testutils.NewAddress())
+	require.NoError(t, withdrawer.CreateWithdrawal(&failing))
+	mockCall(ethClient, failing.ContractAddress, "owner", common.LeftPadBytes(fromAddress.Bytes(), 32))
+	mockCall(ethClient, failing.ContractAddress, "withdrawable", common.LeftPadBytes(big.NewInt(2e18).Bytes(), 32))
+
+	duplicate := newWithdrawal(withdrawn.ContractAddress)
+	assert.ErrorIs(t, withdrawer.CreateWithdrawal(&duplicate), withdrawals.ErrWithdrawalExists)
+
+	txmORM := cltest.NewTxmORM(t, db, cfg)
+	etx := cltest.MustInsertUnconfirmedEthTx(t, txmORM, 0, fromAddress)
+	txm.On("CreateEthTransaction", mock.MatchedBy(func(newTx txmgr.NewTx) bool {
+		payload, err := operatorABI.Pack("withdraw", recipient, big.NewInt(2e18))
+		require.NoError(t, err)
+		return newTx.FromAddress == fromAddress && newTx.ToAddress == withdrawn.ContractAddress && bytes.Equal(newTx.EncodedPayload, payload)
+	}), mock.Anything).Return(etx, nil).Once()
+	txm.On("CreateEthTransaction", mock.MatchedBy(func(newTx txmgr.NewTx) bool {
+		return newTx.ToAddress == failing.ContractAddress
+	}), mock.Anything).Return(txmgr.EthTx{}, errors.New("database is down")).Once()
+
+	require.NoError(t, withdrawer.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, withdrawer.Close()) })
+
+	g := gomega.NewWithT(t)
+	for _, w := range []*withdrawals.Withdrawal{&withdrawn, &below, &notOwned, &failing} {
+		id := w.ID
+		g.Eventually(func() bool {
+			var err error
+			*w, err = withdrawer.FindWithdrawal(id)
+			require.NoError(t, err)
+			return w.LastCheckedAt.Valid
+		}, testutils.WaitTimeout(t), 100*time.Millisecond).Should(gomega.BeTrue())
+	}
+
+	assert.False(t, withdrawn.Error.Valid)
+	assert.Equal(t, etx.ID, withdrawn.LastEthTxID.Int64)
+	require.NotNil(t, withdrawn.LastAmount)
+	assert.Equal(t, "2000000000000000000", withdrawn.LastAmount.ToInt().String())
+	assert.True(t, withdrawn.LastWithdrawnAt.Valid)
+
+	assert.False(t, below.Error.Valid)
+	assert.False(t, below.LastEthTxID.Valid)
+	assert.False(t, below.LastWithdrawnAt.Valid)
+
+	assert.Contains(t, notOwned.Error.String, "is not the owner")
+	assert.False(t, notOwned.LastEthTxID.Valid)
+
+	// nothing is recorded as withdrawn without its transaction
+	assert.Contains(t, failing.Error.String, "failed to create withdraw transaction")
+	assert.False(t, failing.LastEthTxID.Valid)
+	assert.Nil(t, failing.LastAmount)
+	assert.False(t, failing.LastWithdrawnAt.Valid)
+}
@@ -0,0 +1,130 @@
+package sessions
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/auth"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// DefaultAPITokenName is the name of the token managed by the legacy single
+// token endpoints
+const DefaultAPITokenName = "default"
+
+// APITokenScope limits the requests an API token can make, on top of the
+// role of the user owning it
+type APITokenScope string
+
+const (
+	APITokenScopeReadOnly      APITokenScope = "read-only"
+	APITokenScopeJobManagement APITokenScope = "job-management"
+	APITokenScopeTxManagement  APITokenScope = "tx-management"
+	APITokenScopeAdmin         APITokenScope = "admin"
+)
+
+// apiTokenScopePaths lists the route prefixes each scope can make changes to.
+// All scopes can make read requests.
+var apiTokenScopePaths = map[APITokenScope][]string{
+	APITokenScopeJobManagement: {
+		"/v2/jobs",
+		"/v2/pipeline",
+		"/v2/bridge_types",
+		"/v2/external_initiators",
+		"/v2/replay_job",
+	},
+	APITokenScopeTxManagement: {
+		"/v2/transfers",
+		"/v2/replay_from_block",
+		"/v2/nodes/evm/forwarders",
+		// bump, cancel and rebroadcast
+		"/v2/transactions/evm",
+	},
+}
+
+// GetAPITokenScope is the single point of logic for mapping a scope string
+// to APITokenScope
+func GetAPITokenScope(scope string) (APITokenScope, error) {
+	switch s := APITokenScope(scope); s {
+	case APITokenScopeReadOnly, APITokenScopeJobManagement, APITokenScopeTxManagement, APITokenScopeAdmin:
+		return s, nil
+	}
+	return "", errors.Errorf("Invalid scope: %s. Allowed scopes: '%s', '%s', '%s', '%s'.",
+		scope, APITokenScopeReadOnly, APITokenScopeJobManagement, APITokenScopeTxManagement, APITokenScopeAdmin)
+}
+
+// Allows returns true if a token with this scope can make a request with the
+// given method to the given route path
+func (s APITokenScope) Allows(method, path string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	if s == APITokenScopeAdmin {
+		return true
+	}
+	for _, prefix := range apiTokenScopePaths[s] {
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// APIToken is one of the named API tokens of a user
+type APIToken struct {
+	ID                int64
+	UserEmail         string
+	Name              string
+	Scope             APITokenScope
+	TokenKey          string
+	TokenSalt         string
+	TokenHashedSecret string
+	ExpiresAt         null.Time
+	LastUsedAt        null.Time
+	CreatedAt         time.Time
+}
+
+// APITokenRequest is sent when creating a named API token
+type APITokenRequest struct {
+	Name      string    `json:"name"`
+	Scope     string    `json:"scope"`
+	ExpiresAt null.Time `json:"expiresAt"`
+	Password  string    `json:"password"`
+}
+
+// SetAuthToken updates the API token to use the given Authentication Token.
+func (t *APIToken) SetAuthToken(token *auth.Token) error {
+	salt := utils.NewSecret(utils.DefaultSecretSize)
+	hashedSecret, err := auth.HashedSecret(token, salt)
+	if err != nil {
+		return errors.Wrap(err, "api token")
+	}
+	t.TokenSalt = salt
+	t.TokenKey = token.AccessKey
+	t.TokenHashedSecret = hashedSecret
+	return nil
+}
+
+// Expired returns true if the token has an expiry in the past
+func (t APIToken) Expired() bool {
+	return t.ExpiresAt.Valid && !t.ExpiresAt.Time.After(time.Now())
+}
+
+// AuthenticateAPIToken returns true on successful authentication of the given
+// Authentication Token against an unexpired API token.
+func AuthenticateAPIToken(token *auth.Token, apiToken *APIToken) (bool, error) {
+	hashedSecret, err := auth.HashedSecret(token, apiToken.TokenSalt)
+	if err != nil {
+		return false, err
+	}
+	if subtle.ConstantTimeCompare([]byte(hashedSecret), []byte(apiToken.TokenHashedSecret)) != 1 {
+		return false, nil
+	}
+	return !apiToken.Expired(), nil
+}
@@ -0,0 +1,78 @@
+package sessions_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/auth"
+	"github.com/smartcontractkit/chainlink/core/sessions"
+)
+
+func TestGetAPITokenScope(t *testing.T) {
+	t.Parallel()
+
+	scope, err := sessions.GetAPITokenScope("job-management")
+	require.NoError(t, err)
+	assert.Equal(t, sessions.APITokenScopeJobManagement, scope)
+
+	_, err = sessions.GetAPITokenScope("root")
+	require.ErrorContains(t, err, "Invalid scope: root")
+}
+
+func TestAPITokenScope_Allows(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		scope  sessions.APITokenScope
+		method string
+		path   string
+		allows bool
+	}{
+		{sessions.APITokenScopeReadOnly, http.MethodGet, "/v2/keys/evm", true},
+		{sessions.APITokenScopeReadOnly, http.MethodPost, "/v2/jobs", false},
+		{sessions.APITokenScopeJobManagement, http.MethodPost, "/v2/jobs", true},
+		{sessions.APITokenScopeJobManagement, http.MethodDelete, "/v2/jobs/:ID", true},
+		{sessions.APITokenScopeJobManagement, http.MethodPost, "/v2/jobs_other", false},
+		{sessions.APITokenScopeJobManagement, http.MethodPost, "/v2/transfers", false},
+		{sessions.APITokenScopeTxManagement, http.MethodPost, "/v2/transfers/evm", true},
+		{sessions.APITokenScopeTxManagement, http.MethodPost, "/v2/jobs", false},
+		{sessions.APITokenScopeTxManagement, http.MethodPost, "/v2/transactions/evm/:TxHash/bump", true},
+		{sessions.APITokenScopeTxManagement, http.MethodPost, "/v2/transactions/evm/:TxHash/cancel", true},
+		{sessions.APITokenScopeJobManagement, http.MethodPost, "/v2/transactions/evm/:TxHash/bump", false},
+		{sessions.APITokenScopeTxManagement, http.MethodPost, "/v2/transactions/evm/rebroadcast", true},
+		{sessions.APITokenScopeReadOnly, http.MethodPost, "/v2/transactions/evm/rebroadcast", false},
+		{sessions.APITokenScopeAdmin, http.MethodPatch, "/v2/config", true},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.allows, tt.scope.Allows(tt.method, tt.path), "%s %s %s", tt.scope, tt.method, tt.path)
+	}
+}
+
+func TestAuthenticateAPIToken(t *testing.T) {
+	t.Parallel()
+
+	var apiToken sessions.APIToken
+	token := auth.NewToken()
+	require.NoError(t, apiToken.SetAuthToken(token))
+	assert.Equal(t, token.AccessKey, apiToken.TokenKey)
+	assert.NotEqual(t, token.Secret, apiToken.TokenHashedSecret)
+
+	ok, err := sessions.AuthenticateAPIToken(token, &apiToken)
+	require.NoError(t, err)
+	assert.True(t, ok, "authentication must be successful")
+
+	ok, err = sessions.AuthenticateAPIToken(&auth.Token{AccessKey: token.AccessKey, Secret: "wrong"}, &apiToken)
+	require.NoError(t, err)
+	assert.False(t, ok, "authentication must fail with the wrong secret")
+
+	apiToken.ExpiresAt = null.TimeFrom(time.Now().Add(-time.Minute))
+	ok, err = sessions.AuthenticateAPIToken(token, &apiToken)
+	require.NoError(t, err)
+	assert.False(t, ok, "authentication must fail with an expired token")
+}
@@ -8,6 +8,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	models "github.com/smartcontractkit/chainlink/core/store/models"
+
 	sessions "github.com/smartcontractkit/chainlink/core/sessions"
 )
 
@@ -51,6 +53,29 @@ func (_m *ORM) ClearNonCurrentSessions(sessionID string) error {
 	return r0
 }
 
+// CreateAPIToken provides a mock function with given fields: apiToken
+func (_m *ORM) CreateAPIToken(apiToken *sessions.APIToken) (*auth.Token, error) {
+	ret := _m.Called(apiToken)
+
+	var r0 *auth.Token
+	if rf, ok := ret.Get(0).(func(*sessions.APIToken) *auth.Token); ok {
+		r0 = rf(apiToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.Token)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*sessions.APIToken) error); ok {
+		r1 = rf(apiToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateAndSetAuthToken provides a mock function with given fields: user
 func (_m *ORM) CreateAndSetAuthToken(user *sessions.User) (*auth.Token, error) {
 	ret := _m.Called(user)
@@ -109,13 +134,34 @@ func (_m *ORM) CreateUser(user *sessions.User) error {
 	return r0
 }
 
-// DeleteAuthToken provides a mock function with given fields: user
-func (_m *ORM) DeleteAuthToken(user *sessions.User) error {
-	ret := _m.Called(user)
+// DeleteAPIToken provides a mock function with given fields: email, name
+func (_m *ORM) DeleteAPIToken(email string, name string) (sessions.APIToken, error) {
+	ret := _m.Called(email, name)
+
+	var r0 sessions.APIToken
+	if rf, ok := ret.Get(0).(func(string, string) sessions.APIToken); ok {
+		r0 = rf(email, name)
+	} else {
+		r0 = ret.Get(0).(sessions.APIToken)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(email, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeletePasswordSessions provides a mock function with given fields: email
+func (_m *ORM) DeletePasswordSessions(email string) error {
+	ret := _m.Called(email)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*sessions.User) error); ok {
-		r0 = rf(user)
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(email)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -151,6 +197,41 @@ func (_m *ORM) DeleteUserSession(sessionID string) error {
 	return r0
 }
 
+// DeleteWebAuthn provides a mock function with given fields: email, id
+func (_m *ORM) DeleteWebAuthn(email string, id int64) error {
+	ret := _m.Called(email, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(email, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindAPIToken provides a mock function with given fields: accessKey
+func (_m *ORM) FindAPIToken(accessKey string) (sessions.APIToken, error) {
+	ret := _m.Called(accessKey)
+
+	var r0 sessions.APIToken
+	if rf, ok := ret.Get(0).(func(string) sessions.APIToken); ok {
+		r0 = rf(accessKey)
+	} else {
+		r0 = ret.Get(0).(sessions.APIToken)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(accessKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindExternalInitiator provides a mock function with given fields: eia
 func (_m *ORM) FindExternalInitiator(eia *auth.Token) (*bridges.ExternalInitiator, error) {
 	ret := _m.Called(eia)
@@ -195,20 +276,22 @@ func (_m *ORM) FindUser(email string) (sessions.User, error) {
 	return r0, r1
 }
 
-// FindUserByAPIToken provides a mock function with given fields: apiToken
-func (_m *ORM) FindUserByAPIToken(apiToken string) (sessions.User, error) {
-	ret := _m.Called(apiToken)
+// GetUserWebAuthn provides a mock function with given fields: email
+func (_m *ORM) GetUserWebAuthn(email string) ([]sessions.WebAuthn, error) {
+	ret := _m.Called(email)
 
-	var r0 sessions.User
-	if rf, ok := ret.Get(0).(func(string) sessions.User); ok {
-		r0 = rf(apiToken)
+	var r0 []sessions.WebAuthn
+	if rf, ok := ret.Get(0).(func(string) []sessions.WebAuthn); ok {
+		r0 = rf(email)
 	} else {
-		r0 = ret.Get(0).(sessions.User)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sessions.WebAuthn)
+		}
 	}
 
 	var r1 error
 	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(apiToken)
+		r1 = rf(email)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -216,16 +299,60 @@ func (_m *ORM) FindUserByAPIToken(apiToken string) (sessions.User, error) {
 	return r0, r1
 }
 
-// GetUserWebAuthn provides a mock function with given fields: email
-func (_m *ORM) GetUserWebAuthn(email string) ([]sessions.WebAuthn, error) {
+// IsWebAuthnSession provides a mock function with given fields: sessionID
+func (_m *ORM) IsWebAuthnSession(sessionID string) (bool, error) {
+	ret := _m.Called(sessionID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(sessionID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAPITokens provides a mock function with given fields: email
+func (_m *ORM) ListAPITokens(email string) ([]sessions.APIToken, error) {
 	ret := _m.Called(email)
 
-	var r0 []sessions.WebAuthn
-	if rf, ok := ret.Get(0).(func(string) []sessions.WebAuthn); ok {
+	var r0 []sessions.APIToken
+	if rf, ok := ret.Get(0).(func(string) []sessions.APIToken); ok {
 		r0 = rf(email)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]sessions.WebAuthn)
+			r0 = ret.Get(0).([]sessions.APIToken)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSessions provides a mock function with given fields: email
+func (_m *ORM) ListSessions(email string) ([]sessions.Session, error) {
+	ret := _m.Called(email)
+
+	var r0 []sessions.Session
+	if rf, ok := ret.Get(0).(func(string) []sessions.Session); ok {
+		r0 = rf(email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sessions.Session)
 		}
 	}
 
@@ -262,6 +389,34 @@ func (_m *ORM) ListUsers() ([]sessions.User, error) {
 	return r0, r1
 }
 
+// MarkAPITokenUsed provides a mock function with given fields: id
+func (_m *ORM) MarkAPITokenUsed(id int64) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeSession provides a mock function with given fields: email, publicID
+func (_m *ORM) RevokeSession(email string, publicID string) error {
+	ret := _m.Called(email, publicID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(email, publicID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SaveWebAuthn provides a mock function with given fields: token
 func (_m *ORM) SaveWebAuthn(token *sessions.WebAuthn) error {
 	ret := _m.Called(token)
@@ -327,6 +482,27 @@ func (_m *ORM) SetPassword(user *sessions.User, newPassword string) error {
 	return r0
 }
 
+// SetSessionTimeouts provides a mock function with given fields: email, idle, absolute
+func (_m *ORM) SetSessionTimeouts(email string, idle *models.Interval, absolute *models.Interval) (sessions.User, error) {
+	ret := _m.Called(email, idle, absolute)
+
+	var r0 sessions.User
+	if rf, ok := ret.Get(0).(func(string, *models.Interval, *models.Interval) sessions.User); ok {
+		r0 = rf(email, idle, absolute)
+	} else {
+		r0 = ret.Get(0).(sessions.User)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *models.Interval, *models.Interval) error); ok {
+		r1 = rf(email, idle, absolute)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateRole provides a mock function with given fields: email, newRole
 func (_m *ORM) UpdateRole(email string, newRole string) (sessions.User, error) {
 	ret := _m.Called(email, newRole)
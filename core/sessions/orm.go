@@ -2,17 +2,20 @@ package sessions
 
 import (
 	"crypto/subtle"
+	"database/sql"
 	"encoding/json"
 	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/sqlx"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/utils/mathutil"
 )
@@ -21,7 +24,6 @@ import (
 
 type ORM interface {
 	FindUser(email string) (User, error)
-	FindUserByAPIToken(apiToken string) (User, error)
 	ListUsers() ([]User, error)
 	AuthorizedUserWithSession(sessionID string) (User, error)
 	DeleteUser(email string) error
@@ -32,11 +34,21 @@ type ORM interface {
 	UpdateRole(email, newRole string) (User, error)
 	SetAuthToken(user *User, token *auth.Token) error
 	CreateAndSetAuthToken(user *User) (*auth.Token, error)
-	DeleteAuthToken(user *User) error
+	CreateAPIToken(apiToken *APIToken) (*auth.Token, error)
+	FindAPIToken(accessKey string) (APIToken, error)
+	ListAPITokens(email string) ([]APIToken, error)
+	DeleteAPIToken(email, name string) (APIToken, error)
+	MarkAPITokenUsed(id int64) error
 	SetPassword(user *User, newPassword string) error
 	Sessions(offset, limit int) ([]Session, error)
+	ListSessions(email string) ([]Session, error)
+	RevokeSession(email, publicID string) error
+	SetSessionTimeouts(email string, idle, absolute *models.Interval) (User, error)
 	GetUserWebAuthn(email string) ([]WebAuthn, error)
+	IsWebAuthnSession(sessionID string) (bool, error)
+	DeletePasswordSessions(email string) error
 	SaveWebAuthn(token *WebAuthn) error
+	DeleteWebAuthn(email string, id int64) error
 
 	FindExternalInitiator(eia *auth.Token) (initiator *bridges.ExternalInitiator, err error)
 }
@@ -63,13 +75,6 @@ func (o *orm) FindUser(email string) (User, error) {
 	return o.findUser(email)
 }
 
-// FindUserByAPIToken will attempt to return an API user via the user's table token_key column.
-func (o *orm) FindUserByAPIToken(apiToken string) (user User, err error) {
-	sql := "SELECT * FROM users WHERE token_key = $1"
-	err = o.q.Get(&user, sql, apiToken)
-	return
-}
-
 func (o *orm) findUser(email string) (user User, err error) {
 	sql := "SELECT * FROM users WHERE lower(email) = lower($1)"
 	err = o.q.Get(&user, sql, email)
@@ -100,7 +105,10 @@ func (o *orm) AuthorizedUserWithSession(sessionID string) (User, error) {
 			Email string
 			Valid bool
 		}
-		if err := tx.Get(&foundSession, "SELECT email, last_used + $2 >= now() as valid FROM sessions WHERE id = $1 FOR UPDATE", sessionID, o.sessionDuration); err != nil {
+		sql := `SELECT s.email, ` + validSessionSQL("$2") + ` AS valid
+FROM sessions s JOIN users u ON u.email = s.email
+WHERE s.id = $1 FOR UPDATE OF s`
+		if err := tx.Get(&foundSession, sql, sessionID, o.sessionDuration); err != nil {
 			return errors.Wrap(err, "no matching user for provided session token")
 		}
 
@@ -112,7 +120,7 @@ func (o *orm) AuthorizedUserWithSession(sessionID string) (User, error) {
 			return errors.Wrap(err, "no matching user for provided session email")
 		}
 		// Session valid and tied to user, update last_used
-		_, err := tx.Exec("UPDATE sessions SET last_used = now() WHERE id = $1 AND last_used + $2 >= now()", sessionID, o.sessionDuration)
+		_, err := tx.Exec("UPDATE sessions SET last_used = now() WHERE id = $1", sessionID)
 		if err != nil {
 			return errors.Wrap(err, "unable to update sessions table")
 		}
@@ -137,6 +145,20 @@ func (o *orm) DeleteUser(email string) error {
 	})
 }
 
+// IsWebAuthnSession returns whether the login of the session was verified by a
+// WebAuthn assertion.
+func (o *orm) IsWebAuthnSession(sessionID string) (webAuthn bool, err error) {
+	err = o.q.Get(&webAuthn, "SELECT web_authn FROM sessions WHERE id = $1", sessionID)
+	return webAuthn, errors.Wrap(err, "IsWebAuthnSession failed")
+}
+
+// DeletePasswordSessions deletes the sessions of a user whose login was not
+// verified by a WebAuthn assertion.
+func (o *orm) DeletePasswordSessions(email string) error {
+	_, err := o.q.Exec("DELETE FROM sessions WHERE email = lower($1) AND NOT web_authn", email)
+	return errors.Wrap(err, "DeletePasswordSessions failed")
+}
+
 // DeleteUserSession will delete a session by ID.
 func (o *orm) DeleteUserSession(sessionID string) error {
 	_, err := o.q.Exec("DELETE FROM sessions WHERE id = $1", sessionID)
@@ -149,7 +171,7 @@ func (o *orm) DeleteUserSession(sessionID string) error {
 // token multiple times.
 func (o *orm) GetUserWebAuthn(email string) ([]WebAuthn, error) {
 	var uwas []WebAuthn
-	err := o.q.Select(&uwas, "SELECT email, public_key_data FROM web_authns WHERE LOWER(email) = $1", strings.ToLower(email))
+	err := o.q.Select(&uwas, "SELECT id, email, name, public_key_data, created_at FROM web_authns WHERE LOWER(email) = $1 ORDER BY id", strings.ToLower(email))
 	if err != nil {
 		return uwas, err
 	}
@@ -190,9 +212,7 @@ func (o *orm) CreateSession(sr SessionRequest) (string, error) {
 	// No webauthn tokens registered for the current user, so normal authentication is now complete
 	if len(uwas) == 0 {
 		lggr.Infof("No MFA for user. Creating Session")
-		session := NewSession()
-		_, err = o.q.Exec("INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, now(), now())", session.ID, user.Email)
-		return session.ID, err
+		return o.insertSession(user, sr, false)
 	}
 
 	// Next check if this session request includes the required WebAuthn challenge data
@@ -228,12 +248,15 @@ func (o *orm) CreateSession(sr SessionRequest) (string, error) {
 
 	lggr.Infof("User passed MFA authentication and login will proceed")
 	// This is a success so we can create the sessions
+	return o.insertSession(user, sr, true)
+}
+
+func (o *orm) insertSession(user User, sr SessionRequest, webAuthn bool) (string, error) {
 	session := NewSession()
-	_, err = o.q.Exec("INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, now(), now())", session.ID, user.Email)
+	_, err := o.q.Exec("INSERT INTO sessions (id, email, last_used, created_at, ip_address, user_agent, web_authn) VALUES ($1, $2, now(), now(), $3, $4, $5)", session.ID, user.Email, sr.IPAddress, sr.UserAgent, webAuthn)
 	if err != nil {
 		return "", err
 	}
-
 	return session.ID, nil
 }
 
@@ -309,6 +332,8 @@ func (o *orm) SetPassword(user *User, newPassword string) error {
 	return o.q.Get(user, sql, hashedPassword, user.Email)
 }
 
+// CreateAndSetAuthToken replaces the user's default API token with a newly
+// generated one.
 func (o *orm) CreateAndSetAuthToken(user *User) (*auth.Token, error) {
 	newToken := auth.NewToken()
 
@@ -320,30 +345,88 @@ func (o *orm) CreateAndSetAuthToken(user *User) (*auth.Token, error) {
 	return newToken, nil
 }
 
-// SetAuthToken updates the user to use the given Authentication Token.
+// SetAuthToken replaces the user's default API token with the given
+// Authentication Token. The default token has the admin scope and no expiry.
 func (o *orm) SetAuthToken(user *User, token *auth.Token) error {
-	salt := utils.NewSecret(utils.DefaultSecretSize)
-	hashedSecret, err := auth.HashedSecret(token, salt)
-	if err != nil {
-		return errors.Wrap(err, "user")
+	apiToken := APIToken{UserEmail: user.Email, Name: DefaultAPITokenName, Scope: APITokenScopeAdmin}
+	if err := apiToken.SetAuthToken(token); err != nil {
+		return err
 	}
-	sql := "UPDATE users SET token_salt = $1, token_key = $2, token_hashed_secret = $3, updated_at = now() WHERE email = $4 RETURNING *"
-	return o.q.Get(user, sql, salt, token.AccessKey, hashedSecret, user.Email)
+	sql := `INSERT INTO api_tokens (user_email, name, scope, token_key, token_salt, token_hashed_secret, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, NOW())
+ON CONFLICT (user_email, name) DO UPDATE SET
+scope = EXCLUDED.scope, token_key = EXCLUDED.token_key, token_salt = EXCLUDED.token_salt, token_hashed_secret = EXCLUDED.token_hashed_secret,
+expires_at = NULL, last_used_at = NULL, created_at = EXCLUDED.created_at`
+	_, err := o.q.Exec(sql, apiToken.UserEmail, apiToken.Name, apiToken.Scope, apiToken.TokenKey, apiToken.TokenSalt, apiToken.TokenHashedSecret)
+	return errors.Wrap(err, "failed to set API token")
 }
 
-// DeleteAuthToken clears and disables the users Authentication Token.
-func (o *orm) DeleteAuthToken(user *User) error {
-	sql := "UPDATE users SET token_salt = '', token_key = '', token_hashed_secret = '', updated_at = now() WHERE email = $1 RETURNING *"
-	return o.q.Get(user, sql, user.Email)
+// CreateAPIToken generates a new Authentication Token and saves it as apiToken.
+// The token names of a user are unique.
+func (o *orm) CreateAPIToken(apiToken *APIToken) (*auth.Token, error) {
+	token := auth.NewToken()
+	if err := apiToken.SetAuthToken(token); err != nil {
+		return nil, err
+	}
+	sql := `INSERT INTO api_tokens (user_email, name, scope, token_key, token_salt, token_hashed_secret, expires_at, created_at)
+VALUES (:user_email, :name, :scope, :token_key, :token_salt, :token_hashed_secret, :expires_at, NOW()) RETURNING id, created_at`
+	if err := o.q.GetNamed(sql, apiToken, apiToken); err != nil {
+		return nil, errors.Wrap(err, "failed to create API token")
+	}
+	return token, nil
+}
+
+// FindAPIToken returns the API token with the given access key
+func (o *orm) FindAPIToken(accessKey string) (apiToken APIToken, err error) {
+	err = o.q.Get(&apiToken, "SELECT * FROM api_tokens WHERE token_key = $1", accessKey)
+	return
+}
+
+// ListAPITokens returns the API tokens of a user
+func (o *orm) ListAPITokens(email string) (apiTokens []APIToken, err error) {
+	err = o.q.Select(&apiTokens, "SELECT * FROM api_tokens WHERE lower(user_email) = lower($1) ORDER BY name", email)
+	return
+}
+
+// DeleteAPIToken deletes the named API token of a user.
+// Returns sql.ErrNoRows if it does not exist
+func (o *orm) DeleteAPIToken(email, name string) (apiToken APIToken, err error) {
+	err = o.q.Get(&apiToken, "DELETE FROM api_tokens WHERE lower(user_email) = lower($1) AND name = $2 RETURNING *", email, name)
+	return
+}
+
+// MarkAPITokenUsed records that an API token was just used. The time is only
+// updated once a minute to avoid a write for every request.
+func (o *orm) MarkAPITokenUsed(id int64) error {
+	_, err := o.q.Exec(`UPDATE api_tokens SET last_used_at = NOW()
+WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < NOW() - interval '1 minute')`, id)
+	return err
 }
 
 // SaveWebAuthn saves new WebAuthn token information.
 func (o *orm) SaveWebAuthn(token *WebAuthn) error {
-	sql := "INSERT INTO web_authns (email, public_key_data) VALUES ($1, $2)"
-	_, err := o.q.Exec(sql, token.Email, token.PublicKeyData)
+	sql := "INSERT INTO web_authns (email, name, public_key_data) VALUES ($1, $2, $3)"
+	_, err := o.q.Exec(sql, token.Email, token.Name, token.PublicKeyData)
 	return err
 }
 
+// DeleteWebAuthn deletes one of the user's WebAuthn tokens.
+// Returns sql.ErrNoRows if the user has no token with this ID.
+func (o *orm) DeleteWebAuthn(email string, id int64) error {
+	res, err := o.q.Exec("DELETE FROM web_authns WHERE id = $1 AND LOWER(email) = $2", id, strings.ToLower(email))
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
 // Sessions returns all sessions limited by the parameters.
 func (o *orm) Sessions(offset, limit int) (sessions []Session, err error) {
 	sql := `SELECT * FROM sessions ORDER BY created_at, id LIMIT $1 OFFSET $2;`
@@ -353,7 +436,64 @@ func (o *orm) Sessions(offset, limit int) (sessions []Session, err error) {
 	return
 }
 
-// NOTE: this is duplicated from the bridges ORM to appease the AuthStorer interface
+// validSessionSQL is the condition for a session s of user u to be
+// unexpired, given the node's idle timeout as the timeoutParam placeholder
+func validSessionSQL(timeoutParam string) string {
+	return `s.last_used + COALESCE(u.session_idle_timeout / 1000 * interval '1 microsecond', ` + timeoutParam + `) >= now()
+AND (u.session_absolute_timeout IS NULL OR s.created_at + u.session_absolute_timeout / 1000 * interval '1 microsecond' >= now())`
+}
+
+// ListSessions returns the unexpired sessions of a user, oldest first.
+func (o *orm) ListSessions(email string) (sessions []Session, err error) {
+	sql := `SELECT s.* FROM sessions s JOIN users u ON u.email = s.email
+WHERE s.email = lower($1) AND ` + validSessionSQL("$2") + `
+ORDER BY s.created_at, s.id`
+	err = o.q.Select(&sessions, sql, email, o.sessionDuration)
+	return sessions, errors.Wrap(err, "ListSessions failed")
+}
+
+// RevokeSession deletes the session of a user with the given public ID.
+// Returns sql.ErrNoRows if it does not exist.
+func (o *orm) RevokeSession(email, publicID string) error {
+	return o.q.Transaction(func(tx pg.Queryer) error {
+		var sessions []Session
+		if err := tx.Select(&sessions, "SELECT * FROM sessions WHERE email = lower($1) FOR UPDATE", email); err != nil {
+			return errors.Wrap(err, "RevokeSession failed")
+		}
+		for _, session := range sessions {
+			if session.PublicID() == publicID {
+				_, err := tx.Exec("DELETE FROM sessions WHERE id = $1", session.ID)
+				return errors.Wrap(err, "RevokeSession failed")
+			}
+		}
+		return sql.ErrNoRows
+	})
+}
+
+// SetSessionTimeouts sets the idle and absolute session timeouts of a user,
+// nil timeouts use the node's defaults. Existing sessions are not revoked.
+func (o *orm) SetSessionTimeouts(email string, idle, absolute *models.Interval) (user User, err error) {
+	var idleNanos, absoluteNanos null.Int
+	if idle != nil {
+		if idle.Duration() <= 0 {
+			return user, errors.New("idle timeout must be positive")
+		}
+		idleNanos = null.IntFrom(idle.Duration().Nanoseconds())
+	}
+	if absolute != nil {
+		if absolute.Duration() <= 0 {
+			return user, errors.New("absolute timeout must be positive")
+		}
+		absoluteNanos = null.IntFrom(absolute.Duration().Nanoseconds())
+	}
+	sql := "UPDATE users SET session_idle_timeout = $1, session_absolute_timeout = $2, updated_at = now() WHERE lower(email) = lower($3) RETURNING *"
+	err = o.q.Get(&user, sql, idleNanos, absoluteNanos, email)
+	return user, err
+}
+
+// NOTE: this is duplicated from the bridges ORM to appease the AuthStorer interface.
+// The outgoing token and secret are left encrypted, as they are not needed to
+// authenticate the external initiator.
 func (o *orm) FindExternalInitiator(
 	eia *auth.Token,
 ) (*bridges.ExternalInitiator, error) {
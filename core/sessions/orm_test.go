@@ -1,6 +1,7 @@
 package sessions_test
 
 import (
+	"database/sql"
 	"encoding/json"
 	"testing"
 	"time"
@@ -9,6 +10,7 @@ import (
 	"github.com/duo-labs/webauthn/webauthn"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/sqlx"
 
@@ -17,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -201,7 +204,7 @@ func TestORM_WebAuthn(t *testing.T) {
 		PublicKey:       []byte("test-key"),
 		AttestationType: "test-attestation",
 	}
-	require.NoError(t, sessions.AddCredentialToUser(orm, initial.Email, &cred))
+	require.NoError(t, sessions.AddCredentialToUser(orm, initial.Email, "yubikey", &cred))
 
 	was, err = orm.GetUserWebAuthn(initial.Email)
 	require.NoError(t, err)
@@ -264,6 +267,39 @@ func TestORM_WebAuthn(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestORM_WebAuthn_MultipleDevices(t *testing.T) {
+	t.Parallel()
+
+	_, orm := setupORM(t)
+
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, orm.CreateUser(&user))
+	other := cltest.MustRandomUser(t)
+	require.NoError(t, orm.CreateUser(&other))
+
+	for _, name := range []string{"yubikey", "laptop"} {
+		cred := webauthn.Credential{ID: []byte(name), PublicKey: []byte("test-key")}
+		require.NoError(t, sessions.AddCredentialToUser(orm, user.Email, name, &cred))
+	}
+
+	was, err := orm.GetUserWebAuthn(user.Email)
+	require.NoError(t, err)
+	require.Len(t, was, 2)
+	assert.Equal(t, "yubikey", was[0].Name)
+	assert.Equal(t, "laptop", was[1].Name)
+	assert.False(t, was[0].CreatedAt.IsZero())
+
+	// users can only delete their own devices
+	require.ErrorIs(t, orm.DeleteWebAuthn(other.Email, was[0].ID), sql.ErrNoRows)
+	require.NoError(t, orm.DeleteWebAuthn(user.Email, was[0].ID))
+	require.ErrorIs(t, orm.DeleteWebAuthn(user.Email, was[0].ID), sql.ErrNoRows)
+
+	was, err = orm.GetUserWebAuthn(user.Email)
+	require.NoError(t, err)
+	require.Len(t, was, 1)
+	assert.Equal(t, "laptop", was[0].Name)
+}
+
 func TestOrm_GenerateAuthToken(t *testing.T) {
 	t.Parallel()
 
@@ -275,22 +311,162 @@ func TestOrm_GenerateAuthToken(t *testing.T) {
 	token, err := orm.CreateAndSetAuthToken(&initial)
 	require.NoError(t, err)
 
-	dbUser, err := orm.FindUser(initial.Email)
+	apiToken, err := orm.FindAPIToken(token.AccessKey)
 	require.NoError(t, err)
 
-	hashedSecret, err := auth.HashedSecret(token, dbUser.TokenSalt.String)
+	hashedSecret, err := auth.HashedSecret(token, apiToken.TokenSalt)
 	require.NoError(t, err)
 
 	assert.NotNil(t, token)
 	assert.NotNil(t, token.Secret)
 	assert.NotEmpty(t, token.AccessKey)
-	assert.Equal(t, dbUser.TokenKey.String, token.AccessKey)
-	assert.Equal(t, dbUser.TokenHashedSecret.String, hashedSecret)
+	assert.Equal(t, initial.Email, apiToken.UserEmail)
+	assert.Equal(t, sessions.DefaultAPITokenName, apiToken.Name)
+	assert.Equal(t, sessions.APITokenScopeAdmin, apiToken.Scope)
+	assert.Equal(t, apiToken.TokenHashedSecret, hashedSecret)
+
+	// Replaces the default token
+	newToken, err := orm.CreateAndSetAuthToken(&initial)
+	require.NoError(t, err)
+	_, err = orm.FindAPIToken(token.AccessKey)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	deleted, err := orm.DeleteAPIToken(initial.Email, sessions.DefaultAPITokenName)
+	require.NoError(t, err)
+	assert.Equal(t, newToken.AccessKey, deleted.TokenKey)
+	_, err = orm.FindAPIToken(newToken.AccessKey)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestORM_APITokens(t *testing.T) {
+	t.Parallel()
+
+	_, orm := setupORM(t)
+
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, orm.CreateUser(&user))
+
+	expiresAt := time.Now().Add(time.Hour)
+	ci := sessions.APIToken{UserEmail: user.Email, Name: "ci", Scope: sessions.APITokenScopeJobManagement, ExpiresAt: null.TimeFrom(expiresAt)}
+	token, err := orm.CreateAPIToken(&ci)
+	require.NoError(t, err)
+	assert.NotZero(t, ci.ID)
+
+	_, err = orm.CreateAPIToken(&sessions.APIToken{UserEmail: user.Email, Name: "ci", Scope: sessions.APITokenScopeReadOnly})
+	require.Error(t, err, "token names must be unique per user")
+
+	monitoring := sessions.APIToken{UserEmail: user.Email, Name: "monitoring", Scope: sessions.APITokenScopeReadOnly}
+	_, err = orm.CreateAPIToken(&monitoring)
+	require.NoError(t, err)
+
+	found, err := orm.FindAPIToken(token.AccessKey)
+	require.NoError(t, err)
+	assert.Equal(t, ci.ID, found.ID)
+	assert.Equal(t, sessions.APITokenScopeJobManagement, found.Scope)
+	assert.WithinDuration(t, expiresAt, found.ExpiresAt.Time, time.Second)
+	assert.False(t, found.LastUsedAt.Valid)
+	ok, err := sessions.AuthenticateAPIToken(token, &found)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	require.NoError(t, orm.MarkAPITokenUsed(ci.ID))
+	found, err = orm.FindAPIToken(token.AccessKey)
+	require.NoError(t, err)
+	assert.True(t, found.LastUsedAt.Valid)
+
+	tokens, err := orm.ListAPITokens(user.Email)
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+	assert.Equal(t, "ci", tokens[0].Name)
+	assert.Equal(t, "monitoring", tokens[1].Name)
+
+	_, err = orm.DeleteAPIToken(user.Email, "ci")
+	require.NoError(t, err)
+	_, err = orm.DeleteAPIToken(user.Email, "ci")
+	require.ErrorIs(t, err, sql.ErrNoRows)
 
-	require.NoError(t, orm.DeleteAuthToken(&initial))
-	dbUser, err = orm.FindUser(initial.Email)
+	// Deleting the user deletes their tokens
+	require.NoError(t, orm.DeleteUser(user.Email))
+	tokens, err = orm.ListAPITokens(user.Email)
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+}
+
+func TestORM_SessionManagement(t *testing.T) {
+	t.Parallel()
+
+	db, orm := setupORM(t)
+
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, orm.CreateUser(&user))
+
+	request := sessions.SessionRequest{Email: user.Email, Password: cltest.Password, IPAddress: "10.0.0.1", UserAgent: "curl/7.79.1"}
+	firstID, err := orm.CreateSession(request)
+	require.NoError(t, err)
+	secondID, err := orm.CreateSession(request)
+	require.NoError(t, err)
+
+	list, err := orm.ListSessions(user.Email)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, "10.0.0.1", list[0].IPAddress)
+	assert.Equal(t, "curl/7.79.1", list[0].UserAgent)
+
+	require.NoError(t, orm.RevokeSession(user.Email, sessions.Session{ID: firstID}.PublicID()))
+	require.ErrorIs(t, orm.RevokeSession(user.Email, sessions.Session{ID: firstID}.PublicID()), sql.ErrNoRows)
+	require.ErrorIs(t, orm.RevokeSession(cltest.APIEmailAdmin, sessions.Session{ID: secondID}.PublicID()), sql.ErrNoRows, "sessions can only be revoked for their user")
+	_, err = orm.AuthorizedUserWithSession(firstID)
+	require.Error(t, err)
+
+	_, err = orm.SetSessionTimeouts(user.Email, models.NewInterval(-time.Second), nil)
+	require.Error(t, err)
+	_, err = orm.SetSessionTimeouts("unknown@chainlink.test", nil, nil)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	updated, err := orm.SetSessionTimeouts(user.Email, models.NewInterval(time.Hour), models.NewInterval(2*time.Hour))
+	require.NoError(t, err)
+	require.NotNil(t, updated.SessionIdleTimeout)
+	assert.Equal(t, time.Hour, updated.SessionIdleTimeout.Duration())
+
+	// The idle timeout overrides the node's one minute timeout
+	_, err = db.Exec("UPDATE sessions SET last_used = now() - interval '30 minutes' WHERE id = $1", secondID)
+	require.NoError(t, err)
+	_, err = orm.AuthorizedUserWithSession(secondID)
+	require.NoError(t, err)
+
+	// Sessions expire after the absolute timeout, even when active
+	_, err = db.Exec("UPDATE sessions SET created_at = now() - interval '3 hours' WHERE id = $1", secondID)
+	require.NoError(t, err)
+	_, err = orm.AuthorizedUserWithSession(secondID)
+	require.ErrorIs(t, err, sessions.ErrUserSessionExpired)
+	list, err = orm.ListSessions(user.Email)
+	require.NoError(t, err)
+	assert.Empty(t, list)
+}
+
+func TestORM_DeletePasswordSessions(t *testing.T) {
+	t.Parallel()
+
+	db, orm := setupORM(t)
+
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, orm.CreateUser(&user))
+	passwordID, err := orm.CreateSession(sessions.SessionRequest{Email: user.Email, Password: cltest.Password})
+	require.NoError(t, err)
+	webAuthnID := sessions.NewSession().ID
+	_, err = db.Exec("INSERT INTO sessions (id, email, last_used, created_at, web_authn) VALUES ($1, $2, now(), now(), true)", webAuthnID, user.Email)
+	require.NoError(t, err)
+
+	webAuthn, err := orm.IsWebAuthnSession(passwordID)
+	require.NoError(t, err)
+	assert.False(t, webAuthn)
+	webAuthn, err = orm.IsWebAuthnSession(webAuthnID)
+	require.NoError(t, err)
+	assert.True(t, webAuthn)
+
+	require.NoError(t, orm.DeletePasswordSessions(user.Email))
+	_, err = orm.AuthorizedUserWithSession(passwordID)
+	require.Error(t, err)
+	_, err = orm.AuthorizedUserWithSession(webAuthnID)
 	require.NoError(t, err)
-	assert.Empty(t, dbUser.TokenKey.ValueOrZero())
-	assert.Empty(t, dbUser.TokenSalt.ValueOrZero())
-	assert.Empty(t, dbUser.TokenHashedSecret.ValueOrZero())
 }
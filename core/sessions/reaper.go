@@ -34,16 +34,17 @@ func (sr *sessionReaper) Name() string {
 }
 
 func (sr *sessionReaper) Work() {
-	recordCreationStaleThreshold := sr.config.ReaperExpiration().Before(
-		sr.config.SessionTimeout().Before(time.Now()))
-	err := sr.deleteStaleSessions(recordCreationStaleThreshold)
+	err := sr.deleteStaleSessions(sr.config.ReaperExpiration().Before(time.Now()))
 	if err != nil {
 		sr.lggr.Error("unable to reap stale sessions: ", err)
 	}
 }
 
-// DeleteStaleSessions deletes all sessions before the passed time.
+// DeleteStaleSessions deletes all sessions that expired before the passed
+// time, using the session timeouts of their user.
 func (sr *sessionReaper) deleteStaleSessions(before time.Time) error {
-	_, err := sr.db.Exec("DELETE FROM sessions WHERE last_used < $1", before)
+	_, err := sr.db.Exec(`DELETE FROM sessions s USING users u WHERE u.email = s.email
+AND (s.last_used + COALESCE(u.session_idle_timeout / 1000 * interval '1 microsecond', $2) < $1
+OR s.created_at + u.session_absolute_timeout / 1000 * interval '1 microsecond' < $1)`, before, sr.config.SessionTimeout().Duration())
 	return err
 }
@@ -1,29 +1,31 @@
 package sessions
 
 import (
-	"crypto/subtle"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"net/mail"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
-	"gopkg.in/guregu/null.v4"
 
-	"github.com/smartcontractkit/chainlink/core/auth"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 // User holds the credentials for API user.
 type User struct {
-	Email             string
-	HashedPassword    string
-	Role              UserRole
-	CreatedAt         time.Time
-	TokenKey          null.String
-	TokenSalt         null.String
-	TokenHashedSecret null.String
-	UpdatedAt         time.Time
+	Email          string
+	HashedPassword string
+	Role           UserRole
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	// SessionIdleTimeout overrides the node's SESSION_TIMEOUT for this user
+	SessionIdleTimeout *models.Interval
+	// SessionAbsoluteTimeout limits the lifetime of this user's sessions,
+	// regardless of activity
+	SessionAbsoluteTimeout *models.Interval
 }
 
 type UserRole string
@@ -119,6 +121,8 @@ type SessionRequest struct {
 	WebAuthnConfig WebAuthnConfiguration
 	SessionStore   *WebAuthnSessionStore
 	RequestContext *gin.Context
+	IPAddress      string `json:"-"`
+	UserAgent      string `json:"-"`
 }
 
 // Session holds the unique id for the authenticated session.
@@ -127,6 +131,17 @@ type Session struct {
 	Email     string    `json:"email"`
 	LastUsed  time.Time `json:"lastUsed"`
 	CreatedAt time.Time `json:"createdAt"`
+	IPAddress string    `json:"ipAddress"`
+	UserAgent string    `json:"userAgent"`
+	// WebAuthn is set when the login was verified by a WebAuthn assertion
+	WebAuthn bool `json:"webAuthn"`
+}
+
+// PublicID identifies the session without revealing its ID, which is the
+// secret stored in the session cookie.
+func (s Session) PublicID() string {
+	sum := sha256.Sum256([]byte(s.ID))
+	return hex.EncodeToString(sum[:8])
 }
 
 // NewSession returns a session instance with ID set to a random ID and
@@ -142,33 +157,3 @@ func NewSession() Session {
 type ChangeAuthTokenRequest struct {
 	Password string `json:"password"`
 }
-
-// GenerateAuthToken randomly generates and sets the users Authentication
-// Token.
-func (u *User) GenerateAuthToken() (*auth.Token, error) {
-	token := auth.NewToken()
-	return token, u.SetAuthToken(token)
-}
-
-// SetAuthToken updates the user to use the given Authentication Token.
-func (u *User) SetAuthToken(token *auth.Token) error {
-	salt := utils.NewSecret(utils.DefaultSecretSize)
-	hashedSecret, err := auth.HashedSecret(token, salt)
-	if err != nil {
-		return errors.Wrap(err, "user")
-	}
-	u.TokenSalt = null.StringFrom(salt)
-	u.TokenKey = null.StringFrom(token.AccessKey)
-	u.TokenHashedSecret = null.StringFrom(hashedSecret)
-	return nil
-}
-
-// AuthenticateUserByToken returns true on successful authentication of the
-// user against the given Authentication Token.
-func AuthenticateUserByToken(token *auth.Token, user *User) (bool, error) {
-	hashedSecret, err := auth.HashedSecret(token, user.TokenSalt.ValueOrZero())
-	if err != nil {
-		return false, err
-	}
-	return subtle.ConstantTimeCompare([]byte(hashedSecret), []byte(user.TokenHashedSecret.ValueOrZero())) == 1, nil
-}
@@ -6,10 +6,8 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/utils"
-	"gopkg.in/guregu/null.v4"
 
 	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/require"
 )
 
 func TestNewUser(t *testing.T) {
@@ -47,27 +45,3 @@ func TestNewUser(t *testing.T) {
 		})
 	}
 }
-
-func TestUserGenerateAuthToken(t *testing.T) {
-	var user sessions.User
-	token, err := user.GenerateAuthToken()
-	require.NoError(t, err)
-	assert.Equal(t, null.StringFrom(token.AccessKey), user.TokenKey)
-	assert.NotEqual(t, null.StringFrom(token.Secret), user.TokenHashedSecret)
-}
-
-func TestAuthenticateUserByToken(t *testing.T) {
-	var user sessions.User
-
-	token, err := user.GenerateAuthToken()
-	assert.NoError(t, err, "failed when generate auth token")
-	ok, err := sessions.AuthenticateUserByToken(token, &user)
-	require.NoError(t, err)
-	assert.True(t, ok, "authentication must be successful")
-
-	_, err = user.GenerateAuthToken()
-	assert.NoError(t, err, "failed to generate auth token")
-	ok, err = sessions.AuthenticateUserByToken(token, &user)
-	require.NoError(t, err)
-	assert.False(t, ok, "authentication must fail with past token")
-}
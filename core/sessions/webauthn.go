@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/duo-labs/webauthn/protocol"
 	"github.com/duo-labs/webauthn/webauthn"
@@ -13,10 +14,14 @@ import (
 	sqlxTypes "github.com/smartcontractkit/sqlx/types"
 )
 
-// WebAuthn holds the credentials for API user.
+// WebAuthn holds the credentials for API user. A user can register several
+// devices, each with its own credential.
 type WebAuthn struct {
+	ID            int64
 	Email         string
+	Name          string
 	PublicKeyData sqlxTypes.JSONText
+	CreatedAt     time.Time
 }
 
 // WebAuthnUser implements the required duo-labs/webauthn/ 'User' interface
@@ -279,7 +284,7 @@ func (store *WebAuthnSessionStore) GetWebauthnSession(key string) (data webauthn
 	return
 }
 
-func AddCredentialToUser(o ORM, email string, credential *webauthn.Credential) error {
+func AddCredentialToUser(o ORM, email, name string, credential *webauthn.Credential) error {
 	credj, err := json.Marshal(credential)
 	if err != nil {
 		return err
@@ -287,6 +292,7 @@ func AddCredentialToUser(o ORM, email string, credential *webauthn.Credential) e
 
 	token := WebAuthn{
 		Email:         email,
+		Name:          name,
 		PublicKeyData: sqlxTypes.JSONText(credj),
 	}
 	return o.SaveWebAuthn(&token)
@@ -5,7 +5,10 @@ import (
 	"database/sql"
 	"embed"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -107,15 +110,24 @@ func Migrate(db *sql.DB, lggr logger.Logger) error {
 	ensureMigrated(db, lggr)
 	// WithAllowMissing is necessary when upgrading from 0.10.14 since it
 	// includes out-of-order migrations
-	return goose.Up(db, MIGRATIONS_DIR, goose.WithAllowMissing())
+	if err := goose.Up(db, MIGRATIONS_DIR, goose.WithAllowMissing()); err != nil {
+		return err
+	}
+	return recordSchemaChecksum(db, false)
 }
 
 func Rollback(db *sql.DB, lggr logger.Logger, version null.Int) error {
 	ensureMigrated(db, lggr)
+	var err error
 	if version.Valid {
-		return goose.DownTo(db, MIGRATIONS_DIR, version.Int64)
+		err = goose.DownTo(db, MIGRATIONS_DIR, version.Int64)
+	} else {
+		err = goose.Down(db, MIGRATIONS_DIR)
 	}
-	return goose.Down(db, MIGRATIONS_DIR)
+	if err != nil {
+		return err
+	}
+	return deleteStaleSchemaChecksums(db)
 }
 
 func Current(db *sql.DB, lggr logger.Logger) (int64, error) {
@@ -123,6 +135,54 @@ func Current(db *sql.DB, lggr logger.Logger) (int64, error) {
 	return goose.EnsureDBVersion(db)
 }
 
+// Pending returns the number of migrations that have not been applied to db
+func Pending(db *sql.DB) (int, error) {
+	current, err := goose.GetDBVersion(db)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get database version")
+	}
+	migrations, err := goose.CollectMigrations(MIGRATIONS_DIR, current, goose.MaxVersion)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to collect migrations")
+	}
+	return len(migrations), nil
+}
+
+var gooseDownRegexp = regexp.MustCompile(`(?m)^--\s*\+goose Down`)
+
+// WritePendingSQL writes the SQL of the migrations that have not been applied
+// to db, without executing it
+func WritePendingSQL(db *sql.DB, w io.Writer) error {
+	current, err := goose.GetDBVersion(db)
+	if err != nil {
+		return errors.Wrap(err, "failed to get database version")
+	}
+	migrations, err := goose.CollectMigrations(MIGRATIONS_DIR, current, goose.MaxVersion)
+	if err != nil {
+		return errors.Wrap(err, "failed to collect migrations")
+	}
+	for _, m := range migrations {
+		if filepath.Ext(m.Source) != ".sql" {
+			if _, err = fmt.Fprintf(w, "-- %s: Go migration, its statements can't be printed\n\n", filepath.Base(m.Source)); err != nil {
+				return err
+			}
+			continue
+		}
+		b, err := embedMigrations.ReadFile(m.Source)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read migration %s", m.Source)
+		}
+		up := string(b)
+		if loc := gooseDownRegexp.FindStringIndex(up); loc != nil {
+			up = up[:loc[0]]
+		}
+		if _, err = fmt.Fprintf(w, "-- %s\n%s\n\n", filepath.Base(m.Source), strings.TrimSpace(up)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func Status(db *sql.DB, lggr logger.Logger) error {
 	ensureMigrated(db, lggr)
 	return goose.Status(db, MIGRATIONS_DIR)
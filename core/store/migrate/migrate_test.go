@@ -1,12 +1,14 @@
 package migrate_test
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
 	"github.com/lib/pq"
 	"github.com/pressly/goose/v3"
 	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 
@@ -392,9 +394,17 @@ func TestMigrate(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, int64(100), ver)
 
+	pending, err := migrate.Pending(db.DB)
+	require.NoError(t, err)
+	require.Greater(t, pending, 0)
+
 	err = migrate.Migrate(db.DB, lggr)
 	require.NoError(t, err)
 
+	pending, err = migrate.Pending(db.DB)
+	require.NoError(t, err)
+	require.Equal(t, 0, pending)
+
 	err = migrate.Rollback(db.DB, lggr, null.IntFrom(99))
 	require.NoError(t, err)
 
@@ -402,3 +412,37 @@ func TestMigrate(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, int64(99), ver)
 }
+
+func TestMigrate_WritePendingSQL(t *testing.T) {
+	_, db := heavyweight.FullTestDBEmpty(t, migrationDir)
+	require.NoError(t, goose.UpTo(db.DB, migrationDir, 163))
+
+	var b bytes.Buffer
+	require.NoError(t, migrate.WritePendingSQL(db.DB, &b))
+	assert.Contains(t, b.String(), "-- 0164_schema_checksums.sql\n-- +goose Up\nCREATE TABLE schema_checksums")
+	assert.NotContains(t, b.String(), "DROP TABLE schema_checksums")
+	assert.NotContains(t, b.String(), "0163_")
+
+	// nothing is executed
+	ver, err := goose.GetDBVersion(db.DB)
+	require.NoError(t, err)
+	assert.Equal(t, int64(163), ver)
+}
+
+func TestMigrate_CheckSchema(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	_, db := heavyweight.FullTestDBEmpty(t, migrationDir)
+	require.NoError(t, migrate.Migrate(db.DB, lggr))
+	require.NoError(t, migrate.CheckSchema(db.DB))
+
+	_, err := db.Exec(`ALTER TABLE jobs ADD COLUMN drifted boolean`)
+	require.NoError(t, err)
+	require.ErrorIs(t, migrate.CheckSchema(db.DB), migrate.ErrSchemaDrift)
+
+	// migrating again doesn't hide the drift
+	require.NoError(t, migrate.Migrate(db.DB, lggr))
+	require.ErrorIs(t, migrate.CheckSchema(db.DB), migrate.ErrSchemaDrift)
+
+	require.NoError(t, migrate.AcceptSchema(db.DB))
+	require.NoError(t, migrate.CheckSchema(db.DB))
+}
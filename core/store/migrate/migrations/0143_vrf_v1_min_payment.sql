@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE vrf_specs
+    ADD COLUMN "min_contract_payment" numeric(78,0),
+    ADD COLUMN "link_eth_feed_address" bytea
+    CHECK (octet_length(link_eth_feed_address) = 20);
+
+-- +goose Down
+ALTER TABLE vrf_specs
+    DROP COLUMN "min_contract_payment",
+    DROP COLUMN "link_eth_feed_address";
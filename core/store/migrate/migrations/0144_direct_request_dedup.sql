@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE direct_request_specs
+    ADD COLUMN "dedup_key" text,
+    ADD COLUMN "dedup_ttl" bigint;
+
+-- +goose Down
+ALTER TABLE direct_request_specs
+    DROP COLUMN "dedup_key",
+    DROP COLUMN "dedup_ttl";
@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE vrf_requests (
+    job_id integer NOT NULL REFERENCES jobs(id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    request_id numeric(78,0) NOT NULL,
+    block_hash bytea NOT NULL CHECK (octet_length(block_hash) = 32),
+    block_number bigint NOT NULL,
+    created_at timestamptz NOT NULL,
+    PRIMARY KEY (job_id, request_id)
+);
+
+CREATE INDEX idx_vrf_requests_job_id_block_number ON vrf_requests (job_id, block_number);
+
+-- +goose Down
+DROP TABLE vrf_requests;
@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE evm_key_states
+    ADD COLUMN "max_daily_spend" numeric(78,0),
+    ADD COLUMN "max_in_flight_transactions" integer,
+    ADD COLUMN "enabled_job_types" text[];
+
+-- +goose Down
+ALTER TABLE evm_key_states
+    DROP COLUMN "max_daily_spend",
+    DROP COLUMN "max_in_flight_transactions",
+    DROP COLUMN "enabled_job_types";
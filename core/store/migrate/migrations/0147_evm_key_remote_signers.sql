@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE evm_key_remote_signers (
+    address bytea PRIMARY KEY,
+    type text NOT NULL,
+    url text NOT NULL,
+    key_name text NOT NULL DEFAULT '',
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    CONSTRAINT chk_address_length CHECK ((octet_length(address) = 20)),
+    CONSTRAINT chk_type CHECK (type IN ('clef', 'vault', 'grpc'))
+);
+
+-- +goose Down
+DROP TABLE evm_key_remote_signers;
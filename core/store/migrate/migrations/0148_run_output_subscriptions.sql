@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE run_output_subscriptions (
+    id BIGSERIAL PRIMARY KEY,
+    url text NOT NULL,
+    secret text NOT NULL,
+    job_id integer REFERENCES jobs (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    job_type text,
+    status text CHECK (status IN ('completed', 'errored')),
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL
+);
+
+-- +goose Down
+DROP TABLE run_output_subscriptions;
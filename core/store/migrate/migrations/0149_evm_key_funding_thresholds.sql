@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE evm_key_states
+    ADD COLUMN "low_balance" numeric(78,0),
+    ADD COLUMN "critical_balance" numeric(78,0),
+    ADD COLUMN "funding_job_id" uuid REFERENCES jobs (external_job_id) ON DELETE SET NULL DEFERRABLE INITIALLY IMMEDIATE;
+
+-- +goose Down
+ALTER TABLE evm_key_states
+    DROP COLUMN "low_balance",
+    DROP COLUMN "critical_balance",
+    DROP COLUMN "funding_job_id";
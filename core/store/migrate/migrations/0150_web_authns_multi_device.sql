@@ -0,0 +1,14 @@
+-- +goose Up
+DROP INDEX web_authns_email_idx;
+CREATE INDEX web_authns_email_idx ON web_authns (lower(email));
+ALTER TABLE web_authns
+    ADD COLUMN "name" text NOT NULL DEFAULT '',
+    ADD COLUMN "created_at" timestamptz NOT NULL DEFAULT NOW();
+
+-- +goose Down
+ALTER TABLE web_authns
+    DROP COLUMN "name",
+    DROP COLUMN "created_at";
+DELETE FROM web_authns a USING web_authns b WHERE lower(a.email) = lower(b.email) AND a.id > b.id;
+DROP INDEX web_authns_email_idx;
+CREATE UNIQUE INDEX web_authns_email_idx ON web_authns (lower(email));
@@ -0,0 +1,30 @@
+-- +goose Up
+CREATE TABLE api_tokens (
+    id BIGSERIAL PRIMARY KEY,
+    user_email text NOT NULL REFERENCES users (email) ON DELETE CASCADE,
+    name text NOT NULL CHECK (name != ''),
+    scope text NOT NULL CHECK (scope IN ('read-only', 'job-management', 'tx-management', 'admin')),
+    token_key text NOT NULL,
+    token_salt text NOT NULL,
+    token_hashed_secret text NOT NULL,
+    expires_at timestamptz,
+    last_used_at timestamptz,
+    created_at timestamptz NOT NULL
+);
+CREATE UNIQUE INDEX idx_api_tokens_token_key ON api_tokens (token_key);
+CREATE UNIQUE INDEX idx_api_tokens_user_email_name ON api_tokens (user_email, name);
+
+-- Existing tokens become the admin scoped default token of their user
+INSERT INTO api_tokens (user_email, name, scope, token_key, token_salt, token_hashed_secret, created_at)
+SELECT email, 'default', 'admin', token_key, token_salt, token_hashed_secret, updated_at FROM users
+WHERE token_key IS NOT NULL AND token_key != '';
+
+ALTER TABLE users DROP COLUMN token_key, DROP COLUMN token_salt, DROP COLUMN token_hashed_secret;
+
+-- +goose Down
+ALTER TABLE users ADD COLUMN token_key text, ADD COLUMN token_salt text, ADD COLUMN token_hashed_secret text;
+
+UPDATE users SET token_key = api_tokens.token_key, token_salt = api_tokens.token_salt, token_hashed_secret = api_tokens.token_hashed_secret
+FROM api_tokens WHERE api_tokens.user_email = users.email AND api_tokens.name = 'default';
+
+DROP TABLE api_tokens;
@@ -0,0 +1,29 @@
+-- +goose Up
+CREATE TABLE audit_events (
+    id BIGSERIAL PRIMARY KEY,
+    actor text NOT NULL,
+    auth_method text NOT NULL,
+    method text NOT NULL,
+    path text NOT NULL,
+    operation text NOT NULL DEFAULT '',
+    remote_ip text NOT NULL,
+    payload_hash text NOT NULL,
+    status integer NOT NULL,
+    created_at timestamptz NOT NULL
+);
+CREATE INDEX idx_audit_events_created_at ON audit_events (created_at);
+
+-- +goose StatementBegin
+CREATE FUNCTION audit_events_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER audit_events_append_only BEFORE UPDATE OR DELETE ON audit_events
+    FOR EACH ROW EXECUTE PROCEDURE audit_events_append_only();
+
+-- +goose Down
+DROP TABLE audit_events;
+DROP FUNCTION audit_events_append_only;
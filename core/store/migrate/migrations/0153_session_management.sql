@@ -0,0 +1,18 @@
+-- +goose Up
+ALTER TABLE sessions
+    ADD COLUMN ip_address text NOT NULL DEFAULT '',
+    ADD COLUMN user_agent text NOT NULL DEFAULT '';
+CREATE INDEX idx_sessions_email ON sessions (email);
+-- timeouts are in nanoseconds, NULL uses the node's SESSION_TIMEOUT or no absolute timeout
+ALTER TABLE users
+    ADD COLUMN session_idle_timeout bigint CHECK (session_idle_timeout > 0),
+    ADD COLUMN session_absolute_timeout bigint CHECK (session_absolute_timeout > 0);
+
+-- +goose Down
+ALTER TABLE users
+    DROP COLUMN session_idle_timeout,
+    DROP COLUMN session_absolute_timeout;
+DROP INDEX idx_sessions_email;
+ALTER TABLE sessions
+    DROP COLUMN ip_address,
+    DROP COLUMN user_agent;
@@ -0,0 +1,13 @@
+-- +goose Up
+-- highest block fully processed per listener, keyed by job and contract address
+CREATE TABLE log_broadcasts_checkpoints (
+    evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    job_id int4 NOT NULL,
+    address bytea NOT NULL,
+    block_number int8 NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    PRIMARY KEY (evm_chain_id, job_id, address)
+);
+
+-- +goose Down
+DROP TABLE log_broadcasts_checkpoints;
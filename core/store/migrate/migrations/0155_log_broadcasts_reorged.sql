@@ -0,0 +1,8 @@
+-- +goose Up
+-- set when the block of a consumed broadcast was orphaned by a reorg
+ALTER TABLE log_broadcasts ADD COLUMN reorged_at timestamp with time zone;
+CREATE INDEX idx_log_broadcasts_reorged ON log_broadcasts (evm_chain_id, job_id, block_number) WHERE reorged_at IS NOT NULL;
+
+-- +goose Down
+DROP INDEX idx_log_broadcasts_reorged;
+ALTER TABLE log_broadcasts DROP COLUMN reorged_at;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Needed to look up the transactions created by a job or a pipeline run
+CREATE INDEX idx_eth_txes_meta_job_id ON eth_txes ((meta->>'JobID')) WHERE meta->>'JobID' IS NOT NULL;
+CREATE INDEX idx_eth_txes_meta_run_id ON eth_txes ((meta->>'RunID')) WHERE meta->>'RunID' IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_eth_txes_meta_job_id;
+DROP INDEX idx_eth_txes_meta_run_id;
+-- +goose StatementEnd
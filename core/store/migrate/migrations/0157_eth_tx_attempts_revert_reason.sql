@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE eth_tx_attempts ADD COLUMN revert_reason text;
+
+-- +goose Down
+ALTER TABLE eth_tx_attempts DROP COLUMN revert_reason;
@@ -0,0 +1,10 @@
+-- +goose Up
+CREATE TABLE package_log_levels (
+	package text PRIMARY KEY,
+	log_level text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL
+);
+
+-- +goose Down
+DROP TABLE package_log_levels;
@@ -0,0 +1,14 @@
+-- +goose Up
+ALTER TABLE job_spec_errors ADD COLUMN error_class text, ADD COLUMN acknowledged_at timestamp with time zone;
+UPDATE job_spec_errors SET error_class = description;
+ALTER TABLE job_spec_errors ALTER COLUMN error_class SET NOT NULL;
+DROP INDEX job_spec_errors_v2_unique_idx;
+CREATE UNIQUE INDEX job_spec_errors_unique_idx ON job_spec_errors (job_id, error_class);
+
+-- +goose Down
+DROP INDEX job_spec_errors_unique_idx;
+-- the latest descriptions of different classes may be the same
+DELETE FROM job_spec_errors a USING job_spec_errors b
+WHERE a.job_id = b.job_id AND a.description = b.description AND a.id < b.id;
+CREATE UNIQUE INDEX job_spec_errors_v2_unique_idx ON job_spec_errors (job_id, description);
+ALTER TABLE job_spec_errors DROP COLUMN error_class, DROP COLUMN acknowledged_at;
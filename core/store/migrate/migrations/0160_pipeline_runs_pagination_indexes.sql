@@ -0,0 +1,13 @@
+-- +goose Up
+-- Support the cursor pagination of runs, sorted by (created_at, id), for all
+-- runs, the runs of a job and the runs in some states
+DROP INDEX IF EXISTS idx_pipeline_runs_created_at;
+CREATE INDEX idx_pipeline_runs_created_at ON pipeline_runs (created_at, id);
+CREATE INDEX idx_pipeline_runs_pipeline_spec_id_created_at ON pipeline_runs (pipeline_spec_id, created_at, id);
+CREATE INDEX idx_pipeline_runs_state_created_at ON pipeline_runs (state, created_at, id);
+
+-- +goose Down
+DROP INDEX idx_pipeline_runs_state_created_at;
+DROP INDEX idx_pipeline_runs_pipeline_spec_id_created_at;
+DROP INDEX IF EXISTS idx_pipeline_runs_created_at;
+CREATE INDEX idx_pipeline_runs_created_at ON pipeline_runs USING BTREE (created_at);
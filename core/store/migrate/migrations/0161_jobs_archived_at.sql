@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN archived_at timestamp with time zone;
+
+-- +goose Down
+ALTER TABLE jobs DROP COLUMN archived_at;
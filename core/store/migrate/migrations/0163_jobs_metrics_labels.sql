@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN metrics_labels jsonb NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE jobs DROP COLUMN metrics_labels;
@@ -0,0 +1,9 @@
+-- +goose Up
+CREATE TABLE schema_checksums (
+    version bigint PRIMARY KEY,
+    checksum text NOT NULL,
+    created_at timestamptz NOT NULL
+);
+
+-- +goose Down
+DROP TABLE schema_checksums;
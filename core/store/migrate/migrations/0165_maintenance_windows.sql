@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE maintenance_windows (
+    id BIGSERIAL PRIMARY KEY,
+    job_id integer REFERENCES jobs (id) ON DELETE CASCADE,
+    starts_at timestamptz NOT NULL,
+    ends_at timestamptz NOT NULL,
+    action text NOT NULL,
+    reason text NOT NULL DEFAULT '',
+    created_at timestamptz NOT NULL,
+    CONSTRAINT chk_maintenance_windows_ends_after_start CHECK (ends_at > starts_at),
+    CONSTRAINT chk_maintenance_windows_action CHECK (action IN ('skip', 'queue'))
+);
+CREATE INDEX idx_maintenance_windows_ends_at ON maintenance_windows (ends_at);
+
+-- +goose Down
+DROP TABLE maintenance_windows;
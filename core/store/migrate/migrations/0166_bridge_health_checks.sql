@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE bridge_health_checks (
+    id BIGSERIAL PRIMARY KEY,
+    bridge_name text NOT NULL REFERENCES bridge_types (name) ON DELETE CASCADE,
+    success boolean NOT NULL,
+    latency bigint NOT NULL,
+    error text,
+    created_at timestamptz NOT NULL
+);
+CREATE INDEX idx_bridge_health_checks_bridge_name_created_at ON bridge_health_checks (bridge_name, created_at);
+CREATE INDEX idx_bridge_health_checks_created_at ON bridge_health_checks (created_at);
+
+-- +goose Down
+DROP TABLE bridge_health_checks;
@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN run_dedup jsonb;
+CREATE TABLE pipeline_run_heartbeats (
+    id BIGSERIAL PRIMARY KEY,
+    pipeline_run_id BIGINT NOT NULL REFERENCES pipeline_runs (id) ON DELETE CASCADE,
+    created_at timestamptz NOT NULL,
+    finished_at timestamptz NOT NULL
+);
+CREATE INDEX idx_pipeline_run_heartbeats_pipeline_run_id ON pipeline_run_heartbeats (pipeline_run_id);
+
+-- +goose Down
+DROP TABLE pipeline_run_heartbeats;
+ALTER TABLE jobs DROP COLUMN run_dedup;
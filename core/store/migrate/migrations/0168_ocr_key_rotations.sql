@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE ocr_key_rotations (
+    id BIGSERIAL PRIMARY KEY,
+    key_type text NOT NULL,
+    old_key_bundle_id text NOT NULL,
+    new_key_bundle_id text NOT NULL,
+    state text NOT NULL,
+    job_ids integer[] NOT NULL DEFAULT '{}',
+    created_at timestamptz NOT NULL,
+    completed_at timestamptz,
+    CONSTRAINT chk_ocr_key_rotations_key_type CHECK (key_type IN ('ocr', 'ocr2')),
+    CONSTRAINT chk_ocr_key_rotations_state CHECK (state IN ('staged', 'completed'))
+);
+CREATE UNIQUE INDEX idx_ocr_key_rotations_staged_old_key_bundle_id ON ocr_key_rotations (key_type, old_key_bundle_id) WHERE state = 'staged';
+
+-- +goose Down
+DROP TABLE ocr_key_rotations;
@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE TABLE job_trigger_messages (
+    topic text NOT NULL,
+    message_id text NOT NULL,
+    pipeline_run_id BIGINT NOT NULL REFERENCES pipeline_runs (id) ON DELETE CASCADE,
+    created_at timestamptz NOT NULL,
+    PRIMARY KEY (topic, message_id)
+);
+CREATE INDEX idx_job_trigger_messages_pipeline_run_id ON job_trigger_messages (pipeline_run_id);
+
+-- +goose Down
+DROP TABLE job_trigger_messages;
@@ -0,0 +1,10 @@
+-- +goose Up
+CREATE TABLE external_databases (
+    name text PRIMARY KEY CHECK (name <> ''),
+    url text NOT NULL,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL
+);
+
+-- +goose Down
+DROP TABLE external_databases;
@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE pipeline_last_values (
+    pipeline_spec_id INT NOT NULL REFERENCES pipeline_specs (id) ON DELETE CASCADE DEFERRABLE,
+    dot_id text NOT NULL,
+    value numeric NOT NULL,
+    updated_at timestamptz NOT NULL,
+    PRIMARY KEY (pipeline_spec_id, dot_id)
+);
+
+-- +goose Down
+DROP TABLE pipeline_last_values;
@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE pipeline_state (
+    pipeline_spec_id INT NOT NULL REFERENCES pipeline_specs (id) ON DELETE CASCADE DEFERRABLE,
+    key text NOT NULL,
+    value jsonb NOT NULL,
+    updated_at timestamptz NOT NULL,
+    PRIMARY KEY (pipeline_spec_id, key)
+);
+
+-- +goose Down
+DROP TABLE pipeline_state;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN dependencies jsonb NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE jobs DROP COLUMN dependencies;
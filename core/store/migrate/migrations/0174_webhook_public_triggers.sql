@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE webhook_specs
+    ADD COLUMN public_trigger_token_hash text,
+    ADD COLUMN public_trigger_rate_limit integer,
+    ADD COLUMN public_trigger_secret text,
+    ADD CONSTRAINT chk_public_trigger CHECK (public_trigger_token_hash IS NOT NULL OR (public_trigger_rate_limit IS NULL AND public_trigger_secret IS NULL));
+CREATE UNIQUE INDEX idx_webhook_specs_public_trigger_token_hash ON webhook_specs (public_trigger_token_hash) WHERE public_trigger_token_hash IS NOT NULL;
+
+-- +goose Down
+ALTER TABLE webhook_specs
+    DROP COLUMN public_trigger_token_hash,
+    DROP COLUMN public_trigger_rate_limit,
+    DROP COLUMN public_trigger_secret;
@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE TABLE eth_key_balance_samples (
+    id BIGSERIAL PRIMARY KEY,
+    address bytea NOT NULL,
+    evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) ON DELETE CASCADE,
+    balance numeric(78,0) NOT NULL,
+    created_at timestamptz NOT NULL
+);
+CREATE INDEX idx_eth_key_balance_samples_address_created_at ON eth_key_balance_samples (address, created_at);
+
+-- +goose Down
+DROP TABLE eth_key_balance_samples;
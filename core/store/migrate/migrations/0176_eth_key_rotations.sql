@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE eth_key_rotations (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) ON DELETE CASCADE,
+    old_address bytea NOT NULL,
+    new_address bytea NOT NULL,
+    state text NOT NULL,
+    job_ids integer[] NOT NULL DEFAULT '{}',
+    sweep_eth_tx_id bigint REFERENCES eth_txes (id) ON DELETE SET NULL,
+    error text,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    completed_at timestamptz,
+    CONSTRAINT chk_eth_key_rotations_state CHECK (state IN ('migrating_jobs', 'waiting_for_transactions', 'sweeping', 'completed', 'errored'))
+);
+CREATE UNIQUE INDEX idx_eth_key_rotations_pending_old_address ON eth_key_rotations (evm_chain_id, old_address) WHERE state NOT IN ('completed', 'errored');
+
+-- +goose Down
+DROP TABLE eth_key_rotations;
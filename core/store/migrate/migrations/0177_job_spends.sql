@@ -0,0 +1,15 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN monthly_budget numeric(78,0) CHECK (monthly_budget > 0);
+CREATE TABLE job_spends (
+    eth_tx_id bigint PRIMARY KEY,
+    job_id integer NOT NULL REFERENCES jobs (id) ON DELETE CASCADE DEFERRABLE,
+    evm_chain_id numeric(78,0) NOT NULL,
+    gas_used bigint NOT NULL,
+    fee numeric(78,0) NOT NULL,
+    confirmed_at timestamptz NOT NULL
+);
+CREATE INDEX idx_job_spends_job_id_confirmed_at ON job_spends (job_id, confirmed_at);
+
+-- +goose Down
+DROP TABLE job_spends;
+ALTER TABLE jobs DROP COLUMN monthly_budget;
@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE link_payments (
+    id BIGSERIAL PRIMARY KEY,
+    job_id integer NOT NULL REFERENCES jobs (id) ON DELETE CASCADE DEFERRABLE,
+    evm_chain_id numeric(78,0) NOT NULL,
+    contract_address bytea NOT NULL CHECK (octet_length(contract_address) = 20),
+    request_id bytea NOT NULL CHECK (octet_length(request_id) = 32),
+    requester bytea NOT NULL CHECK (octet_length(requester) = 20),
+    amount numeric(78,0) NOT NULL CHECK (amount >= 0),
+    state text NOT NULL CHECK (state IN ('requested', 'fulfilled', 'cancelled')),
+    request_tx_hash bytea NOT NULL,
+    created_at timestamptz NOT NULL,
+    fulfilled_at timestamptz,
+    cancelled_at timestamptz
+);
+CREATE UNIQUE INDEX idx_link_payments_request ON link_payments (evm_chain_id, contract_address, request_id);
+CREATE INDEX idx_link_payments_job_id_request_id ON link_payments (job_id, request_id);
+
+-- +goose Down
+DROP TABLE link_payments;
@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE link_withdrawals (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) ON DELETE CASCADE,
+    contract_address bytea NOT NULL CHECK (octet_length(contract_address) = 20),
+    from_address bytea NOT NULL CHECK (octet_length(from_address) = 20),
+    recipient bytea NOT NULL CHECK (octet_length(recipient) = 20),
+    threshold numeric(78,0) NOT NULL CHECK (threshold > 0),
+    check_interval bigint NOT NULL CHECK (check_interval > 0),
+    last_checked_at timestamptz,
+    last_eth_tx_id bigint REFERENCES eth_txes (id) ON DELETE SET NULL,
+    last_amount numeric(78,0),
+    last_withdrawn_at timestamptz,
+    error text,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL
+);
+CREATE UNIQUE INDEX idx_link_withdrawals_contract ON link_withdrawals (evm_chain_id, contract_address);
+
+-- +goose Down
+DROP TABLE link_withdrawals;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- web_authn is set on the sessions whose login was verified by a WebAuthn assertion
+ALTER TABLE sessions ADD COLUMN web_authn boolean NOT NULL DEFAULT false;
+
+-- +goose Down
+ALTER TABLE sessions DROP COLUMN web_authn;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- pipeline_job_ids are the jobs whose pipeline refers to the old key, which are not switched
+ALTER TABLE eth_key_rotations ADD COLUMN pipeline_job_ids integer[] NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE eth_key_rotations DROP COLUMN pipeline_job_ids;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- idempotency_key deduplicates the transactions inserted again after an insert
+-- which timed out, but may have been committed
+ALTER TABLE eth_txes ADD COLUMN idempotency_key uuid;
+CREATE UNIQUE INDEX idx_eth_txes_idempotency_key ON eth_txes (idempotency_key) WHERE idempotency_key IS NOT NULL;
+
+-- +goose Down
+DROP INDEX idx_eth_txes_idempotency_key;
+ALTER TABLE eth_txes DROP COLUMN idempotency_key;
@@ -0,0 +1,17 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN alert_errors_threshold integer CHECK (alert_errors_threshold > 0),
+    ADD COLUMN alert_errors_window bigint CHECK (alert_errors_window > 0);
+CREATE TABLE alerts (
+    key text PRIMARY KEY,
+    rule text NOT NULL,
+    severity text NOT NULL,
+    summary text NOT NULL,
+    details jsonb NOT NULL DEFAULT '{}',
+    fired_at timestamptz NOT NULL,
+    resolved boolean NOT NULL DEFAULT false,
+    pending_notifiers text[] NOT NULL DEFAULT '{}'
+);
+
+-- +goose Down
+DROP TABLE alerts;
+ALTER TABLE jobs DROP COLUMN alert_errors_window, DROP COLUMN alert_errors_threshold;
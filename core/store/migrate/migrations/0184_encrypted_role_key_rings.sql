@@ -0,0 +1,11 @@
+-- +goose Up
+-- encrypted_role_key_rings hold the keys of the keystore roles with a password
+-- of their own, the passwords being stored in the encrypted_key_rings key ring
+CREATE TABLE encrypted_role_key_rings (
+    role text PRIMARY KEY,
+    encrypted_keys jsonb NOT NULL,
+    updated_at timestamptz NOT NULL
+);
+
+-- +goose Down
+DROP TABLE encrypted_role_key_rings;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- status is 'observed' for the VRF v2 requests awaiting fulfillment, or the
+-- reason a VRF v1 request was skipped without being fulfilled
+ALTER TABLE vrf_requests ADD COLUMN status text NOT NULL DEFAULT 'observed';
+
+-- +goose Down
+ALTER TABLE vrf_requests DROP COLUMN status;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- forced rotations disable the old key even though jobs still refer to it in their pipeline
+ALTER TABLE eth_key_rotations ADD COLUMN forced boolean NOT NULL DEFAULT false;
+ALTER TABLE eth_key_rotations DROP CONSTRAINT chk_eth_key_rotations_state;
+ALTER TABLE eth_key_rotations ADD CONSTRAINT chk_eth_key_rotations_state CHECK (state IN ('migrating_jobs', 'waiting_for_pipelines', 'waiting_for_transactions', 'sweeping', 'completed', 'errored'));
+
+-- +goose Down
+UPDATE eth_key_rotations SET state = 'waiting_for_transactions' WHERE state = 'waiting_for_pipelines';
+ALTER TABLE eth_key_rotations DROP CONSTRAINT chk_eth_key_rotations_state;
+ALTER TABLE eth_key_rotations ADD CONSTRAINT chk_eth_key_rotations_state CHECK (state IN ('migrating_jobs', 'waiting_for_transactions', 'sweeping', 'completed', 'errored'));
+ALTER TABLE eth_key_rotations DROP COLUMN forced;
@@ -0,0 +1,123 @@
+package migrate
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/pressly/goose/v3"
+)
+
+// ErrSchemaDrift is returned by CheckSchema when the schema of the database
+// differs from the one recorded after its last migration
+var ErrSchemaDrift = errors.New("database schema differs from the one recorded after its last migration")
+
+// the tables managed outside of the migrations are not part of the checksum
+const schemaChecksumQuery = `
+SELECT 'column ' || table_name || '.' || column_name || ' ' || data_type || ' ' || is_nullable
+FROM information_schema.columns
+WHERE table_schema = current_schema() AND table_name NOT IN ('goose_migrations', 'lease_lock')
+UNION ALL
+SELECT 'index ' || tablename || '.' || indexname
+FROM pg_indexes
+WHERE schemaname = current_schema() AND tablename NOT IN ('goose_migrations', 'lease_lock')
+ORDER BY 1`
+
+// SchemaChecksum returns a checksum of the tables, columns and indexes of db
+func SchemaChecksum(db *sql.DB) (string, error) {
+	rows, err := db.Query(schemaChecksumQuery)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to load schema")
+	}
+	defer rows.Close()
+	h := sha256.New()
+	for rows.Next() {
+		var line string
+		if err = rows.Scan(&line); err != nil {
+			return "", errors.Wrap(err, "failed to load schema")
+		}
+		_, _ = fmt.Fprintln(h, line)
+	}
+	if err = rows.Err(); err != nil {
+		return "", errors.Wrap(err, "failed to load schema")
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// CheckSchema returns ErrSchemaDrift if the schema of db differs from the one
+// recorded when it was migrated to its current version. Databases migrated
+// without recording their schema are not checked.
+func CheckSchema(db *sql.DB) error {
+	exists, err := schemaChecksumsExist(db)
+	if err != nil || !exists {
+		return err
+	}
+	version, err := goose.GetDBVersion(db)
+	if err != nil {
+		return errors.Wrap(err, "failed to get database version")
+	}
+	var expected string
+	err = db.QueryRow(`SELECT checksum FROM schema_checksums WHERE version = $1`, version).Scan(&expected)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "failed to load schema checksum")
+	}
+	actual, err := SchemaChecksum(db)
+	if err != nil {
+		return err
+	}
+	if actual != expected {
+		return errors.Wrapf(ErrSchemaDrift, "version %d", version)
+	}
+	return nil
+}
+
+// AcceptSchema records the current schema of db as the expected schema of
+// its version, resolving a drift reported by CheckSchema
+func AcceptSchema(db *sql.DB) error {
+	return recordSchemaChecksum(db, true)
+}
+
+func recordSchemaChecksum(db *sql.DB, overwrite bool) error {
+	exists, err := schemaChecksumsExist(db)
+	if err != nil || !exists {
+		return err
+	}
+	version, err := goose.GetDBVersion(db)
+	if err != nil {
+		return errors.Wrap(err, "failed to get database version")
+	}
+	checksum, err := SchemaChecksum(db)
+	if err != nil {
+		return err
+	}
+	onConflict := `DO NOTHING`
+	if overwrite {
+		onConflict = `DO UPDATE SET checksum = EXCLUDED.checksum, created_at = EXCLUDED.created_at`
+	}
+	_, err = db.Exec(`INSERT INTO schema_checksums (version, checksum, created_at) VALUES ($1, $2, NOW()) ON CONFLICT (version) `+onConflict, version, checksum)
+	return errors.Wrap(err, "failed to record schema checksum")
+}
+
+// deleteStaleSchemaChecksums deletes the checksums of the versions rolled back
+func deleteStaleSchemaChecksums(db *sql.DB) error {
+	exists, err := schemaChecksumsExist(db)
+	if err != nil || !exists {
+		return err
+	}
+	version, err := goose.GetDBVersion(db)
+	if err != nil {
+		return errors.Wrap(err, "failed to get database version")
+	}
+	_, err = db.Exec(`DELETE FROM schema_checksums WHERE version > $1`, version)
+	return errors.Wrap(err, "failed to delete schema checksums")
+}
+
+// the schema_checksums table doesn't exist before migration 0164
+func schemaChecksumsExist(db *sql.DB) (exists bool, err error) {
+	err = db.QueryRow(`SELECT to_regclass('schema_checksums') IS NOT NULL`).Scan(&exists)
+	return exists, errors.Wrap(err, "failed to check for schema checksums")
+}
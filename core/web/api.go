@@ -24,6 +24,9 @@ const (
 	// KeyPreviousLink is the name of the key that contains the HREF for the
 	// previous document in a paginated response.
 	KeyPreviousLink = "prev"
+	// KeyCursor is the query parameter of the position of the next page in a
+	// cursor paginated request.
+	KeyCursor = "cursor"
 )
 
 // ParsePaginatedRequest parses the parameters that control pagination for a
@@ -106,6 +109,24 @@ func getPaginatedResponseDoc(url url.URL, size, page, count int, resource interf
 	return document, nil
 }
 
+// NewCursorPaginatedResponse returns a jsonapi.Document with a link to the
+// next collection page, unless next is empty
+func NewCursorPaginatedResponse(url url.URL, next string, resource interface{}) ([]byte, error) {
+	document, err := jsonapi.MarshalToStruct(resource, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal resource to struct: %+v", err)
+	}
+
+	document.Links = make(jsonapi.Links)
+	if next != "" {
+		query := url.Query()
+		query.Set(KeyCursor, next)
+		url.RawQuery = query.Encode()
+		document.Links[KeyNextLink] = jsonapi.Link{Href: url.String()}
+	}
+	return json.Marshal(document)
+}
+
 // ParsePaginatedResponse parse a JSONAPI response for a document with links
 func ParsePaginatedResponse(input []byte, resource interface{}, links *jsonapi.Links) error {
 	document := jsonapi.Document{}
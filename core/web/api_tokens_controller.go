@@ -0,0 +1,119 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	webauth "github.com/smartcontractkit/chainlink/core/web/auth"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// APITokensController manages the named API tokens of the current user
+type APITokensController struct {
+	App chainlink.Application
+}
+
+// Index lists the API tokens of the current user
+// Example:
+// "GET <application>/user/tokens"
+func (tc *APITokensController) Index(c *gin.Context) {
+	user, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+
+	tokens, err := tc.App.SessionORM().ListAPITokens(user.Email)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewAPITokenResources(tokens), "apiTokens")
+}
+
+// Create generates a named API token for the current user. The returned
+// secret is not shown again.
+// Example:
+// "POST <application>/user/tokens"
+func (tc *APITokensController) Create(c *gin.Context) {
+	var request clsessions.APITokenRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	sessionUser, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+	user, err := tc.App.SessionORM().FindUser(sessionUser.Email)
+	if err != nil {
+		tc.App.GetLogger().Errorf("failed to obtain current user record: %s", err)
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("unable to create API token"))
+		return
+	}
+	if !utils.CheckPasswordHash(request.Password, user.HashedPassword) {
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("incorrect password"))
+		return
+	}
+
+	if request.Name == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("name is required"))
+		return
+	}
+	scope, err := clsessions.GetAPITokenScope(request.Scope)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.ExpiresAt.Valid && !request.ExpiresAt.Time.After(time.Now()) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("expiresAt must be in the future"))
+		return
+	}
+
+	apiToken := clsessions.APIToken{
+		UserEmail: user.Email,
+		Name:      request.Name,
+		Scope:     scope,
+		ExpiresAt: request.ExpiresAt,
+	}
+	token, err := tc.App.SessionORM().CreateAPIToken(&apiToken)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	resource := presenters.NewAPITokenResource(apiToken)
+	resource.AccessKey = token.AccessKey
+	resource.Secret = token.Secret
+	jsonAPIResponseWithStatus(c, resource, "apiToken", http.StatusCreated)
+}
+
+// Delete removes a named API token of the current user
+// Example:
+// "DELETE <application>/user/tokens/:name"
+func (tc *APITokensController) Delete(c *gin.Context) {
+	user, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+
+	if _, err := tc.App.SessionORM().DeleteAPIToken(user.Email, c.Param("name")); errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("API token not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "apiToken", http.StatusNoContent)
+}
@@ -0,0 +1,91 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/sessions"
+	webauth "github.com/smartcontractkit/chainlink/core/web/auth"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func createAPIToken(t *testing.T, client cltest.HTTPClientCleaner, request sessions.APITokenRequest, status int) presenters.APITokenResource {
+	body, err := json.Marshal(request)
+	require.NoError(t, err)
+	resp, cleanup := client.Post("/v2/user/tokens", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, status)
+
+	var token presenters.APITokenResource
+	if status == http.StatusCreated {
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &token))
+	}
+	return token
+}
+
+func TestAPITokensController(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	t.Run("validates requests", func(t *testing.T) {
+		createAPIToken(t, client, sessions.APITokenRequest{Name: "ci", Scope: "read-only", Password: "wrong-password"}, http.StatusUnauthorized)
+		createAPIToken(t, client, sessions.APITokenRequest{Scope: "read-only", Password: cltest.Password}, http.StatusUnprocessableEntity)
+		createAPIToken(t, client, sessions.APITokenRequest{Name: "ci", Scope: "root", Password: cltest.Password}, http.StatusUnprocessableEntity)
+		createAPIToken(t, client, sessions.APITokenRequest{Name: "ci", Scope: "read-only", ExpiresAt: null.TimeFrom(time.Now().Add(-time.Hour)), Password: cltest.Password}, http.StatusUnprocessableEntity)
+	})
+
+	readOnly := createAPIToken(t, client, sessions.APITokenRequest{Name: "ci", Scope: "read-only", ExpiresAt: null.TimeFrom(time.Now().Add(time.Hour)), Password: cltest.Password}, http.StatusCreated)
+	assert.Equal(t, "ci", readOnly.Name)
+	assert.Equal(t, sessions.APITokenScopeReadOnly, readOnly.Scope)
+	assert.NotEmpty(t, readOnly.AccessKey)
+	assert.NotEmpty(t, readOnly.Secret)
+	assert.True(t, readOnly.ExpiresAt.Valid)
+
+	createAPIToken(t, client, sessions.APITokenRequest{Name: "ci", Scope: "admin", Password: cltest.Password}, http.StatusUnprocessableEntity)
+
+	t.Run("enforces the token scope", func(t *testing.T) {
+		request := func(method, path string) int {
+			req, err := http.NewRequestWithContext(testutils.Context(t), method, app.Server.URL+path, bytes.NewReader([]byte("{}")))
+			require.NoError(t, err)
+			req.Header.Set(webauth.APIKey, readOnly.AccessKey)
+			req.Header.Set(webauth.APISecret, readOnly.Secret)
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+			return resp.StatusCode
+		}
+
+		assert.Equal(t, http.StatusOK, request(http.MethodGet, "/v2/jobs"))
+		assert.Equal(t, http.StatusForbidden, request(http.MethodPost, "/v2/jobs"))
+	})
+
+	resp, cleanup := client.Get("/v2/user/tokens")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var tokens []presenters.APITokenResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &tokens))
+	require.Len(t, tokens, 1)
+	assert.Equal(t, "ci", tokens[0].Name)
+	assert.Empty(t, tokens[0].Secret)
+	assert.True(t, tokens[0].LastUsedAt.Valid)
+
+	resp, cleanup = client.Delete("/v2/user/tokens/ci")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+	resp, cleanup = client.Delete("/v2/user/tokens/ci")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
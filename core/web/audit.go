@@ -0,0 +1,118 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/core/services/audit"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/web/auth"
+)
+
+var gqlMutationRegexp = regexp.MustCompile(`(?m)^\s*mutation\b`)
+
+// auditRequests records the mutating requests of authenticated users and
+// external initiators, including the ones rejected by later middleware
+func auditRequests(app chainlink.Application) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			return
+		}
+		body, ok := readAuditedBody(c, app.GetConfig().DefaultHTTPLimit())
+		if !ok {
+			return
+		}
+
+		c.Next()
+
+		ev := audit.Event{
+			Method:      c.Request.Method,
+			Path:        c.Request.URL.Path,
+			RemoteIP:    c.ClientIP(),
+			PayloadHash: audit.PayloadHash(body),
+			Status:      c.Writer.Status(),
+		}
+		if user, ok := auth.GetAuthenticatedUser(c); ok {
+			ev.Actor = user.Email
+			ev.AuthMethod = audit.AuthMethodSession
+			if token, ok := auth.GetAuthenticatedAPIToken(c); ok {
+				ev.AuthMethod = audit.AuthMethodAPIToken + ":" + token.Name
+			}
+		} else if ei, ok := auth.GetAuthenticatedExternalInitiator(c); ok {
+			ev.Actor = ei.Name
+			ev.AuthMethod = audit.AuthMethodExternalInitiator
+		} else {
+			return
+		}
+		recordAuditEvent(app, &ev)
+	}
+}
+
+// auditGQLMutations records the GraphQL mutations of authenticated users
+func auditGQLMutations(app chainlink.Application) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		session, ok := auth.GetGQLAuthenticatedSession(c.Request.Context())
+		if !ok {
+			return
+		}
+		body, ok := readAuditedBody(c, app.GetConfig().DefaultHTTPLimit())
+		if !ok {
+			return
+		}
+		var params struct {
+			Query         string `json:"query"`
+			OperationName string `json:"operationName"`
+		}
+		if err := json.Unmarshal(body, &params); err != nil || !gqlMutationRegexp.MatchString(params.Query) {
+			return
+		}
+
+		c.Next()
+
+		recordAuditEvent(app, &audit.Event{
+			Actor:       session.User.Email,
+			AuthMethod:  audit.AuthMethodSession,
+			Method:      c.Request.Method,
+			Path:        c.Request.URL.Path,
+			Operation:   params.OperationName,
+			RemoteIP:    c.ClientIP(),
+			PayloadHash: audit.PayloadHash(body),
+			Status:      c.Writer.Status(),
+		})
+	}
+}
+
+// readAuditedBody reads the request body, up to limit bytes, and puts it back
+// for the handlers
+func readAuditedBody(c *gin.Context, limit int64) ([]byte, bool) {
+	if c.Request.Body == nil {
+		return nil, true
+	}
+	body, err := ioutil.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, limit))
+	if err != nil {
+		if !c.IsAborted() {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				c.AbortWithStatus(http.StatusRequestEntityTooLarge)
+			} else {
+				c.AbortWithStatus(http.StatusBadRequest)
+			}
+		}
+		return nil, false
+	}
+	c.Request.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+	return body, true
+}
+
+func recordAuditEvent(app chainlink.Application, ev *audit.Event) {
+	if err := app.AuditLogger().Record(ev); err != nil {
+		app.GetLogger().Criticalw("Failed to record audit event", "actor", ev.Actor, "method", ev.Method, "path", ev.Path, "err", err)
+	}
+}
@@ -0,0 +1,54 @@
+package web
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// AuditEventsController exports the audit log
+type AuditEventsController struct {
+	App chainlink.Application
+}
+
+// Index lists the audit events, oldest first. The optional since and until
+// RFC3339 timestamps bound the creation time of the events.
+// Example:
+// "GET <application>/audit_events?since=2022-09-01T00:00:00Z"
+func (aec *AuditEventsController) Index(c *gin.Context, size, page, offset int) {
+	since, err := parseTimeQuery(c, "since")
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	until, err := parseTimeQuery(c, "until")
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	events, count, err := aec.App.AuditLogger().Events(since, until, offset, size)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	paginatedResponse(c, "auditEvents", size, page, presenters.NewAuditEventResources(events), count, err)
+}
+
+func parseTimeQuery(c *gin.Context, name string) (null.Time, error) {
+	s := c.Query(name)
+	if s == "" {
+		return null.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return null.Time{}, errors.Wrapf(err, "invalid %s", name)
+	}
+	return null.TimeFrom(t), nil
+}
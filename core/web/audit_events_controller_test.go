@@ -0,0 +1,74 @@
+package web_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/services/audit"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestAuditEventsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	body := []byte(`{"toml":""}`)
+	resp, cleanup := client.Post("/v2/jobs", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Get("/v2/jobs")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	resp, cleanup = client.Get("/v2/audit_events")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var events []presenters.AuditEventResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &events))
+	require.Len(t, events, 1)
+	assert.Equal(t, cltest.APIEmailAdmin, events[0].Actor)
+	assert.Equal(t, audit.AuthMethodSession, events[0].AuthMethod)
+	assert.Equal(t, http.MethodPost, events[0].Method)
+	assert.Equal(t, "/v2/jobs", events[0].Path)
+	assert.Equal(t, audit.PayloadHash(body), events[0].PayloadHash)
+	assert.Equal(t, http.StatusUnprocessableEntity, events[0].Status)
+
+	resp, cleanup = client.Get("/v2/audit_events?since=2000-01-01")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	viewOnlyClient := app.NewHTTPClient(cltest.APIEmailViewOnly)
+	resp, cleanup = viewOnlyClient.Get("/v2/audit_events")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnauthorized)
+}
+
+func TestAuditEventsController_BodyTooLarge(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	body := bytes.Repeat([]byte("a"), int(app.GetConfig().DefaultHTTPLimit())+1)
+	resp, cleanup := client.Post("/v2/jobs", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusRequestEntityTooLarge)
+
+	resp, cleanup = client.Get("/v2/audit_events")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var events []presenters.AuditEventResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &events))
+	assert.Empty(t, events)
+}
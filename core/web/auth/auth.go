@@ -32,6 +32,9 @@ const (
 
 	// SessionExternalInitiatorKey is the External Initiator key in the session map
 	SessionExternalInitiatorKey = "external_initiator"
+
+	// APITokenKey is the API token key in the session map
+	APITokenKey = "api_token"
 )
 
 // Authenticator defines the interface to authenticate requests against a
@@ -40,7 +43,8 @@ type Authenticator interface {
 	AuthorizedUserWithSession(sessionID string) (clsessions.User, error)
 	FindExternalInitiator(eia *auth.Token) (*bridges.ExternalInitiator, error)
 	FindUser(email string) (clsessions.User, error)
-	FindUserByAPIToken(apiToken string) (clsessions.User, error)
+	FindAPIToken(accessKey string) (clsessions.APIToken, error)
+	MarkAPITokenUsed(id int64) error
 }
 
 // authMethod defines a method which can be used to authenticate a request. This
@@ -63,6 +67,7 @@ func AuthenticateBySession(c *gin.Context, authr Authenticator) error {
 		return err
 	}
 
+	c.Set(SessionIDKey, sessionID)
 	c.Set(SessionUserKey, &user)
 
 	return nil
@@ -79,8 +84,8 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 		Secret:    c.GetHeader(APISecret),
 	}
 
-	// We need to first load the user row so we can compare tokens using the stored salt
-	user, err := authr.FindUserByAPIToken(token.AccessKey)
+	// We need to first load the token row so we can compare tokens using the stored salt
+	apiToken, err := authr.FindAPIToken(token.AccessKey)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return auth.ErrorAuthFailed
@@ -88,7 +93,7 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 		return err
 	}
 
-	ok, err := clsessions.AuthenticateUserByToken(token, &user)
+	ok, err := clsessions.AuthenticateAPIToken(token, &apiToken)
 	if err != nil {
 		return err
 	}
@@ -96,7 +101,16 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 		return auth.ErrorAuthFailed
 	}
 
+	user, err := authr.FindUser(apiToken.UserEmail)
+	if err != nil {
+		return err
+	}
+	if err = authr.MarkAPITokenUsed(apiToken.ID); err != nil {
+		return err
+	}
+
 	c.Set(SessionUserKey, &user)
+	c.Set(APITokenKey, &apiToken)
 
 	return nil
 }
@@ -175,6 +189,19 @@ func GetAuthenticatedUser(c *gin.Context) (*clsessions.User, bool) {
 	return user, ok
 }
 
+// GetAuthenticatedAPIToken extracts the API token used to authenticate the
+// request from the context.
+func GetAuthenticatedAPIToken(c *gin.Context) (*clsessions.APIToken, bool) {
+	obj, ok := c.Get(APITokenKey)
+	if !ok {
+		return nil, false
+	}
+
+	apiToken, ok := obj.(*clsessions.APIToken)
+
+	return apiToken, ok
+}
+
 // GetAuthenticatedExternalInitiator extracts the external initiator from the
 // context.
 func GetAuthenticatedExternalInitiator(c *gin.Context) (*bridges.ExternalInitiator, bool) {
@@ -241,3 +268,16 @@ func RequiresAdminRole(handler func(*gin.Context)) func(*gin.Context) {
 		handler(c)
 	}
 }
+
+// RequiresAPITokenScope is middleware which asserts that requests authenticated
+// by an API token are allowed by the token's scope
+func RequiresAPITokenScope() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if apiToken, ok := GetAuthenticatedAPIToken(c); ok && !apiToken.Scope.Allows(c.Request.Method, c.FullPath()) {
+			c.Abort()
+			jsonAPIError(c, http.StatusForbidden, errors.Errorf("API token scope %s does not allow this request", apiToken.Scope))
+			return
+		}
+		c.Next()
+	}
+}
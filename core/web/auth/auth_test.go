@@ -4,11 +4,13 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
@@ -39,29 +41,38 @@ func (u userFindFailer) FindUser(email string) (sessions.User, error) {
 	return sessions.User{}, u.err
 }
 
-func (u userFindFailer) FindUserByAPIToken(token string) (sessions.User, error) {
-	return sessions.User{}, u.err
+func (u userFindFailer) FindAPIToken(accessKey string) (sessions.APIToken, error) {
+	return sessions.APIToken{}, u.err
 }
 
 type userFindSuccesser struct {
 	sessions.ORM
-	user sessions.User
+	user     sessions.User
+	apiToken sessions.APIToken
 }
 
 func (u userFindSuccesser) FindUser(email string) (sessions.User, error) {
 	return u.user, nil
 }
 
-func (u userFindSuccesser) FindUserByAPIToken(token string) (sessions.User, error) {
-	return u.user, nil
+func (u userFindSuccesser) FindAPIToken(accessKey string) (sessions.APIToken, error) {
+	return u.apiToken, nil
 }
 
-func TestAuthenticateByToken_Success(t *testing.T) {
+func (u userFindSuccesser) MarkAPITokenUsed(id int64) error {
+	return nil
+}
+
+func newAPITokenAuthenticator(t *testing.T, scope sessions.APITokenScope) userFindSuccesser {
 	user := cltest.MustRandomUser(t)
-	apiToken := auth.Token{AccessKey: cltest.APIKey, Secret: cltest.APISecret}
-	err := user.SetAuthToken(&apiToken)
+	apiToken := sessions.APIToken{UserEmail: user.Email, Name: "test", Scope: scope}
+	err := apiToken.SetAuthToken(&auth.Token{AccessKey: cltest.APIKey, Secret: cltest.APISecret})
 	require.NoError(t, err)
-	authr := userFindSuccesser{user: user}
+	return userFindSuccesser{user: user, apiToken: apiToken}
+}
+
+func TestAuthenticateByToken_Success(t *testing.T) {
+	authr := newAPITokenAuthenticator(t, sessions.APITokenScopeAdmin)
 
 	called := false
 	router := gin.New()
@@ -102,6 +113,55 @@ func TestAuthenticateByToken_AuthFailed(t *testing.T) {
 	assert.Equal(t, http.StatusText(http.StatusUnauthorized), http.StatusText(w.Code))
 }
 
+func TestAuthenticateByToken_Expired(t *testing.T) {
+	authr := newAPITokenAuthenticator(t, sessions.APITokenScopeAdmin)
+	authr.apiToken.ExpiresAt = null.TimeFrom(time.Now().Add(-time.Minute))
+
+	router := gin.New()
+	router.Use(webauth.Authenticate(authr, webauth.AuthenticateByToken))
+	router.GET("/", func(c *gin.Context) {
+		c.String(http.StatusOK, "")
+	})
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/", nil)
+	req.Header.Set(webauth.APIKey, cltest.APIKey)
+	req.Header.Set(webauth.APISecret, cltest.APISecret)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestRequiresAPITokenScope(t *testing.T) {
+	authr := newAPITokenAuthenticator(t, sessions.APITokenScopeJobManagement)
+
+	router := gin.New()
+	router.Use(webauth.Authenticate(authr, webauth.AuthenticateByToken), webauth.RequiresAPITokenScope())
+	for _, path := range []string{"/v2/jobs", "/v2/transfers"} {
+		router.GET(path, func(c *gin.Context) { c.String(http.StatusOK, "") })
+		router.POST(path, func(c *gin.Context) { c.String(http.StatusOK, "") })
+	}
+
+	tests := []struct {
+		method string
+		path   string
+		status int
+	}{
+		{http.MethodGet, "/v2/jobs", http.StatusOK},
+		{http.MethodPost, "/v2/jobs", http.StatusOK},
+		{http.MethodGet, "/v2/transfers", http.StatusOK},
+		{http.MethodPost, "/v2/transfers", http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(tt.method, tt.path, nil)
+		req.Header.Set(webauth.APIKey, cltest.APIKey)
+		req.Header.Set(webauth.APISecret, cltest.APISecret)
+		router.ServeHTTP(w, req)
+		assert.Equal(t, tt.status, w.Code, "%s %s", tt.method, tt.path)
+	}
+}
+
 func TestRequireAuth_NoneRequired(t *testing.T) {
 	called := false
 	var authr webauth.Authenticator
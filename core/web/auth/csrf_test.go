@@ -0,0 +1,64 @@
+package auth_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/web/auth"
+)
+
+func Test_CSRFProtection(t *testing.T) {
+	t.Parallel()
+
+	const sessionID = "sessionID"
+	sessionStore := sessions.NewCookieStore([]byte(cltest.SessionSecret))
+	r := gin.New()
+	r.Use(sessions.Sessions(auth.SessionName, sessionStore), auth.CSRFProtection())
+	r.GET("/", func(c *gin.Context) { c.String(http.StatusOK, "") })
+	r.POST("/", func(c *gin.Context) { c.String(http.StatusOK, "") })
+
+	token := auth.CSRFToken(sessionID)
+	tests := []struct {
+		name       string
+		method     string
+		withCookie bool
+		headers    map[string]string
+		status     int
+	}{
+		{"read", http.MethodGet, true, map[string]string{"Origin": "http://ui.example"}, http.StatusOK},
+		{"no session", http.MethodPost, false, map[string]string{"Origin": "http://ui.example"}, http.StatusOK},
+		{"no origin", http.MethodPost, true, nil, http.StatusOK},
+		{"same origin", http.MethodPost, true, map[string]string{"Origin": "http://node.example"}, http.StatusOK},
+		{"same origin fetch", http.MethodPost, true, map[string]string{"Origin": "http://proxy.example", "Sec-Fetch-Site": "same-origin"}, http.StatusOK},
+		{"cross origin", http.MethodPost, true, map[string]string{"Origin": "http://ui.example"}, http.StatusForbidden},
+		{"cross site fetch", http.MethodPost, true, map[string]string{"Sec-Fetch-Site": "cross-site"}, http.StatusForbidden},
+		{"wrong token", http.MethodPost, true, map[string]string{"Origin": "http://ui.example", auth.CSRFTokenHeader: "wrong"}, http.StatusForbidden},
+		{"token", http.MethodPost, true, map[string]string{"Origin": "http://ui.example", auth.CSRFTokenHeader: token}, http.StatusOK},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "http://node.example/", nil)
+			if tt.withCookie {
+				req.AddCookie(cltest.MustGenerateSessionCookie(t, sessionID))
+			}
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			assert.Equal(t, tt.status, w.Code)
+			if tt.withCookie {
+				assert.Equal(t, token, w.Header().Get(auth.CSRFTokenHeader))
+			} else {
+				assert.Empty(t, w.Header().Get(auth.CSRFTokenHeader))
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
+)
+
+var (
+	ErrWebAuthnRequired      = errors.New("WebAuthn is enforced, register a WebAuthn key and log in with it to continue")
+	ErrWebAuthnTokenReadOnly = errors.New("WebAuthn is enforced, API tokens can only be used for read-only requests")
+)
+
+// WebAuthnStore loads the WebAuthn keys registered by users, and whether
+// their sessions were logged in with one
+type WebAuthnStore interface {
+	GetUserWebAuthn(email string) ([]clsessions.WebAuthn, error)
+	IsWebAuthnSession(sessionID string) (bool, error)
+}
+
+// RequiresWebAuthn is middleware enforcing WebAuthn MFA. Only the sessions
+// logged in with a WebAuthn key are allowed. Password sessions of users without
+// a key may only call the enrollPaths, and requests authenticated by API token
+// may only read.
+func RequiresWebAuthn(store WebAuthnStore, enrollPaths ...string) gin.HandlerFunc {
+	enroll := make(map[string]struct{}, len(enrollPaths))
+	for _, p := range enrollPaths {
+		enroll[p] = struct{}{}
+	}
+	return func(c *gin.Context) {
+		user, ok := GetAuthenticatedUser(c)
+		if !ok {
+			c.Abort()
+			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
+			return
+		}
+
+		sessionID, bySession := c.Get(SessionIDKey)
+		if !bySession {
+			switch c.Request.Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				c.Next()
+			default:
+				c.Abort()
+				jsonAPIError(c, http.StatusForbidden, ErrWebAuthnTokenReadOnly)
+			}
+			return
+		}
+
+		webAuthn, err := store.IsWebAuthnSession(sessionID.(string))
+		if err != nil {
+			c.Abort()
+			jsonAPIError(c, http.StatusInternalServerError, errors.Wrap(err, "failed to load session"))
+			return
+		}
+		if webAuthn {
+			c.Next()
+			return
+		}
+		if _, ok = enroll[c.FullPath()]; ok {
+			// Once a key is registered, further keys may only be enrolled by
+			// a session logged in with a key
+			uwas, err := store.GetUserWebAuthn(user.Email)
+			if err != nil {
+				c.Abort()
+				jsonAPIError(c, http.StatusInternalServerError, errors.Wrap(err, "failed to load WebAuthn keys"))
+				return
+			}
+			if len(uwas) == 0 {
+				c.Next()
+				return
+			}
+		}
+		c.Abort()
+		jsonAPIError(c, http.StatusForbidden, ErrWebAuthnRequired)
+	}
+}
+
+// RequiresWebAuthnGQL is the GQL counterpart of RequiresWebAuthn, to be used
+// after AuthenticateGQL. Sessions not logged in with a WebAuthn key are
+// removed from the request context, so that they are unauthenticated.
+func RequiresWebAuthnGQL(store WebAuthnStore, lggr logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		session, ok := GetGQLAuthenticatedSession(c.Request.Context())
+		if !ok {
+			return
+		}
+		webAuthn, err := store.IsWebAuthnSession(session.SessionID)
+		if err == nil && webAuthn {
+			return
+		}
+		if err != nil {
+			lggr.Errorw("Failed to load session, unable to authenticate user", "err", err)
+		}
+		ctx := context.WithValue(c.Request.Context(), sessionUserKey{}, nil)
+		c.Request = c.Request.WithContext(ctx)
+	}
+}
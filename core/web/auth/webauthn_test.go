@@ -0,0 +1,104 @@
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/sessions"
+	webauth "github.com/smartcontractkit/chainlink/core/web/auth"
+)
+
+type webAuthnStore map[string][]sessions.WebAuthn
+
+func (s webAuthnStore) GetUserWebAuthn(email string) ([]sessions.WebAuthn, error) {
+	return s[email], nil
+}
+
+// IsWebAuthnSession returns whether the session ID is "webauthn"
+func (s webAuthnStore) IsWebAuthnSession(sessionID string) (bool, error) {
+	return sessionID == "webauthn", nil
+}
+
+func TestRequiresWebAuthn(t *testing.T) {
+	t.Parallel()
+
+	withKey := cltest.MustRandomUser(t)
+	withoutKey := cltest.MustRandomUser(t)
+	store := webAuthnStore{withKey.Email: {{Email: withKey.Email}}}
+
+	tests := []struct {
+		name      string
+		user      sessions.User
+		sessionID string
+		method    string
+		path      string
+		status    int
+	}{
+		{"session logged in with key", withKey, "webauthn", http.MethodPost, "/v2/jobs", http.StatusOK},
+		{"password session with key", withKey, "password", http.MethodPost, "/v2/jobs", http.StatusForbidden},
+		{"password session with key enrolling", withKey, "password", http.MethodPost, "/v2/enroll_webauthn", http.StatusForbidden},
+		{"session without key", withoutKey, "password", http.MethodGet, "/v2/jobs", http.StatusForbidden},
+		{"session without key enrolling", withoutKey, "password", http.MethodPost, "/v2/enroll_webauthn", http.StatusOK},
+		{"token read", withKey, "", http.MethodGet, "/v2/jobs", http.StatusOK},
+		{"token write", withKey, "", http.MethodPost, "/v2/jobs", http.StatusForbidden},
+		{"token enrolling", withoutKey, "", http.MethodPost, "/v2/enroll_webauthn", http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(func(c *gin.Context) {
+				if tt.sessionID != "" {
+					c.Set(webauth.SessionIDKey, tt.sessionID)
+				}
+				c.Set(webauth.SessionUserKey, &tt.user)
+			}, webauth.RequiresWebAuthn(store, "/v2/enroll_webauthn"))
+			ok := func(c *gin.Context) { c.String(http.StatusOK, "") }
+			router.Handle(tt.method, "/v2/jobs", ok)
+			router.Handle(tt.method, "/v2/enroll_webauthn", ok)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
+
+func TestRequiresWebAuthnGQL(t *testing.T) {
+	t.Parallel()
+
+	withKey := cltest.MustRandomUser(t)
+	withoutKey := cltest.MustRandomUser(t)
+	store := webAuthnStore{withKey.Email: {{Email: withKey.Email}}}
+
+	for _, tt := range []struct {
+		user          sessions.User
+		sessionID     string
+		authenticated bool
+	}{
+		{withKey, "webauthn", true},
+		{withKey, "password", false},
+		{withoutKey, "password", false},
+	} {
+		var authenticated bool
+		router := gin.New()
+		router.Use(func(c *gin.Context) {
+			c.Request = c.Request.WithContext(webauth.SetGQLAuthenticatedSession(c.Request.Context(), tt.user, tt.sessionID))
+		}, webauth.RequiresWebAuthnGQL(store, logger.TestLogger(t)))
+		router.POST("/query", func(c *gin.Context) {
+			_, authenticated = webauth.GetGQLAuthenticatedSession(c.Request.Context())
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/query", nil).WithContext(context.Background())
+		router.ServeHTTP(httptest.NewRecorder(), req)
+		assert.Equal(t, tt.authenticated, authenticated, tt.sessionID)
+	}
+}
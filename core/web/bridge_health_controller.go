@@ -0,0 +1,32 @@
+package web
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// BridgeHealthController reports the health of the bridges, probed when
+// BridgeHealthCheckInterval is set
+type BridgeHealthController struct {
+	App chainlink.Application
+}
+
+// Index returns the success rate and p95 latency of the health checks of each
+// bridge over the health window, the bridges without checks are omitted
+// Example:
+// "GET <application>/bridge_health"
+func (bhc *BridgeHealthController) Index(c *gin.Context) {
+	since := time.Now().Add(-bhc.App.GetConfig().BridgeHealthWindow())
+	health, err := bhc.App.BridgeORM().BridgeHealth(nil, since)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewBridgeHealthResources(health), "bridgeHealth")
+}
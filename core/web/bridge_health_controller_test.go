@@ -0,0 +1,42 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestBridgeHealthController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	_, bt := cltest.MustCreateBridge(t, app.GetSqlxDB(), cltest.BridgeOpts{}, app.GetConfig())
+	orm := app.BridgeORM()
+	for i, success := range []bool{true, true, false, true} {
+		check := bridges.BridgeHealthCheck{BridgeName: bt.Name, Success: success, Latency: time.Second, CreatedAt: time.Now().Add(time.Duration(i-4) * time.Minute)}
+		require.NoError(t, orm.CreateBridgeHealthCheck(&check))
+	}
+
+	resp, cleanup := client.Get("/v2/bridge_health")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var health []presenters.BridgeHealthResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &health))
+	require.Len(t, health, 1)
+	assert.Equal(t, bt.Name.String(), health[0].Name)
+	assert.Equal(t, int64(4), health[0].Checks)
+	assert.Equal(t, 0.75, health[0].SuccessRate)
+	assert.Equal(t, time.Second, health[0].LatencyP95.Duration())
+	assert.Nil(t, health[0].LastError)
+}
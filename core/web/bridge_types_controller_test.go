@@ -116,7 +116,7 @@ func TestValidateBridgeNotExist(t *testing.T) {
 
 	db := pgtest.NewSqlxDB(t)
 	cfg := cltest.NewTestGeneralConfig(t)
-	orm := bridges.NewORM(db, logger.TestLogger(t), cfg)
+	orm := bridges.NewORM(db, logger.TestLogger(t), cfg, nil)
 
 	// Create a duplicate
 	bt := bridges.BridgeType{}
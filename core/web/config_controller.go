@@ -4,8 +4,12 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/pkg/errors"
+	"go.uber.org/zap/zapcore"
+
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
 	"github.com/gin-gonic/gin"
@@ -27,6 +31,12 @@ func (cc *ConfigController) Show(c *gin.Context) {
 
 type ConfigV2Resource struct {
 	Config string `json:"config"`
+	// Effective is Config with the default values applied and the secrets
+	// redacted
+	Effective string `json:"effective"`
+	// Sources maps the dotted keys of Effective to the source of their
+	// values: env, db or default
+	Sources map[string]string `json:"sources"`
 }
 
 func (c ConfigV2Resource) GetID() string {
@@ -44,12 +54,31 @@ func (cc *ConfigController) Dump(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
-	jsonAPIResponse(c, ConfigV2Resource{tomlStr}, "config")
+	effective, sources, err := cc.App.EffectiveConfigDump(c)
+	if err != nil {
+		cc.App.GetLogger().Errorw("Failed to dump effective TOML config", "err", err)
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, ConfigV2Resource{Config: tomlStr, Effective: effective, Sources: sources}, "config")
 }
 
+// configPatchRequest holds the settings which can be changed at runtime. The
+// chain settings are persisted, the others last until the node is restarted.
 type configPatchRequest struct {
-	EvmGasPriceDefault *utils.Big `json:"ethGasPriceDefault"`
-	EVMChainID         *utils.Big `json:"evmChainID"`
+	EVMChainID *utils.Big `json:"evmChainID"`
+
+	EvmGasPriceDefault   *utils.Big       `json:"ethGasPriceDefault"`
+	EvmMaxGasPriceWei    *utils.Big       `json:"evmMaxGasPriceWei"`
+	EthTxReaperThreshold *models.Duration `json:"ethTxReaperThreshold"`
+
+	LogLevel                   *zapcore.Level   `json:"logLevel"`
+	DefaultHTTPTimeout         *models.Duration `json:"defaultHTTPTimeout"`
+	JobPipelineReaperThreshold *models.Duration `json:"jobPipelineReaperThreshold"`
+}
+
+func (r *configPatchRequest) chainScoped() bool {
+	return r.EvmGasPriceDefault != nil || r.EvmMaxGasPriceWei != nil || r.EthTxReaperThreshold != nil
 }
 
 // ConfigPatchResponse represents the change to the configuration made due to a
@@ -57,6 +86,9 @@ type configPatchRequest struct {
 type ConfigPatchResponse struct {
 	EvmGasPriceDefault Change     `json:"ethGasPriceDefault"`
 	EVMChainID         *utils.Big `json:"evmChainID"`
+	// Changes holds the changes of the other settings, keyed by their request
+	// field
+	Changes map[string]Change `json:"changes,omitempty"`
 }
 
 // Change represents the old value and the new value after a PATH request has
@@ -77,35 +109,83 @@ func (*ConfigPatchResponse) SetID(string) error {
 	return nil
 }
 
-// Patch updates one or more configuration options
+// Patch updates one or more configuration options, without restarting the
+// node: the log level, the gas price ceilings, the reaper thresholds and the
+// HTTP task timeouts.
+// Example:
+// "PATCH <application>/config"
 func (cc *ConfigController) Patch(c *gin.Context) {
 	request := &configPatchRequest{}
 	if err := c.ShouldBindJSON(request); err != nil {
 		jsonAPIError(c, http.StatusUnprocessableEntity, err)
 		return
 	}
-
-	chain, err := getChain(cc.App.GetChains().EVM, request.EVMChainID.String())
-	switch err {
-	case ErrInvalidChainID, ErrMultipleChains, ErrMissingChainID:
-		jsonAPIError(c, http.StatusUnprocessableEntity, err)
-		return
-	case nil:
-		break
-	default:
-		jsonAPIError(c, http.StatusInternalServerError, err)
+	if !request.chainScoped() && request.LogLevel == nil && request.DefaultHTTPTimeout == nil && request.JobPipelineReaperThreshold == nil {
+		jsonAPIError(c, http.StatusBadRequest, errors.New("no config to update"))
 		return
 	}
 
-	if err := chain.Config().SetEvmGasPriceDefault(request.EvmGasPriceDefault.ToInt()); err != nil {
-		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("failed to set gas price default: %+v", err))
-		return
+	response := &ConfigPatchResponse{Changes: map[string]Change{}}
+	if request.chainScoped() {
+		chain, err := getChain(cc.App.GetChains().EVM, request.EVMChainID.String())
+		switch err {
+		case ErrInvalidChainID, ErrMultipleChains, ErrMissingChainID:
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		case nil:
+			break
+		default:
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		cfg := chain.Config()
+		response.EVMChainID = utils.NewBig(chain.ID())
+
+		if request.EvmMaxGasPriceWei != nil {
+			from := cfg.EvmMaxGasPriceWei().String()
+			if err := cfg.SetEvmMaxGasPriceWei(request.EvmMaxGasPriceWei.ToInt()); err != nil {
+				jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("failed to set maximum gas price: %+v", err))
+				return
+			}
+			response.Changes["evmMaxGasPriceWei"] = Change{From: from, To: cfg.EvmMaxGasPriceWei().String()}
+		}
+		if request.EvmGasPriceDefault != nil {
+			from := cfg.EvmGasPriceDefault().String()
+			if err := cfg.SetEvmGasPriceDefault(request.EvmGasPriceDefault.ToInt()); err != nil {
+				jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("failed to set gas price default: %+v", err))
+				return
+			}
+			response.EvmGasPriceDefault = Change{From: from, To: cfg.EvmGasPriceDefault().String()}
+		}
+		if request.EthTxReaperThreshold != nil {
+			from := cfg.EthTxReaperThreshold().String()
+			if err := cfg.SetEthTxReaperThreshold(request.EthTxReaperThreshold.Duration()); err != nil {
+				jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("failed to set eth_tx reaper threshold: %+v", err))
+				return
+			}
+			response.Changes["ethTxReaperThreshold"] = Change{From: from, To: cfg.EthTxReaperThreshold().String()}
+		}
+	}
+
+	gcfg := cc.App.GetConfig()
+	if request.LogLevel != nil {
+		from := gcfg.LogLevel().String()
+		if err := cc.App.SetLogLevel(*request.LogLevel); err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		response.Changes["logLevel"] = Change{From: from, To: gcfg.LogLevel().String()}
 	}
-	response := &ConfigPatchResponse{
-		EvmGasPriceDefault: Change{
-			From: chain.Config().EvmGasPriceDefault().String(),
-			To:   request.EvmGasPriceDefault.String(),
-		}, EVMChainID: utils.NewBig(chain.ID()),
+	if request.DefaultHTTPTimeout != nil {
+		from := gcfg.DefaultHTTPTimeout().String()
+		gcfg.SetDefaultHTTPTimeout(*request.DefaultHTTPTimeout)
+		response.Changes["defaultHTTPTimeout"] = Change{From: from, To: gcfg.DefaultHTTPTimeout().String()}
 	}
+	if request.JobPipelineReaperThreshold != nil {
+		from := gcfg.JobPipelineReaperThreshold().String()
+		gcfg.SetJobPipelineReaperThreshold(request.JobPipelineReaperThreshold.Duration())
+		response.Changes["jobPipelineReaperThreshold"] = Change{From: from, To: gcfg.JobPipelineReaperThreshold().String()}
+	}
+
 	jsonAPIResponse(c, response, "config")
 }
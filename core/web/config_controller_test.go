@@ -1,9 +1,11 @@
 package web_test
 
 import (
+	"bytes"
 	"math/big"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -12,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/web"
 )
 
 func TestConfigController_Show(t *testing.T) {
@@ -36,3 +39,53 @@ func TestConfigController_Show(t *testing.T) {
 	assert.Equal(t, big.NewInt(evmclient.NullClientChainID).String(), cp.DefaultChainID)
 	assert.Equal(t, cltest.NewTestGeneralConfig(t).BlockBackfillDepth(), cp.BlockBackfillDepth)
 }
+
+func TestConfigController_Patch(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	resp, cleanup := client.Patch("/v2/config", bytes.NewBufferString(`{}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+
+	resp, cleanup = client.Patch("/v2/config", bytes.NewBufferString(`{"defaultHTTPTimeout": "-1s"}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Patch("/v2/config", bytes.NewBufferString(`{"logLevel": "warn", "defaultHTTPTimeout": "42s", "jobPipelineReaperThreshold": "48h"}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var patched web.ConfigPatchResponse
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &patched))
+	assert.Equal(t, "42s", patched.Changes["defaultHTTPTimeout"].To)
+	assert.Equal(t, "48h0m0s", patched.Changes["jobPipelineReaperThreshold"].To)
+	assert.Contains(t, patched.Changes, "logLevel")
+	assert.Nil(t, patched.EVMChainID)
+
+	cfg := app.GetConfig()
+	assert.Equal(t, 42*time.Second, cfg.DefaultHTTPTimeout().Duration())
+	assert.Equal(t, 48*time.Hour, cfg.JobPipelineReaperThreshold())
+}
+
+func TestConfigController_Dump(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	resp, cleanup := client.Get("/v2/config/v2")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var dump web.ConfigV2Resource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &dump))
+	assert.NotEmpty(t, dump.Effective)
+	for key, source := range dump.Sources {
+		assert.Contains(t, []string{"env", "db", "default"}, source, key)
+	}
+}
@@ -4,8 +4,13 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/web/auth"
 )
 
 func TestCors_DefaultOrigins(t *testing.T) {
@@ -68,3 +73,27 @@ func TestCors_OverrideOrigins(t *testing.T) {
 		})
 	}
 }
+
+func TestCors_AllowHeaders(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestGeneralConfig(t)
+	config.Overrides.AllowOrigins = null.StringFrom("http://ui.example")
+	config.Overrides.AllowHeaders = null.StringFrom("X-Request-ID")
+	config.Overrides.EVMRPCEnabled = null.BoolFrom(false)
+	app := cltest.NewApplicationWithConfig(t, config)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	req, err := http.NewRequestWithContext(testutils.Context(t), http.MethodOptions, app.Server.URL+"/v2/jobs", nil)
+	require.NoError(t, err)
+	req.Header.Set("Origin", "http://ui.example")
+	req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	allowed := resp.Header.Get("Access-Control-Allow-Headers")
+	assert.Contains(t, allowed, http.CanonicalHeaderKey("X-Request-ID"))
+	assert.Contains(t, allowed, http.CanonicalHeaderKey(auth.CSRFTokenHeader))
+}
@@ -0,0 +1,125 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/keyrotation"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// ETHKeyRotationsController manages the rotations of the ETH keys
+type ETHKeyRotationsController struct {
+	App chainlink.Application
+}
+
+// ETHKeyRotationRequest is the body of a request starting a rotation
+type ETHKeyRotationRequest struct {
+	Address    string `json:"address"`
+	EVMChainID string `json:"evmChainID"`
+}
+
+// Index lists the rotations, the latest first
+// Example:
+// "GET <application>/keys/eth_rotations"
+func (rc *ETHKeyRotationsController) Index(c *gin.Context) {
+	rotations, err := rc.App.ETHKeyRotator().ETHRotations()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewETHKeyRotationResources(rotations), "ethKeyRotations")
+}
+
+// Show returns the state of a rotation
+// Example:
+// "GET <application>/keys/eth_rotations/:ID"
+func (rc *ETHKeyRotationsController) Show(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("ID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	rotation, err := rc.App.ETHKeyRotator().FindETHRotation(id)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("rotation not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewETHKeyRotationResource(rotation), "ethKeyRotations")
+}
+
+// Create generates a new key replacing the key of the address on the chain,
+// and starts its rotation. The rotation then switches the jobs to the new key,
+// waits for the jobs referring to the old key in their pipeline to be updated
+// and for the transactions of the old key to be finalized, sweeps its ETH to
+// the new key and disables it.
+// Example:
+// "POST <application>/keys/eth_rotations"
+func (rc *ETHKeyRotationsController) Create(c *gin.Context) {
+	request := &ETHKeyRotationRequest{}
+	if err := c.ShouldBindJSON(request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if !common.IsHexAddress(request.Address) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid address: %s", request.Address))
+		return
+	}
+	chain, err := getChain(rc.App.GetChains().EVM, request.EVMChainID)
+	if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	rotation, err := rc.App.ETHKeyRotator().Rotate(chain.ID(), common.HexToAddress(request.Address))
+	if errors.Is(err, keyrotation.ErrETHKeyAlreadyRotating) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, presenters.NewETHKeyRotationResource(rotation), "ethKeyRotations", http.StatusCreated)
+}
+
+// Force stops the rotation from waiting for the jobs referring to the old key
+// in their pipeline, whose transactions fail once the old key is disabled
+// Example:
+// "POST <application>/keys/eth_rotations/:ID/force"
+func (rc *ETHKeyRotationsController) Force(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("ID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	rotation, err := rc.App.ETHKeyRotator().ForceETHRotation(id)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("rotation not found"))
+		return
+	} else if errors.Is(err, keyrotation.ErrETHRotationNotPending) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewETHKeyRotationResource(rotation), "ethKeyRotations")
+}
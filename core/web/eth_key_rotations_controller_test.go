@@ -0,0 +1,78 @@
+package web_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/services/keyrotation"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestETHKeyRotationsController_CreateShow(t *testing.T) {
+	t.Parallel()
+
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.EVMRPCEnabled = null.BoolFrom(false)
+	app := cltest.NewApplicationWithConfig(t, cfg)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+	_, address := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+
+	create := func(address string) *http.Response {
+		resp, cleanup := client.Post("/v2/keys/eth_rotations", bytes.NewBufferString(fmt.Sprintf(`{"address": %q, "evmChainID": "0"}`, address)))
+		t.Cleanup(cleanup)
+		return resp
+	}
+
+	t.Run("invalid keys", func(t *testing.T) {
+		cltest.AssertServerResponse(t, create("0x123"), http.StatusUnprocessableEntity)
+		cltest.AssertServerResponse(t, create(testutils.NewAddress().Hex()), http.StatusUnprocessableEntity)
+	})
+
+	resp := create(address.Hex())
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+	var created presenters.ETHKeyRotationResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &created))
+	assert.Equal(t, address.Hex(), created.OldAddress)
+	assert.NotEqual(t, address.Hex(), created.NewAddress)
+	assert.Equal(t, keyrotation.ETHStateMigratingJobs, created.State)
+
+	cltest.AssertServerResponse(t, create(address.Hex()), http.StatusConflict)
+
+	resp, cleanup := client.Get("/v2/keys/eth_rotations/" + created.ID)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var shown presenters.ETHKeyRotationResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &shown))
+	assert.Equal(t, created.NewAddress, shown.NewAddress)
+
+	resp, cleanup = client.Get("/v2/keys/eth_rotations")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var rotations []presenters.ETHKeyRotationResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &rotations))
+	assert.Len(t, rotations, 1)
+
+	resp, cleanup = client.Get("/v2/keys/eth_rotations/42")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+	resp, cleanup = client.Post("/v2/keys/eth_rotations/"+created.ID+"/force", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var forced presenters.ETHKeyRotationResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &forced))
+	assert.True(t, forced.Forced)
+
+	resp, cleanup = client.Post("/v2/keys/eth_rotations/42/force", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
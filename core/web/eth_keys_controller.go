@@ -7,11 +7,17 @@ import (
 	"net/http"
 	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/monitor"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
@@ -19,7 +25,9 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
 	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
 )
 
 // ETHKeysController manages account keys
@@ -58,6 +66,7 @@ func (ekc *ETHKeysController) Index(c *gin.Context) {
 			ekc.setEthBalance(c.Request.Context(), state),
 			ekc.setLinkBalance(c.Request.Context(), state),
 			ekc.setKeyMaxGasPriceWei(state, key.Address),
+			ekc.setRemoteSigner(key),
 		)
 		if err != nil {
 			jsonAPIError(c, http.StatusInternalServerError, err)
@@ -277,6 +286,62 @@ func (ekc *ETHKeysController) Import(c *gin.Context) {
 	jsonAPIResponse(c, r, "account")
 }
 
+// AddRemote adds a key whose transactions are signed by a remote signer
+// (clef, vault or grpc), so that its private key is never held by the node
+// Example:
+//
+//	"<application>/keys/evm/remote?address=0x...&type=clef&url=http://localhost:8550"
+func (ekc *ETHKeysController) AddRemote(c *gin.Context) {
+	ethKeyStore := ekc.App.GetKeyStore().Eth()
+
+	if !common.IsHexAddress(c.Query("address")) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid address: %q", c.Query("address")))
+		return
+	}
+	address := common.HexToAddress(c.Query("address"))
+
+	chain, err := getChain(ekc.App.GetChains().EVM, c.Query("evmChainID"))
+	switch err {
+	case ErrInvalidChainID, ErrMultipleChains, ErrMissingChainID:
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	case nil:
+		break
+	default:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	key, err := ethKeyStore.AddRemote(ethkey.RemoteSigner{
+		Address: ethkey.EIP55AddressFromAddress(address),
+		Type:    c.Query("type"),
+		URL:     c.Query("url"),
+		KeyName: c.Query("keyName"),
+	}, chain.ID())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	state, err := ethKeyStore.GetState(key.ID(), chain.ID())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	r, err := presenters.NewETHKeyResource(key, state,
+		ekc.setEthBalance(c.Request.Context(), state),
+		ekc.setLinkBalance(c.Request.Context(), state),
+		ekc.setKeyMaxGasPriceWei(state, key.Address),
+		ekc.setRemoteSigner(key),
+	)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, r, "account", http.StatusCreated)
+}
+
 func (ekc *ETHKeysController) Export(c *gin.Context) {
 	defer ekc.App.GetLogger().ErrorIfClosing(c.Request.Body, "Export request body")
 
@@ -291,6 +356,73 @@ func (ekc *ETHKeysController) Export(c *gin.Context) {
 	c.Data(http.StatusOK, MediaType, bytes)
 }
 
+// keyActivityTransactions is the number of recent transactions returned by
+// Activity
+const keyActivityTransactions = 20
+
+// Activity returns the recent transactions of the key, the history of its
+// balances and its gas spend per day over the last days (30 by default, the
+// retention of the balance history), and the jobs using it.
+// Example:
+//
+//	"<application>/keys/eth/:address/activity?days=7"
+func (ekc *ETHKeysController) Activity(c *gin.Context) {
+	ctx := c.Request.Context()
+	if !common.IsHexAddress(c.Param("address")) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid address: %s", c.Param("address")))
+		return
+	}
+	address := common.HexToAddress(c.Param("address"))
+	if _, err := ekc.App.GetKeyStore().Eth().Get(address.Hex()); err != nil {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+
+	maxDays := int(monitor.BalanceSampleRetention / (24 * time.Hour))
+	days := maxDays
+	if s := c.Query("days"); s != "" {
+		var err error
+		days, err = strconv.Atoi(s)
+		if err != nil || days < 1 || days > maxDays {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid value for days: expected 1 to %d, got: %s", maxDays, s))
+			return
+		}
+	}
+	since := time.Now().Add(-time.Duration(days) * 24 * time.Hour)
+
+	txs, _, err := ekc.App.TxmORM().EthTransactionsWithAttemptsByMeta(txmgr.EthTxMetaFilter{FromAddress: &address}, 0, keyActivityTransactions)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	samples, err := monitor.NewORM(ekc.App.GetSqlxDB(), ekc.App.GetLogger(), ekc.App.GetConfig()).BalanceSamples(address, since, pg.WithParentCtx(ctx))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	spend, err := ekc.App.TxmORM().GasSpendByDay(address, since)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jobIDs, err := ekc.App.JobORM().FindJobIDsWithEthKey(address, pg.WithParentCtx(ctx))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jobs := make([]job.Job, 0, len(jobIDs))
+	for _, id := range jobIDs {
+		jb, err := ekc.App.JobORM().FindJob(ctx, id)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		jobs = append(jobs, jb)
+	}
+
+	jsonAPIResponse(c, presenters.NewETHKeyActivityResource(address, txs, samples, spend, jobs), "ethKeyActivity")
+}
+
 // Chain updates settings for a given chain for the key
 func (ekc *ETHKeysController) Chain(c *gin.Context) {
 	kst := ekc.App.GetKeyStore().Eth()
@@ -378,6 +510,32 @@ func (ekc *ETHKeysController) Chain(c *gin.Context) {
 		return
 	}
 
+	limits, changed, err := spendLimitsFromQuery(c, state.SpendLimits)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if changed {
+		if err = kst.SetSpendLimits(address, chain.ID(), limits); err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		state.SpendLimits = limits
+	}
+
+	thresholds, changed, err := ekc.fundingThresholdsFromQuery(c, state.FundingThresholds)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if changed {
+		if err = kst.SetFundingThresholds(address, chain.ID(), thresholds); err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		state.FundingThresholds = thresholds
+	}
+
 	r, err := presenters.NewETHKeyResource(key, state,
 		ekc.setEthBalance(c.Request.Context(), state),
 		ekc.setLinkBalance(c.Request.Context(), state),
@@ -390,6 +548,101 @@ func (ekc *ETHKeysController) Chain(c *gin.Context) {
 	jsonAPIResponse(c, r, "account")
 }
 
+// spendLimitsFromQuery applies the maxDailySpendWei, maxInFlightTransactions
+// and enabledJobTypes (comma separated) query params to limits. An empty value
+// removes the corresponding limit.
+func spendLimitsFromQuery(c *gin.Context, limits ethkey.SpendLimits) (ethkey.SpendLimits, bool, error) {
+	var changed bool
+	if v, ok := c.GetQuery("maxDailySpendWei"); ok {
+		changed = true
+		limits.MaxDailySpend = nil
+		if v != "" {
+			maxDailySpend, ok := new(big.Int).SetString(v, 10)
+			if !ok || maxDailySpend.Sign() < 0 {
+				return limits, false, errors.Errorf("invalid value for maxDailySpendWei: expected 0 or positive int, got: %s", v)
+			}
+			limits.MaxDailySpend = utils.NewBig(maxDailySpend)
+		}
+	}
+	if v, ok := c.GetQuery("maxInFlightTransactions"); ok {
+		changed = true
+		limits.MaxInFlightTransactions = null.Int{}
+		if v != "" {
+			maxInFlight, err := strconv.ParseInt(v, 10, 32)
+			if err != nil || maxInFlight <= 0 {
+				return limits, false, errors.Errorf("invalid value for maxInFlightTransactions: expected positive int, got: %s", v)
+			}
+			limits.MaxInFlightTransactions = null.IntFrom(maxInFlight)
+		}
+	}
+	if v, ok := c.GetQuery("enabledJobTypes"); ok {
+		changed = true
+		limits.EnabledJobTypes = nil
+		for _, t := range strings.Split(v, ",") {
+			t = strings.TrimSpace(t)
+			if t == "" {
+				continue
+			}
+			if job.Type(t).SchemaVersion() == 0 {
+				return limits, false, errors.Errorf("invalid value for enabledJobTypes: unknown job type %s", t)
+			}
+			limits.EnabledJobTypes = append(limits.EnabledJobTypes, t)
+		}
+	}
+	return limits, changed, nil
+}
+
+// fundingThresholdsFromQuery applies the lowBalanceWei, criticalBalanceWei and
+// fundingJobID query params to thresholds. An empty value removes the
+// corresponding setting. The funding job must be a webhook job.
+func (ekc *ETHKeysController) fundingThresholdsFromQuery(c *gin.Context, thresholds ethkey.FundingThresholds) (ethkey.FundingThresholds, bool, error) {
+	var changed bool
+	for _, p := range []struct {
+		param     string
+		threshold **utils.Big
+	}{
+		{"lowBalanceWei", &thresholds.LowBalance},
+		{"criticalBalanceWei", &thresholds.CriticalBalance},
+	} {
+		param, threshold := p.param, p.threshold
+		v, ok := c.GetQuery(param)
+		if !ok {
+			continue
+		}
+		changed = true
+		*threshold = nil
+		if v != "" {
+			balance, ok := new(big.Int).SetString(v, 10)
+			if !ok || balance.Sign() < 0 {
+				return thresholds, false, errors.Errorf("invalid value for %s: expected 0 or positive int, got: %s", param, v)
+			}
+			*threshold = utils.NewBig(balance)
+		}
+	}
+	if thresholds.LowBalance != nil && thresholds.CriticalBalance != nil && thresholds.CriticalBalance.Cmp(thresholds.LowBalance) > 0 {
+		return thresholds, false, errors.New("criticalBalanceWei must not be greater than lowBalanceWei")
+	}
+	if v, ok := c.GetQuery("fundingJobID"); ok {
+		changed = true
+		thresholds.FundingJobID = uuid.NullUUID{}
+		if v != "" {
+			jobID, err := uuid.FromString(v)
+			if err != nil {
+				return thresholds, false, errors.Errorf("invalid value for fundingJobID: expected uuid, got: %s", v)
+			}
+			jb, err := ekc.App.JobORM().FindJobByExternalJobID(jobID, pg.WithParentCtx(c.Request.Context()))
+			if err != nil {
+				return thresholds, false, errors.Wrapf(err, "failed to find funding job %s", v)
+			}
+			if jb.Type != job.Webhook {
+				return thresholds, false, errors.Errorf("funding job %s must be a webhook job, got: %s", v, jb.Type)
+			}
+			thresholds.FundingJobID = uuid.NullUUID{UUID: jobID, Valid: true}
+		}
+	}
+	return thresholds, changed, nil
+}
+
 // setEthBalance is a custom functional option for NewEthKeyResource which
 // queries the EthClient for the ETH balance at the address and sets it on the
 // resource.
@@ -464,3 +717,17 @@ func (ekc *ETHKeysController) setKeyMaxGasPriceWei(state ethkey.State, keyAddres
 		return nil
 	}
 }
+
+// setRemoteSigner sets the remote signer type of remotely signed keys
+func (ekc *ETHKeysController) setRemoteSigner(key ethkey.KeyV2) presenters.NewETHKeyOption {
+	if !key.IsRemote() {
+		return func(*presenters.ETHKeyResource) error { return nil }
+	}
+	signer, err := ekc.App.GetKeyStore().Eth().GetRemoteSigner(key.ID())
+	return func(r *presenters.ETHKeyResource) error {
+		if err != nil {
+			return err
+		}
+		return presenters.SetETHKeyRemoteSigner(signer.Type)(r)
+	}
+}
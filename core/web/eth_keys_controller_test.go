@@ -7,12 +7,15 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	evmMocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/monitor"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	webpresenters "github.com/smartcontractkit/chainlink/core/web/presenters"
 
+	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -191,3 +194,199 @@ func TestETHKeysController_UpdateSuccess(t *testing.T) {
 
 	require.Equal(t, assets.GWei(777), chain.Config().KeySpecificMaxGasPriceWei(key.Address))
 }
+
+func TestETHKeysController_AddRemote(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestGeneralConfig(t)
+	config.Overrides.GlobalBalanceMonitorEnabled = null.BoolFrom(false)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	app := cltest.NewApplicationWithConfigAndKey(t, config, ethClient)
+
+	sub := evmMocks.NewSubscription(t)
+	cltest.MockApplicationEthCalls(t, app, ethClient, sub)
+	ethClient.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).Return(big.NewInt(100), nil)
+	ethClient.On("GetLINKBalance", mock.Anything, mock.Anything, mock.Anything).Return(assets.NewLinkFromJuels(42), nil)
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	address := testutils.NewAddress()
+	remoteURL := "/v2/keys/evm/remote?evmChainID=" + cltest.FixtureChainID.String() + "&address=" + address.Hex()
+
+	resp, cleanup := client.Post(remoteURL+"&type=clef&url=http://localhost:8550", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+
+	var r webpresenters.ETHKeyResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &r))
+	assert.Equal(t, address.Hex(), r.Address)
+	assert.Equal(t, "clef", r.RemoteSigner)
+	assert.False(t, r.Disabled)
+
+	key, err := app.KeyStore.Eth().Get(address.Hex())
+	require.NoError(t, err)
+	assert.True(t, key.IsRemote())
+
+	resp, cleanup = client.Post(remoteURL+"&type=clef&url=http://localhost:8550", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Post("/v2/keys/evm/remote?address=0x1234&type=clef&url=http://localhost:8550", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Post("/v2/keys/evm/remote?address="+testutils.NewAddress().Hex()+"&type=ledger&url=http://localhost:8550", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
+
+func TestETHKeysController_ChainSpendLimits(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestGeneralConfig(t)
+	config.Overrides.GlobalBalanceMonitorEnabled = null.BoolFrom(false)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	app := cltest.NewApplicationWithConfigAndKey(t, config, ethClient)
+
+	sub := evmMocks.NewSubscription(t)
+	cltest.MockApplicationEthCalls(t, app, ethClient, sub)
+	ethClient.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).Return(big.NewInt(100), nil)
+	ethClient.On("GetLINKBalance", mock.Anything, mock.Anything, mock.Anything).Return(assets.NewLinkFromJuels(42), nil)
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	key, err := app.KeyStore.Eth().GetRoundRobinAddress(&cltest.FixtureChainID)
+	require.NoError(t, err)
+	chainURL := "/v2/keys/evm/chain?evmChainID=" + cltest.FixtureChainID.String() + "&address=" + key.Hex()
+
+	resp, cleanup := client.Post(chainURL+"&maxDailySpendWei=1000000&maxInFlightTransactions=4&enabledJobTypes=vrf,keeper", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var r webpresenters.ETHKeyResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &r))
+	assert.Equal(t, "1000000", r.MaxDailySpendWei.String())
+	assert.Equal(t, null.IntFrom(4), r.MaxInFlightTransactions)
+	assert.Equal(t, []string{"vrf", "keeper"}, r.EnabledJobTypes)
+
+	state, err := app.KeyStore.Eth().GetState(key.Hex(), &cltest.FixtureChainID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), state.MaxInFlightTransactions.Int64)
+
+	// only the passed limits are changed
+	resp, cleanup = client.Post(chainURL+"&maxDailySpendWei=", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	state, err = app.KeyStore.Eth().GetState(key.Hex(), &cltest.FixtureChainID)
+	require.NoError(t, err)
+	assert.Nil(t, state.MaxDailySpend)
+	assert.Equal(t, int64(4), state.MaxInFlightTransactions.Int64)
+
+	resp, cleanup = client.Post(chainURL+"&enabledJobTypes=notajob", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Post(chainURL+"&maxInFlightTransactions=0", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
+
+func TestETHKeysController_ChainFundingThresholds(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestGeneralConfig(t)
+	config.Overrides.GlobalBalanceMonitorEnabled = null.BoolFrom(false)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	app := cltest.NewApplicationWithConfigAndKey(t, config, ethClient)
+
+	sub := evmMocks.NewSubscription(t)
+	cltest.MockApplicationEthCalls(t, app, ethClient, sub)
+	ethClient.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).Return(big.NewInt(100), nil)
+	ethClient.On("GetLINKBalance", mock.Anything, mock.Anything, mock.Anything).Return(assets.NewLinkFromJuels(42), nil)
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	key, err := app.KeyStore.Eth().GetRoundRobinAddress(&cltest.FixtureChainID)
+	require.NoError(t, err)
+	chainURL := "/v2/keys/evm/chain?evmChainID=" + cltest.FixtureChainID.String() + "&address=" + key.Hex()
+	jb, _ := cltest.MustInsertWebhookSpec(t, app.GetSqlxDB())
+
+	resp, cleanup := client.Post(chainURL+"&lowBalanceWei=200&criticalBalanceWei=50&fundingJobID="+jb.ExternalJobID.String(), nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var r webpresenters.ETHKeyResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &r))
+	assert.Equal(t, "200", r.LowBalanceWei.String())
+	assert.Equal(t, "50", r.CriticalBalanceWei.String())
+	assert.Equal(t, jb.ExternalJobID.String(), r.FundingJobID)
+	assert.Equal(t, ethkey.FundingStatusLow, r.FundingStatus)
+
+	state, err := app.KeyStore.Eth().GetState(key.Hex(), &cltest.FixtureChainID)
+	require.NoError(t, err)
+	assert.Equal(t, jb.ExternalJobID, state.FundingJobID.UUID)
+
+	// only the passed thresholds are changed
+	resp, cleanup = client.Post(chainURL+"&criticalBalanceWei=&fundingJobID=", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	state, err = app.KeyStore.Eth().GetState(key.Hex(), &cltest.FixtureChainID)
+	require.NoError(t, err)
+	assert.Equal(t, "200", state.LowBalance.String())
+	assert.Nil(t, state.CriticalBalance)
+	assert.False(t, state.FundingJobID.Valid)
+
+	resp, cleanup = client.Post(chainURL+"&criticalBalanceWei=300", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Post(chainURL+"&fundingJobID="+uuid.NewV4().String(), nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
+
+func TestETHKeysController_Activity(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth(), 0)
+	cltest.MustInsertConfirmedEthTxWithReceipt(t, app.TxmORM(), from, 0, 1)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, app.TxmORM(), 1, from)
+	pgtest.MustExec(t, app.GetSqlxDB(), `UPDATE eth_receipts SET receipt = jsonb_set(receipt, '{gasUsed}', '"0x5208"')`)
+	balanceORM := monitor.NewORM(app.GetSqlxDB(), app.GetLogger(), app.GetConfig())
+	require.NoError(t, balanceORM.InsertBalanceSample(big.NewInt(0), from, assets.NewEthValue(42)))
+
+	resp, cleanup := client.Get("/v2/keys/eth/" + from.Hex() + "/activity")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var activity webpresenters.ETHKeyActivityResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &activity))
+	assert.Equal(t, from.Hex(), activity.ID)
+	assert.Len(t, activity.Transactions, 2)
+	require.Len(t, activity.BalanceHistory, 1)
+	assert.Equal(t, big.NewInt(42), activity.BalanceHistory[0].Balance.ToInt())
+	require.Len(t, activity.GasSpend, 1)
+	assert.Equal(t, 1, activity.GasSpend[0].Transactions)
+	assert.Empty(t, activity.Jobs)
+
+	t.Run("invalid days", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/keys/eth/" + from.Hex() + "/activity?days=31")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/keys/eth/" + testutils.NewAddress().Hex() + "/activity")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+}
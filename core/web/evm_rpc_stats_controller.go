@@ -0,0 +1,30 @@
+package web
+
+import (
+	"github.com/gin-gonic/gin"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// EVMRPCStatsController reports the usage of the EVM RPC endpoints
+type EVMRPCStatsController struct {
+	App chainlink.Application
+}
+
+// Index returns the number of calls, approximate bytes and latencies of every
+// JSON-RPC method called on every EVM RPC endpoint since the node started,
+// optionally filtered by chain with the evmChainID query parameter.
+// Example:
+// "GET <application>/ethereum/rpc-stats"
+func (rc *EVMRPCStatsController) Index(c *gin.Context) {
+	chainID := c.Query("evmChainID")
+	var stats []evmclient.RPCStat
+	for _, s := range evmclient.RPCStats() {
+		if chainID == "" || s.EVMChainID == chainID {
+			stats = append(stats, s)
+		}
+	}
+	jsonAPIResponse(c, presenters.NewRPCStatResources(stats), "rpc_stats")
+}
@@ -0,0 +1,34 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestEVMRPCStatsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(cltest.APIEmailViewOnly)
+
+	resp, cleanup := client.Get("/v2/ethereum/rpc-stats")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	resp, cleanup = client.Get("/v2/ethereum/rpc-stats?evmChainID=" + testutils.NewRandomEVMChainID().String())
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var stats []presenters.RPCStatResource
+	require.NoError(t, jsonapi.Unmarshal(cltest.ParseResponseBody(t, resp), &stats))
+	assert.Empty(t, stats)
+}
@@ -1,10 +1,17 @@
 package web
 
 import (
+	"context"
 	"database/sql"
 	"net/http"
+	"sort"
+	"strconv"
+	"time"
 
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -17,9 +24,39 @@ type TransactionsController struct {
 	App chainlink.Application
 }
 
-// Index returns paginated transactions
+// Index returns paginated transactions, optionally only the ones created by
+// a job or a pipeline run.
+// Example:
+//  "<application>/transactions/evm?jobID=1"
+//  "<application>/transactions/evm?runID=1"
 func (tc *TransactionsController) Index(c *gin.Context, size, page, offset int) {
-	txs, count, err := tc.App.TxmORM().EthTransactionsWithAttempts(offset, size)
+	var filter txmgr.EthTxMetaFilter
+	if s := c.Query("jobID"); s != "" {
+		jobID, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid jobID"))
+			return
+		}
+		id := int32(jobID)
+		filter.JobID = &id
+	}
+	if s := c.Query("runID"); s != "" {
+		runID, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid runID"))
+			return
+		}
+		filter.RunID = &runID
+	}
+
+	var txs []txmgr.EthTx
+	var count int
+	var err error
+	if filter.JobID != nil || filter.RunID != nil {
+		txs, count, err = tc.App.TxmORM().EthTransactionsWithAttemptsByMeta(filter, offset, size)
+	} else {
+		txs, count, err = tc.App.TxmORM().EthTransactionsWithAttempts(offset, size)
+	}
 	ptxs := make([]presenters.EthTxResource, len(txs))
 	for i, tx := range txs {
 		tx.EthTxAttempts[0].EthTx = tx
@@ -46,3 +83,185 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(*ethTxAttempt), "transaction")
 }
+
+// Attempts returns the attempts of the transaction of the attempt with the
+// given hash, from the first to the last one, with their receipts.
+// Example:
+//  "<application>/transactions/evm/:TxHash/attempts"
+func (tc *TransactionsController) Attempts(c *gin.Context) {
+	hash := common.HexToHash(c.Param("TxHash"))
+
+	etx, err := tc.App.TxmORM().FindEthTxByHash(hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	*etx, err = tc.App.TxmORM().FindEthTxWithAttempts(etx.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	attempts := etx.EthTxAttempts
+	sort.Slice(attempts, func(i, j int) bool {
+		if attempts[i].CreatedAt.Equal(attempts[j].CreatedAt) {
+			return attempts[i].ID < attempts[j].ID
+		}
+		return attempts[i].CreatedAt.Before(attempts[j].CreatedAt)
+	})
+
+	jsonAPIResponse(c, presenters.NewEthTxAttemptResources(attempts), "evm_tx_attempts")
+}
+
+// GasAnalytics returns the gas spent by the transactions of the jobs, and
+// their average gas bumps before inclusion, over the last days (30 by
+// default), optionally only for one job.
+// Example:
+//  "<application>/transactions/evm/gas_analytics?jobID=1&days=7"
+func (tc *TransactionsController) GasAnalytics(c *gin.Context) {
+	var jobID *int32
+	if s := c.Query("jobID"); s != "" {
+		id, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid jobID"))
+			return
+		}
+		id32 := int32(id)
+		jobID = &id32
+	}
+	days := 30
+	if s := c.Query("days"); s != "" {
+		var err error
+		days, err = strconv.Atoi(s)
+		if err != nil || days <= 0 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid days: %q", s))
+			return
+		}
+	}
+
+	jobs, err := tc.App.TxmORM().GasAnalyticsByJob(jobID, time.Now().AddDate(0, 0, -days))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobGasAnalyticsResources(jobs), "job_gas_analytics")
+}
+
+// Bump immediately sends a new attempt of an unconfirmed transaction with
+// bumped gas.
+// Example:
+//  "<application>/transactions/evm/:TxHash/bump"
+func (tc *TransactionsController) Bump(c *gin.Context) {
+	tc.force(c, func(txm txmgr.TxManager, ctx context.Context, etxID int64) error {
+		return txm.BumpEthTransaction(ctx, etxID)
+	})
+}
+
+// Cancel replaces an unconfirmed transaction with a zero-value transfer to its
+// sender at the same nonce.
+// Example:
+//  "<application>/transactions/evm/:TxHash/cancel"
+func (tc *TransactionsController) Cancel(c *gin.Context) {
+	tc.force(c, func(txm txmgr.TxManager, ctx context.Context, etxID int64) error {
+		return txm.CancelEthTransaction(ctx, etxID)
+	})
+}
+
+// Rebroadcast reconciles the local nonce of a key with the chain, backfilling
+// nonce gaps and re-sending the unconfirmed transactions within the given
+// (inclusive) nonce range.
+// Example:
+//  "<application>/transactions/evm/rebroadcast?address=0x...&beginningNonce=0&endingNonce=10"
+func (tc *TransactionsController) Rebroadcast(c *gin.Context) {
+	if !common.IsHexAddress(c.Query("address")) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid address: %q", c.Query("address")))
+		return
+	}
+	address := common.HexToAddress(c.Query("address"))
+
+	beginningNonce, err := strconv.ParseInt(c.Query("beginningNonce"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid beginningNonce"))
+		return
+	}
+	endingNonce, err := strconv.ParseInt(c.Query("endingNonce"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid endingNonce"))
+		return
+	}
+	if beginningNonce < 0 || endingNonce < beginningNonce {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid nonce range %d to %d", beginningNonce, endingNonce))
+		return
+	}
+
+	chain, err := getChain(tc.App.GetChains().EVM, c.Query("evmChainID"))
+	switch err {
+	case ErrInvalidChainID, ErrMultipleChains, ErrMissingChainID:
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	case nil:
+		break
+	default:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if err = tc.App.GetKeyStore().Eth().CheckEnabled(address, chain.ID()); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	rec, err := chain.TxManager().ReconcileNonces(c.Request.Context(), address, beginningNonce, endingNonce)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewNonceReconciliationResource(rec, *utils.NewBig(chain.ID())), "nonce_reconciliation")
+}
+
+// force runs fn for the transaction of the attempt with the given hash, and
+// responds with its latest attempt.
+func (tc *TransactionsController) force(c *gin.Context, fn func(txm txmgr.TxManager, ctx context.Context, etxID int64) error) {
+	hash := common.HexToHash(c.Param("TxHash"))
+
+	attempt, err := tc.App.TxmORM().FindEthTxAttempt(hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	chain, err := tc.App.GetChains().EVM.Get(attempt.EthTx.EVMChainID.ToInt())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	err = fn(chain.TxManager(), c.Request.Context(), attempt.EthTxID)
+	if errors.Is(err, txmgr.ErrEthTxNotUnconfirmed) || gas.IsBumpErr(err) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	etx, err := tc.App.TxmORM().FindEthTxWithAttempts(attempt.EthTxID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	latest := etx.EthTxAttempts[0]
+	latest.EthTx = etx
+
+	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(latest), "transaction")
+}
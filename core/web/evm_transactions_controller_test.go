@@ -9,6 +9,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
@@ -63,6 +64,43 @@ func TestTransactionsController_Index_Success(t *testing.T) {
 	require.Equal(t, "3", txs[1].SentAt, "expected tx attempts order by sentAt descending")
 }
 
+func TestTransactionsController_Index_ByJob(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	db := app.GetSqlxDB()
+	borm := app.TxmORM()
+	ethKeyStore := cltest.NewKeyStore(t, db, app.Config).Eth()
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+	_, from := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+	tx1 := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 0, 1, from)
+	cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 1, 2, from)
+	pgtest.MustExec(t, db, `UPDATE eth_txes SET meta = '{"JobID": 7, "RunID": 42, "TaskName": "submit"}' WHERE id = $1`, tx1.ID)
+
+	resp, cleanup := client.Get("/v2/transactions?jobID=7")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var links jsonapi.Links
+	var txs []presenters.EthTxResource
+	body := cltest.ParseResponseBody(t, resp)
+	require.NoError(t, web.ParsePaginatedResponse(body, &txs, &links))
+	require.Len(t, txs, 1)
+	require.NotNil(t, txs[0].JobID)
+	assert.Equal(t, int32(7), *txs[0].JobID)
+	require.NotNil(t, txs[0].RunID)
+	assert.Equal(t, int64(42), *txs[0].RunID)
+	require.NotNil(t, txs[0].TaskName)
+	assert.Equal(t, "submit", *txs[0].TaskName)
+
+	resp, cleanup = client.Get("/v2/transactions?runID=abc")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
+
 func TestTransactionsController_Index_Error(t *testing.T) {
 	t.Parallel()
 
@@ -125,3 +163,128 @@ func TestTransactionsController_Show_NotFound(t *testing.T) {
 	t.Cleanup(cleanup)
 	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
 }
+
+func TestTransactionsController_Attempts(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	borm := app.TxmORM()
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth(), 0)
+
+	tx := cltest.MustInsertConfirmedEthTxWithReceipt(t, borm, from, 0, 1)
+	blockNum := int64(2)
+	bump := cltest.NewLegacyEthTxAttempt(t, tx.ID)
+	bump.State = txmgr.EthTxAttemptBroadcast
+	bump.GasPrice = utils.NewBig(big.NewInt(3))
+	bump.BroadcastBeforeBlockNum = &blockNum
+	require.NoError(t, borm.InsertEthTxAttempt(&bump))
+
+	resp, cleanup := client.Get("/v2/transactions/evm/" + bump.Hash.Hex() + "/attempts")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var attempts []presenters.EthTxAttemptResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &attempts))
+	require.Len(t, attempts, 2)
+	assert.Equal(t, tx.EthTxAttempts[0].Hash.Hex(), attempts[0].ID)
+	assert.Equal(t, 0, attempts[0].Bump)
+	require.Len(t, attempts[0].Receipts, 1)
+	assert.Equal(t, int64(1), attempts[0].Receipts[0].BlockNumber)
+	assert.Equal(t, bump.Hash.Hex(), attempts[1].ID)
+	assert.Equal(t, 1, attempts[1].Bump)
+	assert.Equal(t, big.NewInt(3), attempts[1].GasPrice.ToInt())
+	assert.Empty(t, attempts[1].Receipts)
+
+	resp, cleanup = client.Get("/v2/transactions/evm/" + utils.NewHash().Hex() + "/attempts")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestTransactionsController_GasAnalytics(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	db := app.GetSqlxDB()
+	borm := app.TxmORM()
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth(), 0)
+
+	tx := cltest.MustInsertConfirmedEthTxWithReceipt(t, borm, from, 0, 1)
+	pgtest.MustExec(t, db, `UPDATE eth_txes SET meta = '{"JobID": 7}' WHERE id = $1`, tx.ID)
+	pgtest.MustExec(t, db, `UPDATE eth_receipts SET receipt = jsonb_set(receipt, '{gasUsed}', '"0x5208"')`)
+
+	resp, cleanup := client.Get("/v2/transactions/evm/gas_analytics?jobID=7&days=7")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var jobs []presenters.JobGasAnalyticsResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &jobs))
+	require.Len(t, jobs, 1)
+	assert.Equal(t, int32(7), jobs[0].JobID)
+	assert.Equal(t, 1, jobs[0].Transactions)
+	assert.Equal(t, uint64(21000), jobs[0].GasUsed)
+	assert.Equal(t, float64(0), jobs[0].AverageBumps)
+
+	resp, cleanup = client.Get("/v2/transactions/evm/gas_analytics?days=0")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
+
+func TestTransactionsController_Bump_NotUnconfirmed(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	borm := app.TxmORM()
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth(), 0)
+	tx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 1, 1, from)
+	require.Len(t, tx.EthTxAttempts, 1)
+	attempt := tx.EthTxAttempts[0]
+
+	for _, action := range []string{"bump", "cancel"} {
+		resp, cleanup := client.Post("/v2/transactions/evm/"+attempt.Hash.Hex()+"/"+action, nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	}
+}
+
+func TestTransactionsController_Bump_NotFound(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	resp, cleanup := client.Post("/v2/transactions/evm/"+utils.NewHash().Hex()+"/bump", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestTransactionsController_Rebroadcast_InvalidParams(t *testing.T) {
+	t.Parallel()
+
+	key := cltest.MustGenerateRandomKey(t)
+	app := cltest.NewApplicationWithKey(t, key)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	for _, query := range []string{
+		"address=foo&beginningNonce=0&endingNonce=1",
+		"address=" + key.Address.Hex() + "&beginningNonce=2&endingNonce=1",
+		"address=" + key.Address.Hex() + "&beginningNonce=0",
+		"address=" + utils.ZeroAddress.Hex() + "&beginningNonce=0&endingNonce=1",
+	} {
+		resp, cleanup := client.Post("/v2/transactions/evm/rebroadcast?"+query, nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	}
+}
@@ -0,0 +1,73 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/externaldb"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// ExternalDatabasesController manages the external databases queried by the
+// dbquery tasks
+type ExternalDatabasesController struct {
+	App chainlink.Application
+}
+
+// CreateExternalDatabaseRequest is a JSONAPI request for registering an
+// external database
+type CreateExternalDatabaseRequest struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// Index lists external databases
+// Example:
+// "GET <application>/external_databases"
+func (edc *ExternalDatabasesController) Index(c *gin.Context) {
+	dbs, err := edc.App.ExternalDatabases().Databases()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewExternalDatabaseResources(dbs), "externalDatabases")
+}
+
+// Create registers an external database. Its URL is stored encrypted.
+// Example:
+// "POST <application>/external_databases"
+func (edc *ExternalDatabasesController) Create(c *gin.Context) {
+	var request CreateExternalDatabaseRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	db := externaldb.Database{Name: request.Name, URL: request.URL}
+	if err := edc.App.ExternalDatabases().Register(&db); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, presenters.NewExternalDatabaseResource(db), "externalDatabase", http.StatusCreated)
+}
+
+// Delete unregisters an external database
+// Example:
+// "DELETE <application>/external_databases/:name"
+func (edc *ExternalDatabasesController) Delete(c *gin.Context) {
+	err := edc.App.ExternalDatabases().Unregister(c.Param("name"))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("external database not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "externalDatabase", http.StatusNoContent)
+}
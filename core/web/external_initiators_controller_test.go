@@ -25,7 +25,7 @@ func TestValidateExternalInitiator(t *testing.T) {
 
 	db := pgtest.NewSqlxDB(t)
 	cfg := cltest.NewTestGeneralConfig(t)
-	orm := bridges.NewORM(db, logger.TestLogger(t), cfg)
+	orm := bridges.NewORM(db, logger.TestLogger(t), cfg, nil)
 
 	url := cltest.WebURL(t, "https://a.web.url")
 
@@ -72,7 +72,7 @@ func TestExternalInitiatorsController_Index(t *testing.T) {
 	client := app.NewHTTPClient(cltest.APIEmailAdmin)
 
 	db := app.GetSqlxDB()
-	borm := bridges.NewORM(db, logger.TestLogger(t), app.GetConfig())
+	borm := bridges.NewORM(db, logger.TestLogger(t), app.GetConfig(), app.GetKeyStore().ColumnCiphers())
 
 	eiFoo := cltest.MustInsertExternalInitiatorWithOpts(t, borm, cltest.ExternalInitiatorOpts{
 		NamePrefix:    "foo",
@@ -1,12 +1,19 @@
 package web
 
 import (
+	"fmt"
 	"net/http"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/store/migrate"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
@@ -14,10 +21,8 @@ type HealthController struct {
 	App chainlink.Application
 }
 
-// NOTE: We only implement the k8s readiness check, *not* the liveness check. Liveness checks are only recommended in cases
-// where the app doesn't crash itself on panic, and if implemented incorrectly can cause cascading failures.
-// See the following for more information:
-// - https://srcco.de/posts/kubernetes-liveness-probes-are-dangerous.html
+// NOTE: Readyz requires every service to be ready. Prefer /health/readiness,
+// which only requires the node's dependencies unless ReadinessStrict is set.
 func (hc *HealthController) Readyz(c *gin.Context) {
 	status := http.StatusOK
 
@@ -58,38 +63,128 @@ func (hc *HealthController) Readyz(c *gin.Context) {
 	jsonAPIResponse(c, checks, "checks")
 }
 
+// Liveness reports that the process is responsive. It does not check any
+// dependency, so that nodes that are alive but temporarily degraded are not
+// restarted. See the following for more information:
+// - https://srcco.de/posts/kubernetes-liveness-probes-are-dangerous.html
+func (hc *HealthController) Liveness(c *gin.Context) {
+	c.Status(http.StatusOK)
+}
+
+// Readiness reports whether the node can serve traffic: the database is
+// reachable, the keystore is unlocked, the migrations are applied and each
+// EVM chain is connected to its RPC nodes. With ReadinessStrict, every service
+// must be ready as well.
+func (hc *HealthController) Readiness(c *gin.Context) {
+	errs := hc.readinessErrors()
+	if hc.App.GetConfig().ReadinessStrict() {
+		_, serviceErrs := hc.App.GetHealthChecker().IsReady()
+		for name, err := range serviceErrs {
+			errs[name] = err
+		}
+	}
+
+	names := make([]string, 0, len(errs))
+	for name := range errs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	status := http.StatusOK
+	checks := make([]presenters.Check, 0, len(errs))
+	for _, name := range names {
+		check := presenters.Check{
+			JAID:   presenters.NewJAID(name),
+			Name:   name,
+			Status: services.StatusPassing,
+		}
+		if err := errs[name]; err != nil {
+			status = http.StatusServiceUnavailable
+			check.Status = services.StatusFailing
+			check.Output = err.Error()
+		}
+		checks = append(checks, check)
+	}
+
+	jsonAPIResponseWithStatus(c, checks, "checks", status)
+}
+
+// readinessErrors checks the dependencies required to serve traffic
+func (hc *HealthController) readinessErrors() map[string]error {
+	db := hc.App.GetSqlxDB()
+	errs := map[string]error{
+		"Database": pg.NewDBHealthChecker(db).Ready(),
+	}
+
+	errs["Keystore"] = nil
+	if hc.App.GetKeyStore().IsLocked() {
+		errs["Keystore"] = errors.New("keystore is locked")
+	}
+
+	pending, err := migrate.Pending(db.DB)
+	if err == nil && pending > 0 {
+		err = errors.Errorf("%d migrations have not been applied", pending)
+	}
+	errs["Migrations"] = err
+
+	if evmChainSet := hc.App.GetChains().EVM; evmChainSet != nil {
+		for _, chain := range evmChainSet.Chains() {
+			errs[fmt.Sprintf("EVM.%s.RPC", chain.ID())] = chain.HeadTracker().Healthy()
+		}
+	}
+	return errs
+}
+
+// Health returns the health of every service, including the subservices of
+// each chain and job. The report is in JSON API format by default, or in the
+// Prometheus text format with ?format=prometheus.
 func (hc *HealthController) Health(c *gin.Context) {
 	status := http.StatusOK
 
 	checker := hc.App.GetHealthChecker()
 
-	healthy, errors := checker.IsHealthy()
+	healthy, _ := checker.IsHealthy()
 
 	if !healthy {
 		status = http.StatusServiceUnavailable
 	}
 
-	c.Status(status)
-
-	checks := make([]presenters.Check, 0, len(errors))
-
-	for name, err := range errors {
-		status := services.StatusPassing
-		var output string
+	statuses := checker.Statuses()
+	names := make([]string, 0, len(statuses))
+	for name := range statuses {
+		names = append(names, name)
+	}
+	sort.Strings(names)
 
-		if err != nil {
-			status = services.StatusFailing
-			output = err.Error()
-		}
+	if c.Query("format") == "prometheus" {
+		c.String(status, prometheusHealth(names, statuses))
+		return
+	}
 
-		checks = append(checks, presenters.Check{
-			JAID:   presenters.NewJAID(name),
-			Name:   name,
-			Status: status,
-			Output: output,
-		})
+	now := time.Now()
+	checks := make([]presenters.Check, 0, len(statuses))
+	for _, name := range names {
+		checks = append(checks, presenters.NewCheck(name, statuses[name], now))
 	}
 
 	// return a json description of all the checks
-	jsonAPIResponse(c, checks, "checks")
+	jsonAPIResponseWithStatus(c, checks, "checks", status)
+}
+
+// prometheusHealth formats the statuses in the Prometheus text format
+func prometheusHealth(names []string, statuses map[string]services.ServiceStatus) string {
+	var sb strings.Builder
+	sb.WriteString("# HELP health Health status by service\n# TYPE health gauge\n")
+	for _, name := range names {
+		value := 0
+		if statuses[name].Healthy == nil {
+			value = 1
+		}
+		fmt.Fprintf(&sb, "health{service_id=%q} %d\n", name, value)
+	}
+	sb.WriteString("# HELP health_status_since_seconds Unix time of the last change between healthy and unhealthy by service\n# TYPE health_status_since_seconds gauge\n")
+	for _, name := range names {
+		fmt.Fprintf(&sb, "health_status_since_seconds{service_id=%q} %d\n", name, statuses[name].Since.Unix())
+	}
+	return sb.String()
 }
@@ -3,10 +3,17 @@ package web_test
 import (
 	"net/http"
 	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/services/mocks"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -47,3 +54,113 @@ func TestHealthController_Readyz(t *testing.T) {
 		})
 	}
 }
+
+func TestHealthController_Health(t *testing.T) {
+	app := cltest.NewApplicationWithKey(t)
+	healthChecker := new(mocks.Checker)
+	healthChecker.On("Start").Return(nil).Once()
+	healthChecker.On("IsHealthy").Return(false, map[string]error{"a": nil, "b": errors.New("down")})
+	healthChecker.On("Statuses").Return(map[string]services.ServiceStatus{
+		"a": {Since: time.Now().Add(-time.Minute), LastError: errors.New("was down"), LastErrorAt: time.Now().Add(-time.Hour)},
+		"b": {Healthy: errors.New("down"), Since: time.Unix(42, 0), LastError: errors.New("down"), LastErrorAt: time.Now()},
+	})
+	healthChecker.On("Close").Return(nil).Once()
+
+	app.HealthChecker = healthChecker
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	t.Run("json", func(t *testing.T) {
+		resp, cleanup := client.Get("/health")
+		t.Cleanup(cleanup)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+
+		var checks []presenters.Check
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &checks))
+		require.Len(t, checks, 2)
+		assert.Equal(t, "a", checks[0].Name)
+		assert.Equal(t, services.StatusPassing, checks[0].Status)
+		assert.Equal(t, "1m0s", checks[0].Uptime)
+		assert.Equal(t, "was down", checks[0].LastError)
+		assert.Equal(t, "b", checks[1].Name)
+		assert.Equal(t, services.StatusFailing, checks[1].Status)
+		assert.Equal(t, "down", checks[1].Output)
+		assert.Empty(t, checks[1].Uptime)
+	})
+
+	t.Run("prometheus", func(t *testing.T) {
+		resp, cleanup := client.Get("/health?format=prometheus")
+		t.Cleanup(cleanup)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+
+		body := string(cltest.ParseResponseBody(t, resp))
+		assert.Contains(t, body, `health{service_id="a"} 1`)
+		assert.Contains(t, body, `health{service_id="b"} 0`)
+		assert.Contains(t, body, `health_status_since_seconds{service_id="b"} 42`)
+	})
+}
+
+func TestHealthController_Liveness(t *testing.T) {
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+	resp, cleanup := client.Get("/health/liveness")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestHealthController_Readiness(t *testing.T) {
+	var tt = []struct {
+		name   string
+		strict bool
+		status int
+		checks []string
+	}{
+		{
+			name:   "lenient",
+			strict: false,
+			status: http.StatusOK,
+			checks: []string{"Database", "Keystore", "Migrations"},
+		},
+		{
+			name:   "strict",
+			strict: true,
+			status: http.StatusServiceUnavailable,
+			checks: []string{"Database", "Foo", "Keystore", "Migrations"},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := cltest.NewTestGeneralConfig(t)
+			cfg.Overrides.EVMEnabled = null.BoolFrom(false)
+			cfg.Overrides.EVMRPCEnabled = null.BoolFrom(false)
+			cfg.Overrides.ReadinessStrict = null.BoolFrom(tc.strict)
+			app := cltest.NewApplicationWithConfigAndKey(t, cfg)
+			healthChecker := new(mocks.Checker)
+			healthChecker.On("Start").Return(nil).Once()
+			healthChecker.On("IsReady").Return(false, map[string]error{"Foo": errors.New("not ready")}).Maybe()
+			healthChecker.On("Close").Return(nil).Once()
+
+			app.HealthChecker = healthChecker
+			require.NoError(t, app.Start(testutils.Context(t)))
+
+			client := app.NewHTTPClient(cltest.APIEmailAdmin)
+			resp, cleanup := client.Get("/health/readiness")
+			t.Cleanup(cleanup)
+			assert.Equal(t, tc.status, resp.StatusCode)
+
+			var checks []presenters.Check
+			require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &checks))
+			var names []string
+			for _, check := range checks {
+				names = append(names, check.Name)
+				if check.Name != "Foo" {
+					assert.Equal(t, services.StatusPassing, check.Status, check.Output)
+				}
+			}
+			assert.Equal(t, tc.checks, names)
+		})
+	}
+}
@@ -45,6 +45,14 @@ func paginatedResponse(
 	}
 }
 
+func cursorPaginatedResponse(c *gin.Context, resource interface{}, next string) {
+	if buffer, err := NewCursorPaginatedResponse(*c.Request.URL, next, resource); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("failed to marshal document: %+v", err))
+	} else {
+		c.Data(http.StatusOK, MediaType, buffer)
+	}
+}
+
 func paginatedRequest(action func(*gin.Context, int, int, int)) func(*gin.Context) {
 	return func(c *gin.Context) {
 		size, page, offset, err := ParsePaginatedRequest(c.Query("size"), c.Query("page"))
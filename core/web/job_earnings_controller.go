@@ -0,0 +1,36 @@
+package web
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/core/services/budgets"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/payments"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// JobEarningsController reports the LINK earned by the requests served by the
+// jobs, next to the wei spent by their transactions
+type JobEarningsController struct {
+	App chainlink.Application
+}
+
+// Index returns the earnings of the jobs which received payments, this month
+// and in total
+// Example:
+// "GET <application>/job_earnings"
+func (jec *JobEarningsController) Index(c *gin.Context) {
+	monthStart := budgets.MonthStart(time.Now())
+	orm := payments.NewORM(jec.App.GetSqlxDB(), jec.App.GetLogger(), jec.App.GetConfig())
+	earnings, err := orm.JobEarnings(monthStart, pg.WithParentCtx(c.Request.Context()))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobEarningsResources(earnings, monthStart), "jobEarnings")
+}
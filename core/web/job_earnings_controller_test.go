@@ -0,0 +1,32 @@
+package web_test
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestJobEarningsController_Index(t *testing.T) {
+	app, client, _, _, _, jobID := setupJobSpecsControllerTestsWithJobs(t)
+	pgtest.MustExec(t, app.GetSqlxDB(), `INSERT INTO link_payments (job_id, evm_chain_id, contract_address, request_id, requester, amount, state, request_tx_hash, created_at, cancelled_at) VALUES
+($1, 0, $2, $3, $4, 100, 'cancelled', $5, NOW(), NOW())`, jobID, testutils.NewAddress(), utils.NewHash(), testutils.NewAddress(), utils.NewHash())
+
+	resp, cleanup := client.Get("/v2/job_earnings")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var earnings []presenters.JobEarningsResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &earnings))
+	require.Len(t, earnings, 1)
+	assert.Equal(t, strconv.Itoa(int(jobID)), earnings[0].ID)
+	assert.Equal(t, int64(1), earnings[0].Cancelled)
+	assert.True(t, earnings[0].TotalEarned.IsZero())
+}
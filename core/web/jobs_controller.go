@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -11,6 +12,7 @@ import (
 	uuid "github.com/satori/go.uuid"
 
 	"github.com/smartcontractkit/chainlink/core/services/blockhashstore"
+	"github.com/smartcontractkit/chainlink/core/services/budgets"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/cron"
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
@@ -21,7 +23,10 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/core/services/ocrcommon"
+	"github.com/smartcontractkit/chainlink/core/services/payments"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/vrf"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
@@ -32,16 +37,21 @@ type JobsController struct {
 	App chainlink.Application
 }
 
-// Index lists all jobs
+// Index lists all jobs, or the archived jobs with archived=true
 // Example:
 // "GET <application>/jobs"
+// "GET <application>/jobs?archived=true"
 func (jc *JobsController) Index(c *gin.Context, size, page, offset int) {
 	// Temporary: if no size is passed in, use a large page size. Remove once frontend can handle pagination
 	if c.Query("size") == "" {
 		size = 1000
 	}
 
-	jobs, count, err := jc.App.JobORM().FindJobs(offset, size)
+	findJobs := jc.App.JobORM().FindJobs
+	if archived, _ := strconv.ParseBool(c.Query("archived")); archived {
+		findJobs = jc.App.JobORM().FindArchivedJobs
+	}
+	jobs, count, err := findJobs(offset, size)
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
@@ -59,17 +69,121 @@ func (jc *JobsController) Index(c *gin.Context, size, page, offset int) {
 // Example:
 // "GET <application>/jobs/:ID"
 func (jc *JobsController) Show(c *gin.Context) {
+	jobSpec, ok := jc.findJob(c)
+	if !ok {
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobResource(jobSpec), "jobs")
+}
+
+// Graph returns the task graph of the pipeline of a job, with the status of
+// the tasks in its last run, as JSON or, with format=svg, as an SVG image.
+// :ID could be both job ID and external job ID
+// Example:
+// "GET <application>/jobs/:ID/pipeline/graph"
+// "GET <application>/jobs/:ID/pipeline/graph?format=svg"
+func (jc *JobsController) Graph(c *gin.Context) {
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "svg" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("unsupported format %q, must be json or svg", format))
+		return
+	}
+
+	jb, ok := jc.findJob(c)
+	if !ok {
+		return
+	}
+	if jb.PipelineSpec == nil {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job has no pipeline"))
+		return
+	}
+	p, err := jb.PipelineSpec.Pipeline()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, errors.Wrap(err, "failed to parse pipeline"))
+		return
+	}
+
+	var lastRun *pipeline.Run
+	ids, err := jc.App.JobORM().FindPipelineRunIDsByJobID(jb.ID, 0, 1)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if len(ids) > 0 {
+		runs, err := jc.App.JobORM().FindPipelineRunsByIDs(ids)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		if len(runs) > 0 {
+			lastRun = &runs[0]
+		}
+	}
+
+	resource := presenters.NewPipelineGraphResource(jb.ID, p, lastRun)
+	if format == "svg" {
+		c.Data(http.StatusOK, "image/svg+xml", resource.SVG())
+		return
+	}
+	jsonAPIResponse(c, resource, "pipelineGraphs")
+}
+
+// Spend returns the wei spent by the confirmed transactions of a job, this
+// month and in total, and its monthly budget.
+// Example:
+// "GET <application>/jobs/:ID/spend"
+func (jc *JobsController) Spend(c *gin.Context) {
+	jb, ok := jc.findJob(c)
+	if !ok {
+		return
+	}
+
+	monthStart := budgets.MonthStart(time.Now())
+	orm := budgets.NewORM(jc.App.GetSqlxDB(), jc.App.GetLogger(), jc.App.GetConfig())
+	spend, err := orm.FindJobSpend(jb.ID, monthStart, pg.WithParentCtx(c.Request.Context()))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobSpendResource(spend, monthStart), "jobSpends")
+}
+
+// Earnings returns the LINK earned by the job this month and in total, with
+// the wei spent by its transactions
+// Example:
+// "GET <application>/jobs/:ID/earnings"
+func (jc *JobsController) Earnings(c *gin.Context) {
+	jb, ok := jc.findJob(c)
+	if !ok {
+		return
+	}
+
+	monthStart := budgets.MonthStart(time.Now())
+	orm := payments.NewORM(jc.App.GetSqlxDB(), jc.App.GetLogger(), jc.App.GetConfig())
+	earnings, err := orm.FindJobEarnings(jb.ID, monthStart, pg.WithParentCtx(c.Request.Context()))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobEarningsResource(earnings, monthStart), "jobEarnings")
+}
+
+// findJob finds the job of :ID, a job ID or an external job ID, writing the
+// error response if it fails
+func (jc *JobsController) findJob(c *gin.Context) (jb job.Job, ok bool) {
 	var err error
-	jobSpec := job.Job{}
 	if externalJobID, pErr := uuid.FromString(c.Param("ID")); pErr == nil {
 		// Find a job by external job ID
-		jobSpec, err = jc.App.JobORM().FindJobByExternalJobID(externalJobID, pg.WithParentCtx(c.Request.Context()))
-	} else if pErr = jobSpec.SetID(c.Param("ID")); pErr == nil {
+		jb, err = jc.App.JobORM().FindJobByExternalJobID(externalJobID, pg.WithParentCtx(c.Request.Context()))
+	} else if pErr = jb.SetID(c.Param("ID")); pErr == nil {
 		// Find a job by job ID
-		jobSpec, err = jc.App.JobORM().FindJobTx(jobSpec.ID)
+		jb, err = jc.App.JobORM().FindJobTx(jb.ID)
 	} else {
 		jsonAPIError(c, http.StatusUnprocessableEntity, pErr)
-		return
+		return jb, false
 	}
 	if err != nil {
 		if errors.Is(errors.Cause(err), sql.ErrNoRows) {
@@ -77,10 +191,9 @@ func (jc *JobsController) Show(c *gin.Context) {
 		} else {
 			jsonAPIError(c, http.StatusInternalServerError, err)
 		}
-		return
+		return jb, false
 	}
-
-	jsonAPIResponse(c, presenters.NewJobResource(jobSpec), "jobs")
+	return jb, true
 }
 
 // CreateJobRequest represents a request to create and start a job (V2).
@@ -98,6 +211,14 @@ func (jc *JobsController) Create(c *gin.Context) {
 		return
 	}
 
+	config := jc.App.GetConfig()
+	var err error
+	request.TOML, err = job.ExpandSpecVariables(request.TOML, config.JobPipelineSpecVariables())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
 	jobType, err := job.ValidateSpec(request.TOML)
 	if err != nil {
 		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to parse TOML"))
@@ -105,7 +226,6 @@ func (jc *JobsController) Create(c *gin.Context) {
 	}
 
 	var jb job.Job
-	config := jc.App.GetConfig()
 	switch jobType {
 	case job.OffchainReporting:
 		jb, err = ocr.ValidatedOracleSpecToml(jc.App.GetChains().EVM, request.TOML)
@@ -148,7 +268,8 @@ func (jc *JobsController) Create(c *gin.Context) {
 	defer cancel()
 	err = jc.App.AddJobV2(ctx, &jb)
 	if err != nil {
-		if errors.Is(errors.Cause(err), job.ErrNoSuchKeyBundle) || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Is(errors.Cause(err), job.ErrNoSuchTransmitterKey) {
+		if errors.Is(errors.Cause(err), job.ErrNoSuchKeyBundle) || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Is(errors.Cause(err), job.ErrNoSuchTransmitterKey) ||
+			errors.Is(err, ocrcommon.ErrBootstrapperUnreachable) {
 			jsonAPIError(c, http.StatusBadRequest, err)
 			return
 		}
@@ -159,9 +280,13 @@ func (jc *JobsController) Create(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
 }
 
-// Delete hard deletes a job spec.
+// Delete hard deletes a job spec. With archive=true the job is archived
+// instead, keeping its spec and runs, and with purge=true an archived job is
+// deleted.
 // Example:
 // "DELETE <application>/specs/:ID"
+// "DELETE <application>/specs/:ID?archive=true"
+// "DELETE <application>/specs/:ID?purge=true"
 func (jc *JobsController) Delete(c *gin.Context) {
 	j := job.Job{}
 	err := j.SetID(c.Param("ID"))
@@ -169,14 +294,32 @@ func (jc *JobsController) Delete(c *gin.Context) {
 		jsonAPIError(c, http.StatusUnprocessableEntity, err)
 		return
 	}
+	archive, _ := strconv.ParseBool(c.Query("archive"))
+	purge, _ := strconv.ParseBool(c.Query("purge"))
+	if archive && purge {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("archive and purge are mutually exclusive"))
+		return
+	}
 
 	// Delete the job
-	err = jc.App.DeleteJob(c.Request.Context(), j.ID)
+	switch {
+	case archive:
+		err = jc.App.ArchiveJob(c.Request.Context(), j.ID)
+	case purge:
+		err = jc.App.PurgeJob(c.Request.Context(), j.ID)
+	default:
+		err = jc.App.DeleteJob(c.Request.Context(), j.ID)
+	}
 	if errors.Is(err, sql.ErrNoRows) {
 		jsonAPIError(c, http.StatusNotFound, errors.New("JobSpec not found"))
 
 		return
 	}
+	if errors.Is(err, job.ErrJobNotArchived) {
+		jsonAPIError(c, http.StatusConflict, err)
+
+		return
+	}
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 
@@ -185,3 +328,51 @@ func (jc *JobsController) Delete(c *gin.Context) {
 
 	jsonAPIResponseWithStatus(c, nil, "job", http.StatusNoContent)
 }
+
+// Pause stops the services of a job until it is resumed.
+// Example:
+// "POST <application>/jobs/:ID/pause"
+func (jc *JobsController) Pause(c *gin.Context) {
+	jc.setPaused(c, jc.App.PauseJob)
+}
+
+// Resume restarts the services of a paused job.
+// Example:
+// "POST <application>/jobs/:ID/resume"
+func (jc *JobsController) Resume(c *gin.Context) {
+	jc.setPaused(c, jc.App.ResumeJob)
+}
+
+func (jc *JobsController) setPaused(c *gin.Context, set func(ctx context.Context, jobID int32) error) {
+	j := job.Job{}
+	if err := j.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jb, err := jc.App.JobORM().FindJobTx(j.ID)
+	if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if jb.ArchivedAt.Valid {
+		jsonAPIError(c, http.StatusConflict, errors.New("job is archived"))
+		return
+	}
+
+	if err = set(c.Request.Context(), j.ID); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jb, err = jc.App.JobORM().FindJobTx(j.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobResource(jb), "jobs")
+}
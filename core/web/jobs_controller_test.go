@@ -23,12 +23,15 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/testdata/testspecs"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/utils/tomlutils"
 	"github.com/smartcontractkit/chainlink/core/web"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
@@ -469,6 +472,151 @@ func TestJobsController_Show_NonExistentID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusNotFound)
 }
 
+func TestJobsController_Graph(t *testing.T) {
+	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
+	url := fmt.Sprintf("/v2/jobs/%v/pipeline/graph", jobID)
+
+	response, cleanup := client.Get(url)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var graph presenters.PipelineGraphResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &graph))
+	assert.Equal(t, strconv.Itoa(int(jobID)), graph.ID)
+	require.NotNil(t, graph.LastRunID)
+	assert.Equal(t, strconv.Itoa(int(runIDs[1])), *graph.LastRunID)
+
+	require.Len(t, graph.Nodes, 8)
+	nodes := make(map[string]presenters.PipelineGraphNode)
+	for _, node := range graph.Nodes {
+		nodes[node.ID] = node
+	}
+	assert.Equal(t, pipeline.TaskTypeJSONParse, nodes["ds1_parse"].Type)
+	assert.Equal(t, map[string]string{"path": "USD"}, nodes["ds1_parse"].Params)
+	require.NotNil(t, nodes["ds1_parse"].Status)
+	assert.Equal(t, pipeline.RunStatusCompleted, *nodes["ds1_parse"].Status)
+	assert.Nil(t, nodes["ds1_parse"].Error)
+	require.NotNil(t, nodes["ds3"].Status)
+	assert.Equal(t, pipeline.RunStatusErrored, *nodes["ds3"].Status)
+	require.NotNil(t, nodes["ds3"].Error)
+	assert.Equal(t, "uh oh", *nodes["ds3"].Error)
+
+	assert.Len(t, graph.Edges, 7)
+	assert.Contains(t, graph.Edges, presenters.PipelineGraphEdge{From: "ds3", To: "answer"})
+
+	response, cleanup = client.Get(url + "?format=svg")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	assert.Equal(t, "image/svg+xml", response.Header.Get("Content-Type"))
+	svg := string(cltest.ParseResponseBody(t, response))
+	assert.Contains(t, svg, "<svg")
+	assert.Contains(t, svg, "<title>ds3: errored: uh oh</title>")
+
+	response, cleanup = client.Get(url + "?format=png")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+	response, cleanup = client.Get("/v2/jobs/999999999/pipeline/graph")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
+func TestJobsController_Spend(t *testing.T) {
+	app, client, _, _, _, jobID := setupJobSpecsControllerTestsWithJobs(t)
+	db := app.GetSqlxDB()
+	pgtest.MustExec(t, db, `UPDATE jobs SET monthly_budget = 100 WHERE id = $1`, jobID)
+	pgtest.MustExec(t, db, `INSERT INTO job_spends (eth_tx_id, job_id, evm_chain_id, gas_used, fee, confirmed_at) VALUES
+(1, $1, 0, 21000, 60, NOW()), (2, $1, 0, 21000, 30, NOW() - interval '100 days')`, jobID)
+
+	response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/spend", jobID))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var spend presenters.JobSpendResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &spend))
+	assert.Equal(t, strconv.Itoa(int(jobID)), spend.ID)
+	require.NotNil(t, spend.MonthlyBudget)
+	assert.Equal(t, "100", spend.MonthlyBudget.ToInt().String())
+	assert.Equal(t, "60", spend.MonthSpend.ToInt().String())
+	assert.Equal(t, "90", spend.TotalSpend.ToInt().String())
+	assert.Equal(t, int64(2), spend.Transactions)
+	assert.False(t, spend.BudgetExceeded)
+
+	response, cleanup = client.Get("/v2/jobs/999999999/spend")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
+func TestJobsController_Earnings(t *testing.T) {
+	app, client, _, _, _, jobID := setupJobSpecsControllerTestsWithJobs(t)
+	db := app.GetSqlxDB()
+	pgtest.MustExec(t, db, `INSERT INTO link_payments (job_id, evm_chain_id, contract_address, request_id, requester, amount, state, request_tx_hash, created_at, fulfilled_at) VALUES
+($1, 0, $2, $3, $4, 100, 'fulfilled', $5, NOW(), NOW()), ($1, 0, $2, $5, $4, 40, 'requested', $3, NOW(), NULL)`, jobID, testutils.NewAddress(), utils.NewHash(), testutils.NewAddress(), utils.NewHash())
+	pgtest.MustExec(t, db, `INSERT INTO job_spends (eth_tx_id, job_id, evm_chain_id, gas_used, fee, confirmed_at) VALUES (1, $1, 0, 21000, 60, NOW())`, jobID)
+
+	response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/earnings", jobID))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var earnings presenters.JobEarningsResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &earnings))
+	assert.Equal(t, strconv.Itoa(int(jobID)), earnings.ID)
+	assert.Equal(t, "100", earnings.MonthEarned.String())
+	assert.Equal(t, "100", earnings.TotalEarned.String())
+	assert.Equal(t, "40", earnings.Pending.String())
+	assert.Equal(t, int64(2), earnings.Requests)
+	assert.Equal(t, int64(1), earnings.Fulfilled)
+	assert.Equal(t, "60", earnings.MonthSpend.ToInt().String())
+
+	response, cleanup = client.Get("/v2/jobs/999999999/earnings")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
+func TestJobsController_Delete_ArchiveAndPurge(t *testing.T) {
+	app, client, _, jobID, _, jobID2 := setupJobSpecsControllerTestsWithJobs(t)
+	id := fmt.Sprintf("%v", jobID)
+
+	response, cleanup := client.Delete("/v2/jobs/" + id + "?archive=true&purge=true")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+	// only archived jobs can be purged
+	response, cleanup = client.Delete("/v2/jobs/" + id + "?purge=true")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusConflict)
+
+	response, cleanup = client.Delete("/v2/jobs/" + id + "?archive=true")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNoContent)
+	assert.NotContains(t, app.JobSpawner().ActiveJobs(), jobID)
+
+	response, cleanup = client.Get("/v2/jobs")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	var resources []presenters.JobResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resources))
+	require.Len(t, resources, 1)
+	assert.Equal(t, fmt.Sprintf("%v", jobID2), resources[0].ID)
+
+	response, cleanup = client.Get("/v2/jobs?archived=true")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	resources = nil
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resources))
+	require.Len(t, resources, 1)
+	assert.Equal(t, id, resources[0].ID)
+	assert.NotNil(t, resources[0].ArchivedAt)
+
+	response, cleanup = client.Delete("/v2/jobs/" + id + "?purge=true")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNoContent)
+
+	response, cleanup = client.Get("/v2/jobs/" + id)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
 func runOCRJobSpecAssertions(t *testing.T, ocrJobSpecFromFileDB job.Job, ocrJobSpecFromServer presenters.JobResource) {
 	ocrJobSpecFromFile := ocrJobSpecFromFileDB.OCROracleSpec
 	assert.Equal(t, ocrJobSpecFromFile.ContractAddress, ocrJobSpecFromServer.OffChainReportingSpec.ContractAddress)
@@ -0,0 +1,79 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+)
+
+// KeystoreController manages the keystore as a whole
+type KeystoreController struct {
+	App chainlink.Application
+}
+
+// ChangePassword re-encrypts all key material with a new keystore password.
+// The node must be started with the new password afterwards.
+// Example:
+// "PATCH <application>/keys/password"
+func (ksc *KeystoreController) ChangePassword(c *gin.Context) {
+	var request UpdatePasswordRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	err := ksc.App.GetKeyStore().ChangePassword(request.OldPassword, request.NewPassword)
+	ksc.renderPasswordChange(c, err)
+}
+
+// ChangeRolePassword encrypts the keys of a role (transmitter, ocr, vrf or
+// p2p) with a password of their own. The old password is the keystore
+// password if the role has no password of its own yet.
+// Example:
+// "PATCH <application>/keys/roles/:role/password"
+func (ksc *KeystoreController) ChangeRolePassword(c *gin.Context) {
+	role, err := keystore.ParseRole(c.Param("role"))
+	if err != nil {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	var request UpdatePasswordRequest
+	if err = c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	err = ksc.App.GetKeyStore().ChangeRolePassword(role, request.OldPassword, request.NewPassword)
+	ksc.renderPasswordChange(c, err)
+}
+
+func (ksc *KeystoreController) renderPasswordChange(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, keystore.ErrLocked):
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	case err != nil:
+		// a wrong old password is rejected like a weak new one
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "keystore", http.StatusNoContent)
+}
+
+// RotateColumnKey re-encrypts the secrets stored in the database with a new
+// column key, encrypting any stored as plaintext by older versions.
+// Example:
+// "POST <application>/keys/column_key/rotate"
+func (ksc *KeystoreController) RotateColumnKey(c *gin.Context) {
+	if err := ksc.App.GetKeyStore().RotateColumnKey(); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "keystore", http.StatusNoContent)
+}
@@ -0,0 +1,87 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/web"
+)
+
+func TestKeystoreController_ChangePassword(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	const newPassword = "n3wp4ssw0rdAlsoLongEnough"
+	tests := []struct {
+		name   string
+		req    web.UpdatePasswordRequest
+		status int
+	}{
+		{"wrong old password", web.UpdatePasswordRequest{OldPassword: "wrong password", NewPassword: newPassword}, http.StatusUnprocessableEntity},
+		{"weak new password", web.UpdatePasswordRequest{OldPassword: cltest.Password, NewPassword: "short"}, http.StatusUnprocessableEntity},
+		{"success", web.UpdatePasswordRequest{OldPassword: cltest.Password, NewPassword: newPassword}, http.StatusNoContent},
+		{"old password no longer valid", web.UpdatePasswordRequest{OldPassword: cltest.Password, NewPassword: newPassword}, http.StatusUnprocessableEntity},
+	}
+	for _, tt := range tests {
+		body, err := json.Marshal(tt.req)
+		require.NoError(t, err)
+		resp, cleanup := client.Patch("/v2/keys/password", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, tt.status)
+	}
+}
+
+func TestKeystoreController_ChangeRolePassword(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	const newPassword = "n3wp4ssw0rdAlsoLongEnough"
+	tests := []struct {
+		name   string
+		role   string
+		req    web.UpdatePasswordRequest
+		status int
+	}{
+		{"unknown role", "csa", web.UpdatePasswordRequest{OldPassword: cltest.Password, NewPassword: newPassword}, http.StatusNotFound},
+		{"wrong old password", "ocr", web.UpdatePasswordRequest{OldPassword: "wrong password", NewPassword: newPassword}, http.StatusUnprocessableEntity},
+		{"success", "ocr", web.UpdatePasswordRequest{OldPassword: cltest.Password, NewPassword: newPassword}, http.StatusNoContent},
+		{"keystore password no longer valid", "ocr", web.UpdatePasswordRequest{OldPassword: cltest.Password, NewPassword: newPassword}, http.StatusUnprocessableEntity},
+		{"role password", "ocr", web.UpdatePasswordRequest{OldPassword: newPassword, NewPassword: newPassword + "2"}, http.StatusNoContent},
+	}
+	for _, tt := range tests {
+		body, err := json.Marshal(tt.req)
+		require.NoError(t, err)
+		resp, cleanup := client.Patch("/v2/keys/roles/"+tt.role+"/password", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, tt.status)
+	}
+}
+
+func TestKeystoreController_RotateColumnKey(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	viewOnlyClient := app.NewHTTPClient(cltest.APIEmailViewOnly)
+	resp, cleanup := viewOnlyClient.Post("/v2/keys/column_key/rotate", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnauthorized)
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+	resp, cleanup = client.Post("/v2/keys/column_key/rotate", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+}
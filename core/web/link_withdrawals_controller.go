@@ -0,0 +1,138 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/withdrawals"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// LinkWithdrawalsController manages the automatic withdrawals of the LINK
+// earned by the Oracle and Operator contracts
+type LinkWithdrawalsController struct {
+	App chainlink.Application
+}
+
+// LinkWithdrawalRequest is the body of a request creating a withdrawal
+type LinkWithdrawalRequest struct {
+	EVMChainID      string          `json:"evmChainID"`
+	ContractAddress string          `json:"contractAddress"`
+	FromAddress     string          `json:"fromAddress"`
+	Recipient       string          `json:"recipient"`
+	Threshold       assets.Link     `json:"threshold"`
+	Interval        models.Interval `json:"interval"`
+}
+
+// Index lists the withdrawals
+// Example:
+// "GET <application>/link_withdrawals"
+func (wc *LinkWithdrawalsController) Index(c *gin.Context) {
+	ws, err := wc.App.LinkWithdrawer().Withdrawals()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewLinkWithdrawalResources(ws), "linkWithdrawals")
+}
+
+// Show returns a withdrawal, with the result of its last check
+// Example:
+// "GET <application>/link_withdrawals/:ID"
+func (wc *LinkWithdrawalsController) Show(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("ID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	w, err := wc.App.LinkWithdrawer().FindWithdrawal(id)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("withdrawal not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewLinkWithdrawalResource(w), "linkWithdrawals")
+}
+
+// Create adds a withdrawal of the LINK earned by a contract. Every interval,
+// the withdrawable LINK of the contract is withdrawn to the recipient if it
+// reached the threshold, by a transaction sent from the key owning the
+// contract.
+// Example:
+// "POST <application>/link_withdrawals"
+func (wc *LinkWithdrawalsController) Create(c *gin.Context) {
+	request := &LinkWithdrawalRequest{}
+	if err := c.ShouldBindJSON(request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	for _, address := range []string{request.ContractAddress, request.FromAddress, request.Recipient} {
+		if !common.IsHexAddress(address) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid address: %s", address))
+			return
+		}
+	}
+	chain, err := getChain(wc.App.GetChains().EVM, request.EVMChainID)
+	if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	w := withdrawals.Withdrawal{
+		EVMChainID:      *utils.NewBig(chain.ID()),
+		ContractAddress: common.HexToAddress(request.ContractAddress),
+		FromAddress:     common.HexToAddress(request.FromAddress),
+		Recipient:       common.HexToAddress(request.Recipient),
+		Threshold:       request.Threshold,
+		CheckInterval:   request.Interval,
+	}
+	err = wc.App.LinkWithdrawer().CreateWithdrawal(&w)
+	if errors.Is(err, withdrawals.ErrWithdrawalExists) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, presenters.NewLinkWithdrawalResource(w), "linkWithdrawals", http.StatusCreated)
+}
+
+// Delete stops the withdrawals of a contract. A withdraw transaction already
+// sent is not cancelled.
+// Example:
+// "DELETE <application>/link_withdrawals/:ID"
+func (wc *LinkWithdrawalsController) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("ID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if err = wc.App.LinkWithdrawer().DeleteWithdrawal(id); errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("withdrawal not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "linkWithdrawals", http.StatusNoContent)
+}
@@ -0,0 +1,80 @@
+package web_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestLinkWithdrawalsController_CreateShowDelete(t *testing.T) {
+	t.Parallel()
+
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.EVMRPCEnabled = null.BoolFrom(false)
+	app := cltest.NewApplicationWithConfig(t, cfg)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+	_, fromAddress := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+	contract := testutils.NewAddress()
+	recipient := testutils.NewAddress()
+
+	create := func(contract, from, threshold, interval string) *http.Response {
+		body := fmt.Sprintf(`{"evmChainID": "0", "contractAddress": %q, "fromAddress": %q, "recipient": %q, "threshold": %q, "interval": %q}`,
+			contract, from, recipient.Hex(), threshold, interval)
+		resp, cleanup := client.Post("/v2/link_withdrawals", bytes.NewBufferString(body))
+		t.Cleanup(cleanup)
+		return resp
+	}
+
+	t.Run("invalid withdrawals", func(t *testing.T) {
+		cltest.AssertServerResponse(t, create("0x123", fromAddress.Hex(), "1 link", "1h"), http.StatusUnprocessableEntity)
+		cltest.AssertServerResponse(t, create(contract.Hex(), testutils.NewAddress().Hex(), "1 link", "1h"), http.StatusUnprocessableEntity)
+		cltest.AssertServerResponse(t, create(contract.Hex(), fromAddress.Hex(), "0", "1h"), http.StatusUnprocessableEntity)
+		cltest.AssertServerResponse(t, create(contract.Hex(), fromAddress.Hex(), "1 link", "10s"), http.StatusUnprocessableEntity)
+	})
+
+	resp := create(contract.Hex(), fromAddress.Hex(), "1 link", "1h")
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+	var created presenters.LinkWithdrawalResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &created))
+	assert.Equal(t, contract.Hex(), created.ContractAddress)
+	assert.Equal(t, fromAddress.Hex(), created.FromAddress)
+	assert.Equal(t, recipient.Hex(), created.Recipient)
+	assert.Equal(t, "1000000000000000000", created.Threshold.ToInt().String())
+
+	cltest.AssertServerResponse(t, create(contract.Hex(), fromAddress.Hex(), "2 link", "1h"), http.StatusConflict)
+
+	resp, cleanup := client.Get("/v2/link_withdrawals/" + created.ID)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var shown presenters.LinkWithdrawalResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &shown))
+	assert.Equal(t, created.ContractAddress, shown.ContractAddress)
+
+	resp, cleanup = client.Get("/v2/link_withdrawals")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var ws []presenters.LinkWithdrawalResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &ws))
+	assert.Len(t, ws, 1)
+
+	resp, cleanup = client.Delete("/v2/link_withdrawals/" + created.ID)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+	resp, cleanup = client.Get("/v2/link_withdrawals/" + created.ID)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	resp, cleanup = client.Delete("/v2/link_withdrawals/" + created.ID)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
@@ -3,6 +3,7 @@ package web
 import (
 	"fmt"
 	"net/http"
+	"sort"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
@@ -20,6 +21,9 @@ type LogController struct {
 type LogPatchRequest struct {
 	Level      string `json:"level"`
 	SqlEnabled *bool  `json:"sqlEnabled"`
+	// Packages maps package paths, e.g. core/services/pipeline, to their log
+	// level. An empty level clears the override of the package.
+	Packages map[string]string `json:"packages"`
 }
 
 // Get retrieves the current log config settings
@@ -31,6 +35,12 @@ func (cc *LogController) Get(c *gin.Context) {
 	svcs = append(svcs, "IsSqlEnabled")
 	lvls = append(lvls, strconv.FormatBool(cc.App.GetConfig().LogSQL()))
 
+	svcs, lvls, err := cc.appendPackageLogLevels(c, svcs, lvls)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
 	response := &presenters.ServiceLogConfigResource{
 		JAID: presenters.JAID{
 			ID: "log",
@@ -55,11 +65,25 @@ func (cc *LogController) Patch(c *gin.Context) {
 	var svcs, lvls []string
 
 	// Validate request params
-	if request.Level == "" && request.SqlEnabled == nil {
+	if request.Level == "" && request.SqlEnabled == nil && len(request.Packages) == 0 {
 		jsonAPIError(c, http.StatusBadRequest, fmt.Errorf("please check request params, no params configured"))
 		return
 	}
 
+	pkgLevels := make(map[string]*zapcore.Level, len(request.Packages))
+	for pkg, level := range request.Packages {
+		if level == "" {
+			pkgLevels[pkg] = nil
+			continue
+		}
+		var ll zapcore.Level
+		if err := ll.UnmarshalText([]byte(level)); err != nil {
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		}
+		pkgLevels[pkg] = &ll
+	}
+
 	if request.Level != "" {
 		var ll zapcore.Level
 		err := ll.UnmarshalText([]byte(request.Level))
@@ -82,6 +106,19 @@ func (cc *LogController) Patch(c *gin.Context) {
 	svcs = append(svcs, "IsSqlEnabled")
 	lvls = append(lvls, strconv.FormatBool(cc.App.GetConfig().LogSQL()))
 
+	for pkg, ll := range pkgLevels {
+		if err := cc.App.SetPackageLogLevel(c.Request.Context(), pkg, ll); err != nil {
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	svcs, lvls, err := cc.appendPackageLogLevels(c, svcs, lvls)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
 	response := &presenters.ServiceLogConfigResource{
 		JAID: presenters.JAID{
 			ID: "log",
@@ -92,3 +129,22 @@ func (cc *LogController) Patch(c *gin.Context) {
 
 	jsonAPIResponse(c, response, "log")
 }
+
+// appendPackageLogLevels appends the package log levels to svcs and lvls,
+// ordered by package
+func (cc *LogController) appendPackageLogLevels(c *gin.Context, svcs, lvls []string) ([]string, []string, error) {
+	levels, err := cc.App.PackageLogLevels(c.Request.Context())
+	if err != nil {
+		return nil, nil, err
+	}
+	pkgs := make([]string, 0, len(levels))
+	for pkg := range levels {
+		pkgs = append(pkgs, pkg)
+	}
+	sort.Strings(pkgs)
+	for _, pkg := range pkgs {
+		svcs = append(svcs, pkg)
+		lvls = append(lvls, levels[pkg].String())
+	}
+	return svcs, lvls, nil
+}
@@ -145,3 +145,38 @@ func TestLogController_PatchLogConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestLogController_PatchPackageLogLevels(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	patch := func(t *testing.T, packages map[string]string, expectedCode int) presenters.ServiceLogConfigResource {
+		requestData, err := json.Marshal(web.LogPatchRequest{Packages: packages})
+		require.NoError(t, err)
+		resp, cleanup := client.Patch("/v2/log", bytes.NewBuffer(requestData))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, expectedCode)
+		var svcLogConfig presenters.ServiceLogConfigResource
+		if expectedCode == http.StatusOK {
+			require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &svcLogConfig))
+		}
+		return svcLogConfig
+	}
+
+	svcLogConfig := patch(t, map[string]string{"core/services/pipeline": "debug", "/core/chains/evm/": "error"}, http.StatusOK)
+	assert.Equal(t, []string{"Global", "IsSqlEnabled", "core/chains/evm", "core/services/pipeline"}, svcLogConfig.ServiceName)
+	assert.Equal(t, []string{"error", "debug"}, svcLogConfig.LogLevel[2:])
+
+	levels, err := app.PackageLogLevels(testutils.Context(t))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]zapcore.Level{"core/chains/evm": zapcore.ErrorLevel, "core/services/pipeline": zapcore.DebugLevel}, levels)
+
+	svcLogConfig = patch(t, map[string]string{"core/chains/evm": ""}, http.StatusOK)
+	assert.Equal(t, []string{"Global", "IsSqlEnabled", "core/services/pipeline"}, svcLogConfig.ServiceName)
+
+	patch(t, map[string]string{"core/services/pipeline": "loud"}, http.StatusBadRequest)
+	patch(t, map[string]string{" ": "debug"}, http.StatusBadRequest)
+}
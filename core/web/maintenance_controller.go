@@ -0,0 +1,64 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// MaintenanceController manages the node's maintenance mode
+type MaintenanceController struct {
+	App chainlink.Application
+}
+
+type MaintenancePatchRequest struct {
+	Enabled *bool `json:"enabled"`
+}
+
+// Show returns the maintenance mode status, including whether the node is
+// ready to be shut down.
+// Example:
+// "GET <application>/maintenance"
+func (mc *MaintenanceController) Show(c *gin.Context) {
+	mc.respond(c)
+}
+
+// Update enables or disables maintenance mode. While enabled, no new pipeline
+// runs are started and in-flight runs and transactions are left to drain.
+// Example:
+// "PATCH <application>/maintenance"
+func (mc *MaintenanceController) Update(c *gin.Context) {
+	request := &MaintenancePatchRequest{}
+	if err := c.ShouldBindJSON(request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.Enabled == nil {
+		jsonAPIError(c, http.StatusBadRequest, errors.New("missing 'enabled' parameter"))
+		return
+	}
+
+	mc.App.SetMaintenanceMode(*request.Enabled)
+	mc.respond(c)
+}
+
+func (mc *MaintenanceController) respond(c *gin.Context) {
+	status, err := mc.App.MaintenanceStatus(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, &presenters.MaintenanceResource{
+		JAID:                 presenters.JAID{ID: "maintenance"},
+		Enabled:              status.Enabled,
+		InFlightRuns:         status.InFlightRuns,
+		InFlightTransactions: status.InFlightTransactions,
+		QueuedRuns:           status.QueuedRuns,
+		ReadyForShutdown:     status.ReadyForShutdown(),
+	}, "maintenance")
+}
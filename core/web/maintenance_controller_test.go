@@ -0,0 +1,58 @@
+package web_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestMaintenanceController_ShowAndUpdate(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	getStatus := func() presenters.MaintenanceResource {
+		resp, cleanup := client.Get("/v2/maintenance")
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var status presenters.MaintenanceResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &status))
+		return status
+	}
+
+	status := getStatus()
+	assert.False(t, status.Enabled)
+	assert.False(t, status.ReadyForShutdown)
+
+	resp, cleanup := client.Patch("/v2/maintenance", bytes.NewBufferString(`{"enabled": true}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	status = getStatus()
+	assert.True(t, status.Enabled)
+	assert.Equal(t, int64(0), status.InFlightRuns)
+	assert.Equal(t, uint32(0), status.InFlightTransactions)
+	assert.True(t, status.ReadyForShutdown)
+
+	resp, cleanup = client.Patch("/v2/maintenance", bytes.NewBufferString(`{"enabled": false}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	status = getStatus()
+	assert.False(t, status.Enabled)
+	assert.False(t, status.ReadyForShutdown)
+
+	resp, cleanup = client.Patch("/v2/maintenance", bytes.NewBufferString(`{}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+}
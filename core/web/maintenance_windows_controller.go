@@ -0,0 +1,98 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// MaintenanceWindowsController manages the maintenance windows, during which
+// the new runs submitting transactions are skipped or queued
+type MaintenanceWindowsController struct {
+	App chainlink.Application
+}
+
+// MaintenanceWindowRequest is the body of a request creating a maintenance
+// window. JobID is omitted for the windows applying to every job.
+type MaintenanceWindowRequest struct {
+	JobID    *int32                           `json:"jobID"`
+	StartsAt time.Time                        `json:"startsAt"`
+	EndsAt   time.Time                        `json:"endsAt"`
+	Action   pipeline.MaintenanceWindowAction `json:"action"`
+	Reason   string                           `json:"reason"`
+}
+
+// Index lists the current and upcoming maintenance windows
+// Example:
+// "GET <application>/maintenance_windows"
+func (mwc *MaintenanceWindowsController) Index(c *gin.Context) {
+	windows, err := mwc.App.PipelineORM().FindMaintenanceWindows()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewMaintenanceWindowResources(windows), "maintenanceWindows")
+}
+
+// Create schedules a maintenance window
+// Example:
+// "POST <application>/maintenance_windows"
+func (mwc *MaintenanceWindowsController) Create(c *gin.Context) {
+	request := &MaintenanceWindowRequest{}
+	if err := c.ShouldBindJSON(request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	w := pipeline.MaintenanceWindow{
+		JobID:    request.JobID,
+		StartsAt: request.StartsAt,
+		EndsAt:   request.EndsAt,
+		Action:   request.Action,
+		Reason:   request.Reason,
+	}
+	if err := w.Validate(); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err := mwc.App.CreateMaintenanceWindow(c.Request.Context(), &w); errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("job %d not found", *w.JobID))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, presenters.NewMaintenanceWindowResource(w), "maintenanceWindows", http.StatusCreated)
+}
+
+// Delete cancels a maintenance window, the runs it queued start once no other
+// window applies to them
+// Example:
+// "DELETE <application>/maintenance_windows/:ID"
+func (mwc *MaintenanceWindowsController) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("ID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if err = mwc.App.DeleteMaintenanceWindow(c.Request.Context(), id); errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("maintenance window not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "maintenanceWindows", http.StatusNoContent)
+}
@@ -0,0 +1,72 @@
+package web_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestMaintenanceWindowsController_CreateIndexDelete(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	now := time.Now().UTC()
+	body := func(startsAt, endsAt time.Time, action string) *bytes.Buffer {
+		return bytes.NewBufferString(fmt.Sprintf(`{"startsAt": %q, "endsAt": %q, "action": %q, "reason": "contract upgrade"}`,
+			startsAt.Format(time.RFC3339), endsAt.Format(time.RFC3339), action))
+	}
+
+	t.Run("invalid windows", func(t *testing.T) {
+		resp, cleanup := client.Post("/v2/maintenance_windows", body(now, now.Add(-time.Hour), "queue"))
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+		resp, cleanup = client.Post("/v2/maintenance_windows", body(now, now.Add(time.Hour), "pause"))
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+		resp, cleanup = client.Post("/v2/maintenance_windows", bytes.NewBufferString(fmt.Sprintf(`{"jobID": 1000, "startsAt": %q, "endsAt": %q, "action": "skip"}`,
+			now.Format(time.RFC3339), now.Add(time.Hour).Format(time.RFC3339))))
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+
+	resp, cleanup := client.Post("/v2/maintenance_windows", body(now.Add(-time.Minute), now.Add(time.Hour), "queue"))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+	var created presenters.MaintenanceWindowResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &created))
+	assert.Nil(t, created.JobID)
+	assert.Equal(t, pipeline.MaintenanceWindowActionQueue, created.Action)
+	assert.Equal(t, "contract upgrade", created.Reason)
+	assert.True(t, created.Active)
+
+	resp, cleanup = client.Get("/v2/maintenance_windows")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var windows []presenters.MaintenanceWindowResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &windows))
+	require.Len(t, windows, 1)
+	assert.Equal(t, created.ID, windows[0].ID)
+
+	resp, cleanup = client.Delete("/v2/maintenance_windows/" + created.ID)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+	resp, cleanup = client.Delete("/v2/maintenance_windows/" + created.ID)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
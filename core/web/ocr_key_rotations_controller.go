@@ -0,0 +1,118 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/keyrotation"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// OCRKeyRotationsController manages the rotations of the OCR and OCR2 key
+// bundles used by the jobs
+type OCRKeyRotationsController struct {
+	App chainlink.Application
+}
+
+// OCRKeyRotationRequest is the body of a request staging a rotation
+type OCRKeyRotationRequest struct {
+	KeyType     keyrotation.KeyType `json:"keyType"`
+	KeyBundleID string              `json:"keyBundleID"`
+}
+
+// Index lists the rotations, the latest first
+// Example:
+// "GET <application>/keys/ocr_rotations"
+func (rc *OCRKeyRotationsController) Index(c *gin.Context) {
+	rotations, err := rc.App.KeyRotator().Rotations()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewOCRKeyRotationResources(rotations), "ocrKeyRotations")
+}
+
+// Create generates a new key bundle and stages its rotation, the jobs keep
+// using the old key bundle until the rotation is completed
+// Example:
+// "POST <application>/keys/ocr_rotations"
+func (rc *OCRKeyRotationsController) Create(c *gin.Context) {
+	request := &OCRKeyRotationRequest{}
+	if err := c.ShouldBindJSON(request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err := keyrotation.ValidateKeyType(request.KeyType); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	rotation, err := rc.App.KeyRotator().Stage(request.KeyType, request.KeyBundleID)
+	if errors.Is(err, keyrotation.ErrKeyBundleAlreadyStaged) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, presenters.NewOCRKeyRotationResource(rotation), "ocrKeyRotations", http.StatusCreated)
+}
+
+// Complete switches the jobs using the old key bundle of a staged rotation
+// to its new key bundle. It must be called once the on-chain config lists
+// the new key bundle.
+// Example:
+// "POST <application>/keys/ocr_rotations/:ID/complete"
+func (rc *OCRKeyRotationsController) Complete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("ID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	rotation, err := rc.App.KeyRotator().Complete(c.Request.Context(), id)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("rotation not found"))
+		return
+	} else if errors.Is(err, keyrotation.ErrRotationNotStaged) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		// Includes the jobs failing to restart, once the rotation is completed
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewOCRKeyRotationResource(rotation), "ocrKeyRotations")
+}
+
+// Delete cancels a staged rotation, keeping its new key bundle
+// Example:
+// "DELETE <application>/keys/ocr_rotations/:ID"
+func (rc *OCRKeyRotationsController) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("ID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if err = rc.App.KeyRotator().Cancel(id); errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("rotation not found"))
+		return
+	} else if errors.Is(err, keyrotation.ErrRotationNotStaged) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "ocrKeyRotations", http.StatusNoContent)
+}
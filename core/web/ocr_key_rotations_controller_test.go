@@ -0,0 +1,85 @@
+package web_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/services/keyrotation"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestOCRKeyRotationsController_StageCompleteCancel(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+	key, err := app.GetKeyStore().OCR2().Create("evm")
+	require.NoError(t, err)
+
+	stage := func(keyType, keyBundleID string) *http.Response {
+		resp, cleanup := client.Post("/v2/keys/ocr_rotations", bytes.NewBufferString(fmt.Sprintf(`{"keyType": %q, "keyBundleID": %q}`, keyType, keyBundleID)))
+		t.Cleanup(cleanup)
+		return resp
+	}
+
+	t.Run("invalid key bundles", func(t *testing.T) {
+		cltest.AssertServerResponse(t, stage("ocr3", key.ID()), http.StatusUnprocessableEntity)
+		cltest.AssertServerResponse(t, stage("ocr2", "unknown"), http.StatusUnprocessableEntity)
+	})
+
+	resp := stage("ocr2", key.ID())
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+	var staged presenters.OCRKeyRotationResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &staged))
+	assert.Equal(t, keyrotation.KeyTypeOCR2, staged.KeyType)
+	assert.Equal(t, keyrotation.StateStaged, staged.State)
+	assert.Equal(t, key.ID(), staged.OldKeyBundleID)
+	assert.Nil(t, staged.CompletedAt)
+
+	cltest.AssertServerResponse(t, stage("ocr2", key.ID()), http.StatusConflict)
+
+	resp, cleanup := client.Get("/v2/keys/ocr_rotations")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var rotations []presenters.OCRKeyRotationResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &rotations))
+	require.Len(t, rotations, 1)
+	assert.Equal(t, staged.ID, rotations[0].ID)
+
+	resp, cleanup = client.Post("/v2/keys/ocr_rotations/"+staged.ID+"/complete", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var completed presenters.OCRKeyRotationResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &completed))
+	assert.Equal(t, keyrotation.StateCompleted, completed.State)
+	assert.Empty(t, completed.JobIDs)
+	assert.NotNil(t, completed.CompletedAt)
+
+	resp, cleanup = client.Post("/v2/keys/ocr_rotations/"+staged.ID+"/complete", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusConflict)
+
+	resp, cleanup = client.Delete("/v2/keys/ocr_rotations/" + staged.ID)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusConflict)
+
+	resp = stage("ocr2", key.ID())
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &staged))
+
+	resp, cleanup = client.Delete("/v2/keys/ocr_rotations/" + staged.ID)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+	resp, cleanup = client.Delete("/v2/keys/ocr_rotations/" + staged.ID)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
@@ -0,0 +1,70 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// P2PController reports the connectivity of the OCR jobs to their peers
+type P2PController struct {
+	App chainlink.Application
+}
+
+// P2PPingRequest is the body of a request pinging a peer. The addresses are
+// only required for the peers which are not bootstrappers.
+type P2PPingRequest struct {
+	Addresses []string `json:"addresses"`
+}
+
+var errP2PDisabled = errors.New("P2P is disabled")
+
+// Status reports the peers of each OCR job, their connectivity and traffic
+// Example:
+// "GET <application>/p2p/status"
+func (pc *P2PController) Status(c *gin.Context) {
+	peerWrapper := pc.App.PeerWrapper()
+	if peerWrapper == nil {
+		jsonAPIError(c, http.StatusNotFound, errP2PDisabled)
+		return
+	}
+
+	status, err := peerWrapper.Status()
+	if err != nil {
+		jsonAPIError(c, http.StatusServiceUnavailable, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewP2PStatusResource(status), "p2pStatus")
+}
+
+// Ping dials the addresses of a peer, reporting the time to connect to each
+// Example:
+// "POST <application>/p2p/ping/:peerID"
+func (pc *P2PController) Ping(c *gin.Context) {
+	peerWrapper := pc.App.PeerWrapper()
+	if peerWrapper == nil {
+		jsonAPIError(c, http.StatusNotFound, errP2PDisabled)
+		return
+	}
+
+	request := &P2PPingRequest{}
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(request); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+	}
+
+	ping, err := peerWrapper.Ping(c.Request.Context(), c.Param("peerID"), request.Addresses)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewP2PPingResource(ping), "p2pPings")
+}
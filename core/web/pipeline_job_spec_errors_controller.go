@@ -9,6 +9,8 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
 // PipelineJobSpecErrorsController manages PipelineJobSpecError requests
@@ -38,3 +40,84 @@ func (psec *PipelineJobSpecErrorsController) Destroy(c *gin.Context) {
 
 	jsonAPIResponseWithStatus(c, nil, "job", http.StatusNoContent)
 }
+
+// Index lists the errors of a job, by last seen. Acknowledged errors are only
+// included with ?acknowledged=true.
+// Example:
+// "GET <application>/jobs/:ID/errors"
+func (psec *PipelineJobSpecErrorsController) Index(c *gin.Context) {
+	jb := job.Job{}
+	if err := jb.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	includeAcknowledged := c.Query("acknowledged") == "true"
+
+	errs, err := psec.App.JobORM().FindSpecErrorsByJobID(jb.ID, includeAcknowledged, pg.WithParentCtx(c.Request.Context()))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobErrorResources(errs), "job_spec_errors")
+}
+
+// Acknowledge hides a PipelineJobSpecError from the job errors, while its
+// occurrences are still counted
+// Example:
+// "POST <application>/pipeline/job_spec_errors/:ID/acknowledge"
+func (psec *PipelineJobSpecErrorsController) Acknowledge(c *gin.Context) {
+	specErr := job.SpecError{}
+	if err := specErr.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	err := psec.App.JobORM().AcknowledgeError(c.Request.Context(), specErr.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("PipelineJobSpecError not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "job_spec_errors", http.StatusNoContent)
+}
+
+// AcknowledgeAll acknowledges all the errors of a job
+// Example:
+// "POST <application>/jobs/:ID/errors/acknowledge"
+func (psec *PipelineJobSpecErrorsController) AcknowledgeAll(c *gin.Context) {
+	jb := job.Job{}
+	if err := jb.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if err := psec.App.JobORM().AcknowledgeJobErrors(c.Request.Context(), jb.ID); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "job_spec_errors", http.StatusNoContent)
+}
+
+// DestroyAll deletes all the errors of a job
+// Example:
+// "DELETE <application>/jobs/:ID/errors"
+func (psec *PipelineJobSpecErrorsController) DestroyAll(c *gin.Context) {
+	jb := job.Job{}
+	if err := jb.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if err := psec.App.JobORM().DismissJobErrors(c.Request.Context(), jb.ID); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "job_spec_errors", http.StatusNoContent)
+}
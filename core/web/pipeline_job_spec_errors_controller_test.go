@@ -3,6 +3,7 @@ package web_test
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
 func TestPipelineJobSpecErrorsController_Delete_2(t *testing.T) {
@@ -43,3 +45,74 @@ func TestPipelineJobSpecErrorsController_Delete_NotFound(t *testing.T) {
 
 	assert.Equal(t, http.StatusNotFound, resp.StatusCode, "Response should be not found")
 }
+
+func TestPipelineJobSpecErrorsController_Acknowledge(t *testing.T) {
+	app, client, _, jID, _, _ := setupJobSpecsControllerTestsWithJobs(t)
+
+	require.NoError(t, app.JobORM().RecordError(jID, "job spec error description"))
+
+	j, err := app.JobORM().FindJob(testutils.Context(t), jID)
+	require.NoError(t, err)
+	require.Len(t, j.JobSpecErrors, 2)
+	jse := j.JobSpecErrors[1]
+
+	resp, cleanup := client.Post(fmt.Sprintf("/v2/pipeline/job_spec_errors/%v/acknowledge", jse.ID), nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+	// FindJob -> error is hidden
+	j, err = app.JobORM().FindJob(testutils.Context(t), jID)
+	require.NoError(t, err)
+	require.Len(t, j.JobSpecErrors, 1)
+
+	// Index -> error is only listed with ?acknowledged=true
+	var errs []presenters.JobErrorResource
+	resp, cleanup = client.Get(fmt.Sprintf("/v2/jobs/%v/errors", jID))
+	defer cleanup()
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &errs))
+	require.Len(t, errs, 1)
+
+	resp, cleanup = client.Get(fmt.Sprintf("/v2/jobs/%v/errors?acknowledged=true", jID))
+	defer cleanup()
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &errs))
+	require.Len(t, errs, 2)
+	assert.Equal(t, strconv.FormatInt(jse.ID, 10), errs[0].ID)
+	assert.True(t, errs[0].AcknowledgedAt.Valid)
+
+	// recording the error again does not bring it back
+	require.NoError(t, app.JobORM().RecordError(jID, "job spec error description"))
+	j, err = app.JobORM().FindJob(testutils.Context(t), jID)
+	require.NoError(t, err)
+	require.Len(t, j.JobSpecErrors, 1)
+}
+
+func TestPipelineJobSpecErrorsController_Acknowledge_NotFound(t *testing.T) {
+	_, client, _, _, _, _ := setupJobSpecsControllerTestsWithJobs(t)
+
+	resp, cleanup := client.Post("/v2/pipeline/job_spec_errors/1/acknowledge", nil)
+	defer cleanup()
+
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode, "Response should be not found")
+}
+
+func TestPipelineJobSpecErrorsController_AcknowledgeAll_DestroyAll(t *testing.T) {
+	app, client, _, jID, _, _ := setupJobSpecsControllerTestsWithJobs(t)
+
+	require.NoError(t, app.JobORM().RecordError(jID, "job spec error description"))
+
+	resp, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%v/errors/acknowledge", jID), nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+	j, err := app.JobORM().FindJob(testutils.Context(t), jID)
+	require.NoError(t, err)
+	require.Len(t, j.JobSpecErrors, 0)
+
+	resp, cleanup = client.Delete(fmt.Sprintf("/v2/jobs/%v/errors", jID))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+	errs, err := app.JobORM().FindSpecErrorsByJobID(jID, true)
+	require.NoError(t, err)
+	require.Len(t, errs, 0)
+}
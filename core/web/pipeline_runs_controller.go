@@ -5,6 +5,8 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
@@ -12,6 +14,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/core/web/auth"
@@ -24,9 +27,18 @@ type PipelineRunsController struct {
 }
 
 // Index returns all pipeline runs for a job.
+// The runs are paginated by cursor instead of page when any of the cursor,
+// state, createdAfter, createdBefore or sort params is set, see indexByCursor.
 // Example:
 // "GET <application>/jobs/:ID/runs"
 func (prc *PipelineRunsController) Index(c *gin.Context, size, page, offset int) {
+	for _, param := range []string{KeyCursor, "state", "createdAfter", "createdBefore", "sort"} {
+		if _, ok := c.GetQuery(param); ok {
+			prc.indexByCursor(c, size)
+			return
+		}
+	}
+
 	id := c.Param("ID")
 
 	// Temporary: if no size is passed in, use a large page size. Remove once frontend can handle pagination
@@ -60,6 +72,75 @@ func (prc *PipelineRunsController) Index(c *gin.Context, size, page, offset int)
 	paginatedResponse(c, "pipelineRun", size, page, res, count, err)
 }
 
+// indexByCursor returns a page of runs, filtered by the comma separated states
+// and the RFC3339 createdAfter (inclusive) and createdBefore (exclusive)
+// times, and sorted by creation time, latest first unless sort=createdAt. The
+// next page is linked with an opaque cursor, the runs are not counted.
+// Example:
+// "GET <application>/jobs/:ID/runs?state=errored,completed&createdAfter=2022-09-01T00:00:00Z&cursor="
+func (prc *PipelineRunsController) indexByCursor(c *gin.Context, size int) {
+	filter := job.PipelineRunsFilter{Limit: size}
+
+	if id := c.Param("ID"); id != "" {
+		jobSpec := job.Job{}
+		if err := jobSpec.SetID(id); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		filter.JobID = &jobSpec.ID
+	}
+	if cursor := c.Query(KeyCursor); cursor != "" {
+		after, err := job.ParsePipelineRunsCursor(cursor)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		filter.After = &after
+	}
+	if states := c.Query("state"); states != "" {
+		for _, s := range strings.Split(states, ",") {
+			state := pipeline.RunStatus(strings.TrimSpace(s))
+			switch state {
+			case pipeline.RunStatusRunning, pipeline.RunStatusSuspended, pipeline.RunStatusErrored, pipeline.RunStatusCompleted:
+				filter.States = append(filter.States, state)
+			default:
+				jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid state %q", state))
+				return
+			}
+		}
+	}
+	for param, t := range map[string]**time.Time{"createdAfter": &filter.CreatedAfter, "createdBefore": &filter.CreatedBefore} {
+		if v := c.Query(param); v != "" {
+			parsed, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrapf(err, "invalid %s", param))
+				return
+			}
+			*t = &parsed
+		}
+	}
+	switch sort := c.Query("sort"); sort {
+	case "", "-createdAt":
+	case "createdAt":
+		filter.Ascending = true
+	default:
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid sort %q, expected createdAt or -createdAt", sort))
+		return
+	}
+
+	runs, next, err := prc.App.JobORM().FindPipelineRuns(filter, pg.WithParentCtx(c.Request.Context()))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var nextCursor string
+	if next != nil {
+		nextCursor = next.String()
+	}
+	cursorPaginatedResponse(c, presenters.NewPipelineRunResources(runs, prc.App.GetLogger()), nextCursor)
+}
+
 // Show returns a specified pipeline run.
 // Example:
 // "GET <application>/jobs/:ID/runs/:runID"
@@ -119,6 +200,9 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 			if errors.Is(err3, webhook.ErrJobNotExists) {
 				jsonAPIError(c, http.StatusNotFound, err3)
 				return
+			} else if errors.Is(err3, job.ErrJobPaused) {
+				jsonAPIError(c, http.StatusConflict, err3)
+				return
 			} else if err3 != nil {
 				jsonAPIError(c, http.StatusInternalServerError, err3)
 				return
@@ -138,7 +222,10 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 		if err == nil {
 			jobID = int32(jobID64)
 			jobRunID, err := prc.App.RunJobV2(c.Request.Context(), jobID, nil)
-			if err != nil {
+			if errors.Is(err, job.ErrJobPaused) {
+				jsonAPIError(c, http.StatusConflict, err)
+				return
+			} else if err != nil {
 				jsonAPIError(c, http.StatusInternalServerError, err)
 				return
 			}
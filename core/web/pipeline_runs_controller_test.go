@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/manyminds/api2go/jsonapi"
 	"github.com/pelletier/go-toml"
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
@@ -146,6 +147,57 @@ func TestPipelineRunsController_CreateNoBody_HappyPath(t *testing.T) {
 	}
 }
 
+func TestPipelineRunsController_Create_PausedJob(t *testing.T) {
+	t.Parallel()
+
+	ethClient := cltest.NewEthMocksWithStartupAssertions(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+
+	cfg.Overrides.SetDefaultHTTPTimeout(2 * time.Second)
+	cfg.Overrides.EVMRPCEnabled = null.BoolFrom(false)
+
+	app := cltest.NewApplicationWithConfig(t, cfg, ethClient)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	mockServer := cltest.NewHTTPMockServer(t, 200, "POST", `{}`)
+	_, bridge := cltest.MustCreateBridge(t, app.GetSqlxDB(), cltest.BridgeOpts{URL: mockServer.URL}, app.GetConfig())
+
+	tomlStr := fmt.Sprintf(testspecs.WebhookSpecWithBody, bridge.Name.String())
+	jb, err := webhook.ValidatedWebhookSpec(tomlStr, app.GetExternalInitiatorManager())
+	require.NoError(t, err)
+	require.NoError(t, app.AddJobV2(testutils.Context(t), &jb))
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+	jobID := fmt.Sprintf("%v", jb.ID)
+	body := `{"data":{"result":"123.45"}}`
+
+	response, cleanup := client.Post("/v2/jobs/"+jobID+"/pause", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	var resource presenters.JobResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+	assert.True(t, resource.Paused)
+
+	response, cleanup = client.Post("/v2/jobs/"+jb.ExternalJobID.String()+"/runs", strings.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusConflict)
+
+	response, cleanup = client.Post("/v2/jobs/"+jobID+"/resume", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	resource = presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+	assert.False(t, resource.Paused)
+
+	response, cleanup = client.Post("/v2/jobs/"+jb.ExternalJobID.String()+"/runs", strings.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	response, cleanup = client.Post("/v2/jobs/999999/pause", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
 func TestPipelineRunsController_Index_GlobalHappyPath(t *testing.T) {
 	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
 
@@ -212,6 +264,39 @@ func TestPipelineRunsController_Index_Pagination(t *testing.T) {
 	require.Len(t, parsedResponse[0].TaskRuns, 8)
 }
 
+func TestPipelineRunsController_Index_CursorPagination(t *testing.T) {
+	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
+
+	path := fmt.Sprintf("/v2/jobs/%v/runs?size=1&state=completed&cursor=", jobID)
+	var ids []string
+	for path != "" {
+		response, cleanup := client.Get(path)
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var parsedResponse []presenters.PipelineRunResource
+		var links jsonapi.Links
+		require.NoError(t, web.ParsePaginatedResponse(cltest.ParseResponseBody(t, response), &parsedResponse, &links))
+		require.Len(t, parsedResponse, 1)
+		ids = append(ids, parsedResponse[0].ID)
+		path = links["next"].Href
+	}
+	assert.Equal(t, []string{strconv.Itoa(int(runIDs[1])), strconv.Itoa(int(runIDs[0]))}, ids)
+
+	response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%v/runs?state=errored", jobID))
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	var parsedResponse []presenters.PipelineRunResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &parsedResponse))
+	assert.Empty(t, parsedResponse)
+
+	for _, query := range []string{"cursor=foo", "state=foo", "createdAfter=yesterday", "sort=id"} {
+		response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%v/runs?%s", jobID, query))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+	}
+}
+
 func TestPipelineRunsController_Show_HappyPath(t *testing.T) {
 	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
 
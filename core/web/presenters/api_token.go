@@ -0,0 +1,49 @@
+package presenters
+
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/sessions"
+)
+
+// APITokenResource represents a named API token JSONAPI resource. The secret
+// is only set when the token is created.
+type APITokenResource struct {
+	JAID
+	Name       string                 `json:"name"`
+	Scope      sessions.APITokenScope `json:"scope"`
+	AccessKey  string                 `json:"accessKey"`
+	Secret     string                 `json:"secret,omitempty"`
+	ExpiresAt  null.Time              `json:"expiresAt"`
+	LastUsedAt null.Time              `json:"lastUsedAt"`
+	CreatedAt  time.Time              `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r APITokenResource) GetName() string {
+	return "apiTokens"
+}
+
+// NewAPITokenResource constructs a new APITokenResource
+func NewAPITokenResource(t sessions.APIToken) *APITokenResource {
+	return &APITokenResource{
+		JAID:       NewJAID(t.Name),
+		Name:       t.Name,
+		Scope:      t.Scope,
+		AccessKey:  t.TokenKey,
+		ExpiresAt:  t.ExpiresAt,
+		LastUsedAt: t.LastUsedAt,
+		CreatedAt:  t.CreatedAt,
+	}
+}
+
+// NewAPITokenResources constructs a slice of APITokenResources
+func NewAPITokenResources(ts []sessions.APIToken) []APITokenResource {
+	rs := []APITokenResource{}
+	for _, t := range ts {
+		rs = append(rs, *NewAPITokenResource(t))
+	}
+	return rs
+}
@@ -0,0 +1,52 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/audit"
+)
+
+// AuditEventResource represents an audit event JSONAPI resource.
+type AuditEventResource struct {
+	JAID
+	Actor       string    `json:"actor"`
+	AuthMethod  string    `json:"authMethod"`
+	Method      string    `json:"method"`
+	Path        string    `json:"path"`
+	Operation   string    `json:"operation"`
+	RemoteIP    string    `json:"remoteIP"`
+	PayloadHash string    `json:"payloadHash"`
+	Status      int       `json:"status"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r AuditEventResource) GetName() string {
+	return "auditEvents"
+}
+
+// NewAuditEventResource constructs a new AuditEventResource
+func NewAuditEventResource(ev audit.Event) *AuditEventResource {
+	return &AuditEventResource{
+		JAID:        NewJAID(strconv.FormatInt(ev.ID, 10)),
+		Actor:       ev.Actor,
+		AuthMethod:  ev.AuthMethod,
+		Method:      ev.Method,
+		Path:        ev.Path,
+		Operation:   ev.Operation,
+		RemoteIP:    ev.RemoteIP,
+		PayloadHash: ev.PayloadHash,
+		Status:      ev.Status,
+		CreatedAt:   ev.CreatedAt,
+	}
+}
+
+// NewAuditEventResources constructs a slice of AuditEventResources
+func NewAuditEventResources(evs []audit.Event) []AuditEventResource {
+	rs := []AuditEventResource{}
+	for _, ev := range evs {
+		rs = append(rs, *NewAuditEventResource(ev))
+	}
+	return rs
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
 // BridgeResource represents a Bridge JSONAPI resource.
@@ -38,3 +39,41 @@ func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
 		CreatedAt:              b.CreatedAt,
 	}
 }
+
+// BridgeHealthResource represents the health of a Bridge JSONAPI resource.
+type BridgeHealthResource struct {
+	JAID
+	Name          string          `json:"name"`
+	Checks        int64           `json:"checks"`
+	SuccessRate   float64         `json:"successRate"`
+	LatencyP95    models.Duration `json:"latencyP95"`
+	LastCheckedAt time.Time       `json:"lastCheckedAt"`
+	LastError     *string         `json:"lastError"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r BridgeHealthResource) GetName() string {
+	return "bridgeHealth"
+}
+
+// NewBridgeHealthResource constructs a new BridgeHealthResource
+func NewBridgeHealthResource(h bridges.BridgeHealth) *BridgeHealthResource {
+	return &BridgeHealthResource{
+		JAID:          NewJAID(h.Name.String()),
+		Name:          h.Name.String(),
+		Checks:        h.Checks,
+		SuccessRate:   h.SuccessRate,
+		LatencyP95:    models.MustMakeDuration(h.LatencyP95),
+		LastCheckedAt: h.LastCheckedAt,
+		LastError:     h.LastError.Ptr(),
+	}
+}
+
+// NewBridgeHealthResources constructs a list of BridgeHealthResource
+func NewBridgeHealthResources(health []bridges.BridgeHealth) []BridgeHealthResource {
+	rs := []BridgeHealthResource{}
+	for _, h := range health {
+		rs = append(rs, *NewBridgeHealthResource(h))
+	}
+	return rs
+}
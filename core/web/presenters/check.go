@@ -1,6 +1,8 @@
 package presenters
 
 import (
+	"time"
+
 	"github.com/smartcontractkit/chainlink/core/services"
 )
 
@@ -9,6 +11,31 @@ type Check struct {
 	Name   string          `json:"name"`
 	Status services.Status `json:"status"`
 	Output string          `json:"output"`
+	// Uptime is how long the service has been passing
+	Uptime      string     `json:"uptime,omitempty"`
+	LastError   string     `json:"lastError,omitempty"`
+	LastErrorAt *time.Time `json:"lastErrorAt,omitempty"`
+}
+
+// NewCheck returns a Check for the detailed status of a service
+func NewCheck(name string, s services.ServiceStatus, now time.Time) Check {
+	check := Check{
+		JAID:   NewJAID(name),
+		Name:   name,
+		Status: services.StatusPassing,
+	}
+	if s.Healthy != nil {
+		check.Status = services.StatusFailing
+		check.Output = s.Healthy.Error()
+	} else if !s.Since.IsZero() {
+		check.Uptime = now.Sub(s.Since).Round(time.Second).String()
+	}
+	if s.LastError != nil {
+		check.LastError = s.LastError.Error()
+		lastErrorAt := s.LastErrorAt
+		check.LastErrorAt = &lastErrorAt
+	}
+	return check
 }
 
 func (c Check) GetName() string {
@@ -3,6 +3,8 @@ package presenters
 import (
 	"time"
 
+	"gopkg.in/guregu/null.v4"
+
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -21,6 +23,20 @@ type ETHKeyResource struct {
 	CreatedAt      time.Time    `json:"createdAt"`
 	UpdatedAt      time.Time    `json:"updatedAt"`
 	MaxGasPriceWei utils.Big    `json:"maxGasPriceWei"`
+
+	MaxDailySpendWei        *utils.Big `json:"maxDailySpendWei"`
+	MaxInFlightTransactions null.Int   `json:"maxInFlightTransactions"`
+	EnabledJobTypes         []string   `json:"enabledJobTypes"`
+
+	LowBalanceWei      *utils.Big `json:"lowBalanceWei,omitempty"`
+	CriticalBalanceWei *utils.Big `json:"criticalBalanceWei,omitempty"`
+	FundingJobID       string     `json:"fundingJobID,omitempty"`
+	// FundingStatus is set when funding thresholds are configured and the
+	// ETH balance is known
+	FundingStatus ethkey.FundingStatus `json:"fundingStatus,omitempty"`
+
+	// RemoteSigner is the type of the remote signer holding the key, if any
+	RemoteSigner string `json:"remoteSigner,omitempty"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -49,6 +65,16 @@ func NewETHKeyResource(k ethkey.KeyV2, state ethkey.State, opts ...NewETHKeyOpti
 		Disabled:    state.Disabled,
 		CreatedAt:   state.CreatedAt,
 		UpdatedAt:   state.UpdatedAt,
+
+		MaxDailySpendWei:        state.MaxDailySpend,
+		MaxInFlightTransactions: state.MaxInFlightTransactions,
+		EnabledJobTypes:         state.EnabledJobTypes,
+
+		LowBalanceWei:      state.LowBalance,
+		CriticalBalanceWei: state.CriticalBalance,
+	}
+	if state.FundingJobID.Valid {
+		r.FundingJobID = state.FundingJobID.UUID.String()
 	}
 
 	for _, opt := range opts {
@@ -59,6 +85,10 @@ func NewETHKeyResource(k ethkey.KeyV2, state ethkey.State, opts ...NewETHKeyOpti
 		}
 	}
 
+	if r.EthBalance != nil && state.FundingThresholds.IsSet() {
+		r.FundingStatus = state.FundingThresholds.Status(r.EthBalance.ToInt())
+	}
+
 	return r, nil
 }
 
@@ -85,3 +115,11 @@ func SetETHKeyMaxGasPriceWei(maxGasPriceWei utils.Big) NewETHKeyOption {
 		return nil
 	}
 }
+
+func SetETHKeyRemoteSigner(signerType string) NewETHKeyOption {
+	return func(r *ETHKeyResource) error {
+		r.RemoteSigner = signerType
+
+		return nil
+	}
+}
@@ -0,0 +1,87 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/monitor"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// ETHKeyActivityResource is the recent activity of an ETH key, to plan its
+// funding and rotation
+type ETHKeyActivityResource struct {
+	JAID
+	Transactions   []EthTxResource       `json:"transactions"`
+	BalanceHistory []ETHKeyBalanceSample `json:"balanceHistory"`
+	GasSpend       []ETHKeyGasSpend      `json:"gasSpend"`
+	Jobs           []ETHKeyJob           `json:"jobs"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ETHKeyActivityResource) GetName() string {
+	return "ethKeyActivities"
+}
+
+// ETHKeyBalanceSample is the ETH balance of the key on a chain at a time
+type ETHKeyBalanceSample struct {
+	EVMChainID utils.Big  `json:"evmChainID"`
+	Balance    assets.Eth `json:"balance"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+// ETHKeyGasSpend is the gas spent by the transactions of the key on a chain
+// in a day
+type ETHKeyGasSpend struct {
+	Date         string     `json:"date"`
+	EVMChainID   utils.Big  `json:"evmChainID"`
+	Transactions int        `json:"transactions"`
+	GasUsed      uint64     `json:"gasUsed"`
+	Fee          assets.Eth `json:"fee"`
+}
+
+// ETHKeyJob is a job using the key
+type ETHKeyJob struct {
+	ID   int32    `json:"id"`
+	Name string   `json:"name"`
+	Type job.Type `json:"type"`
+}
+
+// NewETHKeyActivityResource returns the activity of the key at address
+func NewETHKeyActivityResource(address common.Address, txs []txmgr.EthTx, samples []monitor.BalanceSample, spend []txmgr.DailyGasSpend, jobs []job.Job) ETHKeyActivityResource {
+	r := ETHKeyActivityResource{
+		JAID:           NewJAID(address.Hex()),
+		Transactions:   make([]EthTxResource, 0, len(txs)),
+		BalanceHistory: make([]ETHKeyBalanceSample, 0, len(samples)),
+		GasSpend:       make([]ETHKeyGasSpend, 0, len(spend)),
+		Jobs:           make([]ETHKeyJob, 0, len(jobs)),
+	}
+	for _, tx := range txs {
+		if len(tx.EthTxAttempts) > 0 {
+			tx.EthTxAttempts[0].EthTx = tx
+			r.Transactions = append(r.Transactions, NewEthTxResourceFromAttempt(tx.EthTxAttempts[0]))
+		} else {
+			r.Transactions = append(r.Transactions, NewEthTxResource(tx))
+		}
+	}
+	for _, s := range samples {
+		r.BalanceHistory = append(r.BalanceHistory, ETHKeyBalanceSample(s))
+	}
+	for _, s := range spend {
+		r.GasSpend = append(r.GasSpend, ETHKeyGasSpend{
+			Date:         s.Date.Format("2006-01-02"),
+			EVMChainID:   s.EVMChainID,
+			Transactions: s.Transactions,
+			GasUsed:      s.GasUsed,
+			Fee:          s.Fee,
+		})
+	}
+	for _, jb := range jobs {
+		r.Jobs = append(r.Jobs, ETHKeyJob{ID: jb.ID, Name: jb.Name.ValueOrZero(), Type: jb.Type})
+	}
+	return r
+}
@@ -0,0 +1,68 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/keyrotation"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// ETHKeyRotationResource represents a rotation of an ETH key JSONAPI resource
+type ETHKeyRotationResource struct {
+	JAID
+	EVMChainID utils.Big            `json:"evmChainID"`
+	OldAddress string               `json:"oldAddress"`
+	NewAddress string               `json:"newAddress"`
+	State      keyrotation.ETHState `json:"state"`
+	// JobIDs are the jobs switched to the new key
+	JobIDs []int32 `json:"jobIDs"`
+	// PipelineJobIDs are the jobs referring to the old key in their pipeline,
+	// which must be updated before the old key is disabled
+	PipelineJobIDs []int32 `json:"pipelineJobIDs"`
+	// Forced rotations do not wait for the PipelineJobIDs
+	Forced bool `json:"forced"`
+	// SweepTxID is the transfer of the remaining ETH to the new key
+	SweepTxID *int64 `json:"sweepTxID"`
+	// Error is the last error of the rotation, retried unless it is errored
+	Error       *string    `json:"error"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+	CompletedAt *time.Time `json:"completedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ETHKeyRotationResource) GetName() string {
+	return "ethKeyRotations"
+}
+
+// NewETHKeyRotationResource constructs a new ETHKeyRotationResource
+func NewETHKeyRotationResource(r keyrotation.ETHKeyRotation) *ETHKeyRotationResource {
+	jobIDs := []int32{}
+	jobIDs = append(jobIDs, r.JobIDs...)
+	pipelineJobIDs := []int32{}
+	pipelineJobIDs = append(pipelineJobIDs, r.PipelineJobIDs...)
+	return &ETHKeyRotationResource{
+		JAID:           NewJAIDInt64(r.ID),
+		EVMChainID:     r.EVMChainID,
+		OldAddress:     r.OldAddress.Hex(),
+		NewAddress:     r.NewAddress.Hex(),
+		State:          r.State,
+		JobIDs:         jobIDs,
+		PipelineJobIDs: pipelineJobIDs,
+		Forced:         r.Forced,
+		SweepTxID:      r.SweepEthTxID.Ptr(),
+		Error:          r.Error.Ptr(),
+		CreatedAt:      r.CreatedAt,
+		UpdatedAt:      r.UpdatedAt,
+		CompletedAt:    r.CompletedAt.Ptr(),
+	}
+}
+
+// NewETHKeyRotationResources constructs a slice of ETHKeyRotationResources
+func NewETHKeyRotationResources(rs []keyrotation.ETHKeyRotation) []ETHKeyRotationResource {
+	resources := []ETHKeyRotationResource{}
+	for _, r := range rs {
+		resources = append(resources, *NewETHKeyRotationResource(r))
+	}
+	return resources
+}
@@ -11,8 +11,10 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/manyminds/api2go/jsonapi"
+	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 )
 
 func TestETHKeyResource(t *testing.T) {
@@ -66,7 +68,10 @@ func TestETHKeyResource(t *testing.T) {
 			  "disabled":true,
 			  "createdAt":"2000-01-01T00:00:00Z",
 			  "updatedAt":"2000-01-01T00:00:00Z",
-			  "maxGasPriceWei":"12345"
+			  "maxGasPriceWei":"12345",
+			  "maxDailySpendWei":null,
+			  "maxInFlightTransactions":null,
+			  "enabledJobTypes":null
 		   }
 		}
 	 }
@@ -74,6 +79,11 @@ func TestETHKeyResource(t *testing.T) {
 
 	assert.JSONEq(t, expected, string(b))
 
+	state.SpendLimits = ethkey.SpendLimits{
+		MaxDailySpend:           utils.NewBigI(1000),
+		MaxInFlightTransactions: null.IntFrom(3),
+		EnabledJobTypes:         []string{"vrf"},
+	}
 	r, err = NewETHKeyResource(key, state,
 		SetETHKeyEthBalance(assets.NewEth(1)),
 		SetETHKeyLinkBalance(assets.NewLinkFromJuels(1)),
@@ -97,7 +107,10 @@ func TestETHKeyResource(t *testing.T) {
 				"disabled":true,
 				"createdAt":"2000-01-01T00:00:00Z",
 				"updatedAt":"2000-01-01T00:00:00Z",
-				"maxGasPriceWei":"12345"
+				"maxGasPriceWei":"12345",
+				"maxDailySpendWei":"1000",
+				"maxInFlightTransactions":3,
+				"enabledJobTypes":["vrf"]
 			}
 		}
 	}`,
@@ -105,4 +118,20 @@ func TestETHKeyResource(t *testing.T) {
 	)
 
 	assert.JSONEq(t, expected, string(b))
+
+	state.FundingThresholds = ethkey.FundingThresholds{
+		LowBalance:      utils.NewBigI(10),
+		CriticalBalance: utils.NewBigI(1),
+		FundingJobID:    uuid.NullUUID{UUID: uuid.FromStringOrNil("0c4e8d3a-5b2c-4b2e-9f8a-2a1c3e4d5f60"), Valid: true},
+	}
+	r, err = NewETHKeyResource(key, state, SetETHKeyEthBalance(assets.NewEth(5)))
+	require.NoError(t, err)
+	assert.Equal(t, utils.NewBigI(10), r.LowBalanceWei)
+	assert.Equal(t, utils.NewBigI(1), r.CriticalBalanceWei)
+	assert.Equal(t, "0c4e8d3a-5b2c-4b2e-9f8a-2a1c3e4d5f60", r.FundingJobID)
+	assert.Equal(t, ethkey.FundingStatusLow, r.FundingStatus)
+
+	r, err = NewETHKeyResource(key, state)
+	require.NoError(t, err)
+	assert.Empty(t, r.FundingStatus)
 }
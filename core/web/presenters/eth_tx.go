@@ -12,18 +12,22 @@ import (
 // EthTxResource represents a Ethereum Transaction JSONAPI resource.
 type EthTxResource struct {
 	JAID
-	State      string          `json:"state"`
-	Data       hexutil.Bytes   `json:"data"`
-	From       *common.Address `json:"from"`
-	GasLimit   string          `json:"gasLimit"`
-	GasPrice   string          `json:"gasPrice"`
-	Hash       common.Hash     `json:"hash"`
-	Hex        string          `json:"rawHex"`
-	Nonce      string          `json:"nonce"`
-	SentAt     string          `json:"sentAt"`
-	To         *common.Address `json:"to"`
-	Value      string          `json:"value"`
-	EVMChainID utils.Big       `json:"evmChainID"`
+	State        string          `json:"state"`
+	Data         hexutil.Bytes   `json:"data"`
+	From         *common.Address `json:"from"`
+	GasLimit     string          `json:"gasLimit"`
+	GasPrice     string          `json:"gasPrice"`
+	Hash         common.Hash     `json:"hash"`
+	Hex          string          `json:"rawHex"`
+	Nonce        string          `json:"nonce"`
+	SentAt       string          `json:"sentAt"`
+	To           *common.Address `json:"to"`
+	Value        string          `json:"value"`
+	EVMChainID   utils.Big       `json:"evmChainID"`
+	JobID        *int32          `json:"jobID,omitempty"`
+	RunID        *int64          `json:"runID,omitempty"`
+	TaskName     *string         `json:"taskName,omitempty"`
+	RevertReason *string         `json:"revertReason,omitempty"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -37,7 +41,7 @@ func (EthTxResource) GetName() string {
 // EthTx as the id being used was the EthTxAttempt Hash.
 // This should really use it's proper id
 func NewEthTxResource(tx txmgr.EthTx) EthTxResource {
-	return EthTxResource{
+	r := EthTxResource{
 		Data:       hexutil.Bytes(tx.EncodedPayload),
 		From:       &tx.FromAddress,
 		GasLimit:   strconv.FormatUint(uint64(tx.GasLimit), 10),
@@ -46,6 +50,13 @@ func NewEthTxResource(tx txmgr.EthTx) EthTxResource {
 		Value:      tx.Value.String(),
 		EVMChainID: tx.EVMChainID,
 	}
+	// Malformed meta is not an error here, it is just not shown
+	if meta, err := tx.GetMeta(); err == nil && meta != nil {
+		r.JobID = meta.JobID
+		r.RunID = meta.RunID
+		r.TaskName = meta.TaskName
+	}
+	return r
 }
 
 func NewEthTxResourceFromAttempt(txa txmgr.EthTxAttempt) EthTxResource {
@@ -64,5 +75,42 @@ func NewEthTxResourceFromAttempt(txa txmgr.EthTxAttempt) EthTxResource {
 	if txa.BroadcastBeforeBlockNum != nil {
 		r.SentAt = strconv.FormatUint(uint64(*txa.BroadcastBeforeBlockNum), 10)
 	}
+	if txa.RevertReason.Valid {
+		r.RevertReason = &txa.RevertReason.String
+	}
 	return r
 }
+
+// NonceReconciliationResource represents the outcome of reconciling the local
+// nonce of a key with the chain.
+type NonceReconciliationResource struct {
+	JAID
+	Address      common.Address `json:"address"`
+	EVMChainID   utils.Big      `json:"evmChainID"`
+	LocalNonce   int64          `json:"localNonce"`
+	LatestNonce  uint64         `json:"latestNonce"`
+	PendingNonce uint64         `json:"pendingNonce"`
+	NextNonce    int64          `json:"nextNonce"`
+	Backfilled   []int64        `json:"backfilled"`
+	Rebroadcast  []int64        `json:"rebroadcast"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (NonceReconciliationResource) GetName() string {
+	return "nonce_reconciliations"
+}
+
+// NewNonceReconciliationResource generates a NonceReconciliationResource
+func NewNonceReconciliationResource(rec txmgr.NonceReconciliation, chainID utils.Big) NonceReconciliationResource {
+	return NonceReconciliationResource{
+		JAID:         NewJAID(rec.Address.Hex()),
+		Address:      rec.Address,
+		EVMChainID:   chainID,
+		LocalNonce:   rec.LocalNonce,
+		LatestNonce:  rec.LatestNonce,
+		PendingNonce: rec.PendingNonce,
+		NextNonce:    rec.NextNonce,
+		Backfilled:   rec.Backfilled,
+		Rebroadcast:  rec.Rebroadcast,
+	}
+}
@@ -0,0 +1,110 @@
+package presenters
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// EthTxAttemptResource is an attempt of a transaction, with its receipts
+type EthTxAttemptResource struct {
+	JAID
+	State     string     `json:"state"`
+	GasPrice  *utils.Big `json:"gasPrice"`
+	GasTipCap *utils.Big `json:"gasTipCap"`
+	GasFeeCap *utils.Big `json:"gasFeeCap"`
+	GasLimit  uint32     `json:"gasLimit"`
+	// Bump is the number of gas bumps before this attempt
+	Bump                    int                    `json:"bump"`
+	CreatedAt               time.Time              `json:"createdAt"`
+	BroadcastBeforeBlockNum *int64                 `json:"broadcastBeforeBlockNum"`
+	Receipts                []EthTxReceiptResource `json:"receipts"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (EthTxAttemptResource) GetName() string {
+	return "evm_tx_attempts"
+}
+
+// EthTxReceiptResource is a receipt of an attempt
+type EthTxReceiptResource struct {
+	BlockHash        common.Hash `json:"blockHash"`
+	BlockNumber      int64       `json:"blockNumber"`
+	TransactionIndex uint        `json:"transactionIndex"`
+	GasUsed          uint64      `json:"gasUsed"`
+	Status           uint64      `json:"status"`
+	CreatedAt        time.Time   `json:"createdAt"`
+}
+
+// NewEthTxAttemptResources generates the resources of attempts sorted from the
+// first to the last one
+func NewEthTxAttemptResources(attempts []txmgr.EthTxAttempt) []EthTxAttemptResource {
+	rs := make([]EthTxAttemptResource, len(attempts))
+	for i, a := range attempts {
+		rs[i] = EthTxAttemptResource{
+			JAID:                    NewJAID(a.Hash.Hex()),
+			State:                   string(a.State),
+			GasPrice:                a.GasPrice,
+			GasTipCap:               a.GasTipCap,
+			GasFeeCap:               a.GasFeeCap,
+			GasLimit:                a.ChainSpecificGasLimit,
+			Bump:                    i,
+			CreatedAt:               a.CreatedAt,
+			BroadcastBeforeBlockNum: a.BroadcastBeforeBlockNum,
+			Receipts:                make([]EthTxReceiptResource, len(a.EthReceipts)),
+		}
+		for j, r := range a.EthReceipts {
+			rs[i].Receipts[j] = EthTxReceiptResource{
+				BlockHash:        r.BlockHash,
+				BlockNumber:      r.BlockNumber,
+				TransactionIndex: r.TransactionIndex,
+				GasUsed:          r.Receipt.GasUsed,
+				Status:           r.Receipt.Status,
+				CreatedAt:        r.CreatedAt,
+			}
+		}
+	}
+	return rs
+}
+
+// JobGasAnalyticsResource is the gas spent by the confirmed transactions of a
+// job on a chain
+type JobGasAnalyticsResource struct {
+	JAID
+	JobID        int32      `json:"jobID"`
+	EVMChainID   utils.Big  `json:"evmChainID"`
+	Transactions int        `json:"transactions"`
+	Attempts     int        `json:"attempts"`
+	GasUsed      uint64     `json:"gasUsed"`
+	Fee          assets.Eth `json:"fee"`
+	AverageBumps float64    `json:"averageBumps"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (JobGasAnalyticsResource) GetName() string {
+	return "job_gas_analytics"
+}
+
+// NewJobGasAnalyticsResources generates a JobGasAnalyticsResource per job
+// and chain
+func NewJobGasAnalyticsResources(jobs []txmgr.JobGasAnalytics) []JobGasAnalyticsResource {
+	rs := make([]JobGasAnalyticsResource, len(jobs))
+	for i, a := range jobs {
+		rs[i] = JobGasAnalyticsResource{
+			JAID:         NewJAID(fmt.Sprintf("%d-%s", a.JobID, a.EVMChainID.String())),
+			JobID:        a.JobID,
+			EVMChainID:   a.EVMChainID,
+			Transactions: a.Transactions,
+			Attempts:     a.Attempts,
+			GasUsed:      a.GasUsed,
+			Fee:          a.Fee,
+			AverageBumps: a.AverageBumps(),
+		}
+	}
+	return rs
+}
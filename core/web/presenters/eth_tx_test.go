@@ -8,6 +8,7 @@ import (
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
@@ -73,6 +74,7 @@ func TestEthTxResource(t *testing.T) {
 		GasPrice:                gasPrice,
 		SignedRawTx:             hexutil.MustDecode("0xcafe"),
 		BroadcastBeforeBlockNum: &broadcastBefore,
+		RevertReason:            null.StringFrom("not enough LINK"),
 	}
 
 	r = NewEthTxResourceFromAttempt(txa)
@@ -97,7 +99,8 @@ func TestEthTxResource(t *testing.T) {
 			"sentAt": "300",
 			"to": "0x0000000000000000000000000000000000000002",
 			"value": "0.000000000000000001",
-			"evmChainID": "0"
+			"evmChainID": "0",
+			"revertReason": "not enough LINK"
 		  }
 		}
 	  }
@@ -0,0 +1,40 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/externaldb"
+)
+
+// ExternalDatabaseResource represents an external database JSONAPI resource.
+// The password of its URL is never returned.
+type ExternalDatabaseResource struct {
+	JAID
+	URL       string    `json:"url"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ExternalDatabaseResource) GetName() string {
+	return "externalDatabases"
+}
+
+// NewExternalDatabaseResource constructs a new ExternalDatabaseResource
+func NewExternalDatabaseResource(db externaldb.Database) *ExternalDatabaseResource {
+	return &ExternalDatabaseResource{
+		JAID:      NewJAID(db.Name),
+		URL:       db.RedactedURL(),
+		CreatedAt: db.CreatedAt,
+		UpdatedAt: db.UpdatedAt,
+	}
+}
+
+// NewExternalDatabaseResources constructs a slice of ExternalDatabaseResources
+func NewExternalDatabaseResources(dbs []externaldb.Database) []ExternalDatabaseResource {
+	rs := []ExternalDatabaseResource{}
+	for _, db := range dbs {
+		rs = append(rs, *NewExternalDatabaseResource(db))
+	}
+	return rs
+}
@@ -48,6 +48,8 @@ type DirectRequestSpec struct {
 	CreatedAt                   time.Time                `json:"createdAt"`
 	UpdatedAt                   time.Time                `json:"updatedAt"`
 	EVMChainID                  *utils.Big               `json:"evmChainID"`
+	DedupKey                    null.String              `json:"dedupKey"`
+	DedupTTL                    models.Duration          `json:"dedupTTL"`
 }
 
 // NewDirectRequestSpec initializes a new DirectRequestSpec from a
@@ -65,6 +67,8 @@ func NewDirectRequestSpec(spec *job.DirectRequestSpec) *DirectRequestSpec {
 		CreatedAt:  spec.CreatedAt,
 		UpdatedAt:  spec.UpdatedAt,
 		EVMChainID: spec.EVMChainID,
+		DedupKey:   spec.DedupKey,
+		DedupTTL:   models.MustMakeDuration(spec.DedupTTL),
 	}
 }
 
@@ -249,16 +253,29 @@ func NewKeeperSpec(spec *job.KeeperSpec) *KeeperSpec {
 
 // WebhookSpec defines the spec details of a Webhook Job
 type WebhookSpec struct {
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	PublicTrigger          bool  `json:"publicTrigger"`
+	PublicTriggerRateLimit int64 `json:"publicTriggerRateLimit,omitempty"`
+	PublicTriggerSigned    bool  `json:"publicTriggerSigned,omitempty"`
+	// PublicTriggerPath is the path of the public trigger URL, only returned
+	// when the job is created
+	PublicTriggerPath string    `json:"publicTriggerPath,omitempty"`
+	CreatedAt         time.Time `json:"createdAt"`
+	UpdatedAt         time.Time `json:"updatedAt"`
 }
 
 // NewWebhookSpec generates a new WebhookSpec from a job.WebhookSpec
 func NewWebhookSpec(spec *job.WebhookSpec) *WebhookSpec {
-	return &WebhookSpec{
-		CreatedAt: spec.CreatedAt,
-		UpdatedAt: spec.UpdatedAt,
+	s := &WebhookSpec{
+		PublicTrigger:          spec.PublicTriggerTokenHash.Valid,
+		PublicTriggerRateLimit: spec.PublicTriggerRateLimit.Int64,
+		PublicTriggerSigned:    spec.PublicTriggerSecret.Valid,
+		CreatedAt:              spec.CreatedAt,
+		UpdatedAt:              spec.UpdatedAt,
 	}
+	if spec.PublicTriggerToken != "" {
+		s.PublicTriggerPath = "/v2/public/webhooks/" + spec.PublicTriggerToken
+	}
+	return s
 }
 
 // CronSpec defines the spec details of a Cron Job
@@ -294,6 +311,8 @@ type VRFSpec struct {
 	BackoffInitialDelay           models.Duration       `json:"backoffInitialDelay"`
 	BackoffMaxDelay               models.Duration       `json:"backoffMaxDelay"`
 	MaxGasPriceGWei               *uint32               `json:"maxGasPriceGWei"`
+	MinContractPayment            *assets.Link          `json:"minContractPaymentLinkJuels"`
+	LinkEthFeedAddress            *ethkey.EIP55Address  `json:"linkEthFeedAddress"`
 }
 
 func NewVRFSpec(spec *job.VRFSpec) *VRFSpec {
@@ -313,6 +332,8 @@ func NewVRFSpec(spec *job.VRFSpec) *VRFSpec {
 		BackoffInitialDelay:      models.MustMakeDuration(spec.BackoffInitialDelay),
 		BackoffMaxDelay:          models.MustMakeDuration(spec.BackoffMaxDelay),
 		MaxGasPriceGWei:          spec.MaxGasPriceGWei,
+		MinContractPayment:       spec.MinContractPayment,
+		LinkEthFeedAddress:       spec.LinkEthFeedAddress,
 	}
 }
 
@@ -375,23 +396,62 @@ func NewBootstrapSpec(spec *job.BootstrapSpec) *BootstrapSpec {
 
 // JobError represents errors on the job
 type JobError struct {
-	ID          int64     `json:"id"`
-	Description string    `json:"description"`
-	Occurrences uint      `json:"occurrences"`
-	CreatedAt   time.Time `json:"createdAt"`
-	UpdatedAt   time.Time `json:"updatedAt"`
+	ID             int64     `json:"id"`
+	Description    string    `json:"description"`
+	ErrorClass     string    `json:"errorClass"`
+	Occurrences    uint      `json:"occurrences"`
+	CreatedAt      time.Time `json:"createdAt"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+	AcknowledgedAt null.Time `json:"acknowledgedAt"`
 }
 
 func NewJobError(e job.SpecError) JobError {
 	return JobError{
-		ID:          e.ID,
-		Description: e.Description,
-		Occurrences: e.Occurrences,
-		CreatedAt:   e.CreatedAt,
-		UpdatedAt:   e.UpdatedAt,
+		ID:             e.ID,
+		Description:    e.Description,
+		ErrorClass:     e.ErrorClass,
+		Occurrences:    e.Occurrences,
+		CreatedAt:      e.CreatedAt,
+		UpdatedAt:      e.UpdatedAt,
+		AcknowledgedAt: e.AcknowledgedAt,
 	}
 }
 
+// JobErrorResource represents an error class of a job
+type JobErrorResource struct {
+	JAID
+	JobID          int32     `json:"jobID"`
+	Description    string    `json:"description"`
+	ErrorClass     string    `json:"errorClass"`
+	Occurrences    uint      `json:"occurrences"`
+	CreatedAt      time.Time `json:"createdAt"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+	AcknowledgedAt null.Time `json:"acknowledgedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobErrorResource) GetName() string {
+	return "job_spec_errors"
+}
+
+// NewJobErrorResources constructs a slice of JobErrorResources
+func NewJobErrorResources(errs []job.SpecError) []JobErrorResource {
+	rs := make([]JobErrorResource, len(errs))
+	for i, e := range errs {
+		rs[i] = JobErrorResource{
+			JAID:           NewJAIDInt64(e.ID),
+			JobID:          e.JobID,
+			Description:    e.Description,
+			ErrorClass:     e.ErrorClass,
+			Occurrences:    e.Occurrences,
+			CreatedAt:      e.CreatedAt,
+			UpdatedAt:      e.UpdatedAt,
+			AcknowledgedAt: e.AcknowledgedAt,
+		}
+	}
+	return rs
+}
+
 // JobResource represents a JobResource
 type JobResource struct {
 	JAID
@@ -414,6 +474,14 @@ type JobResource struct {
 	BootstrapSpec          *BootstrapSpec          `json:"bootstrapSpec"`
 	PipelineSpec           PipelineSpec            `json:"pipelineSpec"`
 	Errors                 []JobError              `json:"errors"`
+	ArchivedAt             *time.Time              `json:"archivedAt,omitempty"`
+	Paused                 bool                    `json:"paused,omitempty"`
+	MetricsLabels          map[string]string       `json:"metricsLabels,omitempty"`
+	RunDedup               *pipeline.RunDedup      `json:"runDedup,omitempty"`
+	Dependencies           *job.Dependencies       `json:"dependencies,omitempty"`
+	MonthlyBudget          *assets.Eth             `json:"monthlyBudgetWei,omitempty"`
+	AlertErrorsThreshold   *uint32                 `json:"alertErrorsThreshold,omitempty"`
+	AlertErrorsWindow      *models.Interval        `json:"alertErrorsWindow,omitempty"`
 }
 
 // NewJobResource initializes a new JSONAPI job resource
@@ -428,6 +496,20 @@ func NewJobResource(j job.Job) *JobResource {
 		MaxTaskDuration:   j.MaxTaskDuration,
 		PipelineSpec:      NewPipelineSpec(j.PipelineSpec),
 		ExternalJobID:     j.ExternalJobID,
+		Paused:            j.Paused,
+		MetricsLabels:     j.MetricsLabels,
+		RunDedup:          j.RunDedup,
+		MonthlyBudget:     j.MonthlyBudget,
+		AlertErrorsWindow: j.AlertErrorsWindow,
+	}
+	if j.AlertErrorsThreshold.Valid {
+		resource.AlertErrorsThreshold = &j.AlertErrorsThreshold.Uint32
+	}
+	if j.ArchivedAt.Valid {
+		resource.ArchivedAt = &j.ArchivedAt.Time
+	}
+	if deps := j.Dependencies; len(deps.Bridges)+len(deps.Keys)+len(deps.Jobs) > 0 {
+		resource.Dependencies = &deps
 	}
 
 	switch j.Type {
@@ -0,0 +1,56 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/services/payments"
+)
+
+// JobEarningsResource is the LINK earned by a job, with the wei spent by its
+// transactions
+type JobEarningsResource struct {
+	JAID
+	Name        string      `json:"name"`
+	MonthStart  time.Time   `json:"monthStart"`
+	MonthEarned assets.Link `json:"monthEarned"`
+	TotalEarned assets.Link `json:"totalEarned"`
+	Pending     assets.Link `json:"pending"`
+	Requests    int64       `json:"requests"`
+	Fulfilled   int64       `json:"fulfilled"`
+	Cancelled   int64       `json:"cancelled"`
+	MonthSpend  assets.Eth  `json:"monthSpendWei"`
+	TotalSpend  assets.Eth  `json:"totalSpendWei"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (JobEarningsResource) GetName() string {
+	return "jobEarnings"
+}
+
+// NewJobEarningsResource generates a JobEarningsResource of the earnings of a
+// job since monthStart
+func NewJobEarningsResource(earnings payments.JobEarnings, monthStart time.Time) JobEarningsResource {
+	return JobEarningsResource{
+		JAID:        NewJAIDInt32(earnings.JobID),
+		Name:        earnings.Name.ValueOrZero(),
+		MonthStart:  monthStart,
+		MonthEarned: earnings.MonthEarned,
+		TotalEarned: earnings.TotalEarned,
+		Pending:     earnings.Pending,
+		Requests:    earnings.Requests,
+		Fulfilled:   earnings.Fulfilled,
+		Cancelled:   earnings.Cancelled,
+		MonthSpend:  earnings.MonthSpend,
+		TotalSpend:  earnings.TotalSpend,
+	}
+}
+
+// NewJobEarningsResources generates a JobEarningsResource per job
+func NewJobEarningsResources(earnings []payments.JobEarnings, monthStart time.Time) []JobEarningsResource {
+	rs := make([]JobEarningsResource, len(earnings))
+	for i, e := range earnings {
+		rs[i] = NewJobEarningsResource(e, monthStart)
+	}
+	return rs
+}
@@ -0,0 +1,38 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/services/budgets"
+)
+
+// JobSpendResource is the wei spent by the confirmed transactions of a job
+type JobSpendResource struct {
+	JAID
+	MonthlyBudget  *assets.Eth `json:"monthlyBudgetWei"`
+	MonthStart     time.Time   `json:"monthStart"`
+	MonthSpend     assets.Eth  `json:"monthSpendWei"`
+	TotalSpend     assets.Eth  `json:"totalSpendWei"`
+	Transactions   int64       `json:"transactions"`
+	BudgetExceeded bool        `json:"budgetExceeded"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (JobSpendResource) GetName() string {
+	return "jobSpends"
+}
+
+// NewJobSpendResource generates a JobSpendResource of the spend of a job
+// since monthStart
+func NewJobSpendResource(spend budgets.JobSpend, monthStart time.Time) JobSpendResource {
+	return JobSpendResource{
+		JAID:           NewJAIDInt32(spend.JobID),
+		MonthlyBudget:  spend.MonthlyBudget,
+		MonthStart:     monthStart,
+		MonthSpend:     spend.MonthSpend,
+		TotalSpend:     spend.TotalSpend,
+		Transactions:   spend.Transactions,
+		BudgetExceeded: spend.Exceeded(),
+	}
+}
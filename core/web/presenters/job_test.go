@@ -99,7 +99,9 @@ func TestJob(t *testing.T) {
 							"initiator": "runlog",
 							"createdAt":"2000-01-01T00:00:00Z",
 							"updatedAt":"2000-01-01T00:00:00Z",
-							"evmChainID": "42"
+							"evmChainID": "42",
+							"dedupKey": null,
+							"dedupTTL": "0s"
 						},
 						"offChainReportingOracleSpec": null,
 						"offChainReporting2OracleSpec": null,
@@ -429,6 +431,7 @@ func TestJob(t *testing.T) {
 							"jobID": 0
 						},
 						"webhookSpec": {
+							"publicTrigger": false,
 							"createdAt":"2000-01-01T00:00:00Z",
 							"updatedAt":"2000-01-01T00:00:00Z"
 						},
@@ -605,6 +608,7 @@ func TestJob(t *testing.T) {
 						ID:          200,
 						JobID:       1,
 						Description: "some error",
+						ErrorClass:  "some error",
 						Occurrences: 1,
 						CreatedAt:   timestamp,
 						UpdatedAt:   timestamp,
@@ -648,9 +652,11 @@ func TestJob(t *testing.T) {
 						"errors": [{
 							"id": 200,
 							"description": "some error",
+							"errorClass": "some error",
 							"occurrences": 1,
 							"createdAt":"2000-01-01T00:00:00Z",
-							"updatedAt":"2000-01-01T00:00:00Z"
+							"updatedAt":"2000-01-01T00:00:00Z",
+							"acknowledgedAt": null
 						}]
 					}
 				}
@@ -0,0 +1,65 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/services/withdrawals"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// LinkWithdrawalResource represents an automatic withdrawal of the LINK earned
+// by an Oracle or Operator contract JSONAPI resource
+type LinkWithdrawalResource struct {
+	JAID
+	EVMChainID      utils.Big       `json:"evmChainID"`
+	ContractAddress string          `json:"contractAddress"`
+	FromAddress     string          `json:"fromAddress"`
+	Recipient       string          `json:"recipient"`
+	Threshold       assets.Link     `json:"threshold"`
+	Interval        models.Interval `json:"interval"`
+	LastCheckedAt   *time.Time      `json:"lastCheckedAt"`
+	// LastTxID is the last withdraw transaction
+	LastTxID        *int64       `json:"lastTxID"`
+	LastAmount      *assets.Link `json:"lastAmount"`
+	LastWithdrawnAt *time.Time   `json:"lastWithdrawnAt"`
+	// Error is the error of the last check, retried on the next one
+	Error     *string   `json:"error"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r LinkWithdrawalResource) GetName() string {
+	return "linkWithdrawals"
+}
+
+// NewLinkWithdrawalResource constructs a new LinkWithdrawalResource
+func NewLinkWithdrawalResource(w withdrawals.Withdrawal) *LinkWithdrawalResource {
+	return &LinkWithdrawalResource{
+		JAID:            NewJAIDInt64(w.ID),
+		EVMChainID:      w.EVMChainID,
+		ContractAddress: w.ContractAddress.Hex(),
+		FromAddress:     w.FromAddress.Hex(),
+		Recipient:       w.Recipient.Hex(),
+		Threshold:       w.Threshold,
+		Interval:        w.CheckInterval,
+		LastCheckedAt:   w.LastCheckedAt.Ptr(),
+		LastTxID:        w.LastEthTxID.Ptr(),
+		LastAmount:      w.LastAmount,
+		LastWithdrawnAt: w.LastWithdrawnAt.Ptr(),
+		Error:           w.Error.Ptr(),
+		CreatedAt:       w.CreatedAt,
+		UpdatedAt:       w.UpdatedAt,
+	}
+}
+
+// NewLinkWithdrawalResources constructs a slice of LinkWithdrawalResources
+func NewLinkWithdrawalResources(ws []withdrawals.Withdrawal) []LinkWithdrawalResource {
+	resources := []LinkWithdrawalResource{}
+	for _, w := range ws {
+		resources = append(resources, *NewLinkWithdrawalResource(w))
+	}
+	return resources
+}
@@ -0,0 +1,63 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+// MaintenanceResource represents the node's maintenance mode status.
+type MaintenanceResource struct {
+	JAID
+	Enabled              bool   `json:"enabled"`
+	InFlightRuns         int64  `json:"inFlightRuns"`
+	InFlightTransactions uint32 `json:"inFlightTransactions"`
+	QueuedRuns           int    `json:"queuedRuns"`
+	ReadyForShutdown     bool   `json:"readyForShutdown"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r MaintenanceResource) GetName() string {
+	return "maintenance"
+}
+
+// MaintenanceWindowResource represents a maintenance window JSONAPI resource
+type MaintenanceWindowResource struct {
+	JAID
+	// JobID is nil for the windows applying to every job
+	JobID     *int32                           `json:"jobID"`
+	StartsAt  time.Time                        `json:"startsAt"`
+	EndsAt    time.Time                        `json:"endsAt"`
+	Action    pipeline.MaintenanceWindowAction `json:"action"`
+	Reason    string                           `json:"reason"`
+	Active    bool                             `json:"active"`
+	CreatedAt time.Time                        `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r MaintenanceWindowResource) GetName() string {
+	return "maintenanceWindows"
+}
+
+// NewMaintenanceWindowResource constructs a new MaintenanceWindowResource
+func NewMaintenanceWindowResource(w pipeline.MaintenanceWindow) *MaintenanceWindowResource {
+	return &MaintenanceWindowResource{
+		JAID:      NewJAIDInt64(w.ID),
+		JobID:     w.JobID,
+		StartsAt:  w.StartsAt,
+		EndsAt:    w.EndsAt,
+		Action:    w.Action,
+		Reason:    w.Reason,
+		Active:    w.Active(time.Now()),
+		CreatedAt: w.CreatedAt,
+	}
+}
+
+// NewMaintenanceWindowResources constructs a slice of MaintenanceWindowResources
+func NewMaintenanceWindowResources(ws []pipeline.MaintenanceWindow) []MaintenanceWindowResource {
+	rs := []MaintenanceWindowResource{}
+	for _, w := range ws {
+		rs = append(rs, *NewMaintenanceWindowResource(w))
+	}
+	return rs
+}
@@ -0,0 +1,51 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/keyrotation"
+)
+
+// OCRKeyRotationResource represents a rotation of an OCR key bundle JSONAPI
+// resource
+type OCRKeyRotationResource struct {
+	JAID
+	KeyType        keyrotation.KeyType `json:"keyType"`
+	OldKeyBundleID string              `json:"oldKeyBundleID"`
+	NewKeyBundleID string              `json:"newKeyBundleID"`
+	State          keyrotation.State   `json:"state"`
+	// JobIDs are the jobs switched to the new key bundle, once completed
+	JobIDs      []int32    `json:"jobIDs"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	CompletedAt *time.Time `json:"completedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r OCRKeyRotationResource) GetName() string {
+	return "ocrKeyRotations"
+}
+
+// NewOCRKeyRotationResource constructs a new OCRKeyRotationResource
+func NewOCRKeyRotationResource(r keyrotation.Rotation) *OCRKeyRotationResource {
+	jobIDs := []int32{}
+	jobIDs = append(jobIDs, r.JobIDs...)
+	return &OCRKeyRotationResource{
+		JAID:           NewJAIDInt64(r.ID),
+		KeyType:        r.KeyType,
+		OldKeyBundleID: r.OldKeyBundleID,
+		NewKeyBundleID: r.NewKeyBundleID,
+		State:          r.State,
+		JobIDs:         jobIDs,
+		CreatedAt:      r.CreatedAt,
+		CompletedAt:    r.CompletedAt.Ptr(),
+	}
+}
+
+// NewOCRKeyRotationResources constructs a slice of OCRKeyRotationResources
+func NewOCRKeyRotationResources(rs []keyrotation.Rotation) []OCRKeyRotationResource {
+	resources := []OCRKeyRotationResource{}
+	for _, r := range rs {
+		resources = append(resources, *NewOCRKeyRotationResource(r))
+	}
+	return resources
+}
@@ -0,0 +1,116 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/ocrcommon"
+)
+
+// P2PPeerStatus represents the connectivity to a remote peer
+type P2PPeerStatus struct {
+	PeerID           string     `json:"peerID"`
+	Connected        bool       `json:"connected"`
+	DialFailures     int64      `json:"dialFailures"`
+	LastDialError    string     `json:"lastDialError,omitempty"`
+	MessagesSent     int64      `json:"messagesSent"`
+	BytesSent        int64      `json:"bytesSent"`
+	MessagesReceived int64      `json:"messagesReceived"`
+	BytesReceived    int64      `json:"bytesReceived"`
+	LastReceivedAt   *time.Time `json:"lastReceivedAt"`
+}
+
+// NewP2PPeerStatus constructs a new P2PPeerStatus
+func NewP2PPeerStatus(s ocrcommon.PeerP2PStatus) P2PPeerStatus {
+	return P2PPeerStatus{
+		PeerID:           s.PeerID,
+		Connected:        s.Connected,
+		DialFailures:     s.DialFailures,
+		LastDialError:    s.LastDialError,
+		MessagesSent:     s.MessagesSent,
+		BytesSent:        s.BytesSent,
+		MessagesReceived: s.MessagesReceived,
+		BytesReceived:    s.BytesReceived,
+		LastReceivedAt:   s.LastReceivedAt,
+	}
+}
+
+// P2PJobStatus represents the connectivity of an OCR job to its peers
+type P2PJobStatus struct {
+	JobID        int32           `json:"jobID"`
+	ConfigDigest string          `json:"configDigest"`
+	Peers        []P2PPeerStatus `json:"peers"`
+}
+
+// P2PStatusResource represents the P2P connectivity of the node JSONAPI
+// resource
+type P2PStatusResource struct {
+	JAID
+	NetworkingStack   string         `json:"networkingStack"`
+	AnnounceAddresses []string       `json:"announceAddresses"`
+	Jobs              []P2PJobStatus `json:"jobs"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r P2PStatusResource) GetName() string {
+	return "p2pStatus"
+}
+
+// NewP2PStatusResource constructs a new P2PStatusResource
+func NewP2PStatusResource(s ocrcommon.P2PStatus) *P2PStatusResource {
+	jobs := []P2PJobStatus{}
+	for _, js := range s.Jobs {
+		peers := []P2PPeerStatus{}
+		for _, ps := range js.Peers {
+			peers = append(peers, NewP2PPeerStatus(ps))
+		}
+		jobs = append(jobs, P2PJobStatus{
+			JobID:        js.JobID,
+			ConfigDigest: js.ConfigDigest,
+			Peers:        peers,
+		})
+	}
+	return &P2PStatusResource{
+		JAID:              NewJAID(s.PeerID),
+		NetworkingStack:   s.NetworkingStack,
+		AnnounceAddresses: s.AnnounceAddresses,
+		Jobs:              jobs,
+	}
+}
+
+// P2PPingResult represents the outcome of dialing an address of a peer
+type P2PPingResult struct {
+	Address string `json:"address"`
+	// Latency is the time to connect, in milliseconds
+	Latency float64 `json:"latency"`
+	Error   string  `json:"error,omitempty"`
+}
+
+// P2PPingResource represents the connectivity to a remote peer, and the
+// outcome of dialing its addresses JSONAPI resource
+type P2PPingResource struct {
+	JAID
+	P2PPeerStatus
+	Results []P2PPingResult `json:"results"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r P2PPingResource) GetName() string {
+	return "p2pPings"
+}
+
+// NewP2PPingResource constructs a new P2PPingResource
+func NewP2PPingResource(p ocrcommon.P2PPing) *P2PPingResource {
+	results := []P2PPingResult{}
+	for _, r := range p.Results {
+		results = append(results, P2PPingResult{
+			Address: r.Address,
+			Latency: float64(r.Latency) / float64(time.Millisecond),
+			Error:   r.Error,
+		})
+	}
+	return &P2PPingResource{
+		JAID:          NewJAID(p.PeerID),
+		P2PPeerStatus: NewP2PPeerStatus(p.PeerP2PStatus),
+		Results:       results,
+	}
+}
@@ -0,0 +1,212 @@
+package presenters
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+// PipelineGraphResource is the task graph of the pipeline of a job, with the
+// status of the tasks in its last run
+type PipelineGraphResource struct {
+	JAID
+	Nodes []PipelineGraphNode `json:"nodes"`
+	Edges []PipelineGraphEdge `json:"edges"`
+	// LastRunID is the ID of the last run of the job, null if it never ran
+	LastRunID *string `json:"lastRunID"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineGraphResource) GetName() string {
+	return "pipelineGraphs"
+}
+
+// PipelineGraphNode is a task of the pipeline
+type PipelineGraphNode struct {
+	ID     string            `json:"id"`
+	Type   pipeline.TaskType `json:"type"`
+	Params map[string]string `json:"params"`
+	// Status is the status of the task in the last run, null if it did not run
+	Status *pipeline.RunStatus `json:"status"`
+	Error  *string             `json:"error"`
+}
+
+// PipelineGraphEdge links the task From to its output task To. Implicit edges
+// come from variables, like $(ds), instead of arrows.
+type PipelineGraphEdge struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Implicit bool   `json:"implicit"`
+}
+
+// NewPipelineGraphResource returns the graph of the pipeline of the job, with
+// the statuses of the tasks in lastRun, which may be nil
+func NewPipelineGraphResource(jobID int32, p *pipeline.Pipeline, lastRun *pipeline.Run) PipelineGraphResource {
+	r := PipelineGraphResource{
+		JAID:  NewJAIDInt32(jobID),
+		Nodes: []PipelineGraphNode{},
+		Edges: []PipelineGraphEdge{},
+	}
+	if lastRun != nil {
+		id := lastRun.GetID()
+		r.LastRunID = &id
+	}
+
+	for _, task := range p.Tasks {
+		params := p.Attributes(task.DotID())
+		delete(params, "type")
+		node := PipelineGraphNode{
+			ID:     task.DotID(),
+			Type:   task.Type(),
+			Params: params,
+		}
+		if lastRun != nil {
+			if tr := lastRun.ByDotID(task.DotID()); tr != nil {
+				status := taskRunStatus(*lastRun, *tr)
+				node.Status = &status
+				if tr.Error.Valid {
+					node.Error = &tr.Error.String
+				}
+			}
+		}
+		r.Nodes = append(r.Nodes, node)
+
+		for _, input := range task.Inputs() {
+			r.Edges = append(r.Edges, PipelineGraphEdge{
+				From:     input.InputTask.DotID(),
+				To:       task.DotID(),
+				Implicit: !input.PropagateResult,
+			})
+		}
+	}
+	return r
+}
+
+func taskRunStatus(run pipeline.Run, tr pipeline.TaskRun) pipeline.RunStatus {
+	switch {
+	case tr.Error.Valid:
+		return pipeline.RunStatusErrored
+	case tr.FinishedAt.Valid:
+		return pipeline.RunStatusCompleted
+	case run.State == pipeline.RunStatusSuspended:
+		return pipeline.RunStatusSuspended
+	default:
+		return pipeline.RunStatusRunning
+	}
+}
+
+// Layout of the SVG rendering, in pixels
+const (
+	svgNodeWidth  = 180
+	svgNodeHeight = 44
+	svgHGap       = 40
+	svgVGap       = 60
+	svgMargin     = 20
+	svgMaxLabel   = 24
+)
+
+// svgColors are the fill and stroke colors of the nodes by status, the empty
+// status being the tasks that did not run
+var svgColors = map[pipeline.RunStatus][2]string{
+	"":                          {"#f1f3f5", "#adb5bd"},
+	pipeline.RunStatusCompleted: {"#d4edda", "#28a745"},
+	pipeline.RunStatusErrored:   {"#f8d7da", "#dc3545"},
+	pipeline.RunStatusRunning:   {"#fff3cd", "#ffc107"},
+	pipeline.RunStatusSuspended: {"#fff3cd", "#ffc107"},
+}
+
+// SVG renders the graph top to bottom, with the tasks in layers below their
+// inputs and colored by their status in the last run
+func (r PipelineGraphResource) SVG() []byte {
+	// The nodes are sorted topologically, so the inputs of a node are placed
+	// before it
+	layerOf := make(map[string]int, len(r.Nodes))
+	for _, node := range r.Nodes {
+		layerOf[node.ID] = 0
+	}
+	for _, node := range r.Nodes {
+		for _, edge := range r.Edges {
+			if edge.To == node.ID && layerOf[edge.From]+1 > layerOf[node.ID] {
+				layerOf[node.ID] = layerOf[edge.From] + 1
+			}
+		}
+	}
+	var layers [][]PipelineGraphNode
+	for _, node := range r.Nodes {
+		l := layerOf[node.ID]
+		for len(layers) <= l {
+			layers = append(layers, nil)
+		}
+		layers[l] = append(layers[l], node)
+	}
+
+	maxPerLayer := 1
+	for _, layer := range layers {
+		if len(layer) > maxPerLayer {
+			maxPerLayer = len(layer)
+		}
+	}
+	width := 2*svgMargin + maxPerLayer*(svgNodeWidth+svgHGap) - svgHGap
+	height := 2*svgMargin + len(layers)*(svgNodeHeight+svgVGap) - svgVGap
+	if len(layers) == 0 {
+		height = 2 * svgMargin
+	}
+
+	type point struct{ x, y int }
+	positions := make(map[string]point, len(r.Nodes))
+	for l, layer := range layers {
+		offset := (width - len(layer)*(svgNodeWidth+svgHGap) + svgHGap) / 2
+		for i, node := range layer {
+			positions[node.ID] = point{offset + i*(svgNodeWidth+svgHGap), svgMargin + l*(svgNodeHeight+svgVGap)}
+		}
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="12">`, width, height, width, height)
+	b.WriteString(`<defs><marker id="arrow" viewBox="0 0 10 10" refX="10" refY="5" markerWidth="8" markerHeight="8" orient="auto"><path d="M 0 0 L 10 5 L 0 10 z" fill="#495057"/></marker></defs>`)
+	for _, edge := range r.Edges {
+		from, to := positions[edge.From], positions[edge.To]
+		dash := ""
+		if edge.Implicit {
+			dash = ` stroke-dasharray="4 4"`
+		}
+		fmt.Fprintf(&b, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#495057"%s marker-end="url(#arrow)"/>`,
+			from.x+svgNodeWidth/2, from.y+svgNodeHeight, to.x+svgNodeWidth/2, to.y, dash)
+	}
+	for _, node := range r.Nodes {
+		pos := positions[node.ID]
+		var status pipeline.RunStatus
+		if node.Status != nil {
+			status = *node.Status
+		}
+		colors, ok := svgColors[status]
+		if !ok {
+			colors = svgColors[""]
+		}
+		title := node.ID
+		if status != "" {
+			title += ": " + string(status)
+		}
+		if node.Error != nil {
+			title += ": " + *node.Error
+		}
+		fmt.Fprintf(&b, `<g><title>%s</title>`, html.EscapeString(title))
+		fmt.Fprintf(&b, `<rect x="%d" y="%d" width="%d" height="%d" rx="6" fill="%s" stroke="%s"/>`,
+			pos.x, pos.y, svgNodeWidth, svgNodeHeight, colors[0], colors[1])
+		fmt.Fprintf(&b, `<text x="%d" y="%d" text-anchor="middle" font-weight="bold">%s</text>`,
+			pos.x+svgNodeWidth/2, pos.y+18, html.EscapeString(svgLabel(node.ID)))
+		fmt.Fprintf(&b, `<text x="%d" y="%d" text-anchor="middle" fill="#495057">%s</text></g>`,
+			pos.x+svgNodeWidth/2, pos.y+34, html.EscapeString(svgLabel(string(node.Type))))
+	}
+	b.WriteString(`</svg>`)
+	return b.Bytes()
+}
+
+func svgLabel(s string) string {
+	if runes := []rune(s); len(runes) > svgMaxLabel {
+		return string(runes[:svgMaxLabel-1]) + "…"
+	}
+	return s
+}
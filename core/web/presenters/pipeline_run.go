@@ -30,6 +30,22 @@ func (r PipelineRunResource) GetName() string {
 	return "pipelineRun"
 }
 
+// PublicTriggerRunResource is a run started through a public trigger URL,
+// whose results are not disclosed to the unauthenticated caller
+type PublicTriggerRunResource struct {
+	JAID
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PublicTriggerRunResource) GetName() string {
+	return "pipelineRun"
+}
+
+// NewPublicTriggerRunResource returns the resource of the run
+func NewPublicTriggerRunResource(runID int64) PublicTriggerRunResource {
+	return PublicTriggerRunResource{JAID: NewJAIDInt64(runID)}
+}
+
 func NewPipelineRunResource(pr pipeline.Run, lggr logger.Logger) PipelineRunResource {
 	lggr = lggr.Named("PipelineRunResource")
 	var trs []PipelineTaskRunResource
@@ -0,0 +1,63 @@
+package presenters
+
+import (
+	"fmt"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// RPCStatResource represents the usage of one JSON-RPC method of one EVM RPC
+// endpoint
+type RPCStatResource struct {
+	JAID
+	EVMChainID    string          `json:"evmChainID"`
+	NodeName      string          `json:"nodeName"`
+	RPCHost       string          `json:"rpcHost"`
+	SendOnly      bool            `json:"sendOnly"`
+	Method        string          `json:"method"`
+	Calls         uint64          `json:"calls"`
+	Errors        uint64          `json:"errors"`
+	RequestBytes  uint64          `json:"requestBytes"`
+	ResponseBytes uint64          `json:"responseBytes"`
+	TotalLatency  models.Duration `json:"totalLatency"`
+	AvgLatency    models.Duration `json:"avgLatency"`
+	MaxLatency    models.Duration `json:"maxLatency"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r RPCStatResource) GetName() string {
+	return "rpc_stats"
+}
+
+// NewRPCStatResource constructs a new RPCStatResource
+func NewRPCStatResource(s evmclient.RPCStat) RPCStatResource {
+	tier := "primary"
+	if s.SendOnly {
+		tier = "sendonly"
+	}
+	return RPCStatResource{
+		JAID:          NewJAID(fmt.Sprintf("%s/%s/%s/%s", s.EVMChainID, s.NodeName, tier, s.Method)),
+		EVMChainID:    s.EVMChainID,
+		NodeName:      s.NodeName,
+		RPCHost:       s.RPCHost,
+		SendOnly:      s.SendOnly,
+		Method:        s.Method,
+		Calls:         s.Calls,
+		Errors:        s.Errors,
+		RequestBytes:  s.RequestBytes,
+		ResponseBytes: s.ResponseBytes,
+		TotalLatency:  models.MustMakeDuration(s.TotalLatency),
+		AvgLatency:    models.MustMakeDuration(s.AvgLatency()),
+		MaxLatency:    models.MustMakeDuration(s.MaxLatency),
+	}
+}
+
+// NewRPCStatResources constructs a slice of RPCStatResources
+func NewRPCStatResources(stats []evmclient.RPCStat) []RPCStatResource {
+	rs := make([]RPCStatResource, len(stats))
+	for i, s := range stats {
+		rs[i] = NewRPCStatResource(s)
+	}
+	return rs
+}
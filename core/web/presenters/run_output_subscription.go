@@ -0,0 +1,50 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/runoutputs"
+)
+
+// RunOutputSubscriptionResource represents a run outputs subscription JSONAPI resource.
+type RunOutputSubscriptionResource struct {
+	JAID
+	URL string `json:"url"`
+	// The Secret is only provided when creating a subscription
+	Secret    string      `json:"secret,omitempty"`
+	JobID     null.Int    `json:"jobID"`
+	JobType   null.String `json:"jobType"`
+	Status    null.String `json:"status"`
+	CreatedAt time.Time   `json:"createdAt"`
+	UpdatedAt time.Time   `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r RunOutputSubscriptionResource) GetName() string {
+	return "runOutputSubscriptions"
+}
+
+// NewRunOutputSubscriptionResource constructs a new RunOutputSubscriptionResource
+func NewRunOutputSubscriptionResource(sub runoutputs.Subscription) *RunOutputSubscriptionResource {
+	return &RunOutputSubscriptionResource{
+		JAID:      NewJAID(strconv.FormatInt(sub.ID, 10)),
+		URL:       sub.URL.String(),
+		JobID:     sub.JobID,
+		JobType:   sub.JobType,
+		Status:    sub.Status,
+		CreatedAt: sub.CreatedAt,
+		UpdatedAt: sub.UpdatedAt,
+	}
+}
+
+// NewRunOutputSubscriptionResources constructs a slice of RunOutputSubscriptionResources
+func NewRunOutputSubscriptionResources(subs []runoutputs.Subscription) []RunOutputSubscriptionResource {
+	rs := []RunOutputSubscriptionResource{}
+	for _, sub := range subs {
+		rs = append(rs, *NewRunOutputSubscriptionResource(sub))
+	}
+	return rs
+}
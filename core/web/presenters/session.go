@@ -0,0 +1,45 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/sessions"
+)
+
+// SessionResource represents a login session JSONAPI resource. The ID is the
+// session's public ID, never the secret session ID.
+type SessionResource struct {
+	JAID
+	IPAddress string    `json:"ipAddress"`
+	UserAgent string    `json:"userAgent"`
+	CreatedAt time.Time `json:"createdAt"`
+	LastUsed  time.Time `json:"lastUsed"`
+	// Current is set for the session making the request
+	Current bool `json:"current"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r SessionResource) GetName() string {
+	return "sessions"
+}
+
+// NewSessionResource constructs a new SessionResource
+func NewSessionResource(s sessions.Session, currentID string) *SessionResource {
+	return &SessionResource{
+		JAID:      NewJAID(s.PublicID()),
+		IPAddress: s.IPAddress,
+		UserAgent: s.UserAgent,
+		CreatedAt: s.CreatedAt,
+		LastUsed:  s.LastUsed,
+		Current:   s.ID == currentID,
+	}
+}
+
+// NewSessionResources constructs a slice of SessionResources
+func NewSessionResources(ss []sessions.Session, currentID string) []SessionResource {
+	rs := []SessionResource{}
+	for _, s := range ss {
+		rs = append(rs, *NewSessionResource(s, currentID))
+	}
+	return rs
+}
@@ -4,16 +4,19 @@ import (
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
 // UserResource represents a User JSONAPI resource.
 type UserResource struct {
 	JAID
-	Email             string            `json:"email"`
-	Role              sessions.UserRole `json:"role"`
-	HasActiveApiToken string            `json:"hasActiveApiToken"`
-	CreatedAt         time.Time         `json:"createdAt"`
-	UpdatedAt         time.Time         `json:"updatedAt"`
+	Email     string            `json:"email"`
+	Role      sessions.UserRole `json:"role"`
+	CreatedAt time.Time         `json:"createdAt"`
+	UpdatedAt time.Time         `json:"updatedAt"`
+	// Unset session timeouts use the node's defaults
+	SessionIdleTimeout     *models.Interval `json:"sessionIdleTimeout"`
+	SessionAbsoluteTimeout *models.Interval `json:"sessionAbsoluteTimeout"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -25,17 +28,15 @@ func (r UserResource) GetName() string {
 //
 // A User does not have an ID primary key, so we must use the email
 func NewUserResource(u sessions.User) *UserResource {
-	hasToken := "false"
-	if u.TokenKey.Valid {
-		hasToken = "true"
-	}
 	return &UserResource{
-		JAID:              NewJAID(u.Email),
-		Email:             u.Email,
-		Role:              sessions.UserRole(u.Role),
-		HasActiveApiToken: hasToken,
-		CreatedAt:         u.CreatedAt,
-		UpdatedAt:         u.UpdatedAt,
+		JAID:      NewJAID(u.Email),
+		Email:     u.Email,
+		Role:      sessions.UserRole(u.Role),
+		CreatedAt: u.CreatedAt,
+		UpdatedAt: u.UpdatedAt,
+
+		SessionIdleTimeout:     u.SessionIdleTimeout,
+		SessionAbsoluteTimeout: u.SessionAbsoluteTimeout,
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -20,6 +21,8 @@ func TestUserResource(t *testing.T) {
 		CreatedAt: ts,
 		UpdatedAt: ts,
 		Role:      sessions.UserRoleAdmin,
+
+		SessionIdleTimeout: models.NewInterval(30 * time.Minute),
 	}
 
 	r := NewUserResource(user)
@@ -36,8 +39,9 @@ func TestUserResource(t *testing.T) {
 			  "email": "notreal@fakeemail.ch",
 			  "createdAt": "2000-01-01T00:00:00Z",
 			  "updatedAt": "2000-01-01T00:00:00Z",
-			  "hasActiveApiToken": "false",
-			  "role": "admin"
+			  "role": "admin",
+			  "sessionIdleTimeout": "30m0s",
+			  "sessionAbsoluteTimeout": null
 		   }
 		}
 	 }
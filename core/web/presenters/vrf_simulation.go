@@ -0,0 +1,37 @@
+package presenters
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/chainlink/core/services/vrf"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// VRFSimulationResource represents a simulated VRF fulfillment.
+type VRFSimulationResource struct {
+	JAID
+	KeyID            string        `json:"keyID"`
+	Proof            hexutil.Bytes `json:"proof"`
+	Randomness       utils.Big     `json:"randomness"`
+	FulfillCalldata  hexutil.Bytes `json:"fulfillCalldata"`
+	CallbackCalldata hexutil.Bytes `json:"callbackCalldata"`
+	CallbackAddress  string        `json:"callbackAddress"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r VRFSimulationResource) GetName() string {
+	return "vrfSimulations"
+}
+
+// NewVRFSimulationResource constructs a new VRFSimulationResource
+func NewVRFSimulationResource(f vrf.SimulatedFulfillment) *VRFSimulationResource {
+	return &VRFSimulationResource{
+		JAID:             NewJAID(f.RequestID.Hex()),
+		KeyID:            f.KeyID,
+		Proof:            f.Proof,
+		Randomness:       *utils.NewBig(f.Randomness),
+		FulfillCalldata:  f.FulfillCalldata,
+		CallbackCalldata: f.CallbackCalldata,
+		CallbackAddress:  f.CallbackAddress.Hex(),
+	}
+}
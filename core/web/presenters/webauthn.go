@@ -1,7 +1,12 @@
 package presenters
 
 import (
+	"strconv"
+	"time"
+
 	"github.com/duo-labs/webauthn/protocol"
+
+	"github.com/smartcontractkit/chainlink/core/sessions"
 )
 
 // RegistrationSettings represents an enrollment settings object
@@ -23,3 +28,33 @@ func NewRegistrationSettings(settings protocol.CredentialCreation) *Registration
 		Settings: settings,
 	}
 }
+
+// WebAuthnDeviceResource represents a registered WebAuthn key
+type WebAuthnDeviceResource struct {
+	JAID
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r WebAuthnDeviceResource) GetName() string {
+	return "webauthn_devices"
+}
+
+// NewWebAuthnDeviceResource constructs a new WebAuthnDeviceResource
+func NewWebAuthnDeviceResource(wa sessions.WebAuthn) *WebAuthnDeviceResource {
+	return &WebAuthnDeviceResource{
+		JAID:      NewJAID(strconv.FormatInt(wa.ID, 10)),
+		Name:      wa.Name,
+		CreatedAt: wa.CreatedAt,
+	}
+}
+
+// NewWebAuthnDeviceResources constructs a slice of WebAuthnDeviceResources
+func NewWebAuthnDeviceResources(was []sessions.WebAuthn) []WebAuthnDeviceResource {
+	rs := []WebAuthnDeviceResource{}
+	for _, wa := range was {
+		rs = append(rs, *NewWebAuthnDeviceResource(wa))
+	}
+	return rs
+}
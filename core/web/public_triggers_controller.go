@@ -0,0 +1,85 @@
+package web
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/ulule/limiter"
+	"github.com/ulule/limiter/drivers/store/memory"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// PublicTriggersController starts the runs of the webhook jobs with a public
+// trigger URL, without authentication.
+type PublicTriggersController struct {
+	App chainlink.Application
+	// jobLimits counts the runs of each job, for their rate limits
+	jobLimits limiter.Store
+}
+
+// NewPublicTriggersController returns a PublicTriggersController
+func NewPublicTriggersController(app chainlink.Application) *PublicTriggersController {
+	return &PublicTriggersController{App: app, jobLimits: memory.NewStore()}
+}
+
+// Create triggers a run of the webhook job of the token, with the request
+// body as $(jobRun.requestBody). Only the ID of the run is returned.
+// Example:
+// "POST <application>/v2/public/webhooks/:token"
+func (ptc *PublicTriggersController) Create(c *gin.Context) {
+	ctx := c.Request.Context()
+	trigger, err := webhook.FindPublicTrigger(ctx, ptc.App.GetSqlxDB().DB, ptc.App.GetKeyStore().ColumnCiphers(), c.Param("token"))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("public trigger not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(c.Request.Body)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if trigger.Secret != "" {
+		if err = trigger.VerifySignature(body, c.GetHeader(webhook.PublicTriggerSignatureHeader)); err != nil {
+			jsonAPIError(c, http.StatusUnauthorized, err)
+			return
+		}
+	}
+
+	rate := limiter.Rate{Period: time.Minute, Limit: trigger.RateLimit}
+	limit, err := limiter.New(ptc.jobLimits, rate).Get(ctx, trigger.ExternalJobID.String())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if limit.Reached {
+		jsonAPIError(c, http.StatusTooManyRequests, errors.Errorf("rate limit of %d runs per minute reached", trigger.RateLimit))
+		return
+	}
+
+	runID, err := ptc.App.RunWebhookJobV2(ctx, trigger.ExternalJobID, string(body), pipeline.JSONSerializable{})
+	if errors.Is(err, webhook.ErrJobNotExists) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if errors.Is(err, job.ErrJobPaused) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPublicTriggerRunResource(runID), "pipelineRun")
+}
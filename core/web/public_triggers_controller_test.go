@@ -0,0 +1,85 @@
+package web_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestPublicTriggersController_Create(t *testing.T) {
+	t.Parallel()
+
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.EVMRPCEnabled = null.BoolFrom(false)
+	app := cltest.NewApplicationWithConfig(t, cfg)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	newJob := func(secret string) string {
+		jb, err := webhook.ValidatedWebhookSpec(`
+type                   = "webhook"
+schemaVersion          = 1
+publicTrigger          = true
+publicTriggerRateLimit = 1
+observationSource      = """
+	parse [type=jsonparse path="value" data="$(jobRun.requestBody)"];
+"""
+`, app.GetExternalInitiatorManager())
+		require.NoError(t, err)
+		if secret != "" {
+			jb.WebhookSpec.PublicTriggerSecret = null.StringFrom(secret)
+		}
+		require.NoError(t, app.AddJobV2(testutils.Context(t), &jb))
+		return jb.WebhookSpec.PublicTriggerToken
+	}
+	post := func(token, body, signature string) *http.Response {
+		req, err := http.NewRequestWithContext(testutils.Context(t), http.MethodPost, app.Server.URL+"/v2/public/webhooks/"+token, strings.NewReader(body))
+		require.NoError(t, err)
+		if signature != "" {
+			req.Header.Set(webhook.PublicTriggerSignatureHeader, signature)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, resp.Body.Close()) })
+		return resp
+	}
+
+	// The requests are also limited to UNAUTHENTICATED_RATE_LIMIT per IP, 5 by default
+	t.Run("rate limited runs", func(t *testing.T) {
+		token := newJob("")
+
+		resp := post(token, `{"value": 42}`, "")
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		var run presenters.PublicTriggerRunResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &run))
+		assert.NotEmpty(t, run.ID)
+
+		cltest.AssertServerResponse(t, post(token, `{"value": 43}`, ""), http.StatusTooManyRequests)
+	})
+
+	t.Run("signed runs", func(t *testing.T) {
+		token := newJob("s3cr3t")
+		body := `{"value": 42}`
+		h := hmac.New(sha256.New, []byte("s3cr3t"))
+		h.Write([]byte(body))
+
+		cltest.AssertServerResponse(t, post(token, body, ""), http.StatusUnauthorized)
+		cltest.AssertServerResponse(t, post(token, body, "sha256="+hex.EncodeToString(h.Sum(nil))), http.StatusOK)
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		cltest.AssertServerResponse(t, post("unknown", "{}", ""), http.StatusNotFound)
+	})
+}
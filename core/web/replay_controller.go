@@ -1,6 +1,7 @@
 package web
 
 import (
+	"database/sql"
 	"net/http"
 	"strconv"
 
@@ -70,9 +71,66 @@ func (bdc *ReplayController) ReplayFromBlock(c *gin.Context) {
 	jsonAPIResponse(c, &response, "response")
 }
 
+// ReplayJob sends the logs in the given block range to the log listeners of a single job,
+// skipping any logs the job has already consumed
+// Example:
+//  "<application>/v2/replay_job/:ID?from=:from&to=:to"
+func (bdc *ReplayController) ReplayJob(c *gin.Context) {
+	jobID, err := strconv.ParseInt(c.Param("ID"), 10, 32)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid job ID"))
+		return
+	}
+
+	fromBlock, err := strconv.ParseUint(c.Query("from"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "non-negative integer required for 'from' query string param"))
+		return
+	}
+	toBlock, err := strconv.ParseUint(c.Query("to"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "non-negative integer required for 'to' query string param"))
+		return
+	}
+	if toBlock < fromBlock {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("'to' block %v cannot be lower than 'from' block %v", toBlock, fromBlock))
+		return
+	}
+
+	chain, err := getChain(bdc.App.GetChains().EVM, c.Query("evmChainID"))
+	switch err {
+	case ErrInvalidChainID, ErrMultipleChains, ErrMissingChainID:
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	case nil:
+		break
+	default:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	chainID := chain.ID()
+
+	sent, err := bdc.App.ReplayJobLogs(c.Request.Context(), chainID, int32(jobID), fromBlock, toBlock)
+	if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	response := ReplayResponse{
+		Message:    "Replay finished",
+		EVMChainID: utils.NewBig(chainID),
+		LogsSent:   &sent,
+	}
+	jsonAPIResponse(c, &response, "response")
+}
+
 type ReplayResponse struct {
 	Message    string     `json:"message"`
 	EVMChainID *utils.Big `json:"evmChainID"`
+	LogsSent   *int       `json:"logsSent,omitempty"`
 }
 
 // GetID returns the jsonapi ID.
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/core/auth"
+	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	webauth "github.com/smartcontractkit/chainlink/core/web/auth"
 )
@@ -205,11 +206,9 @@ func TestResolver_DeleteAPIToken(t *testing.T) {
 				require.NoError(t, err)
 
 				session.User.HashedPassword = pwd
-				err = session.User.TokenKey.UnmarshalText([]byte("new-access-key"))
-				require.NoError(t, err)
 
 				f.Mocks.sessionsORM.On("FindUser", session.User.Email).Return(*session.User, nil)
-				f.Mocks.sessionsORM.On("DeleteAuthToken", session.User).Return(nil)
+				f.Mocks.sessionsORM.On("DeleteAPIToken", session.User.Email, sessions.DefaultAPITokenName).Return(sessions.APIToken{TokenKey: "new-access-key"}, nil)
 				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
 			},
 			query:     mutation,
@@ -291,7 +290,7 @@ func TestResolver_DeleteAPIToken(t *testing.T) {
 				session.User.HashedPassword = pwd
 
 				f.Mocks.sessionsORM.On("FindUser", session.User.Email).Return(*session.User, nil)
-				f.Mocks.sessionsORM.On("DeleteAuthToken", session.User).Return(gError)
+				f.Mocks.sessionsORM.On("DeleteAPIToken", session.User.Email, sessions.DefaultAPITokenName).Return(sessions.APIToken{}, gError)
 				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
 			},
 			query:     mutation,
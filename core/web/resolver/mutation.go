@@ -35,6 +35,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/ocrbootstrap"
 	"github.com/smartcontractkit/chainlink/core/services/vrf"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/utils/crypto"
@@ -926,13 +927,13 @@ func (r *Resolver) DeleteAPIToken(ctx context.Context, args struct {
 		}), nil
 	}
 
-	err = r.App.SessionORM().DeleteAuthToken(&dbUser)
-	if err != nil {
+	apiToken, err := r.App.SessionORM().DeleteAPIToken(dbUser.Email, sessions.DefaultAPITokenName)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return nil, err
 	}
 
 	return NewDeleteAPITokenPayload(&auth.Token{
-		AccessKey: dbUser.TokenKey.String,
+		AccessKey: apiToken.TokenKey,
 	}, nil), nil
 }
 
@@ -167,6 +167,16 @@ func (r *DirectRequestSpecResolver) Requesters() *[]string {
 	return &requesters
 }
 
+// DedupKey resolves the spec's dedup key expression.
+func (r *DirectRequestSpecResolver) DedupKey() *string {
+	return r.spec.DedupKey.Ptr()
+}
+
+// DedupTTL resolves the spec's dedup TTL.
+func (r *DirectRequestSpecResolver) DedupTTL() string {
+	return r.spec.DedupTTL.String()
+}
+
 type FluxMonitorSpecResolver struct {
 	spec job.FluxMonitorSpec
 }
@@ -707,6 +717,24 @@ func (r *VRFSpecResolver) MaxGasPriceGWei() *int32 {
 	return &maxGasPriceGWei
 }
 
+// MinContractPaymentLinkJuels resolves the spec's min contract payment link.
+func (r *VRFSpecResolver) MinContractPaymentLinkJuels() *string {
+	if r.spec.MinContractPayment == nil {
+		return nil
+	}
+	minContractPayment := r.spec.MinContractPayment.String()
+	return &minContractPayment
+}
+
+// LinkEthFeedAddress resolves the spec's LINK/ETH feed address.
+func (r *VRFSpecResolver) LinkEthFeedAddress() *string {
+	if r.spec.LinkEthFeedAddress == nil {
+		return nil
+	}
+	addr := r.spec.LinkEthFeedAddress.String()
+	return &addr
+}
+
 type WebhookSpecResolver struct {
 	spec job.WebhookSpec
 }
@@ -97,6 +97,8 @@ func TestResolver_DirectRequestSpec(t *testing.T) {
 						MinIncomingConfirmationsEnv: true,
 						MinContractPayment:          assets.NewLinkFromJuels(1000),
 						Requesters:                  models.AddressCollection{requesterAddress},
+						DedupKey:                    null.StringFrom("$(oracleRequest.requestId)"),
+						DedupTTL:                    time.Hour,
 					},
 				}, nil)
 			},
@@ -114,6 +116,8 @@ func TestResolver_DirectRequestSpec(t *testing.T) {
 									minIncomingConfirmationsEnv
 									minContractPaymentLinkJuels
 									requesters
+									dedupKey
+									dedupTTL
 								}
 							}
 						}
@@ -131,7 +135,9 @@ func TestResolver_DirectRequestSpec(t *testing.T) {
 							"minIncomingConfirmations": 1,
 							"minIncomingConfirmationsEnv": true,
 							"minContractPaymentLinkJuels": "1000",
-							"requesters": ["0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"]
+							"requesters": ["0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"],
+							"dedupKey": "$(oracleRequest.requestId)",
+							"dedupTTL": "1h0m0s"
 						}
 					}
 				}
@@ -597,6 +603,9 @@ func TestResolver_VRFSpec(t *testing.T) {
 	pubKey, err := secp256k1.NewPublicKeyFromHex("0x9dc09a0f898f3b5e8047204e7ce7e44b587920932f08431e29c9bf6923b8450a01")
 	require.NoError(t, err)
 
+	linkEthFeedAddress, err := ethkey.NewEIP55Address("0x9FBDa871d559710256a2502A2517b794B482Db40")
+	require.NoError(t, err)
+
 	testCases := []GQLTestCase{
 		{
 			name:          "vrf spec",
@@ -623,6 +632,8 @@ func TestResolver_VRFSpec(t *testing.T) {
 						BackoffInitialDelay:           time.Minute,
 						BackoffMaxDelay:               time.Hour,
 						MaxGasPriceGWei:               &maxGasPriceGWei,
+						MinContractPayment:            assets.NewLinkFromJuels(100),
+						LinkEthFeedAddress:            &linkEthFeedAddress,
 					},
 				}, nil)
 			},
@@ -649,6 +660,8 @@ func TestResolver_VRFSpec(t *testing.T) {
 									backoffInitialDelay
 									backoffMaxDelay
 									maxGasPriceGWei
+									minContractPaymentLinkJuels
+									linkEthFeedAddress
 								}
 							}
 						}
@@ -675,7 +688,9 @@ func TestResolver_VRFSpec(t *testing.T) {
 							"chunkSize": 25,
 							"backoffInitialDelay": "1m0s",
 							"backoffMaxDelay": "1h0m0s",
-							"maxGasPriceGWei": 200
+							"maxGasPriceGWei": 200,
+							"minContractPaymentLinkJuels": "100",
+							"linkEthFeedAddress": "0x9FBDa871d559710256a2502A2517b794B482Db40"
 						}
 					}
 				}
@@ -74,6 +74,7 @@ func Router(app chainlink.Application, prometheus *ginprom.Prometheus) *gin.Engi
 			config.AuthenticatedRateLimit(),
 		),
 		sessions.Sessions(auth.SessionName, sessionStore),
+		auth.CSRFProtection(),
 	)
 
 	unauthenticatedDevOnlyMetricRoutes(app, api)
@@ -83,11 +84,12 @@ func Router(app chainlink.Application, prometheus *ginprom.Prometheus) *gin.Engi
 
 	guiAssetRoutes(engine, config, app.GetLogger())
 
-	api.POST("/query",
-		auth.AuthenticateGQL(app.SessionORM(), app.GetLogger().Named("GQLHandler")),
-		loader.Middleware(app),
-		graphqlHandler(app),
-	)
+	gqlHandlers := []gin.HandlerFunc{auth.AuthenticateGQL(app.SessionORM(), app.GetLogger().Named("GQLHandler"))}
+	if config.EnforceWebAuthn() {
+		gqlHandlers = append(gqlHandlers, auth.RequiresWebAuthnGQL(app.SessionORM(), app.GetLogger().Named("GQLHandler")))
+	}
+	gqlHandlers = append(gqlHandlers, auditGQLMutations(app), loader.Middleware(app), graphqlHandler(app))
+	api.POST("/query", gqlHandlers...)
 
 	return engine
 }
@@ -128,6 +130,7 @@ func rateLimiter(period time.Duration, limit int64) gin.HandlerFunc {
 }
 
 type WebSecurityConfig interface {
+	AllowHeaders() string
 	AllowOrigins() string
 	Dev() bool
 	TLSRedirect() bool
@@ -217,6 +220,8 @@ func healthRoutes(app chainlink.Application, r *gin.RouterGroup) {
 	hc := HealthController{app}
 	r.GET("/readyz", hc.Readyz)
 	r.GET("/health", hc.Health)
+	r.GET("/health/liveness", hc.Liveness)
+	r.GET("/health/readiness", hc.Readiness)
 }
 
 func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
@@ -226,10 +231,20 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 	psec := PipelineJobSpecErrorsController{app}
 	unauthedv2.PATCH("/resume/:runID", prc.Resume)
 
+	ptc := NewPublicTriggersController(app)
+	public := unauthedv2.Group("/public", rateLimiter(
+		app.GetConfig().UnAuthenticatedRateLimitPeriod().Duration(),
+		app.GetConfig().UnAuthenticatedRateLimit(),
+	))
+	public.POST("/webhooks/:token", ptc.Create)
+
 	authv2 := r.Group("/v2", auth.Authenticate(app.SessionORM(),
 		auth.AuthenticateByToken,
 		auth.AuthenticateBySession,
-	))
+	), auditRequests(app), auth.RequiresAPITokenScope())
+	if app.GetConfig().EnforceWebAuthn() {
+		authv2.Use(auth.RequiresWebAuthn(app.SessionORM(), "/v2/enroll_webauthn", "/v2/webauthn_devices"))
+	}
 	{
 		uc := UserController{app}
 		authv2.GET("/users", auth.RequiresAdminRole(uc.Index))
@@ -240,9 +255,23 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/user/token", uc.NewAPIToken)
 		authv2.POST("/user/token/delete", uc.DeleteAPIToken)
 
+		usc := UserSessionsController{app}
+		authv2.GET("/users/:email/sessions", auth.RequiresAdminRole(usc.IndexForUser))
+		authv2.DELETE("/users/:email/sessions/:ID", auth.RequiresAdminRole(usc.DeleteForUser))
+		authv2.PATCH("/users/:email/session_timeouts", auth.RequiresAdminRole(usc.UpdateTimeouts))
+		authv2.GET("/user/sessions", usc.Index)
+		authv2.DELETE("/user/sessions/:ID", usc.Delete)
+
+		atc := APITokensController{app}
+		authv2.GET("/user/tokens", atc.Index)
+		authv2.POST("/user/tokens", atc.Create)
+		authv2.DELETE("/user/tokens/:name", atc.Delete)
+
 		wa := NewWebAuthnController(app)
 		authv2.GET("/enroll_webauthn", wa.BeginRegistration)
 		authv2.POST("/enroll_webauthn", wa.FinishRegistration)
+		authv2.GET("/webauthn_devices", wa.Index)
+		authv2.DELETE("/webauthn_devices/:ID", wa.Delete)
 
 		eia := ExternalInitiatorsController{app}
 		authv2.GET("/external_initiators", paginatedRequest(eia.Index))
@@ -256,6 +285,18 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.PATCH("/bridge_types/:BridgeName", auth.RequiresEditRole(bt.Update))
 		authv2.DELETE("/bridge_types/:BridgeName", auth.RequiresEditRole(bt.Destroy))
 
+		bhc := BridgeHealthController{app}
+		authv2.GET("/bridge_health", bhc.Index)
+
+		jec := JobEarningsController{app}
+		authv2.GET("/job_earnings", jec.Index)
+
+		lwc := LinkWithdrawalsController{app}
+		authv2.GET("/link_withdrawals", lwc.Index)
+		authv2.GET("/link_withdrawals/:ID", lwc.Show)
+		authv2.POST("/link_withdrawals", auth.RequiresAdminRole(lwc.Create))
+		authv2.DELETE("/link_withdrawals/:ID", auth.RequiresAdminRole(lwc.Delete))
+
 		ets := EVMTransfersController{app}
 		authv2.POST("/transfers", auth.RequiresAdminRole(ets.Create))
 		authv2.POST("/transfers/evm", auth.RequiresAdminRole(ets.Create))
@@ -275,12 +316,28 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 
 		txs := TransactionsController{app}
 		authv2.GET("/transactions/evm", paginatedRequest(txs.Index))
+		authv2.GET("/transactions/evm/gas_analytics", txs.GasAnalytics)
 		authv2.GET("/transactions/evm/:TxHash", txs.Show)
+		authv2.GET("/transactions/evm/:TxHash/attempts", txs.Attempts)
+		authv2.POST("/transactions/evm/rebroadcast", auth.RequiresAdminRole(txs.Rebroadcast))
+		authv2.POST("/transactions/evm/:TxHash/bump", auth.RequiresAdminRole(txs.Bump))
+		authv2.POST("/transactions/evm/:TxHash/cancel", auth.RequiresAdminRole(txs.Cancel))
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
+		authv2.GET("/transactions/gas_analytics", txs.GasAnalytics)
 		authv2.GET("/transactions/:TxHash", txs.Show)
+		authv2.GET("/transactions/:TxHash/attempts", txs.Attempts)
+
+		rsc := EVMRPCStatsController{app}
+		authv2.GET("/ethereum/rpc-stats", rsc.Index)
 
 		rc := ReplayController{app}
 		authv2.POST("/replay_from_block/:number", auth.RequiresRunRole(rc.ReplayFromBlock))
+		authv2.POST("/replay_job/:ID", auth.RequiresRunRole(rc.ReplayJob))
+
+		ksc := KeystoreController{app}
+		authv2.PATCH("/keys/password", auth.RequiresAdminRole(ksc.ChangePassword))
+		authv2.PATCH("/keys/roles/:role/password", auth.RequiresAdminRole(ksc.ChangeRolePassword))
+		authv2.POST("/keys/column_key/rotate", auth.RequiresAdminRole(ksc.RotateColumnKey))
 
 		csakc := CSAKeysController{app}
 		authv2.GET("/keys/csa", csakc.Index)
@@ -295,6 +352,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.DELETE("/keys/eth/:keyID", auth.RequiresAdminRole(ekc.Delete))
 		authv2.POST("/keys/eth/import", auth.RequiresAdminRole(ekc.Import))
 		authv2.POST("/keys/eth/export/:address", auth.RequiresAdminRole(ekc.Export))
+		authv2.GET("/keys/eth/:address/activity", ekc.Activity)
 		// duplicated from above, with `evm` instead of `eth`
 		// legacy ones remain for backwards compatibility
 		authv2.GET("/keys/evm", ekc.Index)
@@ -303,7 +361,9 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.DELETE("/keys/evm/:keyID", auth.RequiresAdminRole(ekc.Delete))
 		authv2.POST("/keys/evm/import", auth.RequiresAdminRole(ekc.Import))
 		authv2.POST("/keys/evm/export/:address", auth.RequiresAdminRole(ekc.Export))
+		authv2.GET("/keys/evm/:address/activity", ekc.Activity)
 		authv2.POST("/keys/evm/chain", auth.RequiresAdminRole(ekc.Chain))
+		authv2.POST("/keys/evm/remote", auth.RequiresAdminRole(ekc.AddRemote))
 
 		ocrkc := OCRKeysController{app}
 		authv2.GET("/keys/ocr", ocrkc.Index)
@@ -319,6 +379,18 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/keys/ocr2/import", auth.RequiresAdminRole(ocr2kc.Import))
 		authv2.POST("/keys/ocr2/export/:ID", auth.RequiresAdminRole(ocr2kc.Export))
 
+		ekrc := ETHKeyRotationsController{app}
+		authv2.GET("/keys/eth_rotations", ekrc.Index)
+		authv2.GET("/keys/eth_rotations/:ID", ekrc.Show)
+		authv2.POST("/keys/eth_rotations", auth.RequiresAdminRole(ekrc.Create))
+		authv2.POST("/keys/eth_rotations/:ID/force", auth.RequiresAdminRole(ekrc.Force))
+
+		okrc := OCRKeyRotationsController{app}
+		authv2.GET("/keys/ocr_rotations", okrc.Index)
+		authv2.POST("/keys/ocr_rotations", auth.RequiresAdminRole(okrc.Create))
+		authv2.POST("/keys/ocr_rotations/:ID/complete", auth.RequiresAdminRole(okrc.Complete))
+		authv2.DELETE("/keys/ocr_rotations/:ID", auth.RequiresAdminRole(okrc.Delete))
+
 		p2pkc := P2PKeysController{app}
 		authv2.GET("/keys/p2p", p2pkc.Index)
 		authv2.POST("/keys/p2p", auth.RequiresEditRole(p2pkc.Create))
@@ -350,28 +422,69 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/keys/vrf/import", auth.RequiresAdminRole(vrfkc.Import))
 		authv2.POST("/keys/vrf/export/:keyID", auth.RequiresAdminRole(vrfkc.Export))
 
+		vrfc := VRFController{app}
+		authv2.POST("/vrf/simulate", auth.RequiresAdminRole(vrfc.Simulate))
+
 		jc := JobsController{app}
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
 		authv2.GET("/jobs/:ID", jc.Show)
+		authv2.GET("/jobs/:ID/pipeline/graph", jc.Graph)
+		authv2.GET("/jobs/:ID/spend", jc.Spend)
+		authv2.GET("/jobs/:ID/earnings", jc.Earnings)
 		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
 		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
+		authv2.POST("/jobs/:ID/pause", auth.RequiresEditRole(jc.Pause))
+		authv2.POST("/jobs/:ID/resume", auth.RequiresEditRole(jc.Resume))
 
 		// PipelineRunsController
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
 
+		aec := AuditEventsController{app}
+		authv2.GET("/audit_events", auth.RequiresAdminRole(paginatedRequest(aec.Index)))
+
+		rosc := RunOutputSubscriptionsController{app}
+		authv2.GET("/run_output_subscriptions", rosc.Index)
+		authv2.POST("/run_output_subscriptions", auth.RequiresAdminRole(rosc.Create))
+		authv2.DELETE("/run_output_subscriptions/:ID", auth.RequiresAdminRole(rosc.Delete))
+
+		edc := ExternalDatabasesController{app}
+		authv2.GET("/external_databases", edc.Index)
+		authv2.POST("/external_databases", auth.RequiresAdminRole(edc.Create))
+		authv2.DELETE("/external_databases/:name", auth.RequiresAdminRole(edc.Delete))
+
 		// FeaturesController
 		fc := FeaturesController{app}
 		authv2.GET("/features", fc.Index)
 
+		upc := UpdatesController{app}
+		authv2.GET("/updates", upc.Subscribe)
+
 		// PipelineJobSpecErrorsController
 		authv2.DELETE("/pipeline/job_spec_errors/:ID", auth.RequiresEditRole(psec.Destroy))
+		authv2.POST("/pipeline/job_spec_errors/:ID/acknowledge", auth.RequiresEditRole(psec.Acknowledge))
+		authv2.GET("/jobs/:ID/errors", psec.Index)
+		authv2.POST("/jobs/:ID/errors/acknowledge", auth.RequiresEditRole(psec.AcknowledgeAll))
+		authv2.DELETE("/jobs/:ID/errors", auth.RequiresEditRole(psec.DestroyAll))
 
 		lgc := LogController{app}
 		authv2.GET("/log", lgc.Get)
 		authv2.PATCH("/log", auth.RequiresAdminRole(lgc.Patch))
 
+		mc := MaintenanceController{app}
+		authv2.GET("/maintenance", mc.Show)
+		authv2.PATCH("/maintenance", auth.RequiresAdminRole(mc.Update))
+
+		mwc := MaintenanceWindowsController{app}
+		authv2.GET("/maintenance_windows", mwc.Index)
+		authv2.POST("/maintenance_windows", auth.RequiresAdminRole(mwc.Create))
+		authv2.DELETE("/maintenance_windows/:ID", auth.RequiresAdminRole(mwc.Delete))
+
+		p2pc := P2PController{app}
+		authv2.GET("/p2p/status", p2pc.Status)
+		authv2.POST("/p2p/ping/:peerID", auth.RequiresEditRole(p2pc.Ping))
+
 		chains := authv2.Group("chains")
 		for _, chain := range []struct {
 			path string
@@ -428,7 +541,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		auth.AuthenticateExternalInitiator,
 		auth.AuthenticateByToken,
 		auth.AuthenticateBySession,
-	))
+	), auditRequests(app), auth.RequiresAPITokenScope())
 	userOrEI.GET("/ping", ping.Show)
 	userOrEI.POST("/jobs/:ID/runs", auth.RequiresRunRole(prc.Create))
 }
@@ -548,8 +661,8 @@ func loggerFunc(lggr logger.Logger) gin.HandlerFunc {
 func uiCorsHandler(config WebSecurityConfig) gin.HandlerFunc {
 	c := cors.Config{
 		AllowMethods:     []string{"GET", "POST", "PATCH", "DELETE"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept"},
-		ExposeHeaders:    []string{"Content-Length"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", auth.CSRFTokenHeader},
+		ExposeHeaders:    []string{"Content-Length", auth.CSRFTokenHeader},
 		AllowCredentials: true,
 		MaxAge:           math.MaxInt32,
 	}
@@ -558,6 +671,9 @@ func uiCorsHandler(config WebSecurityConfig) gin.HandlerFunc {
 	} else if allowOrigins := strings.Split(config.AllowOrigins(), ","); len(allowOrigins) > 0 {
 		c.AllowOrigins = allowOrigins
 	}
+	if config.AllowHeaders() != "" {
+		c.AllowHeaders = append(c.AllowHeaders, strings.Split(config.AllowHeaders(), ",")...)
+	}
 	return cors.New(c)
 }
 
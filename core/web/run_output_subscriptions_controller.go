@@ -0,0 +1,103 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/runoutputs"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// RunOutputSubscriptionsController manages the URLs receiving the outputs of
+// finished runs
+type RunOutputSubscriptionsController struct {
+	App chainlink.Application
+}
+
+// CreateRunOutputSubscriptionRequest is a JSONAPI request for subscribing a
+// URL to run outputs. Unset filters match every run.
+type CreateRunOutputSubscriptionRequest struct {
+	URL     models.WebURL `json:"url"`
+	JobID   null.Int      `json:"jobID"`
+	JobType null.String   `json:"jobType"`
+	Status  null.String   `json:"status"`
+}
+
+// Index lists run output subscriptions
+// Example:
+// "GET <application>/run_output_subscriptions"
+func (rc *RunOutputSubscriptionsController) Index(c *gin.Context) {
+	subs, err := rc.App.RunOutputsNotifier().Subscriptions()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewRunOutputSubscriptionResources(subs), "runOutputSubscriptions")
+}
+
+// Create subscribes a URL to run outputs. The returned secret is used to
+// sign the deliveries and is not shown again.
+// Example:
+// "POST <application>/run_output_subscriptions"
+func (rc *RunOutputSubscriptionsController) Create(c *gin.Context) {
+	var request CreateRunOutputSubscriptionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if request.JobID.Valid {
+		_, err := rc.App.JobORM().FindJob(c.Request.Context(), int32(request.JobID.Int64))
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("job %d not found", request.JobID.Int64))
+			return
+		} else if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	sub := runoutputs.Subscription{
+		URL:     request.URL,
+		JobID:   request.JobID,
+		JobType: request.JobType,
+		Status:  request.Status,
+	}
+	if err := rc.App.RunOutputsNotifier().Subscribe(&sub); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	resource := presenters.NewRunOutputSubscriptionResource(sub)
+	resource.Secret = sub.Secret
+	jsonAPIResponseWithStatus(c, resource, "runOutputSubscription", http.StatusCreated)
+}
+
+// Delete unsubscribes a URL from run outputs
+// Example:
+// "DELETE <application>/run_output_subscriptions/:ID"
+func (rc *RunOutputSubscriptionsController) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("ID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	err = rc.App.RunOutputsNotifier().Unsubscribe(id)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("run output subscription not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "runOutputSubscription", http.StatusNoContent)
+}
@@ -0,0 +1,70 @@
+package web_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestRunOutputSubscriptionsController(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	t.Run("rejects invalid subscriptions", func(t *testing.T) {
+		for _, body := range []string{
+			`{"url": "https://example.com/outputs", "status": "running"}`,
+			`{"url": "https://example.com/outputs", "jobID": 1234}`,
+			`{"url": "ftp://example.com/outputs"}`,
+		} {
+			resp, cleanup := client.Post("/v2/run_output_subscriptions", bytes.NewBufferString(body))
+			t.Cleanup(cleanup)
+			cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+		}
+	})
+
+	var created presenters.RunOutputSubscriptionResource
+	t.Run("creates a subscription", func(t *testing.T) {
+		body := `{"url": "https://example.com/outputs", "jobType": "webhook", "status": "errored"}`
+		resp, cleanup := client.Post("/v2/run_output_subscriptions", bytes.NewBufferString(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusCreated)
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &created))
+		assert.Equal(t, "https://example.com/outputs", created.URL)
+		assert.Equal(t, "webhook", created.JobType.String)
+		assert.Equal(t, "errored", created.Status.String)
+		assert.False(t, created.JobID.Valid)
+		assert.NotEmpty(t, created.Secret)
+	})
+
+	t.Run("lists subscriptions without their secrets", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/run_output_subscriptions")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		var subs []presenters.RunOutputSubscriptionResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &subs))
+		require.Len(t, subs, 1)
+		assert.Equal(t, created.ID, subs[0].ID)
+		assert.Empty(t, subs[0].Secret)
+	})
+
+	t.Run("deletes a subscription", func(t *testing.T) {
+		resp, cleanup := client.Delete("/v2/run_output_subscriptions/" + created.ID)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+		resp, cleanup = client.Delete("/v2/run_output_subscriptions/" + created.ID)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+}
@@ -37,6 +37,9 @@ func (sc *SessionsController) Create(c *gin.Context) {
 		return
 	}
 
+	sr.IPAddress = c.ClientIP()
+	sr.UserAgent = c.Request.UserAgent()
+
 	// Does this user have 2FA enabled?
 	userWebAuthnTokens, err := sc.App.SessionORM().GetUserWebAuthn(sr.Email)
 	if err != nil {
@@ -64,7 +67,10 @@ func (sc *SessionsController) Create(c *gin.Context) {
 		return
 	}
 
-	jsonAPIResponse(c, Session{Authenticated: true}, "session")
+	jsonAPIResponse(c, Session{
+		Authenticated:    true,
+		WebAuthnRequired: sc.App.GetConfig().EnforceWebAuthn() && len(userWebAuthnTokens) == 0,
+	}, "session")
 }
 
 // Destroy removes the specified session ID from the database.
@@ -93,6 +99,9 @@ func saveSessionID(session sessions.Session, sessionID string) error {
 
 type Session struct {
 	Authenticated bool `json:"authenticated"`
+	// WebAuthnRequired is set when the user must register a WebAuthn key
+	// before the session can be used for anything else
+	WebAuthnRequired bool `json:"webAuthnRequired,omitempty"`
 }
 
 // GetID returns the jsonapi ID.
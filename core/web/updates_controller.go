@@ -0,0 +1,129 @@
+package web
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/updates"
+)
+
+const (
+	updatesWriteTimeout = 10 * time.Second
+	updatesPingInterval = 30 * time.Second
+)
+
+// UpdatesController pushes the changes of runs, transactions and heads to the
+// operator UI over a websocket
+type UpdatesController struct {
+	App chainlink.Application
+}
+
+// Subscribe upgrades the connection to a websocket streaming the updates of
+// the comma separated topics (runs, txes, heads), all of them by default.
+// The websocket is closed with CloseTryAgainLater if the client falls behind,
+// it should then fetch the current state again before resubscribing.
+// Example:
+// "GET <application>/v2/updates?topics=runs,heads"
+func (uc *UpdatesController) Subscribe(c *gin.Context) {
+	var topics []updates.Topic
+	if param := c.Query("topics"); param != "" {
+		for _, s := range strings.Split(param, ",") {
+			topic := updates.Topic(strings.TrimSpace(s))
+			if !isUpdatesTopic(topic) {
+				jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("unknown topic %q", topic))
+				return
+			}
+			topics = append(topics, topic)
+		}
+	}
+
+	// subscribe before the handshake, so no update is missed once the client
+	// is connected
+	chUpdates, unsubscribe := uc.App.UpdatesBroadcaster().Subscribe(topics...)
+	defer unsubscribe()
+
+	upgrader := websocket.Upgrader{CheckOrigin: uc.checkOrigin}
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// Upgrade has already replied with an error
+		uc.App.GetLogger().Debugw("Failed to upgrade updates websocket", "err", err)
+		return
+	}
+	defer conn.Close()
+
+	// clients only send control frames, reading processes them and detects
+	// closed connections
+	_ = conn.SetReadDeadline(time.Now().Add(2 * updatesPingInterval))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(2 * updatesPingInterval))
+	})
+	chClosed := make(chan struct{})
+	go func() {
+		defer close(chClosed)
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(updatesPingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case u, ok := <-chUpdates:
+			if !ok {
+				msg := websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "client fell behind")
+				_ = conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(updatesWriteTimeout))
+				return
+			}
+			_ = conn.SetWriteDeadline(time.Now().Add(updatesWriteTimeout))
+			if err := conn.WriteJSON(u); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(updatesWriteTimeout)); err != nil {
+				return
+			}
+		case <-chClosed:
+			return
+		}
+	}
+}
+
+// checkOrigin allows the same origin and the origins allowed by CORS
+func (uc *UpdatesController) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	if u, err := url.Parse(origin); err == nil && strings.EqualFold(u.Host, r.Host) {
+		return true
+	}
+	allowOrigins := uc.App.GetConfig().AllowOrigins()
+	if allowOrigins == "*" {
+		return true
+	}
+	for _, o := range strings.Split(allowOrigins, ",") {
+		if o == origin {
+			return true
+		}
+	}
+	return false
+}
+
+func isUpdatesTopic(topic updates.Topic) bool {
+	for _, t := range updates.Topics {
+		if t == topic {
+			return true
+		}
+	}
+	return false
+}
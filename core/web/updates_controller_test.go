@@ -0,0 +1,57 @@
+package web_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/updates"
+)
+
+func TestUpdatesController_Subscribe(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	wsURL := "ws" + strings.TrimPrefix(app.Server.URL, "http") + "/v2/updates"
+	header := http.Header{}
+	header.Set("Cookie", cltest.MustGenerateSessionCookie(t, app.MustSeedNewSession(cltest.APIEmailAdmin)).String())
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		_, resp, err := websocket.DefaultDialer.Dial(wsURL, nil)
+		require.Error(t, err)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("unknown topic", func(t *testing.T) {
+		_, resp, err := websocket.DefaultDialer.Dial(wsURL+"?topics=runs,foo", header)
+		require.Error(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	})
+
+	t.Run("streams the updates of the topics", func(t *testing.T) {
+		conn, _, err := websocket.DefaultDialer.Dial(wsURL+"?topics=runs", header)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		b := app.UpdatesBroadcaster()
+		b.OnNewLongestChain(testutils.Context(t), cltest.Head(1))
+		b.OnRunFinished(&pipeline.Run{ID: 1, PipelineSpec: pipeline.Spec{JobID: 2}, State: pipeline.RunStatusCompleted})
+
+		var u struct {
+			Type string
+			Data updates.Run
+		}
+		require.NoError(t, conn.ReadJSON(&u))
+		assert.Equal(t, updates.TypeRunCompleted, u.Type)
+		assert.Equal(t, int64(1), u.Data.ID)
+		assert.Equal(t, int32(2), u.Data.JobID)
+		assert.Equal(t, pipeline.RunStatusCompleted, u.Data.State)
+	})
+}
@@ -1,6 +1,7 @@
 package web
 
 import (
+	"database/sql"
 	"net/http"
 	"strings"
 
@@ -191,7 +192,7 @@ func (c *UserController) UpdatePassword(ctx *gin.Context) {
 	jsonAPIResponse(ctx, presenters.NewUserResource(user), "user")
 }
 
-// NewAPIToken generates a new API token for a user overwriting any pre-existing one set.
+// NewAPIToken generates a new default API token for a user overwriting any pre-existing one set.
 func (c *UserController) NewAPIToken(ctx *gin.Context) {
 	var request clsession.ChangeAuthTokenRequest
 	if err := ctx.ShouldBindJSON(&request); err != nil {
@@ -223,7 +224,7 @@ func (c *UserController) NewAPIToken(ctx *gin.Context) {
 	jsonAPIResponseWithStatus(ctx, newToken, "auth_token", http.StatusCreated)
 }
 
-// DeleteAPIToken deletes and disables a user's API token.
+// DeleteAPIToken deletes and disables a user's default API token.
 func (c *UserController) DeleteAPIToken(ctx *gin.Context) {
 	var request clsession.ChangeAuthTokenRequest
 	if err := ctx.ShouldBindJSON(&request); err != nil {
@@ -246,7 +247,7 @@ func (c *UserController) DeleteAPIToken(ctx *gin.Context) {
 		jsonAPIError(ctx, http.StatusUnauthorized, errors.New("incorrect password"))
 		return
 	}
-	if err := c.App.SessionORM().DeleteAuthToken(&user); err != nil {
+	if _, err := c.App.SessionORM().DeleteAPIToken(user.Email, clsession.DefaultAPITokenName); err != nil && !errors.Is(err, sql.ErrNoRows) {
 		jsonAPIError(ctx, http.StatusInternalServerError, err)
 		return
 	}
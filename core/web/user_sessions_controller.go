@@ -0,0 +1,110 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	webauth "github.com/smartcontractkit/chainlink/core/web/auth"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// UserSessionsController lists and revokes login sessions, and manages the
+// session timeouts of users
+type UserSessionsController struct {
+	App chainlink.Application
+}
+
+// UpdateSessionTimeoutsRequest is sent to set the session timeouts of a user.
+// Null timeouts use the node's defaults.
+type UpdateSessionTimeoutsRequest struct {
+	IdleTimeout     *models.Interval `json:"idleTimeout"`
+	AbsoluteTimeout *models.Interval `json:"absoluteTimeout"`
+}
+
+// Index lists the active sessions of the current user
+// Example:
+// "GET <application>/user/sessions"
+func (usc *UserSessionsController) Index(c *gin.Context) {
+	user, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+	usc.index(c, user.Email)
+}
+
+// Delete revokes a session of the current user
+// Example:
+// "DELETE <application>/user/sessions/:ID"
+func (usc *UserSessionsController) Delete(c *gin.Context) {
+	user, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+	usc.delete(c, user.Email)
+}
+
+// IndexForUser lists the active sessions of any user
+// Example:
+// "GET <application>/users/:email/sessions"
+func (usc *UserSessionsController) IndexForUser(c *gin.Context) {
+	usc.index(c, c.Param("email"))
+}
+
+// DeleteForUser revokes a session of any user
+// Example:
+// "DELETE <application>/users/:email/sessions/:ID"
+func (usc *UserSessionsController) DeleteForUser(c *gin.Context) {
+	usc.delete(c, c.Param("email"))
+}
+
+// UpdateTimeouts sets the session timeouts of a user
+// Example:
+// "PATCH <application>/users/:email/session_timeouts"
+func (usc *UserSessionsController) UpdateTimeouts(c *gin.Context) {
+	var request UpdateSessionTimeoutsRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	user, err := usc.App.SessionORM().SetSessionTimeouts(c.Param("email"), request.IdleTimeout, request.AbsoluteTimeout)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("user not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewUserResource(user), "user")
+}
+
+func (usc *UserSessionsController) index(c *gin.Context, email string) {
+	sessions, err := usc.App.SessionORM().ListSessions(email)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	// Requests authenticated by API token have no current session
+	currentID, _ := getCurrentSessionID(c)
+	jsonAPIResponse(c, presenters.NewSessionResources(sessions, currentID), "sessions")
+}
+
+func (usc *UserSessionsController) delete(c *gin.Context, email string) {
+	if err := usc.App.SessionORM().RevokeSession(email, c.Param("ID")); errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("session not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "session", http.StatusNoContent)
+}
@@ -0,0 +1,90 @@
+package web_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestUserSessionsController_CurrentUser(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+	otherID := app.MustSeedNewSession(cltest.APIEmailAdmin)
+
+	resp, cleanup := client.Get("/v2/user/sessions")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var list []presenters.SessionResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &list))
+	require.Len(t, list, 2)
+	otherPublicID := sessions.Session{ID: otherID}.PublicID()
+	for _, s := range list {
+		assert.Equal(t, s.ID != otherPublicID, s.Current)
+	}
+
+	resp, cleanup = client.Delete("/v2/user/sessions/" + otherPublicID)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+	resp, cleanup = client.Delete("/v2/user/sessions/" + otherPublicID)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestUserSessionsController_Admin(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+	viewOnlyID := app.MustSeedNewSession(cltest.APIEmailViewOnly)
+
+	resp, cleanup := client.Get(fmt.Sprintf("/v2/users/%s/sessions", cltest.APIEmailViewOnly))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var list []presenters.SessionResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &list))
+	require.Len(t, list, 1)
+	assert.Equal(t, sessions.Session{ID: viewOnlyID}.PublicID(), list[0].ID)
+
+	body := []byte(`{"idleTimeout":"10m","absoluteTimeout":"8h"}`)
+	resp, cleanup = client.Patch(fmt.Sprintf("/v2/users/%s/session_timeouts", cltest.APIEmailViewOnly), bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var user presenters.UserResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &user))
+	require.NotNil(t, user.SessionIdleTimeout)
+	assert.Equal(t, 10*time.Minute, user.SessionIdleTimeout.Duration())
+	require.NotNil(t, user.SessionAbsoluteTimeout)
+	assert.Equal(t, 8*time.Hour, user.SessionAbsoluteTimeout.Duration())
+
+	resp, cleanup = client.Patch(fmt.Sprintf("/v2/users/%s/session_timeouts", cltest.APIEmailViewOnly), bytes.NewReader([]byte(`{"idleTimeout":"soon"}`)))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Patch("/v2/users/unknown@chainlink.test/session_timeouts", bytes.NewReader([]byte(`{}`)))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+	resp, cleanup = client.Delete(fmt.Sprintf("/v2/users/%s/sessions/%s", cltest.APIEmailViewOnly, list[0].ID))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+	viewOnlyClient := app.NewHTTPClient(cltest.APIEmailViewOnly)
+	resp, cleanup = viewOnlyClient.Get(fmt.Sprintf("/v2/users/%s/sessions", cltest.APIEmailAdmin))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnauthorized)
+}
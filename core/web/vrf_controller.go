@@ -0,0 +1,57 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/vrf"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// VRFController exposes VRF utilities
+type VRFController struct {
+	App chainlink.Application
+}
+
+type VRFSimulateRequest struct {
+	KeyHash     common.Hash    `json:"keyHash"`
+	Seed        *utils.Big     `json:"seed"`
+	Sender      common.Address `json:"sender"`
+	BlockHash   common.Hash    `json:"blockHash"`
+	BlockNumber uint64         `json:"blockNumber"`
+}
+
+// Simulate generates the proof and calldata the node would submit to fulfill
+// a VRF v1 request, without sending a transaction.
+// Example:
+// "POST <application>/vrf/simulate"
+func (vc *VRFController) Simulate(c *gin.Context) {
+	request := &VRFSimulateRequest{}
+	if err := c.ShouldBindJSON(request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.Seed == nil {
+		jsonAPIError(c, http.StatusBadRequest, errors.New("missing 'seed' parameter"))
+		return
+	}
+
+	f, err := vrf.SimulateFulfillment(vc.App.GetKeyStore().VRF(), vrf.SimulationRequest{
+		KeyHash:   request.KeyHash,
+		Seed:      request.Seed.ToInt(),
+		Sender:    request.Sender,
+		BlockHash: request.BlockHash,
+		BlockNum:  request.BlockNumber,
+	})
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewVRFSimulationResource(f), "vrfSimulation")
+}
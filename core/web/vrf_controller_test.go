@@ -0,0 +1,54 @@
+package web_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestVRFController_Simulate(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	key, err := app.KeyStore.VRF().Create()
+	require.NoError(t, err)
+
+	body := fmt.Sprintf(`{
+		"keyHash": "%s",
+		"seed": "42",
+		"sender": "0x2bB4B32d1C6b3C9eB6C1e0E8F6bA0Ba6C0aB1e47",
+		"blockHash": "0x000000000000000000000000000000000000000000000000000000000000abcd",
+		"blockNumber": 100
+	}`, key.PublicKey.MustHash().Hex())
+	resp, cleanup := client.Post("/v2/vrf/simulate", bytes.NewBufferString(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var sim presenters.VRFSimulationResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &sim))
+	assert.Equal(t, key.ID(), sim.KeyID)
+	assert.NotEmpty(t, sim.Proof)
+	assert.NotEmpty(t, sim.FulfillCalldata)
+	assert.Len(t, sim.CallbackCalldata, 4+32+32)
+	assert.Equal(t, common.HexToAddress("0x2bB4B32d1C6b3C9eB6C1e0E8F6bA0Ba6C0aB1e47").Hex(), sim.CallbackAddress)
+
+	resp, cleanup = client.Post("/v2/vrf/simulate", bytes.NewBufferString(`{"keyHash": "0x01", "seed": "42", "blockHash": "0x01"}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+
+	resp, cleanup = client.Post("/v2/vrf/simulate", bytes.NewBufferString(`{"keyHash": "0x01"}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+}
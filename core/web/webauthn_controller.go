@@ -1,8 +1,11 @@
 package web
 
 import (
+	"database/sql"
 	"net/http"
+	"strconv"
 
+	ginsessions "github.com/gin-gonic/contrib/sessions"
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
 
@@ -81,11 +84,72 @@ func (c *WebAuthnController) FinishRegistration(ctx *gin.Context) {
 		return
 	}
 
-	if sessions.AddCredentialToUser(c.App.SessionORM(), user.Email, credential) != nil {
+	if sessions.AddCredentialToUser(c.App.SessionORM(), user.Email, ctx.Query("name"), credential) != nil {
 		c.App.GetLogger().Errorf("Could not save WebAuthn credential to DB for user: %s", user.Email)
 		jsonAPIError(ctx, http.StatusInternalServerError, errors.New("internal Server Error"))
 		return
 	}
 
+	// The password sessions of the user, including this one, are revoked so
+	// that the user logs in again with a WebAuthn assertion
+	if err = orm.DeletePasswordSessions(user.Email); err != nil {
+		c.App.GetLogger().Errorf("Could not revoke password sessions of user %s: %s", user.Email, err)
+		jsonAPIError(ctx, http.StatusInternalServerError, errors.New("internal Server Error"))
+		return
+	}
+	if _, bySession := ctx.Get(auth.SessionIDKey); bySession {
+		session := ginsessions.Default(ctx)
+		session.Clear()
+		if err = session.Save(); err != nil {
+			c.App.GetLogger().Errorf("Could not clear session cookie: %s", err)
+		}
+	}
+
 	ctx.String(http.StatusOK, "{}")
 }
+
+// Index lists the WebAuthn keys registered by the current user.
+// Example:
+// "GET <application>/webauthn_devices"
+func (c *WebAuthnController) Index(ctx *gin.Context) {
+	user, ok := auth.GetAuthenticatedUser(ctx)
+	if !ok {
+		jsonAPIError(ctx, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+
+	uwas, err := c.App.SessionORM().GetUserWebAuthn(user.Email)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(ctx, presenters.NewWebAuthnDeviceResources(uwas), "webauthn_devices")
+}
+
+// Delete removes a WebAuthn key registered by the current user.
+// Example:
+// "DELETE <application>/webauthn_devices/:ID"
+func (c *WebAuthnController) Delete(ctx *gin.Context) {
+	user, ok := auth.GetAuthenticatedUser(ctx)
+	if !ok {
+		jsonAPIError(ctx, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+
+	id, err := strconv.ParseInt(ctx.Param("ID"), 10, 64)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if err = c.App.SessionORM().DeleteWebAuthn(user.Email, id); errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(ctx, http.StatusNotFound, errors.New("WebAuthn device not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(ctx, nil, "webauthn_device", http.StatusNoContent)
+}
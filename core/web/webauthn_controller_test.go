@@ -0,0 +1,59 @@
+package web_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	sqlxTypes "github.com/smartcontractkit/sqlx/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestWebAuthnController_Devices(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	orm := app.SessionORM()
+	for _, name := range []string{"yubikey", "laptop"} {
+		require.NoError(t, orm.SaveWebAuthn(&sessions.WebAuthn{
+			Email:         cltest.APIEmailAdmin,
+			Name:          name,
+			PublicKeyData: sqlxTypes.JSONText(`{}`),
+		}))
+	}
+
+	resp, cleanup := client.Get("/v2/webauthn_devices")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var devices []presenters.WebAuthnDeviceResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &devices))
+	require.Len(t, devices, 2)
+	assert.Equal(t, "yubikey", devices[0].Name)
+	assert.Equal(t, "laptop", devices[1].Name)
+
+	resp, cleanup = client.Delete(fmt.Sprintf("/v2/webauthn_devices/%s", devices[0].ID))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+	resp, cleanup = client.Delete(fmt.Sprintf("/v2/webauthn_devices/%s", devices[0].ID))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+	resp, cleanup = client.Delete("/v2/webauthn_devices/abc")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	uwas, err := orm.GetUserWebAuthn(cltest.APIEmailAdmin)
+	require.NoError(t, err)
+	require.Len(t, uwas, 1)
+	assert.Equal(t, "laptop", uwas[0].Name)
+}